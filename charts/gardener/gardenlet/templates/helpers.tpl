@@ -40,6 +40,15 @@ components.yaml: |
 gardenlet-imagevector-overwrite-components-{{ include "gardenlet.imagevector-overwrite-components.data" . | sha256sum | trunc 8 }}
 {{- end -}}
 
+{{- define "gardenlet.imagevector-policy.data" -}}
+policy.yaml: |
+{{ .Values.imageVectorPolicy | indent 2 }}
+{{- end -}}
+
+{{- define "gardenlet.imagevector-policy.name" -}}
+gardenlet-imagevector-policy-{{ include "gardenlet.imagevector-policy.data" . | sha256sum | trunc 8 }}
+{{- end -}}
+
 {{- define "gardenlet.cert.name" -}}
 gardenlet-cert-{{ include "gardenlet.cert.data" . | sha256sum | trunc 8 }}
 {{- end -}}
@@ -233,6 +242,10 @@ config.yaml: |
     shootState:
       concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
       syncPeriod: {{ required ".Values.config.controllers.shootState.syncPeriod is required" .Values.config.controllers.shootState.syncPeriod }}
+      {{- if .Values.config.controllers.shootState.backup }}
+      backup:
+{{ toYaml .Values.config.controllers.shootState.backup | indent 8 }}
+      {{- end }}
     {{- end }}
     {{- if .Values.config.controllers.managedSeed }}
     managedSeed:
@@ -325,6 +338,10 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end}}
+  {{- if .Values.config.secretsManager }}
+  secretsManager:
+{{ toYaml .Values.config.secretsManager | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
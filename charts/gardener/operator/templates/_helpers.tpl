@@ -32,6 +32,15 @@ components.yaml: |
 gardener-operator-imagevector-overwrite-components-{{ include "operator.imagevector-overwrite-components.data" . | sha256sum | trunc 8 }}
 {{- end -}}
 
+{{- define "operator.imagevector-policy.data" -}}
+policy.yaml: |
+{{ .Values.imageVectorPolicy | indent 2 }}
+{{- end -}}
+
+{{- define "operator.imagevector-policy.name" -}}
+gardener-operator-imagevector-policy-{{ include "operator.imagevector-policy.data" . | sha256sum | trunc 8 }}
+{{- end -}}
+
 {{- define "operator.config.data" -}}
 config.yaml: |
   ---
@@ -109,6 +118,10 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end }}
+  {{- if .Values.config.secretsManager }}
+  secretsManager:
+{{ toYaml .Values.config.secretsManager | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "operator.config.name" -}}
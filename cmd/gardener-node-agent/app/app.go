@@ -17,6 +17,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/fs"
 	"net"
 	"net/http"
@@ -59,6 +60,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 )
 
@@ -88,6 +90,7 @@ func NewCommand() *cobra.Command {
 	opts.addFlags(flags)
 
 	cmd.AddCommand(getBootstrapCommand(opts))
+	cmd.AddCommand(getDiffCommand(opts))
 	return cmd
 }
 
@@ -112,6 +115,71 @@ func getBootstrapCommand(opts *options) *cobra.Command {
 	return bootstrapCmd
 }
 
+func getDiffCommand(opts *options) *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Print the changes the " + Name + " would apply to this node without applying them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, err := utils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return diff(cmd.Context(), log, cmd.OutOrStdout(), opts.config)
+		},
+	}
+
+	flags := diffCmd.Flags()
+	verflag.AddFlags(flags)
+	opts.addFlags(flags)
+
+	return diffCmd
+}
+
+func diff(ctx context.Context, log logr.Logger, out io.Writer, cfg *config.NodeAgentConfiguration) error {
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		cfg.ClientConnection.Kubeconfig = kubeconfig
+	}
+
+	log.Info("Getting rest config")
+	var (
+		restConfig *rest.Config
+		err        error
+	)
+
+	if len(cfg.ClientConnection.Kubeconfig) > 0 {
+		restConfig, err = kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.ClientConnection, nil, kubernetes.AuthTokenFile)
+		if err != nil {
+			return fmt.Errorf("failed getting REST config from client connection configuration: %w", err)
+		}
+	} else {
+		// The access token is not fetched even if it does not exist yet since the diff must not modify the node.
+		restConfig, _, err = getRESTConfig(log, cfg)
+		if err != nil {
+			return fmt.Errorf("failed getting REST config: %w", err)
+		}
+	}
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return fmt.Errorf("unable to create client: %w", err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: cfg.Controllers.OperatingSystemConfig.SecretName, Namespace: metav1.NamespaceSystem}}
+	log.Info("Reading operating system config secret", "secret", client.ObjectKeyFromObject(secret))
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return fmt.Errorf("failed reading operating system config secret: %w", err)
+	}
+
+	log.Info("Computing changes of operating system config")
+	d, err := operatingsystemconfig.ComputeDiff(afero.Afero{Fs: afero.NewReadOnlyFs(afero.NewOsFs())}, secret)
+	if err != nil {
+		return fmt.Errorf("failed computing changes of operating system config: %w", err)
+	}
+
+	return d.Print(out)
+}
+
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *config.NodeAgentConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
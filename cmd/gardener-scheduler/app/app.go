@@ -16,7 +16,9 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -37,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/yaml"
 
 	cmdutils "github.com/gardener/gardener/cmd/utils"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -46,6 +49,7 @@ import (
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 	"github.com/gardener/gardener/pkg/scheduler/controller"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 	"github.com/gardener/gardener/pkg/utils"
 )
 
@@ -73,9 +77,76 @@ func NewCommand() *cobra.Command {
 	verflag.AddFlags(flags)
 	opts.addFlags(flags)
 
+	cmd.AddCommand(getSimulateCommand())
 	return cmd
 }
 
+func getSimulateCommand() *cobra.Command {
+	opts := &simulateOptions{}
+
+	simulateCmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Print the seed the given shoot would be scheduled to and why all other seeds were filtered out",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, err := cmdutils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return simulate(cmd.Context(), log, cmd.OutOrStdout(), opts)
+		},
+	}
+
+	flags := simulateCmd.Flags()
+	verflag.AddFlags(flags)
+	opts.addFlags(flags)
+
+	return simulateCmd
+}
+
+func simulate(ctx context.Context, log logr.Logger, out io.Writer, opts *simulateOptions) error {
+	cfg := opts.config
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		cfg.ClientConnection.Kubeconfig = kubeconfig
+	}
+
+	log.Info("Getting rest config")
+	restCfg, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.ClientConnection, nil, kubernetes.AuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restCfg, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return fmt.Errorf("unable to create client: %w", err)
+	}
+
+	log.Info("Simulating scheduling of shoot", "shoot", client.ObjectKeyFromObject(opts.shoot))
+	result, err := (&shoot.Reconciler{
+		Client:          c,
+		Config:          cfg.Schedulers.Shoot,
+		GardenNamespace: v1beta1constants.GardenNamespace,
+	}).Simulate(ctx, log, opts.shoot)
+	if err != nil {
+		return fmt.Errorf("failed simulating scheduling of shoot: %w", err)
+	}
+
+	var data []byte
+	switch opts.output {
+	case outputJSON:
+		data, err = json.MarshalIndent(result, "", "  ")
+		data = append(data, '\n')
+	default:
+		data, err = yaml.Marshal(result)
+	}
+	if err != nil {
+		return fmt.Errorf("failed marshalling simulation result: %w", err)
+	}
+
+	_, err = out.Write(data)
+	return err
+}
+
 func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
@@ -24,6 +24,8 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	"github.com/gardener/gardener/cmd/utils"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 	schedulerv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
@@ -83,3 +85,55 @@ func (o *options) Validate() error {
 func (o *options) LogConfig() (string, string) {
 	return o.config.LogLevel, o.config.LogFormat
 }
+
+const (
+	outputYAML = "yaml"
+	outputJSON = "json"
+)
+
+type simulateOptions struct {
+	options
+	shootFile string
+	output    string
+	shoot     *gardencorev1beta1.Shoot
+}
+
+var _ utils.Options = &simulateOptions{}
+
+func (o *simulateOptions) addFlags(fs *pflag.FlagSet) {
+	o.options.addFlags(fs)
+	fs.StringVar(&o.shootFile, "shoot", o.shootFile, "Path to the manifest of the shoot whose scheduling shall be simulated.")
+	fs.StringVarP(&o.output, "output", "o", outputYAML, "Output format of the simulation result, one of 'yaml' or 'json'.")
+}
+
+func (o *simulateOptions) Complete() error {
+	if err := o.options.Complete(); err != nil {
+		return err
+	}
+
+	if len(o.shootFile) == 0 {
+		return fmt.Errorf("missing shoot file")
+	}
+
+	data, err := os.ReadFile(o.shootFile)
+	if err != nil {
+		return fmt.Errorf("error reading shoot file: %w", err)
+	}
+
+	o.shoot = &gardencorev1beta1.Shoot{}
+	if err = runtime.DecodeInto(kubernetes.GardenCodec.UniversalDecoder(gardencorev1beta1.SchemeGroupVersion), data, o.shoot); err != nil {
+		return fmt.Errorf("error decoding shoot: %w", err)
+	}
+	return nil
+}
+
+func (o *simulateOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if o.output != outputYAML && o.output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, must be one of %q or %q", o.output, outputYAML, outputJSON)
+	}
+	return nil
+}
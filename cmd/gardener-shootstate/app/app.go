@@ -0,0 +1,167 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/component-base/version/verflag"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cmdutils "github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+// Name is a const for the name of this component.
+const Name = "gardener-shootstate"
+
+// NewCommand creates a new cobra.Command for running gardener-shootstate.
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: "Export and import the state of shoots for recovering them in another garden",
+		Args:  cobra.NoArgs,
+	}
+
+	verflag.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(getExportCommand())
+	cmd.AddCommand(getImportCommand())
+	return cmd
+}
+
+func getExportCommand() *cobra.Command {
+	opts := &exportOptions{}
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a shoot together with its ShootState, SecretBinding and BackupEntry into an encrypted and signed archive",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, err := cmdutils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return runExport(cmd.Context(), log, opts)
+		},
+	}
+
+	opts.addFlags(exportCmd.Flags())
+	return exportCmd
+}
+
+func getImportCommand() *cobra.Command {
+	opts := &importOptions{}
+
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a shoot from an encrypted and signed archive so that its control plane is restored on the target seed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, err := cmdutils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return runImport(cmd.Context(), log, opts)
+		},
+	}
+
+	opts.addFlags(importCmd.Flags())
+	return importCmd
+}
+
+func runExport(ctx context.Context, log logr.Logger, opts *exportOptions) error {
+	c, err := newClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Exporting shoot", "shoot", client.ObjectKey{Namespace: opts.namespace, Name: opts.name})
+	archive, err := shootstate.Export(ctx, c, clock.RealClock{}, opts.namespace, opts.name)
+	if err != nil {
+		return err
+	}
+
+	data, err := shootstate.EncodeArchive(archive, opts.signingKey, opts.encryptionKey)
+	if err != nil {
+		return err
+	}
+
+	if err := writeNewFile(opts.output, data); err != nil {
+		return fmt.Errorf("failed writing archive: %w", err)
+	}
+
+	log.Info("Shoot exported successfully", "archive", opts.output)
+	return nil
+}
+
+func runImport(ctx context.Context, log logr.Logger, opts *importOptions) error {
+	c, err := newClient(opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Importing shoot", "shoot", client.ObjectKeyFromObject(&opts.archive.Shoot), "exportTime", opts.archive.ExportTime)
+	shoot, err := shootstate.Import(ctx, c, opts.archive, shootstate.ImportOptions{
+		Namespace: opts.namespace,
+		SeedName:  opts.seedName,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.seedName == "" {
+		log.Info("Shoot imported successfully, bind it to a seed to restore its control plane", "shoot", client.ObjectKeyFromObject(shoot))
+		return nil
+	}
+
+	log.Info("Shoot imported successfully, its control plane will be restored on the seed", "shoot", client.ObjectKeyFromObject(shoot), "seed", opts.seedName)
+	return nil
+}
+
+// writeNewFile writes the given data to a new file which is only accessible by the current user. It fails if the file
+// already exists to prevent accidentally overwriting a previous archive.
+func writeNewFile(name string, data []byte) error {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func newClient(kubeconfig string) (client.Client, error) {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating rest config: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client: %w", err)
+	}
+	return c, nil
+}
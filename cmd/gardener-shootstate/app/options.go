@@ -0,0 +1,201 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+type options struct {
+	kubeconfig string
+	logLevel   string
+	logFormat  string
+}
+
+func (o *options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", o.kubeconfig, "Path to the kubeconfig of the garden cluster. Defaults to the KUBECONFIG environment variable.")
+	fs.StringVar(&o.logLevel, "log-level", logger.InfoLevel, "The log level, one of "+fmt.Sprint(logger.AllLogLevels)+".")
+	fs.StringVar(&o.logFormat, "log-format", logger.FormatText, "The log format, one of "+fmt.Sprint(logger.AllLogFormats)+".")
+}
+
+func (o *options) Complete() error {
+	if len(o.kubeconfig) == 0 {
+		o.kubeconfig = os.Getenv("KUBECONFIG")
+	}
+	return nil
+}
+
+func (o *options) Validate() error {
+	if len(o.kubeconfig) == 0 {
+		return fmt.Errorf("missing kubeconfig")
+	}
+	return nil
+}
+
+func (o *options) LogConfig() (string, string) {
+	return o.logLevel, o.logFormat
+}
+
+type exportOptions struct {
+	options
+	namespace         string
+	name              string
+	signingKeyFile    string
+	encryptionKeyFile string
+	output            string
+	signingKey        ed25519.PrivateKey
+	encryptionKey     *ecdh.PublicKey
+}
+
+var _ utils.Options = &exportOptions{}
+
+func (o *exportOptions) addFlags(fs *pflag.FlagSet) {
+	o.options.addFlags(fs)
+	fs.StringVarP(&o.namespace, "namespace", "n", o.namespace, "Namespace of the shoot which shall be exported.")
+	fs.StringVar(&o.name, "name", o.name, "Name of the shoot which shall be exported.")
+	fs.StringVar(&o.signingKeyFile, "signing-key", o.signingKeyFile, "Path to the PEM encoded ed25519 private key used for signing the archive.")
+	fs.StringVar(&o.encryptionKeyFile, "encryption-key", o.encryptionKeyFile, "Path to the PEM encoded X25519 public key of the recipient used for encrypting the archive.")
+	fs.StringVarP(&o.output, "output", "o", o.output, "Path to the file the archive is written to. The file must not exist yet.")
+}
+
+func (o *exportOptions) Complete() error {
+	if err := o.options.Complete(); err != nil {
+		return err
+	}
+
+	if len(o.signingKeyFile) == 0 {
+		return fmt.Errorf("missing signing key file")
+	}
+
+	data, err := os.ReadFile(o.signingKeyFile)
+	if err != nil {
+		return fmt.Errorf("error reading signing key file: %w", err)
+	}
+
+	o.signingKey, err = shootstate.ParseSigningKey(data)
+	if err != nil {
+		return fmt.Errorf("error parsing signing key: %w", err)
+	}
+
+	if len(o.encryptionKeyFile) == 0 {
+		return fmt.Errorf("missing encryption key file")
+	}
+
+	data, err = os.ReadFile(o.encryptionKeyFile)
+	if err != nil {
+		return fmt.Errorf("error reading encryption key file: %w", err)
+	}
+
+	o.encryptionKey, err = shootstate.ParseEncryptionKey(data)
+	if err != nil {
+		return fmt.Errorf("error parsing encryption key: %w", err)
+	}
+	return nil
+}
+
+func (o *exportOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if len(o.namespace) == 0 || len(o.name) == 0 {
+		return fmt.Errorf("namespace and name of the shoot must be specified")
+	}
+	if len(o.output) == 0 {
+		return fmt.Errorf("missing output file")
+	}
+	return nil
+}
+
+type importOptions struct {
+	options
+	archiveFile         string
+	verificationKeyFile string
+	decryptionKeyFile   string
+	namespace           string
+	seedName            string
+	archive             *shootstate.Archive
+}
+
+var _ utils.Options = &importOptions{}
+
+func (o *importOptions) addFlags(fs *pflag.FlagSet) {
+	o.options.addFlags(fs)
+	fs.StringVar(&o.archiveFile, "archive", o.archiveFile, "Path to the archive which shall be imported.")
+	fs.StringVar(&o.verificationKeyFile, "verification-key", o.verificationKeyFile, "Path to the PEM encoded ed25519 public key used for verifying the signature of the archive.")
+	fs.StringVar(&o.decryptionKeyFile, "decryption-key", o.decryptionKeyFile, "Path to the PEM encoded X25519 private key used for decrypting the archive.")
+	fs.StringVarP(&o.namespace, "namespace", "n", o.namespace, "Namespace into which the shoot shall be imported. Defaults to the namespace of the exported shoot.")
+	fs.StringVar(&o.seedName, "seed-name", o.seedName, "Name of the seed the imported shoot shall be bound to. If not set, the shoot must be bound manually.")
+}
+
+func (o *importOptions) Complete() error {
+	if err := o.options.Complete(); err != nil {
+		return err
+	}
+
+	if len(o.verificationKeyFile) == 0 {
+		return fmt.Errorf("missing verification key file")
+	}
+	if len(o.decryptionKeyFile) == 0 {
+		return fmt.Errorf("missing decryption key file")
+	}
+	if len(o.archiveFile) == 0 {
+		return fmt.Errorf("missing archive file")
+	}
+
+	keyData, err := os.ReadFile(o.verificationKeyFile)
+	if err != nil {
+		return fmt.Errorf("error reading verification key file: %w", err)
+	}
+
+	verificationKey, err := shootstate.ParseVerificationKey(keyData)
+	if err != nil {
+		return fmt.Errorf("error parsing verification key: %w", err)
+	}
+
+	keyData, err = os.ReadFile(o.decryptionKeyFile)
+	if err != nil {
+		return fmt.Errorf("error reading decryption key file: %w", err)
+	}
+
+	decryptionKey, err := shootstate.ParseDecryptionKey(keyData)
+	if err != nil {
+		return fmt.Errorf("error parsing decryption key: %w", err)
+	}
+
+	data, err := os.ReadFile(o.archiveFile)
+	if err != nil {
+		return fmt.Errorf("error reading archive file: %w", err)
+	}
+
+	o.archive, err = shootstate.DecodeArchive(data, verificationKey, decryptionKey)
+	if err != nil {
+		return fmt.Errorf("error decoding archive: %w", err)
+	}
+	return nil
+}
+
+func (o *importOptions) Validate() error {
+	return o.options.Validate()
+}
@@ -85,6 +85,13 @@ import (
 // Name is a const for the name of this component.
 const Name = "gardenlet"
 
+const (
+	// flowExecutionsPath is the path of the debugging endpoint serving the most recent shoot flow executions.
+	flowExecutionsPath = "/debug/flows"
+	// flowExecutionHistorySize is the number of shoot flow executions served by the debugging endpoint.
+	flowExecutionHistorySize = 20
+)
+
 // NewCommand creates a new cobra.Command for running gardenlet.
 func NewCommand() *cobra.Command {
 	opts := &options{}
@@ -126,9 +133,20 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	var (
+		extraHandlers map[string]http.Handler
+		flowTracer    flow.Tracer
+	)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		flowExecutionHistory := flow.NewExecutionHistory(clock.RealClock{}, flowExecutionHistorySize)
+		flowTracer = flowExecutionHistory
+
+		extraHandlers = make(map[string]http.Handler, len(routes.ProfilingHandlers)+1)
+		for path, handler := range routes.ProfilingHandlers {
+			extraHandlers[path] = handler
+		}
+		extraHandlers[flowExecutionsPath] = flowExecutionHistory
+
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -221,6 +239,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 				config:                    cfg,
 				healthManager:             healthManager,
 				kubeconfigBootstrapResult: kubeconfigBootstrapResult,
+				flowTracer:                flowTracer,
 			},
 		},
 	}); err != nil {
@@ -237,6 +256,7 @@ type garden struct {
 	config                    *config.GardenletConfiguration
 	healthManager             gardenerhealthz.Manager
 	kubeconfigBootstrapResult *bootstrappers.KubeconfigBootstrapResult
+	flowTracer                flow.Tracer
 }
 
 func (g *garden) Start(ctx context.Context) error {
@@ -294,6 +314,7 @@ func (g *garden) Start(ctx context.Context) error {
 					&gardencorev1beta1.ControllerDeployment{}:   kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ControllerDeployment{}),
 					&gardencorev1beta1.ExposureClass{}:          kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ExposureClass{}),
 					&gardencorev1beta1.InternalSecret{}:         kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.InternalSecret{}),
+					&gardencorev1beta1.NamespacedCloudProfile{}: kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.NamespacedCloudProfile{}),
 					&gardencorev1beta1.Project{}:                kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.Project{}),
 					&gardencorev1beta1.SecretBinding{}:          kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.SecretBinding{}),
 					&gardencorev1beta1.ShootState{}:             kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ShootState{}),
@@ -435,6 +456,7 @@ func (g *garden) Start(ctx context.Context) error {
 		shootClientMap,
 		g.config,
 		g.healthManager,
+		g.flowTracer,
 	); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
@@ -17,6 +17,7 @@ package genericactuator
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -30,6 +31,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -52,7 +54,10 @@ func NewActuator(mgr manager.Manager, backupEntryDelegate BackupEntryDelegate) b
 
 // Reconcile reconciles the update of a BackupEntry.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
-	return a.deployEtcdBackupSecret(ctx, log, be)
+	if err := a.deployEtcdBackupSecret(ctx, log, be); err != nil {
+		return err
+	}
+	return a.storeShootStateSnapshot(ctx, log, be)
 }
 
 func (a *actuator) deployEtcdBackupSecret(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
@@ -98,6 +103,32 @@ func (a *actuator) deployEtcdBackupSecret(ctx context.Context, log logr.Logger,
 	return err
 }
 
+// storeShootStateSnapshot stores the ShootState snapshot handed over by gardenlet in the backup bucket if the delegate
+// supports it.
+func (a *actuator) storeShootStateSnapshot(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	snapshotDelegate, ok := a.backupEntryDelegate.(ShootStateSnapshotDelegate)
+	if !ok || strings.HasPrefix(be.Name, v1beta1constants.BackupSourcePrefix) {
+		return nil
+	}
+
+	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(be.Name)
+
+	secret := shootstate.NewSnapshotSecret(shootTechnicalID)
+	if err := a.client.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get ShootState snapshot secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	store, err := snapshotDelegate.ShootStateSnapshotStore(ctx, log, be)
+	if err != nil {
+		return err
+	}
+
+	return shootstate.StoreSnapshot(ctx, log, store, be.Spec.BucketName, path.Join(be.Name, shootstate.SnapshotPrefix), secret)
+}
+
 // Delete deletes the BackupEntry.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
 	if err := a.deleteEtcdBackupSecret(ctx, be.Name); err != nil {
@@ -16,6 +16,9 @@ package genericactuator_test
 
 import (
 	"context"
+	"path"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -34,6 +37,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockmanager "github.com/gardener/gardener/pkg/mock/controller-runtime/manager"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -145,6 +149,46 @@ var _ = Describe("Actuator", func() {
 			})
 		})
 
+		Context("ShootState snapshots", func() {
+			var (
+				store            *memorySnapshotStore
+				snapshotDelegate *shootStateSnapshotDelegate
+				snapshotSecret   *corev1.Secret
+			)
+
+			BeforeEach(func() {
+				store = &memorySnapshotStore{objects: map[string][]byte{}}
+				snapshotDelegate = &shootStateSnapshotDelegate{BackupEntryDelegate: backupEntryDelegate, store: store}
+
+				snapshotSecret = shootstate.NewSnapshotSecret(shootTechnicalID)
+				shootstate.SetSnapshot(snapshotSecret, []byte("snapshot"), time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC), shootstate.SnapshotRetention{Last: 1})
+
+				backupEntryDelegate.EXPECT().GetETCDSecretData(ctx, gomock.AssignableToTypeOf(logr.Logger{}), backupEntry, backupProviderSecretData).Return(etcdBackupSecretData, nil)
+			})
+
+			It("should store the handed over snapshot if the delegate supports it", func() {
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(seedNamespace, backupEntrySecret, snapshotSecret).Build()
+				mgr.EXPECT().GetClient().Return(fakeClient)
+
+				a = genericactuator.NewActuator(mgr, snapshotDelegate)
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(store.objects).To(Equal(map[string][]byte{
+					bucketName + "/" + backupEntry.Name + "/shootstate/20231010T120000Z.enc": []byte("snapshot"),
+				}))
+			})
+
+			It("should do nothing if no snapshot was handed over", func() {
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(seedNamespace, backupEntrySecret).Build()
+				mgr.EXPECT().GetClient().Return(fakeClient)
+
+				a = genericactuator.NewActuator(mgr, snapshotDelegate)
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(store.objects).To(BeEmpty())
+			})
+		})
+
 		Context("seed namespace does not exist", func() {
 			It("should not create secrets", func() {
 				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(backupEntrySecret).Build()
@@ -206,3 +250,36 @@ var _ = Describe("Actuator", func() {
 		})
 	})
 })
+
+type shootStateSnapshotDelegate struct {
+	genericactuator.BackupEntryDelegate
+	store shootstate.SnapshotStore
+}
+
+func (d *shootStateSnapshotDelegate) ShootStateSnapshotStore(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.BackupEntry) (shootstate.SnapshotStore, error) {
+	return d.store, nil
+}
+
+type memorySnapshotStore struct {
+	objects map[string][]byte
+}
+
+func (m *memorySnapshotStore) Put(_ context.Context, bucketName, key string, data []byte) error {
+	m.objects[path.Join(bucketName, key)] = data
+	return nil
+}
+
+func (m *memorySnapshotStore) List(_ context.Context, bucketName, prefix string) ([]string, error) {
+	var keys []string
+	for key := range m.objects {
+		if k, ok := strings.CutPrefix(key, bucketName+"/"); ok && strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memorySnapshotStore) Delete(_ context.Context, bucketName, key string) error {
+	delete(m.objects, path.Join(bucketName, key))
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/go-logr/logr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
 
 // BackupEntryDelegate preforms provider specific operation with BackupBucket resources.
@@ -29,3 +30,10 @@ type BackupEntryDelegate interface {
 	// GetETCDSecretData returns the updated secret data as per provider requirement.
 	GetETCDSecretData(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry, map[string][]byte) (map[string][]byte, error)
 }
+
+// ShootStateSnapshotDelegate can optionally be implemented by a BackupEntryDelegate in order to store the ShootState
+// snapshots handed over by gardenlet in the backup bucket.
+type ShootStateSnapshotDelegate interface {
+	// ShootStateSnapshotStore returns a store for the ShootState snapshots in the bucket of the given BackupEntry.
+	ShootStateSnapshotStore(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) (shootstate.SnapshotStore, error)
+}
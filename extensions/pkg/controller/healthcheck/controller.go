@@ -16,8 +16,10 @@ package healthcheck
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -26,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -70,6 +73,9 @@ type DefaultAddArgs struct {
 	Controller controller.Options
 	// HealthCheckConfig contains additional config for the health check controller
 	HealthCheckConfig extensionsconfig.HealthCheckConfig
+	// HealthCheckDefinitions are declarative health checks which are executed in addition to the health checks given
+	// during registration.
+	HealthCheckDefinitions []Definition
 }
 
 // RegisteredExtension is a registered extensions that the HealthCheck Controller watches.
@@ -95,11 +101,18 @@ type RegisteredExtension struct {
 // opts contain config for the healthcheck controller
 // custom predicates allow for fine-grained control which resources to watch
 // healthChecks defines the checks to execute mapped to the healthConditionTypes its contributing to (e.g checkDeployment in Seed -> ControlPlaneHealthy).
+// The declarative health check definitions of the opts are executed in addition.
 // register returns a runtime representation of the extension resource to register it with the controller-runtime
 func DefaultRegistration(ctx context.Context, extensionType string, kind schema.GroupVersionKind, getExtensionObjListFunc GetExtensionObjectListFunc, getExtensionObjFunc GetExtensionObjectFunc, mgr manager.Manager, opts DefaultAddArgs, customPredicates []predicate.Predicate, healthChecks []ConditionTypeToHealthCheck, conditionTypesToRemove sets.Set[gardencorev1beta1.ConditionType]) error {
 	predicates := append(DefaultPredicates(), customPredicates...)
 	opts.Controller.RecoverPanic = pointer.Bool(true)
 
+	definedHealthChecks, err := HealthChecksForDefinitions(kind.Kind, opts.HealthCheckDefinitions)
+	if err != nil {
+		return err
+	}
+	healthChecks = append(append([]ConditionTypeToHealthCheck{}, healthChecks...), definedHealthChecks...)
+
 	args := AddArgs{
 		ControllerOptions:       opts.Controller,
 		Predicates:              predicates,
@@ -160,6 +173,10 @@ func DefaultPredicates() []predicate.Predicate {
 // Add creates a new Reconciler and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Register(ctx context.Context, mgr manager.Manager, args AddArgs, actuator HealthCheckActuator) error {
+	if err := metrics.Registry.Register(healthCheckResults); err != nil && !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+		return fmt.Errorf("failed registering health check metrics: %w", err)
+	}
+
 	args.ControllerOptions.Reconciler = NewReconciler(mgr, actuator, *args.registeredExtension, args.SyncPeriod)
 	return add(ctx, mgr, args)
 }
@@ -0,0 +1,212 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+// ObjectKind is the kind of objects which are checked by a declarative health check definition.
+type ObjectKind string
+
+const (
+	// ObjectKindDeployment checks Deployments.
+	ObjectKindDeployment ObjectKind = "Deployment"
+	// ObjectKindStatefulSet checks StatefulSets.
+	ObjectKindStatefulSet ObjectKind = "StatefulSet"
+	// ObjectKindDaemonSet checks DaemonSets.
+	ObjectKindDaemonSet ObjectKind = "DaemonSet"
+	// ObjectKindManagedResource checks ManagedResources.
+	ObjectKindManagedResource ObjectKind = "ManagedResource"
+)
+
+type objectKindCheck struct {
+	newList func() client.ObjectList
+	check   func(runtime.Object) error
+}
+
+var objectKindChecks = map[ObjectKind]objectKindCheck{
+	ObjectKindDeployment: {
+		newList: func() client.ObjectList { return &appsv1.DeploymentList{} },
+		check:   func(obj runtime.Object) error { return health.CheckDeployment(obj.(*appsv1.Deployment)) },
+	},
+	ObjectKindStatefulSet: {
+		newList: func() client.ObjectList { return &appsv1.StatefulSetList{} },
+		check:   func(obj runtime.Object) error { return health.CheckStatefulSet(obj.(*appsv1.StatefulSet)) },
+	},
+	ObjectKindDaemonSet: {
+		newList: func() client.ObjectList { return &appsv1.DaemonSetList{} },
+		check:   func(obj runtime.Object) error { return health.CheckDaemonSet(obj.(*appsv1.DaemonSet)) },
+	},
+	ObjectKindManagedResource: {
+		newList: func() client.ObjectList { return &resourcesv1alpha1.ManagedResourceList{} },
+		check: func(obj runtime.Object) error {
+			return health.CheckManagedResource(obj.(*resourcesv1alpha1.ManagedResource))
+		},
+	},
+}
+
+// Definition declaratively describes a health check. All objects of the given kind in the shoot namespace of the seed
+// cluster which match the label selector are checked, and the result contributes to the given condition type.
+type Definition struct {
+	// ConditionType is the type of the condition the health check contributes to, e.g. ControlPlaneHealthy.
+	ConditionType string
+	// Kind is the kind of the objects which are checked.
+	Kind ObjectKind
+	// LabelSelector selects the objects which are checked. At least one object must match, otherwise the health check
+	// is unsuccessful.
+	LabelSelector map[string]string
+	// ExtensionKind optionally restricts the definition to extension resources of the given kind, e.g. ControlPlane.
+	// If it is empty, the definition is used for all registered extension resources.
+	ExtensionKind string
+	// PreCheckFunc is an optional function which checks whether the health check shall be performed.
+	PreCheckFunc PreCheckFunc
+	// ErrorCodeCheckFunc is an optional function which determines error codes for unsuccessful health checks.
+	ErrorCodeCheckFunc ErrorCodeCheckFunc
+}
+
+// HealthChecksForDefinitions converts the given declarative health check definitions to health checks for extension
+// resources of the given kind.
+func HealthChecksForDefinitions(extensionKind string, definitions []Definition) ([]ConditionTypeToHealthCheck, error) {
+	var healthChecks []ConditionTypeToHealthCheck
+
+	for _, definition := range definitions {
+		if definition.ExtensionKind != "" && definition.ExtensionKind != extensionKind {
+			continue
+		}
+
+		if definition.ConditionType == "" {
+			return nil, fmt.Errorf("health check definition for %s objects does not specify a condition type", definition.Kind)
+		}
+
+		healthCheck, err := NewSelectorHealthCheck(definition.Kind, definition.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		healthChecks = append(healthChecks, ConditionTypeToHealthCheck{
+			ConditionType:      definition.ConditionType,
+			PreCheckFunc:       definition.PreCheckFunc,
+			HealthCheck:        healthCheck,
+			ErrorCodeCheckFunc: definition.ErrorCodeCheckFunc,
+		})
+	}
+
+	return healthChecks, nil
+}
+
+// selectorHealthCheck checks all objects of a kind in the seed cluster which match a label selector.
+type selectorHealthCheck struct {
+	logger     logr.Logger
+	seedClient client.Client
+	kind       ObjectKind
+	selector   labels.Selector
+	check      objectKindCheck
+}
+
+// NewSelectorHealthCheck is a health check function to check all objects of the given kind in the shoot namespace of
+// the seed cluster which match the given labels.
+func NewSelectorHealthCheck(kind ObjectKind, matchLabels map[string]string) (HealthCheck, error) {
+	check, ok := objectKindChecks[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported object kind %q for health check definition", kind)
+	}
+	if len(matchLabels) == 0 {
+		return nil, fmt.Errorf("health check definition for %s objects does not specify a label selector", kind)
+	}
+
+	return &selectorHealthCheck{
+		kind:     kind,
+		selector: labels.SelectorFromSet(matchLabels),
+		check:    check,
+	}, nil
+}
+
+// InjectSeedClient injects the seed client
+func (h *selectorHealthCheck) InjectSeedClient(seedClient client.Client) {
+	h.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger
+func (h *selectorHealthCheck) SetLoggerSuffix(provider, extension string) {
+	h.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-%s-selector", provider, extension, strings.ToLower(string(h.kind))))
+}
+
+// DeepCopy clones the healthCheck struct by making a copy and returning the pointer to that new copy
+// Actually, it does not perform a *deep* copy.
+func (h *selectorHealthCheck) DeepCopy() HealthCheck {
+	shallowCopy := *h
+	return &shallowCopy
+}
+
+// Check executes the health check
+func (h *selectorHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*SingleCheckResult, error) {
+	list := h.check.newList()
+	if err := h.seedClient.List(ctx, list, client.InNamespace(request.Namespace), client.MatchingLabelsSelector{Selector: h.selector}); err != nil {
+		err := fmt.Errorf("failed to list %s objects with labels %q in namespace %q: %w", h.kind, h.selector.String(), request.Namespace, err)
+		h.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+
+	var (
+		found     bool
+		unhealthy []string
+	)
+
+	if err := meta.EachListItem(list, func(obj runtime.Object) error {
+		found = true
+		if err := h.check.check(obj); err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s %q is unhealthy: %v", strings.ToLower(string(h.kind)), obj.(client.Object).GetName(), err))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: fmt.Sprintf("no %s objects with labels %q found in namespace %q", h.kind, h.selector.String(), request.Namespace),
+		}, nil
+	}
+
+	if len(unhealthy) > 0 {
+		err := fmt.Errorf("%s", strings.Join(unhealthy, ", "))
+		h.logger.Error(err, "Health check failed")
+		return &SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: err.Error(),
+		}, nil
+	}
+
+	return &SingleCheckResult{
+		Status: gardencorev1beta1.ConditionTrue,
+	}, nil
+}
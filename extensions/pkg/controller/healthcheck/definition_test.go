@@ -0,0 +1,150 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Definition", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		request   = types.NamespacedName{Namespace: namespace, Name: "foo"}
+		labels    = map[string]string{"app": "foo"}
+
+		fakeClient client.Client
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	})
+
+	newDeployment := func(name string, available bool) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels, Generation: 1},
+			Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(1)},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           1,
+				UpdatedReplicas:    1,
+				AvailableReplicas:  1,
+			},
+		}
+		if available {
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: "True"}}
+		} else {
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: "False"}}
+		}
+		return deployment
+	}
+
+	Describe("#HealthChecksForDefinitions", func() {
+		It("should convert the definitions for the given extension kind", func() {
+			healthChecks, err := HealthChecksForDefinitions("ControlPlane", []Definition{
+				{ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy), Kind: ObjectKindDeployment, LabelSelector: labels, ExtensionKind: "ControlPlane"},
+				{ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy), Kind: ObjectKindManagedResource, LabelSelector: labels},
+				{ConditionType: string(gardencorev1beta1.ShootEveryNodeReady), Kind: ObjectKindDaemonSet, LabelSelector: labels, ExtensionKind: "Worker"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthChecks).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"ConditionType": Equal("ControlPlaneHealthy"), "HealthCheck": Not(BeNil())}),
+				MatchFields(IgnoreExtras, Fields{"ConditionType": Equal("SystemComponentsHealthy"), "HealthCheck": Not(BeNil())}),
+			))
+		})
+
+		It("should fail for definitions without condition type", func() {
+			_, err := HealthChecksForDefinitions("ControlPlane", []Definition{{Kind: ObjectKindDeployment, LabelSelector: labels}})
+			Expect(err).To(MatchError(ContainSubstring("does not specify a condition type")))
+		})
+
+		It("should fail for unsupported object kinds", func() {
+			_, err := HealthChecksForDefinitions("ControlPlane", []Definition{{ConditionType: "foo", Kind: "Pod", LabelSelector: labels}})
+			Expect(err).To(MatchError(ContainSubstring("unsupported object kind")))
+		})
+
+		It("should fail for definitions without label selector", func() {
+			_, err := HealthChecksForDefinitions("ControlPlane", []Definition{{ConditionType: "foo", Kind: ObjectKindStatefulSet}})
+			Expect(err).To(MatchError(ContainSubstring("does not specify a label selector")))
+		})
+	})
+
+	Describe("#NewSelectorHealthCheck", func() {
+		var healthCheck HealthCheck
+
+		BeforeEach(func() {
+			var err error
+			healthCheck, err = NewSelectorHealthCheck(ObjectKindDeployment, labels)
+			Expect(err).NotTo(HaveOccurred())
+			healthCheck.SetLoggerSuffix("test", "ControlPlane")
+			SeedClientInto(fakeClient, healthCheck)
+		})
+
+		It("should be unsuccessful if no objects match", func() {
+			deployment := newDeployment("other", true)
+			deployment.Labels = map[string]string{"app": "other"}
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			result, err := healthCheck.Check(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(result.Detail).To(Equal(`no Deployment objects with labels "app=foo" found in namespace "shoot--foo--bar"`))
+		})
+
+		It("should be successful if all matching objects are healthy", func() {
+			Expect(fakeClient.Create(ctx, newDeployment("foo", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newDeployment("bar", true))).To(Succeed())
+
+			result, err := healthCheck.Check(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
+
+		It("should be unsuccessful if a matching object is unhealthy", func() {
+			Expect(fakeClient.Create(ctx, newDeployment("foo", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newDeployment("bar", false))).To(Succeed())
+
+			result, err := healthCheck.Check(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(result.Detail).To(HavePrefix(`deployment "bar" is unhealthy: `))
+		})
+
+		It("should not check objects in other namespaces", func() {
+			deployment := newDeployment("foo", false)
+			deployment.Namespace = "other"
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+			Expect(fakeClient.Create(ctx, newDeployment("bar", true))).To(Succeed())
+
+			result, err := healthCheck.Check(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	resultSuccessful   = "successful"
+	resultProgressing  = "progressing"
+	resultUnsuccessful = "unsuccessful"
+	resultFailed       = "failed"
+)
+
+// healthCheckResults defines the gauge health_check_results which exposes the number of health checks per result for
+// each health condition type of the registered extension resources.
+var healthCheckResults = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "gardener_extensions",
+		Name:      "health_check_results",
+		Help:      "Number of health checks performed for extension resources by health condition type and result.",
+	},
+	[]string{
+		"kind",
+		"namespace",
+		"name",
+		"condition_type",
+		"result",
+	},
+)
+
+// recordHealthCheckResults sets the health check metrics of the given extension resource to the given results.
+func recordHealthCheckResults(kind, namespace, name string, results []Result) {
+	deleteHealthCheckResults(kind, namespace, name)
+
+	for _, result := range results {
+		for resultLabel, count := range map[string]int{
+			resultSuccessful:   result.SuccessfulChecks,
+			resultProgressing:  result.ProgressingChecks,
+			resultUnsuccessful: result.UnsuccessfulChecks,
+			resultFailed:       result.FailedChecks,
+		} {
+			healthCheckResults.WithLabelValues(kind, namespace, name, result.HealthConditionType, resultLabel).Set(float64(count))
+		}
+	}
+}
+
+// deleteHealthCheckResults removes the health check metrics of the given extension resource.
+func deleteHealthCheckResults(kind, namespace, name string) {
+	healthCheckResults.DeletePartialMatch(prometheus.Labels{"kind": kind, "namespace": namespace, "name": name})
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("metrics", func() {
+	AfterEach(func() {
+		healthCheckResults.Reset()
+	})
+
+	It("should record and delete the health check results of extension resources", func() {
+		recordHealthCheckResults("Worker", "shoot--foo--bar", "bar", []Result{{HealthConditionType: "EveryNodeReady", SuccessfulChecks: 2, UnsuccessfulChecks: 1}})
+		recordHealthCheckResults("Worker", "shoot--foo--baz", "baz", []Result{{HealthConditionType: "EveryNodeReady", SuccessfulChecks: 1}})
+
+		Expect(testutil.CollectAndCompare(healthCheckResults, strings.NewReader(`
+# HELP gardener_extensions_health_check_results Number of health checks performed for extension resources by health condition type and result.
+# TYPE gardener_extensions_health_check_results gauge
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="bar",namespace="shoot--foo--bar",result="failed"} 0
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="bar",namespace="shoot--foo--bar",result="progressing"} 0
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="bar",namespace="shoot--foo--bar",result="successful"} 2
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="bar",namespace="shoot--foo--bar",result="unsuccessful"} 1
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="baz",namespace="shoot--foo--baz",result="failed"} 0
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="baz",namespace="shoot--foo--baz",result="progressing"} 0
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="baz",namespace="shoot--foo--baz",result="successful"} 1
+gardener_extensions_health_check_results{condition_type="EveryNodeReady",kind="Worker",name="baz",namespace="shoot--foo--baz",result="unsuccessful"} 0
+`))).To(Succeed())
+
+		deleteHealthCheckResults("Worker", "shoot--foo--bar", "bar")
+		Expect(testutil.CollectAndCount(healthCheckResults)).To(Equal(4))
+	})
+})
@@ -79,6 +79,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.client.Get(ctx, request.NamespacedName, extension); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object was not found, requeueing")
+			deleteHealthCheckResults(r.registeredExtension.groupVersionKind.Kind, request.Namespace, request.Name)
 			return r.resultWithRequeue(), nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -91,6 +92,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if acc.GetDeletionTimestamp() != nil {
 		log.V(1).Info("Do not perform HealthCheck for extension resource, extension is being deleted")
+		deleteHealthCheckResults(r.registeredExtension.groupVersionKind.Kind, request.Namespace, request.Name)
 		return reconcile.Result{}, nil
 	}
 
@@ -130,6 +132,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 
 		log.V(1).Info("Do not perform HealthCheck for extension resource, Shoot is hibernated", "groupVersionKind", r.registeredExtension.groupVersionKind)
+		deleteHealthCheckResults(r.registeredExtension.groupVersionKind.Kind, request.Namespace, request.Name)
 		return reconcile.Result{}, nil
 	}
 
@@ -160,6 +163,8 @@ func (r *reconciler) performHealthCheck(ctx context.Context, log logr.Logger, re
 		return r.resultWithRequeue(), nil
 	}
 
+	recordHealthCheckResults(r.registeredExtension.groupVersionKind.Kind, request.Namespace, request.Name, *healthCheckResults)
+
 	conditions := make([]condition, 0, len(*healthCheckResults))
 	for _, healthCheckResult := range *healthCheckResults {
 		conditionBuilder, err := v1beta1helper.NewConditionBuilder(gardencorev1beta1.ConditionType(healthCheckResult.HealthConditionType))
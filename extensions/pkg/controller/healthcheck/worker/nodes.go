@@ -29,6 +29,8 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
 
 const (
@@ -167,5 +169,16 @@ func (h *DefaultHealthChecker) Check(ctx context.Context, request types.Namespac
 		}, nil
 	}
 
+	for _, node := range nodeList.Items {
+		if err := health.CheckOptionalNodeCondition(&node, nodeagentv1alpha1.NodeConditionTypeOperatingSystemConfigApplied); err != nil {
+			err := fmt.Errorf("operating system config could not be applied to node %q: %w", node.Name, err)
+			h.logger.Error(err, "Health check failed")
+			return &healthcheck.SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: err.Error(),
+			}, nil
+		}
+	}
+
 	return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
 }
@@ -151,6 +151,12 @@ func WorkerPoolHash(pool extensionsv1alpha1.WorkerPool, cluster *extensionscontr
 		data = append(data, "node-local-dns")
 	}
 
+	// The pod CIDRs of nodes are immutable, hence, nodes have to be replaced when a secondary IP family is added to the
+	// shoot networking to allocate pod CIDRs of both IP families.
+	if networking := cluster.Shoot.Spec.Networking; networking != nil && networking.SecondaryPods != nil {
+		data = append(data, *networking.SecondaryPods)
+	}
+
 	var result string
 	for _, v := range data {
 		result += utils.ComputeSHA256Hex([]byte(v))
@@ -195,6 +195,10 @@ var _ = Describe("Machines", func() {
 			It("when disabling node local dns via specification", func() {
 				c.Shoot.Spec.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: false}}
 			})
+
+			It("when configuring the primary networks", func() {
+				c.Shoot.Spec.Networking = &gardencorev1beta1.Networking{Pods: pointer.String("10.0.0.0/16")}
+			})
 		})
 
 		Context("hash value should change", func() {
@@ -275,6 +279,10 @@ var _ = Describe("Machines", func() {
 			It("when enabling node local dns via specification", func() {
 				c.Shoot.Spec.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}
 			})
+
+			It("when adding a secondary pod network", func() {
+				c.Shoot.Spec.Networking = &gardencorev1beta1.Networking{SecondaryPods: pointer.String("2001:db8:1::/48")}
+			})
 		})
 	})
 
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/admissionpluginsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/auditpolicy"
+	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/authenticationconfig"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/internaldomainsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/kubeconfigsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/namespacedeletion"
@@ -47,6 +48,15 @@ func AddToManager(
 		return fmt.Errorf("failed adding %s webhook handler: %w", auditpolicy.HandlerName, err)
 	}
 
+	if err := (&authenticationconfig.Handler{
+		Logger:    mgr.GetLogger().WithName("webhook").WithName(authenticationconfig.HandlerName),
+		APIReader: mgr.GetAPIReader(),
+		Client:    mgr.GetClient(),
+		Decoder:   admission.NewDecoder(mgr.GetScheme()),
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding %s webhook handler: %w", authenticationconfig.HandlerName, err)
+	}
+
 	if err := (&internaldomainsecret.Handler{
 		Logger:    mgr.GetLogger().WithName("webhook").WithName(internaldomainsecret.HandlerName),
 		APIReader: mgr.GetAPIReader(),
@@ -0,0 +1,209 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authenticationconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/versioning"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	admissionwebhook "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencoreinstall "github.com/gardener/gardener/pkg/apis/core/install"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const authenticationConfigurationConfigMapDataKey = "config.yaml"
+
+var (
+	internalDecoder runtime.Decoder
+
+	shootGK     = schema.GroupKind{Group: "core.gardener.cloud", Kind: "Shoot"}
+	configmapGK = schema.GroupKind{Group: "", Kind: "ConfigMap"}
+)
+
+func init() {
+	// create decoder that decodes Shoots from all known API versions to the internal version, but does not perform defaulting
+	gardencoreScheme := runtime.NewScheme()
+	gardencoreinstall.Install(gardencoreScheme)
+	codecFactory := serializer.NewCodecFactory(gardencoreScheme)
+	internalDecoder = versioning.NewCodec(nil, codecFactory.UniversalDeserializer(), runtime.UnsafeObjectConvertor(gardencoreScheme),
+		gardencoreScheme, gardencoreScheme, nil, runtime.DisabledGroupVersioner, runtime.InternalGroupVersioner, gardencoreScheme.Name())
+}
+
+// Handler validates structured authentication configurations.
+type Handler struct {
+	Logger    logr.Logger
+	APIReader client.Reader
+	Client    client.Reader
+	Decoder   *admission.Decoder
+}
+
+// Handle validates structured authentication configurations.
+func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	requestGK := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+
+	switch requestGK {
+	case shootGK:
+		return h.admitShoot(ctx, req)
+	case configmapGK:
+		return h.admitConfigMap(ctx, req)
+	}
+	return admissionwebhook.Allowed("resource is not *core.gardener.cloud/v1beta1.Shoot or *corev1.ConfigMap")
+}
+
+func (h *Handler) admitShoot(ctx context.Context, request admission.Request) admission.Response {
+	shoot := &gardencore.Shoot{}
+	if err := runtime.DecodeInto(internalDecoder, request.Object.Raw, shoot); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		// don't validate shoot if it's already marked for deletion, otherwise gardener-apiserver will deny the user's/
+		// controller's request, because we changed the spec
+		return admissionwebhook.Allowed("shoot is already marked for deletion")
+	}
+
+	var oldConfigMapName, newConfigMapName string
+
+	if request.Operation == admissionv1.Update {
+		oldShoot := &gardencore.Shoot{}
+		if err := runtime.DecodeInto(internalDecoder, request.OldObject.Raw, oldShoot); err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		// skip verification if spec wasn't changed
+		// this way we make sure, that users/gardenlet can always annotate/label the shoot if the spec doesn't change
+		if apiequality.Semantic.DeepEqual(oldShoot.Spec, shoot.Spec) {
+			return admissionwebhook.Allowed("shoot spec was not changed")
+		}
+
+		oldConfigMapName = gardencorehelper.GetShootAuthenticationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer)
+	}
+	newConfigMapName = gardencorehelper.GetShootAuthenticationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer)
+
+	if newConfigMapName == "" {
+		return admissionwebhook.Allowed("shoot resource is not specifying any authentication configuration")
+	}
+
+	// oldConfigMapName is empty for CREATE shoot requests that specify an authentication configuration reference
+	if oldConfigMapName == newConfigMapName {
+		return admissionwebhook.Allowed("authentication configuration configmap was not changed")
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := h.APIReader.Get(ctx, kubernetesutils.Key(shoot.Namespace, newConfigMapName), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Errored(http.StatusUnprocessableEntity, fmt.Errorf("referenced authentication configuration does not exist: namespace: %s, name: %s", shoot.Namespace, newConfigMapName))
+		}
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("could not retrieve config map: %s", err))
+	}
+
+	authenticationConfiguration, err := getAuthenticationConfiguration(configMap)
+	if err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, fmt.Errorf("error getting authentication configuration from ConfigMap %s/%s: %w", shoot.Namespace, newConfigMapName, err))
+	}
+
+	if err := validateAuthenticationConfiguration(authenticationConfiguration); err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, err)
+	}
+
+	return admissionwebhook.Allowed("referenced authentication configuration is valid")
+}
+
+func (h *Handler) admitConfigMap(ctx context.Context, request admission.Request) admission.Response {
+	var (
+		oldCm = &corev1.ConfigMap{}
+		cm    = &corev1.ConfigMap{}
+	)
+
+	if request.Operation != admissionv1.Update {
+		return admissionwebhook.Allowed("operation is not update")
+	}
+
+	if err := h.Decoder.Decode(request, cm); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	// lookup if configmap is referenced by any shoot in the same namespace
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := h.Client.List(ctx, shootList, client.InNamespace(request.Namespace)); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	var configMapIsReferenced bool
+	for _, shoot := range shootList.Items {
+		if v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer) == request.Name {
+			configMapIsReferenced = true
+			break
+		}
+	}
+
+	if !configMapIsReferenced {
+		return admissionwebhook.Allowed("configmap is not referenced by a Shoot")
+	}
+
+	authenticationConfiguration, err := getAuthenticationConfiguration(cm)
+	if err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, err)
+	}
+
+	if err = h.getOldObject(request, oldCm); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	oldAuthenticationConfiguration, ok := oldCm.Data[authenticationConfigurationConfigMapDataKey]
+	if ok && oldAuthenticationConfiguration == authenticationConfiguration {
+		return admissionwebhook.Allowed("authentication configuration not changed")
+	}
+
+	if err := validateAuthenticationConfiguration(authenticationConfiguration); err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, err)
+	}
+
+	return admissionwebhook.Allowed("configmap change is valid")
+}
+
+func (h *Handler) getOldObject(request admission.Request, oldObj runtime.Object) error {
+	if len(request.OldObject.Raw) != 0 {
+		return h.Decoder.DecodeRaw(request.OldObject, oldObj)
+	}
+	return fmt.Errorf("could not find old object")
+}
+
+func getAuthenticationConfiguration(cm *corev1.ConfigMap) (string, error) {
+	authenticationConfiguration, ok := cm.Data[authenticationConfigurationConfigMapDataKey]
+	if !ok {
+		return "", fmt.Errorf("missing '.data[config.yaml]' in authentication configuration configmap")
+	}
+	if len(authenticationConfiguration) == 0 {
+		return "", fmt.Errorf("empty authentication configuration. Provide non-empty authentication configuration")
+	}
+	return authenticationConfiguration, nil
+}
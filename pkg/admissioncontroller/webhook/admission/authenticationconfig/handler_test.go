@@ -0,0 +1,498 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authenticationconfig_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/authenticationconfig"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+var _ = Describe("handler", func() {
+	var (
+		ctx = context.TODO()
+		log logr.Logger
+
+		request admission.Request
+		decoder *admission.Decoder
+		handler *Handler
+
+		ctrl       *gomock.Controller
+		mockReader *mockclient.MockReader
+		fakeClient client.Client
+
+		statusCodeAllowed       int32 = http.StatusOK
+		statusCodeInvalid       int32 = http.StatusUnprocessableEntity
+		statusCodeInternalError int32 = http.StatusInternalServerError
+
+		testEncoder runtime.Encoder
+
+		cmName         = "fake-cm-name"
+		cmNameOther    = "fake-cm-name-other"
+		cmNamespace    = "fake-cm-namespace"
+		shootName      = "fake-shoot-name"
+		shootNamespace = cmNamespace
+
+		cm           *corev1.ConfigMap
+		shootv1beta1 *gardencorev1beta1.Shoot
+
+		validAuthenticationConfiguration = `
+---
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences:
+    - my-audience
+  claimValidationRules:
+  - claim: hd
+    requiredValue: example.com
+  claimMappings:
+    username:
+      claim: sub
+      prefix: "oidc:"
+    groups:
+      expression: claims.groups
+    extra:
+    - key: example.com/tenant
+      valueExpression: claims.tenant
+- issuer:
+    url: https://other-issuer.example.com
+    audiences:
+    - my-audience
+  claimMappings:
+    username:
+      expression: "'other:' + claims.sub"
+`
+		anotherValidAuthenticationConfiguration = `
+---
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences:
+    - my-audience
+  claimMappings:
+    username:
+      claim: email
+      prefix: ""
+`
+		unknownFieldAuthenticationConfiguration = `
+---
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences:
+    - my-audience
+    foo: bar
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+`
+		invalidAuthenticationConfiguration = `
+---
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: http://issuer.example.com
+    audiences:
+    - my-audience
+  claimMappings:
+    username:
+      claim: sub
+`
+	)
+
+	BeforeEach(func() {
+		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
+		testEncoder = &jsonserializer.Serializer{}
+
+		ctrl = gomock.NewController(GinkgoT())
+		mockReader = mockclient.NewMockReader(ctrl)
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		decoder = admission.NewDecoder(kubernetes.GardenScheme)
+
+		handler = &Handler{Logger: log, APIReader: mockReader, Client: fakeClient, Decoder: decoder}
+
+		request = admission.Request{}
+
+		shootv1beta1 = &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+				Kind:       "Shoot",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      shootName,
+				Namespace: shootNamespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: "1.29.0",
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						StructuredAuthentication: &gardencorev1beta1.StructuredAuthentication{
+							ConfigMapName: cmName,
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	test := func(op admissionv1.Operation, oldObj runtime.Object, obj runtime.Object, expectedAllowed bool, expectedStatusCode int32, expectedMsg string) {
+		request.Operation = op
+
+		if oldObj != nil {
+			objData, err := runtime.Encode(testEncoder, oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			request.OldObject.Raw = objData
+		}
+
+		if obj != nil {
+			objData, err := runtime.Encode(testEncoder, obj)
+			Expect(err).NotTo(HaveOccurred())
+			request.Object.Raw = objData
+		}
+
+		response := handler.Handle(ctx, request)
+		Expect(response).To(Not(BeNil()))
+		Expect(response.Allowed).To(Equal(expectedAllowed))
+		Expect(response.Result.Code).To(Equal(expectedStatusCode))
+		if expectedMsg != "" {
+			Expect(response.Result.Message).To(ContainSubstring(expectedMsg))
+		}
+		Expect(response.Patches).To(BeEmpty())
+	}
+
+	returnConfigMap := func(name, data string) {
+		mockReader.EXPECT().Get(gomock.Any(), kubernetesutils.Key(shootNamespace, name), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+			*cm = corev1.ConfigMap{Data: map[string]string{"config.yaml": data}}
+			return nil
+		})
+	}
+
+	Context("Shoots", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "core.gardener.cloud", Version: "v1beta1", Kind: "Shoot"}
+		})
+
+		Context("Allow", func() {
+			It("has no KubeAPIServer config", func() {
+				shootv1beta1.Spec.Kubernetes.KubeAPIServer = nil
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "shoot resource is not specifying any authentication configuration")
+			})
+
+			It("has no StructuredAuthentication", func() {
+				shootv1beta1.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication = nil
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "shoot resource is not specifying any authentication configuration")
+			})
+
+			It("references a valid authentication configuration (CREATE)", func() {
+				returnConfigMap(cmName, validAuthenticationConfiguration)
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "referenced authentication configuration is valid")
+			})
+
+			It("referenced configmap name was not changed (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Kubernetes.AllowPrivilegedContainers = pointer.Bool(false)
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "authentication configuration configmap was not changed")
+			})
+
+			It("referenced configmap name was changed (UPDATE)", func() {
+				returnConfigMap(cmNameOther, anotherValidAuthenticationConfiguration)
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication.ConfigMapName = cmNameOther
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "referenced authentication configuration is valid")
+			})
+
+			It("referenced configmap name was removed (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Kubernetes.KubeAPIServer = nil
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "shoot resource is not specifying any authentication configuration")
+			})
+
+			It("should not validate if already marked for deletion (UPDATE)", func() {
+				now := metav1.Now()
+				shootv1beta1.DeletionTimestamp = &now
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Labels = map[string]string{"foo": "bar"}
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "marked for deletion")
+			})
+
+			It("should not validate if spec wasn't changed (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Labels = map[string]string{"foo": "bar"}
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "shoot spec was not changed")
+			})
+		})
+
+		Context("Deny", func() {
+			It("references a configmap that does not exist", func() {
+				mockReader.EXPECT().Get(gomock.Any(), kubernetesutils.Key(shootNamespace, cmName), &corev1.ConfigMap{}).Return(apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, cmName))
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "referenced authentication configuration does not exist")
+			})
+
+			It("fails getting cm", func() {
+				mockReader.EXPECT().Get(gomock.Any(), kubernetesutils.Key(shootNamespace, cmName), &corev1.ConfigMap{}).Return(fmt.Errorf("fake"))
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInternalError, "could not retrieve config map: fake")
+			})
+
+			It("references configmap without a config.yaml key", func() {
+				mockReader.EXPECT().Get(gomock.Any(), kubernetesutils.Key(shootNamespace, cmName), &corev1.ConfigMap{}).Return(nil)
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "missing '.data[config.yaml]' in authentication configuration configmap")
+			})
+
+			It("references authentication configuration which breaks validation rules", func() {
+				returnConfigMap(cmName, invalidAuthenticationConfiguration)
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "url scheme must be https")
+			})
+
+			It("references authentication configuration with unknown fields", func() {
+				returnConfigMap(cmName, unknownFieldAuthenticationConfiguration)
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, `unknown field "foo"`)
+			})
+		})
+	})
+
+	Context("ConfigMaps", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"}
+			request.Name = cmName
+			request.Namespace = cmNamespace
+
+			cm = &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cmName,
+					Namespace: cmNamespace,
+				},
+				Data: map[string]string{
+					"config.yaml": validAuthenticationConfiguration,
+				},
+			}
+		})
+
+		Context("Allow", func() {
+			It("is not referenced by any shoot", func() {
+				shootInSameNamespaceButNotReferencing := shootv1beta1.DeepCopy()
+				shootInSameNamespaceButNotReferencing.Spec.Kubernetes.KubeAPIServer = nil
+				Expect(fakeClient.Create(ctx, shootInSameNamespaceButNotReferencing)).To(Succeed())
+				shootInDifferentNamespaceAndReferencing := shootv1beta1.DeepCopy()
+				shootInDifferentNamespaceAndReferencing.Namespace = shootNamespace + "other"
+				Expect(fakeClient.Create(ctx, shootInDifferentNamespaceAndReferencing)).To(Succeed())
+
+				test(admissionv1.Update, cm, cm, true, statusCodeAllowed, "configmap is not referenced by a Shoot")
+			})
+
+			It("is not an update", func() {
+				test(admissionv1.Create, nil, cm, true, statusCodeAllowed, "operation is not update")
+			})
+
+			It("did not change the authentication configuration", func() {
+				Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+				test(admissionv1.Update, cm, cm, true, statusCodeAllowed, "authentication configuration not changed")
+			})
+
+			It("changed the authentication configuration to something valid", func() {
+				Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+				newCm := cm.DeepCopy()
+				newCm.Data["config.yaml"] = anotherValidAuthenticationConfiguration
+				test(admissionv1.Update, cm, newCm, true, statusCodeAllowed, "configmap change is valid")
+			})
+		})
+
+		Context("Deny", func() {
+			BeforeEach(func() {
+				Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+			})
+
+			It("has no data key", func() {
+				newCm := cm.DeepCopy()
+				newCm.Data = nil
+				test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "missing '.data[config.yaml]' in authentication configuration configmap")
+			})
+
+			It("has empty authentication configuration", func() {
+				newCm := cm.DeepCopy()
+				newCm.Data["config.yaml"] = ""
+				test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "empty authentication configuration")
+			})
+
+			DescribeTable("holds an invalid authentication configuration",
+				func(config, expectedMsg string) {
+					newCm := cm.DeepCopy()
+					newCm.Data["config.yaml"] = config
+					test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, expectedMsg)
+				},
+
+				Entry("wrong kind", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: EncryptionConfiguration
+`, "unsupported authentication configuration type"),
+				Entry("no jwt authenticators", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+`, "jwt: Required value"),
+				Entry("duplicate issuers and missing audiences", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo]
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+- issuer:
+    url: https://issuer.example.com
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+`, "jwt[1].issuer.url: Duplicate value"),
+				Entry("multiple audiences", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo, bar]
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+`, "jwt[0].issuer.audiences: Too many"),
+				Entry("invalid certificate authority", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo]
+    certificateAuthority: foo
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+`, "certificateAuthority is not a valid PEM-encoded certificate"),
+				Entry("invalid claim validation rule", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo]
+  claimValidationRules:
+  - claim: hd
+    expression: claims.hd == 'example.com'
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+`, "claim and expression can't both be set"),
+				Entry("missing username mapping", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo]
+  claimMappings:
+    groups:
+      claim: groups
+      prefix: ""
+`, "jwt[0].claimMappings.username: Required value"),
+				Entry("prefix set for expression", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo]
+  claimMappings:
+    username:
+      expression: claims.sub
+      prefix: "oidc:"
+`, "prefix can't be set when expression is set"),
+				Entry("invalid extra mapping", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo]
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+    extra:
+    - key: Tenant
+`, "key must be a domain-prefix path"),
+				Entry("missing user validation rule expression", `
+apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthenticationConfiguration
+jwt:
+- issuer:
+    url: https://issuer.example.com
+    audiences: [foo]
+  claimMappings:
+    username:
+      claim: sub
+      prefix: ""
+  userValidationRules:
+  - message: foo
+`, "jwt[0].userValidationRules[0].expression: Required value"),
+			)
+		})
+	})
+})
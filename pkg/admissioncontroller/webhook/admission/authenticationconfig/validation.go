@@ -0,0 +1,279 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authenticationconfig
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// The following types mirror the `apiserver.config.k8s.io/v1alpha1` `AuthenticationConfiguration` API of
+// kube-apiserver v1.29. They are not shipped with the vendored k8s.io/apiserver version yet, hence they are declared
+// here. CEL expressions are not compiled by this webhook, they are validated by kube-apiserver itself.
+
+const (
+	authenticationConfigurationAPIVersion = "apiserver.config.k8s.io/v1alpha1"
+	authenticationConfigurationKind       = "AuthenticationConfiguration"
+)
+
+type authenticationConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	JWT []jwtAuthenticator `json:"jwt"`
+}
+
+type jwtAuthenticator struct {
+	Issuer               issuer                `json:"issuer"`
+	ClaimValidationRules []claimValidationRule `json:"claimValidationRules,omitempty"`
+	ClaimMappings        claimMappings         `json:"claimMappings"`
+	UserValidationRules  []userValidationRule  `json:"userValidationRules,omitempty"`
+}
+
+type issuer struct {
+	URL                  string   `json:"url"`
+	Audiences            []string `json:"audiences"`
+	CertificateAuthority string   `json:"certificateAuthority,omitempty"`
+}
+
+type claimValidationRule struct {
+	Claim         string `json:"claim,omitempty"`
+	RequiredValue string `json:"requiredValue,omitempty"`
+	Expression    string `json:"expression,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+type claimMappings struct {
+	Username prefixedClaimOrExpression `json:"username"`
+	Groups   prefixedClaimOrExpression `json:"groups,omitempty"`
+	UID      claimOrExpression         `json:"uid,omitempty"`
+	Extra    []extraMapping            `json:"extra,omitempty"`
+}
+
+type prefixedClaimOrExpression struct {
+	Claim      string  `json:"claim,omitempty"`
+	Prefix     *string `json:"prefix,omitempty"`
+	Expression string  `json:"expression,omitempty"`
+}
+
+type claimOrExpression struct {
+	Claim      string `json:"claim,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+type extraMapping struct {
+	Key             string `json:"key"`
+	ValueExpression string `json:"valueExpression"`
+}
+
+type userValidationRule struct {
+	Expression string `json:"expression"`
+	Message    string `json:"message,omitempty"`
+}
+
+func validateAuthenticationConfiguration(data string) error {
+	config := &authenticationConfiguration{}
+	if err := yaml.UnmarshalStrict([]byte(data), config); err != nil {
+		return fmt.Errorf("failed to decode the provided authentication configuration: %w", err)
+	}
+
+	if config.APIVersion != authenticationConfigurationAPIVersion || config.Kind != authenticationConfigurationKind {
+		return fmt.Errorf("unsupported authentication configuration type %s, Kind=%s, must be %s, Kind=%s", config.APIVersion, config.Kind, authenticationConfigurationAPIVersion, authenticationConfigurationKind)
+	}
+
+	if errList := validateJWTAuthenticators(config.JWT, field.NewPath("jwt")); len(errList) != 0 {
+		return fmt.Errorf("provided invalid authentication configuration: %v", errList)
+	}
+
+	return nil
+}
+
+func validateJWTAuthenticators(authenticators []jwtAuthenticator, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(authenticators) == 0 {
+		return append(allErrs, field.Required(fldPath, "at least one jwt authenticator is required"))
+	}
+
+	issuerURLs := sets.New[string]()
+	for i, authenticator := range authenticators {
+		idxPath := fldPath.Index(i)
+
+		if issuerURLs.Has(authenticator.Issuer.URL) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("issuer", "url"), authenticator.Issuer.URL))
+		}
+		issuerURLs.Insert(authenticator.Issuer.URL)
+
+		allErrs = append(allErrs, validateIssuer(authenticator.Issuer, idxPath.Child("issuer"))...)
+		allErrs = append(allErrs, validateClaimValidationRules(authenticator.ClaimValidationRules, idxPath.Child("claimValidationRules"))...)
+		allErrs = append(allErrs, validateClaimMappings(authenticator.ClaimMappings, idxPath.Child("claimMappings"))...)
+
+		for j, rule := range authenticator.UserValidationRules {
+			if len(rule.Expression) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("userValidationRules").Index(j).Child("expression"), "expression is required"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateIssuer(issuer issuer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(issuer.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "issuer url is required"))
+	} else if u, err := url.Parse(issuer.URL); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), issuer.URL, err.Error()))
+	} else {
+		if u.Scheme != "https" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), issuer.URL, "url scheme must be https"))
+		}
+		if u.User != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), issuer.URL, "url must not contain a username or password"))
+		}
+		if len(u.RawQuery) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), issuer.URL, "url must not contain a query"))
+		}
+		if len(u.Fragment) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), issuer.URL, "url must not contain a fragment"))
+		}
+	}
+
+	switch {
+	case len(issuer.Audiences) == 0:
+		allErrs = append(allErrs, field.Required(fldPath.Child("audiences"), "at least one audience is required"))
+	case len(issuer.Audiences) > 1:
+		// kube-apiserver v1.29 supports exactly one audience per issuer.
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("audiences"), len(issuer.Audiences), 1))
+	case len(issuer.Audiences[0]) == 0:
+		allErrs = append(allErrs, field.Required(fldPath.Child("audiences").Index(0), "audience must not be empty"))
+	}
+
+	if len(issuer.CertificateAuthority) > 0 {
+		if _, err := utils.DecodeCertificate([]byte(issuer.CertificateAuthority)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateAuthority"), "<omitted>", "certificateAuthority is not a valid PEM-encoded certificate"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateClaimValidationRules(rules []claimValidationRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	claims := sets.New[string]()
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		switch {
+		case len(rule.Claim) > 0 && len(rule.Expression) > 0:
+			allErrs = append(allErrs, field.Invalid(idxPath, rule.Claim, "claim and expression can't both be set"))
+		case len(rule.Claim) == 0 && len(rule.Expression) == 0:
+			allErrs = append(allErrs, field.Required(idxPath, "claim or expression is required"))
+		case len(rule.Claim) > 0:
+			if len(rule.Message) > 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("message"), rule.Message, "message can't be set when claim is set"))
+			}
+			if claims.Has(rule.Claim) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("claim"), rule.Claim))
+			}
+			claims.Insert(rule.Claim)
+		default:
+			if len(rule.RequiredValue) > 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("requiredValue"), rule.RequiredValue, "requiredValue can't be set when expression is set"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateClaimMappings(mappings claimMappings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(mappings.Username.Claim) == 0 && len(mappings.Username.Expression) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("username"), "claim or expression is required"))
+	}
+	allErrs = append(allErrs, validatePrefixedClaimOrExpression(mappings.Username, fldPath.Child("username"))...)
+	allErrs = append(allErrs, validatePrefixedClaimOrExpression(mappings.Groups, fldPath.Child("groups"))...)
+
+	if len(mappings.UID.Claim) > 0 && len(mappings.UID.Expression) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("uid"), mappings.UID.Claim, "claim and expression can't both be set"))
+	}
+
+	keys := sets.New[string]()
+	for i, mapping := range mappings.Extra {
+		idxPath := fldPath.Child("extra").Index(i)
+
+		if len(mapping.Key) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("key"), "key is required"))
+		} else {
+			allErrs = append(allErrs, validateExtraMappingKey(mapping.Key, idxPath.Child("key"))...)
+			if keys.Has(mapping.Key) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("key"), mapping.Key))
+			}
+			keys.Insert(mapping.Key)
+		}
+
+		if len(mapping.ValueExpression) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("valueExpression"), "valueExpression is required"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePrefixedClaimOrExpression(mapping prefixedClaimOrExpression, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case len(mapping.Claim) > 0 && len(mapping.Expression) > 0:
+		allErrs = append(allErrs, field.Invalid(fldPath, mapping.Claim, "claim and expression can't both be set"))
+	case len(mapping.Claim) > 0:
+		if mapping.Prefix == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("prefix"), "prefix is required when claim is set. It can be set to an empty string to disable prefixing"))
+		}
+	case len(mapping.Expression) > 0:
+		if mapping.Prefix != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("prefix"), *mapping.Prefix, "prefix can't be set when expression is set"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateExtraMappingKey(key string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if key != strings.ToLower(key) {
+		allErrs = append(allErrs, field.Invalid(fldPath, key, "key must be lowercase"))
+	}
+
+	domain, _, ok := strings.Cut(key, "/")
+	if !ok || len(validation.IsDNS1123Subdomain(domain)) != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, key, "key must be a domain-prefix path (e.g. example.org/foo)"))
+	}
+
+	return allErrs
+}
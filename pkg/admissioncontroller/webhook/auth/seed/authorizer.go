@@ -76,6 +76,7 @@ var (
 	leaseResource                     = coordinationv1.Resource("leases")
 	managedSeedResource               = seedmanagementv1alpha1.Resource("managedseeds")
 	namespaceResource                 = corev1.Resource("namespaces")
+	namespacedCloudProfileResource    = gardencorev1beta1.Resource("namespacedcloudprofiles")
 	projectResource                   = gardencorev1beta1.Resource("projects")
 	secretBindingResource             = gardencorev1beta1.Resource("secretbindings")
 	secretResource                    = corev1.Resource("secrets")
@@ -178,6 +179,8 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 			)
 		case namespaceResource:
 			return a.authorizeRead(requestLog, seedName, graph.VertexTypeNamespace, attrs)
+		case namespacedCloudProfileResource:
+			return a.authorizeRead(requestLog, seedName, graph.VertexTypeNamespacedCloudProfile, attrs)
 		case projectResource:
 			return a.authorizeRead(requestLog, seedName, graph.VertexTypeProject, attrs)
 		case secretBindingResource:
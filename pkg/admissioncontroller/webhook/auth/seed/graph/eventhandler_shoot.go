@@ -52,7 +52,12 @@ func (g *graph) setupShootWatch(_ context.Context, informer cache.Informer) erro
 				!apiequality.Semantic.DeepEqual(oldShoot.Status.SeedName, newShoot.Status.SeedName) ||
 				!apiequality.Semantic.DeepEqual(oldShoot.Spec.SecretBindingName, newShoot.Spec.SecretBindingName) ||
 				!apiequality.Semantic.DeepEqual(oldShoot.Spec.CloudProfileName, newShoot.Spec.CloudProfileName) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.CloudProfile, newShoot.Spec.CloudProfile) ||
 				v1beta1helper.GetShootAuditPolicyConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuditPolicyConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
+				v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
+				v1beta1helper.GetShootAuthorizationWebhookKubeconfigSecretName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthorizationWebhookKubeconfigSecretName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
+				v1beta1helper.GetShootAuthorizationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthorizationConfigurationConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
+				!apiequality.Semantic.DeepEqual(v1beta1helper.GetShootAuthorizationConfigurationKubeconfigSecretNames(oldShoot.Spec.Kubernetes.KubeAPIServer), v1beta1helper.GetShootAuthorizationConfigurationKubeconfigSecretNames(newShoot.Spec.Kubernetes.KubeAPIServer)) ||
 				!v1beta1helper.ShootDNSProviderSecretNamesEqual(oldShoot.Spec.DNS, newShoot.Spec.DNS) ||
 				!v1beta1helper.ShootResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources) {
 				g.handleShootCreateOrUpdate(newShoot)
@@ -86,6 +91,7 @@ func (g *graph) handleShootCreateOrUpdate(shoot *gardencorev1beta1.Shoot) {
 	g.deleteAllIncomingEdges(VertexTypeInternalSecret, VertexTypeShoot, shoot.Namespace, shoot.Name)
 	g.deleteAllIncomingEdges(VertexTypeConfigMap, VertexTypeShoot, shoot.Namespace, shoot.Name)
 	g.deleteAllIncomingEdges(VertexTypeNamespace, VertexTypeShoot, shoot.Namespace, shoot.Name)
+	g.deleteAllIncomingEdges(VertexTypeNamespacedCloudProfile, VertexTypeShoot, shoot.Namespace, shoot.Name)
 	g.deleteAllIncomingEdges(VertexTypeSecret, VertexTypeShoot, shoot.Namespace, shoot.Name)
 	g.deleteAllIncomingEdges(VertexTypeSecretBinding, VertexTypeShoot, shoot.Namespace, shoot.Name)
 	g.deleteAllIncomingEdges(VertexTypeShootState, VertexTypeShoot, shoot.Namespace, shoot.Name)
@@ -104,6 +110,11 @@ func (g *graph) handleShootCreateOrUpdate(shoot *gardencorev1beta1.Shoot) {
 	g.addEdge(namespaceVertex, shootVertex)
 	g.addEdge(cloudProfileVertex, shootVertex)
 
+	if shoot.Spec.CloudProfile != nil && shoot.Spec.CloudProfile.Kind == gardencorev1beta1.CloudProfileReferenceKindNamespacedCloudProfile {
+		namespacedCloudProfileVertex := g.getOrCreateVertex(VertexTypeNamespacedCloudProfile, shoot.Namespace, shoot.Spec.CloudProfile.Name)
+		g.addEdge(namespacedCloudProfileVertex, shootVertex)
+	}
+
 	if shoot.Spec.SeedName != nil {
 		seedVertex := g.getOrCreateVertex(VertexTypeSeed, "", *shoot.Spec.SeedName)
 		g.addEdge(shootVertex, seedVertex)
@@ -127,6 +138,26 @@ func (g *graph) handleShootCreateOrUpdate(shoot *gardencorev1beta1.Shoot) {
 		g.addEdge(configMapVertex, shootVertex)
 	}
 
+	if configMapName := v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" {
+		configMapVertex := g.getOrCreateVertex(VertexTypeConfigMap, shoot.Namespace, configMapName)
+		g.addEdge(configMapVertex, shootVertex)
+	}
+
+	if secretName := v1beta1helper.GetShootAuthorizationWebhookKubeconfigSecretName(shoot.Spec.Kubernetes.KubeAPIServer); secretName != "" {
+		secretVertex := g.getOrCreateVertex(VertexTypeSecret, shoot.Namespace, secretName)
+		g.addEdge(secretVertex, shootVertex)
+	}
+
+	if configMapName := v1beta1helper.GetShootAuthorizationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" {
+		configMapVertex := g.getOrCreateVertex(VertexTypeConfigMap, shoot.Namespace, configMapName)
+		g.addEdge(configMapVertex, shootVertex)
+	}
+
+	for _, secretName := range v1beta1helper.GetShootAuthorizationConfigurationKubeconfigSecretNames(shoot.Spec.Kubernetes.KubeAPIServer) {
+		secretVertex := g.getOrCreateVertex(VertexTypeSecret, shoot.Namespace, secretName)
+		g.addEdge(secretVertex, shootVertex)
+	}
+
 	if shoot.Spec.DNS != nil {
 		for _, provider := range shoot.Spec.DNS.Providers {
 			if provider.SecretName != nil {
@@ -464,6 +464,25 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasPathFrom(VertexTypeShoot, shoot1.Namespace, shoot1.Name, VertexTypeSeed, "", seed1.Name)).To(BeTrue())
 		Expect(graph.HasPathFrom(VertexTypeShootState, shoot1.Namespace, shoot1.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
 
+		By("Update (namespaced cloud profile)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "bar"}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(18))
+		Expect(graph.graph.Edges().Len()).To(Equal(17))
+		Expect(graph.HasPathFrom(VertexTypeCloudProfile, "", shoot1.Spec.CloudProfileName, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeNamespacedCloudProfile, shoot1.Namespace, shoot1.Spec.CloudProfile.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeNamespacedCloudProfile, shoot1.Namespace, shoot1.Spec.CloudProfile.Name, VertexTypeSeed, "", seed1.Name)).To(BeTrue())
+
+		By("Update (no namespaced cloud profile)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.CloudProfile = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(17))
+		Expect(graph.graph.Edges().Len()).To(Equal(16))
+		Expect(graph.HasPathFrom(VertexTypeCloudProfile, "", shoot1.Spec.CloudProfileName, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeNamespacedCloudProfile, shoot1.Namespace, shoot1Copy.Spec.CloudProfile.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
 		By("Update (secret binding name)")
 		shoot1Copy = shoot1.DeepCopy()
 		shoot1.Spec.SecretBindingName = pointer.String("bar")
@@ -511,6 +530,56 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasPathFrom(VertexTypeShoot, shoot1.Namespace, shoot1.Name, VertexTypeSeed, "", seed1.Name)).To(BeTrue())
 		Expect(graph.HasPathFrom(VertexTypeShootState, shoot1.Namespace, shoot1.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
 
+		By("Update (structured authentication config map name)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{StructuredAuthentication: &gardencorev1beta1.StructuredAuthentication{ConfigMapName: "authn-config"}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(17))
+		Expect(graph.graph.Edges().Len()).To(Equal(16))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "authn-config", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(16))
+		Expect(graph.graph.Edges().Len()).To(Equal(15))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "authn-config", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
+		By("Update (authorization webhook kubeconfig secret name)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{Authorization: &gardencorev1beta1.Authorization{Webhook: &gardencorev1beta1.AuthorizationWebhook{KubeconfigSecretName: "authz-webhook"}}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(17))
+		Expect(graph.graph.Edges().Len()).To(Equal(16))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "authz-webhook", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(16))
+		Expect(graph.graph.Edges().Len()).To(Equal(15))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "authz-webhook", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
+		By("Update (structured authorization config map and kubeconfig secret names)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{StructuredAuthorization: &gardencorev1beta1.StructuredAuthorization{
+			ConfigMapName: "authz-config",
+			Kubeconfigs:   []gardencorev1beta1.AuthorizerKubeconfigReference{{AuthorizerName: "authz", SecretName: "authz-kubeconfig"}},
+		}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(18))
+		Expect(graph.graph.Edges().Len()).To(Equal(17))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "authz-config", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "authz-kubeconfig", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(16))
+		Expect(graph.graph.Edges().Len()).To(Equal(15))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "authz-config", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "authz-kubeconfig", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
 		By("Update (dns provider secrets)")
 		shoot1Copy = shoot1.DeepCopy()
 		shoot1.Spec.DNS = nil
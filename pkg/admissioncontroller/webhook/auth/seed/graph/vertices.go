@@ -48,6 +48,8 @@ const (
 	VertexTypeManagedSeed
 	// VertexTypeNamespace is a constant for a 'Namespace' vertex.
 	VertexTypeNamespace
+	// VertexTypeNamespacedCloudProfile is a constant for a 'NamespacedCloudProfile' vertex.
+	VertexTypeNamespacedCloudProfile
 	// VertexTypeProject is a constant for a 'Project' vertex.
 	VertexTypeProject
 	// VertexTypeSecret is a constant for a 'Secret' vertex.
@@ -79,6 +81,7 @@ var vertexTypes = map[VertexType]string{
 	VertexTypeLease:                     "Lease",
 	VertexTypeManagedSeed:               "ManagedSeed",
 	VertexTypeNamespace:                 "Namespace",
+	VertexTypeNamespacedCloudProfile:    "NamespacedCloudProfile",
 	VertexTypeProject:                   "Project",
 	VertexTypeSecret:                    "Secret",
 	VertexTypeSecretBinding:             "SecretBinding",
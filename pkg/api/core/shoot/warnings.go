@@ -43,6 +43,10 @@ func GetWarnings(_ context.Context, shoot, oldShoot *core.Shoot, credentialsRota
 		warnings = append(warnings, getWarningsForDueCredentialsRotations(shoot, credentialsRotationInterval)...)
 		warnings = append(warnings, getWarningsForIncompleteCredentialsRotation(shoot, credentialsRotationInterval)...)
 
+		if warning := getWarningForPendingEncryptionProviderChange(shoot); warning != "" {
+			warnings = append(warnings, warning)
+		}
+
 		// Errors are ignored here because we cannot do anything meaningful with them - variables will default to `false`.
 		k8sLess125, _ := versionutils.CheckVersionMeetsConstraint(shoot.Spec.Kubernetes.Version, "< 1.25")
 		if k8sLess125 {
@@ -161,3 +165,23 @@ func getWarningsForPSPAdmissionPlugin(shoot *core.Shoot) string {
 
 	return "you should consider migrating to PodSecurity, see https://github.com/gardener/gardener/blob/master/docs/usage/pod-security.md#migrating-from-podsecuritypolicys-to-podsecurity-admission-controller for details"
 }
+
+func getWarningForPendingEncryptionProviderChange(shoot *core.Shoot) string {
+	var (
+		providerType       = core.EncryptionProviderTypeAESCBC
+		activeProviderType = core.EncryptionProviderTypeAESCBC
+	)
+
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.EncryptionConfig != nil && kubeAPIServer.EncryptionConfig.Provider != nil {
+		providerType = kubeAPIServer.EncryptionConfig.Provider.Type
+	}
+	if shoot.Status.EncryptionProvider != nil {
+		activeProviderType = shoot.Status.EncryptionProvider.Type
+	}
+
+	if providerType == activeProviderType {
+		return ""
+	}
+
+	return fmt.Sprintf("the encryption provider %q only becomes active with the next rotation of the ETCD encryption key, see https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for details", providerType)
+}
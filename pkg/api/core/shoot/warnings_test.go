@@ -330,6 +330,35 @@ var _ = Describe("Warnings", func() {
 			})
 		})
 
+		Context("encryption provider", func() {
+			BeforeEach(func() {
+				shoot.CreationTimestamp = metav1.Now()
+			})
+
+			It("should return a warning when the configured encryption provider is not yet active", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+					EncryptionConfig: &core.EncryptionConfig{
+						Provider: &core.EncryptionProvider{Type: "kms-foo"},
+					},
+				}
+
+				Expect(GetWarnings(ctx, shoot, shoot, credentialsRotationInterval)).To(ContainElement(
+					Equal("the encryption provider \"kms-foo\" only becomes active with the next rotation of the ETCD encryption key, see https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for details"),
+				))
+			})
+
+			It("should not return a warning when the configured encryption provider is active", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+					EncryptionConfig: &core.EncryptionConfig{
+						Provider: &core.EncryptionProvider{Type: "kms-foo"},
+					},
+				}
+				shoot.Status.EncryptionProvider = &core.EncryptionProvider{Type: "kms-foo"}
+
+				Expect(GetWarnings(ctx, shoot, shoot, credentialsRotationInterval)).To(BeEmpty())
+			})
+		})
+
 		It("should return a warning when podEvictionTimeout is set", func() {
 			shoot.Spec.Kubernetes.KubeControllerManager = &core.KubeControllerManagerConfig{
 				PodEvictionTimeout: &metav1.Duration{Duration: 2 * time.Minute},
@@ -16,6 +16,7 @@ package helper
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -346,6 +347,47 @@ func GetShootAuditPolicyConfigMapRef(apiServerConfig *core.KubeAPIServerConfig)
 	return nil
 }
 
+// GetShootAuthenticationConfigurationConfigMapName returns the Shoot's ConfigMap name for the structured authentication
+// configuration.
+func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *core.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.StructuredAuthentication != nil {
+		return apiServerConfig.StructuredAuthentication.ConfigMapName
+	}
+	return ""
+}
+
+// GetShootAuthorizationWebhookKubeconfigSecretName returns the name of the Secret containing the kubeconfig for the
+// Shoot's authorization webhook.
+func GetShootAuthorizationWebhookKubeconfigSecretName(apiServerConfig *core.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.Authorization != nil && apiServerConfig.Authorization.Webhook != nil {
+		return apiServerConfig.Authorization.Webhook.KubeconfigSecretName
+	}
+	return ""
+}
+
+// GetShootAuthorizationConfigurationConfigMapName returns the Shoot's ConfigMap name for the structured authorization
+// configuration.
+func GetShootAuthorizationConfigurationConfigMapName(apiServerConfig *core.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.StructuredAuthorization != nil {
+		return apiServerConfig.StructuredAuthorization.ConfigMapName
+	}
+	return ""
+}
+
+// GetShootAuthorizationConfigurationKubeconfigSecretNames returns the names of the Secrets containing the kubeconfigs
+// for the webhook authorizers of the Shoot's structured authorization configuration.
+func GetShootAuthorizationConfigurationKubeconfigSecretNames(apiServerConfig *core.KubeAPIServerConfig) []string {
+	if apiServerConfig == nil || apiServerConfig.StructuredAuthorization == nil {
+		return nil
+	}
+
+	var names []string
+	for _, kubeconfig := range apiServerConfig.StructuredAuthorization.Kubeconfigs {
+		names = append(names, kubeconfig.SecretName)
+	}
+	return names
+}
+
 // HibernationIsEnabled checks if the given shoot's desired state is hibernated.
 func HibernationIsEnabled(shoot *core.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && pointer.BoolDeref(shoot.Spec.Hibernation.Enabled, false)
@@ -538,6 +580,26 @@ func DeterminePrimaryIPFamily(ipFamilies []core.IPFamily) core.IPFamily {
 	return ipFamilies[0]
 }
 
+// SeedSupportsIPFamilies checks if a seed with the given IP families can host a shoot with the given IP families.
+// Dual-stack shoots can only be hosted by seeds which support both IP families. Seeds without IP families default to
+// IPv4.
+func SeedSupportsIPFamilies(seedIPFamilies, shootIPFamilies []core.IPFamily) bool {
+	if !core.IsDualStack(shootIPFamilies) {
+		return true
+	}
+
+	if len(seedIPFamilies) == 0 {
+		seedIPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+	}
+
+	for _, ipFamily := range shootIPFamilies {
+		if !slices.Contains(seedIPFamilies, ipFamily) {
+			return false
+		}
+	}
+	return true
+}
+
 // KubeAPIServerFeatureGateDisabled returns whether the given feature gate is explicitly disabled for the kube-apiserver for the given Shoot spec.
 func KubeAPIServerFeatureGateDisabled(shoot *core.Shoot, featureGate string) bool {
 	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
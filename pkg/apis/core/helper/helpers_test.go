@@ -492,6 +492,76 @@ var _ = Describe("helper", func() {
 		}, &corev1.ObjectReference{Name: "foo"})
 	})
 
+	Describe("GetShootAuthenticationConfigurationConfigMapName", func() {
+		test := func(description string, config *core.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthenticationConfigurationConfigMapName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("StructuredAuthentication = nil", &core.KubeAPIServerConfig{}, "")
+		test("ConfigMapName set", &core.KubeAPIServerConfig{
+			StructuredAuthentication: &core.StructuredAuthentication{
+				ConfigMapName: "foo",
+			},
+		}, "foo")
+	})
+
+	Describe("GetShootAuthorizationWebhookKubeconfigSecretName", func() {
+		test := func(description string, config *core.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthorizationWebhookKubeconfigSecretName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("Authorization = nil", &core.KubeAPIServerConfig{}, "")
+		test("Webhook = nil", &core.KubeAPIServerConfig{Authorization: &core.Authorization{}}, "")
+		test("KubeconfigSecretName set", &core.KubeAPIServerConfig{
+			Authorization: &core.Authorization{
+				Webhook: &core.AuthorizationWebhook{
+					KubeconfigSecretName: "foo",
+				},
+			},
+		}, "foo")
+	})
+
+	Describe("GetShootAuthorizationConfigurationConfigMapName", func() {
+		test := func(description string, config *core.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthorizationConfigurationConfigMapName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("StructuredAuthorization = nil", &core.KubeAPIServerConfig{}, "")
+		test("ConfigMapName set", &core.KubeAPIServerConfig{
+			StructuredAuthorization: &core.StructuredAuthorization{
+				ConfigMapName: "foo",
+			},
+		}, "foo")
+	})
+
+	Describe("GetShootAuthorizationConfigurationKubeconfigSecretNames", func() {
+		test := func(description string, config *core.KubeAPIServerConfig, expectedNames []string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthorizationConfigurationKubeconfigSecretNames(config)).To(Equal(expectedNames))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, nil)
+		test("StructuredAuthorization = nil", &core.KubeAPIServerConfig{}, nil)
+		test("Kubeconfigs set", &core.KubeAPIServerConfig{
+			StructuredAuthorization: &core.StructuredAuthorization{
+				Kubeconfigs: []core.AuthorizerKubeconfigReference{
+					{AuthorizerName: "foo", SecretName: "foo-kubeconfig"},
+					{AuthorizerName: "bar", SecretName: "bar-kubeconfig"},
+				},
+			},
+		}, []string{"foo-kubeconfig", "bar-kubeconfig"})
+	})
+
 	DescribeTable("#HibernationIsEnabled",
 		func(shoot *core.Shoot, hibernated bool) {
 			Expect(HibernationIsEnabled(shoot)).To(Equal(hibernated))
@@ -1014,6 +1084,18 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	DescribeTable("#SeedSupportsIPFamilies",
+		func(seedIPFamilies, shootIPFamilies []core.IPFamily, expected bool) {
+			Expect(SeedSupportsIPFamilies(seedIPFamilies, shootIPFamilies)).To(Equal(expected))
+		},
+
+		Entry("single-stack shoot on default seed", nil, []core.IPFamily{core.IPFamilyIPv4}, true),
+		Entry("single-stack shoot on seed of another IP family", []core.IPFamily{core.IPFamilyIPv6}, []core.IPFamily{core.IPFamilyIPv4}, true),
+		Entry("dual-stack shoot on default seed", nil, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, false),
+		Entry("dual-stack shoot on single-stack seed", []core.IPFamily{core.IPFamilyIPv6}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, false),
+		Entry("dual-stack shoot on dual-stack seed", []core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, true),
+	)
+
 	DescribeTable("#KubeAPIServerFeatureGateDisabled",
 		func(shoot *core.Shoot, featureGate string, expected bool) {
 			actual := KubeAPIServerFeatureGateDisabled(shoot, featureGate)
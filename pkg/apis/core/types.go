@@ -50,3 +50,8 @@ func IsIPv4SingleStack(ipFamilies []IPFamily) bool {
 func IsIPv6SingleStack(ipFamilies []IPFamily) bool {
 	return len(ipFamilies) == 1 && ipFamilies[0] == IPFamilyIPv6
 }
+
+// IsDualStack determines whether the given list of IP families specifies dual-stack networking.
+func IsDualStack(ipFamilies []IPFamily) bool {
+	return len(ipFamilies) == 2
+}
@@ -55,7 +55,8 @@ type BackupBucketSpec struct {
 	// SecretRef is a reference to a secret that contains the credentials to access object store.
 	SecretRef corev1.SecretReference
 	// SeedName holds the name of the seed allocated to BackupBucket for running controller.
-	// This field is immutable.
+	// If it is not set, the gardener-scheduler assigns a seed.
+	// This field is immutable once set.
 	SeedName *string
 }
 
@@ -0,0 +1,88 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespacedCloudProfile represents certain properties about a provider environment which are only available in the
+// namespace of a project. It extends the CloudProfile it references as its parent.
+type NamespacedCloudProfile struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec defines the provider environment properties.
+	Spec NamespacedCloudProfileSpec
+	// Most recently observed status of the NamespacedCloudProfile.
+	Status NamespacedCloudProfileStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespacedCloudProfileList is a collection of NamespacedCloudProfiles.
+type NamespacedCloudProfileList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of NamespacedCloudProfiles.
+	Items []NamespacedCloudProfile
+}
+
+// NamespacedCloudProfileSpec is the specification of a NamespacedCloudProfile.
+type NamespacedCloudProfileSpec struct {
+	// Kubernetes contains overrides of the expiration dates of Kubernetes versions of the parent CloudProfile.
+	Kubernetes *KubernetesSettings
+	// MachineImages contains additional machine images and versions as well as overrides of the expiration dates of
+	// machine image versions of the parent CloudProfile.
+	MachineImages []MachineImage
+	// MachineTypes contains additional machine types which are not part of the parent CloudProfile.
+	MachineTypes []MachineType
+	// VolumeTypes contains additional volume types which are not part of the parent CloudProfile.
+	VolumeTypes []VolumeType
+	// Parent contains a reference to the CloudProfile which is extended by this NamespacedCloudProfile.
+	Parent CloudProfileReference
+	// ProviderConfig contains provider-specific configuration which is merged into the provider-specific configuration
+	// of the parent CloudProfile.
+	ProviderConfig *runtime.RawExtension
+}
+
+// NamespacedCloudProfileStatus holds the most recently observed status of the NamespacedCloudProfile.
+type NamespacedCloudProfileStatus struct {
+	// CloudProfileSpec is the effective specification of the NamespacedCloudProfile, i.e., the specification of the
+	// parent CloudProfile merged with the specification of the NamespacedCloudProfile.
+	CloudProfileSpec CloudProfileSpec
+	// ObservedGeneration is the most recent generation observed for this NamespacedCloudProfile.
+	ObservedGeneration int64
+}
+
+// CloudProfileReference holds the information about a CloudProfile or a NamespacedCloudProfile.
+type CloudProfileReference struct {
+	// Kind contains a CloudProfile kind, i.e., either `CloudProfile` or `NamespacedCloudProfile`.
+	Kind string
+	// Name contains the name of the referenced CloudProfile or NamespacedCloudProfile.
+	Name string
+}
+
+const (
+	// CloudProfileReferenceKindCloudProfile is the kind of a CloudProfile.
+	CloudProfileReferenceKindCloudProfile = "CloudProfile"
+	// CloudProfileReferenceKindNamespacedCloudProfile is the kind of a NamespacedCloudProfile.
+	CloudProfileReferenceKindNamespacedCloudProfile = "NamespacedCloudProfile"
+)
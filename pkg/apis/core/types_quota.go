@@ -29,6 +29,8 @@ type Quota struct {
 	metav1.ObjectMeta
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the current usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -48,10 +50,42 @@ type QuotaSpec struct {
 	ClusterLifetimeDays *int32
 	// Metrics is a list of resources which will be put under constraints.
 	Metrics corev1.ResourceList
+	// SoftMetrics is a list of resources with soft limits. Exceeding a soft limit does not deny the request but results
+	// in a warning and an event for the project.
+	SoftMetrics corev1.ResourceList
 	// Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.
 	Scope corev1.ObjectReference
 }
 
+// QuotaStatus is the status of a Quota.
+type QuotaStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Quota.
+	ObservedGeneration int64
+	// LastUpdateTime is the last time the usage of the Quota was updated.
+	LastUpdateTime *metav1.Time
+	// Usage is the current usage of the Quota. Quotas with 'secret' scope have exactly one entry for all consumers.
+	// The limits of quotas with 'project' scope apply per project, hence, there is one entry per project namespace.
+	Usage []QuotaUsage
+}
+
+// QuotaUsage is the usage of a Quota by the shoots of a project or of all projects.
+type QuotaUsage struct {
+	// Namespace is the project namespace the usage belongs to. It is only set for quotas with 'project' scope.
+	Namespace *string
+	// Used is the amount of resources which is allocated by the consumers for each metric constrained by the Quota.
+	Used corev1.ResourceList
+	// Consumers is the list of shoots which consume the Quota.
+	Consumers []QuotaConsumer
+}
+
+// QuotaConsumer is a shoot which consumes a Quota.
+type QuotaConsumer struct {
+	// Namespace is the namespace of the shoot.
+	Namespace string
+	// Name is the name of the shoot.
+	Name string
+}
+
 const (
 	// QuotaMetricCPU is the constraint for the amount of CPUs
 	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
@@ -65,4 +99,14 @@ const (
 	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
 	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
 	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+	// QuotaMetricShoots is the constraint for the amount of shoots
+	QuotaMetricShoots corev1.ResourceName = "shoots"
+	// QuotaMetricNodes is the constraint for the amount of worker nodes (based on the maximum of the worker pools)
+	QuotaMetricNodes corev1.ResourceName = "nodes"
+	// QuotaMetricHighlyAvailableControlPlanes is the constraint for the amount of shoots with highly available control
+	// planes
+	QuotaMetricHighlyAvailableControlPlanes corev1.ResourceName = "controlplanes.highavailability"
+	// QuotaMetricMachineTypePrefix is the prefix of the constraints for the amount of worker nodes of a specific machine
+	// type (based on the maximum of the worker pools), e.g. 'machinetype/m5.large'
+	QuotaMetricMachineTypePrefix = "machinetype/"
 )
@@ -110,6 +110,9 @@ type ShootSpec struct {
 	// If not specified, the default scheduler takes over.
 	// This field is immutable.
 	SchedulerName *string
+	// CloudProfile contains a reference to a CloudProfile or a NamespacedCloudProfile. If it refers to a
+	// NamespacedCloudProfile, its parent must be the CloudProfile referenced by CloudProfileName.
+	CloudProfile *CloudProfileReference
 }
 
 // GetProviderType gets the type of the provider.
@@ -163,6 +166,10 @@ type ShootStatus struct {
 	// Secrets are encrypted by default and are not part of the list.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	EncryptedResources []string
+	// EncryptionProvider is the encryption provider which is currently used for the encryption of resources in etcd.
+	// It is only updated to the provider configured in the specification once all resources have been re-encrypted,
+	// see https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
+	EncryptionProvider *EncryptionProvider
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -181,8 +188,30 @@ type LastMaintenance struct {
 type ShootCredentials struct {
 	// Rotation contains information about the credential rotations.
 	Rotation *ShootCredentialsRotation
+	// Expirations contains the expiration dates of the certificate authorities and certificates managed for the shoot.
+	Expirations []CredentialExpiration
 }
 
+// CredentialExpiration contains information about the expiration of a certificate authority or certificate.
+type CredentialExpiration struct {
+	// Name is the name of the credential.
+	Name string
+	// Type is the type of the credential.
+	Type CredentialType
+	// ExpirationTime is the time when the credential expires.
+	ExpirationTime metav1.Time
+}
+
+// CredentialType is a type of credential.
+type CredentialType string
+
+const (
+	// CredentialTypeCertificateAuthority is a constant for the certificate authority credential type.
+	CredentialTypeCertificateAuthority CredentialType = "CertificateAuthority"
+	// CredentialTypeCertificate is a constant for the certificate credential type.
+	CredentialTypeCertificate CredentialType = "Certificate"
+)
+
 // ShootCredentialsRotation contains information about the rotation of credentials.
 type ShootCredentialsRotation struct {
 	// CertificateAuthorities contains information about the certificate authority credential rotation.
@@ -591,6 +620,14 @@ type KubeAPIServerConfig struct {
 	DefaultUnreachableTolerationSeconds *int64
 	// EncryptionConfig contains customizable encryption configuration of the API server.
 	EncryptionConfig *EncryptionConfig
+	// StructuredAuthentication contains configuration settings for structured authentication for the kube-apiserver.
+	// This field is only available for Kubernetes v1.29 or later and cannot be combined with `oidcConfig`.
+	StructuredAuthentication *StructuredAuthentication
+	// Authorization contains settings related to authorization for the kube-apiserver.
+	Authorization *Authorization
+	// StructuredAuthorization contains configuration settings for structured authorization for the kube-apiserver.
+	// This field is only available for Kubernetes v1.29 or later and cannot be combined with `authorization.webhook`.
+	StructuredAuthorization *StructuredAuthorization
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -619,8 +656,28 @@ type EncryptionConfig struct {
 	// Wildcards are not supported for now.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	Resources []string
+	// Provider contains information about the encryption provider which is used for the encryption of resources in etcd.
+	// If not set, a static AES-CBC key managed by Gardener is used.
+	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
+	Provider *EncryptionProvider
+}
+
+// EncryptionProvider contains information about the encryption provider of the API server.
+type EncryptionProvider struct {
+	// Type is the type of the encryption provider. The type `aescbc` denotes a static AES-CBC key managed by Gardener.
+	// Any other value denotes a KMS v2 plugin which is deployed as a sidecar of the kube-apiserver by the extension of
+	// the same type.
+	Type string
+	// ProviderConfig is the configuration passed to the extension of the KMS v2 plugin.
+	ProviderConfig *runtime.RawExtension
 }
 
+const (
+	// EncryptionProviderTypeAESCBC is the type of the encryption provider which uses a static AES-CBC key managed by
+	// Gardener.
+	EncryptionProviderTypeAESCBC = "aescbc"
+)
+
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
 type ServiceAccountConfig struct {
 	// Issuer is the identifier of the service account token issuer. The issuer will assert this
@@ -656,6 +713,54 @@ type AuditPolicy struct {
 	ConfigMapRef *corev1.ObjectReference
 }
 
+// StructuredAuthentication contains authentication config for kube-apiserver.
+type StructuredAuthentication struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthenticationConfiguration
+	// for the kube-apiserver.
+	ConfigMapName string
+}
+
+// StructuredAuthorization contains authorization config for kube-apiserver.
+type StructuredAuthorization struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthorizationConfiguration
+	// for the kube-apiserver. It must only contain authorizers of type `Webhook`, the Node and RBAC authorizers are
+	// always prepended by Gardener.
+	ConfigMapName string
+	// Kubeconfigs is a list of references for kubeconfigs for the authorization webhooks. Each webhook authorizer in
+	// the AuthorizationConfiguration must be referenced here.
+	Kubeconfigs []AuthorizerKubeconfigReference
+}
+
+// AuthorizerKubeconfigReference is a reference for a kubeconfig for an authorization webhook.
+type AuthorizerKubeconfigReference struct {
+	// AuthorizerName is the name of a webhook authorizer in the AuthorizationConfiguration.
+	AuthorizerName string
+	// SecretName is the name of a secret in the project namespace which contains the kubeconfig for the authorization
+	// webhook under the data key `kubeconfig`.
+	SecretName string
+}
+
+// Authorization contains settings related to authorization for the kube-apiserver.
+type Authorization struct {
+	// Webhook contains settings related to an authorization webhook. It is consulted after the Node and RBAC
+	// authorizers, i.e., only for requests which were not already allowed by them.
+	Webhook *AuthorizationWebhook
+}
+
+// AuthorizationWebhook contains settings related to an authorization webhook.
+type AuthorizationWebhook struct {
+	// KubeconfigSecretName is the name of a secret in the project namespace which contains the kubeconfig for the
+	// authorization webhook under the data key `kubeconfig`.
+	KubeconfigSecretName string
+	// CacheAuthorizedTTL is the duration to cache 'authorized' responses from the webhook authorizer.
+	CacheAuthorizedTTL *metav1.Duration
+	// CacheUnauthorizedTTL is the duration to cache 'unauthorized' responses from the webhook authorizer.
+	CacheUnauthorizedTTL *metav1.Duration
+	// Version is the API version of the authorization.k8s.io SubjectAccessReview to send to and expect from the
+	// webhook. Possible values are `v1beta1` and `v1`.
+	Version *string
+}
+
 // OIDCConfig contains configuration settings for the OIDC provider.
 // Note: Descriptions were taken from the Kubernetes documentation.
 type OIDCConfig struct {
@@ -992,10 +1097,21 @@ type Networking struct {
 	Nodes *string
 	// Services is the CIDR of the service network. This field is immutable.
 	Services *string
-	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
+	// IPFamilies specifies the IP protocol versions to use for shoot networking. The first IP family is the primary
+	// one, the networks configured in the fields above belong to it. This field is immutable, however, a second IP
+	// family can be added to single-stack shoots.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md.
 	// Defaults to ["IPv4"].
 	IPFamilies []IPFamily
+	// SecondaryPods is the CIDR of the pod network of the secondary IP family in case of dual-stack networking.
+	// This field is immutable once it is set.
+	SecondaryPods *string
+	// SecondaryNodes is the CIDR of the node network of the secondary IP family in case of dual-stack networking.
+	// This field is immutable once it is set.
+	SecondaryNodes *string
+	// SecondaryServices is the CIDR of the service network of the secondary IP family in case of dual-stack networking.
+	// This field is immutable once it is set.
+	SecondaryServices *string
 }
 
 const (
@@ -1010,6 +1126,8 @@ const (
 	MaintenanceTimeWindowDurationMinimum = 30 * time.Minute
 	// MaintenanceTimeWindowDurationMaximum is the maximum duration for a maintenance time window.
 	MaintenanceTimeWindowDurationMaximum = 6 * time.Hour
+	// CredentialsRotationIntervalMinimum is the minimum interval of a credentials rotation policy.
+	CredentialsRotationIntervalMinimum = 24 * time.Hour
 )
 
 // Maintenance contains information about the time window for maintenance operations and which
@@ -1023,6 +1141,41 @@ type Maintenance struct {
 	// Instead, they are rolled out during the shoot's maintenance time window. There is one exception that will trigger
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	ConfineSpecUpdateRollout *bool
+	// CredentialsRotation contains policies for automatically rotating the shoot credentials during the maintenance
+	// time window.
+	CredentialsRotation *MaintenanceCredentialsRotation
+	// TimeWindowWeekdays restricts the time window to the given days of the week (Mon, Tue, Wed, Thu, Fri, Sat, Sun). The
+	// day is determined by the beginning of the time window in its time zone. If not present, the time window applies to
+	// every day.
+	TimeWindowWeekdays []string
+	// AdditionalTimeRanges are further time ranges in which maintenance operations may be performed.
+	AdditionalTimeRanges []MaintenanceTimeRange
+	// Blackouts are periods in which no maintenance operations are performed, even if they overlap with the time window
+	// or one of the additional time ranges (e.g., an end-of-quarter freeze).
+	Blackouts []MaintenanceBlackout
+}
+
+// MaintenanceCredentialsRotation contains policies for automatically rotating the shoot credentials during the
+// maintenance time window.
+type MaintenanceCredentialsRotation struct {
+	// CertificateAuthorities is the rotation policy for the certificate authorities.
+	CertificateAuthorities *CredentialsRotationPolicy
+	// ServiceAccountKey is the rotation policy for the service account signing key.
+	ServiceAccountKey *CredentialsRotationPolicy
+	// ETCDEncryptionKey is the rotation policy for the ETCD encryption key.
+	ETCDEncryptionKey *CredentialsRotationPolicy
+	// SSHKeypair is the rotation policy for the ssh-keypair.
+	SSHKeypair *CredentialsRotationPolicy
+	// Observability is the rotation policy for the observability credentials.
+	Observability *CredentialsRotationPolicy
+}
+
+// CredentialsRotationPolicy is a policy for automatically rotating credentials.
+type CredentialsRotationPolicy struct {
+	// Interval is the minimum duration between the initiation of two rotations. Once it has passed since the last
+	// initiation (or the creation of the shoot), the rotation is triggered in the next maintenance time window. For
+	// two-phase rotations, the completion is triggered in the subsequent maintenance time window.
+	Interval metav1.Duration
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1043,6 +1196,27 @@ type MaintenanceTimeWindow struct {
 	End string
 }
 
+// MaintenanceTimeRange is a further time range in which maintenance operations may be performed.
+type MaintenanceTimeRange struct {
+	// Begin is the beginning of the time range in the format HHMMSS+ZONE, e.g. "220000+0100".
+	Begin string
+	// End is the end of the time range in the format HHMMSS+ZONE, e.g. "220000+0100".
+	End string
+	// Weekdays restricts the time range to the given days of the week (Mon, Tue, Wed, Thu, Fri, Sat, Sun). The day is
+	// determined by the beginning of the time range in its time zone. If not present, the time range applies to every day.
+	Weekdays []string
+}
+
+// MaintenanceBlackout is a period in which no maintenance operations are performed.
+type MaintenanceBlackout struct {
+	// Begin is the beginning of the blackout period.
+	Begin metav1.Time
+	// End is the end of the blackout period.
+	End metav1.Time
+	// Reason is an optional description of why maintenance operations are not allowed during this period.
+	Reason *string
+}
+
 // Monitoring contains information about the monitoring configuration for the shoot.
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
@@ -63,6 +63,8 @@ type GardenerResourceData struct {
 	Data runtime.RawExtension
 	// Labels are labels of the object
 	Labels map[string]string
+	// Annotations are annotations of the object
+	Annotations map[string]string
 }
 
 // ExtensionResourceState contains the kind of the extension custom resource and its last observed state in the Shoot's
@@ -79,6 +79,12 @@ const (
 	// SecretNamePrefixGardenerETCDEncryptionConfiguration is a constant for the name prefix of a Kubernetes secret
 	// object that contains the configuration for encryption data in ETCD for gardener-apiserver.
 	SecretNamePrefixGardenerETCDEncryptionConfiguration = "gardener-apiserver-etcd-encryption-configuration"
+	// VolumeNameKMSPluginSocket is a constant for the name of the volume in the kube-apiserver deployment in which
+	// KMS v2 plugins must create their sockets. The socket of a KMS plugin must be named `<encryption-provider-type>.sock`.
+	VolumeNameKMSPluginSocket = "kms-plugin-socket"
+	// VolumeMountPathKMSPluginSocket is a constant for the path at which the KMS v2 plugin socket volume is mounted
+	// into the kube-apiserver container.
+	VolumeMountPathKMSPluginSocket = "/var/run/kmsplugin"
 
 	// SecretNameGardener is a constant for the name of a Kubernetes secret object that contains the client
 	// certificate and a kubeconfig for a shoot cluster. It is used by Gardener and can be used by extension
@@ -561,6 +567,9 @@ const (
 	// DataTypeMachineState is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing
 	// that the data is machine state.
 	DataTypeMachineState = "machine-state"
+	// DataTypeFlowState is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing
+	// that the data is the state of a failed flow execution.
+	DataTypeFlowState = "flow-state"
 
 	// DefaultSchedulerName is the name of the default scheduler.
 	DefaultSchedulerName = "default-scheduler"
@@ -585,6 +594,9 @@ const (
 	AnnotationShootSkipCleanup = "shoot.gardener.cloud/skip-cleanup"
 	// AnnotationShootSkipReadiness is a key for an annotation on a Shoot resource that instructs the shoot flow to skip readiness steps during reconciliation.
 	AnnotationShootSkipReadiness = "shoot.gardener.cloud/skip-readiness"
+	// AnnotationShootImportInProgress is a key for an annotation on a Shoot resource that declares that the shoot is being
+	// imported from a ShootState archive. The gardener-scheduler does not assign a seed to such shoots.
+	AnnotationShootImportInProgress = "shoot.gardener.cloud/import-in-progress"
 	// AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds is a key for an annotation on a Shoot resource that
 	// declares the grace period in seconds for finalizing the resources handled in the 'cleanup webhooks' step.
 	// Concretely, after the specified seconds, all the finalizers of the affected resources are forcefully removed.
@@ -670,6 +682,8 @@ const (
 	// EventResourceReferenced indicates that the resource deletion is in waiting mode because the resource is still
 	// being referenced by at least one other resource (e.g. a SecretBinding is still referenced by a Shoot)
 	EventResourceReferenced = "ResourceReferenced"
+	// EventQuotaSoftLimitExceeded indicates that the consumers of a Quota exceed at least one of its soft limits.
+	EventQuotaSoftLimitExceeded = "QuotaSoftLimitExceeded"
 
 	// ReferencedResourcesPrefix is the prefix used when copying referenced resources to the Shoot namespace in the Seed,
 	// to avoid naming collisions with resources managed by Gardener.
@@ -277,10 +277,94 @@ func (m *AuditPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuditPolicy proto.InternalMessageInfo
 
+func (m *Authorization) Reset()      { *m = Authorization{} }
+func (*Authorization) ProtoMessage() {}
+func (*Authorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
+}
+func (m *Authorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Authorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Authorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authorization.Merge(m, src)
+}
+func (m *Authorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *Authorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authorization proto.InternalMessageInfo
+
+func (m *AuthorizationWebhook) Reset()      { *m = AuthorizationWebhook{} }
+func (*AuthorizationWebhook) ProtoMessage() {}
+func (*AuthorizationWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
+}
+func (m *AuthorizationWebhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuthorizationWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationWebhook.Merge(m, src)
+}
+func (m *AuthorizationWebhook) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationWebhook proto.InternalMessageInfo
+
+func (m *AuthorizerKubeconfigReference) Reset()      { *m = AuthorizerKubeconfigReference{} }
+func (*AuthorizerKubeconfigReference) ProtoMessage() {}
+func (*AuthorizerKubeconfigReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+}
+func (m *AuthorizerKubeconfigReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizerKubeconfigReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuthorizerKubeconfigReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizerKubeconfigReference.Merge(m, src)
+}
+func (m *AuthorizerKubeconfigReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizerKubeconfigReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizerKubeconfigReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizerKubeconfigReference proto.InternalMessageInfo
+
 func (m *AvailabilityZone) Reset()      { *m = AvailabilityZone{} }
 func (*AvailabilityZone) ProtoMessage() {}
 func (*AvailabilityZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
 }
 func (m *AvailabilityZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +392,7 @@ var xxx_messageInfo_AvailabilityZone proto.InternalMessageInfo
 func (m *BackupBucket) Reset()      { *m = BackupBucket{} }
 func (*BackupBucket) ProtoMessage() {}
 func (*BackupBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
 }
 func (m *BackupBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +420,7 @@ var xxx_messageInfo_BackupBucket proto.InternalMessageInfo
 func (m *BackupBucketList) Reset()      { *m = BackupBucketList{} }
 func (*BackupBucketList) ProtoMessage() {}
 func (*BackupBucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
 }
 func (m *BackupBucketList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,7 +448,7 @@ var xxx_messageInfo_BackupBucketList proto.InternalMessageInfo
 func (m *BackupBucketProvider) Reset()      { *m = BackupBucketProvider{} }
 func (*BackupBucketProvider) ProtoMessage() {}
 func (*BackupBucketProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
 }
 func (m *BackupBucketProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +476,7 @@ var xxx_messageInfo_BackupBucketProvider proto.InternalMessageInfo
 func (m *BackupBucketSpec) Reset()      { *m = BackupBucketSpec{} }
 func (*BackupBucketSpec) ProtoMessage() {}
 func (*BackupBucketSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
 }
 func (m *BackupBucketSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +504,7 @@ var xxx_messageInfo_BackupBucketSpec proto.InternalMessageInfo
 func (m *BackupBucketStatus) Reset()      { *m = BackupBucketStatus{} }
 func (*BackupBucketStatus) ProtoMessage() {}
 func (*BackupBucketStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
 }
 func (m *BackupBucketStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +532,7 @@ var xxx_messageInfo_BackupBucketStatus proto.InternalMessageInfo
 func (m *BackupEntry) Reset()      { *m = BackupEntry{} }
 func (*BackupEntry) ProtoMessage() {}
 func (*BackupEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
 }
 func (m *BackupEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +560,7 @@ var xxx_messageInfo_BackupEntry proto.InternalMessageInfo
 func (m *BackupEntryList) Reset()      { *m = BackupEntryList{} }
 func (*BackupEntryList) ProtoMessage() {}
 func (*BackupEntryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
 }
 func (m *BackupEntryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,7 +588,7 @@ var xxx_messageInfo_BackupEntryList proto.InternalMessageInfo
 func (m *BackupEntrySpec) Reset()      { *m = BackupEntrySpec{} }
 func (*BackupEntrySpec) ProtoMessage() {}
 func (*BackupEntrySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
 }
 func (m *BackupEntrySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +616,7 @@ var xxx_messageInfo_BackupEntrySpec proto.InternalMessageInfo
 func (m *BackupEntryStatus) Reset()      { *m = BackupEntryStatus{} }
 func (*BackupEntryStatus) ProtoMessage() {}
 func (*BackupEntryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
 }
 func (m *BackupEntryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,7 +644,7 @@ var xxx_messageInfo_BackupEntryStatus proto.InternalMessageInfo
 func (m *CARotation) Reset()      { *m = CARotation{} }
 func (*CARotation) ProtoMessage() {}
 func (*CARotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
 }
 func (m *CARotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +672,7 @@ var xxx_messageInfo_CARotation proto.InternalMessageInfo
 func (m *CRI) Reset()      { *m = CRI{} }
 func (*CRI) ProtoMessage() {}
 func (*CRI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
 }
 func (m *CRI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +700,7 @@ var xxx_messageInfo_CRI proto.InternalMessageInfo
 func (m *CloudProfile) Reset()      { *m = CloudProfile{} }
 func (*CloudProfile) ProtoMessage() {}
 func (*CloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
 }
 func (m *CloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +728,7 @@ var xxx_messageInfo_CloudProfile proto.InternalMessageInfo
 func (m *CloudProfileList) Reset()      { *m = CloudProfileList{} }
 func (*CloudProfileList) ProtoMessage() {}
 func (*CloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
 }
 func (m *CloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,10 +753,38 @@ func (m *CloudProfileList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CloudProfileList proto.InternalMessageInfo
 
+func (m *CloudProfileReference) Reset()      { *m = CloudProfileReference{} }
+func (*CloudProfileReference) ProtoMessage() {}
+func (*CloudProfileReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+}
+func (m *CloudProfileReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloudProfileReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CloudProfileReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloudProfileReference.Merge(m, src)
+}
+func (m *CloudProfileReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloudProfileReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloudProfileReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloudProfileReference proto.InternalMessageInfo
+
 func (m *CloudProfileSpec) Reset()      { *m = CloudProfileSpec{} }
 func (*CloudProfileSpec) ProtoMessage() {}
 func (*CloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *CloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +812,7 @@ var xxx_messageInfo_CloudProfileSpec proto.InternalMessageInfo
 func (m *ClusterAutoscaler) Reset()      { *m = ClusterAutoscaler{} }
 func (*ClusterAutoscaler) ProtoMessage() {}
 func (*ClusterAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *ClusterAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,7 +840,7 @@ var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +868,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +896,7 @@ var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +924,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +952,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +980,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -896,7 +1008,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1036,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,7 +1064,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,7 +1092,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,7 +1120,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1148,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1176,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1092,7 +1204,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1120,7 +1232,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,7 +1260,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1288,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1204,7 +1316,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,10 +1341,66 @@ func (m *CoreDNSRewriting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 
+func (m *CredentialExpiration) Reset()      { *m = CredentialExpiration{} }
+func (*CredentialExpiration) ProtoMessage() {}
+func (*CredentialExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+}
+func (m *CredentialExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CredentialExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialExpiration.Merge(m, src)
+}
+func (m *CredentialExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialExpiration proto.InternalMessageInfo
+
+func (m *CredentialsRotationPolicy) Reset()      { *m = CredentialsRotationPolicy{} }
+func (*CredentialsRotationPolicy) ProtoMessage() {}
+func (*CredentialsRotationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+}
+func (m *CredentialsRotationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialsRotationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CredentialsRotationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialsRotationPolicy.Merge(m, src)
+}
+func (m *CredentialsRotationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialsRotationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialsRotationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialsRotationPolicy proto.InternalMessageInfo
+
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1428,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1456,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1484,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1344,7 +1512,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1372,7 +1540,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,7 +1568,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,10 +1593,38 @@ func (m *EncryptionConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 
+func (m *EncryptionProvider) Reset()      { *m = EncryptionProvider{} }
+func (*EncryptionProvider) ProtoMessage() {}
+func (*EncryptionProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+}
+func (m *EncryptionProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EncryptionProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionProvider.Merge(m, src)
+}
+func (m *EncryptionProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionProvider proto.InternalMessageInfo
+
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1652,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +1680,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1708,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1540,7 +1736,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1568,7 +1764,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1596,7 +1792,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1624,7 +1820,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1848,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1680,7 +1876,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +1904,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1736,7 +1932,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1764,7 +1960,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +1988,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1820,7 +2016,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +2044,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1876,7 +2072,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +2100,7 @@ var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1932,7 +2128,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1960,7 +2156,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1988,7 +2184,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2016,7 +2212,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2044,7 +2240,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2072,7 +2268,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2100,7 +2296,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2128,7 +2324,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2156,7 +2352,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2184,7 +2380,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2212,7 +2408,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2240,7 +2436,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2268,7 +2464,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2296,7 +2492,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2324,7 +2520,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2352,7 +2548,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2380,7 +2576,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2408,7 +2604,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +2632,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2464,7 +2660,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +2688,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2520,7 +2716,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2548,7 +2744,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2573,10 +2769,94 @@ func (m *MaintenanceAutoUpdate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 
+func (m *MaintenanceBlackout) Reset()      { *m = MaintenanceBlackout{} }
+func (*MaintenanceBlackout) ProtoMessage() {}
+func (*MaintenanceBlackout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+}
+func (m *MaintenanceBlackout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceBlackout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceBlackout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceBlackout.Merge(m, src)
+}
+func (m *MaintenanceBlackout) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceBlackout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceBlackout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceBlackout proto.InternalMessageInfo
+
+func (m *MaintenanceCredentialsRotation) Reset()      { *m = MaintenanceCredentialsRotation{} }
+func (*MaintenanceCredentialsRotation) ProtoMessage() {}
+func (*MaintenanceCredentialsRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+}
+func (m *MaintenanceCredentialsRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceCredentialsRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceCredentialsRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceCredentialsRotation.Merge(m, src)
+}
+func (m *MaintenanceCredentialsRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceCredentialsRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceCredentialsRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceCredentialsRotation proto.InternalMessageInfo
+
+func (m *MaintenanceTimeRange) Reset()      { *m = MaintenanceTimeRange{} }
+func (*MaintenanceTimeRange) ProtoMessage() {}
+func (*MaintenanceTimeRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+}
+func (m *MaintenanceTimeRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceTimeRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceTimeRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceTimeRange.Merge(m, src)
+}
+func (m *MaintenanceTimeRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceTimeRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceTimeRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceTimeRange proto.InternalMessageInfo
+
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +2884,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +2912,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +2940,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2685,10 +2965,122 @@ func (m *NamedResourceReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 
+func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
+func (*NamespacedCloudProfile) ProtoMessage() {}
+func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+}
+func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespacedCloudProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespacedCloudProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespacedCloudProfile.Merge(m, src)
+}
+func (m *NamespacedCloudProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespacedCloudProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespacedCloudProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
+
+func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
+func (*NamespacedCloudProfileList) ProtoMessage() {}
+func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+}
+func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespacedCloudProfileList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespacedCloudProfileList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespacedCloudProfileList.Merge(m, src)
+}
+func (m *NamespacedCloudProfileList) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespacedCloudProfileList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespacedCloudProfileList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
+
+func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
+func (*NamespacedCloudProfileSpec) ProtoMessage() {}
+func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+}
+func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespacedCloudProfileSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespacedCloudProfileSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespacedCloudProfileSpec.Merge(m, src)
+}
+func (m *NamespacedCloudProfileSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespacedCloudProfileSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespacedCloudProfileSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
+
+func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
+func (*NamespacedCloudProfileStatus) ProtoMessage() {}
+func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+}
+func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespacedCloudProfileStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespacedCloudProfileStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespacedCloudProfileStatus.Merge(m, src)
+}
+func (m *NamespacedCloudProfileStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespacedCloudProfileStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespacedCloudProfileStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
+
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2716,7 +3108,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2744,7 +3136,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2772,7 +3164,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2800,7 +3192,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +3220,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2856,7 +3248,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2884,7 +3276,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2912,7 +3304,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2940,7 +3332,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2968,7 +3360,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,7 +3388,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3416,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +3444,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3077,10 +3469,38 @@ func (m *Quota) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Quota proto.InternalMessageInfo
 
+func (m *QuotaConsumer) Reset()      { *m = QuotaConsumer{} }
+func (*QuotaConsumer) ProtoMessage() {}
+func (*QuotaConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+}
+func (m *QuotaConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaConsumer.Merge(m, src)
+}
+func (m *QuotaConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaConsumer proto.InternalMessageInfo
+
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3108,7 +3528,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3133,10 +3553,66 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
+func (m *QuotaUsage) Reset()      { *m = QuotaUsage{} }
+func (*QuotaUsage) ProtoMessage() {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+}
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,7 +3640,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3192,7 +3668,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3220,7 +3696,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +3724,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3276,7 +3752,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3304,7 +3780,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3332,7 +3808,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3360,7 +3836,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3388,7 +3864,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3416,7 +3892,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3444,7 +3920,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3472,7 +3948,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3500,7 +3976,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3528,7 +4004,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3556,7 +4032,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3584,7 +4060,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3612,7 +4088,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3640,7 +4116,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3670,7 +4146,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3698,7 +4174,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3726,7 +4202,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3754,7 +4230,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3782,7 +4258,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3810,7 +4286,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3838,7 +4314,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3866,7 +4342,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3894,7 +4370,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3922,7 +4398,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3950,7 +4426,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3978,7 +4454,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4006,7 +4482,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4034,7 +4510,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4062,7 +4538,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4090,7 +4566,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4118,7 +4594,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4146,7 +4622,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4174,7 +4650,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4202,7 +4678,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4230,7 +4706,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4258,7 +4734,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4286,7 +4762,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4314,7 +4790,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4342,7 +4818,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4370,7 +4846,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4398,7 +4874,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4426,7 +4902,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4454,7 +4930,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4482,7 +4958,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4507,10 +4983,66 @@ func (m *ShootTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 
+func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
+func (*StructuredAuthentication) ProtoMessage() {}
+func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+}
+func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StructuredAuthentication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StructuredAuthentication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructuredAuthentication.Merge(m, src)
+}
+func (m *StructuredAuthentication) XXX_Size() int {
+	return m.Size()
+}
+func (m *StructuredAuthentication) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructuredAuthentication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
+
+func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
+func (*StructuredAuthorization) ProtoMessage() {}
+func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+}
+func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StructuredAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StructuredAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructuredAuthorization.Merge(m, src)
+}
+func (m *StructuredAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *StructuredAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructuredAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
+
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4538,7 +5070,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4566,7 +5098,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4594,7 +5126,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4622,7 +5154,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4650,7 +5182,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4678,7 +5210,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4706,7 +5238,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4734,7 +5266,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4762,7 +5294,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4796,6 +5328,9 @@ func init() {
 	proto.RegisterType((*Alerting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Alerting")
 	proto.RegisterType((*AuditConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditConfig")
 	proto.RegisterType((*AuditPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditPolicy")
+	proto.RegisterType((*Authorization)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Authorization")
+	proto.RegisterType((*AuthorizationWebhook)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthorizationWebhook")
+	proto.RegisterType((*AuthorizerKubeconfigReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthorizerKubeconfigReference")
 	proto.RegisterType((*AvailabilityZone)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AvailabilityZone")
 	proto.RegisterType((*BackupBucket)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucket")
 	proto.RegisterType((*BackupBucketList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucketList")
@@ -4810,6 +5345,7 @@ func init() {
 	proto.RegisterType((*CRI)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CRI")
 	proto.RegisterType((*CloudProfile)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfile")
 	proto.RegisterType((*CloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileList")
+	proto.RegisterType((*CloudProfileReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileReference")
 	proto.RegisterType((*CloudProfileSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileSpec")
 	proto.RegisterType((*ClusterAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscaler")
 	proto.RegisterType((*Condition)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Condition")
@@ -4830,6 +5366,8 @@ func init() {
 	proto.RegisterType((*CoreDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNS")
 	proto.RegisterType((*CoreDNSAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNSAutoscaling")
 	proto.RegisterType((*CoreDNSRewriting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNSRewriting")
+	proto.RegisterType((*CredentialExpiration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CredentialExpiration")
+	proto.RegisterType((*CredentialsRotationPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CredentialsRotationPolicy")
 	proto.RegisterType((*DNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DNS")
 	proto.RegisterType((*DNSIncludeExclude)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DNSIncludeExclude")
 	proto.RegisterType((*DNSProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DNSProvider")
@@ -4837,6 +5375,7 @@ func init() {
 	proto.RegisterType((*DeploymentRef)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DeploymentRef")
 	proto.RegisterType((*ETCDEncryptionKeyRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ETCDEncryptionKeyRotation")
 	proto.RegisterType((*EncryptionConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.EncryptionConfig")
+	proto.RegisterType((*EncryptionProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.EncryptionProvider")
 	proto.RegisterType((*ExpirableVersion)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExpirableVersion")
 	proto.RegisterType((*ExposureClass)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExposureClass")
 	proto.RegisterType((*ExposureClassList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExposureClassList")
@@ -4846,6 +5385,7 @@ func init() {
 	proto.RegisterType((*FailureTolerance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.FailureTolerance")
 	proto.RegisterType((*Gardener)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Gardener")
 	proto.RegisterType((*GardenerResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.GardenerResourceData")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.GardenerResourceData.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.GardenerResourceData.LabelsEntry")
 	proto.RegisterType((*Hibernation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Hibernation")
 	proto.RegisterType((*HibernationSchedule)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationSchedule")
@@ -4883,10 +5423,17 @@ func init() {
 	proto.RegisterType((*MachineTypeStorage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineTypeStorage")
 	proto.RegisterType((*Maintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Maintenance")
 	proto.RegisterType((*MaintenanceAutoUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceAutoUpdate")
+	proto.RegisterType((*MaintenanceBlackout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceBlackout")
+	proto.RegisterType((*MaintenanceCredentialsRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceCredentialsRotation")
+	proto.RegisterType((*MaintenanceTimeRange)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeRange")
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
 	proto.RegisterType((*MemorySwapConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MemorySwapConfiguration")
 	proto.RegisterType((*Monitoring)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Monitoring")
 	proto.RegisterType((*NamedResourceReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamedResourceReference")
+	proto.RegisterType((*NamespacedCloudProfile)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfile")
+	proto.RegisterType((*NamespacedCloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfileList")
+	proto.RegisterType((*NamespacedCloudProfileSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfileSpec")
+	proto.RegisterType((*NamespacedCloudProfileStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfileStatus")
 	proto.RegisterType((*Networking)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Networking")
 	proto.RegisterType((*NginxIngress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress.ConfigEntry")
@@ -4904,9 +5451,14 @@ func init() {
 	proto.RegisterType((*ProjectTolerations)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectTolerations")
 	proto.RegisterType((*Provider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Provider")
 	proto.RegisterType((*Quota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Quota")
+	proto.RegisterType((*QuotaConsumer)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaConsumer")
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.SoftMetricsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterType((*QuotaUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaUsage")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaUsage.UsedEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
@@ -4963,6 +5515,8 @@ func init() {
 	proto.RegisterType((*ShootStateSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateSpec")
 	proto.RegisterType((*ShootStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStatus")
 	proto.RegisterType((*ShootTemplate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootTemplate")
+	proto.RegisterType((*StructuredAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthentication")
+	proto.RegisterType((*StructuredAuthorization)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthorization")
 	proto.RegisterType((*SystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SystemComponents")
 	proto.RegisterType((*Toleration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Toleration")
 	proto.RegisterType((*VerticalPodAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler")
@@ -4983,760 +5537,825 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 12035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xc9,
-	0x75, 0x98, 0x7a, 0xf8, 0xfd, 0xf8, 0xb1, 0xdc, 0xda, 0x8f, 0xe3, 0x72, 0xef, 0x76, 0x56, 0x7d,
-	0x27, 0xe5, 0xce, 0x27, 0x73, 0x7d, 0x67, 0xc9, 0xa7, 0x5b, 0xf9, 0x74, 0x22, 0x67, 0xc8, 0xdd,
-	0xf1, 0x92, 0x5c, 0x5e, 0x0d, 0x79, 0x77, 0x3e, 0x3b, 0x67, 0x37, 0xbb, 0x8b, 0xc3, 0x3e, 0xf6,
-	0x74, 0xcf, 0x75, 0xf7, 0x70, 0xc9, 0x3b, 0x3b, 0xb6, 0x14, 0xcb, 0xb1, 0xce, 0x56, 0xe0, 0x18,
-	0x70, 0x04, 0xc9, 0x0e, 0x2c, 0xc3, 0x70, 0xbe, 0x1c, 0x38, 0x86, 0x03, 0x07, 0xb0, 0x8d, 0x00,
-	0x86, 0x01, 0xc7, 0xb2, 0x61, 0x1b, 0x86, 0x94, 0x20, 0x12, 0x12, 0xd3, 0x11, 0xa3, 0xc8, 0x01,
-	0x12, 0x18, 0x01, 0x8c, 0x20, 0xc8, 0xc6, 0x70, 0x82, 0xfa, 0xe8, 0xea, 0xea, 0xaf, 0x21, 0xd9,
-	0x43, 0x52, 0x3a, 0xd8, 0xbf, 0xc8, 0xa9, 0x57, 0xf5, 0x5e, 0x55, 0x75, 0xd5, 0xab, 0x57, 0xaf,
-	0xde, 0x07, 0x2c, 0xb4, 0xec, 0x70, 0xbb, 0xbb, 0x39, 0x67, 0x7a, 0xed, 0x5b, 0x2d, 0xc3, 0xb7,
-	0x88, 0x4b, 0xfc, 0xf8, 0x9f, 0xce, 0x4e, 0xeb, 0x96, 0xd1, 0xb1, 0x83, 0x5b, 0xa6, 0xe7, 0x93,
-	0x5b, 0xbb, 0xcf, 0x6c, 0x92, 0xd0, 0x78, 0xe6, 0x56, 0x8b, 0xc2, 0x8c, 0x90, 0x58, 0x73, 0x1d,
-	0xdf, 0x0b, 0x3d, 0xf4, 0x6c, 0x8c, 0x63, 0x2e, 0x6a, 0x1a, 0xff, 0xd3, 0xd9, 0x69, 0xcd, 0x51,
-	0x1c, 0x73, 0x14, 0xc7, 0x9c, 0xc0, 0x31, 0xfb, 0xad, 0x2a, 0x5d, 0xaf, 0xe5, 0xdd, 0x62, 0xa8,
-	0x36, 0xbb, 0x5b, 0xec, 0x17, 0xfb, 0xc1, 0xfe, 0xe3, 0x24, 0x66, 0x9f, 0xda, 0xf9, 0x70, 0x30,
-	0x67, 0x7b, 0xb4, 0x33, 0xb7, 0x8c, 0x6e, 0xe8, 0x05, 0xa6, 0xe1, 0xd8, 0x6e, 0xeb, 0xd6, 0x6e,
-	0xa6, 0x37, 0xb3, 0xba, 0x52, 0x55, 0x74, 0xbb, 0x67, 0x1d, 0x7f, 0xd3, 0x30, 0xf3, 0xea, 0x7c,
-	0x30, 0xae, 0xd3, 0x36, 0xcc, 0x6d, 0xdb, 0x25, 0xfe, 0x7e, 0x34, 0x21, 0xb7, 0x7c, 0x12, 0x78,
-	0x5d, 0xdf, 0x24, 0x27, 0x6a, 0x15, 0xdc, 0x6a, 0x93, 0xd0, 0xc8, 0xa3, 0x75, 0xab, 0xa8, 0x95,
-	0xdf, 0x75, 0x43, 0xbb, 0x9d, 0x25, 0xf3, 0x1d, 0x47, 0x35, 0x08, 0xcc, 0x6d, 0xd2, 0x36, 0x32,
-	0xed, 0xbe, 0xbd, 0xa8, 0x5d, 0x37, 0xb4, 0x9d, 0x5b, 0xb6, 0x1b, 0x06, 0xa1, 0x9f, 0x6e, 0xa4,
-	0xbf, 0xa3, 0xc1, 0xf4, 0xfc, 0x5a, 0xa3, 0x49, 0xfc, 0x5d, 0xe2, 0x2f, 0x7b, 0xad, 0x96, 0xed,
-	0xb6, 0xd0, 0xd3, 0x30, 0xb6, 0x4b, 0xfc, 0x4d, 0x2f, 0xb0, 0xc3, 0xfd, 0x19, 0xed, 0xa6, 0xf6,
-	0xe4, 0xd0, 0xc2, 0xe4, 0xe1, 0x41, 0x75, 0xec, 0xe5, 0xa8, 0x10, 0xc7, 0x70, 0xd4, 0x80, 0x4b,
-	0xdb, 0x61, 0xd8, 0x99, 0x37, 0x4d, 0x12, 0x04, 0xb2, 0xc6, 0x4c, 0x85, 0x35, 0x7b, 0xe4, 0xf0,
-	0xa0, 0x7a, 0xe9, 0xee, 0xfa, 0xfa, 0x5a, 0x0a, 0x8c, 0xf3, 0xda, 0xe8, 0xbf, 0xaa, 0xc1, 0x45,
-	0xd9, 0x19, 0x4c, 0xde, 0xec, 0x92, 0x20, 0x0c, 0x10, 0x86, 0xab, 0x6d, 0x63, 0x6f, 0xd5, 0x73,
-	0x57, 0xba, 0xa1, 0x11, 0xda, 0x6e, 0xab, 0xe1, 0x6e, 0x39, 0x76, 0x6b, 0x3b, 0x14, 0x5d, 0x9b,
-	0x3d, 0x3c, 0xa8, 0x5e, 0x5d, 0xc9, 0xad, 0x81, 0x0b, 0x5a, 0xd2, 0x4e, 0xb7, 0x8d, 0xbd, 0x0c,
-	0x42, 0xa5, 0xd3, 0x2b, 0x59, 0x30, 0xce, 0x6b, 0xa3, 0x3f, 0x0b, 0x43, 0xf3, 0x96, 0xe5, 0xb9,
-	0xe8, 0x29, 0x18, 0x21, 0xae, 0xb1, 0xe9, 0x10, 0x8b, 0x75, 0x6c, 0x74, 0xe1, 0xc2, 0x17, 0x0e,
-	0xaa, 0xef, 0x39, 0x3c, 0xa8, 0x8e, 0x2c, 0xf2, 0x62, 0x1c, 0xc1, 0xf5, 0x9f, 0xae, 0xc0, 0x30,
-	0x6b, 0x14, 0xa0, 0x9f, 0xd2, 0xe0, 0xd2, 0x4e, 0x77, 0x93, 0xf8, 0x2e, 0x09, 0x49, 0x50, 0x37,
-	0x82, 0xed, 0x4d, 0xcf, 0xf0, 0x39, 0x8a, 0xf1, 0x67, 0xef, 0xcc, 0x9d, 0x7c, 0xff, 0xcd, 0xdd,
-	0xcb, 0xa2, 0xe3, 0x63, 0xca, 0x01, 0xe0, 0x3c, 0xe2, 0x68, 0x17, 0x26, 0xdc, 0x96, 0xed, 0xee,
-	0x35, 0xdc, 0x96, 0x4f, 0x82, 0x80, 0xcd, 0xcb, 0xf8, 0xb3, 0x1f, 0x2b, 0xd3, 0x99, 0x55, 0x05,
-	0xcf, 0xc2, 0xf4, 0xe1, 0x41, 0x75, 0x42, 0x2d, 0xc1, 0x09, 0x3a, 0xfa, 0x5f, 0x69, 0x70, 0x61,
-	0xde, 0x6a, 0xdb, 0x41, 0x60, 0x7b, 0xee, 0x9a, 0xd3, 0x6d, 0xd9, 0x2e, 0xba, 0x09, 0x83, 0xae,
-	0xd1, 0x26, 0x6c, 0x42, 0xc6, 0x16, 0x26, 0xc4, 0x9c, 0x0e, 0xae, 0x1a, 0x6d, 0x82, 0x19, 0x04,
-	0xbd, 0x04, 0xc3, 0xa6, 0xe7, 0x6e, 0xd9, 0x2d, 0xd1, 0xcf, 0x6f, 0x9d, 0xe3, 0x3b, 0x61, 0x4e,
-	0xdd, 0x09, 0xac, 0x7b, 0x62, 0x07, 0xcd, 0x61, 0xe3, 0xc1, 0xe2, 0x5e, 0x48, 0x5c, 0x4a, 0x66,
-	0x01, 0x0e, 0x0f, 0xaa, 0xc3, 0x35, 0x86, 0x00, 0x0b, 0x44, 0xe8, 0x49, 0x18, 0xb5, 0xec, 0x80,
-	0x7f, 0xcc, 0x01, 0xf6, 0x31, 0x27, 0x0e, 0x0f, 0xaa, 0xa3, 0x75, 0x51, 0x86, 0x25, 0x14, 0x2d,
-	0xc3, 0x65, 0x3a, 0x83, 0xbc, 0x5d, 0x93, 0x98, 0x3e, 0x09, 0x69, 0xd7, 0x66, 0x06, 0x59, 0x77,
-	0x67, 0x0e, 0x0f, 0xaa, 0x97, 0xef, 0xe5, 0xc0, 0x71, 0x6e, 0x2b, 0x7d, 0x09, 0x46, 0xe7, 0x1d,
-	0xe2, 0xd3, 0x05, 0x86, 0x6e, 0xc3, 0x14, 0x69, 0x1b, 0xb6, 0x83, 0x89, 0x49, 0xec, 0x5d, 0xe2,
-	0x07, 0x33, 0xda, 0xcd, 0x81, 0x27, 0xc7, 0x16, 0xd0, 0xe1, 0x41, 0x75, 0x6a, 0x31, 0x01, 0xc1,
-	0xa9, 0x9a, 0xfa, 0xc7, 0x35, 0x18, 0x9f, 0xef, 0x5a, 0x76, 0xc8, 0xc7, 0x85, 0x7c, 0x18, 0x37,
-	0xe8, 0xcf, 0x35, 0xcf, 0xb1, 0xcd, 0x7d, 0xb1, 0xb8, 0x5e, 0x2c, 0xf3, 0x3d, 0xe7, 0x63, 0x34,
-	0x0b, 0x17, 0x0e, 0x0f, 0xaa, 0xe3, 0x4a, 0x01, 0x56, 0x89, 0xe8, 0xdb, 0xa0, 0xc2, 0xd0, 0x77,
-	0xc3, 0x04, 0x1f, 0xee, 0x8a, 0xd1, 0xc1, 0x64, 0x4b, 0xf4, 0xe1, 0x71, 0xe5, 0x5b, 0x45, 0x84,
-	0xe6, 0xee, 0x6f, 0xbe, 0x41, 0xcc, 0x10, 0x93, 0x2d, 0xe2, 0x13, 0xd7, 0x24, 0x7c, 0xd9, 0xd4,
-	0x94, 0xc6, 0x38, 0x81, 0x4a, 0xff, 0x53, 0xca, 0xc4, 0x76, 0x0d, 0xdb, 0x31, 0x36, 0x6d, 0xc7,
-	0x0e, 0xf7, 0x5f, 0xf3, 0x5c, 0x72, 0x8c, 0x75, 0xb3, 0x01, 0x8f, 0x74, 0x5d, 0x83, 0xb7, 0x73,
-	0xc8, 0x0a, 0x5f, 0x29, 0xeb, 0xfb, 0x1d, 0x42, 0x17, 0x3c, 0x9d, 0xe9, 0xeb, 0x87, 0x07, 0xd5,
-	0x47, 0x36, 0xf2, 0xab, 0xe0, 0xa2, 0xb6, 0x94, 0x5f, 0x29, 0xa0, 0x97, 0x3d, 0xa7, 0xdb, 0x16,
-	0x58, 0x07, 0x18, 0x56, 0xc6, 0xaf, 0x36, 0x72, 0x6b, 0xe0, 0x82, 0x96, 0xfa, 0x17, 0x2a, 0x30,
-	0xb1, 0x60, 0x98, 0x3b, 0xdd, 0xce, 0x42, 0xd7, 0xdc, 0x21, 0x21, 0xfa, 0x7e, 0x18, 0xa5, 0x07,
-	0x8e, 0x65, 0x84, 0x86, 0x98, 0xc9, 0x6f, 0x2b, 0x5c, 0xf5, 0xec, 0x23, 0xd2, 0xda, 0xf1, 0xdc,
-	0xae, 0x90, 0xd0, 0x58, 0x40, 0x62, 0x4e, 0x20, 0x2e, 0xc3, 0x12, 0x2b, 0xda, 0x82, 0xc1, 0xa0,
-	0x43, 0x4c, 0xb1, 0xa7, 0xea, 0x65, 0xd6, 0x8a, 0xda, 0xe3, 0x66, 0x87, 0x98, 0xf1, 0x57, 0xa0,
-	0xbf, 0x30, 0xc3, 0x8f, 0x5c, 0x18, 0x0e, 0x42, 0x23, 0xec, 0x06, 0x6c, 0xa3, 0x8d, 0x3f, 0xbb,
-	0xd4, 0x37, 0x25, 0x86, 0x6d, 0x61, 0x4a, 0xd0, 0x1a, 0xe6, 0xbf, 0xb1, 0xa0, 0xa2, 0xff, 0x07,
-	0x0d, 0xa6, 0xd5, 0xea, 0xcb, 0x76, 0x10, 0xa2, 0xef, 0xcd, 0x4c, 0xe7, 0xdc, 0xf1, 0xa6, 0x93,
-	0xb6, 0x66, 0x93, 0x39, 0x2d, 0xc8, 0x8d, 0x46, 0x25, 0xca, 0x54, 0x12, 0x18, 0xb2, 0x43, 0xd2,
-	0xe6, 0xcb, 0xaa, 0x24, 0x1f, 0x55, 0xbb, 0xbc, 0x30, 0x29, 0x88, 0x0d, 0x35, 0x28, 0x5a, 0xcc,
-	0xb1, 0xeb, 0xdf, 0x0f, 0x97, 0xd5, 0x5a, 0x6b, 0xbe, 0xb7, 0x6b, 0x5b, 0xc4, 0xa7, 0x3b, 0x21,
-	0xdc, 0xef, 0x64, 0x76, 0x02, 0x5d, 0x59, 0x98, 0x41, 0xd0, 0xfb, 0x61, 0xd8, 0x27, 0x2d, 0xdb,
-	0x73, 0xd9, 0xd7, 0x1e, 0x8b, 0xe7, 0x0e, 0xb3, 0x52, 0x2c, 0xa0, 0xfa, 0xff, 0xaa, 0x24, 0xe7,
-	0x8e, 0x7e, 0x46, 0xb4, 0x0b, 0xa3, 0x1d, 0x41, 0x4a, 0xcc, 0xdd, 0xdd, 0x7e, 0x07, 0x18, 0x75,
-	0x3d, 0x9e, 0xd5, 0xa8, 0x04, 0x4b, 0x5a, 0xc8, 0x86, 0xa9, 0xe8, 0xff, 0x5a, 0x1f, 0xec, 0x9f,
-	0xb1, 0xd3, 0xb5, 0x04, 0x22, 0x9c, 0x42, 0x8c, 0xd6, 0x61, 0x2c, 0x60, 0x4c, 0x9a, 0x32, 0xae,
-	0x81, 0x62, 0xc6, 0xd5, 0x8c, 0x2a, 0x09, 0xc6, 0x75, 0x51, 0x74, 0x7f, 0x4c, 0x02, 0x70, 0x8c,
-	0x88, 0x1e, 0x32, 0x01, 0x21, 0x96, 0x72, 0x5c, 0xb0, 0x43, 0xa6, 0x29, 0xca, 0xb0, 0x84, 0xea,
-	0x9f, 0x1f, 0x04, 0x94, 0x5d, 0xe2, 0xea, 0x0c, 0xf0, 0x12, 0x31, 0xff, 0xfd, 0xcc, 0x80, 0xd8,
-	0x2d, 0x29, 0xc4, 0xe8, 0x2d, 0x98, 0x74, 0x8c, 0x20, 0xbc, 0xdf, 0xa1, 0xd2, 0x63, 0xb4, 0x50,
-	0xc6, 0x9f, 0x9d, 0x2f, 0xf3, 0xa5, 0x97, 0x55, 0x44, 0x0b, 0x17, 0x0f, 0x0f, 0xaa, 0x93, 0x89,
-	0x22, 0x9c, 0x24, 0x85, 0xde, 0x80, 0x31, 0x5a, 0xb0, 0xe8, 0xfb, 0x9e, 0x2f, 0x66, 0xff, 0x85,
-	0xb2, 0x74, 0x19, 0x12, 0x2e, 0xcd, 0xca, 0x9f, 0x38, 0x46, 0x8f, 0xbe, 0x0b, 0x90, 0xb7, 0x19,
-	0x50, 0x01, 0xd4, 0xba, 0xc3, 0x45, 0x65, 0x3a, 0x58, 0xfa, 0x75, 0x06, 0x16, 0x66, 0xc5, 0xd7,
-	0x44, 0xf7, 0x33, 0x35, 0x70, 0x4e, 0x2b, 0xb4, 0x03, 0x48, 0x8a, 0xdb, 0x72, 0x01, 0xcc, 0x0c,
-	0x1d, 0x7f, 0xf9, 0x5c, 0xa5, 0xc4, 0xee, 0x64, 0x50, 0xe0, 0x1c, 0xb4, 0xfa, 0xef, 0x54, 0x60,
-	0x9c, 0x2f, 0x91, 0x45, 0x37, 0xf4, 0xf7, 0xcf, 0xe1, 0x80, 0x20, 0x89, 0x03, 0xa2, 0x56, 0x7e,
-	0xcf, 0xb3, 0x0e, 0x17, 0x9e, 0x0f, 0xed, 0xd4, 0xf9, 0xb0, 0xd8, 0x2f, 0xa1, 0xde, 0xc7, 0xc3,
-	0xbf, 0xd7, 0xe0, 0x82, 0x52, 0xfb, 0x1c, 0x4e, 0x07, 0x2b, 0x79, 0x3a, 0xbc, 0xd8, 0xe7, 0xf8,
-	0x0a, 0x0e, 0x07, 0x2f, 0x31, 0x2c, 0xc6, 0xb8, 0x9f, 0x05, 0xd8, 0x64, 0xec, 0x64, 0x35, 0x96,
-	0x93, 0xe4, 0x27, 0x5f, 0x90, 0x10, 0xac, 0xd4, 0x4a, 0xf0, 0xac, 0x4a, 0x4f, 0x9e, 0xf5, 0x5f,
-	0x07, 0xe0, 0x62, 0x66, 0xda, 0xb3, 0x7c, 0x44, 0xfb, 0x06, 0xf1, 0x91, 0xca, 0x37, 0x82, 0x8f,
-	0x0c, 0x94, 0xe2, 0x23, 0xc7, 0x3e, 0x27, 0x90, 0x0f, 0xa8, 0x6d, 0xb7, 0x78, 0xb3, 0x66, 0x68,
-	0xf8, 0xe1, 0xba, 0xdd, 0x26, 0x82, 0xe3, 0x7c, 0xcb, 0xf1, 0x96, 0x2c, 0x6d, 0xc1, 0x19, 0xcf,
-	0x4a, 0x06, 0x13, 0xce, 0xc1, 0xae, 0x7f, 0x71, 0x10, 0xa0, 0x36, 0x8f, 0xbd, 0x90, 0x77, 0xf6,
-	0x45, 0x18, 0xea, 0x6c, 0x1b, 0x41, 0xb4, 0x9e, 0x9e, 0x8a, 0x16, 0xe3, 0x1a, 0x2d, 0x7c, 0x78,
-	0x50, 0x9d, 0xa9, 0xf9, 0xc4, 0x22, 0x6e, 0x68, 0x1b, 0x4e, 0x10, 0x35, 0x62, 0x30, 0xcc, 0xdb,
-	0xd1, 0x31, 0xd0, 0x69, 0xac, 0x79, 0xed, 0x8e, 0x43, 0x28, 0x94, 0x8d, 0xa1, 0x52, 0x6e, 0x0c,
-	0xcb, 0x19, 0x4c, 0x38, 0x07, 0x7b, 0x44, 0xb3, 0xe1, 0xda, 0xa1, 0x6d, 0x48, 0x9a, 0x03, 0xe5,
-	0x69, 0x26, 0x31, 0xe1, 0x1c, 0xec, 0xe8, 0x1d, 0x0d, 0x66, 0x93, 0xc5, 0x4b, 0xb6, 0x6b, 0x07,
-	0xdb, 0xc4, 0x62, 0xc4, 0x07, 0x4f, 0x4c, 0xfc, 0xc6, 0xe1, 0x41, 0x75, 0x76, 0xb9, 0x10, 0x23,
-	0xee, 0x41, 0x0d, 0x7d, 0x5a, 0x83, 0xeb, 0xa9, 0x79, 0xf1, 0xed, 0x56, 0x8b, 0xf8, 0xa2, 0x37,
-	0x27, 0x5f, 0x42, 0xd5, 0xc3, 0x83, 0xea, 0xf5, 0xe5, 0x62, 0x94, 0xb8, 0x17, 0x3d, 0xfd, 0xb7,
-	0x35, 0x18, 0xa8, 0xe1, 0x06, 0x7a, 0x3a, 0x71, 0x89, 0x7b, 0x44, 0xbd, 0xc4, 0x3d, 0x3c, 0xa8,
-	0x8e, 0xd4, 0x70, 0x43, 0xb9, 0xcf, 0x7d, 0x5a, 0x83, 0x8b, 0xa6, 0xe7, 0x86, 0x06, 0xed, 0x17,
-	0xe6, 0x92, 0x4e, 0xc4, 0x55, 0x4b, 0xdd, 0x5f, 0x6a, 0x29, 0x64, 0x0b, 0xd7, 0x44, 0x07, 0x2e,
-	0xa6, 0x21, 0x01, 0xce, 0x52, 0xd6, 0xbf, 0xac, 0xc1, 0x44, 0xcd, 0xf1, 0xba, 0xd6, 0x9a, 0xef,
-	0x6d, 0xd9, 0x0e, 0x79, 0x77, 0x5c, 0xda, 0xd4, 0x1e, 0x17, 0x1d, 0xca, 0xec, 0x12, 0xa5, 0x56,
-	0x7c, 0x97, 0x5c, 0xa2, 0xd4, 0x2e, 0x17, 0x9c, 0x93, 0x3f, 0x3d, 0x92, 0x1c, 0x19, 0x3b, 0x29,
-	0x9f, 0x84, 0x51, 0xd3, 0x58, 0xe8, 0xba, 0x96, 0x23, 0x6f, 0x51, 0xb4, 0x97, 0xb5, 0x79, 0x5e,
-	0x86, 0x25, 0x14, 0xbd, 0x05, 0x10, 0x2b, 0xd4, 0xc4, 0x67, 0x58, 0xea, 0x4f, 0x89, 0xd7, 0x24,
-	0x61, 0x68, 0xbb, 0xad, 0x20, 0xfe, 0xf4, 0x31, 0x0c, 0x2b, 0xd4, 0xd0, 0x0f, 0xc2, 0xa4, 0x98,
-	0xe4, 0x46, 0xdb, 0x68, 0x09, 0x7d, 0x43, 0xc9, 0x99, 0x5a, 0x51, 0x10, 0x2d, 0x5c, 0x11, 0x84,
-	0x27, 0xd5, 0xd2, 0x00, 0x27, 0xa9, 0xa1, 0x7d, 0x98, 0x68, 0xab, 0x3a, 0x94, 0xc1, 0xf2, 0xe2,
-	0x8c, 0xa2, 0x4f, 0x59, 0xb8, 0x2c, 0x88, 0x4f, 0x24, 0xb4, 0x2f, 0x09, 0x52, 0x39, 0x57, 0xc1,
-	0xa1, 0xb3, 0xba, 0x0a, 0x12, 0x18, 0xe1, 0x97, 0xe1, 0x60, 0x66, 0x98, 0x0d, 0xf0, 0x76, 0x99,
-	0x01, 0xf2, 0x7b, 0x75, 0xac, 0x21, 0xe6, 0xbf, 0x03, 0x1c, 0xe1, 0x46, 0xbb, 0x30, 0x41, 0x4f,
-	0xf5, 0x26, 0x71, 0x88, 0x19, 0x7a, 0xfe, 0xcc, 0x48, 0x79, 0x0d, 0x6c, 0x53, 0xc1, 0xc3, 0x55,
-	0x69, 0x6a, 0x09, 0x4e, 0xd0, 0x91, 0xba, 0x82, 0xd1, 0x42, 0x5d, 0x41, 0x17, 0xc6, 0x77, 0x15,
-	0x9d, 0xd6, 0x18, 0x9b, 0x84, 0x8f, 0x96, 0xe9, 0x58, 0xac, 0xe0, 0x5a, 0xb8, 0x24, 0x08, 0x8d,
-	0xab, 0xca, 0x30, 0x95, 0x8e, 0xfe, 0xcb, 0xe3, 0x70, 0xb1, 0xe6, 0x74, 0x83, 0x90, 0xf8, 0xf3,
-	0xe2, 0x91, 0x88, 0xf8, 0xe8, 0x13, 0x1a, 0x5c, 0x65, 0xff, 0xd6, 0xbd, 0x07, 0x6e, 0x9d, 0x38,
-	0xc6, 0xfe, 0xfc, 0x16, 0xad, 0x61, 0x59, 0x27, 0xe3, 0x40, 0xf5, 0xae, 0x90, 0x22, 0x99, 0x72,
-	0xae, 0x99, 0x8b, 0x11, 0x17, 0x50, 0x42, 0x3f, 0xae, 0xc1, 0xb5, 0x1c, 0x50, 0x9d, 0x38, 0x24,
-	0x8c, 0x24, 0x97, 0x93, 0xf6, 0xe3, 0xb1, 0xc3, 0x83, 0xea, 0xb5, 0x66, 0x11, 0x52, 0x5c, 0x4c,
-	0x0f, 0xfd, 0x7d, 0x0d, 0x66, 0x73, 0xa0, 0x4b, 0x86, 0xed, 0x74, 0xfd, 0x48, 0xa8, 0x39, 0x69,
-	0x77, 0x98, 0x6c, 0xd1, 0x2c, 0xc4, 0x8a, 0x7b, 0x50, 0x44, 0x3f, 0x04, 0x57, 0x24, 0x74, 0xc3,
-	0x75, 0x09, 0xb1, 0x12, 0x22, 0xce, 0x49, 0xbb, 0x72, 0xed, 0xf0, 0xa0, 0x7a, 0xa5, 0x99, 0x87,
-	0x10, 0xe7, 0xd3, 0x41, 0x2d, 0x78, 0x2c, 0x06, 0x84, 0xb6, 0x63, 0xbf, 0xc5, 0xa5, 0xb0, 0x6d,
-	0x9f, 0x04, 0xdb, 0x9e, 0x63, 0x31, 0x66, 0xa1, 0x2d, 0xbc, 0xf7, 0xf0, 0xa0, 0xfa, 0x58, 0xb3,
-	0x57, 0x45, 0xdc, 0x1b, 0x0f, 0xb2, 0x60, 0x22, 0x30, 0x0d, 0xb7, 0xe1, 0x86, 0xc4, 0xdf, 0x35,
-	0x9c, 0x99, 0xe1, 0x52, 0x03, 0xe4, 0x5b, 0x54, 0xc1, 0x83, 0x13, 0x58, 0xd1, 0x87, 0x61, 0x94,
-	0xec, 0x75, 0x0c, 0xd7, 0x22, 0x9c, 0x2d, 0x8c, 0x2d, 0x3c, 0x4a, 0x0f, 0xa3, 0x45, 0x51, 0xf6,
-	0xf0, 0xa0, 0x3a, 0x11, 0xfd, 0xbf, 0xe2, 0x59, 0x04, 0xcb, 0xda, 0xe8, 0x07, 0xe0, 0x32, 0x7b,
-	0x0f, 0xb3, 0x08, 0x63, 0x72, 0x41, 0x24, 0xe8, 0x8e, 0x96, 0xea, 0x27, 0x7b, 0xdb, 0x58, 0xc9,
-	0xc1, 0x87, 0x73, 0xa9, 0xd0, 0xcf, 0xd0, 0x36, 0xf6, 0xee, 0xf8, 0x86, 0x49, 0xb6, 0xba, 0xce,
-	0x3a, 0xf1, 0xdb, 0xb6, 0xcb, 0xef, 0x12, 0xc4, 0xf4, 0x5c, 0x8b, 0xb2, 0x12, 0xed, 0xc9, 0x21,
-	0xfe, 0x19, 0x56, 0x7a, 0x55, 0xc4, 0xbd, 0xf1, 0xa0, 0x0f, 0xc2, 0x84, 0xdd, 0x72, 0x3d, 0x9f,
-	0xac, 0x1b, 0xb6, 0x1b, 0x06, 0x33, 0xc0, 0xd4, 0xee, 0x6c, 0x5a, 0x1b, 0x4a, 0x39, 0x4e, 0xd4,
-	0x42, 0xbb, 0x80, 0x5c, 0xf2, 0x60, 0xcd, 0xb3, 0xd8, 0x12, 0xd8, 0xe8, 0xb0, 0x85, 0x3c, 0x33,
-	0x5e, 0x6a, 0x6a, 0xd8, 0x3d, 0x60, 0x35, 0x83, 0x0d, 0xe7, 0x50, 0x40, 0x4b, 0x80, 0xda, 0xc6,
-	0xde, 0x62, 0xbb, 0x13, 0xee, 0x2f, 0x74, 0x9d, 0x1d, 0xc1, 0x35, 0x26, 0xd8, 0x5c, 0xf0, 0x7b,
-	0x58, 0x06, 0x8a, 0x73, 0x5a, 0x20, 0x03, 0xae, 0xf3, 0xf1, 0xd4, 0x0d, 0xd2, 0xf6, 0xdc, 0x80,
-	0x84, 0x81, 0xb2, 0x48, 0x67, 0x26, 0xd9, 0x2b, 0x16, 0x93, 0xca, 0x1b, 0xc5, 0xd5, 0x70, 0x2f,
-	0x1c, 0xc9, 0x77, 0xe1, 0xa9, 0xde, 0xef, 0xc2, 0xfa, 0xc1, 0x00, 0x8c, 0xd5, 0x3c, 0xd7, 0xb2,
-	0x59, 0xd3, 0x67, 0x12, 0x3a, 0xe8, 0xc7, 0xd4, 0x73, 0xe5, 0xe1, 0x41, 0x75, 0x52, 0x56, 0x54,
-	0x0e, 0x9a, 0xe7, 0xa5, 0xe2, 0x87, 0x2b, 0x1a, 0xde, 0x9b, 0xd4, 0xd8, 0x3c, 0x3c, 0xa8, 0x5e,
-	0x90, 0xcd, 0x92, 0x4a, 0x1c, 0xfa, 0x2d, 0xe9, 0xed, 0x62, 0xdd, 0x37, 0xdc, 0xc0, 0xee, 0xe3,
-	0x3e, 0x27, 0x6f, 0xea, 0xcb, 0x19, 0x6c, 0x38, 0x87, 0x02, 0x7a, 0x03, 0xa6, 0x68, 0xe9, 0x46,
-	0xc7, 0x32, 0x42, 0x52, 0xf2, 0x1a, 0x77, 0x55, 0xd0, 0x9c, 0x5a, 0x4e, 0x60, 0xc2, 0x29, 0xcc,
-	0x5c, 0x67, 0x6f, 0x04, 0x9e, 0xcb, 0xd8, 0x57, 0x42, 0x67, 0x4f, 0x4b, 0xb1, 0x80, 0xa2, 0xa7,
-	0x60, 0xa4, 0x4d, 0x82, 0xc0, 0x68, 0x11, 0xc6, 0x8f, 0xc6, 0x62, 0xa1, 0x63, 0x85, 0x17, 0xe3,
-	0x08, 0x8e, 0x3e, 0x00, 0x43, 0xa6, 0x67, 0x91, 0x60, 0x66, 0x84, 0xed, 0x18, 0xba, 0xfa, 0x86,
-	0x6a, 0xb4, 0xe0, 0xe1, 0x41, 0x75, 0x8c, 0xe9, 0x35, 0xe8, 0x2f, 0xcc, 0x2b, 0xe9, 0x3f, 0x47,
-	0xef, 0x00, 0xa9, 0x4b, 0xcf, 0x31, 0xde, 0x1a, 0xce, 0x4f, 0x6d, 0xaf, 0x7f, 0x86, 0x5e, 0xc0,
-	0x3c, 0x37, 0xf4, 0x3d, 0x67, 0xcd, 0x31, 0x5c, 0x82, 0x7e, 0x54, 0x83, 0xe9, 0x6d, 0xbb, 0xb5,
-	0xad, 0x3e, 0x16, 0x0a, 0x41, 0xa1, 0xd4, 0x5d, 0xe9, 0x6e, 0x0a, 0xd7, 0xc2, 0xe5, 0xc3, 0x83,
-	0xea, 0x74, 0xba, 0x14, 0x67, 0x68, 0xea, 0x9f, 0xaa, 0xc0, 0x65, 0xd1, 0x33, 0x87, 0x9e, 0xdc,
-	0x1d, 0xc7, 0xdb, 0x6f, 0x13, 0xf7, 0x3c, 0xde, 0xf5, 0xa2, 0x2f, 0x54, 0x29, 0xfc, 0x42, 0xed,
-	0xcc, 0x17, 0x1a, 0x28, 0xf3, 0x85, 0xe4, 0x42, 0x3e, 0xe2, 0x2b, 0xfd, 0x99, 0x06, 0x33, 0x79,
-	0x73, 0x71, 0x0e, 0x77, 0xca, 0x76, 0xf2, 0x4e, 0x79, 0xb7, 0xac, 0x92, 0x20, 0xdd, 0xf5, 0x82,
-	0xbb, 0xe5, 0xd7, 0x2b, 0x70, 0x35, 0xae, 0xde, 0x70, 0x83, 0xd0, 0x70, 0x1c, 0xce, 0x5a, 0xcf,
-	0xfe, 0xbb, 0x77, 0x12, 0xaa, 0x81, 0xd5, 0xfe, 0x86, 0xaa, 0xf6, 0xbd, 0x50, 0x73, 0xbf, 0x97,
-	0xd2, 0xdc, 0xaf, 0x9d, 0x22, 0xcd, 0xde, 0x4a, 0xfc, 0xff, 0xae, 0xc1, 0x6c, 0x7e, 0xc3, 0x73,
-	0x58, 0x54, 0x5e, 0x72, 0x51, 0x7d, 0xd7, 0xe9, 0x8d, 0xba, 0x60, 0x59, 0xfd, 0x6a, 0xa5, 0x68,
-	0xb4, 0x4c, 0x79, 0xb1, 0x05, 0x17, 0xe8, 0xad, 0x32, 0x08, 0x85, 0x8a, 0xf9, 0x64, 0xb6, 0x17,
-	0x91, 0xce, 0xed, 0x02, 0x4e, 0xe2, 0xc0, 0x69, 0xa4, 0x68, 0x15, 0x46, 0xe8, 0x55, 0x92, 0xe2,
-	0xaf, 0x1c, 0x1f, 0xbf, 0x3c, 0x8d, 0x9a, 0xbc, 0x2d, 0x8e, 0x90, 0xa0, 0xef, 0x85, 0x49, 0x4b,
-	0xee, 0xa8, 0x23, 0x1e, 0x5e, 0xd3, 0x58, 0xd9, 0x63, 0x40, 0x5d, 0x6d, 0x8d, 0x93, 0xc8, 0xf4,
-	0xbf, 0xd4, 0xe0, 0xd1, 0x5e, 0x6b, 0x0b, 0xbd, 0x09, 0x60, 0x46, 0xe2, 0x05, 0x37, 0xbd, 0x29,
-	0xf9, 0x5c, 0x20, 0x85, 0x94, 0x78, 0x83, 0xca, 0xa2, 0x00, 0x2b, 0x44, 0x72, 0xde, 0x73, 0x2b,
-	0x67, 0xf4, 0x9e, 0xab, 0xff, 0x0f, 0x4d, 0x65, 0x45, 0xea, 0xb7, 0x7d, 0xb7, 0xb1, 0x22, 0xb5,
-	0xef, 0x85, 0xfa, 0xca, 0x2f, 0x55, 0xe0, 0x66, 0x7e, 0x13, 0xe5, 0xec, 0xfd, 0x18, 0x0c, 0x77,
-	0xb8, 0x7d, 0xd4, 0x00, 0x3b, 0x1b, 0x9f, 0xa4, 0x9c, 0x85, 0x5b, 0x2f, 0x3d, 0x3c, 0xa8, 0xce,
-	0xe6, 0x31, 0x7a, 0x61, 0xf7, 0x24, 0xda, 0x21, 0x3b, 0xa5, 0xb5, 0xe1, 0xd2, 0xdf, 0xb7, 0x1f,
-	0x93, 0xb9, 0x18, 0x9b, 0xc4, 0x39, 0xb6, 0xa2, 0xe6, 0xe3, 0x1a, 0x4c, 0x25, 0x56, 0x74, 0x30,
-	0x33, 0xc4, 0xd6, 0x68, 0xa9, 0xa7, 0xb4, 0xc4, 0x56, 0x89, 0x4f, 0xee, 0x44, 0x71, 0x80, 0x53,
-	0x04, 0x53, 0x6c, 0x56, 0x9d, 0xd5, 0x77, 0x1d, 0x9b, 0x55, 0x3b, 0x5f, 0xc0, 0x66, 0x7f, 0xb6,
-	0x52, 0x34, 0x5a, 0xc6, 0x66, 0x1f, 0xc0, 0x58, 0x64, 0x39, 0x1c, 0xb1, 0x8b, 0xa5, 0x7e, 0xfb,
-	0xc4, 0xd1, 0xc5, 0x66, 0x24, 0x51, 0x49, 0x80, 0x63, 0x5a, 0xe8, 0x47, 0x34, 0x80, 0xf8, 0xc3,
-	0x88, 0x4d, 0xb5, 0x7e, 0x7a, 0xd3, 0xa1, 0x88, 0x35, 0x53, 0x74, 0x4b, 0x2b, 0x8b, 0x42, 0xa1,
-	0xab, 0xff, 0x9f, 0x01, 0x40, 0xd9, 0xbe, 0x53, 0x71, 0x73, 0xc7, 0x76, 0xad, 0xf4, 0x85, 0xe0,
-	0x9e, 0xed, 0x5a, 0x98, 0x41, 0x8e, 0x21, 0x90, 0xbe, 0x00, 0x17, 0x5a, 0x8e, 0xb7, 0x69, 0x38,
-	0xce, 0xbe, 0x30, 0xa5, 0x15, 0x46, 0x99, 0x97, 0xe8, 0xc1, 0x74, 0x27, 0x09, 0xc2, 0xe9, 0xba,
-	0xa8, 0x03, 0xd3, 0x3e, 0x31, 0x3d, 0xd7, 0xb4, 0x1d, 0x76, 0x75, 0xf2, 0xba, 0x61, 0x49, 0xdd,
-	0x13, 0x13, 0xef, 0x71, 0x0a, 0x17, 0xce, 0x60, 0x47, 0xef, 0x83, 0x91, 0x8e, 0x6f, 0xb7, 0x0d,
-	0x7f, 0x9f, 0x5d, 0xce, 0x46, 0x17, 0xc6, 0xe9, 0x09, 0xb7, 0xc6, 0x8b, 0x70, 0x04, 0x43, 0x3f,
-	0x00, 0x63, 0x8e, 0xbd, 0x45, 0xcc, 0x7d, 0xd3, 0x21, 0x42, 0x59, 0x74, 0xff, 0x74, 0x96, 0xcc,
-	0x72, 0x84, 0x56, 0x3c, 0x51, 0x47, 0x3f, 0x71, 0x4c, 0x10, 0x35, 0xe0, 0xd2, 0x03, 0xcf, 0xdf,
-	0x21, 0xbe, 0x43, 0x82, 0xa0, 0xd9, 0xed, 0x74, 0x3c, 0x3f, 0x24, 0x16, 0x53, 0x29, 0x8d, 0x72,
-	0x7b, 0xe1, 0x57, 0xb2, 0x60, 0x9c, 0xd7, 0x46, 0x7f, 0xa7, 0x02, 0xd7, 0x7b, 0x74, 0x02, 0x61,
-	0xba, 0x37, 0xc4, 0x1c, 0x89, 0x95, 0xf0, 0x41, 0xbe, 0x9e, 0x45, 0xe1, 0xc3, 0x83, 0xea, 0xe3,
-	0x3d, 0x10, 0x34, 0xe9, 0x52, 0x24, 0xad, 0x7d, 0x1c, 0xa3, 0x41, 0x0d, 0x18, 0xb6, 0x62, 0x0d,
-	0xeb, 0xd8, 0xc2, 0x33, 0x94, 0x5b, 0x73, 0x5d, 0xc8, 0x71, 0xb1, 0x09, 0x04, 0x68, 0x19, 0x46,
-	0xf8, 0xc3, 0x36, 0x11, 0x9c, 0xff, 0x59, 0x76, 0x3d, 0xe6, 0x45, 0xc7, 0x45, 0x16, 0xa1, 0xd0,
-	0xff, 0xb7, 0x06, 0x23, 0x35, 0xcf, 0x27, 0xf5, 0xd5, 0x26, 0xda, 0x87, 0x71, 0xc5, 0xa5, 0x41,
-	0x70, 0xc1, 0x92, 0x6c, 0x81, 0x61, 0x9c, 0x8f, 0xb1, 0x45, 0xe6, 0xb7, 0xb2, 0x00, 0xab, 0xb4,
-	0xd0, 0x9b, 0x74, 0xce, 0x1f, 0xf8, 0x76, 0x48, 0x09, 0xf7, 0xf3, 0x1e, 0xc8, 0x09, 0xe3, 0x08,
-	0x17, 0x5f, 0x51, 0xf2, 0x27, 0x8e, 0xa9, 0xe8, 0x6b, 0x94, 0x03, 0xa4, 0xbb, 0x89, 0x6e, 0xc3,
-	0x60, 0xdb, 0xb3, 0xa2, 0xef, 0xfe, 0xfe, 0x68, 0x7f, 0xaf, 0x78, 0x16, 0x9d, 0xdb, 0xab, 0xd9,
-	0x16, 0x4c, 0x6b, 0xc9, 0xda, 0xe8, 0xab, 0x30, 0x9d, 0xa6, 0x8f, 0x6e, 0xc3, 0x94, 0xe9, 0xb5,
-	0xdb, 0x9e, 0xdb, 0xec, 0x6e, 0x6d, 0xd9, 0x7b, 0x24, 0x61, 0x17, 0x5d, 0x4b, 0x40, 0x70, 0xaa,
-	0xa6, 0xfe, 0x33, 0x1a, 0x0c, 0xd0, 0xef, 0xa2, 0xc3, 0xb0, 0xe5, 0xb5, 0x0d, 0xdb, 0x15, 0xbd,
-	0x62, 0x36, 0xe0, 0x75, 0x56, 0x82, 0x05, 0x04, 0x75, 0x60, 0x2c, 0x12, 0x9a, 0xfa, 0xb2, 0xcd,
-	0xa9, 0xaf, 0x36, 0xa5, 0x3d, 0xa3, 0xe4, 0xe4, 0x51, 0x49, 0x80, 0x63, 0x22, 0xba, 0x01, 0x17,
-	0xeb, 0xab, 0xcd, 0x86, 0x6b, 0x3a, 0x5d, 0x8b, 0x2c, 0xee, 0xb1, 0x3f, 0x94, 0x97, 0xd8, 0xbc,
-	0x44, 0x8c, 0x93, 0xf1, 0x12, 0x51, 0x09, 0x47, 0x30, 0x5a, 0x8d, 0xf0, 0x16, 0xc2, 0x78, 0x99,
-	0x55, 0x13, 0x48, 0x70, 0x04, 0xd3, 0xbf, 0x5c, 0x81, 0x71, 0xa5, 0x43, 0xc8, 0x81, 0x11, 0x3e,
-	0xdc, 0xc8, 0x76, 0x70, 0xb1, 0xe4, 0x10, 0x93, 0xbd, 0xe6, 0xd4, 0xf9, 0x84, 0x06, 0x38, 0x22,
-	0xa1, 0xf2, 0xc5, 0x4a, 0x0f, 0xbe, 0x38, 0x07, 0x10, 0xc4, 0x96, 0xf4, 0x7c, 0x4b, 0xb2, 0xa3,
-	0x47, 0xb1, 0x9f, 0x57, 0x6a, 0xa0, 0x47, 0xc5, 0x09, 0xc2, 0x8d, 0x63, 0x46, 0x53, 0xa7, 0xc7,
-	0x16, 0x0c, 0xbd, 0xe5, 0xb9, 0x24, 0x10, 0x6f, 0x82, 0xa7, 0x34, 0xc0, 0x31, 0x2a, 0x1f, 0xbc,
-	0x46, 0xf1, 0x62, 0x8e, 0x5e, 0xff, 0x79, 0x0d, 0xa0, 0x6e, 0x84, 0x06, 0x7f, 0xc2, 0x3a, 0x86,
-	0xfd, 0xf9, 0xa3, 0x89, 0x83, 0x6f, 0x34, 0x63, 0x93, 0x3b, 0x18, 0xd8, 0x6f, 0x45, 0xc3, 0x97,
-	0x02, 0x35, 0xc7, 0xde, 0xb4, 0xdf, 0x22, 0x98, 0xc1, 0xd1, 0xd3, 0x30, 0x46, 0x5c, 0xd3, 0xdf,
-	0xef, 0x50, 0xe6, 0x3d, 0xc8, 0x66, 0x95, 0xed, 0xd0, 0xc5, 0xa8, 0x10, 0xc7, 0x70, 0xfd, 0x19,
-	0x48, 0xde, 0x8a, 0x8e, 0xee, 0xa5, 0xfe, 0xd5, 0x41, 0xb8, 0xb6, 0xb8, 0x5e, 0xab, 0x0b, 0x7c,
-	0xb6, 0xe7, 0xde, 0x23, 0xfb, 0x7f, 0x63, 0xee, 0xf3, 0x37, 0xe6, 0x3e, 0xa7, 0x68, 0xee, 0xf3,
-	0x22, 0x4c, 0xc7, 0xcb, 0x4b, 0x3c, 0xb4, 0x3f, 0x9d, 0x96, 0xa7, 0xc7, 0xa2, 0x93, 0x27, 0x2b,
-	0x03, 0xeb, 0x0f, 0x35, 0x98, 0x5e, 0xdc, 0xeb, 0xd8, 0x3e, 0x73, 0x9c, 0x20, 0x3e, 0xbd, 0x07,
-	0xa3, 0xa7, 0x60, 0x64, 0x97, 0xff, 0x2b, 0x56, 0xa7, 0xd4, 0x35, 0x88, 0x1a, 0x38, 0x82, 0xa3,
-	0x2d, 0x98, 0x22, 0xac, 0x39, 0x13, 0x78, 0x8d, 0xb0, 0xcc, 0x0a, 0xe4, 0x7e, 0x39, 0x09, 0x2c,
-	0x38, 0x85, 0x15, 0x35, 0x61, 0xca, 0x74, 0x8c, 0x20, 0xb0, 0xb7, 0x6c, 0x33, 0x36, 0x09, 0x1c,
-	0x5b, 0x78, 0x9a, 0x9d, 0x5d, 0x09, 0xc8, 0xc3, 0x83, 0xea, 0x15, 0xd1, 0xcf, 0x24, 0x00, 0xa7,
-	0x50, 0xe8, 0x9f, 0xad, 0xc0, 0xe4, 0xe2, 0x5e, 0xc7, 0x0b, 0xba, 0x3e, 0x61, 0x55, 0xcf, 0xe1,
-	0x0a, 0xff, 0x14, 0x8c, 0x6c, 0x1b, 0xae, 0xe5, 0x10, 0x5f, 0xb0, 0x2f, 0x39, 0xb7, 0x77, 0x79,
-	0x31, 0x8e, 0xe0, 0xe8, 0x6d, 0x80, 0xc0, 0xdc, 0x26, 0x56, 0x97, 0x89, 0x40, 0x7c, 0x97, 0xdd,
-	0x2b, 0xc3, 0x84, 0x13, 0x63, 0x6c, 0x4a, 0x94, 0xe2, 0x68, 0x90, 0xbf, 0xb1, 0x42, 0x4e, 0xff,
-	0x8a, 0x06, 0x17, 0x13, 0xed, 0xce, 0xe1, 0x66, 0xba, 0x95, 0xbc, 0x99, 0xce, 0xf7, 0x3d, 0xd6,
-	0x82, 0x0b, 0xe9, 0x8f, 0x55, 0xe0, 0x91, 0x82, 0x39, 0xc9, 0xd8, 0x8f, 0x68, 0xe7, 0x64, 0x3f,
-	0xd2, 0x85, 0xf1, 0xd0, 0x73, 0x84, 0xe5, 0x6a, 0x34, 0x03, 0xa5, 0xac, 0x43, 0xd6, 0x25, 0x9a,
-	0xd8, 0x3a, 0x24, 0x2e, 0x0b, 0xb0, 0x4a, 0x47, 0xff, 0x6d, 0x0d, 0xc6, 0xa4, 0x02, 0xec, 0x9b,
-	0xea, 0x11, 0xea, 0xf8, 0xae, 0x84, 0xfa, 0x1f, 0x54, 0xe0, 0xaa, 0xc4, 0x1d, 0xb1, 0xb9, 0x66,
-	0x48, 0xf9, 0xc6, 0xd1, 0xb7, 0xe8, 0x47, 0xc5, 0x41, 0xae, 0x08, 0x13, 0x8a, 0xa8, 0x41, 0x05,
-	0xaf, 0xae, 0xdf, 0xf1, 0x82, 0x48, 0x9e, 0xe0, 0x82, 0x17, 0x2f, 0xc2, 0x11, 0x0c, 0xad, 0xc2,
-	0x50, 0x40, 0xe9, 0x89, 0xe3, 0xe8, 0x84, 0xb3, 0xc1, 0x44, 0x22, 0xd6, 0x5f, 0xcc, 0xd1, 0xa0,
-	0xb7, 0x55, 0x1e, 0x3e, 0x54, 0x5e, 0x4f, 0x43, 0x47, 0x62, 0x45, 0x33, 0x92, 0xe3, 0x5e, 0x93,
-	0x7b, 0x26, 0x2c, 0xc3, 0xb4, 0x30, 0x41, 0xe1, 0xcb, 0xc6, 0x35, 0x09, 0xfa, 0x70, 0x62, 0x65,
-	0x3c, 0x91, 0x7a, 0x86, 0xbe, 0x9c, 0xae, 0x1f, 0xaf, 0x18, 0x3d, 0x80, 0xd1, 0x3b, 0xa2, 0x93,
-	0x68, 0x16, 0x2a, 0x76, 0xf4, 0x2d, 0x40, 0xe0, 0xa8, 0x34, 0xea, 0xb8, 0x62, 0x5b, 0x52, 0xa0,
-	0xaa, 0x14, 0x8a, 0x7d, 0xca, 0xb1, 0x34, 0xd0, 0xfb, 0x58, 0xd2, 0xbf, 0x56, 0x81, 0xcb, 0x11,
-	0xd5, 0x68, 0x8c, 0x75, 0xf1, 0x88, 0x77, 0x84, 0x70, 0x79, 0xb4, 0x56, 0xe5, 0x3e, 0x0c, 0x32,
-	0x06, 0x58, 0xea, 0x71, 0x4f, 0x22, 0xa4, 0xdd, 0xc1, 0x0c, 0x11, 0xfa, 0x01, 0x18, 0x76, 0x8c,
-	0x4d, 0xe2, 0x44, 0xa6, 0x7f, 0xa5, 0x74, 0x50, 0x79, 0xc3, 0xe5, 0xaa, 0xd1, 0x80, 0xbb, 0x37,
-	0xc8, 0x37, 0x1f, 0x5e, 0x88, 0x05, 0xcd, 0xd9, 0xe7, 0x61, 0x5c, 0xa9, 0x86, 0xa6, 0x61, 0x60,
-	0x87, 0xf0, 0xc7, 0xdd, 0x31, 0x4c, 0xff, 0x45, 0x97, 0x61, 0x68, 0xd7, 0x70, 0xba, 0x62, 0x4a,
-	0x30, 0xff, 0x71, 0xbb, 0xf2, 0x61, 0x4d, 0xff, 0x65, 0x0d, 0xc6, 0xef, 0xda, 0x9b, 0xc4, 0xe7,
-	0x76, 0x24, 0xec, 0x2e, 0x95, 0xf0, 0xe4, 0x1e, 0xcf, 0xf3, 0xe2, 0x46, 0x7b, 0x30, 0x26, 0x4e,
-	0x1a, 0x69, 0x66, 0x7c, 0xa7, 0xdc, 0x2b, 0xb2, 0x24, 0x2d, 0x38, 0xb8, 0xea, 0x39, 0x16, 0x51,
-	0xc0, 0x31, 0x31, 0xfd, 0x6d, 0xb8, 0x94, 0xd3, 0x08, 0x55, 0xd9, 0xf6, 0xf5, 0x43, 0xb1, 0x2c,
-	0xa2, 0xfd, 0xe8, 0x87, 0x98, 0x97, 0xa3, 0x6b, 0x30, 0x40, 0x5c, 0x4b, 0xac, 0x89, 0x91, 0xc3,
-	0x83, 0xea, 0xc0, 0xa2, 0x6b, 0x61, 0x5a, 0x46, 0xd9, 0x94, 0xe3, 0x25, 0x64, 0x12, 0xc6, 0xa6,
-	0x96, 0x45, 0x19, 0x96, 0x50, 0xf6, 0xee, 0x9f, 0x7e, 0xe2, 0xa6, 0xe2, 0xed, 0xf4, 0x56, 0x6a,
-	0xf7, 0xf4, 0xf3, 0xb2, 0x9e, 0xde, 0x89, 0x0b, 0x33, 0x62, 0x42, 0x32, 0x7b, 0x1a, 0x67, 0xe8,
-	0xea, 0xbf, 0x31, 0x08, 0x8f, 0xdd, 0xf5, 0x7c, 0xfb, 0x2d, 0xcf, 0x0d, 0x0d, 0x67, 0xcd, 0xb3,
-	0x62, 0x8b, 0x41, 0xc1, 0x94, 0x3f, 0xa9, 0xc1, 0x23, 0x66, 0xa7, 0xcb, 0xc5, 0xe3, 0xc8, 0xbe,
-	0x65, 0x8d, 0xf8, 0xb6, 0x57, 0xd6, 0x70, 0x90, 0xf9, 0x0a, 0xd7, 0xd6, 0x36, 0xf2, 0x50, 0xe2,
-	0x22, 0x5a, 0xcc, 0x7e, 0xd1, 0xf2, 0x1e, 0xb8, 0xac, 0x73, 0xcd, 0x90, 0xcd, 0xe6, 0x5b, 0xf1,
-	0x47, 0x28, 0x69, 0xbf, 0x58, 0xcf, 0xc5, 0x88, 0x0b, 0x28, 0xa1, 0x1f, 0x82, 0x2b, 0x36, 0xef,
-	0x1c, 0x26, 0x86, 0x65, 0xbb, 0x24, 0x08, 0xb8, 0xf1, 0x53, 0x1f, 0x06, 0x7a, 0x8d, 0x3c, 0x84,
-	0x38, 0x9f, 0x0e, 0x7a, 0x1d, 0x20, 0xd8, 0x77, 0x4d, 0x31, 0xff, 0x43, 0xa5, 0xa8, 0x72, 0x21,
-	0x50, 0x62, 0xc1, 0x0a, 0x46, 0x7a, 0x95, 0x08, 0xe5, 0xa2, 0x1c, 0x66, 0xc6, 0x7e, 0xec, 0x2a,
-	0x11, 0xaf, 0xa1, 0x18, 0xae, 0xff, 0x0b, 0x0d, 0x46, 0x44, 0x3c, 0x02, 0xf4, 0xfe, 0x94, 0x9a,
-	0x48, 0xf2, 0x9e, 0x94, 0xaa, 0x68, 0x9f, 0xbd, 0x15, 0x0a, 0x15, 0xa1, 0x10, 0x25, 0x4a, 0xe9,
-	0x19, 0x04, 0xe1, 0x58, 0xdf, 0x98, 0x78, 0x33, 0x8c, 0x74, 0x90, 0x0a, 0x31, 0xfd, 0xf3, 0x1a,
-	0x5c, 0xcc, 0xb4, 0x3a, 0x86, 0xbc, 0x70, 0x8e, 0x66, 0x38, 0x5f, 0x1a, 0x84, 0x29, 0x66, 0xbd,
-	0xe8, 0x1a, 0x0e, 0xd7, 0xe0, 0x9c, 0xc3, 0x05, 0xe5, 0x69, 0x18, 0xb3, 0xdb, 0xed, 0x6e, 0x48,
-	0x59, 0xb5, 0x50, 0xc2, 0xb3, 0x6f, 0xde, 0x88, 0x0a, 0x71, 0x0c, 0x47, 0xae, 0x38, 0x0a, 0x39,
-	0x13, 0x5f, 0x2e, 0xf7, 0xe5, 0xd4, 0x01, 0xce, 0xd1, 0x63, 0x8b, 0x9f, 0x57, 0x79, 0x27, 0xe5,
-	0x8f, 0x6a, 0x00, 0x41, 0xe8, 0xdb, 0x6e, 0x8b, 0x16, 0x8a, 0xe3, 0x12, 0x9f, 0x02, 0xd9, 0xa6,
-	0x44, 0xca, 0x89, 0xcb, 0x39, 0x8a, 0x01, 0x58, 0xa1, 0x8c, 0xe6, 0x85, 0x94, 0xc0, 0x39, 0xfe,
-	0xb7, 0xa6, 0xe4, 0xa1, 0xc7, 0xb2, 0xe1, 0x76, 0x84, 0x8f, 0x6a, 0x2c, 0x46, 0xcc, 0x3e, 0x07,
-	0x63, 0x92, 0xde, 0x51, 0xa7, 0xee, 0x84, 0x72, 0xea, 0xce, 0xbe, 0x00, 0x17, 0x52, 0xdd, 0x3d,
-	0xd1, 0xa1, 0xfd, 0x1f, 0x35, 0x40, 0xc9, 0xd1, 0x9f, 0xc3, 0xd5, 0xae, 0x95, 0xbc, 0xda, 0x2d,
-	0xf4, 0xff, 0xc9, 0x0a, 0xee, 0x76, 0x5f, 0x99, 0x02, 0x16, 0xae, 0x45, 0x86, 0xc3, 0x11, 0x07,
-	0x17, 0x3d, 0x67, 0x63, 0x97, 0x0f, 0xb1, 0x73, 0xfb, 0x38, 0x67, 0xef, 0xa5, 0x70, 0xc5, 0xe7,
-	0x6c, 0x1a, 0x82, 0x33, 0x74, 0xd1, 0xa7, 0x34, 0x98, 0x36, 0x92, 0xe1, 0x5a, 0xa2, 0x99, 0x29,
-	0xe5, 0x0e, 0x9c, 0x0a, 0xfd, 0x12, 0xf7, 0x25, 0x05, 0x08, 0x70, 0x86, 0x2c, 0xfa, 0x20, 0x4c,
-	0x18, 0x1d, 0x7b, 0xbe, 0x6b, 0xd9, 0xf4, 0x6a, 0x10, 0xc5, 0xda, 0x60, 0xd7, 0xd5, 0xf9, 0xb5,
-	0x86, 0x2c, 0xc7, 0x89, 0x5a, 0x32, 0x2e, 0x8a, 0x98, 0xc8, 0xc1, 0x3e, 0xe3, 0xa2, 0x88, 0x39,
-	0x8c, 0xe3, 0xa2, 0x88, 0xa9, 0x53, 0x89, 0x20, 0x17, 0xc0, 0xb3, 0x2d, 0x53, 0x90, 0xe4, 0xcf,
-	0x7e, 0xa5, 0x6e, 0xc8, 0xf7, 0x1b, 0xf5, 0x9a, 0xa0, 0xc8, 0x4e, 0xbf, 0xf8, 0x37, 0x56, 0x28,
-	0xa0, 0xcf, 0x68, 0x30, 0x29, 0x78, 0xb7, 0xa0, 0x39, 0xc2, 0x3e, 0xd1, 0x6b, 0x65, 0xd7, 0x4b,
-	0x6a, 0x4d, 0xce, 0x61, 0x15, 0x39, 0xe7, 0x3b, 0xd2, 0x63, 0x28, 0x01, 0xc3, 0xc9, 0x7e, 0xa0,
-	0x7f, 0xa8, 0xc1, 0xe5, 0x80, 0xf8, 0xbb, 0xb6, 0x49, 0xe6, 0x4d, 0xd3, 0xeb, 0xba, 0xd1, 0x77,
-	0x18, 0x2d, 0x1f, 0x46, 0xa2, 0x99, 0x83, 0x8f, 0x9b, 0xaa, 0xe7, 0x41, 0x70, 0x2e, 0x7d, 0x2a,
-	0x96, 0x5d, 0x78, 0x60, 0x84, 0xe6, 0x76, 0xcd, 0x30, 0xb7, 0x99, 0xb2, 0x9d, 0x5b, 0xa7, 0x97,
-	0x5c, 0xd7, 0xaf, 0x24, 0x51, 0xf1, 0x67, 0xeb, 0x54, 0x21, 0x4e, 0x13, 0x44, 0x1e, 0x8c, 0xfa,
-	0x22, 0x06, 0xd6, 0x0c, 0x94, 0x17, 0x29, 0x32, 0x01, 0xb5, 0xb8, 0x60, 0x1f, 0xfd, 0xc2, 0x92,
-	0x08, 0x6a, 0xc1, 0x63, 0xfc, 0x6a, 0x33, 0xef, 0x7a, 0xee, 0x7e, 0xdb, 0xeb, 0x06, 0xf3, 0xdd,
-	0x70, 0x9b, 0xb8, 0x61, 0xa4, 0xab, 0x1c, 0x67, 0xc7, 0x28, 0x33, 0xd0, 0x5f, 0xec, 0x55, 0x11,
-	0xf7, 0xc6, 0x83, 0x5e, 0x85, 0x51, 0xb2, 0x4b, 0xdc, 0x70, 0x7d, 0x7d, 0x99, 0x19, 0xba, 0x9f,
-	0x5c, 0xda, 0x63, 0x43, 0x58, 0x14, 0x38, 0xb0, 0xc4, 0x86, 0x76, 0x60, 0xc4, 0xe1, 0x41, 0xcc,
-	0x98, 0xc1, 0x7b, 0x49, 0xa6, 0x98, 0x0e, 0x88, 0xc6, 0xef, 0x7f, 0xe2, 0x07, 0x8e, 0x28, 0xa0,
-	0x0e, 0xdc, 0xb4, 0xc8, 0x96, 0xd1, 0x75, 0xc2, 0x55, 0x2f, 0xa4, 0x22, 0xed, 0x7e, 0xac, 0x9f,
-	0x8a, 0x7c, 0x1a, 0xa6, 0x98, 0xc7, 0xf7, 0x13, 0x87, 0x07, 0xd5, 0x9b, 0xf5, 0x23, 0xea, 0xe2,
-	0x23, 0xb1, 0xa1, 0x7d, 0x78, 0x5c, 0xd4, 0xd9, 0x70, 0x7d, 0x62, 0x98, 0xdb, 0x74, 0x96, 0xb3,
-	0x44, 0x2f, 0x30, 0xa2, 0x7f, 0xeb, 0xf0, 0xa0, 0xfa, 0x78, 0xfd, 0xe8, 0xea, 0xf8, 0x38, 0x38,
-	0x99, 0xe9, 0x34, 0x49, 0xe9, 0xe8, 0x67, 0xa6, 0xcb, 0xcf, 0x71, 0x5a, 0xdf, 0xcf, 0x6d, 0x2b,
-	0xd2, 0xa5, 0x38, 0x43, 0x73, 0xf6, 0x63, 0x80, 0xb2, 0x0c, 0xe7, 0x28, 0xc9, 0x61, 0x54, 0x95,
-	0x1c, 0x3e, 0x37, 0x04, 0xd7, 0x29, 0x1f, 0x8b, 0xe5, 0xe5, 0x15, 0xc3, 0x35, 0x5a, 0xdf, 0x9c,
-	0x67, 0xec, 0x2f, 0x6b, 0xf0, 0xc8, 0x76, 0xfe, 0x5d, 0x56, 0x48, 0xec, 0x2f, 0x95, 0xd2, 0x39,
-	0xf4, 0xba, 0x1e, 0xf3, 0x2d, 0xde, 0xb3, 0x0a, 0x2e, 0xea, 0x14, 0xfa, 0x18, 0x4c, 0xbb, 0x9e,
-	0x45, 0x6a, 0x8d, 0x3a, 0x5e, 0x31, 0x82, 0x9d, 0x66, 0xf4, 0x86, 0x39, 0xc4, 0xbf, 0xf0, 0x6a,
-	0x0a, 0x86, 0x33, 0xb5, 0xd1, 0x2e, 0xa0, 0x8e, 0x67, 0x2d, 0xee, 0xda, 0x66, 0xf4, 0x7a, 0x56,
-	0xde, 0x62, 0x87, 0x3d, 0xd1, 0xad, 0x65, 0xb0, 0xe1, 0x1c, 0x0a, 0xec, 0x32, 0x4e, 0x3b, 0xb3,
-	0xe2, 0xb9, 0x76, 0xe8, 0xf9, 0xcc, 0xc3, 0xa8, 0xaf, 0x3b, 0x29, 0xbb, 0x8c, 0xaf, 0xe6, 0x62,
-	0xc4, 0x05, 0x94, 0xf4, 0xff, 0xa9, 0xc1, 0x05, 0xba, 0x2c, 0xd6, 0x7c, 0x6f, 0x6f, 0xff, 0x9b,
-	0x71, 0x41, 0x3e, 0x25, 0xcc, 0x39, 0xb8, 0x12, 0xe9, 0x8a, 0x62, 0xca, 0x31, 0xc6, 0xfa, 0x1c,
-	0x5b, 0x6f, 0xa8, 0x7a, 0xb4, 0x81, 0x62, 0x3d, 0x9a, 0xfe, 0x99, 0x0a, 0x97, 0x75, 0x23, 0x3d,
-	0xd6, 0x37, 0xe5, 0x3e, 0x7c, 0x0e, 0x26, 0x69, 0xd9, 0x8a, 0xb1, 0xb7, 0x56, 0x7f, 0xd9, 0x73,
-	0x22, 0xa7, 0x24, 0x66, 0x68, 0x7c, 0x4f, 0x05, 0xe0, 0x64, 0x3d, 0x74, 0x1b, 0x46, 0x3a, 0xdc,
-	0x95, 0x5c, 0xdc, 0xb2, 0x6e, 0x72, 0x9b, 0x07, 0x56, 0xf4, 0xf0, 0xa0, 0x7a, 0x31, 0x7e, 0xb5,
-	0x11, 0x85, 0x38, 0x6a, 0xa0, 0x7f, 0xfa, 0x0a, 0x30, 0xe4, 0x0e, 0x09, 0xbf, 0x19, 0xe7, 0xe4,
-	0x19, 0x18, 0x37, 0x3b, 0xdd, 0xda, 0x52, 0xf3, 0xa5, 0xae, 0xc7, 0x6e, 0xcf, 0x2c, 0xea, 0x25,
-	0x15, 0x7e, 0x6b, 0x6b, 0x1b, 0x51, 0x31, 0x56, 0xeb, 0x50, 0xee, 0x60, 0x76, 0xba, 0x82, 0xdf,
-	0xae, 0xa9, 0xd6, 0xb6, 0x8c, 0x3b, 0xd4, 0xd6, 0x36, 0x12, 0x30, 0x9c, 0xa9, 0x8d, 0x7e, 0x08,
-	0x26, 0x88, 0xd8, 0xb8, 0x77, 0x0d, 0xdf, 0x12, 0x7c, 0xa1, 0x51, 0x76, 0xf0, 0x72, 0x6a, 0x23,
-	0x6e, 0xc0, 0xef, 0x0c, 0x8b, 0x0a, 0x09, 0x9c, 0x20, 0x88, 0xbe, 0x07, 0xae, 0x45, 0xbf, 0xe9,
-	0x57, 0xf6, 0xac, 0x34, 0xa3, 0x18, 0xe2, 0xde, 0xbb, 0x8b, 0x45, 0x95, 0x70, 0x71, 0x7b, 0xf4,
-	0x4b, 0x1a, 0x5c, 0x95, 0x50, 0xdb, 0xb5, 0xdb, 0xdd, 0x36, 0x26, 0xa6, 0x63, 0xd8, 0x6d, 0x71,
-	0x53, 0x78, 0xe5, 0xd4, 0x06, 0x9a, 0x44, 0xcf, 0x99, 0x55, 0x3e, 0x0c, 0x17, 0x74, 0x09, 0x7d,
-	0x5e, 0x83, 0x9b, 0x11, 0x68, 0xcd, 0x27, 0x41, 0xd0, 0xf5, 0x49, 0xec, 0x12, 0x27, 0xa6, 0x64,
-	0xa4, 0x14, 0xef, 0x64, 0x22, 0xd3, 0xe2, 0x11, 0xb8, 0xf1, 0x91, 0xd4, 0xd5, 0xe5, 0xd2, 0xf4,
-	0xb6, 0x42, 0x71, 0xb5, 0x38, 0xab, 0xe5, 0x42, 0x49, 0xe0, 0x04, 0x41, 0xf4, 0x2f, 0x35, 0x78,
-	0x44, 0x2d, 0x50, 0x57, 0x0b, 0xbf, 0x53, 0xbc, 0x7a, 0x6a, 0x9d, 0x49, 0xe1, 0xe7, 0x4a, 0xe9,
-	0x02, 0x20, 0x2e, 0xea, 0x15, 0x65, 0xdb, 0x6d, 0xb6, 0x30, 0xf9, 0xbd, 0x63, 0x88, 0xb3, 0x6d,
-	0xbe, 0x56, 0x03, 0x1c, 0xc1, 0xe8, 0x8d, 0xbb, 0xe3, 0x59, 0x6b, 0xb6, 0x15, 0x2c, 0xdb, 0x6d,
-	0x3b, 0x64, 0xb7, 0x83, 0x01, 0x3e, 0x1d, 0x6b, 0x9e, 0xb5, 0xd6, 0xa8, 0xf3, 0x72, 0x9c, 0xa8,
-	0xc5, 0x9c, 0xe5, 0xed, 0xb6, 0xd1, 0x22, 0x6b, 0x5d, 0xc7, 0x59, 0xf3, 0x3d, 0xa6, 0xb9, 0xac,
-	0x13, 0xc3, 0x72, 0x6c, 0x97, 0x94, 0xbc, 0x0d, 0xb0, 0xed, 0xd6, 0x28, 0x42, 0x8a, 0x8b, 0xe9,
-	0xa1, 0x39, 0x80, 0x2d, 0xc3, 0x76, 0x9a, 0x0f, 0x8c, 0xce, 0xfd, 0xc8, 0x47, 0x96, 0xdd, 0xa5,
-	0x97, 0x64, 0x29, 0x56, 0x6a, 0xd0, 0xd5, 0x44, 0xb9, 0x20, 0x26, 0x3c, 0x48, 0x13, 0x13, 0xef,
-	0x4f, 0x63, 0x35, 0x45, 0x08, 0xf9, 0xf4, 0xdd, 0x53, 0x48, 0xe0, 0x04, 0x41, 0xf4, 0x49, 0x0d,
-	0xa6, 0x82, 0xfd, 0x20, 0x24, 0x6d, 0xd9, 0x87, 0x0b, 0xa7, 0xdd, 0x07, 0xa6, 0xd3, 0x6d, 0x26,
-	0x88, 0xe0, 0x14, 0x51, 0xe6, 0x6d, 0x4c, 0x67, 0xf5, 0x4e, 0xed, 0xae, 0xdd, 0xda, 0x96, 0x2e,
-	0xf0, 0x6b, 0xc4, 0x37, 0x89, 0x1b, 0xb2, 0x8b, 0xc1, 0x90, 0xf0, 0x36, 0x2e, 0xae, 0x86, 0x7b,
-	0xe1, 0x40, 0xaf, 0xc3, 0xac, 0x00, 0x2f, 0x7b, 0x0f, 0x32, 0x14, 0x2e, 0x32, 0x0a, 0xcc, 0x08,
-	0xaa, 0x51, 0x58, 0x0b, 0xf7, 0xc0, 0x80, 0x1a, 0x70, 0x29, 0x20, 0x3e, 0x7b, 0x92, 0x21, 0x72,
-	0xf1, 0x04, 0x33, 0x28, 0xb6, 0x7f, 0x6e, 0x66, 0xc1, 0x38, 0xaf, 0x0d, 0x7a, 0x41, 0xba, 0x58,
-	0xed, 0xd3, 0x82, 0x97, 0xd6, 0x9a, 0x33, 0x97, 0x58, 0xff, 0x2e, 0x29, 0x9e, 0x53, 0x11, 0x08,
-	0xa7, 0xeb, 0x52, 0xd9, 0x22, 0x2a, 0x5a, 0xe8, 0xfa, 0x41, 0x38, 0x73, 0x99, 0x35, 0x66, 0xb2,
-	0x05, 0x56, 0x01, 0x38, 0x59, 0x0f, 0xdd, 0x86, 0xa9, 0x80, 0x98, 0xa6, 0xd7, 0xee, 0x88, 0x7b,
-	0xde, 0xcc, 0x15, 0xd6, 0x7b, 0xfe, 0x05, 0x13, 0x10, 0x9c, 0xaa, 0x89, 0xf6, 0xe1, 0x92, 0x0c,
-	0x59, 0xb4, 0xec, 0xb5, 0x56, 0x8c, 0x3d, 0x26, 0xaa, 0x5f, 0x3d, 0x7a, 0x07, 0xce, 0x45, 0x6f,
-	0xec, 0x73, 0x2f, 0x75, 0x0d, 0x37, 0xb4, 0xc3, 0x7d, 0x3e, 0x5d, 0xb5, 0x2c, 0x3a, 0x9c, 0x47,
-	0x03, 0x2d, 0xc3, 0xe5, 0x54, 0xf1, 0x92, 0xed, 0x90, 0x60, 0xe6, 0x11, 0x36, 0x6c, 0xa6, 0xac,
-	0xa9, 0xe5, 0xc0, 0x71, 0x6e, 0x2b, 0x74, 0x1f, 0xae, 0x74, 0x7c, 0x2f, 0x24, 0x66, 0x78, 0x8f,
-	0x8a, 0x27, 0x8e, 0x18, 0x60, 0x30, 0x33, 0xc3, 0xe6, 0x82, 0x3d, 0x47, 0xad, 0xe5, 0x55, 0xc0,
-	0xf9, 0xed, 0xd0, 0xe7, 0x34, 0xb8, 0x11, 0x84, 0x3e, 0x31, 0xda, 0xb6, 0xdb, 0xaa, 0x79, 0xae,
-	0x4b, 0x18, 0x9b, 0x6c, 0x58, 0xb1, 0xfb, 0xc0, 0xb5, 0x52, 0x7c, 0x4a, 0x3f, 0x3c, 0xa8, 0xde,
-	0x68, 0xf6, 0xc4, 0x8c, 0x8f, 0xa0, 0x8c, 0xde, 0x06, 0x68, 0x93, 0xb6, 0xe7, 0xef, 0x53, 0x8e,
-	0x34, 0x33, 0x5b, 0xde, 0x9a, 0x6a, 0x45, 0x62, 0xe1, 0xdb, 0x3f, 0xf1, 0x90, 0x16, 0x03, 0xb1,
-	0x42, 0x4e, 0x3f, 0xa8, 0xc0, 0x95, 0xdc, 0x83, 0x87, 0xee, 0x00, 0x5e, 0x6f, 0x3e, 0x0a, 0x5f,
-	0x2c, 0xde, 0x9e, 0xd8, 0x0e, 0x58, 0x49, 0x82, 0x70, 0xba, 0x2e, 0x15, 0x0b, 0xd9, 0x4e, 0x5d,
-	0x6a, 0xc6, 0xed, 0x2b, 0xb1, 0x58, 0xd8, 0x48, 0xc1, 0x70, 0xa6, 0x36, 0xaa, 0xc1, 0x45, 0x51,
-	0xd6, 0xa0, 0x37, 0xab, 0x60, 0xc9, 0x27, 0x91, 0xc0, 0x4d, 0xef, 0x28, 0x17, 0x1b, 0x69, 0x20,
-	0xce, 0xd6, 0xa7, 0xa3, 0xa0, 0x3f, 0xd4, 0x5e, 0x0c, 0xc6, 0xa3, 0x58, 0x4d, 0x82, 0x70, 0xba,
-	0x6e, 0x74, 0xf5, 0x4d, 0x74, 0x61, 0x28, 0x1e, 0xc5, 0x6a, 0x0a, 0x86, 0x33, 0xb5, 0xf5, 0xff,
-	0x34, 0x08, 0x8f, 0x1f, 0x43, 0x58, 0x43, 0xed, 0xfc, 0xe9, 0x3e, 0xf9, 0xc6, 0x3d, 0xde, 0xe7,
-	0xe9, 0x14, 0x7c, 0x9e, 0x93, 0xd3, 0x3b, 0xee, 0xe7, 0x0c, 0x8a, 0x3e, 0xe7, 0xc9, 0x49, 0x1e,
-	0xff, 0xf3, 0xb7, 0xf3, 0x3f, 0x7f, 0xc9, 0x59, 0x3d, 0x72, 0xb9, 0x74, 0x0a, 0x96, 0x4b, 0xc9,
-	0x59, 0x3d, 0xc6, 0xf2, 0xfa, 0x93, 0x41, 0x78, 0xe2, 0x38, 0x82, 0x63, 0xc9, 0xf5, 0x95, 0xc3,
-	0xf2, 0xce, 0x74, 0x7d, 0x15, 0x79, 0x68, 0x9d, 0xe1, 0xfa, 0xca, 0x21, 0x79, 0xd6, 0xeb, 0xab,
-	0x68, 0x56, 0xcf, 0x6a, 0x7d, 0x15, 0xcd, 0xea, 0x31, 0xd6, 0xd7, 0x5f, 0xa4, 0xcf, 0x07, 0x29,
-	0x2f, 0x36, 0x60, 0xc0, 0xec, 0x74, 0x4b, 0x32, 0x29, 0x66, 0xa9, 0x54, 0x5b, 0xdb, 0xc0, 0x14,
-	0x07, 0xc2, 0x30, 0xcc, 0xd7, 0x4f, 0x49, 0x16, 0xc4, 0x7c, 0x7d, 0xf8, 0x92, 0xc4, 0x02, 0x13,
-	0x9d, 0x2a, 0xd2, 0xd9, 0x26, 0x6d, 0xe2, 0x1b, 0x4e, 0x33, 0xf4, 0x7c, 0xa3, 0x55, 0x96, 0xdb,
-	0x70, 0x35, 0x76, 0x0a, 0x17, 0xce, 0x60, 0xa7, 0x13, 0xd2, 0xb1, 0xad, 0x92, 0xfc, 0x85, 0x4d,
-	0xc8, 0x5a, 0xa3, 0x8e, 0x29, 0x0e, 0xfd, 0x1f, 0x8f, 0x81, 0x12, 0x12, 0x10, 0x7d, 0x0f, 0x5c,
-	0x33, 0x1c, 0xc7, 0x7b, 0xb0, 0xe6, 0xdb, 0xbb, 0xb6, 0x43, 0x5a, 0xc4, 0x92, 0xc2, 0x54, 0x20,
-	0xec, 0xd9, 0xd8, 0x85, 0x69, 0xbe, 0xa8, 0x12, 0x2e, 0x6e, 0x8f, 0xde, 0xd1, 0xe0, 0xa2, 0x99,
-	0x0e, 0xc3, 0xd6, 0x8f, 0xc5, 0x4b, 0x26, 0xa6, 0x1b, 0xdf, 0x4f, 0x99, 0x62, 0x9c, 0x25, 0x8b,
-	0x7e, 0x58, 0xe3, 0x4a, 0x39, 0xf9, 0x5e, 0x23, 0xbe, 0xd9, 0x9d, 0x53, 0x7a, 0xd9, 0x8c, 0xb5,
-	0x7b, 0xf1, 0x23, 0x5a, 0x92, 0x20, 0xfa, 0xbc, 0x06, 0x57, 0x76, 0xf2, 0xde, 0x12, 0xc4, 0x97,
-	0xbd, 0x5f, 0xb6, 0x2b, 0x05, 0x8f, 0x13, 0x5c, 0x9c, 0xcd, 0xad, 0x80, 0xf3, 0x3b, 0x22, 0x67,
-	0x49, 0xaa, 0x57, 0x05, 0x13, 0x28, 0x3d, 0x4b, 0x29, 0x3d, 0x6d, 0x3c, 0x4b, 0x12, 0x80, 0x93,
-	0x04, 0x51, 0x07, 0xc6, 0x76, 0x22, 0x9d, 0xb6, 0xd0, 0x63, 0xd5, 0xca, 0x52, 0x57, 0x14, 0xe3,
-	0xdc, 0xa2, 0x47, 0x16, 0xe2, 0x98, 0x08, 0xda, 0x86, 0x91, 0x1d, 0xce, 0x88, 0x84, 0xfe, 0x69,
-	0xbe, 0xef, 0xfb, 0x31, 0x57, 0x83, 0x88, 0x22, 0x1c, 0xa1, 0x57, 0xcd, 0x79, 0x47, 0x8f, 0xf0,
-	0x32, 0xf9, 0x9c, 0x06, 0x57, 0x76, 0x89, 0x1f, 0xda, 0x66, 0xfa, 0x25, 0x67, 0xac, 0xfc, 0x1d,
-	0xfe, 0xe5, 0x3c, 0x84, 0x7c, 0x99, 0xe4, 0x82, 0x70, 0x7e, 0x17, 0xe8, 0x8d, 0x9e, 0x2b, 0xe4,
-	0x9b, 0xa1, 0x11, 0xda, 0xe6, 0xba, 0xb7, 0x43, 0xdc, 0x38, 0x73, 0x0d, 0xd3, 0x04, 0x89, 0xf8,
-	0x61, 0x8b, 0xc5, 0xd5, 0x70, 0x2f, 0x1c, 0xfa, 0xd7, 0x35, 0xc8, 0xa8, 0x95, 0xd1, 0x4f, 0x6a,
-	0x30, 0xb1, 0x45, 0x8c, 0xb0, 0xeb, 0x93, 0x3b, 0x46, 0x28, 0x7d, 0xe7, 0x5f, 0x3e, 0x0d, 0x6d,
-	0xf6, 0xdc, 0x92, 0x82, 0x98, 0x5b, 0x26, 0xc8, 0x70, 0xa2, 0x2a, 0x08, 0x27, 0x7a, 0x30, 0xfb,
-	0x22, 0x5c, 0xcc, 0x34, 0x3c, 0xd1, 0x0b, 0xe3, 0xbf, 0xd1, 0x20, 0x2f, 0xd9, 0x12, 0x7a, 0x1d,
-	0x86, 0x0c, 0xcb, 0x92, 0xd9, 0x13, 0x9e, 0x2f, 0x67, 0x24, 0x63, 0xa9, 0x21, 0x0a, 0xd8, 0x4f,
-	0xcc, 0xd1, 0xa2, 0x25, 0x40, 0x46, 0xe2, 0xa9, 0x7d, 0x25, 0x76, 0xbc, 0x65, 0x2f, 0x61, 0xf3,
-	0x19, 0x28, 0xce, 0x69, 0xa1, 0xff, 0x98, 0x06, 0x28, 0x1b, 0x80, 0x16, 0xf9, 0x30, 0x2a, 0x96,
-	0x72, 0xf4, 0x95, 0xea, 0x25, 0x7d, 0x5b, 0x12, 0x8e, 0x5a, 0xb1, 0xc5, 0x95, 0x28, 0x08, 0xb0,
-	0xa4, 0xa3, 0xff, 0xa5, 0x06, 0x71, 0x84, 0x75, 0xf4, 0x21, 0x18, 0xb7, 0x48, 0x60, 0xfa, 0x76,
-	0x27, 0x8c, 0xdd, 0xba, 0xa4, 0x7b, 0x48, 0x3d, 0x06, 0x61, 0xb5, 0x1e, 0xd2, 0x61, 0x38, 0x34,
-	0x82, 0x9d, 0x46, 0x5d, 0x5c, 0x2a, 0x99, 0x08, 0xb0, 0xce, 0x4a, 0xb0, 0x80, 0xc4, 0xc1, 0xcf,
-	0x06, 0x8e, 0x11, 0xfc, 0x0c, 0x6d, 0x9d, 0x42, 0xa4, 0x37, 0x74, 0x74, 0x94, 0x37, 0xfd, 0x17,
-	0x2b, 0x70, 0x81, 0x56, 0x59, 0x31, 0x6c, 0x37, 0x24, 0x2e, 0x73, 0x62, 0x28, 0x39, 0x09, 0x2d,
-	0x98, 0x0c, 0x13, 0x5e, 0x7e, 0x27, 0x77, 0x71, 0x93, 0x66, 0x3d, 0x49, 0xdf, 0xbe, 0x24, 0x5e,
-	0xf4, 0x7c, 0xe4, 0x45, 0xc2, 0xaf, 0xdf, 0x8f, 0x47, 0x4b, 0x95, 0xb9, 0x86, 0x3c, 0x14, 0x2e,
-	0x93, 0x32, 0x2c, 0x7f, 0xc2, 0x61, 0xe4, 0x39, 0x98, 0x14, 0xd6, 0xdc, 0x3c, 0x8a, 0x9d, 0xb8,
-	0x7e, 0xb3, 0x13, 0x66, 0x49, 0x05, 0xe0, 0x64, 0x3d, 0xfd, 0x8b, 0x15, 0x48, 0x06, 0xff, 0x2f,
-	0x3b, 0x4b, 0xd9, 0x10, 0x7e, 0x95, 0x33, 0x0b, 0xe1, 0xf7, 0x01, 0x96, 0x39, 0x87, 0xa7, 0x58,
-	0xe3, 0x4f, 0xe4, 0x6a, 0xbe, 0x1b, 0x9e, 0x20, 0x4d, 0xd6, 0x88, 0xa7, 0x75, 0xf0, 0xc4, 0xd3,
-	0xfa, 0x21, 0x61, 0xe6, 0x39, 0x94, 0x08, 0xa4, 0x18, 0x99, 0x79, 0x5e, 0x4c, 0x34, 0x54, 0x7c,
-	0x5e, 0x7e, 0x4f, 0x83, 0x11, 0x11, 0x75, 0xf9, 0x18, 0x3e, 0x55, 0x5b, 0x30, 0xc4, 0xae, 0x3c,
-	0xfd, 0x48, 0x83, 0xcd, 0x6d, 0xcf, 0x0b, 0x13, 0xb1, 0xa7, 0x99, 0x13, 0x03, 0xfb, 0x17, 0x73,
-	0xf4, 0xcc, 0xd2, 0xcf, 0x37, 0xb7, 0xed, 0x90, 0x98, 0x61, 0x14, 0xd1, 0x36, 0xb2, 0xf4, 0x53,
-	0xca, 0x71, 0xa2, 0x96, 0xfe, 0x33, 0x83, 0x70, 0x53, 0x20, 0xce, 0x88, 0x48, 0x92, 0xc1, 0xed,
-	0xc3, 0x25, 0xf1, 0x6d, 0xeb, 0xbe, 0x61, 0x4b, 0xd3, 0x83, 0x72, 0x57, 0x5f, 0x91, 0x46, 0x30,
-	0x83, 0x0e, 0xe7, 0xd1, 0xe0, 0xb1, 0x59, 0x59, 0xf1, 0x5d, 0x62, 0x38, 0xe1, 0x76, 0x44, 0xbb,
-	0xd2, 0x4f, 0x6c, 0xd6, 0x2c, 0x3e, 0x9c, 0x4b, 0x85, 0x99, 0x3e, 0x08, 0x40, 0xcd, 0x27, 0x86,
-	0x6a, 0x77, 0xd1, 0x87, 0x1f, 0xc2, 0x4a, 0x2e, 0x46, 0x5c, 0x40, 0x89, 0xe9, 0x10, 0x8d, 0x3d,
-	0xa6, 0x92, 0xc0, 0x24, 0xf4, 0x6d, 0x16, 0x43, 0x5c, 0x6a, 0xd1, 0x57, 0x92, 0x20, 0x9c, 0xae,
-	0x8b, 0x6e, 0xc3, 0x14, 0x33, 0x25, 0x89, 0x83, 0x76, 0x0d, 0xc5, 0x71, 0x21, 0x56, 0x13, 0x10,
-	0x9c, 0xaa, 0xa9, 0x7f, 0xbc, 0x02, 0x13, 0xea, 0xb2, 0x3b, 0x86, 0x83, 0x55, 0x57, 0x39, 0x0c,
-	0xfb, 0x70, 0xfe, 0x51, 0xa9, 0x1e, 0xe3, 0x3c, 0x44, 0xaf, 0xc2, 0x54, 0x97, 0x71, 0x90, 0x28,
-	0xf0, 0x88, 0x58, 0xff, 0xdf, 0x46, 0x47, 0xb9, 0x91, 0x80, 0x3c, 0x3c, 0xa8, 0xce, 0xaa, 0xe8,
-	0x93, 0x50, 0x9c, 0xc2, 0xa3, 0x7f, 0x7a, 0x00, 0x2e, 0xe5, 0xf4, 0x86, 0x99, 0x1c, 0x90, 0xd4,
-	0x91, 0xdd, 0x8f, 0xc9, 0x41, 0xe6, 0xf8, 0x97, 0x26, 0x07, 0x69, 0x08, 0xce, 0xd0, 0x45, 0x2f,
-	0xc3, 0x80, 0xe9, 0xdb, 0x62, 0xc2, 0x9f, 0x2b, 0x75, 0xe1, 0xc4, 0x8d, 0x85, 0x71, 0x41, 0x71,
-	0xa0, 0x86, 0x1b, 0x98, 0x22, 0xa4, 0x07, 0x8f, 0xca, 0x2e, 0x22, 0x29, 0x80, 0x1d, 0x3c, 0x2a,
-	0x57, 0x09, 0x70, 0xb2, 0x1e, 0x7a, 0x15, 0x66, 0xc4, 0x4d, 0x20, 0x72, 0xd6, 0xf6, 0xdc, 0x20,
-	0xa4, 0x3b, 0x3b, 0x14, 0x8c, 0xfa, 0xd1, 0xc3, 0x83, 0xea, 0xcc, 0xbd, 0x82, 0x3a, 0xb8, 0xb0,
-	0xb5, 0xfe, 0xe7, 0x03, 0x30, 0xae, 0xc4, 0xbc, 0x47, 0x2b, 0xfd, 0xa8, 0x50, 0xe2, 0x11, 0x47,
-	0x6a, 0x94, 0x15, 0x18, 0x68, 0x75, 0xba, 0x25, 0x75, 0x28, 0x12, 0xdd, 0x1d, 0x8a, 0xae, 0xd5,
-	0xe9, 0xa2, 0x97, 0xa5, 0x56, 0xa6, 0x9c, 0xde, 0x44, 0xba, 0xd6, 0xa4, 0x34, 0x33, 0xd1, 0x46,
-	0x1c, 0x2c, 0xdc, 0x88, 0x6d, 0x18, 0x09, 0x84, 0xca, 0x66, 0xa8, 0x7c, 0x7c, 0x1d, 0x65, 0xa6,
-	0x85, 0x8a, 0x86, 0xdf, 0xf7, 0x22, 0x0d, 0x4e, 0x44, 0x83, 0xca, 0x92, 0x5d, 0xe6, 0xb0, 0xcb,
-	0x2e, 0xb2, 0xa3, 0x5c, 0x96, 0xdc, 0x60, 0x25, 0x58, 0x40, 0x32, 0x47, 0xd4, 0xc8, 0xb1, 0x8e,
-	0xa8, 0xbf, 0x57, 0x01, 0x94, 0xed, 0x06, 0x7a, 0x1c, 0x86, 0x98, 0xc3, 0xbf, 0xe0, 0x45, 0x52,
-	0xf2, 0x67, 0x2e, 0xdf, 0x98, 0xc3, 0x50, 0x53, 0x44, 0x0b, 0x29, 0xf7, 0x39, 0x99, 0xcd, 0x8e,
-	0xa0, 0xa7, 0x84, 0x16, 0xb9, 0x99, 0xf0, 0x0e, 0xc9, 0x3b, 0xf3, 0x37, 0x60, 0xa4, 0x6d, 0xbb,
-	0xec, 0xe1, 0xb0, 0x9c, 0x26, 0x8b, 0x9b, 0x16, 0x70, 0x14, 0x38, 0xc2, 0xa5, 0xff, 0x49, 0x85,
-	0x2e, 0xfd, 0x58, 0xe2, 0xdd, 0x07, 0x30, 0xba, 0xa1, 0xc7, 0x19, 0x98, 0xd8, 0x01, 0x8d, 0x72,
-	0x5f, 0x59, 0x22, 0x9d, 0x97, 0x08, 0xf9, 0x93, 0x57, 0xfc, 0x1b, 0x2b, 0xc4, 0x28, 0xe9, 0xd0,
-	0x6e, 0x93, 0x57, 0x6c, 0xd7, 0xf2, 0x1e, 0x88, 0xe9, 0xed, 0x97, 0xf4, 0xba, 0x44, 0xc8, 0x49,
-	0xc7, 0xbf, 0xb1, 0x42, 0x8c, 0xb2, 0x16, 0x76, 0x71, 0x76, 0x59, 0x12, 0x12, 0xd1, 0x37, 0xcf,
-	0x71, 0xa2, 0x53, 0x79, 0x94, 0xb3, 0x96, 0x5a, 0x41, 0x1d, 0x5c, 0xd8, 0x5a, 0xff, 0x25, 0x0d,
-	0xae, 0xe4, 0x4e, 0x05, 0xba, 0x03, 0x17, 0x63, 0x33, 0x2f, 0x95, 0xd9, 0x8f, 0xc6, 0xc9, 0x6f,
-	0xee, 0xa5, 0x2b, 0xe0, 0x6c, 0x1b, 0x9e, 0x61, 0x39, 0x73, 0x98, 0x08, 0x1b, 0x31, 0x55, 0x34,
-	0x52, 0xc1, 0x38, 0xaf, 0x8d, 0xfe, 0x3d, 0x89, 0xce, 0xc6, 0x93, 0x45, 0x77, 0xc6, 0x26, 0x69,
-	0x49, 0xef, 0x3c, 0xb9, 0x33, 0x16, 0x68, 0x21, 0xe6, 0x30, 0xf4, 0x98, 0xea, 0xf3, 0x2a, 0xf9,
-	0x56, 0xe4, 0xf7, 0xaa, 0x7f, 0x1f, 0x3c, 0x52, 0xf0, 0x12, 0x8a, 0xea, 0x30, 0x11, 0x3c, 0x30,
-	0x3a, 0x0b, 0x64, 0xdb, 0xd8, 0xb5, 0x45, 0x0c, 0x05, 0x6e, 0xbe, 0x37, 0xd1, 0x54, 0xca, 0x1f,
-	0xa6, 0x7e, 0xe3, 0x44, 0x2b, 0x3d, 0x04, 0x10, 0x66, 0x9e, 0xb6, 0xdb, 0x42, 0x5b, 0x30, 0x6a,
-	0x88, 0x04, 0xbf, 0x62, 0x1d, 0x7f, 0x67, 0x29, 0x25, 0x80, 0xc0, 0xc1, 0x0d, 0xe1, 0xa3, 0x5f,
-	0x58, 0xe2, 0xd6, 0xff, 0x99, 0x06, 0x57, 0xf3, 0xbd, 0xe6, 0x8f, 0x21, 0xda, 0xb4, 0x61, 0xdc,
-	0x8f, 0x9b, 0x89, 0x45, 0xff, 0x1d, 0x6a, 0xdc, 0x55, 0x25, 0xd0, 0x18, 0x15, 0xfb, 0x6a, 0xbe,
-	0x17, 0x44, 0x5f, 0x3e, 0x1d, 0x8a, 0x55, 0x5e, 0xb9, 0x94, 0x9e, 0x60, 0x15, 0xbf, 0xfe, 0x1b,
-	0x15, 0x80, 0x55, 0x12, 0x3e, 0xf0, 0xfc, 0x1d, 0x3a, 0x45, 0x8f, 0x26, 0x6e, 0x1a, 0xa3, 0xdf,
-	0xb8, 0xc8, 0x0d, 0x8f, 0xc2, 0x60, 0xc7, 0xb3, 0x02, 0xc1, 0xfe, 0x58, 0x47, 0x98, 0x05, 0x14,
-	0x2b, 0x45, 0x55, 0x18, 0x62, 0x0f, 0x1f, 0xe2, 0x64, 0x62, 0xf7, 0x14, 0x2a, 0x65, 0x06, 0x98,
-	0x97, 0xf3, 0xb4, 0x6d, 0xcc, 0xb9, 0x24, 0x10, 0x17, 0x2f, 0x91, 0xb6, 0x8d, 0x97, 0x61, 0x09,
-	0x45, 0xb7, 0x01, 0xec, 0xce, 0x92, 0xd1, 0xb6, 0x1d, 0x2a, 0xf3, 0x0e, 0xcb, 0x2c, 0xc1, 0xd0,
-	0x58, 0x8b, 0x4a, 0x1f, 0x1e, 0x54, 0x47, 0xc5, 0xaf, 0x7d, 0xac, 0xd4, 0xd6, 0xff, 0x6a, 0x00,
-	0x12, 0x19, 0xb5, 0x63, 0x1d, 0x93, 0x76, 0x36, 0x3a, 0xa6, 0x57, 0x61, 0xc6, 0xf1, 0x0c, 0x6b,
-	0xc1, 0x70, 0xe8, 0x6e, 0xf4, 0x9b, 0xfc, 0x33, 0x1a, 0x6e, 0x4b, 0xa6, 0x4d, 0x66, 0x5c, 0x69,
-	0xb9, 0xa0, 0x0e, 0x2e, 0x6c, 0x8d, 0x42, 0x99, 0xc7, 0x7b, 0xa0, 0xbc, 0x1f, 0xa6, 0x3a, 0x17,
-	0x73, 0xaa, 0x4b, 0x92, 0x14, 0x30, 0x52, 0xa9, 0xbe, 0x3f, 0xa1, 0xc1, 0x15, 0xb2, 0xc7, 0x5d,
-	0xf2, 0xd6, 0x7d, 0x63, 0x6b, 0xcb, 0x36, 0x85, 0x5d, 0x2a, 0xff, 0xb0, 0xcb, 0x87, 0x07, 0xd5,
-	0x2b, 0x8b, 0x79, 0x15, 0x1e, 0x1e, 0x54, 0x6f, 0xe5, 0x7a, 0x48, 0xb2, 0xcf, 0x9a, 0xdb, 0x04,
-	0xe7, 0x93, 0x9a, 0x7d, 0x1e, 0xc6, 0x4f, 0xe0, 0xcd, 0x90, 0xf0, 0x83, 0xfc, 0xcd, 0x0a, 0x4c,
-	0xd0, 0x75, 0xb7, 0xec, 0x99, 0x86, 0x53, 0x5f, 0x6d, 0x9e, 0x20, 0x0f, 0x3d, 0x5a, 0x86, 0xcb,
-	0x5b, 0x9e, 0x6f, 0x92, 0xf5, 0xda, 0xda, 0xba, 0x27, 0x9e, 0x5c, 0xea, 0xab, 0x4d, 0xc1, 0xa5,
-	0xd9, 0x25, 0x72, 0x29, 0x07, 0x8e, 0x73, 0x5b, 0xa1, 0xfb, 0x70, 0x25, 0x2e, 0xdf, 0xe8, 0x70,
-	0x43, 0x16, 0x8a, 0x6e, 0x20, 0x36, 0xc4, 0x59, 0xca, 0xab, 0x80, 0xf3, 0xdb, 0x21, 0x03, 0xae,
-	0x8b, 0xe0, 0x28, 0x4b, 0x9e, 0xff, 0xc0, 0xf0, 0xad, 0x24, 0xda, 0xc1, 0x58, 0x25, 0x5d, 0x2f,
-	0xae, 0x86, 0x7b, 0xe1, 0xd0, 0x7f, 0x76, 0x18, 0x14, 0xbf, 0xb9, 0x13, 0x24, 0xfa, 0xfa, 0x05,
-	0x0d, 0x2e, 0x9b, 0x8e, 0x4d, 0xdc, 0x30, 0xe5, 0x24, 0xc5, 0xd9, 0xd1, 0x46, 0x29, 0x87, 0xbe,
-	0x0e, 0x71, 0x1b, 0x75, 0x61, 0xf7, 0x53, 0xcb, 0x41, 0x2e, 0x6c, 0xa3, 0x72, 0x20, 0x38, 0xb7,
-	0x33, 0x6c, 0x3c, 0xac, 0xbc, 0x51, 0x57, 0xa3, 0x3a, 0xd4, 0x44, 0x19, 0x96, 0x50, 0xf4, 0x0c,
-	0x8c, 0xb7, 0x7c, 0xaf, 0xdb, 0x09, 0x6a, 0xcc, 0xd8, 0x98, 0xaf, 0x7d, 0x26, 0x17, 0xde, 0x89,
-	0x8b, 0xb1, 0x5a, 0x87, 0x4a, 0xb9, 0xfc, 0xe7, 0x9a, 0x4f, 0xb6, 0xec, 0x3d, 0xc1, 0xe4, 0x98,
-	0x94, 0x7b, 0x47, 0x29, 0xc7, 0x89, 0x5a, 0xcc, 0x31, 0x3b, 0x08, 0xba, 0xc4, 0xdf, 0xc0, 0xcb,
-	0x22, 0x23, 0x05, 0x77, 0xcc, 0x8e, 0x0a, 0x71, 0x0c, 0x47, 0x3f, 0xa5, 0xc1, 0x94, 0x4f, 0xde,
-	0xec, 0xda, 0x3e, 0xb1, 0x18, 0xd1, 0x40, 0x38, 0x2f, 0xe2, 0xfe, 0x1c, 0x26, 0xe7, 0x70, 0x02,
-	0x29, 0xe7, 0x10, 0x52, 0x6d, 0x97, 0x04, 0xe2, 0x54, 0x0f, 0xe8, 0x54, 0x05, 0x76, 0xcb, 0xb5,
-	0xdd, 0xd6, 0xbc, 0xd3, 0x0a, 0x66, 0x46, 0x19, 0xd3, 0xe3, 0x22, 0x74, 0x5c, 0x8c, 0xd5, 0x3a,
-	0xf4, 0x7a, 0xd9, 0x0d, 0xe8, 0xbe, 0x6f, 0x13, 0x3e, 0xbf, 0x63, 0xb1, 0x5e, 0x73, 0x43, 0x05,
-	0xe0, 0x64, 0x3d, 0x74, 0x1b, 0xa6, 0xa2, 0x02, 0x31, 0xcb, 0xc0, 0xe3, 0x01, 0xb2, 0xeb, 0x7e,
-	0x02, 0x82, 0x53, 0x35, 0x67, 0xe7, 0xe1, 0x52, 0xce, 0x30, 0x4f, 0xc4, 0x5c, 0xfe, 0x9f, 0x06,
-	0x57, 0x78, 0x96, 0xd2, 0x28, 0x97, 0x45, 0x14, 0xf8, 0x2f, 0x3f, 0x86, 0x9e, 0x76, 0xa6, 0x31,
-	0xf4, 0xbe, 0x01, 0xb1, 0x02, 0xf5, 0x7f, 0x52, 0x81, 0xf7, 0x1e, 0xb9, 0x2f, 0xd1, 0x3f, 0xd2,
-	0x60, 0x9c, 0xec, 0x85, 0xbe, 0x21, 0x3d, 0x32, 0xe8, 0x22, 0xdd, 0x3a, 0x13, 0x26, 0x30, 0xb7,
-	0x18, 0x13, 0xe2, 0x0b, 0x57, 0x8a, 0x58, 0x0a, 0x04, 0xab, 0xfd, 0xa1, 0x97, 0x56, 0x1e, 0x2f,
-	0x53, 0x7d, 0x00, 0x11, 0xc9, 0xa3, 0x05, 0x64, 0xf6, 0xa3, 0x30, 0x9d, 0xc6, 0x7c, 0xa2, 0xb5,
-	0xf2, 0xeb, 0x15, 0x18, 0x59, 0xf3, 0x3d, 0x2a, 0xfd, 0x9d, 0x43, 0x7c, 0x07, 0x23, 0x11, 0x43,
-	0xbe, 0x94, 0xcb, 0xb6, 0xe8, 0x6c, 0x61, 0xfe, 0x0a, 0x3b, 0x95, 0xbf, 0x62, 0xbe, 0x1f, 0x22,
-	0xbd, 0x13, 0x56, 0xfc, 0xa1, 0x06, 0xe3, 0xa2, 0xe6, 0x39, 0x44, 0x31, 0xf8, 0xfe, 0x64, 0x14,
-	0x83, 0x8f, 0xf4, 0x31, 0xae, 0x82, 0xf0, 0x05, 0x9f, 0xd3, 0x60, 0x52, 0xd4, 0x58, 0x21, 0xed,
-	0x4d, 0xe2, 0xa3, 0x25, 0x18, 0x09, 0xba, 0xec, 0x43, 0x8a, 0x01, 0x5d, 0x57, 0xef, 0x13, 0xfe,
-	0xa6, 0x61, 0xb2, 0x0c, 0xe8, 0xbc, 0x8a, 0x92, 0x15, 0x82, 0x17, 0xe0, 0xa8, 0x31, 0xbd, 0xbd,
-	0xf8, 0x9e, 0x93, 0x89, 0x6b, 0x85, 0x3d, 0x87, 0x60, 0x06, 0xa1, 0x82, 0x39, 0xfd, 0x1b, 0xa9,
-	0xf0, 0x98, 0x60, 0x4e, 0xc1, 0x01, 0xe6, 0xe5, 0xfa, 0x27, 0x07, 0xe5, 0x64, 0xb3, 0xc8, 0xed,
-	0x77, 0x61, 0xcc, 0xf4, 0x89, 0x11, 0x12, 0x6b, 0x61, 0xff, 0x38, 0x9d, 0x63, 0xc7, 0x55, 0x2d,
-	0x6a, 0x81, 0xe3, 0xc6, 0xf4, 0x64, 0x50, 0xdf, 0x9c, 0x2a, 0xf1, 0x21, 0x5a, 0xf8, 0xde, 0xf4,
-	0x9d, 0x30, 0xe4, 0x3d, 0x70, 0xa5, 0xe9, 0x4a, 0x4f, 0xc2, 0x6c, 0x28, 0xf7, 0x69, 0x6d, 0xcc,
-	0x1b, 0xa9, 0x71, 0xdd, 0x06, 0x7b, 0xc4, 0x75, 0x73, 0x60, 0xa4, 0xcd, 0x3e, 0x43, 0x5f, 0x49,
-	0x02, 0x12, 0x1f, 0x54, 0x4d, 0x23, 0xc5, 0x30, 0xe3, 0x88, 0x04, 0x3d, 0xe1, 0xe9, 0x29, 0x14,
-	0x74, 0x0c, 0x93, 0xa8, 0x27, 0xfc, 0x6a, 0x54, 0x88, 0x63, 0x38, 0xda, 0x4f, 0x06, 0x0c, 0x1c,
-	0x29, 0xaf, 0xc1, 0x13, 0xdd, 0x53, 0x62, 0x04, 0xf2, 0xa9, 0x2f, 0x0c, 0x1a, 0xf8, 0xe3, 0x83,
-	0x72, 0x91, 0x8a, 0x9c, 0x1f, 0xf9, 0x59, 0xbb, 0xb5, 0x52, 0x59, 0xbb, 0xbf, 0x3d, 0x8a, 0x8c,
-	0x5b, 0x49, 0xa4, 0x3c, 0x93, 0x91, 0x71, 0x27, 0x04, 0xe9, 0x44, 0x34, 0xdc, 0x2e, 0x5c, 0x0a,
-	0x42, 0xc3, 0x21, 0x4d, 0x5b, 0x68, 0x3a, 0x82, 0xd0, 0x68, 0x77, 0x4a, 0x84, 0xa6, 0xe5, 0xfe,
-	0x0b, 0x59, 0x54, 0x38, 0x0f, 0x3f, 0xfa, 0x11, 0x0d, 0x66, 0x58, 0xf9, 0x7c, 0x37, 0xf4, 0x78,
-	0x0c, 0xf5, 0x98, 0xf8, 0xc9, 0x1f, 0xb6, 0xd9, 0x05, 0xb0, 0x59, 0x80, 0x0f, 0x17, 0x52, 0x42,
-	0x6f, 0xc3, 0x15, 0x7a, 0x02, 0xcf, 0x9b, 0xa1, 0xbd, 0x6b, 0x87, 0xfb, 0x71, 0x17, 0x4e, 0x1e,
-	0x8f, 0x96, 0x5d, 0x36, 0x96, 0xf3, 0x90, 0xe1, 0x7c, 0x1a, 0xfa, 0x5f, 0x68, 0x80, 0xb2, 0x4b,
-	0x08, 0x39, 0x30, 0x6a, 0x45, 0x0e, 0x05, 0xda, 0xa9, 0x44, 0xb3, 0x94, 0x9c, 0x59, 0xfa, 0x21,
-	0x48, 0x0a, 0xc8, 0x83, 0xb1, 0x07, 0xdb, 0x76, 0x48, 0x1c, 0x3b, 0x08, 0x4f, 0x29, 0x78, 0xa6,
-	0x8c, 0x24, 0xf7, 0x4a, 0x84, 0x18, 0xc7, 0x34, 0xf4, 0x9f, 0x18, 0x84, 0x51, 0x19, 0x0c, 0xfc,
-	0xe8, 0x37, 0xde, 0x2e, 0x20, 0x53, 0x49, 0xa8, 0xd6, 0x8f, 0x06, 0x86, 0x09, 0x61, 0xb5, 0x0c,
-	0x32, 0x9c, 0x43, 0x00, 0xbd, 0x0d, 0x97, 0x6d, 0x77, 0xcb, 0x37, 0x82, 0xd0, 0xef, 0x32, 0x5d,
-	0x79, 0x3f, 0x79, 0xc9, 0xd8, 0x1d, 0xaa, 0x91, 0x83, 0x0e, 0xe7, 0x12, 0x41, 0x04, 0x46, 0x78,
-	0xce, 0x83, 0x28, 0xae, 0x61, 0xa9, 0x8c, 0xbf, 0x3c, 0x97, 0x42, 0xcc, 0x35, 0xf9, 0xef, 0x00,
-	0x47, 0xb8, 0x79, 0xcc, 0x11, 0xfe, 0x7f, 0xf4, 0x1e, 0x2d, 0xd6, 0x7d, 0xad, 0x3c, 0xbd, 0x38,
-	0x79, 0x34, 0x8f, 0x39, 0x92, 0x2c, 0xc4, 0x69, 0x82, 0xfa, 0xef, 0x6b, 0x30, 0xc4, 0x1d, 0x75,
-	0xcf, 0x5e, 0x82, 0xfb, 0xbe, 0x84, 0x04, 0x57, 0x2a, 0xb5, 0x12, 0xeb, 0x6a, 0x61, 0xd2, 0x9f,
-	0xdf, 0xd3, 0x60, 0x8c, 0xd5, 0x38, 0x07, 0x91, 0xea, 0xf5, 0xa4, 0x48, 0xf5, 0x7c, 0xe9, 0xd1,
-	0x14, 0x08, 0x54, 0xbf, 0x3f, 0x20, 0xc6, 0xc2, 0x24, 0x96, 0x06, 0x5c, 0x12, 0xd6, 0xb0, 0xcb,
-	0xf6, 0x16, 0xa1, 0x4b, 0xbc, 0x6e, 0xec, 0xf3, 0x07, 0xa2, 0x21, 0xe1, 0x8b, 0x95, 0x05, 0xe3,
-	0xbc, 0x36, 0xe8, 0x37, 0x35, 0x2a, 0x1b, 0x84, 0xbe, 0x6d, 0xf6, 0x95, 0x49, 0x47, 0xf6, 0x6d,
-	0x6e, 0x85, 0x23, 0xe3, 0x37, 0x93, 0x8d, 0x58, 0x48, 0x60, 0xa5, 0x0f, 0x0f, 0xaa, 0xd5, 0x1c,
-	0x95, 0x59, 0x9c, 0x55, 0x23, 0x08, 0x3f, 0xf1, 0xa7, 0x3d, 0xab, 0x30, 0x35, 0x75, 0xd4, 0x63,
-	0x74, 0x17, 0x86, 0x02, 0xd3, 0xeb, 0x90, 0x93, 0xe4, 0x06, 0x93, 0x13, 0xdc, 0xa4, 0x2d, 0x31,
-	0x47, 0x30, 0xfb, 0x06, 0x4c, 0xa8, 0x3d, 0xcf, 0xb9, 0xf9, 0xd4, 0xd5, 0x9b, 0xcf, 0x89, 0x5f,
-	0xba, 0xd4, 0x9b, 0xd2, 0x6f, 0x55, 0x60, 0x98, 0x67, 0xfc, 0x3e, 0x86, 0x32, 0xde, 0x8e, 0xd2,
-	0x17, 0x54, 0xca, 0x5b, 0xdc, 0xa9, 0xa1, 0x3a, 0x5f, 0xf3, 0x5c, 0x65, 0x0e, 0xd4, 0x0c, 0x06,
-	0xc8, 0x95, 0x01, 0x5c, 0x07, 0xca, 0xe7, 0x2f, 0xe2, 0x03, 0x3b, 0xeb, 0x90, 0xad, 0x7f, 0xa4,
-	0xc1, 0x44, 0x22, 0x22, 0x6e, 0x1b, 0x06, 0x7c, 0x99, 0xd9, 0xae, 0xec, 0x5b, 0x45, 0x64, 0x53,
-	0x75, 0xbd, 0x47, 0x25, 0x4c, 0xe9, 0xc8, 0xe0, 0xb9, 0x95, 0x53, 0x0a, 0x9e, 0xab, 0x7f, 0x46,
-	0x83, 0xab, 0xd1, 0x80, 0x92, 0xa1, 0xa1, 0xd0, 0x93, 0x30, 0x6a, 0x74, 0x6c, 0xa6, 0x52, 0x53,
-	0x95, 0x92, 0xf3, 0x6b, 0x0d, 0x56, 0x86, 0x25, 0x14, 0x7d, 0x00, 0x46, 0xa3, 0x85, 0x27, 0xc4,
-	0x4e, 0xc9, 0xb3, 0xe4, 0xeb, 0x8b, 0xac, 0x81, 0xde, 0xa7, 0x64, 0x98, 0x18, 0x8a, 0xe5, 0x04,
-	0x49, 0x98, 0xbf, 0x02, 0xeb, 0xdf, 0x01, 0x63, 0xcd, 0xe6, 0xdd, 0x79, 0xd3, 0x24, 0x41, 0x70,
-	0x02, 0xe5, 0xb2, 0xfe, 0xa9, 0x01, 0x98, 0x14, 0x31, 0xee, 0x6c, 0xd7, 0xb2, 0xdd, 0xd6, 0x39,
-	0x9c, 0x29, 0xeb, 0x30, 0xc6, 0xb5, 0x19, 0x47, 0x64, 0x21, 0x6c, 0x46, 0x95, 0xd2, 0x91, 0xa4,
-	0x25, 0x00, 0xc7, 0x88, 0xd0, 0x3d, 0x18, 0x7e, 0x93, 0xf2, 0xb7, 0x68, 0x5f, 0x1c, 0x8b, 0xcd,
-	0xc8, 0x45, 0xcf, 0x58, 0x63, 0x80, 0x05, 0x0a, 0x14, 0x30, 0xa3, 0x3f, 0x26, 0x70, 0xf5, 0x13,
-	0xbb, 0x22, 0x31, 0xb3, 0x32, 0xbf, 0xcc, 0x84, 0xb0, 0x1d, 0x64, 0xbf, 0xb0, 0x24, 0xc4, 0xc2,
-	0xe0, 0x27, 0x5a, 0xbc, 0x4b, 0xc2, 0xe0, 0x27, 0xfa, 0x5c, 0x70, 0x34, 0x3e, 0x0f, 0x57, 0x72,
-	0x27, 0xe3, 0x68, 0x71, 0x56, 0xff, 0x95, 0x0a, 0x0c, 0x36, 0x09, 0xb1, 0xce, 0x61, 0x65, 0xbe,
-	0x9e, 0x90, 0x76, 0xbe, 0xb3, 0x74, 0x20, 0xfe, 0x22, 0x65, 0xd5, 0x56, 0x4a, 0x59, 0xf5, 0xd1,
-	0xd2, 0x14, 0x7a, 0x6b, 0xaa, 0x7e, 0xae, 0x02, 0x40, 0xab, 0x2d, 0x18, 0xe6, 0x0e, 0xe7, 0x38,
-	0x72, 0x35, 0x6b, 0x49, 0x8e, 0x93, 0x5d, 0x86, 0xe7, 0xf9, 0x78, 0xab, 0xc3, 0xb0, 0xcf, 0x4e,
-	0x22, 0xf1, 0xee, 0x01, 0x3c, 0x35, 0x36, 0x2d, 0xc1, 0x02, 0x92, 0xe4, 0x16, 0x83, 0xa7, 0xc4,
-	0x2d, 0xf4, 0x3d, 0x60, 0xb9, 0x4c, 0xeb, 0xab, 0x4d, 0xd4, 0x56, 0x66, 0xa7, 0x52, 0x5e, 0x96,
-	0x17, 0xe8, 0x8e, 0xdc, 0xe5, 0x9f, 0xd2, 0xe0, 0x42, 0xaa, 0xee, 0x31, 0xee, 0x74, 0x67, 0xc2,
-	0x33, 0xf5, 0xdf, 0xd5, 0x60, 0x94, 0xf6, 0xe5, 0x1c, 0x18, 0xcd, 0xdf, 0x4e, 0x32, 0x9a, 0x0f,
-	0x97, 0x9d, 0xe2, 0x02, 0xfe, 0xf2, 0x67, 0x15, 0x60, 0x19, 0x2f, 0x84, 0x89, 0x82, 0xf2, 0xf2,
-	0xaf, 0x15, 0xbc, 0xfc, 0xdf, 0x14, 0x86, 0x03, 0x29, 0x1d, 0xa5, 0x62, 0x3c, 0xf0, 0x01, 0xc5,
-	0x36, 0x60, 0x20, 0xb9, 0x6d, 0x72, 0xec, 0x03, 0xde, 0x82, 0xc9, 0x60, 0xdb, 0xf3, 0x42, 0x19,
-	0xd9, 0x60, 0xb0, 0xbc, 0x3e, 0x9a, 0x59, 0x58, 0x47, 0x43, 0xe1, 0x0f, 0x50, 0x4d, 0x15, 0x37,
-	0x4e, 0x92, 0x42, 0x73, 0x00, 0x9b, 0x8e, 0x67, 0xee, 0xd4, 0x1a, 0x75, 0x1c, 0x59, 0xd4, 0x32,
-	0xa3, 0xa5, 0x05, 0x59, 0x8a, 0x95, 0x1a, 0x7d, 0xd9, 0x32, 0x7c, 0x4d, 0xe3, 0x33, 0x7d, 0x82,
-	0xc5, 0x7b, 0x8e, 0x1c, 0xe5, 0xfd, 0x29, 0x8e, 0xa2, 0x24, 0xdc, 0x4f, 0x70, 0x95, 0x6a, 0x24,
-	0xb0, 0x0f, 0xc6, 0xfa, 0xe7, 0x44, 0xa2, 0xb0, 0x5f, 0x17, 0xc3, 0x94, 0x49, 0x53, 0x3a, 0x30,
-	0xe9, 0xa8, 0xc9, 0x5f, 0xc5, 0x1e, 0x29, 0x95, 0x37, 0x56, 0xba, 0x68, 0x24, 0x8a, 0x71, 0x92,
-	0x00, 0x7a, 0x0e, 0x26, 0xa3, 0xd1, 0xd1, 0xc9, 0x8c, 0x2c, 0x37, 0xd8, 0x72, 0x58, 0x53, 0x01,
-	0x38, 0x59, 0x4f, 0xff, 0x6c, 0x05, 0x1e, 0xe3, 0x7d, 0x67, 0x1a, 0x83, 0x3a, 0xe9, 0x10, 0xd7,
-	0x22, 0xae, 0xb9, 0xcf, 0x64, 0x56, 0xcb, 0x6b, 0xa1, 0xb7, 0x61, 0xf8, 0x01, 0x21, 0x96, 0xd4,
-	0x68, 0xbf, 0x52, 0x3e, 0xe7, 0x4c, 0x01, 0x89, 0x57, 0x18, 0x7a, 0xce, 0xd1, 0xf9, 0xff, 0x58,
-	0x90, 0xa4, 0xc4, 0x3b, 0xbe, 0xb7, 0x29, 0x45, 0xab, 0xd3, 0x27, 0xbe, 0xc6, 0xd0, 0x73, 0xe2,
-	0xfc, 0x7f, 0x2c, 0x48, 0xea, 0x6b, 0xf0, 0xf8, 0x31, 0x9a, 0x9e, 0x44, 0x84, 0x3e, 0x0a, 0x23,
-	0x1f, 0xfd, 0x49, 0x30, 0x7e, 0x45, 0x83, 0x27, 0x14, 0x94, 0x8b, 0x7b, 0x54, 0xaa, 0xaf, 0x19,
-	0x1d, 0xc3, 0xa4, 0x77, 0x54, 0xe6, 0xad, 0x7d, 0xa2, 0x1c, 0x18, 0x9f, 0xd2, 0x60, 0x84, 0x1b,
-	0xd2, 0x44, 0xec, 0xf7, 0xf5, 0x3e, 0xa7, 0xbc, 0xb0, 0x4b, 0x51, 0x70, 0xe5, 0x68, 0x6c, 0xfc,
-	0x77, 0x80, 0x23, 0xfa, 0xfa, 0xbf, 0x1d, 0x82, 0x6f, 0x39, 0x3e, 0x22, 0xf4, 0x35, 0x2d, 0x9b,
-	0xb1, 0xb7, 0x7d, 0xb6, 0x9d, 0x97, 0x5a, 0x0c, 0x71, 0x31, 0x7e, 0x25, 0x93, 0xc0, 0xe6, 0x94,
-	0x14, 0x24, 0x4a, 0x7a, 0xe0, 0x7f, 0xae, 0xc1, 0x04, 0x3d, 0x96, 0x24, 0x73, 0xe1, 0x9f, 0xa9,
-	0x73, 0xc6, 0x23, 0x5d, 0x55, 0x48, 0xa6, 0x3c, 0x2f, 0x55, 0x10, 0x4e, 0xf4, 0x0d, 0x6d, 0x24,
-	0x5f, 0x83, 0xf8, 0x75, 0xeb, 0x46, 0x9e, 0x34, 0x72, 0x92, 0xf4, 0x50, 0xb3, 0x0e, 0x4c, 0x25,
-	0x67, 0xfe, 0x2c, 0xd5, 0x3b, 0xb3, 0x2f, 0xc2, 0xc5, 0xcc, 0xe8, 0x4f, 0xa4, 0xdc, 0xf8, 0xbb,
-	0x83, 0x50, 0x55, 0xa6, 0x3a, 0x61, 0x4a, 0x17, 0xc9, 0x04, 0x3f, 0xa3, 0xc1, 0xb8, 0xe1, 0xba,
-	0xc2, 0x1c, 0x23, 0x5a, 0xbf, 0x56, 0x9f, 0x5f, 0x35, 0x8f, 0xd4, 0xdc, 0x7c, 0x4c, 0x26, 0x65,
-	0x6f, 0xa0, 0x40, 0xb0, 0xda, 0x9b, 0x1e, 0x46, 0x75, 0x95, 0x73, 0x33, 0xaa, 0x43, 0x3f, 0x18,
-	0x1d, 0xc4, 0x7c, 0x19, 0xbd, 0x7a, 0x06, 0x73, 0xc3, 0xce, 0xf5, 0x7c, 0x6d, 0xda, 0xec, 0x47,
-	0x61, 0x3a, 0x3d, 0x73, 0x27, 0x5a, 0x05, 0xbf, 0x32, 0x90, 0x60, 0xd5, 0x85, 0xe4, 0x8f, 0xa1,
-	0x43, 0xfc, 0x7c, 0x6a, 0xb1, 0x70, 0x16, 0x60, 0x9f, 0xd5, 0x84, 0x9c, 0xee, 0x8a, 0x19, 0x38,
-	0x3f, 0x33, 0xcc, 0x7e, 0x3f, 0xd9, 0x02, 0x5c, 0x51, 0xe6, 0x47, 0x49, 0xc7, 0xf7, 0x14, 0x8c,
-	0xec, 0xda, 0x81, 0x1d, 0xc5, 0xd1, 0x51, 0x4e, 0xe8, 0x97, 0x79, 0x31, 0x8e, 0xe0, 0xfa, 0x72,
-	0x62, 0xef, 0xaf, 0x7b, 0x1d, 0xcf, 0xf1, 0x5a, 0xfb, 0xf3, 0x0f, 0x0c, 0x9f, 0x60, 0xaf, 0x1b,
-	0x0a, 0x6c, 0xc7, 0x3d, 0xef, 0x57, 0xe0, 0xa6, 0x82, 0x2d, 0x37, 0x20, 0xc0, 0x49, 0xd0, 0xfd,
-	0xe1, 0x48, 0x24, 0xba, 0x0a, 0x8f, 0xc9, 0x5f, 0xd3, 0xe0, 0x1a, 0x29, 0x3a, 0x0a, 0x84, 0x1c,
-	0xfb, 0xea, 0x59, 0x1d, 0x35, 0x22, 0xce, 0x6a, 0x11, 0x18, 0x17, 0xf7, 0x0c, 0xed, 0x27, 0x92,
-	0x52, 0x56, 0xfa, 0xd1, 0xc3, 0xe5, 0x7c, 0xef, 0x5e, 0x29, 0x29, 0xd1, 0xcf, 0x6b, 0x70, 0xd9,
-	0xc9, 0xd9, 0x3a, 0x42, 0x64, 0x6d, 0x9e, 0xc1, 0xae, 0xe4, 0x6f, 0x9e, 0x79, 0x10, 0x9c, 0xdb,
-	0x15, 0xf4, 0x8b, 0x85, 0x91, 0x2a, 0xf8, 0x93, 0xe4, 0x7a, 0x9f, 0x9d, 0x3c, 0xad, 0xa0, 0x15,
-	0x9f, 0xd5, 0x00, 0x59, 0x19, 0xb1, 0x58, 0x58, 0x91, 0xbc, 0x74, 0xea, 0xc2, 0x3f, 0x7f, 0xb4,
-	0xce, 0x96, 0xe3, 0x9c, 0x4e, 0xb0, 0xef, 0x1c, 0xe6, 0x6c, 0x5f, 0x11, 0x82, 0xb6, 0xdf, 0xef,
-	0x9c, 0xc7, 0x19, 0xf8, 0x77, 0xce, 0x83, 0xe0, 0xdc, 0xae, 0xe8, 0xbf, 0x33, 0xcc, 0xb5, 0x34,
-	0xec, 0x55, 0x71, 0x13, 0x86, 0x37, 0x99, 0x56, 0x4f, 0xec, 0xdb, 0xd2, 0x2a, 0x44, 0xae, 0x1b,
-	0xe4, 0x77, 0x24, 0xfe, 0x3f, 0x16, 0x98, 0xd1, 0x6b, 0x30, 0x60, 0xb9, 0x81, 0xd8, 0x70, 0x1f,
-	0xe9, 0x43, 0x19, 0x16, 0xbb, 0xf2, 0xd4, 0x57, 0x9b, 0x98, 0x22, 0x45, 0x2e, 0x8c, 0xba, 0x42,
-	0xb1, 0x21, 0xee, 0x9e, 0xa5, 0xf3, 0x9d, 0x4a, 0x05, 0x89, 0x54, 0xcb, 0x44, 0x25, 0x58, 0xd2,
-	0xa0, 0xf4, 0x52, 0x9a, 0xfc, 0xd2, 0xf4, 0xa4, 0x6a, 0xaf, 0x97, 0xf6, 0x94, 0xc0, 0x70, 0x68,
-	0xd8, 0x6e, 0xc8, 0xd5, 0x2a, 0x25, 0x9f, 0xcc, 0x29, 0xb5, 0x75, 0x8a, 0x25, 0xd6, 0x5f, 0xb0,
-	0x9f, 0x01, 0x16, 0xc8, 0xe9, 0x32, 0xd8, 0x65, 0x49, 0xc6, 0xc5, 0x36, 0x2a, 0xbd, 0x0c, 0x78,
-	0xaa, 0x72, 0xbe, 0x0c, 0xf8, 0xff, 0x58, 0x60, 0x46, 0x6f, 0xc0, 0x68, 0x10, 0x19, 0x39, 0x8c,
-	0xf6, 0x9b, 0x9a, 0x56, 0x58, 0x38, 0x08, 0xef, 0x1a, 0x61, 0xda, 0x20, 0xf1, 0xa3, 0x4d, 0x18,
-	0xb1, 0xb9, 0x3f, 0x88, 0x08, 0xb3, 0xf3, 0x91, 0x3e, 0x32, 0xb3, 0x45, 0xd9, 0xf7, 0x79, 0x70,
-	0x86, 0x08, 0xb1, 0xfe, 0x87, 0xc0, 0xb5, 0xe2, 0xc2, 0x8e, 0x6c, 0x0b, 0x46, 0x23, 0x74, 0xfd,
-	0x78, 0x79, 0x45, 0xb9, 0x30, 0xf9, 0xd0, 0x64, 0x66, 0x4c, 0x89, 0x1b, 0xd5, 0xf2, 0xbc, 0xf5,
-	0xe2, 0xc0, 0xfc, 0xc7, 0xf3, 0xd4, 0x7b, 0x93, 0x25, 0xaf, 0x8b, 0x7c, 0xe6, 0x07, 0xca, 0x2f,
-	0x2d, 0xe9, 0x4f, 0x9f, 0x48, 0x5a, 0x17, 0xb9, 0xdc, 0x2b, 0x44, 0x0a, 0xec, 0xec, 0x06, 0x4b,
-	0xd9, 0xd9, 0xbd, 0x00, 0x17, 0x84, 0x5d, 0x43, 0x83, 0x25, 0x9a, 0x0f, 0xf7, 0x85, 0x23, 0x02,
-	0xb3, 0x78, 0xa9, 0x25, 0x41, 0x38, 0x5d, 0x17, 0xfd, 0x96, 0x06, 0xa3, 0xa6, 0x10, 0x10, 0xc4,
-	0xbe, 0x5a, 0xee, 0xef, 0xe9, 0x64, 0x2e, 0x92, 0x37, 0xb8, 0xe8, 0xfb, 0x72, 0xb4, 0xa3, 0xa3,
-	0xe2, 0x53, 0xba, 0xe2, 0xcb, 0x5e, 0xa3, 0x3f, 0xa0, 0xd2, 0xbd, 0xc3, 0xf2, 0x73, 0x32, 0xbf,
-	0x64, 0xee, 0x21, 0x71, 0xbf, 0xcf, 0x51, 0xcc, 0xc7, 0x18, 0xf9, 0x40, 0xbe, 0x5b, 0xca, 0xf0,
-	0x31, 0xe4, 0x94, 0xc6, 0xa2, 0x76, 0x1f, 0xfd, 0x53, 0x0d, 0x9e, 0xe0, 0x6e, 0x29, 0x35, 0x7a,
-	0xe6, 0xb3, 0x34, 0xe7, 0x24, 0xce, 0xab, 0x1e, 0x5b, 0x05, 0x8e, 0x9e, 0xd8, 0x2a, 0xf0, 0xc9,
-	0xc3, 0x83, 0xea, 0x13, 0xb5, 0x63, 0xe0, 0xc6, 0xc7, 0xea, 0x01, 0x7a, 0x0b, 0x26, 0x1d, 0x35,
-	0x76, 0x8a, 0x60, 0x30, 0xa5, 0x14, 0xf3, 0x89, 0x20, 0x2c, 0x5c, 0x13, 0x9b, 0x28, 0xc2, 0x49,
-	0x52, 0xb3, 0x3b, 0x30, 0x99, 0x58, 0x68, 0x67, 0xaa, 0xd2, 0x70, 0x61, 0x3a, 0xbd, 0x1e, 0xce,
-	0xd4, 0x42, 0xe6, 0x1e, 0x8c, 0xc9, 0x83, 0x0a, 0x3d, 0xa6, 0x10, 0x8a, 0x8f, 0xfd, 0x7b, 0x64,
-	0x9f, 0x53, 0xad, 0x26, 0xae, 0x63, 0x5c, 0xdf, 0xfe, 0x32, 0x2d, 0x10, 0x08, 0xf5, 0x3f, 0x16,
-	0xfa, 0xf6, 0x75, 0xd2, 0xee, 0x38, 0x46, 0x48, 0xde, 0xfd, 0xaf, 0xbd, 0xfa, 0x7f, 0xd3, 0xf8,
-	0x79, 0xc3, 0x8f, 0x55, 0x64, 0xc0, 0x78, 0x9b, 0x07, 0x08, 0x66, 0xae, 0xf8, 0x5a, 0xf9, 0x20,
-	0x00, 0x2b, 0x31, 0x1a, 0xac, 0xe2, 0x44, 0x0f, 0x60, 0x2c, 0x12, 0x44, 0x22, 0xfd, 0xc1, 0x52,
-	0x7f, 0x82, 0x81, 0x94, 0x79, 0xe4, 0x43, 0x62, 0x54, 0x12, 0xe0, 0x98, 0x96, 0x6e, 0x00, 0xca,
-	0xb6, 0xa1, 0x77, 0xd6, 0xc8, 0xf0, 0x5d, 0x4b, 0x46, 0xdd, 0xcb, 0x18, 0xbf, 0x1f, 0x99, 0x91,
-	0x5b, 0xff, 0xed, 0x0a, 0xe4, 0x66, 0x87, 0x43, 0x3a, 0x0c, 0x73, 0x5f, 0xb4, 0x28, 0xd9, 0x37,
-	0x15, 0x65, 0xb8, 0xa3, 0x1a, 0x16, 0x10, 0x74, 0x9f, 0xeb, 0x2d, 0x5c, 0x8b, 0x45, 0xbb, 0x8b,
-	0xb9, 0x84, 0xea, 0xf5, 0xb8, 0x98, 0x57, 0x01, 0xe7, 0xb7, 0x43, 0xbb, 0x80, 0xda, 0xc6, 0x5e,
-	0x1a, 0x5b, 0x1f, 0xe9, 0x8f, 0x56, 0x32, 0xd8, 0x70, 0x0e, 0x05, 0x7a, 0x90, 0x1a, 0xa6, 0x49,
-	0x3a, 0x21, 0xb1, 0xf8, 0x10, 0xa3, 0xe7, 0x3e, 0x76, 0x90, 0xce, 0x27, 0x41, 0x38, 0x5d, 0x57,
-	0xff, 0xea, 0x20, 0x5c, 0x4b, 0x4e, 0x22, 0xdd, 0xa1, 0x91, 0xbb, 0xd8, 0x8b, 0x91, 0x35, 0x3c,
-	0x9f, 0xc8, 0xa7, 0xd2, 0xd6, 0xf0, 0x33, 0x35, 0x9f, 0xb0, 0x23, 0xd9, 0x70, 0x82, 0xa8, 0x51,
-	0xc2, 0x32, 0xfe, 0x1b, 0xe0, 0xfb, 0x55, 0xe0, 0xe3, 0x36, 0x70, 0xa6, 0x3e, 0x6e, 0xef, 0x68,
-	0x30, 0x9b, 0x2c, 0x5e, 0xb2, 0x5d, 0x3b, 0xd8, 0x16, 0x31, 0xdb, 0x4e, 0x6e, 0x8c, 0xcf, 0x52,
-	0x24, 0x2c, 0x17, 0x62, 0xc4, 0x3d, 0xa8, 0xa1, 0x4f, 0x6b, 0x70, 0x3d, 0x35, 0x2f, 0x89, 0x08,
-	0x72, 0x27, 0xb7, 0xcb, 0x67, 0xde, 0xba, 0xcb, 0xc5, 0x28, 0x71, 0x2f, 0x7a, 0xfa, 0xbf, 0xaa,
-	0xc0, 0x10, 0x7b, 0xad, 0x7e, 0x77, 0x98, 0x27, 0xb3, 0xae, 0x16, 0x5a, 0xec, 0xb4, 0x52, 0x16,
-	0x3b, 0x2f, 0x96, 0x27, 0xd1, 0xdb, 0x64, 0xe7, 0xbb, 0xe1, 0x2a, 0xab, 0x36, 0x6f, 0x31, 0x25,
-	0x4a, 0x40, 0xac, 0x79, 0xcb, 0x62, 0xb1, 0x02, 0x8e, 0xd6, 0x1c, 0x3f, 0x06, 0x03, 0x5d, 0xdf,
-	0x49, 0x47, 0xcf, 0xd8, 0xc0, 0xcb, 0x98, 0x96, 0xeb, 0xef, 0x68, 0x30, 0xcd, 0x70, 0x2b, 0xdb,
-	0x17, 0xed, 0xc2, 0xa8, 0x2f, 0xb6, 0xb0, 0xf8, 0x36, 0xcb, 0xa5, 0x87, 0x96, 0xc3, 0x16, 0x44,
-	0xfe, 0x4a, 0xf1, 0x0b, 0x4b, 0x5a, 0xfa, 0x97, 0x87, 0x61, 0xa6, 0xa8, 0x11, 0xfa, 0x29, 0x0d,
-	0xae, 0x9a, 0xb1, 0x34, 0x37, 0xdf, 0x0d, 0xb7, 0x3d, 0xdf, 0x0e, 0x6d, 0x61, 0xc6, 0x51, 0xf2,
-	0x9a, 0x5b, 0x9b, 0x97, 0xbd, 0x62, 0x11, 0xcf, 0x6a, 0xb9, 0x14, 0x70, 0x01, 0x65, 0xf4, 0x36,
-	0xc0, 0x4e, 0x1c, 0x62, 0xb5, 0x52, 0x3e, 0x99, 0x03, 0x1b, 0xb6, 0x12, 0x86, 0x35, 0xea, 0x14,
-	0xd3, 0x43, 0x2a, 0xe5, 0x0a, 0x39, 0x4a, 0x3c, 0x08, 0xb6, 0xef, 0x91, 0xfd, 0x8e, 0x61, 0x47,
-	0x8f, 0xf5, 0xe5, 0x89, 0x37, 0x9b, 0x77, 0x05, 0xaa, 0x24, 0x71, 0xa5, 0x5c, 0x21, 0x87, 0x3e,
-	0xa1, 0xc1, 0xa4, 0xa7, 0x3a, 0x16, 0xf7, 0x63, 0x0b, 0x99, 0xeb, 0xa1, 0xcc, 0x45, 0xe8, 0x24,
-	0x28, 0x49, 0x92, 0xae, 0x89, 0x8b, 0x41, 0xfa, 0xc8, 0x12, 0x4c, 0x6d, 0xa5, 0xff, 0xe4, 0xb3,
-	0xca, 0xf9, 0xc7, 0xaf, 0xe3, 0x59, 0x70, 0x96, 0x3c, 0xeb, 0x14, 0x09, 0x4d, 0x2b, 0x4e, 0x85,
-	0x49, 0x3b, 0x35, 0x5c, 0xbe, 0x53, 0x8b, 0xeb, 0xb5, 0x7a, 0x02, 0x59, 0xb2, 0x53, 0x59, 0x70,
-	0x96, 0xbc, 0xfe, 0xf1, 0x0a, 0x3c, 0x52, 0xb0, 0xc6, 0xfe, 0xda, 0x78, 0x82, 0xff, 0x9e, 0x06,
-	0x63, 0x6c, 0x0e, 0xde, 0x25, 0xee, 0x24, 0xac, 0xaf, 0x05, 0x36, 0x6d, 0xbf, 0xab, 0xc1, 0xc5,
-	0x4c, 0xac, 0xcd, 0x63, 0x39, 0x23, 0x9c, 0x9b, 0xb9, 0xd5, 0xfb, 0xe2, 0xb8, 0xda, 0x03, 0xb1,
-	0x6b, 0x6b, 0x3a, 0xa6, 0xb6, 0xfe, 0x0a, 0x4c, 0x26, 0x4c, 0xda, 0x64, 0xd4, 0x1e, 0x2d, 0x37,
-	0x6a, 0x8f, 0x1a, 0x94, 0xa7, 0xd2, 0x2b, 0x28, 0x4f, 0xbc, 0xe4, 0xb3, 0x9c, 0xed, 0xaf, 0xcd,
-	0x92, 0xff, 0xca, 0x05, 0xb1, 0xe4, 0xd9, 0xfb, 0xc0, 0xeb, 0x30, 0xcc, 0x42, 0x00, 0x45, 0x27,
-	0xe6, 0xed, 0xd2, 0xa1, 0x85, 0x02, 0x7e, 0x93, 0xe2, 0xff, 0x63, 0x81, 0x15, 0xd5, 0x61, 0xda,
-	0x74, 0xbc, 0xae, 0x25, 0xd2, 0x60, 0xae, 0xc6, 0x97, 0x36, 0x19, 0x21, 0xb2, 0x96, 0x82, 0xe3,
-	0x4c, 0x0b, 0x84, 0xf9, 0x0b, 0x03, 0x3f, 0xcf, 0x4a, 0x45, 0x88, 0xac, 0xaf, 0x36, 0x79, 0x86,
-	0x05, 0xf9, 0xb2, 0xf0, 0x26, 0x00, 0x89, 0x16, 0x6f, 0xe4, 0x05, 0xf8, 0x42, 0xb9, 0xd8, 0x97,
-	0x72, 0x0b, 0x44, 0xc2, 0xa7, 0x2c, 0x0a, 0xb0, 0x42, 0x04, 0xf9, 0x30, 0xbe, 0x6d, 0x6f, 0x12,
-	0xdf, 0xe5, 0x72, 0xd4, 0x50, 0x79, 0x11, 0xf1, 0x6e, 0x8c, 0x86, 0xdf, 0xf1, 0x95, 0x02, 0xac,
-	0x12, 0x41, 0x3e, 0x17, 0x47, 0xb8, 0x7a, 0xb8, 0x9f, 0xcc, 0xf4, 0xb1, 0xde, 0x39, 0x1e, 0x67,
-	0x5c, 0x86, 0x15, 0x2a, 0xc8, 0x05, 0x70, 0x65, 0xec, 0xaf, 0x7e, 0x5e, 0x1c, 0xe2, 0x08, 0x62,
-	0x5c, 0xf0, 0x88, 0x7f, 0x63, 0x85, 0x02, 0x9d, 0xd7, 0x76, 0x1c, 0x4c, 0x4e, 0xe8, 0x10, 0x5f,
-	0xec, 0x33, 0xa0, 0x9f, 0xd0, 0x9d, 0xc4, 0x05, 0x58, 0x25, 0x42, 0xc7, 0xd8, 0x96, 0x21, 0xe0,
-	0x84, 0x8e, 0xb0, 0xd4, 0x18, 0xe3, 0x40, 0x72, 0x22, 0x4d, 0x97, 0xfc, 0x8d, 0x15, 0x0a, 0xe8,
-	0x0d, 0xe5, 0x61, 0x0a, 0xca, 0x6b, 0xa0, 0x8e, 0xf5, 0x28, 0xf5, 0xa1, 0x58, 0x11, 0x33, 0xce,
-	0xf6, 0xea, 0x75, 0x45, 0x09, 0xc3, 0x42, 0xe3, 0x51, 0xfe, 0x91, 0x51, 0xca, 0xc4, 0xc6, 0xb4,
-	0x13, 0x3d, 0x8d, 0x69, 0x6b, 0x54, 0x42, 0x53, 0x9c, 0x3b, 0x18, 0x53, 0x98, 0x8c, 0x5f, 0x38,
-	0x9a, 0x69, 0x20, 0xce, 0xd6, 0xe7, 0x4c, 0x9f, 0x58, 0xac, 0xed, 0x94, 0xca, 0xf4, 0x79, 0x19,
-	0x96, 0x50, 0xb4, 0x0b, 0x13, 0x81, 0x62, 0x99, 0x2b, 0x72, 0x2b, 0xf6, 0xf1, 0x36, 0x25, 0xac,
-	0x72, 0x59, 0x50, 0x24, 0xb5, 0x04, 0x27, 0xe8, 0xa0, 0xb7, 0x55, 0x53, 0xc4, 0xe9, 0xf2, 0x6e,
-	0x98, 0xf9, 0x21, 0xff, 0x62, 0x0d, 0x9b, 0xb4, 0x82, 0x53, 0x2d, 0x04, 0xbb, 0x49, 0xa3, 0xbb,
-	0x8b, 0xa7, 0xe2, 0x76, 0x7e, 0xa4, 0x51, 0x1e, 0xfd, 0xb4, 0x64, 0xaf, 0xe3, 0x05, 0x5d, 0x9f,
-	0xb0, 0x50, 0xa6, 0xec, 0xf3, 0xa0, 0xf8, 0xd3, 0x2e, 0xa6, 0x81, 0x38, 0x5b, 0x9f, 0xa5, 0xa5,
-	0xe7, 0xa9, 0x29, 0xe9, 0xd1, 0xe5, 0xb9, 0xc4, 0x0d, 0x03, 0x96, 0x7b, 0xb1, 0xa4, 0xa7, 0x64,
-	0x33, 0x85, 0x8b, 0xe7, 0xf3, 0x49, 0x97, 0xe2, 0x0c, 0x4d, 0xba, 0x72, 0x54, 0xc7, 0x75, 0x96,
-	0xc2, 0xb1, 0xe4, 0xca, 0x51, 0x9d, 0xe2, 0xf9, 0xca, 0x51, 0x4b, 0x70, 0x82, 0x0e, 0x7a, 0x0e,
-	0x26, 0x83, 0x28, 0xcf, 0x0a, 0x9b, 0xc1, 0x2b, 0x71, 0x64, 0xa9, 0xa6, 0x0a, 0xc0, 0xc9, 0x7a,
-	0xfa, 0xbf, 0xd3, 0x00, 0xa4, 0xf6, 0xe0, 0x3c, 0x74, 0xe2, 0x56, 0x42, 0xa1, 0xb2, 0xd0, 0x97,
-	0xb6, 0x83, 0x14, 0x6a, 0xc6, 0xbf, 0xa4, 0xc1, 0x54, 0x5c, 0xed, 0x1c, 0x44, 0x75, 0x33, 0x29,
-	0xaa, 0x7f, 0xb4, 0xbf, 0x71, 0x15, 0xc8, 0xeb, 0xff, 0xb7, 0xa2, 0x8e, 0x8a, 0x49, 0x63, 0xbb,
-	0x89, 0x37, 0x66, 0x4a, 0xfa, 0x6e, 0x3f, 0x6f, 0xcc, 0xaa, 0x33, 0x6d, 0x3c, 0xde, 0x9c, 0x37,
-	0xe7, 0xbf, 0x93, 0x90, 0x85, 0xfa, 0x70, 0x19, 0x97, 0x82, 0x4f, 0x44, 0x9a, 0x4f, 0xc0, 0x51,
-	0x82, 0xd1, 0x9b, 0x2a, 0xab, 0xe4, 0xaf, 0xd5, 0x1f, 0x2b, 0xe7, 0xa7, 0xac, 0x0c, 0xb8, 0x27,
-	0x83, 0xd4, 0xff, 0xc1, 0x14, 0x8c, 0x2b, 0x8a, 0xb6, 0xd4, 0x8b, 0xb9, 0x76, 0x1e, 0x2f, 0xe6,
-	0x21, 0x8c, 0x9b, 0x32, 0x34, 0x78, 0x34, 0xed, 0x7d, 0xd2, 0x94, 0x2c, 0x3a, 0x0e, 0x3a, 0x1e,
-	0x60, 0x95, 0x0c, 0x15, 0x24, 0xe4, 0x1a, 0x1b, 0x38, 0x05, 0x3b, 0x86, 0x5e, 0xeb, 0xea, 0x83,
-	0x00, 0x91, 0x2c, 0x4a, 0x2c, 0x11, 0xdb, 0x51, 0x9a, 0x8c, 0x37, 0x82, 0xbb, 0x12, 0x86, 0x95,
-	0x7a, 0xd9, 0x17, 0xd8, 0xa1, 0x73, 0x7b, 0x81, 0xa5, 0xcb, 0xc0, 0x89, 0x32, 0xd3, 0xf4, 0x65,
-	0x93, 0x23, 0xf3, 0xdb, 0xc4, 0xcb, 0x40, 0x16, 0x05, 0x58, 0x21, 0x52, 0x60, 0x38, 0x31, 0x52,
-	0xca, 0x70, 0xa2, 0x0b, 0x97, 0x7c, 0x12, 0xfa, 0xfb, 0xb5, 0x7d, 0x93, 0x25, 0x6c, 0xf2, 0x43,
-	0x76, 0xa3, 0x1c, 0x2d, 0x17, 0x6b, 0x08, 0x67, 0x51, 0xe1, 0x3c, 0xfc, 0x09, 0x61, 0x6c, 0xac,
-	0xa7, 0x30, 0xf6, 0x21, 0x18, 0x0f, 0x89, 0xb9, 0xed, 0xda, 0xa6, 0xe1, 0x34, 0xea, 0x22, 0xf0,
-	0x61, 0x2c, 0x57, 0xc4, 0x20, 0xac, 0xd6, 0x43, 0x0b, 0x30, 0xd0, 0xb5, 0x2d, 0x21, 0x8d, 0x7e,
-	0x9b, 0x54, 0x59, 0x37, 0xea, 0x0f, 0x0f, 0xaa, 0xef, 0x8d, 0x2d, 0x11, 0xe4, 0xa8, 0x6e, 0x75,
-	0x76, 0x5a, 0xb7, 0xc2, 0xfd, 0x0e, 0x09, 0xe6, 0x36, 0x1a, 0x75, 0x4c, 0x1b, 0xe7, 0x19, 0x95,
-	0x4c, 0x9c, 0xc0, 0xa8, 0xe4, 0xb3, 0x1a, 0x5c, 0x32, 0xd2, 0xda, 0x76, 0x12, 0xcc, 0x4c, 0x96,
-	0xe7, 0x96, 0xf9, 0x1a, 0xfc, 0x85, 0xeb, 0x62, 0x7c, 0x97, 0xe6, 0xb3, 0xe4, 0x70, 0x5e, 0x1f,
-	0x90, 0x0f, 0xa8, 0x6d, 0xb7, 0x64, 0x92, 0x18, 0xf1, 0xd5, 0xa7, 0xca, 0xe9, 0x11, 0x56, 0x32,
-	0x98, 0x70, 0x0e, 0x76, 0xf4, 0x00, 0xc6, 0xcd, 0x58, 0x27, 0x2f, 0xa4, 0xea, 0xfa, 0x69, 0x3c,
-	0x0a, 0xf0, 0x9b, 0x97, 0xaa, 0xf0, 0x57, 0x29, 0xc9, 0xd7, 0x34, 0xe5, 0xca, 0x2b, 0x5e, 0x94,
-	0xd8, 0xa8, 0xa7, 0xcb, 0xbf, 0xa6, 0xe5, 0x63, 0xc4, 0x3d, 0xa8, 0xb1, 0x08, 0x3f, 0x4e, 0x32,
-	0x97, 0x13, 0x4b, 0x63, 0x5e, 0xd2, 0x2b, 0x38, 0x95, 0x16, 0x8a, 0x2f, 0xcd, 0x54, 0x21, 0x4e,
-	0x13, 0x44, 0x4b, 0x80, 0x08, 0x57, 0xed, 0xc6, 0x17, 0x85, 0x60, 0x06, 0xc9, 0x9c, 0x57, 0x68,
-	0x31, 0x03, 0xc5, 0x39, 0x2d, 0xf4, 0x2f, 0x6a, 0x42, 0xf1, 0x76, 0x8e, 0x56, 0x15, 0x67, 0xfd,
-	0x24, 0xa7, 0xff, 0xb9, 0x06, 0x19, 0x59, 0x1f, 0x6d, 0xc2, 0x08, 0x45, 0x51, 0x5f, 0x6d, 0x8a,
-	0x61, 0x7d, 0xa4, 0xdc, 0xb1, 0xcb, 0x50, 0x70, 0x2d, 0xa6, 0xf8, 0x81, 0x23, 0xc4, 0xf4, 0xf6,
-	0xe0, 0x2a, 0x31, 0x9c, 0xc5, 0x08, 0x4b, 0xc9, 0x35, 0x6a, 0x2c, 0x68, 0x7e, 0x7b, 0x50, 0x4b,
-	0x70, 0x82, 0x8e, 0xbe, 0x0c, 0x10, 0xdf, 0xcf, 0xfa, 0x36, 0xb4, 0xf9, 0xfa, 0x10, 0x5c, 0xe9,
-	0xd7, 0xc5, 0x80, 0xa5, 0x22, 0x22, 0xbb, 0xb6, 0x19, 0xce, 0x6f, 0x85, 0xc4, 0xbf, 0x7f, 0x7f,
-	0x45, 0x26, 0xee, 0x2f, 0x99, 0x0b, 0x89, 0x3d, 0xcc, 0x2d, 0xe6, 0x62, 0xc4, 0x05, 0x94, 0xd8,
-	0xdd, 0x54, 0xa4, 0x46, 0xc6, 0x54, 0x28, 0x65, 0x59, 0xf9, 0x79, 0x9c, 0x14, 0x7e, 0x37, 0x4d,
-	0x03, 0x71, 0xb6, 0x7e, 0x1a, 0xc9, 0xb2, 0xdd, 0xb6, 0x79, 0x4e, 0x18, 0x2d, 0x8b, 0x84, 0x01,
-	0x71, 0xb6, 0xbe, 0x8a, 0x84, 0x7f, 0x29, 0xca, 0x35, 0x86, 0xb2, 0x48, 0x24, 0x10, 0x67, 0xeb,
-	0x23, 0x0b, 0x1e, 0xf5, 0x89, 0xe9, 0xb5, 0xdb, 0xc4, 0xb5, 0x78, 0x96, 0x3f, 0xc3, 0x6f, 0xd9,
-	0xee, 0x92, 0x6f, 0xb0, 0x8a, 0x4c, 0xd5, 0xa7, 0xb1, 0xcc, 0x06, 0x8f, 0xe2, 0x1e, 0xf5, 0x70,
-	0x4f, 0x2c, 0xa8, 0x0d, 0x17, 0x78, 0x4a, 0x21, 0xbf, 0xe1, 0x86, 0xc4, 0xdf, 0x35, 0x1c, 0xa1,
-	0xcf, 0x2b, 0x95, 0xde, 0x78, 0x23, 0x89, 0x0a, 0xa7, 0x71, 0xa3, 0x7d, 0x2a, 0xbf, 0x88, 0xee,
-	0x28, 0x24, 0x47, 0xcb, 0x27, 0xeb, 0xc2, 0x59, 0x74, 0x38, 0x8f, 0x86, 0xfe, 0x59, 0x0d, 0x84,
-	0x45, 0x33, 0x7a, 0x34, 0xf1, 0x66, 0x32, 0x9a, 0x7a, 0x2f, 0x89, 0x72, 0x19, 0x54, 0x72, 0x73,
-	0x19, 0xbc, 0x5f, 0x09, 0xc0, 0x33, 0x16, 0xf3, 0x3e, 0x8e, 0x59, 0xc9, 0xc3, 0xf2, 0x34, 0x8c,
-	0x49, 0x0e, 0x2c, 0x24, 0x63, 0x16, 0x50, 0x33, 0x66, 0xd5, 0x31, 0x5c, 0xff, 0x23, 0x0d, 0x04,
-	0x06, 0x96, 0x35, 0xe8, 0x58, 0xd9, 0x63, 0x8e, 0x34, 0x91, 0x52, 0xb2, 0xde, 0x0c, 0x14, 0x66,
-	0xbd, 0x39, 0xa3, 0x64, 0x30, 0xbf, 0xa6, 0xc1, 0x85, 0x64, 0x44, 0xa4, 0x00, 0xbd, 0x0f, 0x46,
-	0x44, 0xcc, 0x44, 0x11, 0xf4, 0x8c, 0x35, 0x15, 0x41, 0x0b, 0x70, 0x04, 0x4b, 0xaa, 0xd5, 0xfa,
-	0xb8, 0xaa, 0xe6, 0x07, 0x66, 0x3a, 0xe2, 0xd6, 0xf8, 0xc9, 0x69, 0x18, 0xe6, 0x01, 0xf7, 0x28,
-	0x4f, 0xcb, 0x71, 0xd6, 0xbc, 0x57, 0x3e, 0xae, 0x5f, 0x19, 0x0f, 0x3b, 0x35, 0xb6, 0x7d, 0xa5,
-	0x67, 0x6c, 0x7b, 0xcc, 0x93, 0x6c, 0xf5, 0xf1, 0x84, 0x52, 0xc3, 0x0d, 0x91, 0xb5, 0x3b, 0x4a,
-	0xb0, 0x15, 0x26, 0xde, 0x16, 0x06, 0xcb, 0x4b, 0x80, 0x7c, 0x02, 0x94, 0x17, 0x86, 0xa9, 0x9e,
-	0xaf, 0x0b, 0x51, 0x44, 0xb3, 0xa1, 0xf2, 0x26, 0x8b, 0x62, 0xca, 0x8f, 0x11, 0xd1, 0x4c, 0x6e,
-	0xa4, 0xe1, 0xc2, 0x8d, 0xb4, 0x05, 0x23, 0x62, 0x2b, 0x08, 0xe6, 0xf8, 0x91, 0x3e, 0xb2, 0x55,
-	0x29, 0x41, 0x78, 0x79, 0x01, 0x8e, 0x90, 0xd3, 0x13, 0xb7, 0x6d, 0xec, 0xd9, 0xed, 0x6e, 0x9b,
-	0x71, 0xc4, 0x21, 0xb5, 0x2a, 0x2b, 0xc6, 0x11, 0x9c, 0x55, 0xe5, 0x96, 0x9e, 0xec, 0x42, 0xa6,
-	0x56, 0xe5, 0xc5, 0x38, 0x82, 0xa3, 0xd7, 0x60, 0xb4, 0x6d, 0xec, 0x35, 0xbb, 0x7e, 0x8b, 0x88,
-	0x97, 0x85, 0x62, 0x19, 0xaf, 0x1b, 0xda, 0xce, 0x9c, 0xed, 0x86, 0x41, 0xe8, 0xcf, 0x35, 0xdc,
-	0xf0, 0xbe, 0xdf, 0x0c, 0x7d, 0x99, 0xb2, 0x66, 0x45, 0x60, 0xc1, 0x12, 0x1f, 0x72, 0x60, 0xaa,
-	0x6d, 0xec, 0x6d, 0xb8, 0x86, 0x4c, 0x8e, 0x3f, 0x5e, 0x92, 0x02, 0x7b, 0x5e, 0x5e, 0x49, 0xe0,
-	0xc2, 0x29, 0xdc, 0x39, 0x2f, 0xd9, 0x13, 0x67, 0xf5, 0x92, 0x3d, 0x2f, 0xfd, 0x76, 0xf8, 0xfd,
-	0xef, 0x5a, 0xae, 0x3f, 0x7b, 0x4f, 0x9f, 0x9c, 0xd7, 0xa5, 0x4f, 0xce, 0x54, 0xf9, 0xa7, 0xd7,
-	0x1e, 0xfe, 0x38, 0x5d, 0x18, 0xa7, 0x12, 0x36, 0x2f, 0xa5, 0x17, 0xb4, 0xd2, 0xaa, 0xcc, 0xba,
-	0x44, 0xa3, 0x24, 0x5b, 0x8d, 0x51, 0x63, 0x95, 0x0e, 0xba, 0xcf, 0x93, 0xa7, 0x3b, 0x24, 0x8c,
-	0xab, 0x30, 0xc5, 0xc0, 0x34, 0xdb, 0x3f, 0x32, 0xd7, 0x79, 0xa6, 0x02, 0xce, 0x6f, 0x17, 0xc7,
-	0x5e, 0xb9, 0x98, 0x1f, 0x7b, 0x05, 0xfd, 0x44, 0xde, 0x7b, 0x01, 0x62, 0x73, 0xfa, 0x5d, 0xe5,
-	0x79, 0x43, 0xe9, 0x57, 0x83, 0x7f, 0xad, 0xc1, 0x4c, 0xbb, 0x20, 0x2b, 0xa9, 0x78, 0xc6, 0x58,
-	0xef, 0x83, 0x3f, 0x14, 0x66, 0x3a, 0x5d, 0x78, 0xe2, 0xf0, 0xa0, 0x7a, 0x64, 0x3e, 0x54, 0x5c,
-	0xd8, 0x37, 0xe4, 0xc3, 0x48, 0xb0, 0x1f, 0x98, 0xa1, 0x13, 0xcc, 0x5c, 0x2e, 0x9f, 0xfc, 0x52,
-	0x70, 0xd6, 0x26, 0xc7, 0xc4, 0x59, 0x6b, 0x1c, 0xfa, 0x9d, 0x97, 0xe2, 0x88, 0x50, 0xbf, 0xde,
-	0xd9, 0x7d, 0x84, 0x9b, 0x9c, 0xbd, 0x0d, 0x13, 0x6a, 0x27, 0x4f, 0xe4, 0x14, 0xfe, 0x0b, 0x1a,
-	0x4c, 0xa7, 0x0f, 0x2d, 0x35, 0x3f, 0xbd, 0x76, 0xb6, 0xf9, 0xe9, 0x15, 0x3b, 0x9a, 0x4a, 0x0f,
-	0x3b, 0x9a, 0x17, 0xe0, 0x6a, 0xfe, 0x5a, 0xa6, 0x12, 0xa4, 0xe1, 0x38, 0xde, 0x03, 0x71, 0x73,
-	0x8b, 0xb3, 0x42, 0xd1, 0x42, 0xcc, 0x61, 0xfa, 0x0f, 0x42, 0x3a, 0xb8, 0x30, 0x7a, 0x03, 0xc6,
-	0x82, 0x60, 0x9b, 0xc7, 0x8d, 0x14, 0x83, 0x2c, 0x77, 0x65, 0x8f, 0x82, 0x4f, 0x72, 0xa1, 0x57,
-	0xfe, 0xc4, 0x31, 0xfa, 0x85, 0x57, 0xbf, 0xf0, 0xd5, 0x1b, 0xef, 0xf9, 0xe3, 0xaf, 0xde, 0x78,
-	0xcf, 0x97, 0xbf, 0x7a, 0xe3, 0x3d, 0x3f, 0x7c, 0x78, 0x43, 0xfb, 0xc2, 0xe1, 0x0d, 0xed, 0x8f,
-	0x0f, 0x6f, 0x68, 0x5f, 0x3e, 0xbc, 0xa1, 0xfd, 0xe7, 0xc3, 0x1b, 0xda, 0x4f, 0xfe, 0x97, 0x1b,
-	0xef, 0x79, 0xed, 0xd9, 0x98, 0xfa, 0xad, 0x88, 0x68, 0xfc, 0x4f, 0x67, 0xa7, 0x75, 0x8b, 0x52,
-	0x8f, 0x5c, 0x94, 0x18, 0xf5, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x00, 0xd7, 0x75, 0x8b, 0x45,
-	0xeb, 0x00, 0x00,
+	// 13073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x64, 0xd9,
+	0x59, 0x18, 0xee, 0xdb, 0xad, 0xe7, 0xa7, 0xc7, 0xcc, 0x9c, 0x79, 0x6c, 0xef, 0xec, 0xee, 0x68,
+	0x7c, 0x77, 0xe1, 0xb7, 0x8b, 0x41, 0x83, 0xd7, 0x36, 0xb6, 0x17, 0xd6, 0x6b, 0xa9, 0xa5, 0x99,
+	0x11, 0x23, 0xcd, 0xc8, 0x5f, 0x4b, 0x3b, 0x6b, 0xc3, 0x6f, 0xe1, 0xaa, 0xfb, 0xa8, 0x75, 0x57,
+	0xb7, 0xef, 0xed, 0xbd, 0xf7, 0xf6, 0x8c, 0xb4, 0x8b, 0x63, 0x20, 0x98, 0x60, 0x83, 0x29, 0x8a,
+	0x14, 0x71, 0xd9, 0x90, 0xc2, 0x40, 0x41, 0x1e, 0xa4, 0x08, 0x21, 0x45, 0x52, 0x84, 0x4a, 0x15,
+	0x45, 0x15, 0xc1, 0x54, 0x20, 0x45, 0x41, 0x52, 0x31, 0x09, 0x11, 0xb1, 0x20, 0x90, 0xaa, 0xa4,
+	0xa8, 0x54, 0x91, 0x84, 0xca, 0x24, 0x45, 0x52, 0xe7, 0x75, 0xef, 0xb9, 0xaf, 0x56, 0xeb, 0xb6,
+	0x24, 0xef, 0x16, 0xfc, 0x25, 0xf5, 0xf9, 0xce, 0xf9, 0xbe, 0x73, 0xce, 0x3d, 0x8f, 0xef, 0x7c,
+	0x4f, 0x58, 0x6c, 0xdb, 0xe1, 0x4e, 0x6f, 0x6b, 0xbe, 0xe9, 0x75, 0x6e, 0xb4, 0x2d, 0xbf, 0x45,
+	0x5d, 0xea, 0xc7, 0xff, 0x74, 0x77, 0xdb, 0x37, 0xac, 0xae, 0x1d, 0xdc, 0x68, 0x7a, 0x3e, 0xbd,
+	0xf1, 0xe0, 0xdd, 0x5b, 0x34, 0xb4, 0xde, 0x7d, 0xa3, 0xcd, 0x60, 0x56, 0x48, 0x5b, 0xf3, 0x5d,
+	0xdf, 0x0b, 0x3d, 0xf2, 0x7c, 0x8c, 0x63, 0x5e, 0x35, 0x8d, 0xff, 0xe9, 0xee, 0xb6, 0xe7, 0x19,
+	0x8e, 0x79, 0x86, 0x63, 0x5e, 0xe2, 0xb8, 0xfa, 0x75, 0x3a, 0x5d, 0xaf, 0xed, 0xdd, 0xe0, 0xa8,
+	0xb6, 0x7a, 0xdb, 0xfc, 0x17, 0xff, 0xc1, 0xff, 0x13, 0x24, 0xae, 0x3e, 0xb7, 0xfb, 0x81, 0x60,
+	0xde, 0xf6, 0x58, 0x67, 0x6e, 0x58, 0xbd, 0xd0, 0x0b, 0x9a, 0x96, 0x63, 0xbb, 0xed, 0x1b, 0x0f,
+	0x32, 0xbd, 0xb9, 0x6a, 0x6a, 0x55, 0x65, 0xb7, 0xfb, 0xd6, 0xf1, 0xb7, 0xac, 0x66, 0x5e, 0x9d,
+	0xf7, 0xc6, 0x75, 0x3a, 0x56, 0x73, 0xc7, 0x76, 0xa9, 0xbf, 0xaf, 0x26, 0xe4, 0x86, 0x4f, 0x03,
+	0xaf, 0xe7, 0x37, 0xe9, 0xb1, 0x5a, 0x05, 0x37, 0x3a, 0x34, 0xb4, 0xf2, 0x68, 0xdd, 0x28, 0x6a,
+	0xe5, 0xf7, 0xdc, 0xd0, 0xee, 0x64, 0xc9, 0x7c, 0xc3, 0x51, 0x0d, 0x82, 0xe6, 0x0e, 0xed, 0x58,
+	0x99, 0x76, 0xef, 0x29, 0x6a, 0xd7, 0x0b, 0x6d, 0xe7, 0x86, 0xed, 0x86, 0x41, 0xe8, 0xa7, 0x1b,
+	0x99, 0x9f, 0x36, 0xe0, 0xfc, 0xc2, 0xfa, 0x4a, 0x83, 0xfa, 0x0f, 0xa8, 0xbf, 0xea, 0xb5, 0xdb,
+	0xb6, 0xdb, 0x26, 0xef, 0x82, 0xc9, 0x07, 0xd4, 0xdf, 0xf2, 0x02, 0x3b, 0xdc, 0xaf, 0x19, 0xd7,
+	0x8d, 0x67, 0x47, 0x17, 0x67, 0x0e, 0x0f, 0xe6, 0x26, 0x5f, 0x56, 0x85, 0x18, 0xc3, 0xc9, 0x0a,
+	0x5c, 0xdc, 0x09, 0xc3, 0xee, 0x42, 0xb3, 0x49, 0x83, 0x20, 0xaa, 0x51, 0xab, 0xf0, 0x66, 0x8f,
+	0x1d, 0x1e, 0xcc, 0x5d, 0xbc, 0xbd, 0xb1, 0xb1, 0x9e, 0x02, 0x63, 0x5e, 0x1b, 0xf3, 0x17, 0x0c,
+	0xb8, 0x10, 0x75, 0x06, 0xe9, 0xeb, 0x3d, 0x1a, 0x84, 0x01, 0x41, 0xb8, 0xd2, 0xb1, 0xf6, 0xee,
+	0x7a, 0xee, 0x5a, 0x2f, 0xb4, 0x42, 0xdb, 0x6d, 0xaf, 0xb8, 0xdb, 0x8e, 0xdd, 0xde, 0x09, 0x65,
+	0xd7, 0xae, 0x1e, 0x1e, 0xcc, 0x5d, 0x59, 0xcb, 0xad, 0x81, 0x05, 0x2d, 0x59, 0xa7, 0x3b, 0xd6,
+	0x5e, 0x06, 0xa1, 0xd6, 0xe9, 0xb5, 0x2c, 0x18, 0xf3, 0xda, 0x98, 0xcf, 0xc3, 0xe8, 0x42, 0xab,
+	0xe5, 0xb9, 0xe4, 0x39, 0x18, 0xa7, 0xae, 0xb5, 0xe5, 0xd0, 0x16, 0xef, 0xd8, 0xc4, 0xe2, 0xb9,
+	0x2f, 0x1e, 0xcc, 0xbd, 0xe3, 0xf0, 0x60, 0x6e, 0x7c, 0x59, 0x14, 0xa3, 0x82, 0x9b, 0x3f, 0x52,
+	0x81, 0x31, 0xde, 0x28, 0x20, 0x3f, 0x6c, 0xc0, 0xc5, 0xdd, 0xde, 0x16, 0xf5, 0x5d, 0x1a, 0xd2,
+	0x60, 0xc9, 0x0a, 0x76, 0xb6, 0x3c, 0xcb, 0x17, 0x28, 0xa6, 0x9e, 0xbf, 0x35, 0x7f, 0xfc, 0xfd,
+	0x37, 0x7f, 0x27, 0x8b, 0x4e, 0x8c, 0x29, 0x07, 0x80, 0x79, 0xc4, 0xc9, 0x03, 0x98, 0x76, 0xdb,
+	0xb6, 0xbb, 0xb7, 0xe2, 0xb6, 0x7d, 0x1a, 0x04, 0x7c, 0x5e, 0xa6, 0x9e, 0xff, 0x70, 0x99, 0xce,
+	0xdc, 0xd5, 0xf0, 0x2c, 0x9e, 0x3f, 0x3c, 0x98, 0x9b, 0xd6, 0x4b, 0x30, 0x41, 0xc7, 0xfc, 0x0b,
+	0x03, 0xce, 0x2d, 0xb4, 0x3a, 0x76, 0x10, 0xd8, 0x9e, 0xbb, 0xee, 0xf4, 0xda, 0xb6, 0x4b, 0xae,
+	0xc3, 0x88, 0x6b, 0x75, 0x28, 0x9f, 0x90, 0xc9, 0xc5, 0x69, 0x39, 0xa7, 0x23, 0x77, 0xad, 0x0e,
+	0x45, 0x0e, 0x21, 0x1f, 0x81, 0xb1, 0xa6, 0xe7, 0x6e, 0xdb, 0x6d, 0xd9, 0xcf, 0xaf, 0x9b, 0x17,
+	0x3b, 0x61, 0x5e, 0xdf, 0x09, 0xbc, 0x7b, 0x72, 0x07, 0xcd, 0xa3, 0xf5, 0x70, 0x79, 0x2f, 0xa4,
+	0x2e, 0x23, 0xb3, 0x08, 0x87, 0x07, 0x73, 0x63, 0x75, 0x8e, 0x00, 0x25, 0x22, 0xf2, 0x2c, 0x4c,
+	0xb4, 0xec, 0x40, 0x7c, 0xcc, 0x2a, 0xff, 0x98, 0xd3, 0x87, 0x07, 0x73, 0x13, 0x4b, 0xb2, 0x0c,
+	0x23, 0x28, 0x59, 0x85, 0x4b, 0x6c, 0x06, 0x45, 0xbb, 0x06, 0x6d, 0xfa, 0x34, 0x64, 0x5d, 0xab,
+	0x8d, 0xf0, 0xee, 0xd6, 0x0e, 0x0f, 0xe6, 0x2e, 0xdd, 0xc9, 0x81, 0x63, 0x6e, 0x2b, 0xf3, 0x26,
+	0x4c, 0x2c, 0x38, 0xd4, 0x67, 0x0b, 0x8c, 0xbc, 0x00, 0xb3, 0xb4, 0x63, 0xd9, 0x0e, 0xd2, 0x26,
+	0xb5, 0x1f, 0x50, 0x3f, 0xa8, 0x19, 0xd7, 0xab, 0xcf, 0x4e, 0x2e, 0x92, 0xc3, 0x83, 0xb9, 0xd9,
+	0xe5, 0x04, 0x04, 0x53, 0x35, 0xcd, 0xef, 0x32, 0x60, 0x6a, 0xa1, 0xd7, 0xb2, 0x43, 0x31, 0x2e,
+	0xe2, 0xc3, 0x94, 0xc5, 0x7e, 0xae, 0x7b, 0x8e, 0xdd, 0xdc, 0x97, 0x8b, 0xeb, 0xa5, 0x32, 0xdf,
+	0x73, 0x21, 0x46, 0xb3, 0x78, 0xee, 0xf0, 0x60, 0x6e, 0x4a, 0x2b, 0x40, 0x9d, 0x88, 0xb9, 0x03,
+	0x3a, 0x8c, 0x7c, 0x14, 0xa6, 0xc5, 0x70, 0xd7, 0xac, 0x2e, 0xd2, 0x6d, 0xd9, 0x87, 0xa7, 0xb5,
+	0x6f, 0xa5, 0x08, 0xcd, 0xdf, 0xdb, 0x7a, 0x8d, 0x36, 0x43, 0xa4, 0xdb, 0xd4, 0xa7, 0x6e, 0x93,
+	0x8a, 0x65, 0x53, 0xd7, 0x1a, 0x63, 0x02, 0x95, 0xf9, 0x9d, 0x06, 0xcc, 0x2c, 0xf4, 0xc2, 0x1d,
+	0xcf, 0xb7, 0xdf, 0xb0, 0x42, 0xdb, 0x73, 0x89, 0x07, 0xe3, 0x0f, 0xe9, 0xd6, 0x8e, 0xe7, 0xed,
+	0x4a, 0x3a, 0xb7, 0xcb, 0x8d, 0x55, 0xc3, 0x79, 0x5f, 0xe0, 0x5b, 0x9c, 0x62, 0x3b, 0x5a, 0xfe,
+	0x40, 0x45, 0xc5, 0xfc, 0x64, 0x15, 0x2e, 0xe5, 0x55, 0x27, 0xeb, 0x05, 0xeb, 0x43, 0x2c, 0xe7,
+	0x27, 0xe5, 0x72, 0x3e, 0xc6, 0x1a, 0x21, 0x0f, 0x80, 0x34, 0xad, 0xe6, 0x0e, 0x55, 0xe4, 0x68,
+	0x6b, 0x63, 0x63, 0x55, 0x2e, 0xfd, 0xf9, 0xc2, 0xa5, 0xcf, 0x47, 0xc7, 0xee, 0x28, 0x36, 0xc1,
+	0x4b, 0x3d, 0x9f, 0x77, 0x72, 0xf1, 0xca, 0xe1, 0xc1, 0x1c, 0xa9, 0x67, 0xb0, 0x61, 0x0e, 0x05,
+	0xf2, 0x1d, 0x70, 0x89, 0x97, 0x6e, 0xba, 0x56, 0x82, 0x72, 0xb5, 0x14, 0x65, 0xbe, 0x33, 0xea,
+	0x39, 0xf8, 0x30, 0x97, 0x0a, 0xf9, 0x2a, 0x18, 0x67, 0x2b, 0xdb, 0xf6, 0x5c, 0xb9, 0xb5, 0xf8,
+	0x77, 0x78, 0x59, 0x14, 0xa1, 0x82, 0x99, 0x7f, 0xd3, 0x80, 0xa7, 0xa2, 0x6e, 0xfb, 0xf1, 0xac,
+	0x46, 0x8b, 0x89, 0x7c, 0x08, 0x66, 0x23, 0xcc, 0xbe, 0xf6, 0x29, 0xae, 0xc8, 0x4f, 0x31, 0xbb,
+	0x90, 0x80, 0x62, 0xaa, 0x36, 0x79, 0x1e, 0x20, 0x88, 0x3f, 0x63, 0x85, 0xb7, 0x25, 0xb2, 0x2d,
+	0x68, 0x1f, 0x4f, 0xab, 0x65, 0xfe, 0x01, 0xbb, 0x65, 0x1f, 0x58, 0xb6, 0x63, 0x6d, 0xd9, 0x8e,
+	0x1d, 0xee, 0x7f, 0xcc, 0x73, 0xe9, 0x00, 0x07, 0xdb, 0x26, 0x3c, 0xd6, 0x73, 0x2d, 0xd1, 0xce,
+	0xa1, 0x6b, 0x62, 0x56, 0x37, 0xf6, 0xbb, 0x94, 0x9d, 0xc8, 0xec, 0x28, 0x78, 0xe2, 0xf0, 0x60,
+	0xee, 0xb1, 0xcd, 0xfc, 0x2a, 0x58, 0xd4, 0x96, 0x5d, 0xa8, 0x1a, 0xe8, 0x65, 0xcf, 0xe9, 0x75,
+	0x24, 0xd6, 0x2a, 0xc7, 0xca, 0x2f, 0xd4, 0xcd, 0xdc, 0x1a, 0x58, 0xd0, 0xd2, 0xfc, 0x62, 0x05,
+	0xa6, 0x17, 0xad, 0xe6, 0x6e, 0xaf, 0xbb, 0xd8, 0x6b, 0xee, 0xd2, 0x90, 0x7c, 0x3b, 0x4c, 0xb0,
+	0x6f, 0xde, 0xb2, 0x42, 0x4b, 0x6e, 0xc1, 0xaf, 0x1f, 0x6c, 0x85, 0x88, 0xcd, 0xbf, 0x46, 0x43,
+	0x2b, 0x9e, 0xd6, 0xb8, 0x0c, 0x23, 0xac, 0x64, 0x1b, 0x46, 0x82, 0x2e, 0x6d, 0xca, 0x95, 0xbf,
+	0x54, 0x66, 0x83, 0xeb, 0x3d, 0x6e, 0x74, 0x69, 0x33, 0xfe, 0x0a, 0xec, 0x17, 0x72, 0xfc, 0xc4,
+	0x85, 0xb1, 0x20, 0xb4, 0xc2, 0x5e, 0x20, 0x57, 0xfa, 0xcd, 0xa1, 0x29, 0x71, 0x6c, 0x8b, 0xb3,
+	0x92, 0xd6, 0x98, 0xf8, 0x8d, 0x92, 0x8a, 0xf9, 0x6f, 0x0d, 0x38, 0xaf, 0x57, 0x5f, 0xb5, 0x83,
+	0x90, 0x7c, 0x6b, 0x66, 0x3a, 0x07, 0xdc, 0x70, 0xac, 0x35, 0x9f, 0xcc, 0xf3, 0x92, 0xdc, 0x84,
+	0x2a, 0xd1, 0xa6, 0x92, 0xc2, 0xa8, 0x1d, 0xd2, 0x8e, 0x58, 0x56, 0x25, 0x2f, 0x7a, 0xbd, 0xcb,
+	0x8b, 0x33, 0x92, 0xd8, 0xe8, 0x0a, 0x43, 0x8b, 0x02, 0xbb, 0xf9, 0xed, 0x70, 0x49, 0xaf, 0xb5,
+	0xee, 0x7b, 0x0f, 0xec, 0x16, 0xf5, 0xd9, 0x4e, 0x08, 0xf7, 0xbb, 0x99, 0x9d, 0xc0, 0x56, 0x16,
+	0x72, 0x08, 0xf9, 0x6a, 0x18, 0xf3, 0x69, 0x9b, 0x6d, 0x7e, 0xb1, 0xe1, 0xa2, 0xb9, 0x43, 0x5e,
+	0x8a, 0x12, 0x6a, 0xfe, 0x8f, 0x4a, 0x72, 0xee, 0xd8, 0x67, 0x24, 0x0f, 0x60, 0xa2, 0x2b, 0x49,
+	0x0d, 0x73, 0x1b, 0xe4, 0x75, 0x3d, 0x9e, 0x55, 0x55, 0x82, 0x11, 0x2d, 0x62, 0xc3, 0xac, 0xfa,
+	0xbf, 0x3e, 0x04, 0x7f, 0xc2, 0xef, 0xfb, 0xf5, 0x04, 0x22, 0x4c, 0x21, 0x26, 0x1b, 0x30, 0x29,
+	0x8e, 0x1b, 0x76, 0xb3, 0x56, 0x8b, 0x6f, 0xd6, 0x86, 0xaa, 0x24, 0x6f, 0xd6, 0x0b, 0xb2, 0xfb,
+	0x93, 0x11, 0x00, 0x63, 0x44, 0x8c, 0x0b, 0x0a, 0x28, 0x6d, 0x69, 0xfc, 0x0c, 0xe7, 0x82, 0x1a,
+	0xb2, 0x0c, 0x23, 0xa8, 0xf9, 0x85, 0x11, 0x20, 0xd9, 0x25, 0xae, 0xcf, 0x80, 0x28, 0xa9, 0x19,
+	0x43, 0xcf, 0x80, 0xdc, 0x2d, 0x29, 0xc4, 0xe4, 0x0d, 0x98, 0x71, 0xac, 0x20, 0xbc, 0xd7, 0xa5,
+	0xe2, 0x82, 0x91, 0x73, 0xbd, 0x50, 0xe6, 0x4b, 0xaf, 0xea, 0x88, 0x16, 0x2f, 0x1c, 0x1e, 0xcc,
+	0xcd, 0x24, 0x8a, 0x30, 0x49, 0x8a, 0xbc, 0x06, 0x93, 0xac, 0x60, 0xd9, 0xf7, 0x3d, 0x5f, 0xce,
+	0xfe, 0x8b, 0x65, 0xe9, 0x72, 0x24, 0xe2, 0xb9, 0x15, 0xfd, 0xc4, 0x18, 0x3d, 0xf9, 0x66, 0x20,
+	0xde, 0x56, 0xc0, 0x5e, 0x48, 0xad, 0x5b, 0xd4, 0x55, 0x83, 0x65, 0x5f, 0xa7, 0xba, 0x78, 0x55,
+	0x7e, 0x4d, 0x72, 0x2f, 0x53, 0x03, 0x73, 0x5a, 0x91, 0x5d, 0x20, 0xd1, 0x7b, 0x30, 0x5a, 0x00,
+	0xb5, 0xd1, 0xc1, 0x97, 0x0f, 0x67, 0x1f, 0x6e, 0x65, 0x50, 0x60, 0x0e, 0x5a, 0xf3, 0xd7, 0x2a,
+	0x30, 0x25, 0x96, 0xc8, 0xb2, 0x1b, 0xfa, 0xfb, 0x67, 0x70, 0x41, 0xd0, 0xc4, 0x05, 0x51, 0x2f,
+	0xbf, 0xe7, 0x79, 0x87, 0x0b, 0xef, 0x87, 0x4e, 0xea, 0x7e, 0x58, 0x1e, 0x96, 0x50, 0xff, 0xeb,
+	0xe1, 0xdf, 0x18, 0x70, 0x4e, 0xab, 0x7d, 0x06, 0xb7, 0x43, 0x2b, 0x79, 0x3b, 0xbc, 0x34, 0xe4,
+	0xf8, 0x0a, 0x2e, 0x07, 0x2f, 0x31, 0x2c, 0x7e, 0x70, 0x3f, 0x0f, 0xb0, 0xc5, 0x8f, 0x13, 0x8d,
+	0x4d, 0x8b, 0x3e, 0xf9, 0x62, 0x04, 0x41, 0xad, 0x56, 0xe2, 0xcc, 0xaa, 0xf4, 0x3d, 0xb3, 0xfe,
+	0x53, 0x15, 0x2e, 0x64, 0xa6, 0x3d, 0x7b, 0x8e, 0x18, 0x5f, 0xa1, 0x73, 0xa4, 0xf2, 0x95, 0x38,
+	0x47, 0xaa, 0xa5, 0xce, 0x91, 0x81, 0xef, 0x09, 0xe2, 0x03, 0xe9, 0xd8, 0x6d, 0xd1, 0xac, 0x11,
+	0x5a, 0x7e, 0xb8, 0x61, 0x77, 0xa8, 0x3c, 0x71, 0xbe, 0x66, 0xb0, 0x25, 0xcb, 0x5a, 0x88, 0x83,
+	0x67, 0x2d, 0x83, 0x09, 0x73, 0xb0, 0x9b, 0xbf, 0x33, 0x02, 0x50, 0x5f, 0x40, 0x2f, 0x14, 0x9d,
+	0x7d, 0x09, 0x46, 0xbb, 0x3b, 0x56, 0xa0, 0xd6, 0xd3, 0x73, 0x6a, 0x31, 0xae, 0xb3, 0xc2, 0x47,
+	0x07, 0x73, 0xb5, 0xba, 0x4f, 0x5b, 0xd4, 0x0d, 0x6d, 0xcb, 0x09, 0x54, 0x23, 0x0e, 0x43, 0xd1,
+	0x8e, 0x8d, 0x81, 0x4d, 0x63, 0xdd, 0xeb, 0x74, 0x1d, 0xca, 0xa0, 0x7c, 0x0c, 0x95, 0x72, 0x63,
+	0x58, 0xcd, 0x60, 0xc2, 0x1c, 0xec, 0x8a, 0xe6, 0x8a, 0x6b, 0x87, 0xb6, 0x15, 0xd1, 0xac, 0x96,
+	0xa7, 0x99, 0xc4, 0x84, 0x39, 0xd8, 0xc9, 0xa7, 0x0d, 0xb8, 0x9a, 0x2c, 0xbe, 0x69, 0xbb, 0x76,
+	0xb0, 0x43, 0x5b, 0x1b, 0xb6, 0xfc, 0xd0, 0xc7, 0x23, 0x7e, 0xed, 0xf0, 0x60, 0xee, 0xea, 0x6a,
+	0x21, 0x46, 0xec, 0x43, 0x8d, 0x7c, 0xc6, 0x80, 0x27, 0x52, 0xf3, 0xe2, 0xdb, 0xed, 0x36, 0xf5,
+	0x69, 0xab, 0xe4, 0x12, 0x9a, 0x3b, 0x3c, 0x98, 0x7b, 0x62, 0xb5, 0x18, 0x25, 0xf6, 0xa3, 0x67,
+	0xfe, 0xaa, 0x01, 0xd5, 0x3a, 0xae, 0x90, 0x77, 0x25, 0x1e, 0x71, 0x8f, 0xe9, 0x8f, 0xb8, 0x47,
+	0x07, 0x73, 0xe3, 0x75, 0x5c, 0xd1, 0xde, 0x73, 0x9f, 0x31, 0xe0, 0x42, 0xd3, 0x73, 0x43, 0x8b,
+	0xf5, 0x0b, 0x05, 0xa7, 0xa3, 0x4e, 0xd5, 0x52, 0xef, 0x97, 0x7a, 0x0a, 0xd9, 0xe2, 0xe3, 0xb2,
+	0x03, 0x17, 0xd2, 0x90, 0x00, 0xb3, 0x94, 0xcd, 0x2f, 0x19, 0x30, 0x5d, 0x77, 0xbc, 0x5e, 0x6b,
+	0xdd, 0xf7, 0xb6, 0x6d, 0x87, 0xbe, 0x3d, 0x1e, 0x6d, 0x7a, 0x8f, 0x8b, 0x2e, 0x65, 0xfe, 0x88,
+	0xd2, 0x2b, 0xbe, 0x4d, 0x1e, 0x51, 0x7a, 0x97, 0x0b, 0xee, 0xc9, 0x6f, 0x81, 0xcb, 0x7a, 0xad,
+	0x58, 0xb0, 0x71, 0x1d, 0x46, 0x76, 0x6d, 0xb7, 0x95, 0x7e, 0x45, 0xdd, 0xb1, 0xdd, 0x16, 0x72,
+	0x48, 0x24, 0x71, 0xa8, 0x14, 0x49, 0x1c, 0xcc, 0x1f, 0x19, 0x4f, 0x4e, 0x1b, 0xbf, 0x86, 0x9f,
+	0x85, 0x89, 0xa6, 0xb5, 0xd8, 0x73, 0x5b, 0x4e, 0xf4, 0x44, 0x63, 0x53, 0x50, 0x5f, 0x10, 0x65,
+	0x18, 0x41, 0xc9, 0x1b, 0x00, 0xb1, 0x38, 0xb9, 0x56, 0x29, 0xff, 0x5c, 0x8e, 0x25, 0xd5, 0x0d,
+	0x1a, 0x86, 0xb6, 0xdb, 0x0e, 0xe2, 0x75, 0x15, 0xc3, 0x50, 0xa3, 0x46, 0x3e, 0x0e, 0x33, 0xf2,
+	0x0b, 0xae, 0x74, 0xac, 0xb6, 0x14, 0x66, 0x94, 0xfc, 0x0c, 0x6b, 0x1a, 0xa2, 0xc5, 0xcb, 0x92,
+	0xf0, 0x8c, 0x5e, 0x1a, 0x60, 0x92, 0x1a, 0xd9, 0x87, 0xe9, 0x8e, 0x2e, 0xa0, 0x19, 0x29, 0xcf,
+	0x2b, 0x69, 0xc2, 0x9a, 0xc5, 0x4b, 0x92, 0xf8, 0x74, 0x42, 0xb4, 0x93, 0x20, 0x95, 0xf3, 0xce,
+	0x1c, 0x3d, 0xad, 0x77, 0x26, 0x85, 0x71, 0xf1, 0xd2, 0x0e, 0x6a, 0x63, 0x7c, 0x80, 0x2f, 0x94,
+	0x19, 0xa0, 0x78, 0xb4, 0xc7, 0xfa, 0x11, 0xf1, 0x3b, 0x40, 0x85, 0x9b, 0xe9, 0x1f, 0x18, 0xcb,
+	0xd0, 0xa0, 0x0e, 0x6d, 0x86, 0x9e, 0x5f, 0x1b, 0x2f, 0xaf, 0x7f, 0x68, 0x68, 0x78, 0x84, 0x20,
+	0x59, 0x2f, 0xc1, 0x04, 0x9d, 0x48, 0x10, 0x31, 0x51, 0x28, 0x88, 0xe8, 0xc1, 0xd4, 0x03, 0x4d,
+	0x60, 0x36, 0xc9, 0x27, 0xe1, 0x43, 0x65, 0x3a, 0x16, 0x4b, 0xcf, 0x16, 0x2f, 0x4a, 0x42, 0x53,
+	0xba, 0xa4, 0x4d, 0xa7, 0x63, 0xfe, 0xdc, 0x14, 0x5c, 0xa8, 0x3b, 0xbd, 0x20, 0xa4, 0xfe, 0x82,
+	0x54, 0x91, 0x52, 0x9f, 0x7c, 0xb7, 0x01, 0x57, 0xf8, 0xbf, 0x4b, 0xde, 0x43, 0x77, 0x89, 0x3a,
+	0xd6, 0xfe, 0xc2, 0x36, 0xab, 0xd1, 0x6a, 0xd5, 0x8c, 0x52, 0x42, 0x59, 0x2e, 0xf9, 0x6b, 0xe4,
+	0x62, 0xc4, 0x02, 0x4a, 0xe4, 0xfb, 0x0d, 0x78, 0x3c, 0x07, 0xb4, 0x44, 0x1d, 0x1a, 0xd2, 0x92,
+	0x62, 0xe9, 0xa7, 0x0e, 0x0f, 0xe6, 0x1e, 0x6f, 0x14, 0x21, 0xc5, 0x62, 0x7a, 0xe4, 0x07, 0x0d,
+	0xb8, 0x9a, 0x03, 0xbd, 0x69, 0xd9, 0x4e, 0xcf, 0xa7, 0x25, 0x65, 0xd5, 0x9c, 0x71, 0x69, 0x14,
+	0x62, 0xc5, 0x3e, 0x14, 0xc9, 0x27, 0xe0, 0x72, 0x04, 0xdd, 0x74, 0x5d, 0x4a, 0x5b, 0x09, 0xfe,
+	0xe9, 0xb8, 0x5d, 0x79, 0xfc, 0xf0, 0x60, 0xee, 0x72, 0x23, 0x0f, 0x21, 0xe6, 0xd3, 0x21, 0x6d,
+	0x78, 0x2a, 0x06, 0x84, 0xb6, 0x23, 0xf5, 0x13, 0x1b, 0x3b, 0x3e, 0x0d, 0x76, 0x3c, 0xa7, 0xc5,
+	0x0f, 0x0b, 0x63, 0xf1, 0x9d, 0x87, 0x07, 0x73, 0x4f, 0x35, 0xfa, 0x55, 0xc4, 0xfe, 0x78, 0x48,
+	0x0b, 0xa6, 0x83, 0xa6, 0xe5, 0xae, 0xb8, 0x21, 0xf5, 0x1f, 0x58, 0x4e, 0x6d, 0xac, 0xd4, 0x00,
+	0xc5, 0x16, 0xd5, 0xf0, 0x60, 0x02, 0x2b, 0xf9, 0x00, 0x4c, 0xd0, 0xbd, 0xae, 0xe5, 0xb6, 0xa8,
+	0x38, 0x16, 0x26, 0x17, 0x9f, 0x64, 0x97, 0xd1, 0xb2, 0x2c, 0x7b, 0x74, 0x30, 0x37, 0xad, 0xfe,
+	0x5f, 0xf3, 0x5a, 0x14, 0xa3, 0xda, 0x4c, 0x7f, 0xc1, 0xb5, 0xc1, 0x2d, 0xca, 0x0f, 0xb9, 0x40,
+	0x71, 0xd1, 0x13, 0xe5, 0xf5, 0x17, 0x6b, 0x39, 0xf8, 0x30, 0x97, 0x0a, 0xfb, 0x0c, 0x1d, 0x6b,
+	0xef, 0x96, 0x6f, 0x35, 0xe9, 0x76, 0xcf, 0xd9, 0xa0, 0x7e, 0xc7, 0x76, 0xc5, 0x43, 0x85, 0x69,
+	0x28, 0x5a, 0xec, 0x28, 0x61, 0xba, 0x67, 0xfe, 0x19, 0xd6, 0xfa, 0x55, 0xc4, 0xfe, 0x78, 0xc8,
+	0x7b, 0x61, 0xda, 0x6e, 0xbb, 0x9e, 0x4f, 0x37, 0x2c, 0xdb, 0x0d, 0x83, 0x1a, 0x70, 0x99, 0x3e,
+	0x9f, 0xd6, 0x15, 0xad, 0x1c, 0x13, 0xb5, 0x98, 0x52, 0xc9, 0xa5, 0x0f, 0xd7, 0xbd, 0x16, 0x5f,
+	0x02, 0x9b, 0x5d, 0xbe, 0x90, 0x6b, 0x53, 0xe5, 0x95, 0x4a, 0x77, 0x33, 0xd8, 0x30, 0x87, 0x02,
+	0xb9, 0x09, 0xa4, 0x63, 0xed, 0x2d, 0x77, 0xba, 0xe1, 0xfe, 0x62, 0xcf, 0xd9, 0x95, 0xa7, 0xc6,
+	0x34, 0x9f, 0x0b, 0xf1, 0xc8, 0xcb, 0x40, 0x31, 0xa7, 0x05, 0xb1, 0xe0, 0x09, 0x31, 0x9e, 0x25,
+	0x8b, 0x76, 0x3c, 0x37, 0xa0, 0x61, 0xa0, 0x2d, 0xd2, 0xda, 0x0c, 0xd7, 0xe1, 0x72, 0x96, 0x7f,
+	0xa5, 0xb8, 0x1a, 0xf6, 0xc3, 0x91, 0xb4, 0x8a, 0x98, 0xed, 0x6f, 0x15, 0x61, 0x1e, 0x54, 0x61,
+	0xb2, 0xee, 0xb9, 0x2d, 0x9b, 0x37, 0x7d, 0x77, 0x42, 0xc0, 0xfd, 0x94, 0x7e, 0xaf, 0x3c, 0x3a,
+	0x98, 0x9b, 0x89, 0x2a, 0x6a, 0x17, 0xcd, 0x07, 0x23, 0xa9, 0x92, 0xe0, 0xd6, 0xde, 0x99, 0x14,
+	0x07, 0x3d, 0x3a, 0x98, 0x3b, 0x17, 0x35, 0x4b, 0x4a, 0x88, 0xd8, 0xb7, 0x64, 0x4f, 0x97, 0x0d,
+	0xdf, 0x72, 0x03, 0x7b, 0x88, 0xc7, 0x62, 0x24, 0x06, 0x58, 0xcd, 0x60, 0xc3, 0x1c, 0x0a, 0xe4,
+	0x35, 0x98, 0x65, 0xa5, 0x9b, 0xdd, 0x96, 0x15, 0xd2, 0x92, 0x6f, 0xc4, 0x48, 0x0b, 0xb7, 0x9a,
+	0xc0, 0x84, 0x29, 0xcc, 0x42, 0x21, 0x60, 0x05, 0x9e, 0x5b, 0x1b, 0x4d, 0x2b, 0x04, 0xac, 0x40,
+	0x28, 0x04, 0xac, 0x40, 0x18, 0x65, 0x74, 0x68, 0x10, 0x58, 0x6d, 0xca, 0xcf, 0xa3, 0xc9, 0x98,
+	0xe9, 0x58, 0x13, 0xc5, 0xa8, 0xe0, 0xe4, 0x6b, 0x61, 0xb4, 0xe9, 0xb5, 0x68, 0x50, 0x1b, 0xe7,
+	0x3b, 0x86, 0xad, 0xbe, 0xd1, 0x3a, 0x2b, 0x78, 0x74, 0x30, 0x37, 0xc9, 0x85, 0x26, 0xec, 0x17,
+	0x8a, 0x4a, 0xe6, 0x8f, 0xb3, 0x07, 0x46, 0xea, 0x45, 0x35, 0x80, 0x22, 0xe3, 0xec, 0x74, 0x02,
+	0xe6, 0x67, 0xd9, 0xeb, 0xce, 0x73, 0x43, 0xdf, 0x73, 0xd6, 0x1d, 0xcb, 0xa5, 0xe4, 0x7b, 0x0d,
+	0x38, 0xbf, 0x63, 0xb7, 0x77, 0x74, 0x4d, 0x64, 0xcd, 0x28, 0xff, 0x10, 0xbb, 0x9d, 0xc2, 0xb5,
+	0x78, 0xe9, 0xf0, 0x60, 0xee, 0x7c, 0xba, 0x14, 0x33, 0x34, 0xcd, 0x4f, 0x55, 0xe0, 0x92, 0xec,
+	0x99, 0xc3, 0x6e, 0xee, 0xae, 0xe3, 0xed, 0x77, 0xa8, 0x7b, 0x16, 0x4a, 0x43, 0xf5, 0x85, 0x2a,
+	0x85, 0x5f, 0xa8, 0x93, 0xf9, 0x42, 0xd5, 0x32, 0x5f, 0x28, 0x5a, 0xc8, 0x47, 0x7c, 0xa5, 0x3f,
+	0x31, 0xa0, 0x96, 0x37, 0x17, 0x67, 0xf0, 0x60, 0xed, 0x24, 0x1f, 0xac, 0xb7, 0xcb, 0x4a, 0x20,
+	0xd2, 0x5d, 0x2f, 0x78, 0xb8, 0xfe, 0x71, 0x05, 0xae, 0xc4, 0xd5, 0x57, 0xdc, 0x20, 0xb4, 0x1c,
+	0x47, 0x1c, 0xad, 0xa7, 0xff, 0xdd, 0xbb, 0x09, 0xb9, 0xc3, 0xdd, 0xe1, 0x86, 0xaa, 0xf7, 0xbd,
+	0x50, 0x2d, 0xb0, 0x97, 0x52, 0x0b, 0xac, 0x9f, 0x20, 0xcd, 0xfe, 0x1a, 0x82, 0xff, 0x62, 0xc0,
+	0xd5, 0xfc, 0x86, 0x67, 0xb0, 0xa8, 0xbc, 0xe4, 0xa2, 0xfa, 0xe6, 0x93, 0x1b, 0x75, 0xc1, 0xb2,
+	0xfa, 0x85, 0x4a, 0xd1, 0x68, 0xb9, 0xf0, 0x62, 0x1b, 0xce, 0xf9, 0xb4, 0x6d, 0x07, 0xa1, 0x94,
+	0x5f, 0x1f, 0xcf, 0xf2, 0x48, 0x09, 0xf4, 0xce, 0x61, 0x12, 0x07, 0xa6, 0x91, 0x92, 0xbb, 0x30,
+	0xce, 0x9e, 0x92, 0x0c, 0x7f, 0x65, 0x70, 0xfc, 0xd1, 0x6d, 0xd4, 0x10, 0x6d, 0x51, 0x21, 0x21,
+	0xdf, 0x0a, 0x33, 0xad, 0x68, 0x47, 0x1d, 0xa1, 0xd5, 0x4d, 0x63, 0xe5, 0x9a, 0x86, 0x25, 0xbd,
+	0x35, 0x26, 0x91, 0x99, 0xff, 0xc7, 0x80, 0x27, 0xfb, 0xad, 0x2d, 0xf2, 0x3a, 0x40, 0x53, 0xb1,
+	0x17, 0xc2, 0xf0, 0xac, 0xa4, 0x2e, 0x22, 0x62, 0x52, 0xe2, 0x0d, 0x1a, 0x15, 0x05, 0xa8, 0x11,
+	0xc9, 0x51, 0x16, 0x57, 0x4e, 0x49, 0x59, 0x6c, 0xfe, 0x57, 0x43, 0x3f, 0x8a, 0xf4, 0x6f, 0xfb,
+	0x76, 0x3b, 0x8a, 0xf4, 0xbe, 0x17, 0x0a, 0x43, 0x7f, 0xb7, 0x02, 0xd7, 0xf3, 0x9b, 0x68, 0x77,
+	0xef, 0x87, 0x61, 0xac, 0x2b, 0xac, 0x03, 0xab, 0xfc, 0x6e, 0x7c, 0x96, 0x9d, 0x2c, 0xc2, 0x76,
+	0xef, 0xd1, 0xc1, 0xdc, 0xd5, 0xbc, 0x83, 0x5e, 0x40, 0x51, 0xb6, 0x23, 0x76, 0x4a, 0x6a, 0x23,
+	0xb8, 0xbf, 0xf7, 0x0c, 0x78, 0xb8, 0x58, 0x5b, 0xd4, 0x19, 0x58, 0x50, 0xf3, 0x5d, 0x06, 0xcc,
+	0x26, 0x56, 0x74, 0x50, 0x1b, 0xbd, 0x5e, 0x2d, 0xab, 0xa7, 0x4b, 0x6c, 0x95, 0xf8, 0xe6, 0x4e,
+	0x14, 0x07, 0x98, 0x22, 0x98, 0x3a, 0x66, 0xf5, 0x59, 0x7d, 0xdb, 0x1d, 0xb3, 0x7a, 0xe7, 0x0b,
+	0x8e, 0xd9, 0x1f, 0xab, 0x14, 0x8d, 0x96, 0x1f, 0xb3, 0x0f, 0x61, 0x52, 0xd9, 0xcd, 0xab, 0xe3,
+	0xe2, 0xe6, 0xb0, 0x7d, 0x12, 0xe8, 0x62, 0x1b, 0x15, 0x55, 0x12, 0x60, 0x4c, 0x8b, 0x7c, 0x8f,
+	0x01, 0x10, 0x7f, 0x18, 0xb9, 0xa9, 0x36, 0x4e, 0x6e, 0x3a, 0x34, 0xb6, 0x66, 0x96, 0x6d, 0xe9,
+	0xf8, 0x37, 0x6a, 0x74, 0xcd, 0xff, 0x55, 0x05, 0x92, 0xed, 0xfb, 0x60, 0x32, 0xf9, 0x23, 0x18,
+	0xd2, 0x17, 0xe1, 0x5c, 0xdb, 0xf1, 0xb6, 0x2c, 0xc7, 0xd9, 0x97, 0x86, 0xe4, 0xd2, 0x24, 0xf9,
+	0x22, 0xbb, 0x98, 0x6e, 0x25, 0x41, 0x98, 0xae, 0x4b, 0xba, 0x70, 0xde, 0x67, 0xa2, 0x81, 0xa6,
+	0xed, 0xf0, 0xa7, 0x93, 0xd7, 0x0b, 0x4b, 0xca, 0x9e, 0x38, 0x7b, 0x8f, 0x29, 0x5c, 0x98, 0xc1,
+	0xce, 0x4c, 0x35, 0xbb, 0xbe, 0xdd, 0xb1, 0xfc, 0x7d, 0xfe, 0x38, 0x9b, 0x10, 0xa6, 0x9a, 0xeb,
+	0xa2, 0x08, 0x15, 0x8c, 0x7c, 0x07, 0x4c, 0x3a, 0xf6, 0x36, 0x6d, 0xee, 0x37, 0x1d, 0x2a, 0x85,
+	0x45, 0xf7, 0x4e, 0x66, 0xc9, 0xac, 0x2a, 0xb4, 0x52, 0xff, 0xad, 0x7e, 0x62, 0x4c, 0x90, 0x79,
+	0x00, 0x3c, 0xf4, 0xfc, 0x5d, 0xea, 0x3b, 0x34, 0x08, 0x1a, 0xbd, 0x6e, 0xd7, 0xf3, 0x43, 0xda,
+	0xe2, 0x22, 0xa5, 0x09, 0x61, 0x2d, 0x7f, 0x3f, 0x0b, 0xc6, 0xbc, 0x36, 0xe6, 0xa7, 0x2b, 0xf0,
+	0x44, 0x9f, 0x4e, 0x10, 0x84, 0xc9, 0x68, 0x8e, 0xe4, 0x4a, 0x78, 0xaf, 0x58, 0xcf, 0xb2, 0xf0,
+	0xd1, 0xc1, 0xdc, 0xd3, 0x7d, 0x10, 0x34, 0xd8, 0x52, 0xa4, 0xed, 0x7d, 0x8c, 0xd1, 0x90, 0x15,
+	0x18, 0x6b, 0xc5, 0x12, 0xd6, 0xc9, 0xc5, 0x77, 0xb3, 0xd3, 0x5a, 0xc8, 0x42, 0x06, 0xc5, 0x26,
+	0x11, 0x90, 0x55, 0x18, 0x17, 0x5a, 0x73, 0x2a, 0x4f, 0xfe, 0xe7, 0xf9, 0xf3, 0x58, 0x14, 0x0d,
+	0x8a, 0x4c, 0xa1, 0x30, 0xff, 0xdc, 0x80, 0xf1, 0x3a, 0x93, 0xa1, 0xdc, 0x6d, 0x90, 0x7d, 0x66,
+	0x75, 0x1e, 0x39, 0xf4, 0xc8, 0x53, 0xb0, 0xe4, 0xb1, 0xc0, 0x31, 0x2e, 0xc4, 0xd8, 0x94, 0xf1,
+	0x79, 0x54, 0x80, 0x3a, 0x2d, 0xf2, 0x3a, 0x9b, 0xf3, 0x87, 0xbe, 0x1d, 0x32, 0xc2, 0xc3, 0x28,
+	0x1b, 0x05, 0x61, 0x54, 0xb8, 0xc4, 0x8a, 0x8a, 0x7e, 0x62, 0x4c, 0xc5, 0x5c, 0x07, 0x22, 0x6b,
+	0x6b, 0xbd, 0x22, 0x2f, 0xc0, 0x48, 0xc7, 0x6b, 0xa9, 0xef, 0xfe, 0xd5, 0x6a, 0x7f, 0x33, 0xd9,
+	0xe4, 0xa3, 0x83, 0xb9, 0x2b, 0xd9, 0x16, 0x0c, 0x82, 0xbc, 0x8d, 0x79, 0x17, 0xce, 0x4b, 0x78,
+	0x44, 0x90, 0x79, 0x05, 0x34, 0xbd, 0x4e, 0xc7, 0x73, 0x1b, 0xbd, 0xed, 0x6d, 0x7b, 0x8f, 0x26,
+	0xbc, 0x02, 0xea, 0x09, 0x08, 0xa6, 0x6a, 0x32, 0x7d, 0xef, 0xa5, 0xd8, 0xba, 0x61, 0x79, 0xaf,
+	0x6b, 0x4b, 0xa6, 0xe7, 0x68, 0x53, 0xe4, 0xe7, 0x13, 0xc7, 0xd4, 0xb5, 0x94, 0x04, 0x6b, 0x36,
+	0xc6, 0xaa, 0x1d, 0x5c, 0xaf, 0xc1, 0x2c, 0x8d, 0x68, 0x94, 0x35, 0x58, 0x50, 0x97, 0xf1, 0x72,
+	0x02, 0x13, 0xa6, 0x30, 0x9b, 0xfb, 0xf0, 0x78, 0x9e, 0xdd, 0x86, 0x60, 0x4c, 0xbe, 0x15, 0x26,
+	0x6c, 0x25, 0x95, 0x2e, 0xa7, 0x18, 0x89, 0xae, 0xe2, 0x48, 0x2a, 0x1d, 0x61, 0x34, 0x7f, 0xd4,
+	0x80, 0x2a, 0x5b, 0xed, 0x26, 0x8c, 0xb5, 0xbc, 0x8e, 0x65, 0xbb, 0x72, 0x1a, 0xb9, 0x5f, 0xc9,
+	0x12, 0x2f, 0x41, 0x09, 0x21, 0x5d, 0x98, 0x54, 0xac, 0xe8, 0x50, 0xe6, 0x54, 0x4b, 0x77, 0x1b,
+	0x91, 0x09, 0x6a, 0x74, 0x3f, 0xaa, 0x92, 0x00, 0x63, 0x22, 0xa6, 0x05, 0x17, 0x96, 0xee, 0x36,
+	0x56, 0xdc, 0xa6, 0xd3, 0x6b, 0xd1, 0xe5, 0x3d, 0xfe, 0x87, 0x9d, 0xd0, 0xb6, 0x28, 0x91, 0xab,
+	0x87, 0x9f, 0xd0, 0xb2, 0x12, 0x2a, 0x18, 0xab, 0x46, 0x45, 0x8b, 0x5a, 0x25, 0xae, 0x26, 0x91,
+	0xa0, 0x82, 0x99, 0x5f, 0xaa, 0xc0, 0x94, 0xd6, 0x21, 0xe2, 0xc0, 0xb8, 0x18, 0xae, 0x32, 0xf7,
+	0x5c, 0x2e, 0x39, 0xc4, 0x64, 0xaf, 0x05, 0x75, 0x31, 0xa1, 0x01, 0x2a, 0x12, 0xfa, 0x6d, 0x53,
+	0xe9, 0x73, 0xdb, 0xcc, 0x27, 0xcc, 0xf6, 0xc5, 0x41, 0x37, 0x5b, 0x6c, 0xb2, 0x4f, 0x9e, 0x94,
+	0x0b, 0x5e, 0xd8, 0x33, 0x4d, 0xa4, 0xee, 0xe4, 0x6d, 0x18, 0x7d, 0xc3, 0x73, 0x69, 0x50, 0x1b,
+	0x3d, 0xc9, 0x01, 0x4e, 0x32, 0xae, 0x8b, 0xf9, 0x06, 0x04, 0x28, 0xd0, 0x9b, 0x3f, 0x61, 0x00,
+	0x2c, 0x59, 0xa1, 0x25, 0x14, 0x83, 0x03, 0xec, 0xd3, 0x27, 0x13, 0xfb, 0x74, 0x22, 0x63, 0x46,
+	0x3d, 0x12, 0xd8, 0x6f, 0xa8, 0xe1, 0x47, 0xcf, 0x14, 0x81, 0xbd, 0x61, 0xbf, 0x41, 0x91, 0xc3,
+	0x99, 0xa8, 0x9b, 0xba, 0x4d, 0x7f, 0xbf, 0xcb, 0xae, 0xc4, 0x11, 0x3e, 0xab, 0xfc, 0xdc, 0x5b,
+	0x56, 0x85, 0x18, 0xc3, 0xcd, 0x77, 0x43, 0xf2, 0xad, 0x79, 0x74, 0x2f, 0xcd, 0x2f, 0x8f, 0xc0,
+	0xe3, 0xcb, 0x1b, 0xf5, 0x25, 0x89, 0xcf, 0xf6, 0xdc, 0x3b, 0x74, 0xff, 0xaf, 0x2c, 0xb4, 0xfe,
+	0xca, 0x42, 0xeb, 0x04, 0x2d, 0xb4, 0xfe, 0x91, 0x01, 0xe7, 0xe3, 0xf5, 0x25, 0xed, 0x17, 0xde,
+	0x95, 0x7e, 0xa6, 0x4c, 0xaa, 0x0b, 0x3d, 0xe7, 0x69, 0xd1, 0xd5, 0xfc, 0x06, 0x86, 0xb0, 0x65,
+	0x89, 0x3b, 0x11, 0x1d, 0xd9, 0xd3, 0xf9, 0x1e, 0x03, 0x6c, 0xbb, 0x93, 0x6c, 0xf5, 0xb7, 0x96,
+	0x5a, 0xe1, 0x11, 0x9b, 0x57, 0x7e, 0xf9, 0x32, 0x17, 0x20, 0xe1, 0x76, 0xc5, 0xd4, 0x2c, 0xca,
+	0x3b, 0xcb, 0x48, 0xaa, 0x59, 0xd2, 0x1e, 0x5a, 0x64, 0x5b, 0xe7, 0x0a, 0x96, 0xac, 0xb0, 0xcc,
+	0xc6, 0x24, 0x49, 0x8e, 0x80, 0x61, 0xc1, 0x14, 0x56, 0xd2, 0x80, 0xd9, 0xa6, 0x63, 0x05, 0x81,
+	0xbd, 0x6d, 0x37, 0x63, 0xe3, 0xd6, 0xc9, 0xc5, 0x77, 0x71, 0x46, 0x29, 0x01, 0x79, 0x74, 0x30,
+	0x77, 0x59, 0xf6, 0x33, 0x09, 0xc0, 0x14, 0x0a, 0xf3, 0x73, 0x15, 0x98, 0x59, 0xde, 0xeb, 0x7a,
+	0x41, 0xcf, 0xa7, 0xbc, 0xea, 0x19, 0xc8, 0x8b, 0x9e, 0x83, 0xf1, 0x1d, 0x8b, 0x99, 0x57, 0xf9,
+	0xb5, 0x4a, 0x72, 0x6e, 0x6f, 0x8b, 0x62, 0x54, 0x70, 0xf2, 0x26, 0x00, 0x73, 0x0e, 0x6f, 0xf5,
+	0x38, 0xbf, 0x2d, 0x0e, 0x9f, 0x3b, 0xa5, 0xd6, 0xac, 0x3e, 0xc6, 0x46, 0x84, 0x52, 0xde, 0x98,
+	0xd1, 0x6f, 0xd4, 0xc8, 0x99, 0xbf, 0x67, 0xc0, 0x85, 0x44, 0xbb, 0x33, 0x10, 0x83, 0x6c, 0x27,
+	0xc5, 0x20, 0x0b, 0x43, 0x8f, 0xb5, 0x40, 0xfa, 0xf1, 0x7d, 0x15, 0x78, 0xac, 0x60, 0x4e, 0x32,
+	0xc6, 0x4a, 0xc6, 0x19, 0x19, 0x2b, 0xf5, 0x60, 0x2a, 0xf4, 0x1c, 0x69, 0x83, 0xad, 0x66, 0xa0,
+	0x94, 0x29, 0xd2, 0x46, 0x84, 0x26, 0x36, 0x45, 0x8a, 0xcb, 0x02, 0xd4, 0xe9, 0x30, 0xcb, 0xd7,
+	0xc9, 0xe8, 0xc4, 0x78, 0x4b, 0x1d, 0x4d, 0x83, 0x7b, 0x6d, 0x9b, 0xbf, 0x59, 0x81, 0x2b, 0x11,
+	0x6e, 0x75, 0xf8, 0x33, 0xe1, 0xf0, 0x20, 0x22, 0x9b, 0x27, 0x13, 0x66, 0x94, 0x13, 0x29, 0x0e,
+	0x8c, 0xf1, 0xa3, 0x3d, 0xbf, 0xeb, 0x05, 0x8a, 0xcd, 0x12, 0xfc, 0xa8, 0x28, 0x42, 0x05, 0x23,
+	0x77, 0x61, 0x34, 0x60, 0xf4, 0x6a, 0x23, 0x65, 0x66, 0x83, 0x73, 0x8a, 0xbc, 0xbf, 0x28, 0xd0,
+	0x90, 0x37, 0xf5, 0x9b, 0x6d, 0xb4, 0xbc, 0x50, 0x90, 0x8d, 0xa4, 0xa5, 0x66, 0x24, 0xc7, 0x51,
+	0x2c, 0xef, 0xa6, 0x34, 0x57, 0xe1, 0xbc, 0xb4, 0x77, 0x12, 0xcb, 0x86, 0x99, 0xa3, 0x7e, 0x20,
+	0xb1, 0x32, 0x9e, 0x49, 0xbd, 0x18, 0x2f, 0xa5, 0xeb, 0xc7, 0x2b, 0xc6, 0x0c, 0x60, 0xe2, 0x96,
+	0xec, 0x24, 0xb9, 0x0a, 0x15, 0x5b, 0x7d, 0x0b, 0x90, 0x38, 0x2a, 0x2b, 0x4b, 0x58, 0xb1, 0x07,
+	0x30, 0x67, 0xd5, 0xaf, 0xa5, 0x6a, 0xff, 0x6b, 0xc9, 0xfc, 0xa7, 0x23, 0x70, 0x49, 0x51, 0x55,
+	0x63, 0x5c, 0x92, 0x1a, 0xe3, 0x23, 0x78, 0xee, 0xa3, 0x45, 0x78, 0xf7, 0x60, 0x84, 0x1f, 0x80,
+	0xa5, 0x34, 0xc9, 0x11, 0x42, 0xd6, 0x1d, 0xe4, 0x88, 0xc8, 0x77, 0xc0, 0x98, 0xc3, 0x04, 0xe6,
+	0xca, 0xce, 0xb4, 0x94, 0xc0, 0x33, 0x6f, 0xb8, 0x42, 0x0e, 0x1f, 0x08, 0x47, 0x9d, 0x48, 0xc1,
+	0x28, 0x0a, 0x51, 0xd2, 0x24, 0x3f, 0x64, 0xc0, 0x94, 0xe5, 0xba, 0x92, 0xf5, 0x56, 0xcb, 0xed,
+	0xa3, 0x27, 0xd6, 0x87, 0x85, 0x18, 0xb7, 0xe8, 0x48, 0x74, 0x2a, 0x69, 0x10, 0xd4, 0xbb, 0x70,
+	0xf5, 0x83, 0x30, 0xa5, 0xf5, 0x9c, 0x9c, 0x87, 0xea, 0x2e, 0x15, 0xc6, 0x0d, 0x93, 0xc8, 0xfe,
+	0x25, 0x97, 0x60, 0xf4, 0x81, 0xe5, 0xf4, 0xe4, 0x57, 0x42, 0xf1, 0xe3, 0x85, 0xca, 0x07, 0x8c,
+	0xab, 0x1f, 0x82, 0xf3, 0x69, 0x82, 0xc7, 0x69, 0x6f, 0xfe, 0x9c, 0x01, 0x53, 0xb7, 0xed, 0x2d,
+	0xea, 0x0b, 0x3b, 0x2c, 0xfe, 0x6a, 0x4e, 0xc4, 0x01, 0x99, 0xca, 0x8b, 0x01, 0x42, 0xf6, 0x60,
+	0x52, 0x5e, 0x9e, 0x91, 0x0f, 0xc0, 0xad, 0x72, 0x56, 0x18, 0x11, 0x69, 0x79, 0x29, 0xe9, 0x6e,
+	0x9d, 0x8a, 0x02, 0xc6, 0xc4, 0xcc, 0x37, 0xe1, 0x62, 0x4e, 0x23, 0x32, 0xc7, 0x4f, 0x24, 0x3f,
+	0x94, 0x2b, 0x5d, 0x1d, 0x31, 0x7e, 0x88, 0xa2, 0x9c, 0x3c, 0x0e, 0x55, 0xea, 0xb6, 0xe4, 0x32,
+	0x1f, 0x3f, 0x3c, 0x98, 0xab, 0x2e, 0xbb, 0x2d, 0x64, 0x65, 0xec, 0xe4, 0x75, 0xbc, 0x04, 0x9b,
+	0xc5, 0x4f, 0xde, 0x55, 0x59, 0x86, 0x11, 0x94, 0xdb, 0xcd, 0xa4, 0x4d, 0x44, 0xd8, 0x43, 0xe6,
+	0xfc, 0x76, 0xea, 0x40, 0x18, 0xc6, 0x32, 0x25, 0x7d, 0xb8, 0x2c, 0xd6, 0xe4, 0x84, 0x64, 0x8e,
+	0x29, 0xcc, 0xd0, 0x35, 0xff, 0xd9, 0x08, 0x3c, 0x75, 0x9b, 0x39, 0xfc, 0x7b, 0x6e, 0x68, 0x39,
+	0xeb, 0x5e, 0x2b, 0xb6, 0xb8, 0x95, 0xf7, 0xcc, 0x27, 0x0d, 0x78, 0xac, 0xd9, 0xed, 0x89, 0x87,
+	0x90, 0xb2, 0x0f, 0x5b, 0xa7, 0xbe, 0xed, 0x95, 0x35, 0xbc, 0xe5, 0x8e, 0xfc, 0xf5, 0xf5, 0xcd,
+	0x3c, 0x94, 0x58, 0x44, 0x8b, 0xdb, 0xff, 0xb6, 0xbc, 0x87, 0x2e, 0xef, 0x5c, 0x23, 0xe4, 0xb3,
+	0xf9, 0x46, 0xfc, 0x11, 0x4a, 0xda, 0xff, 0x2e, 0xe5, 0x62, 0xc4, 0x02, 0x4a, 0xcc, 0xc0, 0xd5,
+	0x16, 0x9d, 0x43, 0x6a, 0xb5, 0x6c, 0x97, 0x06, 0x81, 0x30, 0x1e, 0x1c, 0xc2, 0xc0, 0x75, 0x25,
+	0x0f, 0x21, 0xe6, 0xd3, 0x21, 0xaf, 0x02, 0x04, 0xfb, 0x6e, 0x53, 0xce, 0xff, 0x68, 0x29, 0xaa,
+	0x82, 0xaf, 0x8d, 0xb0, 0xa0, 0x86, 0x91, 0xbd, 0x19, 0xc3, 0x68, 0x51, 0x8e, 0x71, 0x63, 0x59,
+	0xfe, 0x66, 0x8c, 0xd7, 0x50, 0x0c, 0x37, 0xff, 0x81, 0x01, 0xe3, 0x32, 0x9a, 0x0d, 0xb3, 0x51,
+	0x4b, 0x08, 0x04, 0xa3, 0xe3, 0x34, 0x25, 0x14, 0xdc, 0xe7, 0xba, 0x76, 0x29, 0x62, 0x97, 0xdc,
+	0x51, 0x29, 0x89, 0x92, 0x24, 0x1c, 0xcb, 0xeb, 0x13, 0x3a, 0x77, 0x59, 0x86, 0x1a, 0x31, 0xf3,
+	0x0b, 0x06, 0x5c, 0xc8, 0xb4, 0x1a, 0x80, 0x05, 0x3a, 0xc3, 0xf7, 0xe6, 0xef, 0x8e, 0xc0, 0x2c,
+	0x97, 0xba, 0xba, 0x96, 0x23, 0x64, 0x75, 0x67, 0xf0, 0xe6, 0x7a, 0x17, 0x4c, 0xda, 0x9d, 0x4e,
+	0x2f, 0x64, 0x47, 0xb5, 0x54, 0x62, 0xf1, 0x6f, 0xbe, 0xa2, 0x0a, 0x31, 0x86, 0x13, 0x57, 0xde,
+	0xee, 0xe2, 0x10, 0x5f, 0x2d, 0xf7, 0xe5, 0xf4, 0x01, 0xce, 0xb3, 0x5b, 0x50, 0xdc, 0x7c, 0x79,
+	0x97, 0xff, 0xf7, 0x1a, 0x00, 0x41, 0xe8, 0xdb, 0x6e, 0x9b, 0x15, 0x4a, 0x0e, 0x00, 0x4f, 0x80,
+	0x6c, 0x23, 0x42, 0x2a, 0x88, 0xc7, 0x61, 0x4d, 0x22, 0x00, 0x6a, 0x94, 0xc9, 0x82, 0x64, 0x7c,
+	0xc4, 0x89, 0xff, 0x75, 0x29, 0x16, 0xef, 0xa9, 0x6c, 0xb0, 0x36, 0xe9, 0x40, 0x1e, 0x73, 0x46,
+	0x57, 0xdf, 0x0f, 0x93, 0x11, 0xbd, 0xa3, 0x6e, 0xdd, 0x69, 0xfd, 0xd6, 0x7e, 0x11, 0xce, 0xa5,
+	0xba, 0x7b, 0xac, 0x4b, 0xfb, 0xdf, 0x1b, 0x40, 0x92, 0xa3, 0x3f, 0x83, 0xd7, 0x6a, 0x3b, 0xf9,
+	0x5a, 0x5d, 0x1c, 0xfe, 0x93, 0x15, 0x3c, 0x57, 0x7f, 0x8a, 0x00, 0x0f, 0xf6, 0x15, 0x05, 0x53,
+	0x93, 0x17, 0x17, 0xbb, 0x67, 0x63, 0x97, 0x29, 0xb9, 0x73, 0x87, 0xb8, 0x67, 0xef, 0xa4, 0x70,
+	0xc5, 0xf7, 0x6c, 0x1a, 0x82, 0x19, 0xba, 0xe4, 0x53, 0x06, 0x9c, 0xb7, 0x92, 0xc1, 0xbe, 0xd4,
+	0xcc, 0x94, 0xf2, 0xd5, 0x4f, 0x05, 0x0e, 0x8b, 0xfb, 0x92, 0x02, 0x04, 0x98, 0x21, 0xcb, 0x8c,
+	0xe6, 0xad, 0xae, 0xcd, 0xc2, 0x55, 0xb1, 0xd7, 0x8e, 0x0a, 0x84, 0xc3, 0x5f, 0xe0, 0x0b, 0xeb,
+	0x2b, 0x51, 0x39, 0x26, 0x6a, 0x45, 0x51, 0xb5, 0xe4, 0x44, 0x8e, 0x0c, 0x19, 0x55, 0x4b, 0xce,
+	0x61, 0x1c, 0x55, 0x4b, 0x4e, 0x9d, 0x4e, 0x84, 0xb8, 0x00, 0x9e, 0xdd, 0x6a, 0x4a, 0x92, 0x42,
+	0x6d, 0x5e, 0xea, 0xd1, 0x7f, 0x6f, 0x65, 0xa9, 0x2e, 0x29, 0xf2, 0xdb, 0x2f, 0xfe, 0x8d, 0x1a,
+	0x05, 0xf2, 0x59, 0x03, 0x66, 0xe4, 0xd9, 0x2d, 0x69, 0x8e, 0xf3, 0x4f, 0xf4, 0xb1, 0xb2, 0xeb,
+	0x25, 0xb5, 0x26, 0xe7, 0x51, 0x47, 0x2e, 0xce, 0x9d, 0xc8, 0xe3, 0x2e, 0x01, 0xc3, 0x64, 0x3f,
+	0xc8, 0xdf, 0x32, 0xe0, 0x12, 0x73, 0x45, 0xb7, 0x9b, 0x74, 0xa1, 0xd9, 0xf4, 0x7a, 0xae, 0xfa,
+	0x0e, 0x13, 0xe5, 0x63, 0xbc, 0x34, 0x72, 0xf0, 0x09, 0x57, 0x8f, 0x3c, 0x08, 0xe6, 0xd2, 0x67,
+	0x6c, 0xd9, 0xb9, 0x87, 0x56, 0xd8, 0xdc, 0xe1, 0xe1, 0xad, 0x98, 0x5a, 0x45, 0x78, 0x77, 0x94,
+	0x5c, 0xd7, 0xf7, 0x93, 0xa8, 0x84, 0xd9, 0x47, 0xaa, 0x10, 0xd3, 0x04, 0x89, 0x07, 0x13, 0xbe,
+	0x8c, 0xa0, 0x58, 0x83, 0xf2, 0x2c, 0x45, 0x26, 0x1c, 0xa3, 0x60, 0xec, 0xd5, 0x2f, 0x8c, 0x88,
+	0x30, 0x07, 0x17, 0xf1, 0xb4, 0x59, 0x70, 0x3d, 0x77, 0xbf, 0xe3, 0xf5, 0x02, 0x16, 0x48, 0x8b,
+	0xba, 0xa1, 0x12, 0xbf, 0x4e, 0xf1, 0x6b, 0x94, 0x3b, 0xb8, 0x2c, 0xf7, 0xab, 0x88, 0xfd, 0xf1,
+	0x90, 0x57, 0x60, 0x82, 0x3e, 0xa0, 0x6e, 0xc8, 0x62, 0x8f, 0x4d, 0x97, 0xe2, 0xf6, 0xf8, 0x10,
+	0x96, 0x25, 0x0e, 0x8c, 0xb0, 0x91, 0x5d, 0x18, 0x77, 0x44, 0x08, 0xcc, 0xda, 0x4c, 0xf9, 0x43,
+	0x31, 0x1d, 0x4e, 0x53, 0xbc, 0xff, 0xe4, 0x0f, 0x54, 0x14, 0x48, 0x17, 0xae, 0xb7, 0xe8, 0xb6,
+	0xd5, 0x73, 0xc2, 0xbb, 0x5e, 0xc8, 0x58, 0xda, 0xfd, 0x58, 0xe4, 0xa6, 0x7c, 0x82, 0x66, 0x79,
+	0x38, 0x86, 0x67, 0x0e, 0x0f, 0xe6, 0xae, 0x2f, 0x1d, 0x51, 0x17, 0x8f, 0xc4, 0x46, 0xf6, 0xe1,
+	0x69, 0x59, 0x67, 0xd3, 0xf5, 0xa9, 0xd5, 0xdc, 0x61, 0xb3, 0x9c, 0x25, 0x7a, 0x8e, 0x13, 0xfd,
+	0xff, 0x0e, 0x0f, 0xe6, 0x9e, 0x5e, 0x3a, 0xba, 0x3a, 0x0e, 0x82, 0x93, 0xbb, 0x1e, 0xd0, 0x94,
+	0x32, 0xa6, 0x76, 0xbe, 0xfc, 0x1c, 0xa7, 0x15, 0x3b, 0xc2, 0x36, 0x29, 0x5d, 0x8a, 0x19, 0x9a,
+	0xe4, 0x67, 0x0c, 0xa8, 0x05, 0xa1, 0xdf, 0x6b, 0x86, 0x3d, 0x9f, 0xb6, 0x52, 0x2b, 0xf4, 0xc2,
+	0x75, 0xa3, 0x2c, 0x03, 0xd7, 0x28, 0xc0, 0xc9, 0xbd, 0xd3, 0x6a, 0x45, 0x50, 0x2c, 0xec, 0x0b,
+	0x8b, 0x43, 0x62, 0xe9, 0xf1, 0x04, 0x6b, 0xa4, 0x7c, 0x1c, 0x92, 0x44, 0x60, 0x42, 0x61, 0x1d,
+	0x9c, 0x28, 0xc2, 0x24, 0x29, 0xf2, 0x93, 0x06, 0x3c, 0x96, 0xec, 0x58, 0xdc, 0x8d, 0x8b, 0xe5,
+	0x95, 0x0a, 0x8d, 0x7c, 0x94, 0xe2, 0xf1, 0x5b, 0x00, 0xc4, 0xa2, 0x8e, 0x5c, 0xfd, 0x30, 0x90,
+	0xec, 0xd5, 0x71, 0x14, 0x0f, 0x38, 0xa1, 0xf3, 0x80, 0x9f, 0x1f, 0x85, 0x27, 0xd8, 0x8d, 0x14,
+	0xbf, 0x7c, 0xd6, 0x2c, 0xd7, 0x6a, 0xbf, 0x35, 0xb9, 0xa5, 0x9f, 0x33, 0xe0, 0xb1, 0x9d, 0x7c,
+	0xa9, 0x84, 0x7c, 0x7b, 0x7d, 0xa4, 0x94, 0xf4, 0xa8, 0x9f, 0xa0, 0x43, 0x1c, 0xd6, 0x7d, 0xab,
+	0x60, 0x51, 0xa7, 0xc8, 0x87, 0xe1, 0xbc, 0xeb, 0xb5, 0x68, 0x7d, 0x65, 0x09, 0xd7, 0xac, 0x60,
+	0xb7, 0xa1, 0xec, 0x0e, 0x46, 0xc5, 0x5e, 0xbd, 0x9b, 0x82, 0x61, 0xa6, 0x36, 0xf3, 0x63, 0xeb,
+	0x7a, 0xad, 0xe5, 0x07, 0x76, 0x53, 0x69, 0xbc, 0xcb, 0xdb, 0x2e, 0x72, 0xb5, 0xfa, 0x7a, 0x06,
+	0x1b, 0xe6, 0x50, 0xe0, 0x62, 0x15, 0xd6, 0x99, 0x35, 0xcf, 0xb5, 0x43, 0xcf, 0xe7, 0xbe, 0x96,
+	0x43, 0x49, 0x17, 0xb8, 0x58, 0xe5, 0x6e, 0x2e, 0x46, 0x2c, 0xa0, 0x64, 0xfe, 0x37, 0x03, 0xce,
+	0xb1, 0x65, 0xb1, 0xee, 0x7b, 0x7b, 0xfb, 0x6f, 0xc5, 0x05, 0xf9, 0x9c, 0x34, 0x6c, 0x13, 0xe2,
+	0xc0, 0xcb, 0x9a, 0x51, 0xdb, 0x24, 0xef, 0x73, 0x6c, 0xc7, 0xa6, 0x4b, 0x44, 0xab, 0xc5, 0x12,
+	0x51, 0xf3, 0xb3, 0x15, 0xf1, 0x6a, 0x51, 0x12, 0xc9, 0xb7, 0xe4, 0x3e, 0x7c, 0x3f, 0xcc, 0xb0,
+	0xb2, 0x35, 0x6b, 0x6f, 0x7d, 0xe9, 0x65, 0xcf, 0x51, 0xee, 0x99, 0xfc, 0x50, 0xbd, 0xa3, 0x03,
+	0x30, 0x59, 0x8f, 0xbc, 0xc0, 0xec, 0x94, 0x78, 0x50, 0x0d, 0xf9, 0x5e, 0xbe, 0x2e, 0xec, 0x94,
+	0x78, 0xd1, 0xa3, 0x83, 0xb9, 0x0b, 0xb1, 0x4a, 0x51, 0x16, 0xa2, 0x6a, 0x60, 0x7e, 0xe6, 0x32,
+	0x70, 0xe4, 0x0e, 0x0d, 0xdf, 0x8a, 0x73, 0xf2, 0x6e, 0x98, 0x6a, 0x76, 0x7b, 0xf5, 0x9b, 0x8d,
+	0x8f, 0xf4, 0x3c, 0x2e, 0x07, 0xe1, 0xd1, 0xaf, 0xd9, 0x33, 0xa6, 0xbe, 0xbe, 0xa9, 0x8a, 0x51,
+	0xaf, 0xc3, 0x4e, 0x87, 0x66, 0xb7, 0x27, 0xcf, 0xdb, 0x75, 0xdd, 0xef, 0x80, 0x9f, 0x0e, 0xf5,
+	0xf5, 0xcd, 0x04, 0x0c, 0x33, 0xb5, 0xc9, 0x27, 0x60, 0x9a, 0xca, 0x8d, 0x7b, 0x9b, 0x05, 0xcc,
+	0x16, 0xe7, 0xc2, 0x4a, 0xd9, 0xc1, 0x47, 0x53, 0xab, 0x4e, 0x03, 0xf1, 0xfa, 0x5b, 0xd6, 0x48,
+	0x60, 0x82, 0x20, 0xf9, 0x16, 0x78, 0x5c, 0xfd, 0x66, 0x5f, 0xd9, 0x6b, 0xa5, 0x0f, 0x8a, 0x51,
+	0x11, 0xc7, 0x60, 0xb9, 0xa8, 0x12, 0x16, 0xb7, 0x27, 0x3f, 0x6b, 0xc0, 0x95, 0x08, 0x6a, 0xbb,
+	0x76, 0xa7, 0xd7, 0x41, 0xda, 0x74, 0x2c, 0xbb, 0x23, 0xdf, 0x7c, 0xf7, 0x4f, 0x6c, 0xa0, 0x49,
+	0xf4, 0xe2, 0xb0, 0xca, 0x87, 0x61, 0x41, 0x97, 0xc8, 0x17, 0x0c, 0xb8, 0xae, 0x40, 0xeb, 0x3e,
+	0x0d, 0x98, 0x9a, 0x3c, 0x76, 0x0e, 0x96, 0x53, 0x32, 0x5e, 0xea, 0xec, 0xe4, 0xcc, 0xef, 0xf2,
+	0x11, 0xb8, 0xf1, 0x48, 0xea, 0xfa, 0x72, 0x69, 0x78, 0xdb, 0x61, 0x6d, 0xe2, 0x54, 0x97, 0x0b,
+	0x23, 0x81, 0x09, 0x82, 0xe4, 0x1f, 0x1a, 0xf0, 0x98, 0x5e, 0xa0, 0xaf, 0x16, 0xf1, 0x3a, 0x7c,
+	0xe5, 0xc4, 0x3a, 0x93, 0xc2, 0x2f, 0x38, 0xac, 0x02, 0x20, 0x16, 0xf5, 0x8a, 0x1d, 0xdb, 0x1d,
+	0xbe, 0x30, 0xc5, 0x0b, 0x72, 0x54, 0x1c, 0xdb, 0x62, 0xad, 0x06, 0xa8, 0x60, 0x4c, 0x76, 0xd2,
+	0xf5, 0x5a, 0xeb, 0x76, 0x2b, 0x58, 0xb5, 0x3b, 0x76, 0xc8, 0xdf, 0x79, 0x55, 0x31, 0x1d, 0xeb,
+	0x5e, 0x6b, 0x7d, 0x65, 0x49, 0x94, 0x63, 0xa2, 0x16, 0x0f, 0x1b, 0x62, 0x77, 0xac, 0x36, 0x5d,
+	0xef, 0x39, 0xce, 0xba, 0xef, 0x71, 0x19, 0xf4, 0x12, 0xb5, 0x5a, 0x8e, 0xed, 0xd2, 0x92, 0xef,
+	0x3a, 0xbe, 0xdd, 0x56, 0x8a, 0x90, 0x62, 0x31, 0x3d, 0x66, 0x1d, 0xca, 0xf4, 0x40, 0x8d, 0x87,
+	0x56, 0xf7, 0x9e, 0x8a, 0x16, 0xc0, 0xa5, 0x22, 0x37, 0xa3, 0x52, 0xd4, 0x6a, 0xb0, 0xd5, 0xc4,
+	0x4e, 0x41, 0xa4, 0x22, 0x16, 0x5e, 0x6d, 0xf6, 0x84, 0x56, 0x93, 0x42, 0x28, 0xa6, 0xef, 0x8e,
+	0x46, 0x02, 0x13, 0x04, 0x99, 0x0a, 0x6a, 0x36, 0xd8, 0x0f, 0x42, 0xda, 0x89, 0xfa, 0x70, 0xee,
+	0xa4, 0xfb, 0xc0, 0xa5, 0xf3, 0x8d, 0x04, 0x11, 0x4c, 0x11, 0xe5, 0x71, 0x17, 0xd8, 0xac, 0xde,
+	0xaa, 0x33, 0xa5, 0x5e, 0x14, 0x0c, 0x64, 0x9d, 0xfa, 0x4d, 0xe6, 0x8e, 0x73, 0x9e, 0xaf, 0x1b,
+	0x11, 0x77, 0xa1, 0xb8, 0x1a, 0xf6, 0xc3, 0x41, 0x5e, 0x85, 0xab, 0x12, 0xbc, 0xea, 0x3d, 0xcc,
+	0x50, 0xb8, 0xc0, 0x29, 0x70, 0xc3, 0xc5, 0x95, 0xc2, 0x5a, 0xd8, 0x07, 0x03, 0xf3, 0x04, 0x09,
+	0xa8, 0xcf, 0x95, 0x6b, 0x34, 0x5a, 0x3c, 0x41, 0x8d, 0xc4, 0x9e, 0x20, 0x8d, 0x2c, 0x18, 0xf3,
+	0xda, 0x30, 0x57, 0x1d, 0xe9, 0x17, 0xba, 0xcf, 0x0a, 0x3e, 0xb2, 0xde, 0xe0, 0xef, 0xa5, 0x51,
+	0x21, 0xb3, 0xc1, 0x24, 0x08, 0xd3, 0x75, 0x19, 0x6f, 0xa1, 0x8a, 0x16, 0x7b, 0x7e, 0x10, 0xd6,
+	0x2e, 0xf1, 0xc6, 0x9c, 0xb7, 0x40, 0x1d, 0x80, 0xc9, 0x7a, 0xcc, 0x29, 0x20, 0xa0, 0xcd, 0xa6,
+	0xd7, 0xe9, 0xca, 0x17, 0x7b, 0xed, 0x32, 0xef, 0xbd, 0xf8, 0x82, 0x09, 0x08, 0xa6, 0x6a, 0x92,
+	0x7d, 0xb8, 0x18, 0x45, 0x86, 0x5b, 0xf5, 0xda, 0x6b, 0xd6, 0x1e, 0x67, 0xd5, 0xaf, 0x1c, 0xbd,
+	0x03, 0xe7, 0x95, 0x01, 0xc8, 0xfc, 0x47, 0x7a, 0x96, 0x1b, 0xb2, 0x08, 0x00, 0x7c, 0xba, 0xea,
+	0x59, 0x74, 0x98, 0x47, 0x83, 0xe5, 0x4e, 0x48, 0x15, 0xdf, 0xb4, 0x99, 0x36, 0xfc, 0x31, 0x3e,
+	0x6c, 0x11, 0x21, 0x3e, 0x07, 0x8e, 0xb9, 0xad, 0xc8, 0x3d, 0xb8, 0xdc, 0xf5, 0xbd, 0x90, 0x36,
+	0xc3, 0x3b, 0xd4, 0x77, 0xa9, 0x23, 0x07, 0x18, 0xd4, 0x6a, 0x7c, 0x2e, 0xb8, 0x62, 0x71, 0x3d,
+	0xaf, 0x02, 0xe6, 0xb7, 0x23, 0x9f, 0x37, 0xe0, 0x5a, 0x10, 0xfa, 0xd4, 0xea, 0xd8, 0x6e, 0xbb,
+	0xee, 0xb9, 0x2e, 0xe5, 0xc7, 0xe4, 0x4a, 0x2b, 0x76, 0xa4, 0x7a, 0xbc, 0xd4, 0x39, 0x65, 0x1e,
+	0x1e, 0xcc, 0x5d, 0x6b, 0xf4, 0xc5, 0x8c, 0x47, 0x50, 0x66, 0xa6, 0x7e, 0x1d, 0xda, 0xf1, 0xfc,
+	0x7d, 0x76, 0x22, 0xd5, 0xae, 0x96, 0x7f, 0x95, 0xaf, 0x45, 0x58, 0xc4, 0xf6, 0x4f, 0xa8, 0x44,
+	0x63, 0x20, 0x6a, 0xe4, 0xcc, 0x83, 0x0a, 0x5c, 0xce, 0xbd, 0x78, 0xd8, 0x0e, 0x10, 0xf5, 0x16,
+	0x54, 0x94, 0x78, 0xa9, 0x45, 0xe4, 0x3b, 0x60, 0x2d, 0x09, 0xc2, 0x74, 0x5d, 0xc6, 0x16, 0xf2,
+	0x9d, 0x7a, 0xb3, 0x11, 0xb7, 0xaf, 0xc4, 0x6c, 0xe1, 0x4a, 0x0a, 0x86, 0x99, 0xda, 0xa4, 0x0e,
+	0x17, 0x64, 0xd9, 0x0a, 0x7b, 0x59, 0x05, 0x37, 0x7d, 0xaa, 0x18, 0x6e, 0xf6, 0x46, 0xb9, 0xb0,
+	0x92, 0x06, 0x62, 0xb6, 0x3e, 0x1b, 0x05, 0xfb, 0xa1, 0xf7, 0x62, 0x24, 0x1e, 0xc5, 0xdd, 0x24,
+	0x08, 0xd3, 0x75, 0xd5, 0xd3, 0x37, 0xd1, 0x85, 0xd1, 0x78, 0x14, 0x77, 0x53, 0x30, 0xcc, 0xd4,
+	0x36, 0x7f, 0x7f, 0x04, 0x9e, 0x1e, 0x80, 0x59, 0x23, 0x9d, 0xfc, 0xe9, 0x3e, 0xfe, 0xc6, 0x1d,
+	0xec, 0xf3, 0x74, 0x0b, 0x3e, 0xcf, 0xf1, 0xe9, 0x0d, 0xfa, 0x39, 0x83, 0xa2, 0xcf, 0x79, 0x7c,
+	0x92, 0x83, 0x7f, 0xfe, 0x4e, 0xfe, 0xe7, 0x2f, 0x39, 0xab, 0x47, 0x2e, 0x97, 0x6e, 0xc1, 0x72,
+	0x29, 0x39, 0xab, 0x03, 0x2c, 0xaf, 0xff, 0x30, 0x02, 0xcf, 0x0c, 0xc2, 0x38, 0x96, 0x5c, 0x5f,
+	0x39, 0x47, 0xde, 0xa9, 0xae, 0xaf, 0x22, 0x5f, 0xd5, 0x53, 0x5c, 0x5f, 0x39, 0x24, 0x4f, 0x7b,
+	0x7d, 0x15, 0xcd, 0xea, 0x69, 0xad, 0xaf, 0xa2, 0x59, 0x1d, 0x60, 0x7d, 0xfd, 0x59, 0xfa, 0x7e,
+	0x88, 0xf8, 0xc5, 0x15, 0xa8, 0x36, 0xbb, 0xbd, 0x92, 0x87, 0x14, 0xb7, 0x39, 0xab, 0xaf, 0x6f,
+	0x22, 0xc3, 0x41, 0x10, 0xc6, 0xc4, 0xfa, 0x29, 0x79, 0x04, 0x71, 0xff, 0x3c, 0xb1, 0x24, 0x51,
+	0x62, 0x62, 0x53, 0x45, 0xbb, 0x3b, 0xb4, 0x43, 0x7d, 0xcb, 0x69, 0x84, 0x9e, 0x6f, 0xb5, 0xcb,
+	0x9e, 0x36, 0x42, 0x21, 0x91, 0xc2, 0x85, 0x19, 0xec, 0x6c, 0x42, 0xba, 0x76, 0xab, 0x36, 0x52,
+	0x7e, 0x42, 0xd6, 0x57, 0x96, 0x90, 0xe1, 0x30, 0x7f, 0x66, 0x12, 0xb4, 0xe0, 0xa8, 0x4c, 0x3e,
+	0x61, 0x39, 0x8e, 0xf7, 0x70, 0xdd, 0xb7, 0x1f, 0xd8, 0x0e, 0x6d, 0xd3, 0x56, 0xc4, 0x4c, 0x05,
+	0xd2, 0x32, 0x91, 0x3f, 0x98, 0x16, 0x8a, 0x2a, 0x61, 0x71, 0x7b, 0x26, 0x7f, 0xba, 0xd0, 0x4c,
+	0x07, 0xa4, 0x1c, 0xc6, 0x76, 0x29, 0x13, 0xdd, 0x52, 0xec, 0xa7, 0x4c, 0x31, 0x66, 0xc9, 0x12,
+	0x96, 0xff, 0x69, 0x57, 0x57, 0x2d, 0xcb, 0x6f, 0x76, 0xeb, 0x84, 0x74, 0xd4, 0xb1, 0x74, 0x2f,
+	0x02, 0x60, 0x92, 0x20, 0x93, 0x80, 0x5c, 0xde, 0xcd, 0xd3, 0x25, 0xd4, 0x46, 0xca, 0x7b, 0xb6,
+	0xf7, 0x51, 0x4e, 0x08, 0x76, 0x36, 0xb7, 0x02, 0xe6, 0x77, 0x24, 0x9a, 0xa5, 0x48, 0xbc, 0x5a,
+	0x1b, 0x1d, 0x6e, 0x96, 0x52, 0x72, 0xda, 0x78, 0x96, 0x22, 0x00, 0x26, 0x09, 0x32, 0xf7, 0xd7,
+	0x5d, 0x25, 0xd3, 0xae, 0x8d, 0x95, 0x57, 0x89, 0xa7, 0x04, 0xe3, 0xc2, 0x36, 0x2b, 0x2a, 0xc4,
+	0x98, 0x08, 0xd9, 0x81, 0xf1, 0x5d, 0x71, 0x10, 0xd5, 0xc6, 0xcb, 0x2b, 0xd0, 0x12, 0x67, 0x99,
+	0x10, 0x83, 0xc8, 0x22, 0x54, 0xe8, 0x75, 0x5b, 0xf3, 0x89, 0x23, 0x5c, 0xa0, 0x3e, 0x6f, 0xc0,
+	0xe5, 0x07, 0xd4, 0x0f, 0xed, 0x66, 0x5a, 0x93, 0x33, 0x59, 0xfe, 0x0d, 0xff, 0x72, 0x1e, 0x42,
+	0xb1, 0x4c, 0x72, 0x41, 0x98, 0xdf, 0x05, 0xf6, 0xa2, 0x17, 0x02, 0xf9, 0x46, 0x68, 0x85, 0x76,
+	0x73, 0xc3, 0xdb, 0xa5, 0x6e, 0x9c, 0x47, 0xab, 0x06, 0x71, 0x24, 0xc5, 0xe5, 0xe2, 0x6a, 0xd8,
+	0x0f, 0x87, 0xf9, 0xc7, 0x06, 0x64, 0xc4, 0xca, 0xcc, 0xa8, 0x7c, 0x7a, 0x9b, 0x5a, 0x61, 0xcf,
+	0xa7, 0xb7, 0xac, 0x30, 0x8a, 0x22, 0xf2, 0xf2, 0x49, 0x48, 0xb3, 0xe7, 0x6f, 0x6a, 0x88, 0x85,
+	0x8d, 0x49, 0x14, 0x58, 0x59, 0x07, 0x61, 0xa2, 0x07, 0x57, 0x5f, 0x82, 0x0b, 0x99, 0x86, 0xc7,
+	0xd2, 0x30, 0xfe, 0x73, 0x03, 0xf2, 0x92, 0x2e, 0x92, 0x57, 0x61, 0xd4, 0x62, 0xe9, 0x1f, 0xe5,
+	0x81, 0xf9, 0xc1, 0x72, 0xe6, 0x4e, 0x2d, 0x3d, 0x58, 0x0b, 0xff, 0x89, 0x02, 0x2d, 0x8b, 0xaa,
+	0x69, 0x25, 0xd4, 0xc9, 0x6b, 0x71, 0x08, 0x02, 0xae, 0x09, 0x5b, 0xc8, 0x40, 0x31, 0xa7, 0x85,
+	0xf9, 0x7d, 0x06, 0x90, 0x6c, 0x28, 0x6e, 0xe2, 0xc3, 0x84, 0x5c, 0xca, 0xea, 0x2b, 0x2d, 0x95,
+	0x74, 0xbc, 0x4a, 0x78, 0x11, 0xc6, 0xb6, 0x73, 0xb2, 0x20, 0xc0, 0x88, 0x0e, 0x8b, 0x58, 0x15,
+	0x27, 0xb2, 0x20, 0xef, 0x83, 0xa9, 0x16, 0x0d, 0x9a, 0xbe, 0xdd, 0x0d, 0x63, 0x9f, 0xc3, 0xc8,
+	0x4b, 0x60, 0x29, 0x06, 0xa1, 0x5e, 0x8f, 0xb9, 0xe8, 0x87, 0x56, 0xb0, 0xbb, 0xb2, 0x24, 0x1f,
+	0x95, 0x9c, 0x05, 0xd8, 0xe0, 0x25, 0x28, 0x21, 0x71, 0x18, 0xc8, 0xea, 0x00, 0x61, 0x20, 0x99,
+	0x37, 0xe3, 0xd0, 0x31, 0x2f, 0xc9, 0xd1, 0xf1, 0x2e, 0xcd, 0x9f, 0xae, 0xc0, 0x39, 0x56, 0x65,
+	0xcd, 0xb2, 0xdd, 0x90, 0xba, 0xdc, 0xc3, 0xa6, 0xe4, 0x24, 0xb4, 0x61, 0x26, 0x4c, 0x78, 0xe6,
+	0x1e, 0xdf, 0xff, 0x32, 0x32, 0xd0, 0x4a, 0xfa, 0xe3, 0x26, 0xf1, 0x92, 0x0f, 0x2a, 0x17, 0x27,
+	0xf1, 0xfc, 0x7e, 0x5a, 0x2d, 0x55, 0xee, 0xb7, 0xf4, 0x48, 0xba, 0x39, 0x47, 0xd9, 0x4f, 0x12,
+	0xde, 0x4c, 0xef, 0x87, 0x19, 0x69, 0x97, 0x2f, 0xe2, 0x79, 0xca, 0xe7, 0x37, 0xbf, 0x61, 0x6e,
+	0xea, 0x00, 0x4c, 0xd6, 0x33, 0x7f, 0xa7, 0x02, 0xc9, 0x1c, 0x2b, 0x65, 0x67, 0x29, 0x1b, 0xcc,
+	0xb4, 0x72, 0x6a, 0xc1, 0x4c, 0xbf, 0x96, 0x3b, 0x1a, 0x8b, 0x54, 0xab, 0x42, 0x45, 0xae, 0xa7,
+	0x15, 0xe3, 0xe5, 0x18, 0xd5, 0x88, 0xa7, 0x75, 0xe4, 0xd8, 0xd3, 0xfa, 0x3e, 0x69, 0xb0, 0x3b,
+	0x9a, 0x08, 0x29, 0xab, 0x0c, 0x76, 0x2f, 0x24, 0x1a, 0x6a, 0x0e, 0x59, 0xbf, 0x61, 0xc0, 0xb8,
+	0x8c, 0x3f, 0x3f, 0x80, 0xc3, 0x1f, 0xf3, 0xc9, 0x64, 0x4f, 0x9e, 0x61, 0xb8, 0xc1, 0xc6, 0x8e,
+	0xe7, 0x85, 0x89, 0x28, 0xfc, 0xdc, 0x1d, 0x85, 0xff, 0x8b, 0x02, 0x3d, 0xb7, 0xd9, 0xf4, 0x9b,
+	0x3b, 0x76, 0x48, 0xb9, 0x79, 0x88, 0x5c, 0x65, 0xc2, 0x66, 0x53, 0x2b, 0xc7, 0x44, 0x2d, 0xf3,
+	0x47, 0x47, 0xe0, 0xba, 0x44, 0x9c, 0x61, 0x91, 0xa2, 0x03, 0x6e, 0x9f, 0xa5, 0x07, 0xe6, 0x75,
+	0x96, 0x7c, 0xcb, 0x8e, 0x4c, 0x0f, 0xca, 0x3d, 0x7d, 0x65, 0x3a, 0xe1, 0x0c, 0x3a, 0xcc, 0xa3,
+	0x21, 0xa2, 0x54, 0xf3, 0xe2, 0xdb, 0xd4, 0x72, 0xc2, 0x1d, 0x45, 0xbb, 0x32, 0x4c, 0x94, 0xea,
+	0x2c, 0x3e, 0xcc, 0xa5, 0xc2, 0x4d, 0x1f, 0x24, 0xa0, 0xee, 0x53, 0x4b, 0xb7, 0xbb, 0x18, 0xc2,
+	0xa3, 0x64, 0x2d, 0x17, 0x23, 0x16, 0x50, 0xe2, 0x32, 0x44, 0x6b, 0x8f, 0x8b, 0x24, 0x90, 0x86,
+	0xbe, 0xcd, 0xb3, 0x29, 0x44, 0x52, 0xf4, 0xb5, 0x24, 0x08, 0xd3, 0x75, 0x99, 0x30, 0x9c, 0x9b,
+	0x92, 0xc4, 0xe1, 0x0b, 0x47, 0xe3, 0x08, 0x39, 0x77, 0x13, 0x10, 0x4c, 0xd5, 0x34, 0xbf, 0xab,
+	0x02, 0xd3, 0xfa, 0xb2, 0x1b, 0xc0, 0xfb, 0xaf, 0xa7, 0x5d, 0x86, 0x43, 0xb8, 0x71, 0xe9, 0x54,
+	0x07, 0xb8, 0x0f, 0xc9, 0x2b, 0x30, 0xdb, 0xe3, 0x27, 0x88, 0x0a, 0xc1, 0x24, 0xd7, 0xff, 0xd7,
+	0xb3, 0x51, 0x6e, 0x26, 0x20, 0x2c, 0x7c, 0x9f, 0x8e, 0x3e, 0x09, 0xc5, 0x14, 0x1e, 0xf3, 0x33,
+	0x55, 0xb8, 0x98, 0xd3, 0x1b, 0x6e, 0x72, 0x40, 0x53, 0x57, 0xf6, 0x30, 0x26, 0x07, 0x99, 0xeb,
+	0x3f, 0x32, 0x39, 0x48, 0x43, 0x30, 0x43, 0x97, 0xbc, 0x0c, 0xd5, 0xa6, 0x6f, 0xcb, 0x09, 0x7f,
+	0x7f, 0xa9, 0x07, 0x27, 0xae, 0x2c, 0x4e, 0x49, 0x8a, 0x2c, 0x95, 0x0f, 0x32, 0x84, 0xec, 0xe2,
+	0xd1, 0x8f, 0x0b, 0xc5, 0x05, 0x08, 0x9b, 0x39, 0x1d, 0x80, 0xc9, 0x7a, 0xe4, 0x15, 0xa8, 0xc9,
+	0x97, 0x80, 0xec, 0x62, 0xdd, 0x73, 0x83, 0x90, 0xed, 0xec, 0x50, 0x1e, 0xd4, 0xdc, 0x12, 0xf0,
+	0x4e, 0x41, 0x1d, 0x2c, 0x6c, 0x6d, 0xfe, 0x69, 0x15, 0xa6, 0xb4, 0xec, 0x1f, 0x64, 0x6d, 0x18,
+	0x11, 0x4a, 0x3c, 0x62, 0x25, 0x46, 0x59, 0x83, 0x6a, 0xbb, 0xdb, 0xab, 0x55, 0x86, 0x43, 0x77,
+	0x8b, 0xa1, 0x6b, 0x77, 0x7b, 0xe4, 0xe5, 0x48, 0x2a, 0x53, 0x4e, 0x6e, 0x12, 0x39, 0x49, 0xa5,
+	0x24, 0x33, 0x6a, 0x23, 0x8e, 0x14, 0x6e, 0xc4, 0x0e, 0x8c, 0x07, 0x52, 0x64, 0x33, 0x5a, 0x3e,
+	0x5a, 0x87, 0x36, 0xd3, 0x52, 0x44, 0x23, 0xde, 0x7b, 0xf2, 0x07, 0x2a, 0x1a, 0x8c, 0x97, 0xec,
+	0x71, 0x6f, 0x72, 0xfe, 0x90, 0x9d, 0x10, 0xbc, 0xe4, 0x26, 0x2f, 0x41, 0x09, 0xc9, 0x5c, 0x51,
+	0xe3, 0x03, 0x5d, 0x51, 0x7f, 0xa3, 0x02, 0x24, 0xdb, 0x0d, 0xf2, 0x34, 0x8c, 0xf2, 0x68, 0x14,
+	0xf2, 0x2c, 0x8a, 0x38, 0x7f, 0x1e, 0x8f, 0x00, 0x05, 0x8c, 0x34, 0x64, 0x84, 0x9f, 0x72, 0x9f,
+	0x93, 0xdb, 0xec, 0x48, 0x7a, 0x5a, 0x38, 0xa0, 0xeb, 0x09, 0x3f, 0x9f, 0xbc, 0x3b, 0x7f, 0x93,
+	0xc5, 0x90, 0x73, 0x59, 0x93, 0x92, 0x92, 0x2c, 0x61, 0x5a, 0x20, 0x50, 0xa0, 0xc2, 0x65, 0xfe,
+	0xfe, 0x18, 0x4c, 0xe9, 0x1c, 0xef, 0x3e, 0x80, 0xd5, 0x0b, 0x3d, 0x71, 0x80, 0xd5, 0x8c, 0xf2,
+	0x8f, 0x65, 0x0d, 0xe9, 0x42, 0x84, 0x50, 0xa8, 0xbc, 0xe2, 0xdf, 0xa8, 0x11, 0x63, 0xa4, 0x43,
+	0xbb, 0x43, 0xef, 0xdb, 0x6e, 0xcb, 0x7b, 0x58, 0xab, 0x9c, 0x08, 0xe9, 0x8d, 0x08, 0xa1, 0x20,
+	0x1d, 0xff, 0x46, 0x8d, 0x18, 0x3b, 0x5a, 0xf8, 0xc3, 0xd9, 0xe5, 0xe9, 0x98, 0x64, 0xdf, 0x3c,
+	0xc7, 0x51, 0xb7, 0xf2, 0x84, 0x38, 0x5a, 0xea, 0x05, 0x75, 0xb0, 0xb0, 0x35, 0xf9, 0xdb, 0x06,
+	0x5c, 0x6c, 0x66, 0x83, 0x29, 0xc9, 0x6f, 0x88, 0x43, 0x0e, 0x2f, 0x27, 0x4c, 0x93, 0x54, 0x10,
+	0x67, 0x01, 0x98, 0xd7, 0x0f, 0xf6, 0x8e, 0x8d, 0xe7, 0xe1, 0x3e, 0xa5, 0xbb, 0x2d, 0x6b, 0x5f,
+	0x5d, 0xe7, 0xfc, 0x1d, 0xbb, 0x91, 0x81, 0x62, 0x4e, 0x0b, 0x26, 0x70, 0xb9, 0x64, 0xb5, 0xc4,
+	0x25, 0x6f, 0x39, 0xac, 0x11, 0x5a, 0x6e, 0x9b, 0xaa, 0x24, 0x46, 0xb7, 0x4f, 0xe0, 0x3b, 0x72,
+	0x84, 0x71, 0x3e, 0xf7, 0x85, 0x1c, 0x6a, 0x98, 0xdb, 0x07, 0xe6, 0x08, 0xbe, 0xe5, 0x58, 0xcd,
+	0x5d, 0xaf, 0x17, 0x06, 0xb5, 0xf1, 0x61, 0x38, 0x88, 0xa8, 0x43, 0x8b, 0x12, 0x5f, 0xec, 0x08,
+	0xae, 0x4a, 0x02, 0x8c, 0x89, 0x99, 0x3f, 0x6b, 0xc0, 0xe5, 0xdc, 0x9d, 0x40, 0x6e, 0xc1, 0x85,
+	0xd8, 0xca, 0x4f, 0xbf, 0xeb, 0x27, 0xe2, 0x14, 0x73, 0x77, 0xd2, 0x15, 0x30, 0xdb, 0x86, 0x19,
+	0x57, 0x74, 0xb2, 0xbc, 0x84, 0x34, 0x11, 0xd4, 0x39, 0x63, 0x1d, 0x8c, 0x79, 0x6d, 0x58, 0xe2,
+	0xd3, 0x8b, 0x39, 0x63, 0x24, 0xf7, 0x60, 0x74, 0x8b, 0xb6, 0x6d, 0xc5, 0x8b, 0x1c, 0xe7, 0x81,
+	0x16, 0x9d, 0xa1, 0x8b, 0x0c, 0x01, 0x0a, 0x3c, 0x4c, 0x24, 0xaf, 0xfc, 0xdc, 0x8f, 0x87, 0x2e,
+	0xba, 0x0d, 0x23, 0xbf, 0x78, 0x33, 0x4a, 0x53, 0x51, 0x8d, 0x05, 0x0e, 0xc9, 0x14, 0x15, 0xe6,
+	0xff, 0x1c, 0x85, 0x6b, 0xfd, 0x77, 0x0d, 0xf9, 0x09, 0x03, 0xae, 0x34, 0xa9, 0x1f, 0x8a, 0x40,
+	0x44, 0x2a, 0x2f, 0x7f, 0x68, 0x53, 0x15, 0x61, 0x6f, 0xad, 0x14, 0x07, 0x54, 0x14, 0x30, 0x51,
+	0x70, 0xed, 0xf5, 0x5c, 0x82, 0x58, 0xd0, 0x11, 0xf2, 0xc3, 0x06, 0x5c, 0x48, 0xba, 0x43, 0xdd,
+	0xa1, 0x4a, 0x35, 0x73, 0xc2, 0xdd, 0xe3, 0x9a, 0x81, 0x46, 0x9a, 0x16, 0x66, 0xc9, 0xf3, 0x4e,
+	0xd1, 0xb0, 0xd9, 0x4a, 0x04, 0x9a, 0xab, 0x55, 0x4f, 0xad, 0x53, 0xd9, 0xa0, 0x76, 0x59, 0xf2,
+	0xe4, 0xe3, 0x00, 0x41, 0xb0, 0x73, 0x87, 0xee, 0x77, 0x2d, 0x5b, 0xe9, 0x07, 0x4e, 0xb8, 0x33,
+	0xc2, 0x9f, 0xbd, 0x71, 0x5b, 0x12, 0x41, 0x8d, 0x20, 0xf3, 0xc5, 0x99, 0x11, 0x49, 0x5c, 0x55,
+	0x0e, 0x90, 0xd1, 0xd3, 0xe8, 0x02, 0x67, 0x99, 0xef, 0xe9, 0x74, 0x30, 0x49, 0xd6, 0xfc, 0x1e,
+	0x03, 0x2e, 0xe5, 0x9d, 0xa2, 0x8c, 0xd3, 0x89, 0x77, 0xf4, 0x64, 0xc1, 0x2e, 0x7d, 0x4a, 0x8f,
+	0x46, 0x91, 0xdd, 0x79, 0xcf, 0xc2, 0xc4, 0x43, 0x75, 0x61, 0x08, 0x1e, 0x9e, 0x7b, 0x7d, 0x45,
+	0xd7, 0x44, 0x04, 0x65, 0x09, 0x15, 0x73, 0xef, 0xe4, 0x93, 0xe8, 0x86, 0xf9, 0x6d, 0xf0, 0x58,
+	0x81, 0x81, 0x0d, 0x59, 0x82, 0xe9, 0xe0, 0xa1, 0xd5, 0x5d, 0xa4, 0x3b, 0xd6, 0x03, 0x5b, 0xc6,
+	0x8d, 0x12, 0x56, 0xe1, 0xd3, 0x0d, 0xad, 0xfc, 0x51, 0xea, 0x37, 0x26, 0x5a, 0x99, 0x21, 0x80,
+	0xf4, 0x1e, 0x60, 0x4e, 0x65, 0xdb, 0x30, 0x61, 0x39, 0x6c, 0x7f, 0x46, 0xe1, 0x76, 0xbf, 0xa9,
+	0x94, 0x6c, 0x59, 0xe2, 0x10, 0x73, 0xa6, 0x7e, 0x61, 0x84, 0xdb, 0xfc, 0x7b, 0x06, 0x5c, 0xc9,
+	0x8f, 0x14, 0x34, 0xc0, 0x8b, 0xb9, 0x03, 0x53, 0x7e, 0xdc, 0x4c, 0x1e, 0x11, 0xdf, 0xa0, 0x9d,
+	0xb3, 0xf3, 0x5a, 0x24, 0x5f, 0x76, 0xb8, 0xd6, 0x7d, 0x2f, 0x50, 0x37, 0x4a, 0x3a, 0xd7, 0x41,
+	0x24, 0xc9, 0xd3, 0x7a, 0x82, 0x3a, 0x7e, 0x9e, 0x77, 0x84, 0x51, 0x0f, 0xba, 0x56, 0x93, 0xb6,
+	0xce, 0x38, 0xdf, 0xe9, 0x09, 0x04, 0xfb, 0xcf, 0xef, 0xfb, 0xe9, 0xe6, 0x1d, 0x29, 0xa0, 0x79,
+	0x74, 0xde, 0x91, 0xfc, 0x86, 0x6f, 0x93, 0x80, 0xf8, 0xf9, 0x9d, 0x2f, 0xf0, 0xb1, 0xff, 0xc3,
+	0xd1, 0xa2, 0xd1, 0xb2, 0x8f, 0x41, 0x1e, 0x24, 0x52, 0xa1, 0x1a, 0x27, 0x9a, 0x0a, 0x75, 0xf6,
+	0x38, 0x69, 0x50, 0x2b, 0x5f, 0xd1, 0x34, 0xa8, 0xd5, 0xb3, 0x4b, 0x83, 0x9a, 0x4a, 0xcd, 0x39,
+	0x72, 0x36, 0xa9, 0x39, 0xc9, 0xeb, 0x30, 0xd6, 0xb5, 0x7c, 0x66, 0x8a, 0x3c, 0x5a, 0xfe, 0x51,
+	0x98, 0x9b, 0xd1, 0x37, 0xde, 0x68, 0xeb, 0x9c, 0x00, 0x4a, 0x42, 0x39, 0xd1, 0x57, 0xc6, 0x4e,
+	0x2b, 0xfa, 0xca, 0x9f, 0x1b, 0xf0, 0x64, 0xbf, 0xc3, 0x80, 0x4b, 0x05, 0x9b, 0xa9, 0xc5, 0x3f,
+	0x8c, 0x54, 0x30, 0x73, 0xc6, 0x45, 0x52, 0xc1, 0x34, 0x04, 0x33, 0x74, 0x0b, 0x92, 0xda, 0x57,
+	0xca, 0x24, 0xb5, 0x37, 0xff, 0x7b, 0x15, 0xe0, 0x2e, 0x0d, 0x59, 0xc0, 0x7f, 0x76, 0xb3, 0x3e,
+	0x99, 0xd0, 0x7b, 0x4c, 0x7c, 0xe5, 0x82, 0x1c, 0x3e, 0x09, 0x23, 0x5d, 0xaf, 0x25, 0x4e, 0x77,
+	0xd9, 0x11, 0xee, 0x8f, 0xc1, 0x4b, 0x59, 0x10, 0x2f, 0x6e, 0x86, 0x25, 0xe5, 0x64, 0x5c, 0x6b,
+	0xc2, 0x64, 0xde, 0x01, 0x8a, 0x72, 0x91, 0xab, 0x9f, 0xb3, 0xc9, 0x81, 0x54, 0x03, 0xc9, 0x5c,
+	0xfd, 0xa2, 0x0c, 0x23, 0x28, 0x79, 0x01, 0xc0, 0xee, 0xde, 0xb4, 0x3a, 0xb6, 0x63, 0xcb, 0x97,
+	0xf2, 0x24, 0x7f, 0x18, 0xc0, 0xca, 0xba, 0x2a, 0x7d, 0xc4, 0x22, 0xa2, 0x8b, 0x5f, 0xfb, 0xa8,
+	0xd5, 0x66, 0x62, 0xd4, 0x80, 0xbb, 0x7e, 0x5b, 0xfe, 0x3e, 0x77, 0x20, 0x19, 0x8f, 0xf5, 0x77,
+	0x0d, 0x1d, 0x80, 0xc9, 0x7a, 0xd2, 0x92, 0x5d, 0x14, 0xf0, 0x7e, 0x4b, 0x63, 0x0a, 0x65, 0xc9,
+	0xae, 0x41, 0x30, 0x55, 0x93, 0x99, 0xfe, 0x46, 0x25, 0x6a, 0x3c, 0xb5, 0xc9, 0xd8, 0xf4, 0xb7,
+	0x91, 0x06, 0x62, 0xb6, 0xbe, 0xf9, 0x17, 0x55, 0x98, 0xbe, 0xdb, 0xb6, 0xdd, 0x3d, 0x15, 0xc5,
+	0x29, 0xd2, 0xd5, 0x1b, 0xa7, 0xa3, 0xab, 0x7f, 0x05, 0x6a, 0x8e, 0x67, 0xb5, 0x16, 0x2d, 0x87,
+	0xb1, 0x9f, 0x7e, 0x43, 0xf0, 0x2d, 0x96, 0xab, 0xce, 0x6e, 0x29, 0x38, 0x5e, 0x2d, 0xa8, 0x83,
+	0x85, 0xad, 0x49, 0x08, 0x63, 0x4d, 0x95, 0xc1, 0xae, 0x74, 0x64, 0x22, 0x7d, 0x2e, 0xe6, 0xf5,
+	0x20, 0x1d, 0xd1, 0xe1, 0x24, 0xd7, 0xa9, 0xa4, 0xc5, 0x54, 0x48, 0x97, 0xe9, 0x9e, 0x08, 0x52,
+	0xb3, 0xe1, 0x5b, 0xdb, 0xdb, 0x76, 0x53, 0xfa, 0xf7, 0x89, 0x25, 0xb9, 0xca, 0x2c, 0x52, 0x96,
+	0xf3, 0x2a, 0x3c, 0x3a, 0x98, 0xbb, 0x91, 0x1b, 0x33, 0x88, 0x7f, 0x9a, 0xdc, 0x26, 0x98, 0x4f,
+	0x8a, 0x85, 0x03, 0x3c, 0x86, 0x57, 0x78, 0x22, 0x32, 0xd0, 0x2f, 0x57, 0x60, 0x9a, 0xad, 0x27,
+	0x16, 0xbb, 0xce, 0x61, 0x71, 0xfd, 0x9f, 0x4b, 0xc7, 0xf3, 0x8b, 0x0c, 0x7b, 0x32, 0x31, 0xfd,
+	0x56, 0xe1, 0xd2, 0xb6, 0xe7, 0x37, 0xe9, 0x46, 0x7d, 0x7d, 0xc3, 0x93, 0xa6, 0x6b, 0x4b, 0x77,
+	0x1b, 0x52, 0xdc, 0xc1, 0x95, 0x71, 0x37, 0x73, 0xe0, 0x98, 0xdb, 0x8a, 0x39, 0x34, 0xc4, 0xe5,
+	0x9b, 0x5d, 0xe1, 0x10, 0xc0, 0xd0, 0x55, 0x63, 0x87, 0x86, 0x9b, 0x79, 0x15, 0x30, 0xbf, 0x1d,
+	0x33, 0xed, 0x91, 0x11, 0x50, 0x6f, 0x7a, 0xfe, 0x43, 0xcb, 0x6f, 0x25, 0xd1, 0x8e, 0xc4, 0xa6,
+	0x3d, 0x4b, 0xc5, 0xd5, 0xb0, 0x1f, 0x0e, 0xf3, 0xc7, 0xc6, 0x40, 0x8b, 0x24, 0x73, 0x8c, 0xd4,
+	0xf1, 0x3f, 0x65, 0xc0, 0xa5, 0xa6, 0x63, 0x53, 0x37, 0x4c, 0x05, 0x65, 0x10, 0x07, 0xe9, 0x66,
+	0xa9, 0x10, 0x37, 0x5d, 0xea, 0xae, 0x2c, 0x49, 0xff, 0x89, 0x7a, 0x0e, 0x72, 0xe9, 0x63, 0x92,
+	0x03, 0xc1, 0xdc, 0xce, 0xf0, 0xf1, 0xf0, 0xf2, 0x95, 0x25, 0x3d, 0xce, 0x61, 0x5d, 0x96, 0x61,
+	0x04, 0x65, 0x3e, 0xb1, 0x6d, 0xdf, 0xeb, 0x75, 0x83, 0x3a, 0x77, 0xda, 0x14, 0x6b, 0x9f, 0xcb,
+	0xd7, 0x6f, 0xc5, 0xc5, 0xa8, 0xd7, 0x61, 0xda, 0x02, 0xf1, 0x73, 0xdd, 0xa7, 0xdb, 0xf6, 0x5e,
+	0x6d, 0x34, 0xd6, 0x16, 0xdc, 0xd2, 0xca, 0x31, 0x51, 0x8b, 0x87, 0x2a, 0x0b, 0x82, 0x1e, 0xf5,
+	0x37, 0x71, 0x55, 0xe6, 0x38, 0x15, 0xa1, 0xca, 0x54, 0x21, 0xc6, 0x70, 0x26, 0x0f, 0x99, 0x65,
+	0x11, 0x5b, 0x6c, 0x9f, 0x5d, 0xe6, 0x96, 0xdd, 0x51, 0x12, 0x47, 0x1c, 0x2e, 0x84, 0xd0, 0x3c,
+	0x26, 0x90, 0x8a, 0x13, 0x22, 0x32, 0x7f, 0x48, 0x02, 0x31, 0xd5, 0x03, 0x36, 0x55, 0x81, 0xdd,
+	0x76, 0x6d, 0xb7, 0xbd, 0xe0, 0xb4, 0xd9, 0x81, 0x5f, 0x55, 0x53, 0xd5, 0x88, 0x8b, 0x51, 0xaf,
+	0xc3, 0xee, 0x97, 0x5e, 0xc0, 0xf6, 0x7d, 0x87, 0x8a, 0xf9, 0x9d, 0x8c, 0xef, 0x97, 0x4d, 0x1d,
+	0x80, 0xc9, 0x7a, 0xec, 0x7e, 0x51, 0x05, 0x72, 0x96, 0x21, 0xbe, 0x5f, 0x36, 0x13, 0x10, 0x4c,
+	0xd5, 0xbc, 0xba, 0x00, 0x17, 0x73, 0x86, 0x79, 0xac, 0xc3, 0xe5, 0xff, 0x1a, 0x70, 0x39, 0x29,
+	0x13, 0x51, 0x22, 0xbe, 0xfc, 0xfc, 0x01, 0xc6, 0xa9, 0xe6, 0x0f, 0xf8, 0x0a, 0xe4, 0x49, 0x30,
+	0xff, 0x4e, 0x05, 0xde, 0x79, 0xe4, 0xbe, 0x64, 0x8a, 0x89, 0x29, 0xba, 0x17, 0xfa, 0x56, 0xe4,
+	0xd9, 0xce, 0x16, 0xe9, 0xf6, 0xa9, 0x1c, 0x02, 0xf3, 0xcb, 0x31, 0xa1, 0x54, 0xb8, 0x59, 0x0d,
+	0x82, 0x7a, 0x7f, 0x98, 0x5c, 0x57, 0xe4, 0x0a, 0xd1, 0x0d, 0xc9, 0x44, 0x48, 0x36, 0x94, 0x10,
+	0x16, 0x57, 0x36, 0x8d, 0xf9, 0x58, 0x6b, 0xe5, 0x97, 0x2a, 0xc0, 0xc2, 0x03, 0x30, 0xa1, 0xc2,
+	0x19, 0x08, 0x2a, 0xac, 0x84, 0xa0, 0xa2, 0xd4, 0x83, 0x4d, 0x76, 0xb6, 0x50, 0x32, 0x61, 0xa7,
+	0x24, 0x13, 0x0b, 0xc3, 0x10, 0xe9, 0x2f, 0x8a, 0xf8, 0x2d, 0x03, 0xa6, 0x64, 0xcd, 0x33, 0x90,
+	0x3d, 0x7c, 0x7b, 0x52, 0xf6, 0xf0, 0x8d, 0x43, 0x8c, 0xab, 0x40, 0xd8, 0xf0, 0x79, 0x03, 0x66,
+	0x64, 0x8d, 0x35, 0xda, 0xd9, 0xa2, 0x3e, 0xb9, 0x09, 0xe3, 0x41, 0x8f, 0x7f, 0x48, 0x39, 0xa0,
+	0x27, 0xb4, 0x01, 0xcd, 0xfb, 0x5b, 0x56, 0x93, 0x75, 0xbf, 0x21, 0xaa, 0x68, 0x79, 0x46, 0x45,
+	0x01, 0xaa, 0xc6, 0x4c, 0x5c, 0xe7, 0x7b, 0x4e, 0x26, 0x78, 0x35, 0x7a, 0x0e, 0x45, 0x0e, 0x61,
+	0x4f, 0x0a, 0xf6, 0x57, 0x89, 0x51, 0xf9, 0x93, 0x82, 0x81, 0x03, 0x14, 0xe5, 0xe6, 0x27, 0x47,
+	0xa2, 0xc9, 0xe6, 0xaf, 0xb0, 0xdb, 0x30, 0xd9, 0xf4, 0xa9, 0x15, 0xd2, 0xd6, 0xe2, 0xfe, 0x20,
+	0x9d, 0xe3, 0xd7, 0x55, 0x5d, 0xb5, 0xc0, 0xb8, 0x31, 0xbb, 0x19, 0x74, 0xdb, 0xbd, 0x4a, 0x7c,
+	0x89, 0x16, 0xda, 0xed, 0x7d, 0x13, 0x8c, 0x7a, 0x0f, 0xdd, 0xc8, 0x05, 0xa0, 0x2f, 0x61, 0x3e,
+	0x94, 0x7b, 0xac, 0x36, 0x8a, 0x46, 0x7a, 0xf0, 0xf6, 0x91, 0x3e, 0xc1, 0xdb, 0x1d, 0x96, 0x55,
+	0x9c, 0x7d, 0x86, 0xa1, 0xd2, 0x4e, 0x26, 0x3e, 0xa8, 0x9e, 0x98, 0x9c, 0x63, 0x46, 0x45, 0x82,
+	0xdd, 0xf0, 0xae, 0x7a, 0x82, 0xeb, 0x37, 0x7c, 0xf4, 0x2e, 0xc7, 0x18, 0xce, 0x72, 0xae, 0xe9,
+	0x59, 0x01, 0xc6, 0xcb, 0x0b, 0x9e, 0x64, 0xf7, 0xb4, 0x44, 0x00, 0x62, 0xea, 0x0b, 0x33, 0x03,
+	0x7c, 0xff, 0x48, 0xb4, 0x48, 0xa5, 0x70, 0x20, 0xff, 0x3d, 0x6e, 0x94, 0x79, 0x8f, 0x93, 0xf7,
+	0xa8, 0xac, 0x40, 0x95, 0x44, 0x12, 0xfd, 0x28, 0x2b, 0xd0, 0xb4, 0x24, 0x9d, 0xc8, 0x04, 0xd4,
+	0x83, 0x8b, 0x41, 0xc8, 0x42, 0x16, 0xdb, 0x52, 0xb4, 0x1f, 0x84, 0x56, 0xa7, 0x5b, 0x22, 0x2d,
+	0x8f, 0xf0, 0x03, 0xcf, 0xa2, 0xc2, 0x3c, 0xfc, 0x2c, 0x29, 0x65, 0x8d, 0x97, 0x33, 0x95, 0xaa,
+	0xc8, 0xca, 0x17, 0x13, 0x3f, 0xbe, 0x81, 0xb0, 0x8c, 0x21, 0x96, 0x8f, 0x0f, 0x0b, 0x29, 0x91,
+	0x37, 0xe1, 0x32, 0xbb, 0x81, 0x17, 0x9a, 0xa1, 0xfd, 0xc0, 0x0e, 0xf7, 0xe3, 0x2e, 0x1c, 0x3f,
+	0x17, 0x0f, 0x7f, 0x6c, 0xac, 0xe6, 0x21, 0xc3, 0x7c, 0x1a, 0xe6, 0x9f, 0x19, 0x40, 0xb2, 0x4b,
+	0x88, 0x38, 0x30, 0xd1, 0x52, 0x8e, 0xd9, 0xc6, 0x89, 0xa4, 0xac, 0x88, 0x4e, 0xe6, 0xc8, 0x9f,
+	0x3b, 0xa2, 0x40, 0x3c, 0x98, 0x7c, 0xb8, 0x63, 0x87, 0xd4, 0xb1, 0x83, 0xf0, 0x84, 0x32, 0x64,
+	0x44, 0x2a, 0xf5, 0xfb, 0x0a, 0x31, 0xc6, 0x34, 0xcc, 0x1f, 0x18, 0x81, 0x89, 0x63, 0xe4, 0xed,
+	0xe9, 0x01, 0x69, 0x6a, 0x29, 0xfa, 0x87, 0x91, 0x1d, 0x71, 0x26, 0xac, 0x9e, 0x41, 0x86, 0x39,
+	0x04, 0xc8, 0x9b, 0x70, 0xc9, 0x76, 0xb7, 0x7d, 0x2b, 0x8a, 0xad, 0x36, 0x4c, 0xa6, 0x7b, 0xfe,
+	0x86, 0x5a, 0xc9, 0x41, 0x87, 0xb9, 0x44, 0x08, 0x85, 0x71, 0x91, 0x45, 0x53, 0xc9, 0x68, 0x5f,
+	0x28, 0x15, 0x15, 0x93, 0xa3, 0x88, 0x4f, 0x4d, 0xf1, 0x3b, 0x40, 0x85, 0x5b, 0x44, 0xe1, 0x14,
+	0xff, 0x2b, 0xc1, 0x79, 0x6d, 0xb4, 0xbc, 0xcb, 0xd1, 0xfd, 0x24, 0x2a, 0x19, 0x85, 0x33, 0x59,
+	0x88, 0x69, 0x82, 0xe6, 0x3f, 0xae, 0xc0, 0xa8, 0x08, 0x78, 0x74, 0xfa, 0x1c, 0xdc, 0xb7, 0x25,
+	0x38, 0xb8, 0x52, 0xc9, 0xba, 0x79, 0x57, 0x0b, 0xf9, 0xb7, 0x76, 0x8a, 0x7f, 0x7b, 0xa9, 0x3c,
+	0x89, 0xfe, 0xdc, 0xdb, 0x16, 0xcc, 0xf0, 0x6a, 0xcc, 0x08, 0xb2, 0xd7, 0xa1, 0x3e, 0xb9, 0xa1,
+	0xdf, 0x80, 0x62, 0x37, 0x45, 0xdb, 0x30, 0xf7, 0x16, 0x3c, 0x32, 0x35, 0x08, 0xb3, 0x69, 0x9f,
+	0xe4, 0x44, 0xce, 0x80, 0x3f, 0x7c, 0x35, 0xc9, 0x1f, 0x7e, 0xb0, 0xf4, 0xbc, 0x15, 0x65, 0x27,
+	0x1a, 0x93, 0x63, 0xe1, 0xec, 0xd7, 0x0a, 0x5c, 0x94, 0x2e, 0x92, 0x2c, 0x4d, 0x2b, 0xdb, 0xaf,
+	0x4b, 0x4c, 0x09, 0x6e, 0x70, 0x13, 0x6a, 0x61, 0x7f, 0x95, 0x05, 0x63, 0x5e, 0x1b, 0xf2, 0xcb,
+	0x06, 0x63, 0x74, 0x42, 0xdf, 0x6e, 0x0e, 0xa5, 0x57, 0x8b, 0xfa, 0x36, 0xbf, 0x26, 0x90, 0x89,
+	0x67, 0xd6, 0x66, 0xcc, 0xf1, 0xf0, 0xd2, 0x47, 0x07, 0x73, 0x73, 0x39, 0xf2, 0xbf, 0x38, 0xe9,
+	0x6c, 0x10, 0x7e, 0xf7, 0x1f, 0xf4, 0xad, 0xc2, 0xbf, 0xaf, 0xea, 0x31, 0xf9, 0x97, 0x06, 0x4c,
+	0x05, 0xde, 0x76, 0x28, 0xd1, 0xcb, 0xd3, 0xe6, 0xee, 0x70, 0x23, 0x68, 0xc4, 0x08, 0xc5, 0x28,
+	0x3e, 0xaa, 0x1e, 0x8b, 0x1a, 0xe4, 0x84, 0x46, 0xa2, 0xf7, 0x9e, 0xdc, 0x86, 0xd1, 0xa0, 0xe9,
+	0x75, 0x95, 0x3f, 0xf2, 0xd3, 0x3a, 0x63, 0x2b, 0xfb, 0x3a, 0x9f, 0x56, 0x8e, 0x47, 0xcb, 0xa5,
+	0xc1, 0x5a, 0xa2, 0x40, 0x70, 0xf5, 0x35, 0x98, 0xd6, 0x47, 0x90, 0xf3, 0x28, 0x5d, 0xd2, 0x1f,
+	0xa5, 0xc7, 0x36, 0xe6, 0xd4, 0xc3, 0xb4, 0xbb, 0x70, 0x3e, 0x3d, 0x63, 0xa7, 0x49, 0xcf, 0xfc,
+	0x99, 0x0a, 0x4c, 0x69, 0x47, 0xcc, 0x89, 0x72, 0xa0, 0xdb, 0x27, 0xe0, 0x12, 0x34, 0x80, 0xaf,
+	0x17, 0x69, 0xc2, 0x68, 0x2f, 0x10, 0x9e, 0xe7, 0xa5, 0x19, 0x16, 0x3e, 0x07, 0x9b, 0x0c, 0x4b,
+	0xbc, 0x08, 0xf8, 0x4f, 0x14, 0xb8, 0xcd, 0xdf, 0xa8, 0x02, 0xc4, 0x95, 0x92, 0x6f, 0x0c, 0xe3,
+	0x88, 0x37, 0xc6, 0xcf, 0x1b, 0x30, 0xd2, 0x0b, 0x68, 0xab, 0x56, 0x29, 0x6f, 0x3e, 0x19, 0xd3,
+	0x9e, 0xdf, 0x0c, 0x68, 0x4b, 0xec, 0x25, 0x54, 0x07, 0x35, 0x2b, 0x3a, 0xa1, 0x4d, 0xc4, 0x7b,
+	0x4a, 0x7c, 0x98, 0x6c, 0xca, 0xdb, 0x44, 0x29, 0xa5, 0x17, 0x4a, 0x77, 0x5b, 0xdd, 0x4b, 0xf1,
+	0x25, 0xa4, 0x4a, 0x02, 0x8c, 0xc9, 0x5c, 0x6d, 0xc3, 0x64, 0x34, 0xb4, 0x53, 0x5d, 0xf4, 0xbf,
+	0x52, 0x81, 0x31, 0xa4, 0xed, 0xc1, 0x32, 0x39, 0xdb, 0x2a, 0x75, 0x6d, 0xa5, 0xbc, 0xe7, 0xa6,
+	0x9e, 0xbc, 0x87, 0xe5, 0xab, 0x8d, 0xd7, 0x98, 0x9e, 0xbd, 0x96, 0xb8, 0x51, 0x96, 0x2a, 0x31,
+	0xe3, 0xa5, 0x9e, 0xa1, 0x62, 0x60, 0x83, 0xe4, 0xa5, 0x1a, 0x22, 0x09, 0x94, 0xf9, 0xaf, 0x0c,
+	0x98, 0x4e, 0xa4, 0xfd, 0xea, 0x40, 0xd5, 0xa7, 0xdb, 0x35, 0x63, 0x28, 0xe3, 0x24, 0xe5, 0x9b,
+	0xf7, 0x44, 0x9f, 0x4a, 0xc8, 0xe8, 0x44, 0x19, 0xc2, 0x2a, 0x27, 0x94, 0x21, 0xcc, 0xfc, 0xac,
+	0x01, 0x57, 0xd4, 0x80, 0x92, 0xc1, 0xe2, 0x99, 0x12, 0xc3, 0xea, 0xda, 0x5c, 0xa5, 0xa0, 0x2b,
+	0x65, 0x16, 0xd6, 0x57, 0x78, 0x19, 0x46, 0x50, 0xe6, 0x98, 0xa8, 0x16, 0x9e, 0x64, 0xa5, 0x22,
+	0x36, 0x47, 0xe1, 0xc6, 0xa8, 0x06, 0xf9, 0x2a, 0x2d, 0xbb, 0xf0, 0xa8, 0xb6, 0x37, 0x14, 0x61,
+	0xe1, 0x4d, 0x60, 0x7e, 0x03, 0x4c, 0x36, 0x1a, 0xb7, 0x17, 0x9a, 0x4d, 0xa6, 0x5d, 0x1d, 0x5c,
+	0xb9, 0x66, 0x7e, 0xaa, 0x0a, 0x33, 0x32, 0xeb, 0x85, 0xed, 0xb6, 0x98, 0x4e, 0xfe, 0xf4, 0x79,
+	0xea, 0x0d, 0x98, 0x14, 0xd2, 0xdc, 0xd8, 0x50, 0x2d, 0xf7, 0xe2, 0x6d, 0xa8, 0x4a, 0xe9, 0x74,
+	0x79, 0x11, 0x00, 0x63, 0x44, 0xe4, 0x0e, 0x8c, 0xbd, 0xce, 0xce, 0x11, 0xb5, 0x2f, 0x06, 0xba,
+	0xcb, 0xa3, 0x45, 0xcf, 0x8f, 0xa0, 0x00, 0x25, 0x0a, 0x12, 0x68, 0x59, 0x6a, 0x87, 0x88, 0x81,
+	0x9a, 0x98, 0xd9, 0x23, 0x13, 0xd5, 0xb2, 0x5c, 0x9f, 0x89, 0x16, 0x6f, 0x93, 0x5c, 0x9f, 0x89,
+	0x3e, 0x17, 0x70, 0xd3, 0x1f, 0x84, 0xcb, 0xb9, 0x93, 0x71, 0xf4, 0x73, 0xde, 0xfc, 0xf9, 0x0a,
+	0x8c, 0xb0, 0x8c, 0x9d, 0x67, 0xb0, 0x32, 0x5f, 0x4d, 0xbc, 0xf6, 0xbe, 0xa9, 0x74, 0xb6, 0xd1,
+	0xa2, 0xc7, 0xde, 0x76, 0xea, 0xb1, 0xf7, 0xa1, 0xd2, 0x14, 0xfa, 0xbf, 0xf5, 0x7e, 0xbc, 0x02,
+	0xc0, 0xaa, 0x2d, 0x5a, 0xcd, 0x5d, 0x71, 0xe2, 0x44, 0xab, 0xd9, 0x48, 0x9e, 0x38, 0xd9, 0x65,
+	0x78, 0x96, 0x66, 0x37, 0xdc, 0x92, 0xbf, 0x6d, 0xa7, 0x2d, 0xf9, 0xdb, 0xb6, 0xb0, 0xe4, 0x67,
+	0x7f, 0x93, 0xa7, 0xc5, 0xc8, 0x09, 0x9d, 0x16, 0xe6, 0x1e, 0x8c, 0xb3, 0x09, 0x62, 0x0a, 0xfc,
+	0x4e, 0x26, 0x23, 0x75, 0xbd, 0xec, 0x67, 0xd1, 0x12, 0xf6, 0x17, 0xee, 0xf2, 0x4f, 0x19, 0x70,
+	0x2e, 0x55, 0x77, 0x00, 0x99, 0xd6, 0xa9, 0x9c, 0x99, 0xe6, 0xaf, 0x1b, 0x30, 0xc1, 0xfa, 0x72,
+	0x06, 0x07, 0xcd, 0xff, 0x9f, 0x3c, 0x68, 0x3e, 0x50, 0x76, 0x8a, 0x0b, 0xce, 0x97, 0x3f, 0xa9,
+	0x00, 0x4f, 0xeb, 0x2b, 0x8d, 0xcb, 0x34, 0x9b, 0x2d, 0xa3, 0xc0, 0x66, 0xeb, 0xba, 0x34, 0xf9,
+	0x4a, 0x49, 0x33, 0x34, 0xb3, 0xaf, 0xaf, 0xd5, 0xac, 0xba, 0xaa, 0xc9, 0x6d, 0x93, 0x63, 0xd9,
+	0xf5, 0x06, 0xcc, 0x04, 0xcc, 0xc1, 0x3e, 0x8a, 0x90, 0x39, 0x52, 0x5e, 0x1f, 0xc7, 0x3d, 0xf5,
+	0xd5, 0x50, 0xa4, 0x81, 0x97, 0x8e, 0x1b, 0x93, 0xa4, 0x58, 0xa4, 0xdd, 0x2d, 0xc7, 0x6b, 0xee,
+	0xb2, 0x48, 0xff, 0xca, 0x95, 0x8b, 0xdb, 0xb3, 0x2e, 0x46, 0xa5, 0xa8, 0xd5, 0x18, 0xc6, 0x0a,
+	0xcd, 0xfc, 0x23, 0x43, 0xcc, 0xf4, 0x5b, 0x32, 0x91, 0x3a, 0x4b, 0x0f, 0x98, 0x38, 0x51, 0xa2,
+	0x13, 0x32, 0x75, 0xaa, 0xcc, 0x29, 0x86, 0x7d, 0x24, 0xd6, 0xbf, 0xe9, 0x6c, 0xb6, 0xf9, 0x4b,
+	0x72, 0x98, 0x51, 0x66, 0xe8, 0x2e, 0xcc, 0x70, 0x8e, 0x38, 0x95, 0x92, 0xfa, 0x3d, 0x03, 0xee,
+	0x11, 0xbd, 0x69, 0x6c, 0xf6, 0x9b, 0x28, 0xc6, 0x24, 0x01, 0x66, 0x8f, 0xa1, 0x46, 0x27, 0xac,
+	0x6f, 0x2b, 0xb1, 0xdb, 0xf4, 0xba, 0x0e, 0xc0, 0x64, 0x3d, 0x96, 0x50, 0xfd, 0x29, 0xd1, 0x77,
+	0x2e, 0x31, 0x5d, 0xa2, 0x5d, 0xea, 0xb6, 0xa8, 0xdb, 0xdc, 0xe7, 0x3c, 0x6b, 0xcb, 0x63, 0xb2,
+	0xea, 0xb1, 0x87, 0x94, 0xb6, 0x22, 0x8d, 0xde, 0xfd, 0xd2, 0x17, 0x51, 0x11, 0x89, 0xfb, 0x1c,
+	0xbd, 0x38, 0xd1, 0xc5, 0xff, 0x28, 0x49, 0x32, 0xe2, 0x5d, 0xdf, 0xdb, 0x8a, 0x58, 0xab, 0x93,
+	0x27, 0xbe, 0xce, 0xd1, 0x0b, 0xe2, 0xe2, 0x7f, 0x94, 0x24, 0xcd, 0x75, 0x78, 0x7a, 0x80, 0xa6,
+	0xc7, 0x61, 0xa1, 0x8f, 0xc2, 0x28, 0x46, 0x7f, 0x1c, 0x8c, 0xbf, 0x67, 0xc0, 0x33, 0x1a, 0xca,
+	0xe5, 0x3d, 0xc6, 0xd5, 0xd7, 0xad, 0xae, 0xd5, 0x64, 0x6f, 0x54, 0x1e, 0xf5, 0xef, 0x58, 0x59,
+	0x71, 0x3f, 0x65, 0xc0, 0xb8, 0x30, 0x24, 0x54, 0xc7, 0xef, 0xab, 0x43, 0x4e, 0x79, 0x61, 0x97,
+	0x54, 0xba, 0x35, 0x35, 0x36, 0xf1, 0x3b, 0x40, 0x45, 0xdf, 0xfc, 0x17, 0xa3, 0xf0, 0x35, 0x83,
+	0x23, 0x22, 0x7f, 0x64, 0xe8, 0x29, 0xb8, 0x85, 0x6e, 0xab, 0x73, 0xba, 0x9d, 0x8f, 0x24, 0x1d,
+	0xf2, 0x61, 0x7c, 0x3f, 0x93, 0xa5, 0xfb, 0x84, 0x84, 0x28, 0xf1, 0xc0, 0xc8, 0xdf, 0x37, 0x60,
+	0x9a, 0x5d, 0x4b, 0xd1, 0xe1, 0x22, 0x3e, 0x53, 0xf7, 0x94, 0x47, 0x7a, 0x57, 0x23, 0x99, 0x8a,
+	0xe0, 0xa5, 0x83, 0x30, 0xd1, 0x37, 0xb2, 0x99, 0xd4, 0x86, 0x8b, 0xe7, 0xd6, 0xb5, 0x3c, 0x6e,
+	0xe4, 0x38, 0x39, 0xf0, 0xaf, 0x3a, 0x30, 0x9b, 0x9c, 0xf9, 0x53, 0x95, 0xa1, 0xbe, 0x04, 0x17,
+	0x32, 0xa3, 0x3f, 0x96, 0x70, 0xe3, 0xaf, 0x8f, 0xc0, 0x9c, 0x36, 0xd5, 0x09, 0x53, 0x62, 0xc5,
+	0x13, 0xfc, 0x68, 0x2a, 0xa7, 0xb7, 0x58, 0xbf, 0xad, 0x21, 0xbf, 0x6a, 0x1e, 0xa9, 0x12, 0xe9,
+	0xbd, 0xfb, 0x18, 0x15, 0x57, 0xce, 0xcc, 0xa8, 0x98, 0x7c, 0x5c, 0x5d, 0xc4, 0x62, 0x19, 0xbd,
+	0x72, 0x0a, 0x73, 0xc3, 0xef, 0xf5, 0x7c, 0x69, 0xda, 0xd0, 0x79, 0xca, 0x7f, 0xbe, 0x0a, 0xcf,
+	0x0c, 0x42, 0x7e, 0x00, 0x19, 0xe2, 0x17, 0x52, 0x8b, 0x45, 0x1c, 0x01, 0xf6, 0x69, 0x4d, 0xc8,
+	0xc9, 0xae, 0x98, 0xea, 0xd9, 0x99, 0xa1, 0x0f, 0xfb, 0xc9, 0x16, 0xe1, 0xb2, 0x36, 0x3f, 0x71,
+	0x82, 0x20, 0x1e, 0x6c, 0xd2, 0x0e, 0x6c, 0x15, 0x8f, 0x59, 0xbb, 0xa1, 0x5f, 0x16, 0xc5, 0xa8,
+	0xe0, 0xe6, 0x6a, 0x62, 0xef, 0x6f, 0x78, 0x5d, 0xcf, 0xf1, 0xda, 0xfb, 0x0b, 0x0f, 0x2d, 0x9f,
+	0xa2, 0xd7, 0x0b, 0x25, 0xb6, 0x41, 0xef, 0xfb, 0x35, 0xb8, 0xae, 0x61, 0xcb, 0x0d, 0x2c, 0x79,
+	0x1c, 0x74, 0xbf, 0x35, 0x0e, 0xd3, 0x1a, 0xbe, 0x80, 0xfc, 0xa2, 0x01, 0x8f, 0xd3, 0xa2, 0xab,
+	0x40, 0xf2, 0xb1, 0xaf, 0x9c, 0xd6, 0x55, 0x23, 0xf3, 0xf5, 0x14, 0x81, 0xb1, 0xb8, 0x67, 0x2c,
+	0x3c, 0x48, 0x10, 0x7d, 0x9e, 0x61, 0xc2, 0x83, 0xe4, 0x7e, 0x6f, 0xe9, 0xcf, 0x1d, 0xfd, 0x46,
+	0x8d, 0x18, 0x0b, 0x0e, 0x70, 0xc9, 0xc9, 0xd9, 0x3a, 0x92, 0x65, 0x6d, 0x9c, 0xc2, 0xae, 0x14,
+	0x36, 0x1f, 0x79, 0x10, 0xcc, 0xed, 0x0a, 0xf9, 0xe9, 0xc2, 0x88, 0xa7, 0xc2, 0x24, 0x63, 0x63,
+	0xc8, 0x4e, 0x9e, 0x54, 0xf0, 0xd3, 0xcf, 0x19, 0x40, 0x5a, 0x19, 0xb6, 0xb8, 0x36, 0x5e, 0x3e,
+	0xc1, 0x5e, 0x5f, 0x7e, 0x5b, 0x18, 0xed, 0x64, 0xcb, 0x31, 0xa7, 0x13, 0xfc, 0x3b, 0x87, 0x39,
+	0xdb, 0xb7, 0x36, 0x71, 0x22, 0xdf, 0x39, 0xef, 0x64, 0x10, 0xdf, 0x39, 0x0f, 0x82, 0xb9, 0x5d,
+	0x31, 0x7f, 0x6d, 0x4c, 0x48, 0x69, 0xb8, 0x21, 0xc2, 0x16, 0x8c, 0x6d, 0x71, 0xa9, 0x5e, 0xcd,
+	0x18, 0x4e, 0x84, 0x28, 0x64, 0x83, 0xe2, 0x8d, 0x24, 0xfe, 0x47, 0x89, 0x99, 0x7c, 0x0c, 0xaa,
+	0x2d, 0x37, 0x90, 0x1b, 0xee, 0x1b, 0x87, 0x10, 0x86, 0xc5, 0xbe, 0xfb, 0xcc, 0xc7, 0x85, 0x21,
+	0x25, 0x2e, 0x4c, 0xb8, 0x52, 0xb0, 0x21, 0xdf, 0x9e, 0x1f, 0x2e, 0x4b, 0x20, 0x12, 0x90, 0x44,
+	0x62, 0x19, 0x55, 0x82, 0x11, 0x0d, 0x46, 0x2f, 0x25, 0xc9, 0x2f, 0x4d, 0x2f, 0x12, 0xed, 0xf5,
+	0x93, 0x9e, 0x52, 0x16, 0x0d, 0xd5, 0x76, 0x43, 0x15, 0x06, 0xe7, 0xc5, 0xb2, 0xd4, 0x36, 0x18,
+	0x96, 0x58, 0x7e, 0xc1, 0x7f, 0x06, 0x28, 0x91, 0xb3, 0x65, 0x20, 0xfc, 0x65, 0x6b, 0xe3, 0xc3,
+	0x2d, 0x03, 0xe1, 0x82, 0x2b, 0x96, 0x81, 0xf8, 0x1f, 0x25, 0x66, 0xf2, 0x1a, 0x93, 0x7f, 0x49,
+	0x23, 0xaf, 0x89, 0xe1, 0xa6, 0x2e, 0xb2, 0xf0, 0x92, 0x7e, 0x91, 0xe2, 0x17, 0x46, 0xf8, 0xc9,
+	0x16, 0x8c, 0xdb, 0xc2, 0x1f, 0xae, 0x36, 0x59, 0x7e, 0xd9, 0x49, 0x97, 0x3a, 0xf1, 0x0c, 0x96,
+	0x3f, 0x50, 0x21, 0x36, 0x7f, 0x0b, 0x84, 0x54, 0x5c, 0x5a, 0x31, 0x6c, 0xc3, 0x84, 0x42, 0x37,
+	0x4c, 0x58, 0x87, 0x5b, 0x12, 0x2c, 0x86, 0xa6, 0x7e, 0x61, 0x84, 0x9b, 0x79, 0x50, 0x66, 0xc3,
+	0xfe, 0xc4, 0x09, 0x1e, 0x07, 0x0b, 0xf9, 0xf3, 0x3a, 0x40, 0x33, 0x8e, 0xbd, 0x58, 0x2d, 0xbf,
+	0xb4, 0xa2, 0xb8, 0x8c, 0xb1, 0x2a, 0x24, 0x2a, 0x0a, 0x50, 0x23, 0x52, 0x60, 0xe5, 0x31, 0x52,
+	0xca, 0xca, 0xe3, 0x45, 0x38, 0x27, 0x4d, 0xa1, 0x56, 0x78, 0x64, 0x13, 0x19, 0x1f, 0x45, 0xe6,
+	0x7e, 0xa9, 0x27, 0x41, 0x98, 0xae, 0x4b, 0x7e, 0xc5, 0x60, 0x2e, 0x6f, 0x82, 0x41, 0xa8, 0x8d,
+	0x95, 0xf7, 0xbb, 0x8c, 0xbf, 0xfe, 0xbc, 0xe2, 0x37, 0x04, 0xeb, 0xfb, 0xb2, 0xda, 0xd1, 0xaa,
+	0xf8, 0x84, 0x9e, 0xf8, 0x51, 0xaf, 0xc9, 0x6f, 0x32, 0xee, 0xde, 0x71, 0xbc, 0xa6, 0x15, 0xf2,
+	0xf8, 0x76, 0xc2, 0x43, 0xec, 0xde, 0x90, 0xa3, 0x58, 0x88, 0x31, 0xa6, 0x0c, 0xa7, 0x34, 0xc8,
+	0x49, 0x19, 0x4e, 0x69, 0xdd, 0x27, 0x7f, 0xd7, 0x80, 0x67, 0x84, 0x5b, 0x9e, 0x16, 0xd1, 0x48,
+	0x84, 0x98, 0x54, 0x5e, 0x49, 0xc2, 0x2a, 0x7a, 0xe2, 0xd8, 0xd6, 0x3c, 0xcf, 0x1e, 0x1e, 0xcc,
+	0x3d, 0x53, 0x1f, 0x00, 0x37, 0x0e, 0xd4, 0x03, 0x26, 0x98, 0x77, 0xf4, 0x18, 0xbc, 0xb5, 0xc9,
+	0xf2, 0x82, 0xf9, 0x44, 0x30, 0x5f, 0x21, 0x89, 0x4d, 0x14, 0x61, 0x92, 0xd4, 0xd5, 0x5d, 0x98,
+	0x49, 0x2c, 0xb4, 0xd3, 0x36, 0x0b, 0x4b, 0xaf, 0x87, 0x53, 0xb5, 0x90, 0xb9, 0x03, 0x93, 0xd1,
+	0x45, 0x45, 0x9e, 0xd2, 0x08, 0xc5, 0xd7, 0x3e, 0x0b, 0xd6, 0xc4, 0xa9, 0xce, 0x25, 0x9e, 0x63,
+	0x42, 0xde, 0xfe, 0x32, 0x2b, 0x90, 0x08, 0xcd, 0xdf, 0x96, 0xf2, 0xf6, 0x0d, 0xda, 0xe9, 0x3a,
+	0x56, 0x48, 0xdf, 0xfe, 0xda, 0x5e, 0xf3, 0x3f, 0x1b, 0xe2, 0xbe, 0x11, 0xd7, 0x2a, 0xb1, 0x60,
+	0xaa, 0x23, 0x12, 0x4d, 0xf1, 0x90, 0x8e, 0x46, 0xf9, 0x60, 0x92, 0x6b, 0x31, 0x1a, 0xd4, 0x71,
+	0x92, 0x87, 0x30, 0xa9, 0x18, 0x11, 0x25, 0x3f, 0xb8, 0x39, 0x1c, 0x63, 0x10, 0xf1, 0x3c, 0x91,
+	0x22, 0x51, 0x95, 0x04, 0x18, 0xd3, 0x32, 0x2d, 0x20, 0xd9, 0x36, 0xec, 0xcd, 0xaa, 0x1c, 0x7f,
+	0x8c, 0x64, 0xf6, 0x86, 0x8c, 0xf3, 0xcf, 0xd1, 0xb6, 0xc5, 0xbf, 0x5a, 0x81, 0x4b, 0xc9, 0xf0,
+	0x64, 0xb1, 0x12, 0x59, 0xf8, 0xe2, 0x4a, 0x22, 0x9c, 0x95, 0x11, 0x8e, 0xba, 0x28, 0x21, 0xcc,
+	0xeb, 0x9b, 0x09, 0x13, 0xdc, 0x16, 0xcf, 0x9a, 0x10, 0x9f, 0x12, 0xba, 0xd7, 0xf7, 0x72, 0x5e,
+	0x05, 0xcc, 0x6f, 0xc7, 0xd2, 0x68, 0x77, 0xac, 0xbd, 0x34, 0xb6, 0x21, 0xd2, 0x68, 0xaf, 0x65,
+	0xb0, 0x61, 0x0e, 0x05, 0x76, 0x91, 0x5a, 0xcd, 0x26, 0xed, 0x86, 0xb4, 0x25, 0x86, 0xa8, 0xd4,
+	0x7d, 0xfc, 0x22, 0x5d, 0x48, 0x82, 0x30, 0x5d, 0xd7, 0xfc, 0xf2, 0x08, 0x3c, 0x9e, 0x8d, 0xf1,
+	0xa6, 0xdc, 0x65, 0x5f, 0x52, 0xde, 0x40, 0x62, 0x22, 0x9f, 0x4b, 0x7b, 0x03, 0xd5, 0xf2, 0x02,
+	0x93, 0xe9, 0x9e, 0x41, 0x5f, 0x01, 0xdf, 0xd7, 0x02, 0x1f, 0xdf, 0xea, 0xa9, 0xfa, 0xf8, 0x7e,
+	0xda, 0x80, 0xab, 0xc9, 0xe2, 0x9b, 0xb6, 0x6b, 0x07, 0x3b, 0x32, 0xf6, 0xff, 0xf1, 0x9d, 0x91,
+	0x78, 0xaa, 0xcd, 0xd5, 0x42, 0x8c, 0xd8, 0x87, 0x1a, 0xf9, 0x8c, 0x01, 0x4f, 0xa4, 0xe6, 0x25,
+	0x91, 0x89, 0xe0, 0xf8, 0x7e, 0x49, 0x3c, 0x5a, 0xc1, 0x6a, 0x31, 0x4a, 0xec, 0x47, 0x8f, 0xbb,
+	0x67, 0x70, 0x6d, 0xf5, 0xdb, 0xc3, 0x3d, 0x83, 0x77, 0xf5, 0x74, 0xdd, 0x33, 0x04, 0x89, 0xfe,
+	0x26, 0x3b, 0x1f, 0x85, 0x2b, 0xbc, 0xda, 0x42, 0x8b, 0x0b, 0x51, 0x02, 0xda, 0x5a, 0x68, 0xb5,
+	0x78, 0xac, 0x94, 0xa3, 0x25, 0xc7, 0x4f, 0x41, 0xb5, 0xe7, 0x3b, 0xe9, 0x70, 0x79, 0x2c, 0x4a,
+	0x01, 0x2b, 0x37, 0xbf, 0xbf, 0x02, 0xe7, 0x39, 0x6e, 0x6d, 0xfb, 0x92, 0x07, 0x30, 0xe1, 0xab,
+	0xc8, 0xb4, 0xe2, 0xdb, 0xac, 0x96, 0x1e, 0x5a, 0x5e, 0x4c, 0x5a, 0xfe, 0x1a, 0x52, 0xbf, 0x30,
+	0xa2, 0x45, 0x3e, 0xc1, 0x7c, 0xd0, 0xd5, 0x71, 0x16, 0x0c, 0x63, 0xec, 0x1c, 0x53, 0x8d, 0xcf,
+	0x47, 0xdd, 0xcb, 0x3c, 0x22, 0x82, 0x3a, 0x45, 0xf3, 0x4b, 0x63, 0x50, 0x2b, 0xea, 0x35, 0x0b,
+	0xe5, 0xd0, 0x3f, 0x26, 0x68, 0xa9, 0x77, 0x76, 0x7d, 0x21, 0x9a, 0x96, 0x32, 0x41, 0x40, 0xdf,
+	0x14, 0x51, 0xcf, 0x9a, 0xba, 0xe9, 0xc4, 0x9d, 0xd2, 0x1f, 0x4b, 0xcb, 0x27, 0xa4, 0x3a, 0x15,
+	0x85, 0x3e, 0x93, 0xe5, 0x1a, 0x39, 0x46, 0x5c, 0x8b, 0xab, 0x59, 0x1d, 0x92, 0xb8, 0x16, 0x3d,
+	0x33, 0x41, 0xbc, 0x20, 0xaa, 0xe6, 0x77, 0x67, 0xa2, 0x6a, 0x0e, 0x61, 0x8c, 0x99, 0x1b, 0x22,
+	0xe2, 0xe8, 0x88, 0x9a, 0x05, 0x31, 0x58, 0x87, 0x08, 0xef, 0x59, 0x78, 0x01, 0x0f, 0x1d, 0x83,
+	0x75, 0xac, 0x7c, 0xa7, 0xb2, 0x41, 0x56, 0x13, 0x9d, 0x1a, 0x24, 0x06, 0x2b, 0x4b, 0xf4, 0xf0,
+	0x58, 0xc1, 0x1a, 0xfb, 0x4b, 0x13, 0x8a, 0x83, 0xb9, 0xc0, 0xf1, 0x39, 0x78, 0x9b, 0xb8, 0xc0,
+	0xf1, 0xbe, 0x16, 0x18, 0xd5, 0xfd, 0x3a, 0x33, 0x48, 0x4e, 0x27, 0x8d, 0x19, 0xc8, 0x1b, 0xe2,
+	0xcc, 0xec, 0xbd, 0xbe, 0x2a, 0x4e, 0x10, 0x57, 0x8d, 0x63, 0x0b, 0xa4, 0x93, 0xc3, 0x99, 0xf7,
+	0x61, 0x26, 0x61, 0x53, 0x17, 0x05, 0x7c, 0x33, 0x72, 0x03, 0xbe, 0xe9, 0xf1, 0xdc, 0x2a, 0xfd,
+	0xe2, 0xb9, 0xc5, 0x4b, 0x3e, 0x7b, 0xb2, 0xfd, 0xa5, 0x59, 0xf2, 0xff, 0xee, 0xbc, 0x5c, 0xf2,
+	0x5c, 0x41, 0xf1, 0x2a, 0x8c, 0xf1, 0x18, 0x6c, 0xea, 0xc6, 0x7c, 0xa1, 0x74, 0x6c, 0xb7, 0x40,
+	0x3c, 0xe5, 0xc4, 0xff, 0x28, 0xb1, 0x92, 0xa5, 0x64, 0x68, 0xc4, 0xbb, 0xf1, 0xab, 0x31, 0x37,
+	0xa8, 0x21, 0x5f, 0x96, 0x99, 0x16, 0x04, 0x85, 0x8a, 0x43, 0xdc, 0x67, 0xa5, 0x52, 0x9d, 0x30,
+	0xf5, 0xc6, 0x78, 0x42, 0xb5, 0xf1, 0x3a, 0x00, 0x55, 0x8b, 0x57, 0x39, 0x46, 0xbe, 0x58, 0x2e,
+	0x89, 0x4b, 0xb4, 0x05, 0x14, 0xf7, 0x1b, 0x15, 0x05, 0xa8, 0x11, 0x21, 0x3e, 0x4c, 0xed, 0xd8,
+	0x4c, 0x56, 0x2c, 0x18, 0xb9, 0xd1, 0xf2, 0x3c, 0xea, 0xed, 0x18, 0x8d, 0x10, 0x32, 0x68, 0x05,
+	0xa8, 0x13, 0x21, 0x7e, 0x22, 0x08, 0xeb, 0x58, 0x79, 0xb6, 0x28, 0x16, 0x7c, 0xc7, 0xe3, 0x2c,
+	0x08, 0xc0, 0xea, 0x02, 0xb8, 0x51, 0xd8, 0xc8, 0x61, 0x54, 0x1e, 0x71, 0xf0, 0x49, 0xc1, 0x78,
+	0xc4, 0xbf, 0x51, 0xa3, 0xc0, 0xe6, 0xb5, 0x13, 0x87, 0xaf, 0xae, 0x4d, 0x94, 0x9f, 0x57, 0x3d,
+	0xb8, 0xbe, 0x10, 0xde, 0xc4, 0x05, 0xa8, 0x13, 0x61, 0x63, 0xec, 0x44, 0x41, 0xa7, 0x6b, 0x93,
+	0xe5, 0xc7, 0x18, 0x87, 0xae, 0x96, 0xf9, 0xe6, 0xa3, 0xdf, 0xa8, 0x51, 0x60, 0xea, 0x9d, 0x48,
+	0x33, 0x06, 0xe5, 0x45, 0x60, 0x03, 0x69, 0xc5, 0xde, 0x17, 0x4b, 0x82, 0xa6, 0xf8, 0x5e, 0x7d,
+	0x42, 0x93, 0x02, 0xf1, 0x60, 0xdc, 0xec, 0xfc, 0xc8, 0x48, 0x85, 0x62, 0x6b, 0xde, 0xe9, 0xbe,
+	0xd6, 0xbc, 0x22, 0x48, 0x65, 0xec, 0x5d, 0xc2, 0x0f, 0x85, 0x99, 0x44, 0x90, 0xca, 0x24, 0x10,
+	0xb3, 0xf5, 0xc5, 0xa1, 0x4f, 0x5b, 0xbc, 0xed, 0xac, 0x7e, 0xe8, 0x8b, 0x32, 0x8c, 0xa0, 0xe4,
+	0x01, 0x4c, 0x07, 0x9a, 0x69, 0x70, 0xed, 0xdc, 0xb0, 0xca, 0x31, 0x81, 0x47, 0x44, 0xa5, 0xd3,
+	0x4b, 0x30, 0x41, 0x87, 0xbc, 0xa9, 0xdb, 0x42, 0x9e, 0x1f, 0x2e, 0x24, 0x73, 0x36, 0xc8, 0x78,
+	0x2c, 0xe2, 0x53, 0xa0, 0x40, 0x37, 0x51, 0xec, 0x25, 0xad, 0xfe, 0x2e, 0x9c, 0x48, 0xdc, 0x8f,
+	0x23, 0xad, 0x02, 0xd9, 0xa7, 0xa5, 0x7b, 0x5d, 0x2f, 0x60, 0xa1, 0x2e, 0x1c, 0x2b, 0x08, 0xf8,
+	0xe7, 0x21, 0xf1, 0xa7, 0x5d, 0x4e, 0x03, 0x31, 0x5b, 0x9f, 0x45, 0xe7, 0x3f, 0x1f, 0xec, 0x07,
+	0x21, 0xed, 0xb0, 0xab, 0xcb, 0x73, 0x29, 0xd3, 0xcf, 0x5e, 0x2c, 0x1f, 0x4f, 0xb7, 0x91, 0xc2,
+	0x25, 0x12, 0x53, 0xa7, 0x4b, 0x31, 0x43, 0x93, 0xad, 0x1c, 0x3d, 0x72, 0x48, 0xed, 0x52, 0xf9,
+	0x95, 0xa3, 0x47, 0x25, 0x11, 0x2b, 0x47, 0x2f, 0xc1, 0x04, 0x1d, 0x1e, 0x3a, 0x56, 0x25, 0x0c,
+	0xe6, 0x33, 0x78, 0x59, 0x0b, 0x1d, 0xab, 0x03, 0x30, 0x59, 0x8f, 0x7c, 0x02, 0xa6, 0xf5, 0xbb,
+	0xb3, 0x76, 0xe5, 0xa4, 0xc3, 0x31, 0x8b, 0x9e, 0xeb, 0xa0, 0x04, 0x41, 0xf3, 0x5f, 0x33, 0x21,
+	0xba, 0x92, 0x9f, 0x9c, 0x85, 0x56, 0xa0, 0x95, 0x10, 0x29, 0x2d, 0x0e, 0x25, 0xef, 0x29, 0x0c,
+	0x28, 0x6f, 0xfe, 0xae, 0x01, 0xb3, 0x71, 0xb5, 0x33, 0x78, 0x2b, 0x34, 0x93, 0x6f, 0x85, 0x0f,
+	0x0d, 0x37, 0xae, 0x82, 0x07, 0xc3, 0xff, 0xae, 0xe8, 0xa3, 0x92, 0x21, 0xdb, 0x75, 0x2d, 0x7b,
+	0x69, 0x61, 0x4f, 0xa4, 0x57, 0xd7, 0xdc, 0x89, 0xe3, 0xf1, 0xe6, 0x68, 0xdd, 0xff, 0x5a, 0x82,
+	0x19, 0x1b, 0x22, 0xce, 0x46, 0xc4, 0x79, 0x29, 0xd2, 0x62, 0x02, 0x8e, 0xe2, 0xcc, 0x5e, 0xd7,
+	0xcf, 0xea, 0x6a, 0xf9, 0x70, 0xf1, 0x89, 0x01, 0xf7, 0x3d, 0xa1, 0xcd, 0x9f, 0x3c, 0x07, 0x53,
+	0x9a, 0xa8, 0x31, 0x65, 0x33, 0x60, 0x9c, 0x85, 0xcd, 0x40, 0x08, 0x53, 0xcd, 0x28, 0xc9, 0x9e,
+	0x9a, 0xf6, 0x21, 0x69, 0x46, 0x77, 0x44, 0x9c, 0xbe, 0x2f, 0x40, 0x9d, 0x0c, 0xe3, 0x64, 0xa2,
+	0x35, 0x56, 0x3d, 0x01, 0x4b, 0x8e, 0x7e, 0xeb, 0xea, 0xbd, 0x00, 0x8a, 0x19, 0xa6, 0x2d, 0x19,
+	0xdd, 0x37, 0x32, 0x9a, 0x5f, 0x09, 0x6e, 0x47, 0x30, 0xd4, 0xea, 0x65, 0x75, 0xd0, 0xa3, 0x67,
+	0xa6, 0x83, 0x66, 0xcb, 0xc0, 0x51, 0x39, 0x9e, 0x87, 0xb2, 0x4a, 0x8a, 0x32, 0x45, 0xc7, 0xcb,
+	0x20, 0x2a, 0x0a, 0x50, 0x23, 0x52, 0x60, 0x3a, 0x32, 0x5e, 0xca, 0x74, 0xa4, 0x07, 0x17, 0x7d,
+	0x1a, 0xfa, 0xfb, 0xf5, 0xfd, 0x26, 0x8f, 0x8f, 0xef, 0x87, 0xfc, 0x49, 0x3b, 0x51, 0x2e, 0xda,
+	0x1c, 0x66, 0x51, 0x61, 0x1e, 0xfe, 0x04, 0x37, 0x38, 0xd9, 0x97, 0x1b, 0x7c, 0x1f, 0x4c, 0x85,
+	0xb4, 0xb9, 0xe3, 0xda, 0x4d, 0xcb, 0x59, 0x59, 0x92, 0xa1, 0x6f, 0x63, 0xc6, 0x26, 0x06, 0xa1,
+	0x5e, 0x8f, 0x2c, 0x42, 0xb5, 0x67, 0xb7, 0x24, 0x3b, 0xfc, 0xf5, 0x91, 0xd0, 0x7e, 0x65, 0xe9,
+	0xd1, 0xc1, 0xdc, 0x3b, 0x63, 0x5b, 0x8c, 0x68, 0x54, 0x37, 0xba, 0xbb, 0xed, 0x1b, 0xcc, 0x9d,
+	0x2e, 0x98, 0xdf, 0x5c, 0x59, 0x42, 0xd6, 0x38, 0xcf, 0xac, 0x66, 0xfa, 0x18, 0x66, 0x35, 0x9f,
+	0x33, 0xe0, 0xa2, 0x95, 0xd6, 0x37, 0xd0, 0xa0, 0x36, 0x53, 0xfe, 0xb4, 0xcc, 0xd7, 0x61, 0x2c,
+	0x3e, 0x21, 0xc7, 0x77, 0x71, 0x21, 0x4b, 0x0e, 0xf3, 0xfa, 0xc0, 0x04, 0x19, 0x1d, 0xbb, 0x1d,
+	0xa5, 0x5b, 0x96, 0x5f, 0x7d, 0xb6, 0x9c, 0x20, 0x63, 0x2d, 0x83, 0x09, 0x73, 0xb0, 0x93, 0x87,
+	0x30, 0xa5, 0x25, 0xcc, 0xab, 0x9d, 0x1b, 0x82, 0x41, 0x4c, 0x29, 0x18, 0xc4, 0xd3, 0x4f, 0x2b,
+	0x40, 0x9d, 0x52, 0xa4, 0x4f, 0xd4, 0xde, 0xdc, 0x52, 0xa7, 0xc6, 0x47, 0x7d, 0xbe, 0xbc, 0x3e,
+	0x31, 0x1f, 0x23, 0xf6, 0xa1, 0xc6, 0x63, 0xbc, 0x39, 0xc9, 0xac, 0xe8, 0xb5, 0x0b, 0xe5, 0xfd,
+	0xa2, 0x53, 0x09, 0xd6, 0xc5, 0xd2, 0x4c, 0x15, 0x62, 0x9a, 0x20, 0x4b, 0x52, 0x48, 0x85, 0x6c,
+	0x39, 0x7e, 0xa9, 0x04, 0x35, 0x12, 0x27, 0x29, 0x5c, 0xce, 0x40, 0x31, 0xa7, 0x05, 0xf9, 0x41,
+	0x23, 0x42, 0xc4, 0x94, 0xd5, 0xea, 0xbd, 0x7b, 0xb1, 0x7c, 0x04, 0xcf, 0xe5, 0x0c, 0xb6, 0x44,
+	0x87, 0xb4, 0x72, 0xcc, 0xa1, 0x6c, 0xfe, 0x8e, 0x21, 0x45, 0x91, 0x67, 0x68, 0xe8, 0x72, 0xda,
+	0x5a, 0x52, 0xf3, 0x3e, 0xd4, 0x1a, 0x2a, 0x20, 0x61, 0x2b, 0x15, 0x75, 0xfa, 0x1b, 0x61, 0x46,
+	0xa8, 0x02, 0xd6, 0xac, 0xee, 0xdd, 0x58, 0x6e, 0x1c, 0xb9, 0xc0, 0xd6, 0x75, 0x20, 0x26, 0xeb,
+	0x9a, 0x5f, 0x36, 0xe0, 0xb1, 0x24, 0x66, 0xcf, 0xb7, 0xdf, 0x18, 0x1e, 0x31, 0xf9, 0x3e, 0x03,
+	0xa6, 0x62, 0x2d, 0x97, 0xe2, 0x54, 0x4a, 0x19, 0xa3, 0xab, 0x5e, 0x51, 0x5f, 0x53, 0x7b, 0x64,
+	0x53, 0x69, 0xc5, 0xc0, 0x00, 0x75, 0xd2, 0xe6, 0x9f, 0x1a, 0x90, 0x79, 0x3a, 0x32, 0x7b, 0x58,
+	0x46, 0x84, 0x65, 0x10, 0x30, 0xca, 0xdb, 0xc3, 0xd6, 0x05, 0x0a, 0x21, 0x14, 0x97, 0x3f, 0x50,
+	0x21, 0x66, 0x8f, 0x51, 0x57, 0xcb, 0xc9, 0x20, 0x97, 0x47, 0x29, 0x2e, 0x55, 0xcf, 0xed, 0x20,
+	0x9e, 0x74, 0x7a, 0x09, 0x26, 0xe8, 0x98, 0xab, 0x00, 0xf1, 0x73, 0x7f, 0x68, 0xc3, 0xb1, 0x3f,
+	0x1e, 0x85, 0xcb, 0xc3, 0xba, 0xcc, 0xf0, 0x14, 0xed, 0xf4, 0x81, 0xdd, 0x0c, 0x17, 0xb6, 0x43,
+	0xea, 0xdf, 0xbb, 0xb7, 0xb6, 0xb1, 0xe3, 0xd3, 0x60, 0xc7, 0x73, 0x5a, 0x25, 0x73, 0xc4, 0x73,
+	0x3d, 0xef, 0x72, 0x2e, 0x46, 0x2c, 0xa0, 0xc4, 0x45, 0x1d, 0x0c, 0xc2, 0x38, 0x21, 0xf6, 0xc4,
+	0xe8, 0xf9, 0x41, 0x28, 0xe3, 0xfe, 0x08, 0x51, 0x47, 0x1a, 0x88, 0xd9, 0xfa, 0x69, 0x24, 0xab,
+	0x76, 0xc7, 0x16, 0xb9, 0xb2, 0x8d, 0x2c, 0x12, 0x0e, 0xc4, 0x6c, 0x7d, 0x1d, 0x89, 0xf8, 0x52,
+	0xec, 0x0e, 0x18, 0xcd, 0x22, 0x89, 0x80, 0x98, 0xad, 0x4f, 0x5a, 0xf0, 0xa4, 0x4f, 0x9b, 0x5e,
+	0xa7, 0x43, 0xdd, 0x16, 0x9f, 0x94, 0x35, 0xcb, 0x6f, 0xdb, 0xee, 0x4d, 0xdf, 0xe2, 0x15, 0xb9,
+	0xe4, 0xd8, 0xe0, 0xa9, 0xf9, 0x9e, 0xc4, 0x3e, 0xf5, 0xb0, 0x2f, 0x16, 0xd2, 0x81, 0x73, 0x22,
+	0xd5, 0xba, 0xbf, 0xe2, 0x86, 0x4c, 0x6b, 0xeb, 0xd4, 0xc6, 0x4b, 0x7d, 0x31, 0x7e, 0x2f, 0x6d,
+	0x26, 0x51, 0x61, 0x1a, 0x37, 0xd9, 0x87, 0x8b, 0x51, 0x77, 0x34, 0x92, 0x13, 0xa5, 0x48, 0x4a,
+	0x8e, 0x34, 0x83, 0x0e, 0xf3, 0x68, 0x98, 0x9f, 0x33, 0x40, 0x5a, 0xe8, 0x33, 0xed, 0x95, 0xa6,
+	0x82, 0x9b, 0x48, 0xa9, 0xdf, 0x54, 0x56, 0xa5, 0x4a, 0x6e, 0x56, 0xa5, 0xaf, 0xd6, 0x02, 0x4a,
+	0x4d, 0xc6, 0x17, 0x87, 0xc0, 0xac, 0xe5, 0xa7, 0x7e, 0x17, 0x4c, 0x46, 0xf7, 0xa9, 0x7c, 0xe7,
+	0xf0, 0xe0, 0x75, 0xf1, 0xc5, 0x1b, 0xc3, 0x59, 0xa4, 0x2f, 0x88, 0x93, 0x79, 0x0d, 0x96, 0x55,
+	0xfb, 0x48, 0x93, 0x3f, 0x2d, 0x1b, 0x78, 0xb5, 0x30, 0x1b, 0xf8, 0x29, 0x25, 0xc9, 0xfe, 0x45,
+	0x03, 0xce, 0x25, 0x23, 0x7c, 0x05, 0x4c, 0xd7, 0x28, 0x63, 0x20, 0xcb, 0xb8, 0x9f, 0xbc, 0xa9,
+	0x0c, 0xc2, 0x81, 0x0a, 0x96, 0x94, 0xd2, 0x0e, 0x21, 0x78, 0xc8, 0x0f, 0x34, 0x76, 0x84, 0x0c,
+	0xe0, 0x93, 0xe7, 0x61, 0x4c, 0x04, 0xd0, 0x65, 0x67, 0x5a, 0x8e, 0xf3, 0xf1, 0x9d, 0xf2, 0x71,
+	0x7a, 0xcb, 0x78, 0x8c, 0xea, 0xb9, 0x6a, 0x2a, 0x7d, 0x73, 0xd5, 0x20, 0x54, 0x9b, 0xbe, 0x3d,
+	0x8c, 0x46, 0xae, 0x8e, 0x2b, 0x42, 0x23, 0x57, 0xc7, 0x15, 0x64, 0xc8, 0x48, 0x98, 0x50, 0x55,
+	0x8d, 0x94, 0xe7, 0xe7, 0xc5, 0x04, 0x68, 0x0a, 0xab, 0xd9, 0xbe, 0xca, 0x2a, 0x15, 0xa1, 0x6f,
+	0xb4, 0xbc, 0x09, 0xae, 0x9c, 0xf2, 0x01, 0x22, 0xf4, 0x45, 0x1b, 0x69, 0xac, 0x70, 0x23, 0x6d,
+	0xc3, 0xb8, 0xdc, 0x0a, 0xb5, 0xf1, 0xf2, 0xdc, 0x84, 0xb4, 0x02, 0xd0, 0x82, 0xea, 0x8b, 0x02,
+	0x54, 0xc8, 0xd9, 0x8d, 0xdb, 0xb1, 0xf6, 0x98, 0x39, 0x32, 0x3f, 0x11, 0x47, 0xf5, 0xaa, 0xbc,
+	0x18, 0x15, 0x9c, 0x57, 0x15, 0x96, 0xcb, 0xb5, 0xc9, 0x54, 0x55, 0x51, 0x8c, 0x0a, 0x4e, 0x3e,
+	0x06, 0x13, 0x1d, 0x6b, 0xaf, 0xd1, 0xf3, 0xdb, 0xb4, 0x06, 0x47, 0x30, 0xc8, 0xbd, 0xd0, 0x76,
+	0xe6, 0x99, 0x50, 0x28, 0xf4, 0xe7, 0x57, 0xdc, 0xf0, 0x9e, 0xdf, 0x08, 0xfd, 0x28, 0xe7, 0xea,
+	0x9a, 0xc4, 0x82, 0x11, 0x3e, 0xe2, 0xc0, 0x6c, 0xc7, 0xda, 0xdb, 0x74, 0x2d, 0x11, 0x7c, 0xd1,
+	0x11, 0xfa, 0xa9, 0x32, 0x14, 0xb8, 0xb5, 0xc2, 0x5a, 0x02, 0x17, 0xa6, 0x70, 0xe7, 0x18, 0x46,
+	0x4c, 0x9f, 0x96, 0x61, 0xc4, 0x42, 0xe4, 0x87, 0x26, 0x5e, 0xf3, 0x8f, 0xe7, 0xc6, 0x67, 0xe8,
+	0xeb, 0x63, 0xf6, 0x6a, 0xe4, 0x63, 0x36, 0x5b, 0x5e, 0x93, 0xdf, 0xc7, 0xbf, 0xac, 0x07, 0x53,
+	0xec, 0x79, 0x22, 0x4a, 0xd9, 0x73, 0xbb, 0xb4, 0x60, 0x7a, 0x29, 0x42, 0x13, 0x1f, 0x49, 0x71,
+	0x59, 0x80, 0x3a, 0x1d, 0x66, 0x0b, 0xce, 0x36, 0xab, 0x43, 0xc3, 0xb8, 0xca, 0x5d, 0x4b, 0x3e,
+	0xb3, 0x27, 0x85, 0x2d, 0xf8, 0x9d, 0xbc, 0x0a, 0x98, 0xdf, 0x2e, 0x8e, 0x25, 0x74, 0x21, 0x3f,
+	0x96, 0x10, 0xf9, 0x81, 0x3c, 0xf5, 0x13, 0xb9, 0x6e, 0x94, 0xbd, 0x19, 0xc4, 0xd9, 0x50, 0x5a,
+	0x09, 0xf5, 0x4f, 0x0c, 0xa8, 0xc9, 0x55, 0x26, 0x55, 0x46, 0x0e, 0xf5, 0xd7, 0x2c, 0xd7, 0x6a,
+	0x47, 0x2f, 0xe3, 0x8d, 0x21, 0xce, 0x87, 0x0c, 0xce, 0xc8, 0xf9, 0xef, 0x99, 0xc3, 0x83, 0xb9,
+	0xeb, 0x47, 0xd5, 0xc2, 0xc2, 0xbe, 0x11, 0x1f, 0xc6, 0x83, 0xfd, 0xa0, 0x19, 0x3a, 0x41, 0xed,
+	0x52, 0xf9, 0x94, 0xfe, 0xf2, 0x64, 0x6d, 0x08, 0x4c, 0xe2, 0x68, 0x8d, 0x53, 0xb9, 0x88, 0x52,
+	0x54, 0x84, 0x86, 0x8d, 0x36, 0x30, 0x44, 0xf8, 0xd4, 0xab, 0x2f, 0xc0, 0xb4, 0xde, 0xc9, 0x63,
+	0x05, 0x39, 0xf8, 0x29, 0x03, 0xce, 0xa7, 0x2f, 0x2d, 0xb2, 0x03, 0xe3, 0x72, 0x05, 0xd7, 0x8c,
+	0xf2, 0xf2, 0x67, 0xb9, 0x37, 0x64, 0xa4, 0x1f, 0xce, 0x03, 0xc9, 0x22, 0x54, 0xe8, 0x75, 0xb3,
+	0xac, 0x4a, 0x1f, 0xb3, 0xac, 0x17, 0xe1, 0x4a, 0xfe, 0x5a, 0x66, 0x1c, 0xa4, 0xe5, 0x38, 0xde,
+	0x43, 0xf9, 0x72, 0x8b, 0xb3, 0x3c, 0xb2, 0x42, 0x14, 0x30, 0xf3, 0xe3, 0x90, 0x4e, 0x16, 0x40,
+	0x5e, 0x83, 0xc9, 0x20, 0xd8, 0x11, 0x71, 0x50, 0x6b, 0xc6, 0x10, 0xf2, 0x0e, 0x15, 0x4c, 0x55,
+	0x30, 0xbd, 0xd1, 0x4f, 0x8c, 0xd1, 0x2f, 0xbe, 0xf2, 0xc5, 0x2f, 0x5f, 0x7b, 0xc7, 0x6f, 0x7f,
+	0xf9, 0xda, 0x3b, 0xbe, 0xf4, 0xe5, 0x6b, 0xef, 0xf8, 0xce, 0xc3, 0x6b, 0xc6, 0x17, 0x0f, 0xaf,
+	0x19, 0xbf, 0x7d, 0x78, 0xcd, 0xf8, 0xd2, 0xe1, 0x35, 0xe3, 0x3f, 0x1e, 0x5e, 0x33, 0x7e, 0xe8,
+	0x0f, 0xaf, 0xbd, 0xe3, 0x63, 0xcf, 0xc7, 0xd4, 0x6f, 0x28, 0xa2, 0xf1, 0x3f, 0x4c, 0xa8, 0xcb,
+	0xa8, 0x2b, 0x97, 0x3b, 0x4e, 0xfd, 0xff, 0x0d, 0x00, 0x2c, 0x78, 0xb6, 0x9c, 0x65, 0x08, 0x01,
+	0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -6042,6 +6661,133 @@ func (m *AuditPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Authorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != nil {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CacheUnauthorizedTTL != nil {
+		{
+			size, err := m.CacheUnauthorizedTTL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CacheAuthorizedTTL != nil {
+		{
+			size, err := m.CacheAuthorizedTTL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.KubeconfigSecretName)
+	copy(dAtA[i:], m.KubeconfigSecretName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KubeconfigSecretName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizerKubeconfigReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizerKubeconfigReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizerKubeconfigReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SecretName)
+	copy(dAtA[i:], m.SecretName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SecretName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.AuthorizerName)
+	copy(dAtA[i:], m.AuthorizerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthorizerName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AvailabilityZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6769,6 +7515,39 @@ func (m *CloudProfileList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CloudProfileReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloudProfileReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloudProfileReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CloudProfileSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7927,6 +8706,82 @@ func (m *CoreDNSRewriting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CredentialExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialsRotationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialsRotationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialsRotationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DNS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8257,6 +9112,18 @@ func (m *EncryptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Provider != nil {
+		{
+			size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Resources) > 0 {
 		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Resources[iNdEx])
@@ -8269,6 +9136,46 @@ func (m *EncryptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EncryptionProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderConfig != nil {
+		{
+			size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ExpirableVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8666,6 +9573,30 @@ func (m *GardenerResourceData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Labels) > 0 {
 		keysForLabels := make([]string, 0, len(m.Labels))
 		for k := range m.Labels {
@@ -9157,6 +10088,48 @@ func (m *KubeAPIServerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StructuredAuthorization != nil {
+		{
+			size, err := m.StructuredAuthorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.StructuredAuthentication != nil {
+		{
+			size, err := m.StructuredAuthentication.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.EncryptionConfig != nil {
 		{
 			size, err := m.EncryptionConfig.MarshalToSizedBuffer(dAtA[:i])
@@ -10900,6 +11873,55 @@ func (m *Maintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Blackouts) > 0 {
+		for iNdEx := len(m.Blackouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blackouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AdditionalTimeRanges) > 0 {
+		for iNdEx := len(m.AdditionalTimeRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalTimeRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TimeWindowWeekdays) > 0 {
+		for iNdEx := len(m.TimeWindowWeekdays) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TimeWindowWeekdays[iNdEx])
+			copy(dAtA[i:], m.TimeWindowWeekdays[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeWindowWeekdays[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CredentialsRotation != nil {
+		{
+			size, err := m.CredentialsRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ConfineSpecUpdateRollout != nil {
 		i--
 		if *m.ConfineSpecUpdateRollout {
@@ -10978,6 +12000,181 @@ func (m *MaintenanceAutoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceBlackout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceBlackout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceBlackout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Begin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceCredentialsRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceCredentialsRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceCredentialsRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Observability != nil {
+		{
+			size, err := m.Observability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SSHKeypair != nil {
+		{
+			size, err := m.SSHKeypair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ETCDEncryptionKey != nil {
+		{
+			size, err := m.ETCDEncryptionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ServiceAccountKey != nil {
+		{
+			size, err := m.ServiceAccountKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CertificateAuthorities != nil {
+		{
+			size, err := m.CertificateAuthorities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceTimeRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceTimeRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceTimeRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weekdays) > 0 {
+		for iNdEx := len(m.Weekdays) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Weekdays[iNdEx])
+			copy(dAtA[i:], m.Weekdays[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Weekdays[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.End)
+	copy(dAtA[i:], m.End)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Begin)
+	copy(dAtA[i:], m.Begin)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Begin)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MaintenanceTimeWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11114,6 +12311,241 @@ func (m *NamedResourceReference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespacedCloudProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespacedCloudProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespacedCloudProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespacedCloudProfileList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespacedCloudProfileList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespacedCloudProfileList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespacedCloudProfileSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespacedCloudProfileSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespacedCloudProfileSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderConfig != nil {
+		{
+			size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.VolumeTypes) > 0 {
+		for iNdEx := len(m.VolumeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MachineTypes) > 0 {
+		for iNdEx := len(m.MachineTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MachineTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MachineImages) > 0 {
+		for iNdEx := len(m.MachineImages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MachineImages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Kubernetes != nil {
+		{
+			size, err := m.Kubernetes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespacedCloudProfileStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespacedCloudProfileStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespacedCloudProfileStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.CloudProfileSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Networking) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11134,6 +12566,27 @@ func (m *Networking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SecondaryServices != nil {
+		i -= len(*m.SecondaryServices)
+		copy(dAtA[i:], *m.SecondaryServices)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SecondaryServices)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SecondaryNodes != nil {
+		i -= len(*m.SecondaryNodes)
+		copy(dAtA[i:], *m.SecondaryNodes)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SecondaryNodes)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SecondaryPods != nil {
+		i -= len(*m.SecondaryPods)
+		copy(dAtA[i:], *m.SecondaryPods)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SecondaryPods)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.IPFamilies) > 0 {
 		for iNdEx := len(m.IPFamilies) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.IPFamilies[iNdEx])
@@ -11995,6 +13448,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12018,6 +13481,39 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QuotaList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12085,6 +13581,35 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SoftMetrics) > 0 {
+		keysForSoftMetrics := make([]string, 0, len(m.SoftMetrics))
+		for k := range m.SoftMetrics {
+			keysForSoftMetrics = append(keysForSoftMetrics, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForSoftMetrics)
+		for iNdEx := len(keysForSoftMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.SoftMetrics[k8s_io_api_core_v1.ResourceName(keysForSoftMetrics[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForSoftMetrics[iNdEx])
+			copy(dAtA[i:], keysForSoftMetrics[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForSoftMetrics[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12132,6 +13657,131 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastUpdateTime != nil {
+		{
+			size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14077,6 +15727,20 @@ func (m *ShootCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Expirations) > 0 {
+		for iNdEx := len(m.Expirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Rotation != nil {
 		{
 			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
@@ -14432,6 +16096,20 @@ func (m *ShootSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CloudProfile != nil {
+		{
+			size, err := m.CloudProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.SchedulerName != nil {
 		i -= len(*m.SchedulerName)
 		copy(dAtA[i:], *m.SchedulerName)
@@ -14837,6 +16515,20 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EncryptionProvider != nil {
+		{
+			size, err := m.EncryptionProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.EncryptedResources) > 0 {
 		for iNdEx := len(m.EncryptedResources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EncryptedResources[iNdEx])
@@ -15071,6 +16763,76 @@ func (m *ShootTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StructuredAuthentication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StructuredAuthentication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StructuredAuthentication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ConfigMapName)
+	copy(dAtA[i:], m.ConfigMapName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMapName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StructuredAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StructuredAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StructuredAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kubeconfigs) > 0 {
+		for iNdEx := len(m.Kubeconfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kubeconfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.ConfigMapName)
+	copy(dAtA[i:], m.ConfigMapName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMapName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SystemComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15904,6 +17666,55 @@ func (m *AuditPolicy) Size() (n int) {
 	return n
 }
 
+func (m *Authorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizationWebhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KubeconfigSecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CacheAuthorizedTTL != nil {
+		l = m.CacheAuthorizedTTL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CacheUnauthorizedTTL != nil {
+		l = m.CacheUnauthorizedTTL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizerKubeconfigReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthorizerName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *AvailabilityZone) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16166,6 +17977,19 @@ func (m *CloudProfileList) Size() (n int) {
 	return n
 }
 
+func (m *CloudProfileReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *CloudProfileSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16598,6 +18422,32 @@ func (m *CoreDNSRewriting) Size() (n int) {
 	return n
 }
 
+func (m *CredentialExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpirationTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *CredentialsRotationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Interval.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *DNS) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16736,6 +18586,25 @@ func (m *EncryptionConfig) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Provider != nil {
+		l = m.Provider.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptionProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProviderConfig != nil {
+		l = m.ProviderConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16904,6 +18773,14 @@ func (m *GardenerResourceData) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -17135,6 +19012,18 @@ func (m *KubeAPIServerConfig) Size() (n int) {
 		l = m.EncryptionConfig.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.StructuredAuthentication != nil {
+		l = m.StructuredAuthentication.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.StructuredAuthorization != nil {
+		l = m.StructuredAuthorization.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17728,6 +19617,28 @@ func (m *Maintenance) Size() (n int) {
 	if m.ConfineSpecUpdateRollout != nil {
 		n += 2
 	}
+	if m.CredentialsRotation != nil {
+		l = m.CredentialsRotation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.TimeWindowWeekdays) > 0 {
+		for _, s := range m.TimeWindowWeekdays {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.AdditionalTimeRanges) > 0 {
+		for _, e := range m.AdditionalTimeRanges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Blackouts) > 0 {
+		for _, e := range m.Blackouts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17744,6 +19655,71 @@ func (m *MaintenanceAutoUpdate) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceBlackout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Begin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceCredentialsRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CertificateAuthorities != nil {
+		l = m.CertificateAuthorities.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ServiceAccountKey != nil {
+		l = m.ServiceAccountKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ETCDEncryptionKey != nil {
+		l = m.ETCDEncryptionKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SSHKeypair != nil {
+		l = m.SSHKeypair.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Observability != nil {
+		l = m.Observability.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceTimeRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Begin)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.End)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Weekdays) > 0 {
+		for _, s := range m.Weekdays {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MaintenanceTimeWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17796,6 +19772,87 @@ func (m *NamedResourceReference) Size() (n int) {
 	return n
 }
 
+func (m *NamespacedCloudProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespacedCloudProfileList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespacedCloudProfileSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kubernetes != nil {
+		l = m.Kubernetes.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.MachineImages) > 0 {
+		for _, e := range m.MachineImages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.MachineTypes) > 0 {
+		for _, e := range m.MachineTypes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VolumeTypes) > 0 {
+		for _, e := range m.VolumeTypes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Parent.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProviderConfig != nil {
+		l = m.ProviderConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespacedCloudProfileStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CloudProfileSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	return n
+}
+
 func (m *Networking) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17828,6 +19885,18 @@ func (m *Networking) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.SecondaryPods != nil {
+		l = len(*m.SecondaryPods)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecondaryNodes != nil {
+		l = len(*m.SecondaryNodes)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecondaryServices != nil {
+		l = len(*m.SecondaryServices)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18146,6 +20215,21 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *QuotaConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18186,6 +20270,63 @@ func (m *QuotaSpec) Size() (n int) {
 	}
 	l = m.Scope.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.SoftMetrics) > 0 {
+		for k, v := range m.SoftMetrics {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if m.LastUpdateTime != nil {
+		l = m.LastUpdateTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18898,6 +21039,12 @@ func (m *ShootCredentials) Size() (n int) {
 		l = m.Rotation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Expirations) > 0 {
+		for _, e := range m.Expirations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19109,6 +21256,10 @@ func (m *ShootSpec) Size() (n int) {
 		l = len(*m.SchedulerName)
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.CloudProfile != nil {
+		l = m.CloudProfile.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19245,6 +21396,10 @@ func (m *ShootStatus) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.EncryptionProvider != nil {
+		l = m.EncryptionProvider.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19261,6 +21416,34 @@ func (m *ShootTemplate) Size() (n int) {
 	return n
 }
 
+func (m *StructuredAuthentication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigMapName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StructuredAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigMapName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Kubeconfigs) > 0 {
+		for _, e := range m.Kubeconfigs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SystemComponents) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19616,6 +21799,40 @@ func (this *AuditPolicy) String() string {
 	}, "")
 	return s
 }
+func (this *Authorization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Authorization{`,
+		`Webhook:` + strings.Replace(this.Webhook.String(), "AuthorizationWebhook", "AuthorizationWebhook", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthorizationWebhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthorizationWebhook{`,
+		`KubeconfigSecretName:` + fmt.Sprintf("%v", this.KubeconfigSecretName) + `,`,
+		`CacheAuthorizedTTL:` + strings.Replace(fmt.Sprintf("%v", this.CacheAuthorizedTTL), "Duration", "v11.Duration", 1) + `,`,
+		`CacheUnauthorizedTTL:` + strings.Replace(fmt.Sprintf("%v", this.CacheUnauthorizedTTL), "Duration", "v11.Duration", 1) + `,`,
+		`Version:` + valueToStringGenerated(this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthorizerKubeconfigReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthorizerKubeconfigReference{`,
+		`AuthorizerName:` + fmt.Sprintf("%v", this.AuthorizerName) + `,`,
+		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AvailabilityZone) String() string {
 	if this == nil {
 		return "nil"
@@ -19804,6 +22021,17 @@ func (this *CloudProfileList) String() string {
 	}, "")
 	return s
 }
+func (this *CloudProfileReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloudProfileReference{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CloudProfileSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -20105,6 +22333,28 @@ func (this *CoreDNSRewriting) String() string {
 	}, "")
 	return s
 }
+func (this *CredentialExpiration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CredentialExpiration{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ExpirationTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpirationTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CredentialsRotationPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CredentialsRotationPolicy{`,
+		`Interval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DNS) String() string {
 	if this == nil {
 		return "nil"
@@ -20189,6 +22439,18 @@ func (this *EncryptionConfig) String() string {
 	}
 	s := strings.Join([]string{`&EncryptionConfig{`,
 		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`Provider:` + strings.Replace(this.Provider.String(), "EncryptionProvider", "EncryptionProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionProvider{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20316,11 +22578,22 @@ func (this *GardenerResourceData) String() string {
 		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
 	}
 	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
 	s := strings.Join([]string{`&GardenerResourceData{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Data:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Data), "RawExtension", "runtime.RawExtension", 1), `&`, ``, 1) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20484,6 +22757,9 @@ func (this *KubeAPIServerConfig) String() string {
 		`DefaultNotReadyTolerationSeconds:` + valueToStringGenerated(this.DefaultNotReadyTolerationSeconds) + `,`,
 		`DefaultUnreachableTolerationSeconds:` + valueToStringGenerated(this.DefaultUnreachableTolerationSeconds) + `,`,
 		`EncryptionConfig:` + strings.Replace(this.EncryptionConfig.String(), "EncryptionConfig", "EncryptionConfig", 1) + `,`,
+		`StructuredAuthentication:` + strings.Replace(this.StructuredAuthentication.String(), "StructuredAuthentication", "StructuredAuthentication", 1) + `,`,
+		`Authorization:` + strings.Replace(this.Authorization.String(), "Authorization", "Authorization", 1) + `,`,
+		`StructuredAuthorization:` + strings.Replace(this.StructuredAuthorization.String(), "StructuredAuthorization", "StructuredAuthorization", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20815,10 +23091,24 @@ func (this *Maintenance) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAdditionalTimeRanges := "[]MaintenanceTimeRange{"
+	for _, f := range this.AdditionalTimeRanges {
+		repeatedStringForAdditionalTimeRanges += strings.Replace(strings.Replace(f.String(), "MaintenanceTimeRange", "MaintenanceTimeRange", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAdditionalTimeRanges += "}"
+	repeatedStringForBlackouts := "[]MaintenanceBlackout{"
+	for _, f := range this.Blackouts {
+		repeatedStringForBlackouts += strings.Replace(strings.Replace(f.String(), "MaintenanceBlackout", "MaintenanceBlackout", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBlackouts += "}"
 	s := strings.Join([]string{`&Maintenance{`,
 		`AutoUpdate:` + strings.Replace(this.AutoUpdate.String(), "MaintenanceAutoUpdate", "MaintenanceAutoUpdate", 1) + `,`,
 		`TimeWindow:` + strings.Replace(this.TimeWindow.String(), "MaintenanceTimeWindow", "MaintenanceTimeWindow", 1) + `,`,
 		`ConfineSpecUpdateRollout:` + valueToStringGenerated(this.ConfineSpecUpdateRollout) + `,`,
+		`CredentialsRotation:` + strings.Replace(this.CredentialsRotation.String(), "MaintenanceCredentialsRotation", "MaintenanceCredentialsRotation", 1) + `,`,
+		`TimeWindowWeekdays:` + fmt.Sprintf("%v", this.TimeWindowWeekdays) + `,`,
+		`AdditionalTimeRanges:` + repeatedStringForAdditionalTimeRanges + `,`,
+		`Blackouts:` + repeatedStringForBlackouts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20834,6 +23124,44 @@ func (this *MaintenanceAutoUpdate) String() string {
 	}, "")
 	return s
 }
+func (this *MaintenanceBlackout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceBlackout{`,
+		`Begin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Begin), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + valueToStringGenerated(this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceCredentialsRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceCredentialsRotation{`,
+		`CertificateAuthorities:` + strings.Replace(this.CertificateAuthorities.String(), "CredentialsRotationPolicy", "CredentialsRotationPolicy", 1) + `,`,
+		`ServiceAccountKey:` + strings.Replace(this.ServiceAccountKey.String(), "CredentialsRotationPolicy", "CredentialsRotationPolicy", 1) + `,`,
+		`ETCDEncryptionKey:` + strings.Replace(this.ETCDEncryptionKey.String(), "CredentialsRotationPolicy", "CredentialsRotationPolicy", 1) + `,`,
+		`SSHKeypair:` + strings.Replace(this.SSHKeypair.String(), "CredentialsRotationPolicy", "CredentialsRotationPolicy", 1) + `,`,
+		`Observability:` + strings.Replace(this.Observability.String(), "CredentialsRotationPolicy", "CredentialsRotationPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceTimeRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceTimeRange{`,
+		`Begin:` + fmt.Sprintf("%v", this.Begin) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`Weekdays:` + fmt.Sprintf("%v", this.Weekdays) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MaintenanceTimeWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -20876,6 +23204,75 @@ func (this *NamedResourceReference) String() string {
 	}, "")
 	return s
 }
+func (this *NamespacedCloudProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespacedCloudProfile{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NamespacedCloudProfileSpec", "NamespacedCloudProfileSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NamespacedCloudProfileStatus", "NamespacedCloudProfileStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespacedCloudProfileList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]NamespacedCloudProfile{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NamespacedCloudProfile", "NamespacedCloudProfile", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NamespacedCloudProfileList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespacedCloudProfileSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMachineImages := "[]MachineImage{"
+	for _, f := range this.MachineImages {
+		repeatedStringForMachineImages += strings.Replace(strings.Replace(f.String(), "MachineImage", "MachineImage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMachineImages += "}"
+	repeatedStringForMachineTypes := "[]MachineType{"
+	for _, f := range this.MachineTypes {
+		repeatedStringForMachineTypes += strings.Replace(strings.Replace(f.String(), "MachineType", "MachineType", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMachineTypes += "}"
+	repeatedStringForVolumeTypes := "[]VolumeType{"
+	for _, f := range this.VolumeTypes {
+		repeatedStringForVolumeTypes += strings.Replace(strings.Replace(f.String(), "VolumeType", "VolumeType", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVolumeTypes += "}"
+	s := strings.Join([]string{`&NamespacedCloudProfileSpec{`,
+		`Kubernetes:` + strings.Replace(this.Kubernetes.String(), "KubernetesSettings", "KubernetesSettings", 1) + `,`,
+		`MachineImages:` + repeatedStringForMachineImages + `,`,
+		`MachineTypes:` + repeatedStringForMachineTypes + `,`,
+		`VolumeTypes:` + repeatedStringForVolumeTypes + `,`,
+		`Parent:` + strings.Replace(strings.Replace(this.Parent.String(), "CloudProfileReference", "CloudProfileReference", 1), `&`, ``, 1) + `,`,
+		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespacedCloudProfileStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespacedCloudProfileStatus{`,
+		`CloudProfileSpec:` + strings.Replace(strings.Replace(this.CloudProfileSpec.String(), "CloudProfileSpec", "CloudProfileSpec", 1), `&`, ``, 1) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Networking) String() string {
 	if this == nil {
 		return "nil"
@@ -20887,6 +23284,9 @@ func (this *Networking) String() string {
 		`Nodes:` + valueToStringGenerated(this.Nodes) + `,`,
 		`Services:` + valueToStringGenerated(this.Services) + `,`,
 		`IPFamilies:` + fmt.Sprintf("%v", this.IPFamilies) + `,`,
+		`SecondaryPods:` + valueToStringGenerated(this.SecondaryPods) + `,`,
+		`SecondaryNodes:` + valueToStringGenerated(this.SecondaryNodes) + `,`,
+		`SecondaryServices:` + valueToStringGenerated(this.SecondaryServices) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21110,6 +23510,18 @@ func (this *Quota) String() string {
 	s := strings.Join([]string{`&Quota{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaConsumer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaConsumer{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21144,10 +23556,65 @@ func (this *QuotaSpec) String() string {
 		mapStringForMetrics += fmt.Sprintf("%v: %v,", k, this.Metrics[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForMetrics += "}"
+	keysForSoftMetrics := make([]string, 0, len(this.SoftMetrics))
+	for k := range this.SoftMetrics {
+		keysForSoftMetrics = append(keysForSoftMetrics, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSoftMetrics)
+	mapStringForSoftMetrics := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForSoftMetrics {
+		mapStringForSoftMetrics += fmt.Sprintf("%v: %v,", k, this.SoftMetrics[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForSoftMetrics += "}"
 	s := strings.Join([]string{`&QuotaSpec{`,
 		`ClusterLifetimeDays:` + valueToStringGenerated(this.ClusterLifetimeDays) + `,`,
 		`Metrics:` + mapStringForMetrics + `,`,
 		`Scope:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Scope), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`SoftMetrics:` + mapStringForSoftMetrics + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUsage := "[]QuotaUsage{"
+	for _, f := range this.Usage {
+		repeatedStringForUsage += strings.Replace(strings.Replace(f.String(), "QuotaUsage", "QuotaUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForUsage += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1) + `,`,
+		`Usage:` + repeatedStringForUsage + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConsumers := "[]QuotaConsumer{"
+	for _, f := range this.Consumers {
+		repeatedStringForConsumers += strings.Replace(strings.Replace(f.String(), "QuotaConsumer", "QuotaConsumer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConsumers += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaUsage{`,
+		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`Consumers:` + repeatedStringForConsumers + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21703,8 +24170,14 @@ func (this *ShootCredentials) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForExpirations := "[]CredentialExpiration{"
+	for _, f := range this.Expirations {
+		repeatedStringForExpirations += strings.Replace(strings.Replace(f.String(), "CredentialExpiration", "CredentialExpiration", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForExpirations += "}"
 	s := strings.Join([]string{`&ShootCredentials{`,
 		`Rotation:` + strings.Replace(this.Rotation.String(), "ShootCredentialsRotation", "ShootCredentialsRotation", 1) + `,`,
+		`Expirations:` + repeatedStringForExpirations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21826,6 +24299,7 @@ func (this *ShootSpec) String() string {
 		`SystemComponents:` + strings.Replace(this.SystemComponents.String(), "SystemComponents", "SystemComponents", 1) + `,`,
 		`ControlPlane:` + strings.Replace(this.ControlPlane.String(), "ControlPlane", "ControlPlane", 1) + `,`,
 		`SchedulerName:` + valueToStringGenerated(this.SchedulerName) + `,`,
+		`CloudProfile:` + strings.Replace(this.CloudProfile.String(), "CloudProfileReference", "CloudProfileReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21927,6 +24401,7 @@ func (this *ShootStatus) String() string {
 		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v11.Time", 1) + `,`,
 		`LastMaintenance:` + strings.Replace(this.LastMaintenance.String(), "LastMaintenance", "LastMaintenance", 1) + `,`,
 		`EncryptedResources:` + fmt.Sprintf("%v", this.EncryptedResources) + `,`,
+		`EncryptionProvider:` + strings.Replace(this.EncryptionProvider.String(), "EncryptionProvider", "EncryptionProvider", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21942,6 +24417,32 @@ func (this *ShootTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *StructuredAuthentication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StructuredAuthentication{`,
+		`ConfigMapName:` + fmt.Sprintf("%v", this.ConfigMapName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StructuredAuthorization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKubeconfigs := "[]AuthorizerKubeconfigReference{"
+	for _, f := range this.Kubeconfigs {
+		repeatedStringForKubeconfigs += strings.Replace(strings.Replace(f.String(), "AuthorizerKubeconfigReference", "AuthorizerKubeconfigReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForKubeconfigs += "}"
+	s := strings.Join([]string{`&StructuredAuthorization{`,
+		`ConfigMapName:` + fmt.Sprintf("%v", this.ConfigMapName) + `,`,
+		`Kubeconfigs:` + repeatedStringForKubeconfigs + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SystemComponents) String() string {
 	if this == nil {
 		return "nil"
@@ -22929,7 +25430,7 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
+func (m *Authorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22952,17 +25453,17 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22972,27 +25473,81 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Webhook == nil {
+				m.Webhook = &AuthorizationWebhook{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeconfigSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23020,93 +25575,11 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
+			m.KubeconfigSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BackupBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheAuthorizedTTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23133,13 +25606,16 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CacheAuthorizedTTL == nil {
+				m.CacheAuthorizedTTL = &v11.Duration{}
+			}
+			if err := m.CacheAuthorizedTTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheUnauthorizedTTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23166,15 +25642,18 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CacheUnauthorizedTTL == nil {
+				m.CacheUnauthorizedTTL = &v11.Duration{}
+			}
+			if err := m.CacheUnauthorizedTTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23184,24 +25663,24 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23224,7 +25703,7 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
+func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23247,17 +25726,17 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizerKubeconfigReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizerKubeconfigReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23267,30 +25746,29 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthorizerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23300,25 +25778,23 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupBucket{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23341,7 +25817,7 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
+func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23364,15 +25840,15 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23400,11 +25876,11 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23432,7 +25908,39 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23455,7 +25963,7 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
+func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23478,15 +25986,15 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23513,13 +26021,13 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23546,16 +26054,13 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23582,43 +26087,10 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23640,7 +26112,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23663,15 +26135,15 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23698,16 +26170,432 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderStatus == nil {
-				m.ProviderStatus = &runtime.RawExtension{}
-			}
-			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, BackupBucket{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderStatus == nil {
+				m.ProviderStatus = &runtime.RawExtension{}
+			}
+			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25019,6 +27907,120 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28308,7 +31310,7 @@ func (m *CoreDNSRewriting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNS) Unmarshal(dAtA []byte) error {
+func (m *CredentialExpiration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28331,15 +31333,15 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNS: wiretype end group for non-group")
+			return fmt.Errorf("proto: CredentialExpiration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CredentialExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28367,12 +31369,43 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Domain = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = CredentialType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28399,8 +31432,7 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, DNSProvider{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28425,7 +31457,7 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
+func (m *CredentialsRotationPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28448,15 +31480,98 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
+			return fmt.Errorf("proto: CredentialsRotationPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CredentialsRotationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28484,13 +31599,14 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Domain = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28500,23 +31616,139 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			m.Providers = append(m.Providers, DNSProvider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29285,6 +32517,42 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Provider == nil {
+				m.Provider = &EncryptionProvider{}
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29306,7 +32574,7 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
+func (m *EncryptionProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29329,15 +32597,15 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptionProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptionProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29365,11 +32633,11 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29396,46 +32664,13 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = &v11.Time{}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
 			}
-			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := VersionClassification(dAtA[iNdEx:postIndex])
-			m.Classification = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29457,7 +32692,7 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClass) Unmarshal(dAtA []byte) error {
+func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29480,17 +32715,17 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29500,30 +32735,29 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29533,29 +32767,33 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Handler = string(dAtA[iNdEx:postIndex])
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
+			}
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29565,27 +32803,24 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scheduling == nil {
-				m.Scheduling = &ExposureClassScheduling{}
-			}
-			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := VersionClassification(dAtA[iNdEx:postIndex])
+			m.Classification = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29608,7 +32843,158 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
+func (m *ExposureClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scheduling == nil {
+				m.Scheduling = &ExposureClassScheduling{}
+			}
+			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30683,6 +34069,133 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32580,6 +36093,114 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredAuthentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredAuthentication == nil {
+				m.StructuredAuthentication = &StructuredAuthentication{}
+			}
+			if err := m.StructuredAuthentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &Authorization{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredAuthorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredAuthorization == nil {
+				m.StructuredAuthorization = &StructuredAuthorization{}
+			}
+			if err := m.StructuredAuthorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37317,61 +40938,11 @@ func (m *Maintenance) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.ConfineSpecUpdateRollout = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceAutoUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceAutoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRotation", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37381,17 +40952,33 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KubernetesVersion = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialsRotation == nil {
+				m.CredentialsRotation = &MaintenanceCredentialsRotation{}
+			}
+			if err := m.CredentialsRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindowWeekdays", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37401,68 +40988,29 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.MachineImageVersion = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TimeWindowWeekdays = append(m.TimeWindowWeekdays, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalTimeRanges", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37472,29 +41020,31 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Begin = string(dAtA[iNdEx:postIndex])
+			m.AdditionalTimeRanges = append(m.AdditionalTimeRanges, MaintenanceTimeRange{})
+			if err := m.AdditionalTimeRanges[len(m.AdditionalTimeRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blackouts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37504,23 +41054,25 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = string(dAtA[iNdEx:postIndex])
+			m.Blackouts = append(m.Blackouts, MaintenanceBlackout{})
+			if err := m.Blackouts[len(m.Blackouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37543,7 +41095,7 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37566,17 +41118,17 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceAutoUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceAutoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37586,25 +41138,33 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.KubernetesVersion = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersion", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			s := SwapBehavior(dAtA[iNdEx:postIndex])
-			m.SwapBehavior = &s
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.MachineImageVersion = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37626,7 +41186,7 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Monitoring) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceBlackout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37649,15 +41209,15 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceBlackout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceBlackout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37684,68 +41244,15 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Alerting == nil {
-				m.Alerting = &Alerting{}
-			}
-			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Begin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37755,29 +41262,30 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37787,24 +41295,24 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37827,7 +41335,7 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Networking) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceCredentialsRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37850,17 +41358,17 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Networking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceCredentialsRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Networking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceCredentialsRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateAuthorities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37870,28 +41378,31 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Type = &s
+			if m.CertificateAuthorities == nil {
+				m.CertificateAuthorities = &CredentialsRotationPolicy{}
+			}
+			if err := m.CertificateAuthorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37918,18 +41429,18 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			if m.ServiceAccountKey == nil {
+				m.ServiceAccountKey = &CredentialsRotationPolicy{}
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServiceAccountKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ETCDEncryptionKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37939,30 +41450,33 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Pods = &s
+			if m.ETCDEncryptionKey == nil {
+				m.ETCDEncryptionKey = &CredentialsRotationPolicy{}
+			}
+			if err := m.ETCDEncryptionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeypair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37972,30 +41486,33 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Nodes = &s
+			if m.SSHKeypair == nil {
+				m.SSHKeypair = &CredentialsRotationPolicy{}
+			}
+			if err := m.SSHKeypair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observability", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38005,56 +41522,27 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Services = &s
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Observability == nil {
+				m.Observability = &CredentialsRotationPolicy{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Observability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38077,7 +41565,7 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NginxIngress) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceTimeRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38100,17 +41588,17 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NginxIngress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceTimeRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NginxIngress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceTimeRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38120,28 +41608,1546 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerSourceRanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekdays", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weekdays = append(m.Weekdays, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := SwapBehavior(dAtA[iNdEx:postIndex])
+			m.SwapBehavior = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Monitoring) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Alerting == nil {
+				m.Alerting = &Alerting{}
+			}
+			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfileList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, NamespacedCloudProfile{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubernetes == nil {
+				m.Kubernetes = &KubernetesSettings{}
+			}
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineImages = append(m.MachineImages, MachineImage{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineTypes = append(m.MachineTypes, MachineType{})
+			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeTypes = append(m.VolumeTypes, VolumeType{})
+			if err := m.VolumeTypes[len(m.VolumeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfileStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfileStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfileStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudProfileSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloudProfileSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Networking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Networking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Networking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Type = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pods = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Nodes = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Services = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryPods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecondaryPods = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecondaryNodes = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryServices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecondaryServices = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NginxIngress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NginxIngress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NginxIngress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerSourceRanges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39293,7 +44299,273 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39316,15 +44588,15 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39351,15 +44623,15 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39369,30 +44641,29 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39402,24 +44673,23 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39442,7 +44712,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39465,15 +44735,15 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39500,13 +44770,49 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedBy == nil {
+				m.CreatedBy = &v13.Subject{}
+			}
+			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Description = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39533,64 +44839,49 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Owner == nil {
+				m.Owner = &v13.Subject{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectMember) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := string(dAtA[iNdEx:postIndex])
+			m.Purpose = &s
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39617,13 +44908,14 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, ProjectMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39651,13 +44943,14 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39667,23 +44960,27 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			if m.Tolerations == nil {
+				m.Tolerations = &ProjectTolerations{}
+			}
+			if err := m.Tolerations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39706,7 +45003,7 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39729,17 +45026,17 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var msglen int
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39749,31 +45046,14 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreatedBy == nil {
-				m.CreatedBy = &v13.Subject{}
-			}
-			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39801,12 +45081,11 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleSinceTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39833,18 +45112,18 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &v13.Subject{}
+			if m.StaleSinceTimestamp == nil {
+				m.StaleSinceTimestamp = &v11.Time{}
 			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAutoDeleteTimestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39854,28 +45133,31 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Purpose = &s
+			if m.StaleAutoDeleteTimestamp == nil {
+				m.StaleAutoDeleteTimestamp = &v11.Time{}
+			}
+			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39902,16 +45184,68 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, ProjectMember{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastActivityTimestamp == nil {
+				m.LastActivityTimestamp = &v11.Time{}
+			}
+			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTolerations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTolerations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39921,28 +45255,29 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.Defaults = append(m.Defaults, Toleration{})
+			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39969,10 +45304,8 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tolerations == nil {
-				m.Tolerations = &ProjectTolerations{}
-			}
-			if err := m.Tolerations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Whitelist = append(m.Whitelist, Toleration{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39997,7 +45330,7 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
+func (m *Provider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40020,17 +45353,17 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ObservedGeneration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40040,16 +45373,29 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlaneConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40059,27 +45405,31 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
+			if m.ControlPlaneConfig == nil {
+				m.ControlPlaneConfig = &runtime.RawExtension{}
+			}
+			if err := m.ControlPlaneConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleSinceTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfrastructureConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40106,16 +45456,16 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StaleSinceTimestamp == nil {
-				m.StaleSinceTimestamp = &v11.Time{}
+			if m.InfrastructureConfig == nil {
+				m.InfrastructureConfig = &runtime.RawExtension{}
 			}
-			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InfrastructureConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleAutoDeleteTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40142,16 +45492,14 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StaleAutoDeleteTimestamp == nil {
-				m.StaleAutoDeleteTimestamp = &v11.Time{}
-			}
-			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Workers = append(m.Workers, Worker{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersSettings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40178,10 +45526,10 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastActivityTimestamp == nil {
-				m.LastActivityTimestamp = &v11.Time{}
+			if m.WorkersSettings == nil {
+				m.WorkersSettings = &WorkersSettings{}
 			}
-			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkersSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40206,7 +45554,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
+func (m *Quota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40229,15 +45577,15 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectTolerations: wiretype end group for non-group")
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectTolerations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40264,14 +45612,13 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Defaults = append(m.Defaults, Toleration{})
-			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40298,8 +45645,40 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, Toleration{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40324,7 +45703,7 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
+func (m *QuotaConsumer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40347,15 +45726,15 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaConsumer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40383,13 +45762,13 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlaneConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40399,67 +45778,77 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ControlPlaneConfig == nil {
-				m.ControlPlaneConfig = &runtime.RawExtension{}
-			}
-			if err := m.ControlPlaneConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfrastructureConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InfrastructureConfig == nil {
-				m.InfrastructureConfig = &runtime.RawExtension{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.InfrastructureConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40486,14 +45875,13 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, Worker{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40520,10 +45908,8 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkersSettings == nil {
-				m.WorkersSettings = &WorkersSettings{}
-			}
-			if err := m.WorkersSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Quota{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40548,7 +45934,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Quota) Unmarshal(dAtA []byte) error {
+func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40571,15 +45957,197 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterLifetimeDays = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftMetrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40606,42 +46174,105 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.SoftMetrics == nil {
+				m.SoftMetrics = make(k8s_io_api_core_v1.ResourceList)
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SoftMetrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40664,7 +46295,7 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuotaList) Unmarshal(dAtA []byte) error {
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40687,15 +46318,34 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuotaList: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotaList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40722,13 +46372,16 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = &v11.Time{}
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40755,8 +46408,8 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Quota{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Usage = append(m.Usage, QuotaUsage{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40781,7 +46434,7 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
+func (m *QuotaUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40804,17 +46457,17 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40824,15 +46477,28 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterLifetimeDays = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40859,8 +46525,8 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
 			}
 			var mapkey k8s_io_api_core_v1.ResourceName
 			mapvalue := &resource.Quantity{}
@@ -40957,11 +46623,11 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40988,7 +46654,8 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Consumers = append(m.Consumers, QuotaConsumer{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46878,6 +52545,40 @@ func (m *ShootCredentials) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expirations = append(m.Expirations, CredentialExpiration{})
+			if err := m.Expirations[len(m.Expirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48467,18 +54168,54 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SystemComponents == nil {
-				m.SystemComponents = &SystemComponents{}
+			if m.SystemComponents == nil {
+				m.SystemComponents = &SystemComponents{}
+			}
+			if err := m.SystemComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ControlPlane == nil {
+				m.ControlPlane = &ControlPlane{}
 			}
-			if err := m.SystemComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48488,33 +54225,30 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ControlPlane == nil {
-				m.ControlPlane = &ControlPlane{}
-			}
-			if err := m.ControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SchedulerName = &s
 			iNdEx = postIndex
-		case 21:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudProfile", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48524,24 +54258,27 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SchedulerName = &s
+			if m.CloudProfile == nil {
+				m.CloudProfile = &CloudProfileReference{}
+			}
+			if err := m.CloudProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49564,6 +55301,42 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptionProvider == nil {
+				m.EncryptionProvider = &EncryptionProvider{}
+			}
+			if err := m.EncryptionProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49701,6 +55474,204 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StructuredAuthentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StructuredAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StructuredAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StructuredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubeconfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kubeconfigs = append(m.Kubeconfigs, AuthorizerKubeconfigReference{})
+			if err := m.Kubeconfigs[len(m.Kubeconfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
@@ -96,6 +97,17 @@ func HasOperationAnnotation(annotations map[string]string) bool {
 		annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationMigrate
 }
 
+// QuotaScope returns the scope of a quota scope reference.
+func QuotaScope(scopeRef corev1.ObjectReference) (string, error) {
+	if gvk := schema.FromAPIVersionAndKind(scopeRef.APIVersion, scopeRef.Kind); gvk.Group == gardencorev1beta1.GroupName && gvk.Kind == "Project" {
+		return "project", nil
+	}
+	if scopeRef.APIVersion == "v1" && scopeRef.Kind == "Secret" {
+		return "secret", nil
+	}
+	return "", fmt.Errorf("unknown quota scope")
+}
+
 // TaintsHave returns true if the given key is part of the taints list.
 func TaintsHave(taints []gardencorev1beta1.SeedTaint, key string) bool {
 	for _, taint := range taints {
@@ -1121,6 +1133,47 @@ func GetShootAuditPolicyConfigMapRef(apiServerConfig *gardencorev1beta1.KubeAPIS
 	return nil
 }
 
+// GetShootAuthenticationConfigurationConfigMapName returns the Shoot's ConfigMap name for the structured authentication
+// configuration.
+func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.StructuredAuthentication != nil {
+		return apiServerConfig.StructuredAuthentication.ConfigMapName
+	}
+	return ""
+}
+
+// GetShootAuthorizationWebhookKubeconfigSecretName returns the name of the Secret containing the kubeconfig for the
+// Shoot's authorization webhook.
+func GetShootAuthorizationWebhookKubeconfigSecretName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.Authorization != nil && apiServerConfig.Authorization.Webhook != nil {
+		return apiServerConfig.Authorization.Webhook.KubeconfigSecretName
+	}
+	return ""
+}
+
+// GetShootAuthorizationConfigurationConfigMapName returns the Shoot's ConfigMap name for the structured authorization
+// configuration.
+func GetShootAuthorizationConfigurationConfigMapName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.StructuredAuthorization != nil {
+		return apiServerConfig.StructuredAuthorization.ConfigMapName
+	}
+	return ""
+}
+
+// GetShootAuthorizationConfigurationKubeconfigSecretNames returns the names of the Secrets containing the kubeconfigs
+// for the webhook authorizers of the Shoot's structured authorization configuration.
+func GetShootAuthorizationConfigurationKubeconfigSecretNames(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) []string {
+	if apiServerConfig == nil || apiServerConfig.StructuredAuthorization == nil {
+		return nil
+	}
+
+	var names []string
+	for _, kubeconfig := range apiServerConfig.StructuredAuthorization.Kubeconfigs {
+		names = append(names, kubeconfig.SecretName)
+	}
+	return names
+}
+
 // AnonymousAuthenticationEnabled returns true if anonymous authentication is set explicitly to 'true' and false otherwise.
 func AnonymousAuthenticationEnabled(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig) bool {
 	if kubeAPIServerConfig == nil {
@@ -1467,6 +1520,26 @@ func IsMultiZonalShootControlPlane(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.HighAvailability != nil && shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type == gardencorev1beta1.FailureToleranceTypeZone
 }
 
+// SeedSupportsIPFamilies checks if a seed with the given IP families can host a shoot with the given IP families.
+// Dual-stack shoots can only be hosted by seeds which support both IP families. Seeds without IP families default to
+// IPv4.
+func SeedSupportsIPFamilies(seedIPFamilies, shootIPFamilies []gardencorev1beta1.IPFamily) bool {
+	if !gardencorev1beta1.IsDualStack(shootIPFamilies) {
+		return true
+	}
+
+	if len(seedIPFamilies) == 0 {
+		seedIPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}
+	}
+
+	for _, ipFamily := range shootIPFamilies {
+		if !slices.Contains(seedIPFamilies, ipFamily) {
+			return false
+		}
+	}
+	return true
+}
+
 // IsWorkerless checks if the shoot has zero workers.
 func IsWorkerless(shoot *gardencorev1beta1.Shoot) bool {
 	return len(shoot.Spec.Provider.Workers) == 0
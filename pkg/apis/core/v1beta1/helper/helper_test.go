@@ -244,6 +244,18 @@ var _ = Describe("Helper", func() {
 		Entry("worker found", []gardencorev1beta1.MachineType{{Name: "foo"}}, "foo", &gardencorev1beta1.MachineType{Name: "foo"}),
 	)
 
+	DescribeTable("#QuotaScope",
+		func(apiVersion, kind, expectedScope string, expectedErr gomegatypes.GomegaMatcher) {
+			scope, err := QuotaScope(corev1.ObjectReference{APIVersion: apiVersion, Kind: kind})
+			Expect(scope).To(Equal(expectedScope))
+			Expect(err).To(expectedErr)
+		},
+
+		Entry("project", "core.gardener.cloud/v1beta1", "Project", "project", BeNil()),
+		Entry("secret", "v1", "Secret", "secret", BeNil()),
+		Entry("unknown", "v2", "Foo", "", HaveOccurred()),
+	)
+
 	DescribeTable("#TaintsHave",
 		func(taints []gardencorev1beta1.SeedTaint, key string, expectation bool) {
 			Expect(TaintsHave(taints, key)).To(Equal(expectation))
@@ -2554,6 +2566,76 @@ var _ = Describe("Helper", func() {
 		}, &corev1.ObjectReference{Name: "foo"})
 	})
 
+	Describe("GetShootAuthenticationConfigurationConfigMapName", func() {
+		test := func(description string, config *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthenticationConfigurationConfigMapName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("StructuredAuthentication = nil", &gardencorev1beta1.KubeAPIServerConfig{}, "")
+		test("ConfigMapName set", &gardencorev1beta1.KubeAPIServerConfig{
+			StructuredAuthentication: &gardencorev1beta1.StructuredAuthentication{
+				ConfigMapName: "foo",
+			},
+		}, "foo")
+	})
+
+	Describe("GetShootAuthorizationWebhookKubeconfigSecretName", func() {
+		test := func(description string, config *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthorizationWebhookKubeconfigSecretName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("Authorization = nil", &gardencorev1beta1.KubeAPIServerConfig{}, "")
+		test("Webhook = nil", &gardencorev1beta1.KubeAPIServerConfig{Authorization: &gardencorev1beta1.Authorization{}}, "")
+		test("KubeconfigSecretName set", &gardencorev1beta1.KubeAPIServerConfig{
+			Authorization: &gardencorev1beta1.Authorization{
+				Webhook: &gardencorev1beta1.AuthorizationWebhook{
+					KubeconfigSecretName: "foo",
+				},
+			},
+		}, "foo")
+	})
+
+	Describe("GetShootAuthorizationConfigurationConfigMapName", func() {
+		test := func(description string, config *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthorizationConfigurationConfigMapName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("StructuredAuthorization = nil", &gardencorev1beta1.KubeAPIServerConfig{}, "")
+		test("ConfigMapName set", &gardencorev1beta1.KubeAPIServerConfig{
+			StructuredAuthorization: &gardencorev1beta1.StructuredAuthorization{
+				ConfigMapName: "foo",
+			},
+		}, "foo")
+	})
+
+	Describe("GetShootAuthorizationConfigurationKubeconfigSecretNames", func() {
+		test := func(description string, config *gardencorev1beta1.KubeAPIServerConfig, expectedNames []string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuthorizationConfigurationKubeconfigSecretNames(config)).To(Equal(expectedNames))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, nil)
+		test("StructuredAuthorization = nil", &gardencorev1beta1.KubeAPIServerConfig{}, nil)
+		test("Kubeconfigs set", &gardencorev1beta1.KubeAPIServerConfig{
+			StructuredAuthorization: &gardencorev1beta1.StructuredAuthorization{
+				Kubeconfigs: []gardencorev1beta1.AuthorizerKubeconfigReference{
+					{AuthorizerName: "foo", SecretName: "foo-kubeconfig"},
+					{AuthorizerName: "bar", SecretName: "bar-kubeconfig"},
+				},
+			},
+		}, []string{"foo-kubeconfig", "bar-kubeconfig"})
+	})
+
 	Describe("#CalculateSeedUsage", func() {
 		type shootCase struct {
 			specSeedName, statusSeedName string
@@ -3069,6 +3151,18 @@ var _ = Describe("Helper", func() {
 		})
 	})
 
+	DescribeTable("#SeedSupportsIPFamilies",
+		func(seedIPFamilies, shootIPFamilies []gardencorev1beta1.IPFamily, expected bool) {
+			Expect(SeedSupportsIPFamilies(seedIPFamilies, shootIPFamilies)).To(Equal(expected))
+		},
+
+		Entry("single-stack shoot on default seed", nil, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, true),
+		Entry("single-stack shoot on seed of another IP family", []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, true),
+		Entry("dual-stack shoot on default seed", nil, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}, false),
+		Entry("dual-stack shoot on single-stack seed", []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}, false),
+		Entry("dual-stack shoot on dual-stack seed", []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6, gardencorev1beta1.IPFamilyIPv4}, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}, true),
+	)
+
 	Describe("#IsWorkerless", func() {
 		var shoot *gardencorev1beta1.Shoot
 
@@ -57,7 +57,8 @@ type BackupBucketSpec struct {
 	// SecretRef is a reference to a secret that contains the credentials to access object store.
 	SecretRef corev1.SecretReference `json:"secretRef" protobuf:"bytes,3,opt,name=secretRef"`
 	// SeedName holds the name of the seed allocated to BackupBucket for running controller.
-	// This field is immutable.
+	// If it is not set, the gardener-scheduler assigns a seed.
+	// This field is immutable once set.
 	// +optional
 	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,4,opt,name=seedName"`
 }
@@ -0,0 +1,104 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespacedCloudProfile represents certain properties about a provider environment which are only available in the
+// namespace of a project. It extends the CloudProfile it references as its parent.
+type NamespacedCloudProfile struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the provider environment properties.
+	Spec NamespacedCloudProfileSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the NamespacedCloudProfile.
+	// +optional
+	Status NamespacedCloudProfileStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespacedCloudProfileList is a collection of NamespacedCloudProfiles.
+type NamespacedCloudProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of NamespacedCloudProfiles.
+	Items []NamespacedCloudProfile `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// NamespacedCloudProfileSpec is the specification of a NamespacedCloudProfile.
+type NamespacedCloudProfileSpec struct {
+	// Kubernetes contains overrides of the expiration dates of Kubernetes versions of the parent CloudProfile.
+	// +optional
+	Kubernetes *KubernetesSettings `json:"kubernetes,omitempty" protobuf:"bytes,1,opt,name=kubernetes"`
+	// MachineImages contains additional machine images and versions as well as overrides of the expiration dates of
+	// machine image versions of the parent CloudProfile.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	MachineImages []MachineImage `json:"machineImages,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=machineImages"`
+	// MachineTypes contains additional machine types which are not part of the parent CloudProfile.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	MachineTypes []MachineType `json:"machineTypes,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=machineTypes"`
+	// VolumeTypes contains additional volume types which are not part of the parent CloudProfile.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	VolumeTypes []VolumeType `json:"volumeTypes,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,4,rep,name=volumeTypes"`
+	// Parent contains a reference to the CloudProfile which is extended by this NamespacedCloudProfile.
+	Parent CloudProfileReference `json:"parent" protobuf:"bytes,5,opt,name=parent"`
+	// ProviderConfig contains provider-specific configuration which is merged into the provider-specific configuration
+	// of the parent CloudProfile.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,6,opt,name=providerConfig"`
+}
+
+// NamespacedCloudProfileStatus holds the most recently observed status of the NamespacedCloudProfile.
+type NamespacedCloudProfileStatus struct {
+	// CloudProfileSpec is the effective specification of the NamespacedCloudProfile, i.e., the specification of the
+	// parent CloudProfile merged with the specification of the NamespacedCloudProfile.
+	// +optional
+	CloudProfileSpec CloudProfileSpec `json:"cloudProfileSpec,omitempty" protobuf:"bytes,1,opt,name=cloudProfileSpec"`
+	// ObservedGeneration is the most recent generation observed for this NamespacedCloudProfile.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+}
+
+// CloudProfileReference holds the information about a CloudProfile or a NamespacedCloudProfile.
+type CloudProfileReference struct {
+	// Kind contains a CloudProfile kind, i.e., either `CloudProfile` or `NamespacedCloudProfile`.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name contains the name of the referenced CloudProfile or NamespacedCloudProfile.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+}
+
+const (
+	// CloudProfileReferenceKindCloudProfile is the kind of a CloudProfile.
+	CloudProfileReferenceKindCloudProfile = "CloudProfile"
+	// CloudProfileReferenceKindNamespacedCloudProfile is the kind of a NamespacedCloudProfile.
+	CloudProfileReferenceKindNamespacedCloudProfile = "NamespacedCloudProfile"
+)
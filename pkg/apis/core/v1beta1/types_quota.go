@@ -31,6 +31,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the current usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -52,6 +55,45 @@ type QuotaSpec struct {
 	ClusterLifetimeDays *int32 `json:"clusterLifetimeDays,omitempty" protobuf:"varint,1,opt,name=clusterLifetimeDays"`
 	// Metrics is a list of resources which will be put under constraints.
 	Metrics corev1.ResourceList `json:"metrics" protobuf:"bytes,2,rep,name=metrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// SoftMetrics is a list of resources with soft limits. Exceeding a soft limit does not deny the request but results
+	// in a warning and an event for the project.
+	// +optional
+	SoftMetrics corev1.ResourceList `json:"softMetrics,omitempty" protobuf:"bytes,4,rep,name=softMetrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 	// Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"`
 }
+
+// QuotaStatus is the status of a Quota.
+type QuotaStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Quota.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+	// LastUpdateTime is the last time the usage of the Quota was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+	// Usage is the current usage of the Quota. Quotas with 'secret' scope have exactly one entry for all consumers.
+	// The limits of quotas with 'project' scope apply per project, hence, there is one entry per project namespace.
+	// +optional
+	Usage []QuotaUsage `json:"usage,omitempty" protobuf:"bytes,3,rep,name=usage"`
+}
+
+// QuotaUsage is the usage of a Quota by the shoots of a project or of all projects.
+type QuotaUsage struct {
+	// Namespace is the project namespace the usage belongs to. It is only set for quotas with 'project' scope.
+	// +optional
+	Namespace *string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Used is the amount of resources which is allocated by the consumers for each metric constrained by the Quota.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,2,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Consumers is the list of shoots which consume the Quota.
+	// +optional
+	Consumers []QuotaConsumer `json:"consumers,omitempty" protobuf:"bytes,3,rep,name=consumers"`
+}
+
+// QuotaConsumer is a shoot which consumes a Quota.
+type QuotaConsumer struct {
+	// Namespace is the namespace of the shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the shoot.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+}
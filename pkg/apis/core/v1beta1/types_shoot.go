@@ -135,6 +135,10 @@ type ShootSpec struct {
 	// This field is immutable.
 	// +optional
 	SchedulerName *string `json:"schedulerName,omitempty" protobuf:"bytes,21,opt,name=schedulerName"`
+	// CloudProfile contains a reference to a CloudProfile or a NamespacedCloudProfile. If it refers to a
+	// NamespacedCloudProfile, its parent must be the CloudProfile referenced by CloudProfileName.
+	// +optional
+	CloudProfile *CloudProfileReference `json:"cloudProfile,omitempty" protobuf:"bytes,22,opt,name=cloudProfile"`
 }
 
 // GetProviderType gets the type of the provider.
@@ -208,6 +212,11 @@ type ShootStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty" protobuf:"bytes,18,rep,name=encryptedResources"`
+	// EncryptionProvider is the encryption provider which is currently used for the encryption of resources in etcd.
+	// It is only updated to the provider configured in the specification once all resources have been re-encrypted,
+	// see https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
+	// +optional
+	EncryptionProvider *EncryptionProvider `json:"encryptionProvider,omitempty" protobuf:"bytes,19,opt,name=encryptionProvider"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -228,8 +237,31 @@ type ShootCredentials struct {
 	// Rotation contains information about the credential rotations.
 	// +optional
 	Rotation *ShootCredentialsRotation `json:"rotation,omitempty" protobuf:"bytes,1,opt,name=rotation"`
+	// Expirations contains the expiration dates of the certificate authorities and certificates managed for the shoot.
+	// +optional
+	Expirations []CredentialExpiration `json:"expirations,omitempty" protobuf:"bytes,2,rep,name=expirations"`
+}
+
+// CredentialExpiration contains information about the expiration of a certificate authority or certificate.
+type CredentialExpiration struct {
+	// Name is the name of the credential.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the credential.
+	Type CredentialType `json:"type" protobuf:"bytes,2,opt,name=type,casttype=CredentialType"`
+	// ExpirationTime is the time when the credential expires.
+	ExpirationTime metav1.Time `json:"expirationTime" protobuf:"bytes,3,opt,name=expirationTime"`
 }
 
+// CredentialType is a type of credential.
+type CredentialType string
+
+const (
+	// CredentialTypeCertificateAuthority is a constant for the certificate authority credential type.
+	CredentialTypeCertificateAuthority CredentialType = "CertificateAuthority"
+	// CredentialTypeCertificate is a constant for the certificate credential type.
+	CredentialTypeCertificate CredentialType = "Certificate"
+)
+
 // ShootCredentialsRotation contains information about the rotation of credentials.
 type ShootCredentialsRotation struct {
 	// CertificateAuthorities contains information about the certificate authority credential rotation.
@@ -761,6 +793,17 @@ type KubeAPIServerConfig struct {
 	// EncryptionConfig contains customizable encryption configuration of the Kube API server.
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty" protobuf:"bytes,16,opt,name=encryptionConfig"`
+	// StructuredAuthentication contains configuration settings for structured authentication for the kube-apiserver.
+	// This field is only available for Kubernetes v1.29 or later and cannot be combined with `oidcConfig`.
+	// +optional
+	StructuredAuthentication *StructuredAuthentication `json:"structuredAuthentication,omitempty" protobuf:"bytes,17,opt,name=structuredAuthentication"`
+	// Authorization contains settings related to authorization for the kube-apiserver.
+	// +optional
+	Authorization *Authorization `json:"authorization,omitempty" protobuf:"bytes,18,opt,name=authorization"`
+	// StructuredAuthorization contains configuration settings for structured authorization for the kube-apiserver.
+	// This field is only available for Kubernetes v1.29 or later and cannot be combined with `authorization.webhook`.
+	// +optional
+	StructuredAuthorization *StructuredAuthorization `json:"structuredAuthorization,omitempty" protobuf:"bytes,19,opt,name=structuredAuthorization"`
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -794,8 +837,30 @@ type EncryptionConfig struct {
 	// Wildcards are not supported for now.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	Resources []string `json:"resources" protobuf:"bytes,1,rep,name=resources"`
+	// Provider contains information about the encryption provider which is used for the encryption of resources in etcd.
+	// If not set, a static AES-CBC key managed by Gardener is used.
+	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
+	// +optional
+	Provider *EncryptionProvider `json:"provider,omitempty" protobuf:"bytes,2,opt,name=provider"`
+}
+
+// EncryptionProvider contains information about the encryption provider of the API server.
+type EncryptionProvider struct {
+	// Type is the type of the encryption provider. The type `aescbc` denotes a static AES-CBC key managed by Gardener.
+	// Any other value denotes a KMS v2 plugin which is deployed as a sidecar of the kube-apiserver by the extension of
+	// the same type.
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// ProviderConfig is the configuration passed to the extension of the KMS v2 plugin.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,2,opt,name=providerConfig"`
 }
 
+const (
+	// EncryptionProviderTypeAESCBC is the type of the encryption provider which uses a static AES-CBC key managed by
+	// Gardener.
+	EncryptionProviderTypeAESCBC = "aescbc"
+)
+
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
 type ServiceAccountConfig struct {
 	// Issuer is the identifier of the service account token issuer. The issuer will assert this
@@ -841,6 +906,61 @@ type AuditPolicy struct {
 	ConfigMapRef *corev1.ObjectReference `json:"configMapRef,omitempty" protobuf:"bytes,1,opt,name=configMapRef"`
 }
 
+// StructuredAuthentication contains authentication config for kube-apiserver.
+type StructuredAuthentication struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthenticationConfiguration
+	// for the kube-apiserver.
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,name=configMapName"`
+}
+
+// StructuredAuthorization contains authorization config for kube-apiserver.
+type StructuredAuthorization struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthorizationConfiguration
+	// for the kube-apiserver. It must only contain authorizers of type `Webhook`, the Node and RBAC authorizers are
+	// always prepended by Gardener.
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,name=configMapName"`
+	// Kubeconfigs is a list of references for kubeconfigs for the authorization webhooks. Each webhook authorizer in
+	// the AuthorizationConfiguration must be referenced here.
+	// +listType=map
+	// +listMapKey=authorizerName
+	// +optional
+	Kubeconfigs []AuthorizerKubeconfigReference `json:"kubeconfigs,omitempty" protobuf:"bytes,2,rep,name=kubeconfigs"`
+}
+
+// AuthorizerKubeconfigReference is a reference for a kubeconfig for an authorization webhook.
+type AuthorizerKubeconfigReference struct {
+	// AuthorizerName is the name of a webhook authorizer in the AuthorizationConfiguration.
+	AuthorizerName string `json:"authorizerName" protobuf:"bytes,1,name=authorizerName"`
+	// SecretName is the name of a secret in the project namespace which contains the kubeconfig for the authorization
+	// webhook under the data key `kubeconfig`.
+	SecretName string `json:"secretName" protobuf:"bytes,2,name=secretName"`
+}
+
+// Authorization contains settings related to authorization for the kube-apiserver.
+type Authorization struct {
+	// Webhook contains settings related to an authorization webhook. It is consulted after the Node and RBAC
+	// authorizers, i.e., only for requests which were not already allowed by them.
+	// +optional
+	Webhook *AuthorizationWebhook `json:"webhook,omitempty" protobuf:"bytes,1,opt,name=webhook"`
+}
+
+// AuthorizationWebhook contains settings related to an authorization webhook.
+type AuthorizationWebhook struct {
+	// KubeconfigSecretName is the name of a secret in the project namespace which contains the kubeconfig for the
+	// authorization webhook under the data key `kubeconfig`.
+	KubeconfigSecretName string `json:"kubeconfigSecretName" protobuf:"bytes,1,opt,name=kubeconfigSecretName"`
+	// CacheAuthorizedTTL is the duration to cache 'authorized' responses from the webhook authorizer.
+	// +optional
+	CacheAuthorizedTTL *metav1.Duration `json:"cacheAuthorizedTTL,omitempty" protobuf:"bytes,2,opt,name=cacheAuthorizedTTL"`
+	// CacheUnauthorizedTTL is the duration to cache 'unauthorized' responses from the webhook authorizer.
+	// +optional
+	CacheUnauthorizedTTL *metav1.Duration `json:"cacheUnauthorizedTTL,omitempty" protobuf:"bytes,3,opt,name=cacheUnauthorizedTTL"`
+	// Version is the API version of the authorization.k8s.io SubjectAccessReview to send to and expect from the
+	// webhook. Possible values are `v1beta1` and `v1`.
+	// +optional
+	Version *string `json:"version,omitempty" protobuf:"bytes,4,opt,name=version"`
+}
+
 // OIDCConfig contains configuration settings for the OIDC provider.
 // Note: Descriptions were taken from the Kubernetes documentation.
 type OIDCConfig struct {
@@ -1277,11 +1397,25 @@ type Networking struct {
 	// Services is the CIDR of the service network. This field is immutable.
 	// +optional
 	Services *string `json:"services,omitempty" protobuf:"bytes,5,opt,name=services"`
-	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
+	// IPFamilies specifies the IP protocol versions to use for shoot networking. The first IP family is the primary
+	// one, the networks configured in the fields above belong to it. This field is immutable, however, a second IP
+	// family can be added to single-stack shoots.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md.
 	// Defaults to ["IPv4"].
 	// +optional
 	IPFamilies []IPFamily `json:"ipFamilies,omitempty" protobuf:"bytes,6,rep,name=ipFamilies,casttype=IPFamily"`
+	// SecondaryPods is the CIDR of the pod network of the secondary IP family in case of dual-stack networking.
+	// This field is immutable once it is set.
+	// +optional
+	SecondaryPods *string `json:"secondaryPods,omitempty" protobuf:"bytes,7,opt,name=secondaryPods"`
+	// SecondaryNodes is the CIDR of the node network of the secondary IP family in case of dual-stack networking.
+	// This field is immutable once it is set.
+	// +optional
+	SecondaryNodes *string `json:"secondaryNodes,omitempty" protobuf:"bytes,8,opt,name=secondaryNodes"`
+	// SecondaryServices is the CIDR of the service network of the secondary IP family in case of dual-stack networking.
+	// This field is immutable once it is set.
+	// +optional
+	SecondaryServices *string `json:"secondaryServices,omitempty" protobuf:"bytes,9,opt,name=secondaryServices"`
 }
 
 const (
@@ -1296,6 +1430,8 @@ const (
 	MaintenanceTimeWindowDurationMinimum = 30 * time.Minute
 	// MaintenanceTimeWindowDurationMaximum is the maximum duration for a maintenance time window.
 	MaintenanceTimeWindowDurationMaximum = 6 * time.Hour
+	// CredentialsRotationIntervalMinimum is the minimum interval of a credentials rotation policy.
+	CredentialsRotationIntervalMinimum = 24 * time.Hour
 )
 
 // Maintenance contains information about the time window for maintenance operations and which
@@ -1312,6 +1448,50 @@ type Maintenance struct {
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	// +optional
 	ConfineSpecUpdateRollout *bool `json:"confineSpecUpdateRollout,omitempty" protobuf:"varint,3,opt,name=confineSpecUpdateRollout"`
+	// CredentialsRotation contains policies for automatically rotating the shoot credentials during the maintenance
+	// time window.
+	// +optional
+	CredentialsRotation *MaintenanceCredentialsRotation `json:"credentialsRotation,omitempty" protobuf:"bytes,4,opt,name=credentialsRotation"`
+	// TimeWindowWeekdays restricts the time window to the given days of the week (Mon, Tue, Wed, Thu, Fri, Sat, Sun). The
+	// day is determined by the beginning of the time window in its time zone. If not present, the time window applies to
+	// every day.
+	// +optional
+	TimeWindowWeekdays []string `json:"timeWindowWeekdays,omitempty" protobuf:"bytes,5,rep,name=timeWindowWeekdays"`
+	// AdditionalTimeRanges are further time ranges in which maintenance operations may be performed.
+	// +optional
+	AdditionalTimeRanges []MaintenanceTimeRange `json:"additionalTimeRanges,omitempty" protobuf:"bytes,6,rep,name=additionalTimeRanges"`
+	// Blackouts are periods in which no maintenance operations are performed, even if they overlap with the time window
+	// or one of the additional time ranges (e.g., an end-of-quarter freeze).
+	// +optional
+	Blackouts []MaintenanceBlackout `json:"blackouts,omitempty" protobuf:"bytes,7,rep,name=blackouts"`
+}
+
+// MaintenanceCredentialsRotation contains policies for automatically rotating the shoot credentials during the
+// maintenance time window.
+type MaintenanceCredentialsRotation struct {
+	// CertificateAuthorities is the rotation policy for the certificate authorities.
+	// +optional
+	CertificateAuthorities *CredentialsRotationPolicy `json:"certificateAuthorities,omitempty" protobuf:"bytes,1,opt,name=certificateAuthorities"`
+	// ServiceAccountKey is the rotation policy for the service account signing key.
+	// +optional
+	ServiceAccountKey *CredentialsRotationPolicy `json:"serviceAccountKey,omitempty" protobuf:"bytes,2,opt,name=serviceAccountKey"`
+	// ETCDEncryptionKey is the rotation policy for the ETCD encryption key.
+	// +optional
+	ETCDEncryptionKey *CredentialsRotationPolicy `json:"etcdEncryptionKey,omitempty" protobuf:"bytes,3,opt,name=etcdEncryptionKey"`
+	// SSHKeypair is the rotation policy for the ssh-keypair.
+	// +optional
+	SSHKeypair *CredentialsRotationPolicy `json:"sshKeypair,omitempty" protobuf:"bytes,4,opt,name=sshKeypair"`
+	// Observability is the rotation policy for the observability credentials.
+	// +optional
+	Observability *CredentialsRotationPolicy `json:"observability,omitempty" protobuf:"bytes,5,opt,name=observability"`
+}
+
+// CredentialsRotationPolicy is a policy for automatically rotating credentials.
+type CredentialsRotationPolicy struct {
+	// Interval is the minimum duration between the initiation of two rotations. Once it has passed since the last
+	// initiation (or the creation of the shoot), the rotation is triggered in the next maintenance time window. For
+	// two-phase rotations, the completion is triggered in the subsequent maintenance time window.
+	Interval metav1.Duration `json:"interval" protobuf:"bytes,1,opt,name=interval"`
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1337,6 +1517,31 @@ type MaintenanceTimeWindow struct {
 	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
 }
 
+// MaintenanceTimeRange is a further time range in which maintenance operations may be performed.
+type MaintenanceTimeRange struct {
+	// Begin is the beginning of the time range in the format HHMMSS+ZONE, e.g. "220000+0100".
+	// +kubebuilder:validation:Pattern=`([0-1][0-9]|2[0-3])[0-5][0-9][0-5][0-9]\+[0-1][0-4]00`
+	Begin string `json:"begin" protobuf:"bytes,1,opt,name=begin"`
+	// End is the end of the time range in the format HHMMSS+ZONE, e.g. "220000+0100".
+	// +kubebuilder:validation:Pattern=`([0-1][0-9]|2[0-3])[0-5][0-9][0-5][0-9]\+[0-1][0-4]00`
+	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Weekdays restricts the time range to the given days of the week (Mon, Tue, Wed, Thu, Fri, Sat, Sun). The day is
+	// determined by the beginning of the time range in its time zone. If not present, the time range applies to every day.
+	// +optional
+	Weekdays []string `json:"weekdays,omitempty" protobuf:"bytes,3,rep,name=weekdays"`
+}
+
+// MaintenanceBlackout is a period in which no maintenance operations are performed.
+type MaintenanceBlackout struct {
+	// Begin is the beginning of the blackout period.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,1,opt,name=begin"`
+	// End is the end of the blackout period.
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Reason is an optional description of why maintenance operations are not allowed during this period.
+	// +optional
+	Reason *string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+}
+
 // Monitoring contains information about the monitoring configuration for the shoot.
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
@@ -72,6 +72,9 @@ type GardenerResourceData struct {
 	// Labels are labels of the object
 	// +optional
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,4,opt,name=labels"`
+	// Annotations are annotations of the object
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,5,opt,name=annotations"`
 }
 
 // ExtensionResourceState contains the kind of the extension custom resource and its last observed state in the Shoot's
@@ -122,6 +122,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Authorization)(nil), (*core.Authorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Authorization_To_core_Authorization(a.(*Authorization), b.(*core.Authorization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Authorization)(nil), (*Authorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Authorization_To_v1beta1_Authorization(a.(*core.Authorization), b.(*Authorization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizationWebhook)(nil), (*core.AuthorizationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuthorizationWebhook_To_core_AuthorizationWebhook(a.(*AuthorizationWebhook), b.(*core.AuthorizationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuthorizationWebhook)(nil), (*AuthorizationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(a.(*core.AuthorizationWebhook), b.(*AuthorizationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizerKubeconfigReference)(nil), (*core.AuthorizerKubeconfigReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(a.(*AuthorizerKubeconfigReference), b.(*core.AuthorizerKubeconfigReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuthorizerKubeconfigReference)(nil), (*AuthorizerKubeconfigReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigReference(a.(*core.AuthorizerKubeconfigReference), b.(*AuthorizerKubeconfigReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AvailabilityZone)(nil), (*core.AvailabilityZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(a.(*AvailabilityZone), b.(*core.AvailabilityZone), scope)
 	}); err != nil {
@@ -262,6 +292,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProfileReference)(nil), (*core.CloudProfileReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudProfileReference_To_core_CloudProfileReference(a.(*CloudProfileReference), b.(*core.CloudProfileReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CloudProfileReference)(nil), (*CloudProfileReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CloudProfileReference_To_v1beta1_CloudProfileReference(a.(*core.CloudProfileReference), b.(*CloudProfileReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileSpec)(nil), (*core.CloudProfileSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(a.(*CloudProfileSpec), b.(*core.CloudProfileSpec), scope)
 	}); err != nil {
@@ -462,6 +502,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CredentialExpiration)(nil), (*core.CredentialExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CredentialExpiration_To_core_CredentialExpiration(a.(*CredentialExpiration), b.(*core.CredentialExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CredentialExpiration)(nil), (*CredentialExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CredentialExpiration_To_v1beta1_CredentialExpiration(a.(*core.CredentialExpiration), b.(*CredentialExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CredentialsRotationPolicy)(nil), (*core.CredentialsRotationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CredentialsRotationPolicy_To_core_CredentialsRotationPolicy(a.(*CredentialsRotationPolicy), b.(*core.CredentialsRotationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CredentialsRotationPolicy)(nil), (*CredentialsRotationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CredentialsRotationPolicy_To_v1beta1_CredentialsRotationPolicy(a.(*core.CredentialsRotationPolicy), b.(*CredentialsRotationPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*core.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_core_DNS(a.(*DNS), b.(*core.DNS), scope)
 	}); err != nil {
@@ -532,6 +592,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionProvider)(nil), (*core.EncryptionProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EncryptionProvider_To_core_EncryptionProvider(a.(*EncryptionProvider), b.(*core.EncryptionProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EncryptionProvider)(nil), (*EncryptionProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EncryptionProvider_To_v1beta1_EncryptionProvider(a.(*core.EncryptionProvider), b.(*EncryptionProvider), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExpirableVersion)(nil), (*core.ExpirableVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(a.(*ExpirableVersion), b.(*core.ExpirableVersion), scope)
 	}); err != nil {
@@ -937,6 +1007,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceBlackout)(nil), (*core.MaintenanceBlackout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceBlackout_To_core_MaintenanceBlackout(a.(*MaintenanceBlackout), b.(*core.MaintenanceBlackout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceBlackout)(nil), (*MaintenanceBlackout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceBlackout_To_v1beta1_MaintenanceBlackout(a.(*core.MaintenanceBlackout), b.(*MaintenanceBlackout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceCredentialsRotation)(nil), (*core.MaintenanceCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceCredentialsRotation_To_core_MaintenanceCredentialsRotation(a.(*MaintenanceCredentialsRotation), b.(*core.MaintenanceCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceCredentialsRotation)(nil), (*MaintenanceCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceCredentialsRotation_To_v1beta1_MaintenanceCredentialsRotation(a.(*core.MaintenanceCredentialsRotation), b.(*MaintenanceCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeRange)(nil), (*core.MaintenanceTimeRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceTimeRange_To_core_MaintenanceTimeRange(a.(*MaintenanceTimeRange), b.(*core.MaintenanceTimeRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceTimeRange)(nil), (*MaintenanceTimeRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceTimeRange_To_v1beta1_MaintenanceTimeRange(a.(*core.MaintenanceTimeRange), b.(*MaintenanceTimeRange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -977,6 +1077,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespacedCloudProfile)(nil), (*core.NamespacedCloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespacedCloudProfile_To_core_NamespacedCloudProfile(a.(*NamespacedCloudProfile), b.(*core.NamespacedCloudProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespacedCloudProfile)(nil), (*NamespacedCloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespacedCloudProfile_To_v1beta1_NamespacedCloudProfile(a.(*core.NamespacedCloudProfile), b.(*NamespacedCloudProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespacedCloudProfileList)(nil), (*core.NamespacedCloudProfileList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespacedCloudProfileList_To_core_NamespacedCloudProfileList(a.(*NamespacedCloudProfileList), b.(*core.NamespacedCloudProfileList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespacedCloudProfileList)(nil), (*NamespacedCloudProfileList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespacedCloudProfileList_To_v1beta1_NamespacedCloudProfileList(a.(*core.NamespacedCloudProfileList), b.(*NamespacedCloudProfileList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespacedCloudProfileSpec)(nil), (*core.NamespacedCloudProfileSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespacedCloudProfileSpec_To_core_NamespacedCloudProfileSpec(a.(*NamespacedCloudProfileSpec), b.(*core.NamespacedCloudProfileSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespacedCloudProfileSpec)(nil), (*NamespacedCloudProfileSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespacedCloudProfileSpec_To_v1beta1_NamespacedCloudProfileSpec(a.(*core.NamespacedCloudProfileSpec), b.(*NamespacedCloudProfileSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespacedCloudProfileStatus)(nil), (*core.NamespacedCloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespacedCloudProfileStatus_To_core_NamespacedCloudProfileStatus(a.(*NamespacedCloudProfileStatus), b.(*core.NamespacedCloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespacedCloudProfileStatus)(nil), (*NamespacedCloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespacedCloudProfileStatus_To_v1beta1_NamespacedCloudProfileStatus(a.(*core.NamespacedCloudProfileStatus), b.(*NamespacedCloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Networking)(nil), (*core.Networking)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Networking_To_core_Networking(a.(*Networking), b.(*core.Networking), scope)
 	}); err != nil {
@@ -1097,6 +1237,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaConsumer)(nil), (*core.QuotaConsumer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaConsumer_To_core_QuotaConsumer(a.(*QuotaConsumer), b.(*core.QuotaConsumer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaConsumer)(nil), (*QuotaConsumer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaConsumer_To_v1beta1_QuotaConsumer(a.(*core.QuotaConsumer), b.(*QuotaConsumer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaList)(nil), (*core.QuotaList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaList_To_core_QuotaList(a.(*QuotaList), b.(*core.QuotaList), scope)
 	}); err != nil {
@@ -1117,6 +1267,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaUsage)(nil), (*core.QuotaUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaUsage_To_core_QuotaUsage(a.(*QuotaUsage), b.(*core.QuotaUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaUsage)(nil), (*QuotaUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaUsage_To_v1beta1_QuotaUsage(a.(*core.QuotaUsage), b.(*QuotaUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1607,6 +1777,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StructuredAuthentication)(nil), (*core.StructuredAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(a.(*StructuredAuthentication), b.(*core.StructuredAuthentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.StructuredAuthentication)(nil), (*StructuredAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_StructuredAuthentication_To_v1beta1_StructuredAuthentication(a.(*core.StructuredAuthentication), b.(*StructuredAuthentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StructuredAuthorization)(nil), (*core.StructuredAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StructuredAuthorization_To_core_StructuredAuthorization(a.(*StructuredAuthorization), b.(*core.StructuredAuthorization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.StructuredAuthorization)(nil), (*StructuredAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_StructuredAuthorization_To_v1beta1_StructuredAuthorization(a.(*core.StructuredAuthorization), b.(*StructuredAuthorization), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SystemComponents)(nil), (*core.SystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SystemComponents_To_core_SystemComponents(a.(*SystemComponents), b.(*core.SystemComponents), scope)
 	}); err != nil {
@@ -1907,6 +2097,74 @@ func Convert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *
 	return autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in, out, s)
 }
 
+func autoConvert_v1beta1_Authorization_To_core_Authorization(in *Authorization, out *core.Authorization, s conversion.Scope) error {
+	out.Webhook = (*core.AuthorizationWebhook)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1beta1_Authorization_To_core_Authorization is an autogenerated conversion function.
+func Convert_v1beta1_Authorization_To_core_Authorization(in *Authorization, out *core.Authorization, s conversion.Scope) error {
+	return autoConvert_v1beta1_Authorization_To_core_Authorization(in, out, s)
+}
+
+func autoConvert_core_Authorization_To_v1beta1_Authorization(in *core.Authorization, out *Authorization, s conversion.Scope) error {
+	out.Webhook = (*AuthorizationWebhook)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_core_Authorization_To_v1beta1_Authorization is an autogenerated conversion function.
+func Convert_core_Authorization_To_v1beta1_Authorization(in *core.Authorization, out *Authorization, s conversion.Scope) error {
+	return autoConvert_core_Authorization_To_v1beta1_Authorization(in, out, s)
+}
+
+func autoConvert_v1beta1_AuthorizationWebhook_To_core_AuthorizationWebhook(in *AuthorizationWebhook, out *core.AuthorizationWebhook, s conversion.Scope) error {
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.CacheAuthorizedTTL = (*metav1.Duration)(unsafe.Pointer(in.CacheAuthorizedTTL))
+	out.CacheUnauthorizedTTL = (*metav1.Duration)(unsafe.Pointer(in.CacheUnauthorizedTTL))
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	return nil
+}
+
+// Convert_v1beta1_AuthorizationWebhook_To_core_AuthorizationWebhook is an autogenerated conversion function.
+func Convert_v1beta1_AuthorizationWebhook_To_core_AuthorizationWebhook(in *AuthorizationWebhook, out *core.AuthorizationWebhook, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuthorizationWebhook_To_core_AuthorizationWebhook(in, out, s)
+}
+
+func autoConvert_core_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(in *core.AuthorizationWebhook, out *AuthorizationWebhook, s conversion.Scope) error {
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.CacheAuthorizedTTL = (*metav1.Duration)(unsafe.Pointer(in.CacheAuthorizedTTL))
+	out.CacheUnauthorizedTTL = (*metav1.Duration)(unsafe.Pointer(in.CacheUnauthorizedTTL))
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	return nil
+}
+
+// Convert_core_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook is an autogenerated conversion function.
+func Convert_core_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(in *core.AuthorizationWebhook, out *AuthorizationWebhook, s conversion.Scope) error {
+	return autoConvert_core_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(in, out, s)
+}
+
+func autoConvert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(in *AuthorizerKubeconfigReference, out *core.AuthorizerKubeconfigReference, s conversion.Scope) error {
+	out.AuthorizerName = in.AuthorizerName
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference is an autogenerated conversion function.
+func Convert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(in *AuthorizerKubeconfigReference, out *core.AuthorizerKubeconfigReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(in, out, s)
+}
+
+func autoConvert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigReference(in *core.AuthorizerKubeconfigReference, out *AuthorizerKubeconfigReference, s conversion.Scope) error {
+	out.AuthorizerName = in.AuthorizerName
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigReference is an autogenerated conversion function.
+func Convert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigReference(in *core.AuthorizerKubeconfigReference, out *AuthorizerKubeconfigReference, s conversion.Scope) error {
+	return autoConvert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigReference(in, out, s)
+}
+
 func autoConvert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(in *AvailabilityZone, out *core.AvailabilityZone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.UnavailableMachineTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableMachineTypes))
@@ -2267,6 +2525,28 @@ func Convert_core_CloudProfileList_To_v1beta1_CloudProfileList(in *core.CloudPro
 	return autoConvert_core_CloudProfileList_To_v1beta1_CloudProfileList(in, out, s)
 }
 
+func autoConvert_v1beta1_CloudProfileReference_To_core_CloudProfileReference(in *CloudProfileReference, out *core.CloudProfileReference, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_CloudProfileReference_To_core_CloudProfileReference is an autogenerated conversion function.
+func Convert_v1beta1_CloudProfileReference_To_core_CloudProfileReference(in *CloudProfileReference, out *core.CloudProfileReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudProfileReference_To_core_CloudProfileReference(in, out, s)
+}
+
+func autoConvert_core_CloudProfileReference_To_v1beta1_CloudProfileReference(in *core.CloudProfileReference, out *CloudProfileReference, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_CloudProfileReference_To_v1beta1_CloudProfileReference is an autogenerated conversion function.
+func Convert_core_CloudProfileReference_To_v1beta1_CloudProfileReference(in *core.CloudProfileReference, out *CloudProfileReference, s conversion.Scope) error {
+	return autoConvert_core_CloudProfileReference_To_v1beta1_CloudProfileReference(in, out, s)
+}
+
 func autoConvert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(in *CloudProfileSpec, out *core.CloudProfileSpec, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	if err := Convert_v1beta1_KubernetesSettings_To_core_KubernetesSettings(&in.Kubernetes, &out.Kubernetes, s); err != nil {
@@ -2809,6 +3089,50 @@ func Convert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in *core.CoreDNSR
 	return autoConvert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in, out, s)
 }
 
+func autoConvert_v1beta1_CredentialExpiration_To_core_CredentialExpiration(in *CredentialExpiration, out *core.CredentialExpiration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = core.CredentialType(in.Type)
+	out.ExpirationTime = in.ExpirationTime
+	return nil
+}
+
+// Convert_v1beta1_CredentialExpiration_To_core_CredentialExpiration is an autogenerated conversion function.
+func Convert_v1beta1_CredentialExpiration_To_core_CredentialExpiration(in *CredentialExpiration, out *core.CredentialExpiration, s conversion.Scope) error {
+	return autoConvert_v1beta1_CredentialExpiration_To_core_CredentialExpiration(in, out, s)
+}
+
+func autoConvert_core_CredentialExpiration_To_v1beta1_CredentialExpiration(in *core.CredentialExpiration, out *CredentialExpiration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = CredentialType(in.Type)
+	out.ExpirationTime = in.ExpirationTime
+	return nil
+}
+
+// Convert_core_CredentialExpiration_To_v1beta1_CredentialExpiration is an autogenerated conversion function.
+func Convert_core_CredentialExpiration_To_v1beta1_CredentialExpiration(in *core.CredentialExpiration, out *CredentialExpiration, s conversion.Scope) error {
+	return autoConvert_core_CredentialExpiration_To_v1beta1_CredentialExpiration(in, out, s)
+}
+
+func autoConvert_v1beta1_CredentialsRotationPolicy_To_core_CredentialsRotationPolicy(in *CredentialsRotationPolicy, out *core.CredentialsRotationPolicy, s conversion.Scope) error {
+	out.Interval = in.Interval
+	return nil
+}
+
+// Convert_v1beta1_CredentialsRotationPolicy_To_core_CredentialsRotationPolicy is an autogenerated conversion function.
+func Convert_v1beta1_CredentialsRotationPolicy_To_core_CredentialsRotationPolicy(in *CredentialsRotationPolicy, out *core.CredentialsRotationPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_CredentialsRotationPolicy_To_core_CredentialsRotationPolicy(in, out, s)
+}
+
+func autoConvert_core_CredentialsRotationPolicy_To_v1beta1_CredentialsRotationPolicy(in *core.CredentialsRotationPolicy, out *CredentialsRotationPolicy, s conversion.Scope) error {
+	out.Interval = in.Interval
+	return nil
+}
+
+// Convert_core_CredentialsRotationPolicy_To_v1beta1_CredentialsRotationPolicy is an autogenerated conversion function.
+func Convert_core_CredentialsRotationPolicy_To_v1beta1_CredentialsRotationPolicy(in *core.CredentialsRotationPolicy, out *CredentialsRotationPolicy, s conversion.Scope) error {
+	return autoConvert_core_CredentialsRotationPolicy_To_v1beta1_CredentialsRotationPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Providers = *(*[]core.DNSProvider)(unsafe.Pointer(&in.Providers))
@@ -2957,6 +3281,7 @@ func Convert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation
 
 func autoConvert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(in *EncryptionConfig, out *core.EncryptionConfig, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Provider = (*core.EncryptionProvider)(unsafe.Pointer(in.Provider))
 	return nil
 }
 
@@ -2967,6 +3292,7 @@ func Convert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(in *EncryptionCon
 
 func autoConvert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Provider = (*EncryptionProvider)(unsafe.Pointer(in.Provider))
 	return nil
 }
 
@@ -2975,6 +3301,28 @@ func Convert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.Encrypti
 	return autoConvert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_EncryptionProvider_To_core_EncryptionProvider(in *EncryptionProvider, out *core.EncryptionProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1beta1_EncryptionProvider_To_core_EncryptionProvider is an autogenerated conversion function.
+func Convert_v1beta1_EncryptionProvider_To_core_EncryptionProvider(in *EncryptionProvider, out *core.EncryptionProvider, s conversion.Scope) error {
+	return autoConvert_v1beta1_EncryptionProvider_To_core_EncryptionProvider(in, out, s)
+}
+
+func autoConvert_core_EncryptionProvider_To_v1beta1_EncryptionProvider(in *core.EncryptionProvider, out *EncryptionProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_core_EncryptionProvider_To_v1beta1_EncryptionProvider is an autogenerated conversion function.
+func Convert_core_EncryptionProvider_To_v1beta1_EncryptionProvider(in *core.EncryptionProvider, out *EncryptionProvider, s conversion.Scope) error {
+	return autoConvert_core_EncryptionProvider_To_v1beta1_EncryptionProvider(in, out, s)
+}
+
 func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVersion, out *core.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
@@ -3168,6 +3516,7 @@ func autoConvert_v1beta1_GardenerResourceData_To_core_GardenerResourceData(in *G
 	out.Type = in.Type
 	out.Data = in.Data
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	return nil
 }
 
@@ -3181,6 +3530,7 @@ func autoConvert_core_GardenerResourceData_To_v1beta1_GardenerResourceData(in *c
 	out.Type = in.Type
 	out.Data = in.Data
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	return nil
 }
 
@@ -3427,6 +3777,9 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*core.EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.StructuredAuthentication = (*core.StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
+	out.Authorization = (*core.Authorization)(unsafe.Pointer(in.Authorization))
+	out.StructuredAuthorization = (*core.StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
 	return nil
 }
 
@@ -3463,6 +3816,9 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.StructuredAuthentication = (*StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
+	out.Authorization = (*Authorization)(unsafe.Pointer(in.Authorization))
+	out.StructuredAuthorization = (*StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
 	return nil
 }
 
@@ -4143,6 +4499,10 @@ func autoConvert_v1beta1_Maintenance_To_core_Maintenance(in *Maintenance, out *c
 	out.AutoUpdate = (*core.MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.CredentialsRotation = (*core.MaintenanceCredentialsRotation)(unsafe.Pointer(in.CredentialsRotation))
+	out.TimeWindowWeekdays = *(*[]string)(unsafe.Pointer(&in.TimeWindowWeekdays))
+	out.AdditionalTimeRanges = *(*[]core.MaintenanceTimeRange)(unsafe.Pointer(&in.AdditionalTimeRanges))
+	out.Blackouts = *(*[]core.MaintenanceBlackout)(unsafe.Pointer(&in.Blackouts))
 	return nil
 }
 
@@ -4155,6 +4515,10 @@ func autoConvert_core_Maintenance_To_v1beta1_Maintenance(in *core.Maintenance, o
 	out.AutoUpdate = (*MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.CredentialsRotation = (*MaintenanceCredentialsRotation)(unsafe.Pointer(in.CredentialsRotation))
+	out.TimeWindowWeekdays = *(*[]string)(unsafe.Pointer(&in.TimeWindowWeekdays))
+	out.AdditionalTimeRanges = *(*[]MaintenanceTimeRange)(unsafe.Pointer(&in.AdditionalTimeRanges))
+	out.Blackouts = *(*[]MaintenanceBlackout)(unsafe.Pointer(&in.Blackouts))
 	return nil
 }
 
@@ -4185,6 +4549,82 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceBlackout_To_core_MaintenanceBlackout(in *MaintenanceBlackout, out *core.MaintenanceBlackout, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceBlackout_To_core_MaintenanceBlackout is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceBlackout_To_core_MaintenanceBlackout(in *MaintenanceBlackout, out *core.MaintenanceBlackout, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceBlackout_To_core_MaintenanceBlackout(in, out, s)
+}
+
+func autoConvert_core_MaintenanceBlackout_To_v1beta1_MaintenanceBlackout(in *core.MaintenanceBlackout, out *MaintenanceBlackout, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_core_MaintenanceBlackout_To_v1beta1_MaintenanceBlackout is an autogenerated conversion function.
+func Convert_core_MaintenanceBlackout_To_v1beta1_MaintenanceBlackout(in *core.MaintenanceBlackout, out *MaintenanceBlackout, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceBlackout_To_v1beta1_MaintenanceBlackout(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceCredentialsRotation_To_core_MaintenanceCredentialsRotation(in *MaintenanceCredentialsRotation, out *core.MaintenanceCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*core.CredentialsRotationPolicy)(unsafe.Pointer(in.CertificateAuthorities))
+	out.ServiceAccountKey = (*core.CredentialsRotationPolicy)(unsafe.Pointer(in.ServiceAccountKey))
+	out.ETCDEncryptionKey = (*core.CredentialsRotationPolicy)(unsafe.Pointer(in.ETCDEncryptionKey))
+	out.SSHKeypair = (*core.CredentialsRotationPolicy)(unsafe.Pointer(in.SSHKeypair))
+	out.Observability = (*core.CredentialsRotationPolicy)(unsafe.Pointer(in.Observability))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceCredentialsRotation_To_core_MaintenanceCredentialsRotation is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceCredentialsRotation_To_core_MaintenanceCredentialsRotation(in *MaintenanceCredentialsRotation, out *core.MaintenanceCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceCredentialsRotation_To_core_MaintenanceCredentialsRotation(in, out, s)
+}
+
+func autoConvert_core_MaintenanceCredentialsRotation_To_v1beta1_MaintenanceCredentialsRotation(in *core.MaintenanceCredentialsRotation, out *MaintenanceCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*CredentialsRotationPolicy)(unsafe.Pointer(in.CertificateAuthorities))
+	out.ServiceAccountKey = (*CredentialsRotationPolicy)(unsafe.Pointer(in.ServiceAccountKey))
+	out.ETCDEncryptionKey = (*CredentialsRotationPolicy)(unsafe.Pointer(in.ETCDEncryptionKey))
+	out.SSHKeypair = (*CredentialsRotationPolicy)(unsafe.Pointer(in.SSHKeypair))
+	out.Observability = (*CredentialsRotationPolicy)(unsafe.Pointer(in.Observability))
+	return nil
+}
+
+// Convert_core_MaintenanceCredentialsRotation_To_v1beta1_MaintenanceCredentialsRotation is an autogenerated conversion function.
+func Convert_core_MaintenanceCredentialsRotation_To_v1beta1_MaintenanceCredentialsRotation(in *core.MaintenanceCredentialsRotation, out *MaintenanceCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceCredentialsRotation_To_v1beta1_MaintenanceCredentialsRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceTimeRange_To_core_MaintenanceTimeRange(in *MaintenanceTimeRange, out *core.MaintenanceTimeRange, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceTimeRange_To_core_MaintenanceTimeRange is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceTimeRange_To_core_MaintenanceTimeRange(in *MaintenanceTimeRange, out *core.MaintenanceTimeRange, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceTimeRange_To_core_MaintenanceTimeRange(in, out, s)
+}
+
+func autoConvert_core_MaintenanceTimeRange_To_v1beta1_MaintenanceTimeRange(in *core.MaintenanceTimeRange, out *MaintenanceTimeRange, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	return nil
+}
+
+// Convert_core_MaintenanceTimeRange_To_v1beta1_MaintenanceTimeRange is an autogenerated conversion function.
+func Convert_core_MaintenanceTimeRange_To_v1beta1_MaintenanceTimeRange(in *core.MaintenanceTimeRange, out *MaintenanceTimeRange, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceTimeRange_To_v1beta1_MaintenanceTimeRange(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -4269,6 +4709,120 @@ func Convert_core_NamedResourceReference_To_v1beta1_NamedResourceReference(in *c
 	return autoConvert_core_NamedResourceReference_To_v1beta1_NamedResourceReference(in, out, s)
 }
 
+func autoConvert_v1beta1_NamespacedCloudProfile_To_core_NamespacedCloudProfile(in *NamespacedCloudProfile, out *core.NamespacedCloudProfile, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_NamespacedCloudProfileSpec_To_core_NamespacedCloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_NamespacedCloudProfileStatus_To_core_NamespacedCloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_NamespacedCloudProfile_To_core_NamespacedCloudProfile is an autogenerated conversion function.
+func Convert_v1beta1_NamespacedCloudProfile_To_core_NamespacedCloudProfile(in *NamespacedCloudProfile, out *core.NamespacedCloudProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespacedCloudProfile_To_core_NamespacedCloudProfile(in, out, s)
+}
+
+func autoConvert_core_NamespacedCloudProfile_To_v1beta1_NamespacedCloudProfile(in *core.NamespacedCloudProfile, out *NamespacedCloudProfile, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_NamespacedCloudProfileSpec_To_v1beta1_NamespacedCloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_NamespacedCloudProfileStatus_To_v1beta1_NamespacedCloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_NamespacedCloudProfile_To_v1beta1_NamespacedCloudProfile is an autogenerated conversion function.
+func Convert_core_NamespacedCloudProfile_To_v1beta1_NamespacedCloudProfile(in *core.NamespacedCloudProfile, out *NamespacedCloudProfile, s conversion.Scope) error {
+	return autoConvert_core_NamespacedCloudProfile_To_v1beta1_NamespacedCloudProfile(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespacedCloudProfileList_To_core_NamespacedCloudProfileList(in *NamespacedCloudProfileList, out *core.NamespacedCloudProfileList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.NamespacedCloudProfile)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_NamespacedCloudProfileList_To_core_NamespacedCloudProfileList is an autogenerated conversion function.
+func Convert_v1beta1_NamespacedCloudProfileList_To_core_NamespacedCloudProfileList(in *NamespacedCloudProfileList, out *core.NamespacedCloudProfileList, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespacedCloudProfileList_To_core_NamespacedCloudProfileList(in, out, s)
+}
+
+func autoConvert_core_NamespacedCloudProfileList_To_v1beta1_NamespacedCloudProfileList(in *core.NamespacedCloudProfileList, out *NamespacedCloudProfileList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NamespacedCloudProfile)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_NamespacedCloudProfileList_To_v1beta1_NamespacedCloudProfileList is an autogenerated conversion function.
+func Convert_core_NamespacedCloudProfileList_To_v1beta1_NamespacedCloudProfileList(in *core.NamespacedCloudProfileList, out *NamespacedCloudProfileList, s conversion.Scope) error {
+	return autoConvert_core_NamespacedCloudProfileList_To_v1beta1_NamespacedCloudProfileList(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespacedCloudProfileSpec_To_core_NamespacedCloudProfileSpec(in *NamespacedCloudProfileSpec, out *core.NamespacedCloudProfileSpec, s conversion.Scope) error {
+	out.Kubernetes = (*core.KubernetesSettings)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = *(*[]core.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.MachineTypes = *(*[]core.MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]core.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
+	if err := Convert_v1beta1_CloudProfileReference_To_core_CloudProfileReference(&in.Parent, &out.Parent, s); err != nil {
+		return err
+	}
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1beta1_NamespacedCloudProfileSpec_To_core_NamespacedCloudProfileSpec is an autogenerated conversion function.
+func Convert_v1beta1_NamespacedCloudProfileSpec_To_core_NamespacedCloudProfileSpec(in *NamespacedCloudProfileSpec, out *core.NamespacedCloudProfileSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespacedCloudProfileSpec_To_core_NamespacedCloudProfileSpec(in, out, s)
+}
+
+func autoConvert_core_NamespacedCloudProfileSpec_To_v1beta1_NamespacedCloudProfileSpec(in *core.NamespacedCloudProfileSpec, out *NamespacedCloudProfileSpec, s conversion.Scope) error {
+	out.Kubernetes = (*KubernetesSettings)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.MachineTypes = *(*[]MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]VolumeType)(unsafe.Pointer(&in.VolumeTypes))
+	if err := Convert_core_CloudProfileReference_To_v1beta1_CloudProfileReference(&in.Parent, &out.Parent, s); err != nil {
+		return err
+	}
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_core_NamespacedCloudProfileSpec_To_v1beta1_NamespacedCloudProfileSpec is an autogenerated conversion function.
+func Convert_core_NamespacedCloudProfileSpec_To_v1beta1_NamespacedCloudProfileSpec(in *core.NamespacedCloudProfileSpec, out *NamespacedCloudProfileSpec, s conversion.Scope) error {
+	return autoConvert_core_NamespacedCloudProfileSpec_To_v1beta1_NamespacedCloudProfileSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespacedCloudProfileStatus_To_core_NamespacedCloudProfileStatus(in *NamespacedCloudProfileStatus, out *core.NamespacedCloudProfileStatus, s conversion.Scope) error {
+	if err := Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(&in.CloudProfileSpec, &out.CloudProfileSpec, s); err != nil {
+		return err
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1beta1_NamespacedCloudProfileStatus_To_core_NamespacedCloudProfileStatus is an autogenerated conversion function.
+func Convert_v1beta1_NamespacedCloudProfileStatus_To_core_NamespacedCloudProfileStatus(in *NamespacedCloudProfileStatus, out *core.NamespacedCloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespacedCloudProfileStatus_To_core_NamespacedCloudProfileStatus(in, out, s)
+}
+
+func autoConvert_core_NamespacedCloudProfileStatus_To_v1beta1_NamespacedCloudProfileStatus(in *core.NamespacedCloudProfileStatus, out *NamespacedCloudProfileStatus, s conversion.Scope) error {
+	if err := Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(&in.CloudProfileSpec, &out.CloudProfileSpec, s); err != nil {
+		return err
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_core_NamespacedCloudProfileStatus_To_v1beta1_NamespacedCloudProfileStatus is an autogenerated conversion function.
+func Convert_core_NamespacedCloudProfileStatus_To_v1beta1_NamespacedCloudProfileStatus(in *core.NamespacedCloudProfileStatus, out *NamespacedCloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_core_NamespacedCloudProfileStatus_To_v1beta1_NamespacedCloudProfileStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Networking_To_core_Networking(in *Networking, out *core.Networking, s conversion.Scope) error {
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
@@ -4276,6 +4830,9 @@ func autoConvert_v1beta1_Networking_To_core_Networking(in *Networking, out *core
 	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
 	out.IPFamilies = *(*[]core.IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.SecondaryPods = (*string)(unsafe.Pointer(in.SecondaryPods))
+	out.SecondaryNodes = (*string)(unsafe.Pointer(in.SecondaryNodes))
+	out.SecondaryServices = (*string)(unsafe.Pointer(in.SecondaryServices))
 	return nil
 }
 
@@ -4291,6 +4848,9 @@ func autoConvert_core_Networking_To_v1beta1_Networking(in *core.Networking, out
 	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
 	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.SecondaryPods = (*string)(unsafe.Pointer(in.SecondaryPods))
+	out.SecondaryNodes = (*string)(unsafe.Pointer(in.SecondaryNodes))
+	out.SecondaryServices = (*string)(unsafe.Pointer(in.SecondaryServices))
 	return nil
 }
 
@@ -4669,6 +5229,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4682,6 +5245,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4690,6 +5256,28 @@ func Convert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conversio
 	return autoConvert_core_Quota_To_v1beta1_Quota(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaConsumer_To_core_QuotaConsumer(in *QuotaConsumer, out *core.QuotaConsumer, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_QuotaConsumer_To_core_QuotaConsumer is an autogenerated conversion function.
+func Convert_v1beta1_QuotaConsumer_To_core_QuotaConsumer(in *QuotaConsumer, out *core.QuotaConsumer, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaConsumer_To_core_QuotaConsumer(in, out, s)
+}
+
+func autoConvert_core_QuotaConsumer_To_v1beta1_QuotaConsumer(in *core.QuotaConsumer, out *QuotaConsumer, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_QuotaConsumer_To_v1beta1_QuotaConsumer is an autogenerated conversion function.
+func Convert_core_QuotaConsumer_To_v1beta1_QuotaConsumer(in *core.QuotaConsumer, out *QuotaConsumer, s conversion.Scope) error {
+	return autoConvert_core_QuotaConsumer_To_v1beta1_QuotaConsumer(in, out, s)
+}
+
 func autoConvert_v1beta1_QuotaList_To_core_QuotaList(in *QuotaList, out *core.QuotaList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.Quota)(unsafe.Pointer(&in.Items))
@@ -4715,6 +5303,7 @@ func Convert_core_QuotaList_To_v1beta1_QuotaList(in *core.QuotaList, out *QuotaL
 func autoConvert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.SoftMetrics = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftMetrics))
 	out.Scope = in.Scope
 	return nil
 }
@@ -4727,6 +5316,7 @@ func Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaS
 func autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.SoftMetrics = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftMetrics))
 	out.Scope = in.Scope
 	return nil
 }
@@ -4736,6 +5326,54 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Usage = *(*[]core.QuotaUsage)(unsafe.Pointer(&in.Usage))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Usage = *(*[]QuotaUsage)(unsafe.Pointer(&in.Usage))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_QuotaUsage_To_core_QuotaUsage(in *QuotaUsage, out *core.QuotaUsage, s conversion.Scope) error {
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Consumers = *(*[]core.QuotaConsumer)(unsafe.Pointer(&in.Consumers))
+	return nil
+}
+
+// Convert_v1beta1_QuotaUsage_To_core_QuotaUsage is an autogenerated conversion function.
+func Convert_v1beta1_QuotaUsage_To_core_QuotaUsage(in *QuotaUsage, out *core.QuotaUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaUsage_To_core_QuotaUsage(in, out, s)
+}
+
+func autoConvert_core_QuotaUsage_To_v1beta1_QuotaUsage(in *core.QuotaUsage, out *QuotaUsage, s conversion.Scope) error {
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Consumers = *(*[]QuotaConsumer)(unsafe.Pointer(&in.Consumers))
+	return nil
+}
+
+// Convert_core_QuotaUsage_To_v1beta1_QuotaUsage is an autogenerated conversion function.
+func Convert_core_QuotaUsage_To_v1beta1_QuotaUsage(in *core.QuotaUsage, out *QuotaUsage, s conversion.Scope) error {
+	return autoConvert_core_QuotaUsage_To_v1beta1_QuotaUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -5644,6 +6282,7 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	out.Expirations = *(*[]core.CredentialExpiration)(unsafe.Pointer(&in.Expirations))
 	return nil
 }
 
@@ -5654,6 +6293,7 @@ func Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredenti
 
 func autoConvert_core_ShootCredentials_To_v1beta1_ShootCredentials(in *core.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	out.Expirations = *(*[]CredentialExpiration)(unsafe.Pointer(&in.Expirations))
 	return nil
 }
 
@@ -5854,6 +6494,7 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.SystemComponents = (*core.SystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.ControlPlane = (*core.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	return nil
 }
 
@@ -5888,6 +6529,7 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.SystemComponents = (*SystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	return nil
 }
 
@@ -5989,6 +6631,7 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.EncryptionProvider = (*core.EncryptionProvider)(unsafe.Pointer(in.EncryptionProvider))
 	return nil
 }
 
@@ -6018,6 +6661,7 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.EncryptionProvider = (*EncryptionProvider)(unsafe.Pointer(in.EncryptionProvider))
 	return nil
 }
 
@@ -6052,6 +6696,48 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in *StructuredAuthentication, out *core.StructuredAuthentication, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication is an autogenerated conversion function.
+func Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in *StructuredAuthentication, out *core.StructuredAuthentication, s conversion.Scope) error {
+	return autoConvert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in, out, s)
+}
+
+func autoConvert_core_StructuredAuthentication_To_v1beta1_StructuredAuthentication(in *core.StructuredAuthentication, out *StructuredAuthentication, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_core_StructuredAuthentication_To_v1beta1_StructuredAuthentication is an autogenerated conversion function.
+func Convert_core_StructuredAuthentication_To_v1beta1_StructuredAuthentication(in *core.StructuredAuthentication, out *StructuredAuthentication, s conversion.Scope) error {
+	return autoConvert_core_StructuredAuthentication_To_v1beta1_StructuredAuthentication(in, out, s)
+}
+
+func autoConvert_v1beta1_StructuredAuthorization_To_core_StructuredAuthorization(in *StructuredAuthorization, out *core.StructuredAuthorization, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	out.Kubeconfigs = *(*[]core.AuthorizerKubeconfigReference)(unsafe.Pointer(&in.Kubeconfigs))
+	return nil
+}
+
+// Convert_v1beta1_StructuredAuthorization_To_core_StructuredAuthorization is an autogenerated conversion function.
+func Convert_v1beta1_StructuredAuthorization_To_core_StructuredAuthorization(in *StructuredAuthorization, out *core.StructuredAuthorization, s conversion.Scope) error {
+	return autoConvert_v1beta1_StructuredAuthorization_To_core_StructuredAuthorization(in, out, s)
+}
+
+func autoConvert_core_StructuredAuthorization_To_v1beta1_StructuredAuthorization(in *core.StructuredAuthorization, out *StructuredAuthorization, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	out.Kubeconfigs = *(*[]AuthorizerKubeconfigReference)(unsafe.Pointer(&in.Kubeconfigs))
+	return nil
+}
+
+// Convert_core_StructuredAuthorization_To_v1beta1_StructuredAuthorization is an autogenerated conversion function.
+func Convert_core_StructuredAuthorization_To_v1beta1_StructuredAuthorization(in *core.StructuredAuthorization, out *StructuredAuthorization, s conversion.Scope) error {
+	return autoConvert_core_StructuredAuthorization_To_v1beta1_StructuredAuthorization(in, out, s)
+}
+
 func autoConvert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemComponents, out *core.SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*core.CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*core.NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
@@ -217,6 +217,74 @@ func (in *AuditPolicy) DeepCopy() *AuditPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Authorization) DeepCopyInto(out *Authorization) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuthorizationWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorization.
+func (in *Authorization) DeepCopy() *Authorization {
+	if in == nil {
+		return nil
+	}
+	out := new(Authorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationWebhook) DeepCopyInto(out *AuthorizationWebhook) {
+	*out = *in
+	if in.CacheAuthorizedTTL != nil {
+		in, out := &in.CacheAuthorizedTTL, &out.CacheAuthorizedTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CacheUnauthorizedTTL != nil {
+		in, out := &in.CacheUnauthorizedTTL, &out.CacheUnauthorizedTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationWebhook.
+func (in *AuthorizationWebhook) DeepCopy() *AuthorizationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizerKubeconfigReference) DeepCopyInto(out *AuthorizerKubeconfigReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerKubeconfigReference.
+func (in *AuthorizerKubeconfigReference) DeepCopy() *AuthorizerKubeconfigReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizerKubeconfigReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
 	*out = *in
@@ -616,6 +684,22 @@ func (in *CloudProfileList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProfileReference) DeepCopyInto(out *CloudProfileReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileReference.
+func (in *CloudProfileReference) DeepCopy() *CloudProfileReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProfileReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 	*out = *in
@@ -1253,6 +1337,40 @@ func (in *CoreDNSRewriting) DeepCopy() *CoreDNSRewriting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialExpiration) DeepCopyInto(out *CredentialExpiration) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialExpiration.
+func (in *CredentialExpiration) DeepCopy() *CredentialExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsRotationPolicy) DeepCopyInto(out *CredentialsRotationPolicy) {
+	*out = *in
+	out.Interval = in.Interval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsRotationPolicy.
+func (in *CredentialsRotationPolicy) DeepCopy() *CredentialsRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
@@ -1430,6 +1548,11 @@ func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(EncryptionProvider)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1443,6 +1566,27 @@ func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionProvider) DeepCopyInto(out *EncryptionProvider) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionProvider.
+func (in *EncryptionProvider) DeepCopy() *EncryptionProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersion) DeepCopyInto(out *ExpirableVersion) {
 	*out = *in
@@ -1665,6 +1809,13 @@ func (in *GardenerResourceData) DeepCopyInto(out *GardenerResourceData) {
 			(*out)[key] = val
 		}
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1997,6 +2148,21 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StructuredAuthentication != nil {
+		in, out := &in.StructuredAuthentication, &out.StructuredAuthentication
+		*out = new(StructuredAuthentication)
+		**out = **in
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StructuredAuthorization != nil {
+		in, out := &in.StructuredAuthorization, &out.StructuredAuthorization
+		*out = new(StructuredAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2807,6 +2973,30 @@ func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CredentialsRotation != nil {
+		in, out := &in.CredentialsRotation, &out.CredentialsRotation
+		*out = new(MaintenanceCredentialsRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeWindowWeekdays != nil {
+		in, out := &in.TimeWindowWeekdays, &out.TimeWindowWeekdays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalTimeRanges != nil {
+		in, out := &in.AdditionalTimeRanges, &out.AdditionalTimeRanges
+		*out = make([]MaintenanceTimeRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]MaintenanceBlackout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2841,6 +3031,91 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceBlackout) DeepCopyInto(out *MaintenanceBlackout) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceBlackout.
+func (in *MaintenanceBlackout) DeepCopy() *MaintenanceBlackout {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceBlackout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceCredentialsRotation) DeepCopyInto(out *MaintenanceCredentialsRotation) {
+	*out = *in
+	if in.CertificateAuthorities != nil {
+		in, out := &in.CertificateAuthorities, &out.CertificateAuthorities
+		*out = new(CredentialsRotationPolicy)
+		**out = **in
+	}
+	if in.ServiceAccountKey != nil {
+		in, out := &in.ServiceAccountKey, &out.ServiceAccountKey
+		*out = new(CredentialsRotationPolicy)
+		**out = **in
+	}
+	if in.ETCDEncryptionKey != nil {
+		in, out := &in.ETCDEncryptionKey, &out.ETCDEncryptionKey
+		*out = new(CredentialsRotationPolicy)
+		**out = **in
+	}
+	if in.SSHKeypair != nil {
+		in, out := &in.SSHKeypair, &out.SSHKeypair
+		*out = new(CredentialsRotationPolicy)
+		**out = **in
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(CredentialsRotationPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceCredentialsRotation.
+func (in *MaintenanceCredentialsRotation) DeepCopy() *MaintenanceCredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceCredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTimeRange) DeepCopyInto(out *MaintenanceTimeRange) {
+	*out = *in
+	if in.Weekdays != nil {
+		in, out := &in.Weekdays, &out.Weekdays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeRange.
+func (in *MaintenanceTimeRange) DeepCopy() *MaintenanceTimeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTimeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -2916,6 +3191,132 @@ func (in *NamedResourceReference) DeepCopy() *NamedResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfile) DeepCopyInto(out *NamespacedCloudProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfile.
+func (in *NamespacedCloudProfile) DeepCopy() *NamespacedCloudProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedCloudProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfileList) DeepCopyInto(out *NamespacedCloudProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedCloudProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileList.
+func (in *NamespacedCloudProfileList) DeepCopy() *NamespacedCloudProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedCloudProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfileSpec) DeepCopyInto(out *NamespacedCloudProfileSpec) {
+	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]MachineType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]VolumeType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Parent = in.Parent
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileSpec.
+func (in *NamespacedCloudProfileSpec) DeepCopy() *NamespacedCloudProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfileStatus) DeepCopyInto(out *NamespacedCloudProfileStatus) {
+	*out = *in
+	in.CloudProfileSpec.DeepCopyInto(&out.CloudProfileSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileStatus.
+func (in *NamespacedCloudProfileStatus) DeepCopy() *NamespacedCloudProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Networking) DeepCopyInto(out *Networking) {
 	*out = *in
@@ -2949,6 +3350,21 @@ func (in *Networking) DeepCopyInto(out *Networking) {
 		*out = make([]IPFamily, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecondaryPods != nil {
+		in, out := &in.SecondaryPods, &out.SecondaryPods
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryNodes != nil {
+		in, out := &in.SecondaryNodes, &out.SecondaryNodes
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryServices != nil {
+		in, out := &in.SecondaryServices, &out.SecondaryServices
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3385,6 +3801,7 @@ func (in *Quota) DeepCopyInto(out *Quota) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -3406,6 +3823,22 @@ func (in *Quota) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaConsumer) DeepCopyInto(out *QuotaConsumer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaConsumer.
+func (in *QuotaConsumer) DeepCopy() *QuotaConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaList) DeepCopyInto(out *QuotaList) {
 	*out = *in
@@ -3454,6 +3887,13 @@ func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.SoftMetrics != nil {
+		in, out := &in.SoftMetrics, &out.SoftMetrics
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	out.Scope = in.Scope
 	return
 }
@@ -3468,6 +3908,66 @@ func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaStatus) DeepCopyInto(out *QuotaStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make([]QuotaUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaStatus.
+func (in *QuotaStatus) DeepCopy() *QuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]QuotaConsumer, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Region) DeepCopyInto(out *Region) {
 	*out = *in
@@ -4437,6 +4937,13 @@ func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
 		*out = new(ShootCredentialsRotation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Expirations != nil {
+		in, out := &in.Expirations, &out.Expirations
+		*out = make([]CredentialExpiration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4723,6 +5230,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CloudProfile != nil {
+		in, out := &in.CloudProfile, &out.CloudProfile
+		*out = new(CloudProfileReference)
+		**out = **in
+	}
 	return
 }
 
@@ -4905,6 +5417,11 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EncryptionProvider != nil {
+		in, out := &in.EncryptionProvider, &out.EncryptionProvider
+		*out = new(EncryptionProvider)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4936,6 +5453,43 @@ func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StructuredAuthentication) DeepCopyInto(out *StructuredAuthentication) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructuredAuthentication.
+func (in *StructuredAuthentication) DeepCopy() *StructuredAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(StructuredAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StructuredAuthorization) DeepCopyInto(out *StructuredAuthorization) {
+	*out = *in
+	if in.Kubeconfigs != nil {
+		in, out := &in.Kubeconfigs, &out.Kubeconfigs
+		*out = make([]AuthorizerKubeconfigReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructuredAuthorization.
+func (in *StructuredAuthorization) DeepCopy() *StructuredAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(StructuredAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 	*out = *in
@@ -53,7 +53,8 @@ func ValidateBackupBucketSpec(spec *core.BackupBucketSpec, fldPath *field.Path)
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("provider.region"), spec.Provider.Region, "region must not be empty"))
 	}
 
-	if spec.SeedName == nil || len(*spec.SeedName) == 0 {
+	// The seed name may be empty, in which case the BackupBucket is scheduled to a seed by the gardener-scheduler.
+	if spec.SeedName != nil && len(*spec.SeedName) == 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("seedName"), spec.SeedName, "seed must not be empty"))
 	}
 
@@ -67,7 +68,9 @@ func ValidateBackupBucketSpecUpdate(newSpec, oldSpec *core.BackupBucketSpec, fld
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Provider, oldSpec.Provider, fldPath.Child("provider"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SeedName, oldSpec.SeedName, fldPath.Child("seedName"))...)
+	if oldSpec.SeedName != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SeedName, oldSpec.SeedName, fldPath.Child("seedName"))...)
+	}
 
 	return allErrs
 }
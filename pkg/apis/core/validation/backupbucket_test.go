@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/pkg/apis/core/validation"
@@ -100,7 +101,7 @@ var _ = Describe("validation", func() {
 			backupBucket.Spec.Provider.Type = ""
 			backupBucket.Spec.Provider.Region = ""
 			backupBucket.Spec.SecretRef = corev1.SecretReference{}
-			backupBucket.Spec.SeedName = nil
+			backupBucket.Spec.SeedName = pointer.String("")
 
 			errorList := ValidateBackupBucket(backupBucket)
 
@@ -126,6 +127,20 @@ var _ = Describe("validation", func() {
 				}))))
 		})
 
+		It("should allow BackupBucket specification without seed name", func() {
+			backupBucket.Spec.SeedName = nil
+
+			Expect(ValidateBackupBucket(backupBucket)).To(BeEmpty())
+		})
+
+		It("should allow setting the seed name if it was not set before", func() {
+			backupBucket.Spec.SeedName = nil
+			newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+			newBackupBucket.Spec.SeedName = pointer.String("seed")
+
+			Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(BeEmpty())
+		})
+
 		It("should forbid updating some keys", func() {
 			newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
 			newBackupBucket.Spec.Provider.Type = "another-type"
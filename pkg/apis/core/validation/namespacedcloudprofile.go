@@ -0,0 +1,111 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateNamespacedCloudProfile validates a NamespacedCloudProfile object.
+func ValidateNamespacedCloudProfile(namespacedCloudProfile *core.NamespacedCloudProfile) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&namespacedCloudProfile.ObjectMeta, true, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateNamespacedCloudProfileSpec(&namespacedCloudProfile.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateNamespacedCloudProfileUpdate validates a NamespacedCloudProfile object before an update.
+func ValidateNamespacedCloudProfileUpdate(newProfile, oldProfile *core.NamespacedCloudProfile) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newProfile.ObjectMeta, &oldProfile.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newProfile.Spec.Parent, oldProfile.Spec.Parent, field.NewPath("spec", "parent"))...)
+	allErrs = append(allErrs, ValidateNamespacedCloudProfile(newProfile)...)
+
+	return allErrs
+}
+
+// ValidateNamespacedCloudProfileStatusUpdate validates the status field of a NamespacedCloudProfile object.
+func ValidateNamespacedCloudProfileStatusUpdate(_, _ *core.NamespacedCloudProfile) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	return allErrs
+}
+
+// ValidateNamespacedCloudProfileSpec validates the specification of a NamespacedCloudProfile object.
+func ValidateNamespacedCloudProfileSpec(spec *core.NamespacedCloudProfileSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateNamespacedCloudProfileParent(spec.Parent, fldPath.Child("parent"))...)
+
+	if spec.Kubernetes != nil {
+		allErrs = append(allErrs, validateNamespacedCloudProfileKubernetesSettings(*spec.Kubernetes, fldPath.Child("kubernetes"))...)
+	}
+	if len(spec.MachineImages) > 0 {
+		allErrs = append(allErrs, validateMachineImages(spec.MachineImages, fldPath.Child("machineImages"))...)
+	}
+	if len(spec.MachineTypes) > 0 {
+		allErrs = append(allErrs, validateMachineTypes(spec.MachineTypes, fldPath.Child("machineTypes"))...)
+	}
+	allErrs = append(allErrs, validateVolumeTypes(spec.VolumeTypes, fldPath.Child("volumeTypes"))...)
+
+	return allErrs
+}
+
+func validateNamespacedCloudProfileParent(parent core.CloudProfileReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if parent.Kind != core.CloudProfileReferenceKindCloudProfile {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), parent.Kind, []string{core.CloudProfileReferenceKindCloudProfile}))
+	}
+	if len(parent.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide a parent name"))
+	}
+
+	return allErrs
+}
+
+func validateNamespacedCloudProfileKubernetesSettings(kubernetes core.KubernetesSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	versionsFound := sets.New[string]()
+	for i, version := range kubernetes.Versions {
+		idxPath := fldPath.Child("versions").Index(i)
+		if !k8sVersionCPRegex.MatchString(version.Version) {
+			allErrs = append(allErrs, field.Invalid(idxPath, version, fmt.Sprintf("all Kubernetes versions must match the regex %s", k8sVersionCPRegex)))
+		} else if versionsFound.Has(version.Version) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("version"), version.Version))
+		} else {
+			versionsFound.Insert(version.Version)
+		}
+
+		if version.ExpirationDate == nil {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expirationDate"), "must provide an expiration date for the Kubernetes version"))
+		}
+		if version.Classification != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("classification"), "the classification of Kubernetes versions cannot be overridden"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("NamespacedCloudProfile Validation Tests ", func() {
+	var namespacedCloudProfile *core.NamespacedCloudProfile
+
+	BeforeEach(func() {
+		namespacedCloudProfile = &core.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "profile",
+				Namespace: "garden-dev",
+			},
+			Spec: core.NamespacedCloudProfileSpec{
+				Parent: core.CloudProfileReference{
+					Kind: "CloudProfile",
+					Name: "aws",
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateNamespacedCloudProfile", func() {
+		It("should allow a NamespacedCloudProfile only referencing its parent", func() {
+			Expect(ValidateNamespacedCloudProfile(namespacedCloudProfile)).To(BeEmpty())
+		})
+
+		It("should allow additional machine types, volume types and machine images", func() {
+			namespacedCloudProfile.Spec.MachineTypes = machineTypesConstraint
+			namespacedCloudProfile.Spec.VolumeTypes = volumeTypesConstraint
+			namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
+				{
+					Name: "some-image",
+					Versions: []core.MachineImageVersion{
+						{
+							ExpirableVersion: core.ExpirableVersion{Version: "1.2.3"},
+							CRI:              []core.CRI{{Name: "docker"}},
+							Architectures:    []string{"amd64"},
+						},
+					},
+				},
+			}
+
+			Expect(ValidateNamespacedCloudProfile(namespacedCloudProfile)).To(BeEmpty())
+		})
+
+		It("should forbid a missing namespace and an invalid parent", func() {
+			namespacedCloudProfile.Namespace = ""
+			namespacedCloudProfile.Spec.Parent = core.CloudProfileReference{Kind: "NamespacedCloudProfile"}
+
+			Expect(ValidateNamespacedCloudProfile(namespacedCloudProfile)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.parent.kind"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.parent.name"),
+				})),
+			))
+		})
+
+		It("should forbid invalid machine types and volume types", func() {
+			namespacedCloudProfile.Spec.MachineTypes = []core.MachineType{invalidMachineType}
+			namespacedCloudProfile.Spec.VolumeTypes = []core.VolumeType{{Name: "volume-type"}}
+
+			Expect(ValidateNamespacedCloudProfile(namespacedCloudProfile)).To(ContainElements(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.machineTypes[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.volumeTypes[0].class"),
+				})),
+			))
+		})
+
+		Context("kubernetes versions", func() {
+			It("should allow overriding the expiration date", func() {
+				namespacedCloudProfile.Spec.Kubernetes = &core.KubernetesSettings{
+					Versions: []core.ExpirableVersion{{Version: "1.27.3", ExpirationDate: &metav1.Time{Time: time.Now().Add(24 * time.Hour)}}},
+				}
+
+				Expect(ValidateNamespacedCloudProfile(namespacedCloudProfile)).To(BeEmpty())
+			})
+
+			It("should forbid invalid, duplicate or incomplete overrides", func() {
+				classification := core.ClassificationPreview
+				namespacedCloudProfile.Spec.Kubernetes = &core.KubernetesSettings{
+					Versions: []core.ExpirableVersion{
+						{Version: "1.27", ExpirationDate: &metav1.Time{Time: time.Now()}},
+						{Version: "1.27.3", ExpirationDate: &metav1.Time{Time: time.Now()}},
+						{Version: "1.27.3", Classification: &classification},
+					},
+				}
+
+				Expect(ValidateNamespacedCloudProfile(namespacedCloudProfile)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.versions[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.versions[2].version"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.versions[2].expirationDate"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.versions[2].classification"),
+					})),
+				))
+			})
+		})
+	})
+
+	Describe("#ValidateNamespacedCloudProfileUpdate", func() {
+		It("should forbid changing the parent", func() {
+			namespacedCloudProfile.ResourceVersion = "1"
+			newNamespacedCloudProfile := namespacedCloudProfile.DeepCopy()
+			newNamespacedCloudProfile.Spec.Parent.Name = "gcp"
+			newNamespacedCloudProfile.Spec.MachineTypes = []core.MachineType{{Name: "foo", Architecture: pointer.String("amd64")}}
+
+			Expect(ValidateNamespacedCloudProfileUpdate(newNamespacedCloudProfile, namespacedCloudProfile)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.parent"),
+				})),
+			))
+		})
+	})
+})
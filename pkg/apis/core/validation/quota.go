@@ -16,6 +16,7 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -44,6 +45,43 @@ func ValidateQuotaUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
 	return allErrs
 }
 
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&newQuota.ObjectMeta, &oldQuota.ObjectMeta, field.NewPath("metadata"))
+
+	fldPath := field.NewPath("status")
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(newQuota.Status.ObservedGeneration, fldPath.Child("observedGeneration"))...)
+
+	namespaces := make(map[string]struct{}, len(newQuota.Status.Usage))
+	for i, usage := range newQuota.Status.Usage {
+		idxPath := fldPath.Child("usage").Index(i)
+
+		namespace := ""
+		if usage.Namespace != nil {
+			namespace = *usage.Namespace
+		}
+		if _, ok := namespaces[namespace]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("namespace"), namespace))
+		}
+		namespaces[namespace] = struct{}{}
+
+		for k, v := range usage.Used {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, idxPath.Child("used").Key(string(k)))...)
+		}
+
+		for j, consumer := range usage.Consumers {
+			if len(consumer.Namespace) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("consumers").Index(j).Child("namespace"), "must provide the namespace of the consumer"))
+			}
+			if len(consumer.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("consumers").Index(j).Child("name"), "must provide the name of the consumer"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateQuotaSpec validates the specification of a Quota object.
 func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -53,9 +91,23 @@ func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.Err
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scope"), scopeRef, []string{"project", "secret"}))
 	}
 
-	metricsFldPath := fldPath.Child("metrics")
-	for k, v := range quotaSpec.Metrics {
-		keyPath := metricsFldPath.Key(string(k))
+	allErrs = append(allErrs, validateQuotaMetrics(quotaSpec.Metrics, fldPath.Child("metrics"))...)
+	allErrs = append(allErrs, validateQuotaMetrics(quotaSpec.SoftMetrics, fldPath.Child("softMetrics"))...)
+
+	for k, v := range quotaSpec.SoftMetrics {
+		if limit, ok := quotaSpec.Metrics[k]; ok && v.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("softMetrics").Key(string(k)), v.String(), fmt.Sprintf("soft limit must not be greater than the limit in metrics (%s)", limit.String())))
+		}
+	}
+
+	return allErrs
+}
+
+func validateQuotaMetrics(metrics corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range metrics {
+		keyPath := fldPath.Key(string(k))
 		if !isValidQuotaMetric(corev1.ResourceName(k)) {
 			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
 		}
@@ -73,8 +125,11 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 		core.QuotaMetricMemory,
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer:
+		core.QuotaMetricLoadbalancer,
+		core.QuotaMetricShoots,
+		core.QuotaMetricNodes,
+		core.QuotaMetricHighlyAvailableControlPlanes:
 		return true
 	}
-	return false
+	return strings.HasPrefix(string(metric), core.QuotaMetricMachineTypePrefix) && len(metric) > len(core.QuotaMetricMachineTypePrefix)
 }
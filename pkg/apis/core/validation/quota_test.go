@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/pkg/apis/core/validation"
@@ -129,5 +130,118 @@ var _ = Describe("Quota Validation Tests ", func() {
 				})),
 			))
 		})
+
+		It("should allow the additional quota metrics", func() {
+			quota.Spec.Metrics["shoots"] = resource.MustParse("10")
+			quota.Spec.Metrics["nodes"] = resource.MustParse("100")
+			quota.Spec.Metrics["controlplanes.highavailability"] = resource.MustParse("2")
+			quota.Spec.Metrics["machinetype/m5.large"] = resource.MustParse("20")
+
+			Expect(ValidateQuota(quota)).To(BeEmpty())
+		})
+
+		It("should forbid machine type quota metrics without machine type", func() {
+			quota.Spec.Metrics["machinetype/"] = resource.MustParse("20")
+
+			Expect(ValidateQuota(quota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.metrics[machinetype/]"),
+				})),
+			))
+		})
+
+		It("should allow soft limits which are not greater than the limits", func() {
+			quota.Spec.SoftMetrics = corev1.ResourceList{
+				"cpu":    resource.MustParse("200"),
+				"shoots": resource.MustParse("5"),
+			}
+
+			Expect(ValidateQuota(quota)).To(BeEmpty())
+		})
+
+		It("should forbid invalid soft limits", func() {
+			quota.Spec.SoftMetrics = corev1.ResourceList{
+				"cpu": resource.MustParse("201"),
+				"key": resource.MustParse("1"),
+			}
+
+			Expect(ValidateQuota(quota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.softMetrics[cpu]"),
+					"Detail": ContainSubstring("soft limit must not be greater than the limit"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.softMetrics[key]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var oldQuota, newQuota *core.Quota
+
+		BeforeEach(func() {
+			oldQuota = &core.Quota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "quota-1",
+					Namespace:       "my-namespace",
+					ResourceVersion: "1",
+				},
+			}
+			newQuota = oldQuota.DeepCopy()
+		})
+
+		It("should allow a valid status", func() {
+			newQuota.Status = core.QuotaStatus{
+				ObservedGeneration: 1,
+				Usage: []core.QuotaUsage{
+					{
+						Namespace: pointer.String("garden-foo"),
+						Used:      corev1.ResourceList{"cpu": resource.MustParse("8")},
+						Consumers: []core.QuotaConsumer{{Namespace: "garden-foo", Name: "shoot"}},
+					},
+					{
+						Namespace: pointer.String("garden-bar"),
+					},
+				},
+			}
+
+			Expect(ValidateQuotaStatusUpdate(newQuota, oldQuota)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid status", func() {
+			newQuota.Status = core.QuotaStatus{
+				ObservedGeneration: -1,
+				Usage: []core.QuotaUsage{
+					{
+						Used:      corev1.ResourceList{"cpu": resource.MustParse("-1")},
+						Consumers: []core.QuotaConsumer{{Name: "shoot"}},
+					},
+					{},
+				},
+			}
+
+			Expect(ValidateQuotaStatusUpdate(newQuota, oldQuota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.observedGeneration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usage[0].used[cpu]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.usage[0].consumers[0].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.usage[1].namespace"),
+				})),
+			))
+		})
 	})
 })
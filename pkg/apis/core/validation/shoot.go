@@ -142,6 +142,10 @@ var (
 		"PS512",
 		"none",
 	)
+	availableAuthorizationWebhookVersions = sets.New(
+		"v1beta1",
+		"v1",
+	)
 
 	workerlessErrorMsg = "this field should not be set for workerless Shoot clusters"
 )
@@ -187,7 +191,7 @@ func ValidateShootUpdate(newShoot, oldShoot *core.Shoot) field.ErrorList {
 		hibernationEnabled = pointer.BoolDeref(newShoot.Spec.Hibernation.Enabled, false)
 	}
 
-	allErrs = append(allErrs, ValidateEncryptionConfigUpdate(newEncryptionConfig, oldEncryptionConfig, sets.New(newShoot.Status.EncryptedResources...), etcdEncryptionKeyRotation, hibernationEnabled, field.NewPath("spec", "kubernetes", "kubeAPIServer", "encryptionConfig"))...)
+	allErrs = append(allErrs, ValidateEncryptionConfigUpdate(newEncryptionConfig, oldEncryptionConfig, newShoot.Status.EncryptionProvider, sets.New(newShoot.Status.EncryptedResources...), etcdEncryptionKeyRotation, hibernationEnabled, field.NewPath("spec", "kubernetes", "kubeAPIServer", "encryptionConfig"))...)
 	// validate version updates only to kubernetes 1.25
 	allErrs = append(allErrs, validateKubernetesVersionUpdate125(newShoot, oldShoot)...)
 	allErrs = append(allErrs, ValidateShoot(newShoot)...)
@@ -267,7 +271,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateProvider(spec.Provider, spec.Kubernetes, spec.Networking, workerless, fldPath.Child("provider"), inTemplate)...)
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Purpose, workerless, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
-	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
+	allErrs = append(allErrs, validateExtensions(spec.Extensions, spec.Kubernetes.KubeAPIServer, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, validateResources(spec.Resources, fldPath.Child("resources"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, isDockerConfigured(spec.Provider.Workers), workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
@@ -281,6 +285,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	if len(spec.CloudProfileName) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("cloudProfileName"), "must specify a cloud profile"))
 	}
+	allErrs = append(allErrs, validateCloudProfileReference(spec.CloudProfile, spec.CloudProfileName, fldPath.Child("cloudProfile"))...)
 	if spec.SecretBindingName != nil && workerless {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretBindingName"), workerlessErrorMsg))
 	} else if len(pointer.StringDeref(spec.SecretBindingName, "")) == 0 && !workerless {
@@ -308,6 +313,30 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	return allErrs
 }
 
+func validateCloudProfileReference(cloudProfile *core.CloudProfileReference, cloudProfileName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cloudProfile == nil {
+		return allErrs
+	}
+
+	if len(cloudProfile.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must specify a cloud profile name"))
+	}
+
+	switch cloudProfile.Kind {
+	case core.CloudProfileReferenceKindCloudProfile:
+		if cloudProfile.Name != cloudProfileName {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), cloudProfile.Name, "must be equal to .spec.cloudProfileName when referencing a CloudProfile"))
+		}
+	case core.CloudProfileReferenceKindNamespacedCloudProfile:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), cloudProfile.Kind, []string{core.CloudProfileReferenceKindCloudProfile, core.CloudProfileReferenceKindNamespacedCloudProfile}))
+	}
+
+	return allErrs
+}
+
 func isDockerConfigured(workers []core.Worker) bool {
 	for _, worker := range workers {
 		if worker.CRI == nil || worker.CRI.Name == core.CRINameDocker {
@@ -582,8 +611,10 @@ func ValidateTotalNodeCountWithPodCIDR(shoot *core.Shoot) field.ErrorList {
 	return allErrs
 }
 
-// ValidateEncryptionConfigUpdate validates the updates to the KubeAPIServer encryption configuration.
-func ValidateEncryptionConfigUpdate(newConfig, oldConfig *core.EncryptionConfig, currentEncryptedResources sets.Set[string], etcdEncryptionKeyRotation *core.ETCDEncryptionKeyRotation, isClusterInHibernation bool, fldPath *field.Path) field.ErrorList {
+// ValidateEncryptionConfigUpdate validates the updates to the KubeAPIServer encryption configuration. The given active
+// provider is the encryption provider which is currently used for the encryption of resources in etcd (nil if the
+// default provider is used).
+func ValidateEncryptionConfigUpdate(newConfig, oldConfig *core.EncryptionConfig, activeProvider *core.EncryptionProvider, currentEncryptedResources sets.Set[string], etcdEncryptionKeyRotation *core.ETCDEncryptionKeyRotation, isClusterInHibernation bool, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs               = field.ErrorList{}
 		oldEncryptedResources = sets.New[string]()
@@ -598,6 +629,20 @@ func ValidateEncryptionConfigUpdate(newConfig, oldConfig *core.EncryptionConfig,
 		newEncryptedResources.Insert(newConfig.Resources...)
 	}
 
+	if !apiequality.Semantic.DeepEqual(encryptionProvider(newConfig), encryptionProvider(oldConfig)) &&
+		etcdEncryptionKeyRotation != nil && etcdEncryptionKeyRotation.Phase != core.RotationCompleted && etcdEncryptionKeyRotation.Phase != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("provider"), fmt.Sprintf("provider cannot be changed when .status.credentials.rotation.etcdEncryptionKey.phase is not %q", string(core.RotationCompleted))))
+	}
+
+	// A change of the encryption provider is only rolled out together with an ETCD encryption key rotation if its type
+	// changes. Changing the configuration of the active provider would take effect immediately without re-encrypting
+	// the resources, e.g., switching to another key could make the existing resources unreadable.
+	if newProvider := encryptionProvider(newConfig); newProvider != nil && activeProvider != nil &&
+		!apiequality.Semantic.DeepEqual(newProvider, encryptionProvider(oldConfig)) &&
+		newProvider.Type == activeProvider.Type && !apiequality.Semantic.DeepEqual(newProvider.ProviderConfig, activeProvider.ProviderConfig) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("provider", "providerConfig"), "provider config of the active encryption provider cannot be changed, change the provider type instead"))
+	}
+
 	if !newEncryptedResources.Equal(oldEncryptedResources) {
 		if etcdEncryptionKeyRotation != nil && etcdEncryptionKeyRotation.Phase != core.RotationCompleted && etcdEncryptionKeyRotation.Phase != "" {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("resources"), fmt.Sprintf("resources cannot be changed when .status.credentials.rotation.etcdEncryptionKey.phase is not %q", string(core.RotationCompleted))))
@@ -615,6 +660,13 @@ func ValidateEncryptionConfigUpdate(newConfig, oldConfig *core.EncryptionConfig,
 	return allErrs
 }
 
+func encryptionProvider(config *core.EncryptionConfig) *core.EncryptionProvider {
+	if config == nil {
+		return nil
+	}
+	return config.Provider
+}
+
 func validateKubeControllerManagerUpdate(newConfig, oldConfig *core.KubeControllerManagerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -719,7 +771,18 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Type, oldNetworking.Type, fldPath.Child("type"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.IPFamilies, oldNetworking.IPFamilies, fldPath.Child("ipFamilies"))...)
+	if !isSecondaryIPFamilyAdded(newNetworking.IPFamilies, oldNetworking.IPFamilies) {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.IPFamilies, oldNetworking.IPFamilies, fldPath.Child("ipFamilies"))...)
+	}
+	if oldNetworking.SecondaryPods != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.SecondaryPods, oldNetworking.SecondaryPods, fldPath.Child("secondaryPods"))...)
+	}
+	if oldNetworking.SecondaryNodes != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.SecondaryNodes, oldNetworking.SecondaryNodes, fldPath.Child("secondaryNodes"))...)
+	}
+	if oldNetworking.SecondaryServices != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.SecondaryServices, oldNetworking.SecondaryServices, fldPath.Child("secondaryServices"))...)
+	}
 	if oldNetworking.Pods != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Pods, oldNetworking.Pods, fldPath.Child("pods"))...)
 	}
@@ -733,6 +796,14 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	return allErrs
 }
 
+// isSecondaryIPFamilyAdded returns true if a second IP family is added to single-stack networking, i.e., the primary IP
+// family is kept.
+func isSecondaryIPFamilyAdded(newIPFamilies, oldIPFamilies []core.IPFamily) bool {
+	return len(oldIPFamilies) <= 1 &&
+		core.IsDualStack(newIPFamilies) &&
+		newIPFamilies[0] == helper.DeterminePrimaryIPFamily(oldIPFamilies)
+}
+
 // validateWorkerGroupAndControlPlaneKubernetesVersion ensures that new version is newer than old version and does not skip two minor
 func validateWorkerGroupAndControlPlaneKubernetesVersion(controlPlaneVersion, workerGroupVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -834,9 +905,15 @@ func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
-func validateExtensions(extensions []core.Extension, fldPath *field.Path) field.ErrorList {
+func validateExtensions(extensions []core.Extension, kubeAPIServer *core.KubeAPIServerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	types := sets.Set[string]{}
+
+	var encryptionProviderType string
+	if kubeAPIServer != nil && kubeAPIServer.EncryptionConfig != nil && kubeAPIServer.EncryptionConfig.Provider != nil && kubeAPIServer.EncryptionConfig.Provider.Type != core.EncryptionProviderTypeAESCBC {
+		encryptionProviderType = kubeAPIServer.EncryptionConfig.Provider.Type
+	}
+
 	for i, extension := range extensions {
 		if extension.Type == "" {
 			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("type"), "field must not be empty"))
@@ -845,6 +922,10 @@ func validateExtensions(extensions []core.Extension, fldPath *field.Path) field.
 		} else {
 			types.Insert(extension.Type)
 		}
+
+		if len(encryptionProviderType) > 0 && extension.Type == encryptionProviderType {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("type"), "extension is configured as encryption provider in spec.kubernetes.kubeAPIServer.encryptionConfig.provider and managed by Gardener"))
+		}
 	}
 	return allErrs
 }
@@ -963,6 +1044,12 @@ func validateNetworking(networking *core.Networking, workerless bool, fldPath *f
 		if networking.Nodes != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodes"), workerlessErrorMsg))
 		}
+		if networking.SecondaryPods != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondaryPods"), workerlessErrorMsg))
+		}
+		if networking.SecondaryNodes != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondaryNodes"), workerlessErrorMsg))
+		}
 	} else {
 		if networking == nil {
 			allErrs = append(allErrs, field.Required(fldPath, "networking should not be nil for a Shoot with workers"))
@@ -1008,6 +1095,56 @@ func validateNetworking(networking *core.Networking, workerless bool, fldPath *f
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(path, cidr.GetCIDR())...)
 	}
 
+	allErrs = append(allErrs, validateSecondaryNetworks(networking, workerless, fldPath)...)
+
+	return allErrs
+}
+
+func validateSecondaryNetworks(networking *core.Networking, workerless bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	secondaryNetworks := []struct {
+		name     string
+		cidr     *string
+		required bool
+	}{
+		{name: "secondaryNodes", cidr: networking.SecondaryNodes},
+		{name: "secondaryPods", cidr: networking.SecondaryPods, required: !workerless},
+		{name: "secondaryServices", cidr: networking.SecondaryServices, required: true},
+	}
+
+	if !core.IsDualStack(networking.IPFamilies) {
+		for _, network := range secondaryNetworks {
+			if network.cidr != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child(network.name), "secondary networks can only be configured for dual-stack networking"))
+			}
+		}
+		return allErrs
+	}
+
+	if !features.DefaultFeatureGate.Enabled(features.DualStackNetworking) {
+		return append(allErrs, field.Invalid(fldPath.Child("ipFamilies"), networking.IPFamilies, "dual-stack networking is not supported"))
+	}
+
+	secondaryIPFamily := networking.IPFamilies[1]
+
+	for _, network := range secondaryNetworks {
+		path := fldPath.Child(network.name)
+
+		if network.cidr == nil {
+			if network.required {
+				allErrs = append(allErrs, field.Required(path, "secondary network is required for dual-stack networking"))
+			}
+			continue
+		}
+
+		cidr := cidrvalidation.NewCIDR(*network.cidr, path)
+
+		allErrs = append(allErrs, cidr.ValidateParse()...)
+		allErrs = append(allErrs, cidr.ValidateIPFamily(string(secondaryIPFamily))...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(path, cidr.GetCIDR())...)
+	}
+
 	return allErrs
 }
 
@@ -1116,6 +1253,26 @@ func validateEncryptionConfig(encryptionConfig *core.EncryptionConfig, version s
 		}
 	}
 
+	if provider := encryptionConfig.Provider; provider != nil {
+		providerPath := fldPath.Child("encryptionConfig", "provider")
+
+		if len(provider.Type) == 0 {
+			allErrs = append(allErrs, field.Required(providerPath.Child("type"), "must provide a provider type"))
+		} else if provider.Type == core.EncryptionProviderTypeAESCBC {
+			if provider.ProviderConfig != nil {
+				allErrs = append(allErrs, field.Forbidden(providerPath.Child("providerConfig"), fmt.Sprintf("provider config is not supported for provider type %q", core.EncryptionProviderTypeAESCBC)))
+			}
+		} else {
+			for _, msg := range validation.IsDNS1123Label(provider.Type) {
+				allErrs = append(allErrs, field.Invalid(providerPath.Child("type"), provider.Type, msg))
+			}
+
+			if k8sLess127, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.27"); k8sLess127 {
+				allErrs = append(allErrs, field.Forbidden(providerPath.Child("type"), "KMS v2 encryption providers are only supported for Kubernetes versions >= 1.27"))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -1313,6 +1470,92 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 		}
 	}
 
+	if structuredAuthentication := kubeAPIServer.StructuredAuthentication; structuredAuthentication != nil {
+		structuredAuthenticationPath := fldPath.Child("structuredAuthentication")
+
+		if k8sLess129, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.29"); k8sLess129 {
+			allErrs = append(allErrs, field.Forbidden(structuredAuthenticationPath, "is available for Kubernetes versions >= v1.29"))
+		} else if k8sLess130, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.30"); k8sLess130 && !kubeAPIServer.FeatureGates["StructuredAuthenticationConfiguration"] {
+			allErrs = append(allErrs, field.Forbidden(structuredAuthenticationPath, "requires feature gate StructuredAuthenticationConfiguration to be enabled"))
+		}
+
+		if kubeAPIServer.OIDCConfig != nil {
+			allErrs = append(allErrs, field.Forbidden(structuredAuthenticationPath, "is incompatible with oidcConfig"))
+		}
+
+		if len(structuredAuthentication.ConfigMapName) == 0 {
+			allErrs = append(allErrs, field.Required(structuredAuthenticationPath.Child("configMapName"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(structuredAuthentication.ConfigMapName, false) {
+				allErrs = append(allErrs, field.Invalid(structuredAuthenticationPath.Child("configMapName"), structuredAuthentication.ConfigMapName, msg))
+			}
+		}
+	}
+
+	if authorization := kubeAPIServer.Authorization; authorization != nil && authorization.Webhook != nil {
+		webhookPath := fldPath.Child("authorization", "webhook")
+
+		if len(authorization.Webhook.KubeconfigSecretName) == 0 {
+			allErrs = append(allErrs, field.Required(webhookPath.Child("kubeconfigSecretName"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(authorization.Webhook.KubeconfigSecretName, false) {
+				allErrs = append(allErrs, field.Invalid(webhookPath.Child("kubeconfigSecretName"), authorization.Webhook.KubeconfigSecretName, msg))
+			}
+		}
+		if ttl := authorization.Webhook.CacheAuthorizedTTL; ttl != nil && ttl.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("cacheAuthorizedTTL"), *ttl, "can not be negative"))
+		}
+		if ttl := authorization.Webhook.CacheUnauthorizedTTL; ttl != nil && ttl.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("cacheUnauthorizedTTL"), *ttl, "can not be negative"))
+		}
+		if v := authorization.Webhook.Version; v != nil && !availableAuthorizationWebhookVersions.Has(*v) {
+			allErrs = append(allErrs, field.NotSupported(webhookPath.Child("version"), *v, sets.List(availableAuthorizationWebhookVersions)))
+		}
+	}
+
+	if structuredAuthorization := kubeAPIServer.StructuredAuthorization; structuredAuthorization != nil {
+		structuredAuthorizationPath := fldPath.Child("structuredAuthorization")
+
+		if k8sLess129, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.29"); k8sLess129 {
+			allErrs = append(allErrs, field.Forbidden(structuredAuthorizationPath, "is available for Kubernetes versions >= v1.29"))
+		} else if k8sLess130, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.30"); k8sLess130 && !kubeAPIServer.FeatureGates["StructuredAuthorizationConfiguration"] {
+			allErrs = append(allErrs, field.Forbidden(structuredAuthorizationPath, "requires feature gate StructuredAuthorizationConfiguration to be enabled"))
+		}
+
+		if kubeAPIServer.Authorization != nil && kubeAPIServer.Authorization.Webhook != nil {
+			allErrs = append(allErrs, field.Forbidden(structuredAuthorizationPath, "is incompatible with authorization.webhook"))
+		}
+
+		if len(structuredAuthorization.ConfigMapName) == 0 {
+			allErrs = append(allErrs, field.Required(structuredAuthorizationPath.Child("configMapName"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(structuredAuthorization.ConfigMapName, false) {
+				allErrs = append(allErrs, field.Invalid(structuredAuthorizationPath.Child("configMapName"), structuredAuthorization.ConfigMapName, msg))
+			}
+		}
+
+		authorizerNames := sets.New[string]()
+		for i, kubeconfig := range structuredAuthorization.Kubeconfigs {
+			idxPath := structuredAuthorizationPath.Child("kubeconfigs").Index(i)
+
+			if len(kubeconfig.AuthorizerName) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("authorizerName"), "must provide an authorizer name"))
+			} else if authorizerNames.Has(kubeconfig.AuthorizerName) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("authorizerName"), kubeconfig.AuthorizerName))
+			} else {
+				authorizerNames.Insert(kubeconfig.AuthorizerName)
+			}
+
+			if len(kubeconfig.SecretName) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("secretName"), "must provide a secret name"))
+			} else {
+				for _, msg := range apivalidation.NameIsDNSSubdomain(kubeconfig.SecretName, false) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("secretName"), kubeconfig.SecretName, msg))
+				}
+			}
+		}
+	}
+
 	allErrs = append(allErrs, admissionpluginsvalidation.ValidateAdmissionPlugins(kubeAPIServer.AdmissionPlugins, version, fldPath.Child("admissionPlugins"))...)
 	allErrs = append(allErrs, apigroupsvalidation.ValidateAPIGroupVersions(kubeAPIServer.RuntimeConfig, version, workerless, fldPath.Child("runtimeConfig"))...)
 
@@ -1518,20 +1761,83 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	}
 
 	if maintenance.TimeWindow != nil {
-		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
-		} else {
-			duration := maintenanceTimeWindow.Duration()
-			if duration > core.MaintenanceTimeWindowDurationMaximum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
-				return allErrs
-			}
-			if duration < core.MaintenanceTimeWindowDurationMinimum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
-				return allErrs
-			}
+		allErrs = append(allErrs, validateMaintenanceTimeRange(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End, maintenance.TimeWindow, fldPath.Child("timeWindow"))...)
+	}
+
+	allErrs = append(allErrs, validateMaintenanceWeekdays(maintenance.TimeWindowWeekdays, fldPath.Child("timeWindowWeekdays"))...)
+
+	for i, additionalRange := range maintenance.AdditionalTimeRanges {
+		idxPath := fldPath.Child("additionalTimeRanges").Index(i)
+		allErrs = append(allErrs, validateMaintenanceTimeRange(additionalRange.Begin, additionalRange.End, additionalRange, idxPath)...)
+		allErrs = append(allErrs, validateMaintenanceWeekdays(additionalRange.Weekdays, idxPath.Child("weekdays"))...)
+	}
+
+	for i, blackout := range maintenance.Blackouts {
+		if !blackout.End.After(blackout.Begin.Time) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("blackouts").Index(i).Child("end"), blackout.End, "end must be after begin"))
+		}
+	}
+
+	if rotation := maintenance.CredentialsRotation; rotation != nil {
+		rotationPath := fldPath.Child("credentialsRotation")
+		allErrs = append(allErrs, validateCredentialsRotationPolicy(rotation.CertificateAuthorities, rotationPath.Child("certificateAuthorities"))...)
+		allErrs = append(allErrs, validateCredentialsRotationPolicy(rotation.ServiceAccountKey, rotationPath.Child("serviceAccountKey"))...)
+		allErrs = append(allErrs, validateCredentialsRotationPolicy(rotation.ETCDEncryptionKey, rotationPath.Child("etcdEncryptionKey"))...)
+		allErrs = append(allErrs, validateCredentialsRotationPolicy(rotation.SSHKeypair, rotationPath.Child("sshKeypair"))...)
+		allErrs = append(allErrs, validateCredentialsRotationPolicy(rotation.Observability, rotationPath.Child("observability"))...)
+
+		if workerless && rotation.SSHKeypair != nil {
+			allErrs = append(allErrs, field.Forbidden(rotationPath.Child("sshKeypair"), workerlessErrorMsg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCredentialsRotationPolicy(policy *core.CredentialsRotationPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	if policy.Interval.Duration < core.CredentialsRotationIntervalMinimum {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), policy.Interval.Duration.String(), fmt.Sprintf("interval must not be smaller than %s", core.CredentialsRotationIntervalMinimum)))
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceTimeRange(begin, end string, value interface{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(begin, end)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin/end"), value, err.Error()))
+	} else {
+		duration := maintenanceTimeWindow.Duration()
+		if duration > core.MaintenanceTimeWindowDurationMaximum {
+			allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
+		} else if duration < core.MaintenanceTimeWindowDurationMinimum {
+			allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceWeekdays(weekdays []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seenWeekdays := sets.New[string]()
+	for i, weekday := range weekdays {
+		idxPath := fldPath.Index(i)
+		if _, err := timewindow.ParseWeekday(weekday); err != nil {
+			allErrs = append(allErrs, field.NotSupported(idxPath, weekday, []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}))
+		} else if seenWeekdays.Has(weekday) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, weekday))
 		}
+		seenWeekdays.Insert(weekday)
 	}
 
 	return allErrs
@@ -735,6 +735,46 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Context("cloud profile reference", func() {
+			It("should allow referencing the CloudProfile of cloudProfileName", func() {
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "CloudProfile", Name: "aws-profile"}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should allow referencing a NamespacedCloudProfile", func() {
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "aws-profile-custom"}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid referencing a CloudProfile different from cloudProfileName", func() {
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "CloudProfile", Name: "other-profile"}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloudProfile.name"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported kinds and empty names", func() {
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "Foo"}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.cloudProfile.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloudProfile.kind"),
+					})),
+				))
+			})
+		})
+
 		It("should forbid adding secretBindingName in case of workerless shoot", func() {
 			shoot.Spec.Provider.Workers = nil
 			shoot.Spec.SecretBindingName = pointer.String("foo")
@@ -1725,6 +1765,219 @@ var _ = Describe("Shoot Validation Tests", func() {
 				)
 			})
 
+			Context("structured authentication", func() {
+				BeforeEach(func() {
+					shoot.Spec.Kubernetes.Version = "1.29.0"
+					shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
+					shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = map[string]bool{"StructuredAuthenticationConfiguration": true}
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication = &core.StructuredAuthentication{
+						ConfigMapName: "my-authentication-config",
+					}
+				})
+
+				It("should allow valid structured authentication configuration", func() {
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid structured authentication for Kubernetes versions < 1.29", func() {
+					shoot.Spec.Kubernetes.Version = "1.28.4"
+					shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = nil
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication"),
+					}))))
+				})
+
+				It("should forbid structured authentication if the feature gate is not enabled", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = nil
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication"),
+						"Detail": Equal("requires feature gate StructuredAuthenticationConfiguration to be enabled"),
+					}))))
+				})
+
+				It("should forbid combining structured authentication with OIDC configuration", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = &core.OIDCConfig{}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication"),
+						"Detail": Equal("is incompatible with oidcConfig"),
+					}))))
+				})
+
+				It("should forbid empty or invalid ConfigMap names", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication.ConfigMapName = ""
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.configMapName"),
+					}))))
+
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication.ConfigMapName = "Foo_Bar"
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.configMapName"),
+					}))))
+				})
+			})
+
+			Context("authorization webhook", func() {
+				BeforeEach(func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization = &core.Authorization{
+						Webhook: &core.AuthorizationWebhook{
+							KubeconfigSecretName: "my-authorization-webhook",
+							CacheAuthorizedTTL:   &metav1.Duration{Duration: time.Minute},
+							CacheUnauthorizedTTL: &metav1.Duration{Duration: 30 * time.Second},
+							Version:              pointer.String("v1"),
+						},
+					}
+				})
+
+				It("should allow valid authorization webhook configuration", func() {
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid empty or invalid secret names", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization.Webhook.KubeconfigSecretName = ""
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authorization.webhook.kubeconfigSecretName"),
+					}))))
+
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization.Webhook.KubeconfigSecretName = "Foo_Bar"
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authorization.webhook.kubeconfigSecretName"),
+					}))))
+				})
+
+				It("should forbid negative cache TTLs", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization.Webhook.CacheAuthorizedTTL = &metav1.Duration{Duration: -time.Second}
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization.Webhook.CacheUnauthorizedTTL = &metav1.Duration{Duration: -time.Second}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.authorization.webhook.cacheAuthorizedTTL"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.authorization.webhook.cacheUnauthorizedTTL"),
+						})),
+					))
+				})
+
+				It("should forbid unsupported versions", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization.Webhook.Version = pointer.String("v1alpha1")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authorization.webhook.version"),
+					}))))
+				})
+			})
+
+			Context("structured authorization", func() {
+				BeforeEach(func() {
+					shoot.Spec.Kubernetes.Version = "1.29.0"
+					shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = map[string]bool{"StructuredAuthorizationConfiguration": true}
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization = &core.StructuredAuthorization{
+						ConfigMapName: "my-authorization-config",
+						Kubeconfigs: []core.AuthorizerKubeconfigReference{
+							{AuthorizerName: "foo", SecretName: "my-foo-kubeconfig"},
+							{AuthorizerName: "bar", SecretName: "my-bar-kubeconfig"},
+						},
+					}
+				})
+
+				It("should allow valid structured authorization configuration", func() {
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid structured authorization for Kubernetes versions < 1.29", func() {
+					shoot.Spec.Kubernetes.Version = "1.28.4"
+					shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = nil
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization"),
+					}))))
+				})
+
+				It("should forbid structured authorization if the feature gate is not enabled", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = nil
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization"),
+						"Detail": Equal("requires feature gate StructuredAuthorizationConfiguration to be enabled"),
+					}))))
+				})
+
+				It("should allow structured authorization without the feature gate for Kubernetes versions >= 1.30", func() {
+					shoot.Spec.Kubernetes.Version = "1.30.0"
+					shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = nil
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid combining structured authorization with an authorization webhook", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization = &core.Authorization{
+						Webhook: &core.AuthorizationWebhook{KubeconfigSecretName: "my-authorization-webhook"},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization"),
+						"Detail": Equal("is incompatible with authorization.webhook"),
+					}))))
+				})
+
+				It("should forbid empty or invalid ConfigMap names", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization.ConfigMapName = ""
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization.configMapName"),
+					}))))
+
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization.ConfigMapName = "Foo_Bar"
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization.configMapName"),
+					}))))
+				})
+
+				It("should forbid invalid kubeconfig references", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization.Kubeconfigs = []core.AuthorizerKubeconfigReference{
+						{AuthorizerName: "", SecretName: ""},
+						{AuthorizerName: "foo", SecretName: "Foo_Bar"},
+						{AuthorizerName: "foo", SecretName: "my-foo-kubeconfig"},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization.kubeconfigs[0].authorizerName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization.kubeconfigs[0].secretName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization.kubeconfigs[1].secretName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthorization.kubeconfigs[2].authorizerName"),
+						})),
+					))
+				})
+			})
+
 			Context("admission plugin validation", func() {
 				It("should allow not specifying admission plugins", func() {
 					shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = nil
@@ -2001,6 +2254,182 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 					Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
 				})
+
+				Context("provider", func() {
+					It("should allow specifying the aescbc provider", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "aescbc"},
+						}
+
+						Expect(ValidateShoot(shoot)).To(BeEmpty())
+					})
+
+					It("should allow specifying a KMS provider", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{
+								Type:           "kms-foo",
+								ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"keyID":"foo"}`)},
+							},
+						}
+
+						Expect(ValidateShoot(shoot)).To(BeEmpty())
+					})
+
+					It("should deny an empty provider type", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{},
+						}
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeRequired),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.provider.type"),
+							})),
+						))
+					})
+
+					It("should deny an invalid provider type", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "KMS_Foo"},
+						}
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.provider.type"),
+							})),
+						))
+					})
+
+					It("should deny a provider config for the aescbc provider", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{
+								Type:           "aescbc",
+								ProviderConfig: &runtime.RawExtension{Raw: []byte(`{}`)},
+							},
+						}
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.provider.providerConfig"),
+							})),
+						))
+					})
+
+					It("should deny a KMS provider for Kubernetes versions < 1.27", func() {
+						shoot.Spec.Kubernetes.Version = "1.26.5"
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "kms-foo"},
+						}
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeForbidden),
+								"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.provider.type"),
+								"Detail": Equal("KMS v2 encryption providers are only supported for Kubernetes versions >= 1.27"),
+							})),
+						))
+					})
+
+					It("should deny configuring the extension of the KMS provider explicitly", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "kms-foo"},
+						}
+						shoot.Spec.Extensions = []core.Extension{{Type: "kms-foo"}}
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.extensions[0].type"),
+							})),
+						))
+					})
+
+					It("should deny changing the provider during ETCD Encryption Key rotation", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "kms-foo"},
+						}
+						newShoot.Status.Credentials = &core.ShootCredentials{
+							Rotation: &core.ShootCredentialsRotation{
+								ETCDEncryptionKey: &core.ETCDEncryptionKeyRotation{
+									Phase: core.RotationPrepared,
+								},
+							},
+						}
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeForbidden),
+								"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.provider"),
+								"Detail": Equal("provider cannot be changed when .status.credentials.rotation.etcdEncryptionKey.phase is not \"Completed\""),
+							})),
+						))
+					})
+
+					It("should deny changing the config of the active provider", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "kms-foo", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"keyID":"foo"}`)}},
+						}
+						shoot.Status.EncryptionProvider = shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.Provider.DeepCopy()
+
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.Provider.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"keyID":"bar"}`)}
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.provider.providerConfig"),
+							})),
+						))
+					})
+
+					It("should deny switching back to the active provider with another config", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "aescbc"},
+						}
+						shoot.Status.EncryptionProvider = &core.EncryptionProvider{Type: "kms-foo", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"keyID":"foo"}`)}}
+
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.Provider = &core.EncryptionProvider{Type: "kms-foo", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"keyID":"bar"}`)}}
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.provider.providerConfig"),
+							})),
+						))
+					})
+
+					It("should allow changing the config of a provider which is not active yet", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "kms-foo", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"keyID":"foo"}`)}},
+						}
+						shoot.Status.EncryptionProvider = &core.EncryptionProvider{Type: "aescbc"}
+
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.Provider.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"keyID":"bar"}`)}
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+					})
+
+					It("should allow changing the provider if ETCD Encryption Key rotation is in phase Completed", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "kms-foo"},
+						}
+						newShoot.Status.Credentials = &core.ShootCredentials{
+							Rotation: &core.ShootCredentialsRotation{
+								ETCDEncryptionKey: &core.ETCDEncryptionKeyRotation{
+									Phase: core.RotationCompleted,
+								},
+							},
+						}
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+					})
+				})
 			})
 
 			Context("WatchCacheSizes validation", func() {
@@ -3619,6 +4048,140 @@ var _ = Describe("Shoot Validation Tests", func() {
 				})
 			})
 
+			Context("dual-stack", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.DualStackNetworking, true))
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					shoot.Spec.Networking.Nodes = pointer.String("10.250.0.0/16")
+					shoot.Spec.Networking.Services = pointer.String("100.64.0.0/13")
+					shoot.Spec.Networking.Pods = pointer.String("100.96.0.0/11")
+					shoot.Spec.Networking.SecondaryNodes = pointer.String("2001:db8:2::/48")
+					shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:3::/108")
+					shoot.Spec.Networking.SecondaryPods = pointer.String("2001:db8:1::/48")
+				})
+
+				It("should allow valid networking configuration", func() {
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid dual-stack networking if the feature gate is disabled", func() {
+					DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.DualStackNetworking, false))
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.ipFamilies"),
+						"Detail": Equal("dual-stack networking is not supported"),
+					}))
+				})
+
+				It("should require the secondary pod and service networks", func() {
+					shoot.Spec.Networking.SecondaryNodes = nil
+					shoot.Spec.Networking.SecondaryServices = nil
+					shoot.Spec.Networking.SecondaryPods = nil
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.networking.secondaryPods"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.networking.secondaryServices"),
+					}))
+				})
+
+				It("should forbid secondary networks of the primary IP family", func() {
+					shoot.Spec.Networking.SecondaryNodes = pointer.String("10.251.0.0/16")
+					shoot.Spec.Networking.SecondaryServices = pointer.String("100.72.0.0/13")
+					shoot.Spec.Networking.SecondaryPods = pointer.String("100.128.0.0/11")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.secondaryNodes"),
+						"Detail": ContainSubstring("must be a valid IPv6 address"),
+					}, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.secondaryPods"),
+						"Detail": ContainSubstring("must be a valid IPv6 address"),
+					}, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.secondaryServices"),
+						"Detail": ContainSubstring("must be a valid IPv6 address"),
+					}))
+				})
+
+				It("should forbid non canonical secondary CIDRs", func() {
+					shoot.Spec.Networking.SecondaryPods = pointer.String("2001:db8:1::1/48")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.secondaryPods"),
+						"Detail": Equal("must be valid canonical CIDR"),
+					}))
+				})
+
+				It("should forbid secondary networks for single-stack networking", func() {
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.networking.secondaryNodes"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.networking.secondaryPods"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.networking.secondaryServices"),
+					}))
+				})
+
+				It("should allow adding a second IP family to a single-stack shoot", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+					oldShoot.Spec.Networking.SecondaryNodes = nil
+					oldShoot.Spec.Networking.SecondaryServices = nil
+					oldShoot.Spec.Networking.SecondaryPods = nil
+					newShoot := prepareShootForUpdate(shoot)
+
+					Expect(ValidateShootUpdate(newShoot, oldShoot)).To(BeEmpty())
+				})
+
+				It("should forbid changing the primary IP family when adding a second one", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv6}
+					newShoot := prepareShootForUpdate(shoot)
+
+					Expect(ValidateShootUpdate(newShoot, oldShoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.ipFamilies"),
+					}))))
+				})
+
+				It("should forbid removing the second IP family", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+					newShoot.Spec.Networking.SecondaryNodes = nil
+					newShoot.Spec.Networking.SecondaryServices = nil
+					newShoot.Spec.Networking.SecondaryPods = nil
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.ipFamilies"),
+						"Detail": ContainSubstring(`field is immutable`),
+					}, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.secondaryPods"),
+						"Detail": ContainSubstring(`field is immutable`),
+					}, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.secondaryNodes"),
+						"Detail": ContainSubstring(`field is immutable`),
+					}, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.secondaryServices"),
+						"Detail": ContainSubstring(`field is immutable`),
+					}))
+				})
+			})
+
 			It("should fail updating immutable fields", func() {
 				shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
 
@@ -3681,6 +4244,76 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should allow valid weekdays, additional ranges and blackouts", func() {
+				shoot.Spec.Maintenance.TimeWindowWeekdays = []string{"Mon", "Wed"}
+				shoot.Spec.Maintenance.AdditionalTimeRanges = []core.MaintenanceTimeRange{{Begin: "020000+0000", End: "040000+0000", Weekdays: []string{"Sat", "Sun"}}}
+				shoot.Spec.Maintenance.Blackouts = []core.MaintenanceBlackout{{
+					Begin:  metav1.Date(2023, 12, 20, 0, 0, 0, 0, time.UTC),
+					End:    metav1.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+					Reason: pointer.String("year-end freeze"),
+				}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unknown and duplicate weekdays", func() {
+				shoot.Spec.Maintenance.TimeWindowWeekdays = []string{"Mon", "Monday", "Mon"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.maintenance.timeWindowWeekdays[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.maintenance.timeWindowWeekdays[2]"),
+					})),
+				))
+			})
+
+			It("should forbid invalid additional ranges", func() {
+				shoot.Spec.Maintenance.AdditionalTimeRanges = []core.MaintenanceTimeRange{
+					{Begin: "invalidformat", End: "invalidformat"},
+					{Begin: "225000+0100", End: "231000+0100"},
+					{Begin: "010000+0000", End: "020000+0000", Weekdays: []string{"Foo"}},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.additionalTimeRanges[0].begin/end"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.additionalTimeRanges[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.maintenance.additionalTimeRanges[2].weekdays[0]"),
+					})),
+				))
+			})
+
+			It("should forbid blackouts which do not end after they begin", func() {
+				shoot.Spec.Maintenance.Blackouts = []core.MaintenanceBlackout{{
+					Begin: metav1.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+					End:   metav1.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenance.blackouts[0].end"),
+				}))))
+			})
+
 			It("should not allow setting machineImageVersion for autoUpdate if it's a workerless Shoot", func() {
 				shoot.Spec.Provider.Workers = nil
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(true)
@@ -3693,6 +4326,53 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Detail": ContainSubstring("this field should not be set for workerless Shoot cluster"),
 				}))))
 			})
+
+			It("should allow valid credentials rotation policies", func() {
+				shoot.Spec.Maintenance.CredentialsRotation = &core.MaintenanceCredentialsRotation{
+					CertificateAuthorities: &core.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * 24 * time.Hour}},
+					ServiceAccountKey:      &core.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * 24 * time.Hour}},
+					ETCDEncryptionKey:      &core.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 365 * 24 * time.Hour}},
+					SSHKeypair:             &core.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 30 * 24 * time.Hour}},
+					Observability:          &core.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 24 * time.Hour}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid credentials rotation intervals smaller than the minimum", func() {
+				shoot.Spec.Maintenance.CredentialsRotation = &core.MaintenanceCredentialsRotation{
+					CertificateAuthorities: &core.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: time.Hour}},
+					Observability:          &core.CredentialsRotationPolicy{},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.credentialsRotation.certificateAuthorities.interval"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.credentialsRotation.observability.interval"),
+					})),
+				))
+			})
+
+			It("should not allow an ssh-keypair rotation policy if it's a workerless Shoot", func() {
+				shoot.Spec.Provider.Workers = nil
+				shoot.Spec.Maintenance.CredentialsRotation = &core.MaintenanceCredentialsRotation{
+					SSHKeypair: &core.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 30 * 24 * time.Hour}},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ContainElements(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.maintenance.credentialsRotation.sshKeypair"),
+					"Detail": ContainSubstring("this field should not be set for workerless Shoot cluster"),
+				}))))
+			})
 		})
 
 		It("should forbid updating the spec for shoots with deletion timestamp", func() {
@@ -75,10 +75,20 @@ type NetworkSpec struct {
 	PodCIDR string `json:"podCIDR"`
 	// ServiceCIDR defines the CIDR that will be used for services. This field is immutable.
 	ServiceCIDR string `json:"serviceCIDR"`
-	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
+	// IPFamilies specifies the IP protocol versions to use for shoot networking. The first IP family is the primary
+	// one, PodCIDR and ServiceCIDR belong to it. This field is immutable, however, a second IP family can be added to
+	// single-stack networks.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md
 	// +optional
 	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
+	// SecondaryPodCIDR defines the CIDR that will be used for pods of the secondary IP family in case of dual-stack
+	// networking. This field is immutable once it is set.
+	// +optional
+	SecondaryPodCIDR *string `json:"secondaryPodCIDR,omitempty"`
+	// SecondaryServiceCIDR defines the CIDR that will be used for services of the secondary IP family in case of
+	// dual-stack networking. This field is immutable once it is set.
+	// +optional
+	SecondaryServiceCIDR *string `json:"secondaryServiceCIDR,omitempty"`
 }
 
 // NetworkStatus is the status for an Network resource.
@@ -1335,6 +1335,16 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = make([]IPFamily, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecondaryPodCIDR != nil {
+		in, out := &in.SecondaryPodCIDR, &out.SecondaryPodCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryServiceCIDR != nil {
+		in, out := &in.SecondaryServiceCIDR, &out.SecondaryServiceCIDR
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -83,6 +83,34 @@ func ValidateNetworkSpec(spec *extensionsv1alpha1.NetworkSpec, fldPath *field.Pa
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIPFamily(cidrs, string(primaryIPFamily))...)
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(cidrs, false)...)
 
+	var secondaryCIDRs []cidrvalidation.CIDR
+
+	if len(spec.IPFamilies) < 2 {
+		if spec.SecondaryPodCIDR != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondaryPodCIDR"), "secondary CIDRs can only be configured for dual-stack networking"))
+		}
+		if spec.SecondaryServiceCIDR != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondaryServiceCIDR"), "secondary CIDRs can only be configured for dual-stack networking"))
+		}
+		return allErrs
+	}
+
+	if spec.SecondaryPodCIDR == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secondaryPodCIDR"), "field is required for dual-stack networking"))
+	} else {
+		secondaryCIDRs = append(secondaryCIDRs, cidrvalidation.NewCIDR(*spec.SecondaryPodCIDR, fldPath.Child("secondaryPodCIDR")))
+	}
+
+	if spec.SecondaryServiceCIDR == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secondaryServiceCIDR"), "field is required for dual-stack networking"))
+	} else {
+		secondaryCIDRs = append(secondaryCIDRs, cidrvalidation.NewCIDR(*spec.SecondaryServiceCIDR, fldPath.Child("secondaryServiceCIDR")))
+	}
+
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(secondaryCIDRs...)...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIPFamily(secondaryCIDRs, string(spec.IPFamilies[1]))...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(secondaryCIDRs, false)...)
+
 	return allErrs
 }
 
@@ -104,9 +132,16 @@ func ValidateNetworkSpecUpdate(new, old *extensionsv1alpha1.NetworkSpec, deletio
 	// allow upgrades from empty IPFamilies to the default of IPv4
 	// the if condition can be removed once the network extension of all shoots have been updated
 	// TODO: Remove in Gardener 1.87
-	if !(old.IPFamilies == nil && slices.Equal(new.IPFamilies, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})) {
+	if !(old.IPFamilies == nil && slices.Equal(new.IPFamilies, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})) &&
+		!isSecondaryIPFamilyAdded(new.IPFamilies, old.IPFamilies) {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.IPFamilies, old.IPFamilies, fldPath.Child("ipFamilies"))...)
 	}
+	if old.SecondaryPodCIDR != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.SecondaryPodCIDR, old.SecondaryPodCIDR, fldPath.Child("secondaryPodCIDR"))...)
+	}
+	if old.SecondaryServiceCIDR != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.SecondaryServiceCIDR, old.SecondaryServiceCIDR, fldPath.Child("secondaryServiceCIDR"))...)
+	}
 
 	return allErrs
 }
@@ -140,10 +175,13 @@ func ValidateIPFamilies(ipFamilies []extensionsv1alpha1.IPFamily, fldPath *field
 		return allErrs
 	}
 
-	// validate: only supported single-stack/dual-stack combinations
-	if len(ipFamilies) > 1 {
-		allErrs = append(allErrs, field.Invalid(fldPath, ipFamilies, "dual-stack networking is not supported"))
-	}
-
 	return allErrs
 }
+
+// isSecondaryIPFamilyAdded returns true if a second IP family is added to single-stack networking, i.e., the primary IP
+// family is kept.
+func isSecondaryIPFamilyAdded(newIPFamilies, oldIPFamilies []extensionsv1alpha1.IPFamily) bool {
+	return len(oldIPFamilies) <= 1 &&
+		len(newIPFamilies) == 2 &&
+		newIPFamilies[0] == extensionsv1alpha1helper.DeterminePrimaryIPFamily(oldIPFamilies)
+}
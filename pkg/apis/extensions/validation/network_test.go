@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
@@ -184,9 +185,87 @@ var _ = Describe("Network validation tests", func() {
 				}))))
 			})
 		})
+
+		Context("dual-stack", func() {
+			BeforeEach(func() {
+				network.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+				network.Spec.SecondaryPodCIDR = pointer.String("2001:db8:1::/48")
+				network.Spec.SecondaryServiceCIDR = pointer.String("2001:db8:3::/108")
+			})
+
+			It("should allow valid network resources", func() {
+				Expect(ValidateNetwork(network)).To(BeEmpty())
+			})
+
+			It("should require the secondary CIDRs", func() {
+				network.Spec.SecondaryPodCIDR = nil
+				network.Spec.SecondaryServiceCIDR = nil
+
+				Expect(ValidateNetwork(network)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.secondaryPodCIDR"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.secondaryServiceCIDR"),
+				}))))
+			})
+
+			It("should forbid secondary CIDRs of the primary IP family", func() {
+				network.Spec.SecondaryPodCIDR = pointer.String("10.0.0.0/16")
+
+				Expect(ValidateNetwork(network)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.secondaryPodCIDR"),
+					"Detail": Equal("must be a valid IPv6 address"),
+				}))))
+			})
+
+			It("should forbid secondary CIDRs for single-stack networks", func() {
+				network.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+
+				Expect(ValidateNetwork(network)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.secondaryPodCIDR"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.secondaryServiceCIDR"),
+				}))))
+			})
+		})
 	})
 
 	Describe("#ValidateNetworkUpdate", func() {
+		It("should allow adding a second IP family", func() {
+			network.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+			newNetwork := prepareNetworkForUpdate(network)
+			newNetwork.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+			newNetwork.Spec.SecondaryPodCIDR = pointer.String("2001:db8:1::/48")
+			newNetwork.Spec.SecondaryServiceCIDR = pointer.String("2001:db8:3::/108")
+
+			Expect(ValidateNetworkUpdate(newNetwork, network)).To(BeEmpty())
+		})
+
+		It("should prevent removing the second IP family", func() {
+			network.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+			network.Spec.SecondaryPodCIDR = pointer.String("2001:db8:1::/48")
+			network.Spec.SecondaryServiceCIDR = pointer.String("2001:db8:3::/108")
+			newNetwork := prepareNetworkForUpdate(network)
+			newNetwork.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+			newNetwork.Spec.SecondaryPodCIDR = nil
+			newNetwork.Spec.SecondaryServiceCIDR = nil
+
+			Expect(ValidateNetworkUpdate(newNetwork, network)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ipFamilies"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.secondaryPodCIDR"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.secondaryServiceCIDR"),
+			}))))
+		})
+
 		It("should prevent updating anything if deletion time stamp is set", func() {
 			now := metav1.Now()
 			network.DeletionTimestamp = &now
@@ -293,17 +372,9 @@ var _ = Describe("Network validation tests", func() {
 			))
 		})
 
-		It("should deny dual-stack IP families", func() {
-			ipFamilies := []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
-			errorList := ValidateIPFamilies(ipFamilies, fldPath)
-			Expect(errorList).To(ConsistOf(
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":     Equal(field.ErrorTypeInvalid),
-					"Field":    Equal(fldPath.String()),
-					"BadValue": Equal(ipFamilies),
-					"Detail":   Equal("dual-stack networking is not supported"),
-				})),
-			))
+		It("should allow dual-stack IP families", func() {
+			errorList := ValidateIPFamilies([]extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}, fldPath)
+			Expect(errorList).To(BeEmpty())
 		})
 
 		It("should allow IPv4 single-stack", func() {
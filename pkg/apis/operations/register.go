@@ -47,6 +47,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&BastionExtensionRequest{},
 	)
 	return nil
 }
@@ -60,6 +60,10 @@ type BastionSpec struct {
 	SSHPublicKey string
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy
+	// SessionDuration is the requested duration of the bastion session. If it is set, the ExpirationTimestamp is
+	// not advanced by heartbeats anymore, but the session has to be extended explicitly via the `extend`
+	// subresource. This field is immutable.
+	SessionDuration *metav1.Duration
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -84,4 +88,61 @@ type BastionStatus struct {
 	// ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the
 	// Bastion's generation, which is updated on mutation by the API Server.
 	ObservedGeneration *int64
+	// Sessions is the history of actions which were performed on the session of the Bastion.
+	Sessions []BastionSession
+	// RecordedSessions is the number of entries in Sessions for which an event has already been recorded for the
+	// project of the Bastion.
+	RecordedSessions *int32
+}
+
+// BastionSession is an entry in the session history of a Bastion.
+type BastionSession struct {
+	// Action is the action which was performed on the session of the Bastion.
+	Action BastionSessionAction
+	// User is the name of the user who performed the action.
+	User string
+	// Timestamp is the time when the action was performed.
+	Timestamp metav1.Time
+	// ExpirationTimestamp is the expiration time of the Bastion after the action was performed.
+	ExpirationTimestamp *metav1.Time
+}
+
+// BastionSessionAction is an action which is performed on the session of a Bastion.
+type BastionSessionAction string
+
+const (
+	// BastionSessionActionCreate indicates that the Bastion was created.
+	BastionSessionActionCreate BastionSessionAction = "Create"
+	// BastionSessionActionExtend indicates that the session of the Bastion was extended.
+	BastionSessionActionExtend BastionSessionAction = "Extend"
+	// BastionSessionActionExpire indicates that the Bastion expired and is deleted.
+	BastionSessionActionExpire BastionSessionAction = "Expire"
+	// BastionSessionActionDelete indicates that the Bastion is deleted because its Shoot is gone, is being deleted or
+	// was migrated to another Seed.
+	BastionSessionActionDelete BastionSessionAction = "Delete"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionExtensionRequest can be used to extend the session of a Bastion.
+type BastionExtensionRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of the BastionExtensionRequest.
+	Spec BastionExtensionRequestSpec
+	// Status is the status of the BastionExtensionRequest.
+	Status BastionExtensionRequestStatus
+}
+
+// BastionExtensionRequestSpec contains the duration by which the session of the Bastion shall be extended.
+type BastionExtensionRequestSpec struct {
+	// Duration is the duration by which the ExpirationTimestamp of the Bastion is advanced.
+	Duration metav1.Duration
+}
+
+// BastionExtensionRequestStatus is the status of the BastionExtensionRequest.
+type BastionExtensionRequestStatus struct {
+	// ExpirationTimestamp is the new expiration time of the Bastion.
+	ExpirationTimestamp metav1.Time
 }
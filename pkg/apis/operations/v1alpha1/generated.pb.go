@@ -74,10 +74,94 @@ func (m *Bastion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Bastion proto.InternalMessageInfo
 
+func (m *BastionExtensionRequest) Reset()      { *m = BastionExtensionRequest{} }
+func (*BastionExtensionRequest) ProtoMessage() {}
+func (*BastionExtensionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{1}
+}
+func (m *BastionExtensionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionExtensionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionExtensionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionExtensionRequest.Merge(m, src)
+}
+func (m *BastionExtensionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionExtensionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionExtensionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionExtensionRequest proto.InternalMessageInfo
+
+func (m *BastionExtensionRequestSpec) Reset()      { *m = BastionExtensionRequestSpec{} }
+func (*BastionExtensionRequestSpec) ProtoMessage() {}
+func (*BastionExtensionRequestSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{2}
+}
+func (m *BastionExtensionRequestSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionExtensionRequestSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionExtensionRequestSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionExtensionRequestSpec.Merge(m, src)
+}
+func (m *BastionExtensionRequestSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionExtensionRequestSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionExtensionRequestSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionExtensionRequestSpec proto.InternalMessageInfo
+
+func (m *BastionExtensionRequestStatus) Reset()      { *m = BastionExtensionRequestStatus{} }
+func (*BastionExtensionRequestStatus) ProtoMessage() {}
+func (*BastionExtensionRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{3}
+}
+func (m *BastionExtensionRequestStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionExtensionRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionExtensionRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionExtensionRequestStatus.Merge(m, src)
+}
+func (m *BastionExtensionRequestStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionExtensionRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionExtensionRequestStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionExtensionRequestStatus proto.InternalMessageInfo
+
 func (m *BastionIngressPolicy) Reset()      { *m = BastionIngressPolicy{} }
 func (*BastionIngressPolicy) ProtoMessage() {}
 func (*BastionIngressPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{1}
+	return fileDescriptor_a8b335fad1255a79, []int{4}
 }
 func (m *BastionIngressPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,7 +189,7 @@ var xxx_messageInfo_BastionIngressPolicy proto.InternalMessageInfo
 func (m *BastionList) Reset()      { *m = BastionList{} }
 func (*BastionList) ProtoMessage() {}
 func (*BastionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{2}
+	return fileDescriptor_a8b335fad1255a79, []int{5}
 }
 func (m *BastionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -130,10 +214,38 @@ func (m *BastionList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BastionList proto.InternalMessageInfo
 
+func (m *BastionSession) Reset()      { *m = BastionSession{} }
+func (*BastionSession) ProtoMessage() {}
+func (*BastionSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{6}
+}
+func (m *BastionSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionSession.Merge(m, src)
+}
+func (m *BastionSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionSession proto.InternalMessageInfo
+
 func (m *BastionSpec) Reset()      { *m = BastionSpec{} }
 func (*BastionSpec) ProtoMessage() {}
 func (*BastionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{3}
+	return fileDescriptor_a8b335fad1255a79, []int{7}
 }
 func (m *BastionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +273,7 @@ var xxx_messageInfo_BastionSpec proto.InternalMessageInfo
 func (m *BastionStatus) Reset()      { *m = BastionStatus{} }
 func (*BastionStatus) ProtoMessage() {}
 func (*BastionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{4}
+	return fileDescriptor_a8b335fad1255a79, []int{8}
 }
 func (m *BastionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,8 +300,12 @@ var xxx_messageInfo_BastionStatus proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Bastion)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.Bastion")
+	proto.RegisterType((*BastionExtensionRequest)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionExtensionRequest")
+	proto.RegisterType((*BastionExtensionRequestSpec)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionExtensionRequestSpec")
+	proto.RegisterType((*BastionExtensionRequestStatus)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionExtensionRequestStatus")
 	proto.RegisterType((*BastionIngressPolicy)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionIngressPolicy")
 	proto.RegisterType((*BastionList)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionList")
+	proto.RegisterType((*BastionSession)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSession")
 	proto.RegisterType((*BastionSpec)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSpec")
 	proto.RegisterType((*BastionStatus)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionStatus")
 }
@@ -199,57 +315,72 @@ func init() {
 }
 
 var fileDescriptor_a8b335fad1255a79 = []byte{
-	// 799 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xe3, 0xdd, 0x6c, 0x77, 0x3b, 0x9b, 0x42, 0x35, 0xad, 0x96, 0x68, 0x0f, 0xce, 0x92,
-	0x0b, 0x11, 0x12, 0x63, 0x52, 0x55, 0xa8, 0x3d, 0x70, 0x19, 0x44, 0xd9, 0x88, 0xa5, 0x1b, 0x4d,
-	0x2a, 0x0e, 0x08, 0x09, 0x26, 0xf6, 0x5b, 0x67, 0x48, 0xec, 0x31, 0x9e, 0x49, 0x20, 0x1c, 0x10,
-	0x1f, 0x01, 0x3e, 0x15, 0x7b, 0xec, 0x81, 0x43, 0x4f, 0x11, 0x6b, 0x3e, 0x06, 0x17, 0xe4, 0xf1,
-	0x24, 0x76, 0x48, 0x2a, 0x42, 0xdb, 0xdb, 0xcc, 0x9b, 0xf7, 0x7e, 0xff, 0x37, 0xef, 0x3d, 0x8f,
-	0x8c, 0x7a, 0xa1, 0xd0, 0xa3, 0xe9, 0x90, 0xf8, 0x32, 0xf2, 0x42, 0x9e, 0x06, 0x10, 0x43, 0x5a,
-	0x2e, 0x92, 0x71, 0xe8, 0xf1, 0x44, 0x28, 0x4f, 0x26, 0x90, 0x72, 0x2d, 0x64, 0xac, 0xbc, 0x59,
-	0x97, 0x4f, 0x92, 0x11, 0xef, 0x7a, 0x61, 0xee, 0xc2, 0x35, 0x04, 0x24, 0x49, 0xa5, 0x96, 0xf8,
-	0x71, 0x89, 0x22, 0x4b, 0x42, 0xb9, 0x48, 0xc6, 0x21, 0xc9, 0x51, 0xa4, 0x44, 0x91, 0x25, 0xea,
-	0x94, 0xee, 0x96, 0x85, 0x2f, 0x53, 0xf0, 0x66, 0xdd, 0x21, 0xe8, 0x4d, 0xf9, 0xd3, 0x0f, 0xaa,
-	0x0c, 0x19, 0x4a, 0xcf, 0x98, 0x87, 0xd3, 0x2b, 0xb3, 0x33, 0x1b, 0xb3, 0xb2, 0xee, 0xed, 0xf1,
-	0x23, 0x45, 0x84, 0xcc, 0xc1, 0x4b, 0xee, 0x06, 0xb2, 0x53, 0xf1, 0x89, 0x41, 0xff, 0x20, 0xd3,
-	0xb1, 0x88, 0xc3, 0x6d, 0x9e, 0x0f, 0x4b, 0xcf, 0x88, 0xfb, 0x23, 0x11, 0x43, 0x3a, 0x2f, 0xf3,
-	0x8e, 0x40, 0xf3, 0x6d, 0x51, 0xde, 0xcb, 0xa2, 0xd2, 0x69, 0xac, 0x45, 0x04, 0x1b, 0x01, 0x1f,
-	0xfd, 0x57, 0x80, 0xf2, 0x47, 0x10, 0xf1, 0x7f, 0xc7, 0xb5, 0x7f, 0xdf, 0x43, 0x87, 0x94, 0xab,
-	0xbc, 0xea, 0xf8, 0x5b, 0x74, 0x94, 0xe7, 0x13, 0x70, 0xcd, 0x9b, 0xce, 0x99, 0xd3, 0x39, 0x7e,
-	0xf0, 0x21, 0x29, 0xb0, 0xa4, 0x8a, 0x2d, 0x1b, 0x96, 0x7b, 0x93, 0x59, 0x97, 0x5c, 0x0e, 0xbf,
-	0x03, 0x5f, 0x7f, 0x01, 0x9a, 0x53, 0x7c, 0xbd, 0x68, 0xd5, 0xb2, 0x45, 0x0b, 0x95, 0x36, 0xb6,
-	0xa2, 0xe2, 0x11, 0xaa, 0xab, 0x04, 0xfc, 0xe6, 0x9e, 0xa1, 0x3f, 0x21, 0xaf, 0x3c, 0x17, 0xc4,
-	0xe6, 0x3c, 0x48, 0xc0, 0xa7, 0x0d, 0xab, 0x59, 0xcf, 0x77, 0xcc, 0x28, 0xe0, 0x04, 0xdd, 0x52,
-	0x9a, 0xeb, 0xa9, 0x6a, 0xee, 0x1b, 0xad, 0xf3, 0x37, 0xa0, 0x65, 0x78, 0xf4, 0x2d, 0xab, 0x76,
-	0xab, 0xd8, 0x33, 0xab, 0xd3, 0x0e, 0xd0, 0x7d, 0xeb, 0xd8, 0x8b, 0xc3, 0x14, 0x94, 0xea, 0xcb,
-	0x89, 0xf0, 0xe7, 0xf8, 0x02, 0x1d, 0x8a, 0x84, 0x4e, 0xa4, 0x3f, 0xb6, 0x45, 0x7d, 0xb7, 0x52,
-	0x54, 0x52, 0x0e, 0x4f, 0x5e, 0xc8, 0x5e, 0xdf, 0x38, 0xd2, 0xb7, 0xad, 0xc6, 0xa1, 0x35, 0xb0,
-	0x25, 0xa2, 0xfd, 0x87, 0x83, 0x8e, 0xad, 0xcc, 0x85, 0x50, 0x1a, 0x7f, 0xbd, 0xd1, 0x33, 0xb2,
-	0x5b, 0xcf, 0xf2, 0x68, 0xd3, 0xb1, 0xbb, 0x56, 0xeb, 0x68, 0x69, 0xa9, 0xf4, 0x2b, 0x44, 0x07,
-	0x42, 0x43, 0xa4, 0x9a, 0x7b, 0x67, 0xfb, 0x9d, 0xe3, 0x07, 0xf4, 0xf5, 0x8b, 0x48, 0xef, 0x58,
-	0xb9, 0x83, 0x5e, 0x0e, 0x66, 0x05, 0xbf, 0xfd, 0xf7, 0xde, 0xea, 0x5a, 0x79, 0x13, 0xf1, 0x97,
-	0xe8, 0x48, 0x8d, 0xa4, 0xd4, 0x0c, 0xae, 0xec, 0xb5, 0x3a, 0xd5, 0xaa, 0xe5, 0x9f, 0xa5, 0xb9,
-	0x84, 0xf4, 0xf9, 0xa4, 0x98, 0x34, 0x06, 0x57, 0x90, 0x42, 0xec, 0x43, 0x79, 0xa1, 0x81, 0x25,
-	0xb0, 0x15, 0x0b, 0x77, 0xd0, 0x91, 0x02, 0x08, 0x9e, 0xf2, 0x08, 0xcc, 0x10, 0xde, 0xa6, 0x0d,
-	0xe3, 0x69, 0x6d, 0x6c, 0x75, 0x8a, 0x1f, 0xa2, 0x46, 0x92, 0xca, 0x99, 0x08, 0x20, 0x7d, 0x36,
-	0x4f, 0xc0, 0x8c, 0xd1, 0x6d, 0x7a, 0x37, 0x5b, 0xb4, 0x1a, 0xfd, 0x8a, 0x9d, 0xad, 0x79, 0xe1,
-	0x47, 0xa8, 0xa1, 0xd4, 0xa8, 0x3f, 0x1d, 0x4e, 0x84, 0xff, 0x39, 0xcc, 0x9b, 0x75, 0x13, 0x75,
-	0xdf, 0x66, 0xd4, 0x18, 0x0c, 0xce, 0x57, 0x67, 0x6c, 0xcd, 0x13, 0xff, 0x84, 0x0e, 0x45, 0x31,
-	0x37, 0xcd, 0x03, 0x53, 0xec, 0xcb, 0xd7, 0x2f, 0xf6, 0xda, 0x20, 0x56, 0x86, 0xaa, 0x30, 0xb3,
-	0xa5, 0x60, 0xfb, 0xb7, 0x3a, 0xba, 0xb3, 0x36, 0xe4, 0xf8, 0x69, 0x99, 0x4d, 0x51, 0xfe, 0xf7,
-	0xb6, 0x97, 0x9f, 0x07, 0x94, 0x4f, 0x78, 0xec, 0x43, 0x6a, 0xa1, 0xf4, 0x78, 0x9b, 0x02, 0xfe,
-	0x1e, 0x21, 0x5f, 0xc6, 0x81, 0x30, 0x79, 0xda, 0x69, 0xfa, 0x78, 0xc7, 0x0b, 0x5a, 0x35, 0xf3,
-	0xb6, 0x93, 0x4f, 0x96, 0x94, 0xf2, 0xa5, 0x59, 0x99, 0x14, 0xab, 0x88, 0xe0, 0x9f, 0xd1, 0xc9,
-	0x84, 0x2b, 0x7d, 0x0e, 0x3c, 0xd5, 0x43, 0xe0, 0xfa, 0x99, 0x88, 0x40, 0x69, 0x1e, 0x25, 0xf6,
-	0x45, 0x78, 0x7f, 0xb7, 0xef, 0x24, 0x0f, 0xa3, 0xa7, 0xd9, 0xa2, 0x75, 0x72, 0xb1, 0x95, 0xc6,
-	0x5e, 0xa2, 0x82, 0xa7, 0xe8, 0x1e, 0xfc, 0x98, 0x88, 0xa2, 0x37, 0xa5, 0x78, 0xfd, 0x7f, 0x8b,
-	0xbf, 0x93, 0x2d, 0x5a, 0xf7, 0x3e, 0xdd, 0x44, 0xb1, 0x6d, 0x7c, 0xfc, 0x04, 0x61, 0x39, 0x54,
-	0x90, 0xce, 0x20, 0xf8, 0xac, 0x78, 0xeb, 0x85, 0x8c, 0x9b, 0x07, 0x67, 0x4e, 0x67, 0x9f, 0x9e,
-	0x64, 0x8b, 0x16, 0xbe, 0xdc, 0x38, 0x65, 0x5b, 0x22, 0xe8, 0x37, 0xd7, 0x37, 0x6e, 0xed, 0xf9,
-	0x8d, 0x5b, 0x7b, 0x71, 0xe3, 0xd6, 0x7e, 0xc9, 0x5c, 0xe7, 0x3a, 0x73, 0x9d, 0xe7, 0x99, 0xeb,
-	0xbc, 0xc8, 0x5c, 0xe7, 0xcf, 0xcc, 0x75, 0x7e, 0xfd, 0xcb, 0xad, 0x7d, 0xf5, 0xf8, 0x95, 0x7f,
-	0x12, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xea, 0x1f, 0x49, 0x60, 0x08, 0x00, 0x00,
+	// 1040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0x26, 0x71, 0x62, 0x4f, 0xd2, 0x26, 0xbf, 0x49, 0x94, 0x5a, 0xa9, 0x7e, 0x76, 0xf0,
+	0x01, 0x2c, 0x24, 0xd6, 0xa4, 0xaa, 0x50, 0x7b, 0x00, 0xc1, 0x42, 0x4b, 0x2c, 0x42, 0x13, 0x8d,
+	0x43, 0x85, 0x00, 0x09, 0xc6, 0xbb, 0x6f, 0xec, 0xc5, 0xde, 0x9d, 0xed, 0xce, 0xd8, 0x8d, 0x41,
+	0x42, 0xfd, 0x08, 0x9c, 0xf9, 0x40, 0x90, 0x03, 0x87, 0x1e, 0x38, 0xf4, 0x64, 0x91, 0xe5, 0xc6,
+	0x27, 0x40, 0x9c, 0xd0, 0xce, 0xce, 0xfe, 0xf1, 0x9f, 0x04, 0x27, 0x29, 0x70, 0xf3, 0xbe, 0xf3,
+	0xbe, 0xcf, 0xf3, 0xce, 0x33, 0xcf, 0x3b, 0xbb, 0x46, 0xf5, 0x96, 0x2d, 0xda, 0xbd, 0xa6, 0x6e,
+	0x32, 0xa7, 0xd6, 0xa2, 0xbe, 0x05, 0x2e, 0xf8, 0xe9, 0x0f, 0xaf, 0xd3, 0xaa, 0x51, 0xcf, 0xe6,
+	0x35, 0xe6, 0x81, 0x4f, 0x85, 0xcd, 0x5c, 0x5e, 0xeb, 0xef, 0xd2, 0xae, 0xd7, 0xa6, 0xbb, 0xb5,
+	0x56, 0x98, 0x42, 0x05, 0x58, 0xba, 0xe7, 0x33, 0xc1, 0xf0, 0xfd, 0x14, 0x4a, 0x8f, 0x11, 0xd2,
+	0x1f, 0x5e, 0xa7, 0xa5, 0x87, 0x50, 0x7a, 0x0a, 0xa5, 0xc7, 0x50, 0xdb, 0xc6, 0x6c, 0x5d, 0x98,
+	0xcc, 0x87, 0x5a, 0x7f, 0xb7, 0x09, 0x62, 0x92, 0x7e, 0xfb, 0x8d, 0x2c, 0x06, 0x6b, 0xb1, 0x9a,
+	0x0c, 0x37, 0x7b, 0xc7, 0xf2, 0x49, 0x3e, 0xc8, 0x5f, 0x2a, 0xbd, 0xd2, 0xb9, 0xc7, 0x75, 0x9b,
+	0x85, 0xc0, 0x31, 0xee, 0x04, 0x64, 0x35, 0x93, 0xe3, 0x82, 0x78, 0xca, 0xfc, 0x8e, 0xed, 0xb6,
+	0xa6, 0x65, 0xde, 0x4d, 0x33, 0x1d, 0x6a, 0xb6, 0x6d, 0x17, 0xfc, 0x41, 0xda, 0xb7, 0x03, 0x82,
+	0x4e, 0xab, 0xaa, 0x9d, 0x57, 0xe5, 0xf7, 0x5c, 0x61, 0x3b, 0x30, 0x51, 0xf0, 0xd6, 0xdf, 0x15,
+	0x70, 0xb3, 0x0d, 0x0e, 0x1d, 0xaf, 0xab, 0xfc, 0x34, 0x8f, 0x96, 0x0d, 0xca, 0x43, 0xd5, 0xf1,
+	0x57, 0x28, 0x1f, 0xf6, 0x63, 0x51, 0x41, 0x8b, 0xda, 0x8e, 0x56, 0x5d, 0xb9, 0xf3, 0xa6, 0x1e,
+	0xc1, 0xea, 0x59, 0xd8, 0xf4, 0xc0, 0xc2, 0x6c, 0xbd, 0xbf, 0xab, 0x1f, 0x34, 0xbf, 0x06, 0x53,
+	0x7c, 0x0c, 0x82, 0x1a, 0xf8, 0x74, 0x58, 0x9e, 0x0b, 0x86, 0x65, 0x94, 0xc6, 0x48, 0x82, 0x8a,
+	0xdb, 0x68, 0x91, 0x7b, 0x60, 0x16, 0xe7, 0x25, 0xfa, 0x43, 0xfd, 0xca, 0xbe, 0xd0, 0x55, 0xcf,
+	0x0d, 0x0f, 0x4c, 0x63, 0x55, 0x71, 0x2e, 0x86, 0x4f, 0x44, 0x32, 0x60, 0x0f, 0x2d, 0x71, 0x41,
+	0x45, 0x8f, 0x17, 0x17, 0x24, 0xd7, 0xde, 0x4b, 0xe0, 0x92, 0x78, 0xc6, 0x4d, 0xc5, 0xb6, 0x14,
+	0x3d, 0x13, 0xc5, 0x53, 0xf9, 0x63, 0x1e, 0xdd, 0x52, 0x99, 0x0f, 0x4e, 0x04, 0xb8, 0xdc, 0x66,
+	0x2e, 0x81, 0x27, 0x3d, 0xe0, 0xe2, 0x5f, 0x50, 0xf6, 0x64, 0x44, 0xd9, 0xc7, 0xd7, 0xdf, 0xed,
+	0xf8, 0x1e, 0xce, 0x55, 0xfa, 0x99, 0x36, 0x26, 0xf5, 0xa7, 0xff, 0x00, 0xf9, 0xc5, 0xd2, 0x7f,
+	0x8b, 0x6e, 0x5f, 0xd0, 0x35, 0xfe, 0x02, 0xe5, 0xad, 0x5e, 0x44, 0xa5, 0xd4, 0xd7, 0x67, 0x53,
+	0xff, 0x03, 0x55, 0x65, 0xac, 0x2b, 0xe2, 0x7c, 0x1c, 0x21, 0x09, 0x62, 0xe5, 0x07, 0x0d, 0xfd,
+	0xff, 0xc2, 0xb6, 0xf1, 0x00, 0x6d, 0xc0, 0x89, 0x67, 0x47, 0xf9, 0x47, 0xb6, 0x03, 0x5c, 0x50,
+	0xc7, 0x53, 0xad, 0xbc, 0x3e, 0x5b, 0x2b, 0x61, 0x99, 0x71, 0x5b, 0xb5, 0xb1, 0xf1, 0x60, 0x12,
+	0x8e, 0x4c, 0xe3, 0xa8, 0x58, 0x68, 0x53, 0xf5, 0x56, 0x77, 0x5b, 0x3e, 0x70, 0x7e, 0xc8, 0xba,
+	0xb6, 0x39, 0xc0, 0xfb, 0x68, 0xd9, 0xf6, 0x8c, 0x2e, 0x33, 0x3b, 0xaa, 0x8d, 0x57, 0x32, 0x6d,
+	0xe8, 0xe9, 0x8d, 0x16, 0x52, 0xd7, 0x0f, 0x65, 0xa2, 0xb1, 0xa6, 0xd8, 0x97, 0x55, 0x80, 0xc4,
+	0x10, 0x95, 0x5f, 0x34, 0xb4, 0xa2, 0x68, 0xf6, 0x6d, 0x2e, 0x42, 0xc1, 0xc7, 0xec, 0x3e, 0xa3,
+	0xe0, 0x61, 0xb5, 0x34, 0x7b, 0x22, 0x78, 0x1c, 0xc9, 0x58, 0xbd, 0x85, 0x72, 0xb6, 0x00, 0x87,
+	0x17, 0xe7, 0x77, 0x16, 0xaa, 0x2b, 0x77, 0x8c, 0xeb, 0xdb, 0xcd, 0xb8, 0xa1, 0xe8, 0x72, 0xf5,
+	0x10, 0x98, 0x44, 0xf8, 0x95, 0x1f, 0xe7, 0xd1, 0xcd, 0x78, 0xf6, 0x81, 0x87, 0xe7, 0x8a, 0xdf,
+	0x41, 0x4b, 0xd4, 0x4c, 0x8c, 0x54, 0x30, 0x5e, 0x8d, 0x1d, 0xf9, 0x9e, 0x8c, 0xfe, 0x39, 0x2c,
+	0x6f, 0x8e, 0x56, 0x44, 0x71, 0xa2, 0xaa, 0xf0, 0x0e, 0x5a, 0xec, 0x71, 0xf0, 0xe5, 0x98, 0x16,
+	0xd2, 0x71, 0xfa, 0x84, 0x83, 0x4f, 0xe4, 0x0a, 0xfe, 0x1c, 0x15, 0x44, 0x62, 0x91, 0x85, 0x4b,
+	0x5b, 0xe4, 0x7f, 0x0a, 0xb2, 0x90, 0x1a, 0x23, 0xc5, 0xc3, 0xbd, 0xe9, 0x4e, 0x5c, 0xbc, 0x34,
+	0xcd, 0xad, 0x4b, 0xb9, 0xf0, 0xf7, 0x85, 0xc4, 0x1f, 0x72, 0x20, 0x1f, 0xa3, 0x3c, 0x6f, 0x33,
+	0x26, 0x08, 0x1c, 0x2b, 0x7f, 0x54, 0xb3, 0xf6, 0x0b, 0x5f, 0xba, 0xd2, 0x0d, 0xcc, 0xa4, 0xdd,
+	0xe8, 0xb6, 0x23, 0x70, 0x0c, 0x3e, 0xb8, 0x26, 0xa4, 0xce, 0x68, 0x28, 0x04, 0x92, 0x60, 0xe1,
+	0x2a, 0xca, 0x73, 0x00, 0xeb, 0x11, 0x75, 0x20, 0x56, 0x58, 0x66, 0xaa, 0x18, 0x49, 0x56, 0xf1,
+	0x5d, 0xb4, 0xea, 0xf9, 0xac, 0x6f, 0x5b, 0xe0, 0x1f, 0x0d, 0x3c, 0x90, 0x42, 0x17, 0x8c, 0xf5,
+	0x60, 0x58, 0x5e, 0x3d, 0xcc, 0xc4, 0xc9, 0x48, 0x16, 0xbe, 0x87, 0x56, 0x39, 0x6f, 0x1f, 0xf6,
+	0x9a, 0x5d, 0xdb, 0xfc, 0x08, 0x06, 0x52, 0xb7, 0x82, 0xb1, 0xa9, 0x3a, 0x5a, 0x6d, 0x34, 0xf6,
+	0x92, 0x35, 0x32, 0x92, 0x89, 0xbf, 0x41, 0xcb, 0x76, 0x34, 0x80, 0xc5, 0x9c, 0x74, 0xed, 0xc1,
+	0xf5, 0x5d, 0x3b, 0x32, 0xd1, 0x99, 0xe9, 0x8c, 0xc2, 0x24, 0x26, 0xc4, 0x0e, 0x5a, 0xe3, 0x91,
+	0x19, 0xe3, 0xdb, 0xab, 0xb8, 0x74, 0xa5, 0x5b, 0x70, 0x23, 0x18, 0x96, 0xd7, 0x1a, 0xa3, 0x50,
+	0x64, 0x1c, 0xbb, 0xf2, 0x73, 0x0e, 0xdd, 0x18, 0x79, 0x63, 0xe2, 0x47, 0xe9, 0xe6, 0xa3, 0xd3,
+	0x7e, 0x6d, 0xfa, 0x69, 0x53, 0xcb, 0xa0, 0x5d, 0xea, 0x9a, 0xe0, 0xab, 0x3d, 0x18, 0x2b, 0x53,
+	0x37, 0xf4, 0x04, 0x21, 0x93, 0xb9, 0x96, 0x2d, 0x65, 0x51, 0xb7, 0xc0, 0xdb, 0x33, 0xea, 0xa9,
+	0xd8, 0xe4, 0x87, 0xa2, 0xfe, 0x7e, 0x8c, 0x92, 0xbe, 0x5c, 0x93, 0x10, 0x27, 0x19, 0x12, 0xfc,
+	0x1d, 0xda, 0xea, 0x52, 0x2e, 0xf6, 0x80, 0xfa, 0xa2, 0x09, 0x54, 0x1c, 0x5d, 0x63, 0x44, 0xb7,
+	0x83, 0x61, 0x79, 0x6b, 0x7f, 0x2a, 0x1a, 0x39, 0x87, 0xe5, 0x3f, 0x1a, 0x5c, 0xfc, 0x10, 0x61,
+	0xd6, 0xe4, 0xe0, 0xf7, 0xc1, 0xfa, 0x30, 0xfa, 0x70, 0x0c, 0xdd, 0x93, 0xdb, 0xd1, 0xaa, 0x0b,
+	0xc6, 0x56, 0x30, 0x2c, 0xe3, 0x83, 0x89, 0x55, 0x32, 0xa5, 0x02, 0x3f, 0x45, 0x79, 0x65, 0x13,
+	0x5e, 0x5c, 0x92, 0xe7, 0x55, 0x7f, 0x09, 0xdf, 0x63, 0x11, 0x62, 0xe6, 0x46, 0x50, 0x14, 0x24,
+	0x21, 0xc3, 0xef, 0xa2, 0x75, 0x1f, 0x4c, 0xe6, 0x5b, 0x60, 0xc5, 0xab, 0xc5, 0xe5, 0x1d, 0xad,
+	0x9a, 0x33, 0x36, 0x83, 0x61, 0x79, 0x9d, 0x8c, 0xad, 0x91, 0x89, 0x6c, 0xe3, 0xcb, 0xd3, 0xb3,
+	0xd2, 0xdc, 0xf3, 0xb3, 0xd2, 0xdc, 0x8b, 0xb3, 0xd2, 0xdc, 0xb3, 0xa0, 0xa4, 0x9d, 0x06, 0x25,
+	0xed, 0x79, 0x50, 0xd2, 0x5e, 0x04, 0x25, 0xed, 0xd7, 0xa0, 0xa4, 0x7d, 0xff, 0x5b, 0x69, 0xee,
+	0xb3, 0xfb, 0x57, 0xfe, 0xb3, 0xf4, 0xd7, 0x00, 0x87, 0xe5, 0x34, 0x07, 0x68, 0x0d, 0x00, 0x00,
 }
 
 func (m *Bastion) Marshal() (dAtA []byte, err error) {
@@ -305,6 +436,125 @@ func (m *Bastion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BastionExtensionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionExtensionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionExtensionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BastionExtensionRequestSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionExtensionRequestSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionExtensionRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BastionExtensionRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionExtensionRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionExtensionRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpirationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BastionIngressPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -385,6 +635,61 @@ func (m *BastionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BastionSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTimestamp != nil {
+		{
+			size, err := m.ExpirationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.User)
+	copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BastionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -405,6 +710,18 @@ func (m *BastionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SessionDuration != nil {
+		{
+			size, err := m.SessionDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Ingress) > 0 {
 		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -471,6 +788,25 @@ func (m *BastionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecordedSessions != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RecordedSessions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.ObservedGeneration != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ObservedGeneration))
 		i--
@@ -555,26 +891,63 @@ func (m *Bastion) Size() (n int) {
 	return n
 }
 
-func (m *BastionIngressPolicy) Size() (n int) {
+func (m *BastionExtensionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.IPBlock.Size()
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *BastionList) Size() (n int) {
+func (m *BastionExtensionRequestSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = m.Duration.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
+	return n
+}
+
+func (m *BastionExtensionRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExpirationTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *BastionIngressPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IPBlock.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *BastionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
 		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
@@ -583,6 +956,25 @@ func (m *BastionList) Size() (n int) {
 	return n
 }
 
+func (m *BastionSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Timestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ExpirationTimestamp != nil {
+		l = m.ExpirationTimestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *BastionSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -607,6 +999,10 @@ func (m *BastionSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.SessionDuration != nil {
+		l = m.SessionDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -637,6 +1033,15 @@ func (m *BastionStatus) Size() (n int) {
 	if m.ObservedGeneration != nil {
 		n += 1 + sovGenerated(uint64(*m.ObservedGeneration))
 	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RecordedSessions != nil {
+		n += 1 + sovGenerated(uint64(*m.RecordedSessions))
+	}
 	return n
 }
 
@@ -658,6 +1063,38 @@ func (this *Bastion) String() string {
 	}, "")
 	return s
 }
+func (this *BastionExtensionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionExtensionRequest{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "BastionExtensionRequestSpec", "BastionExtensionRequestSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "BastionExtensionRequestStatus", "BastionExtensionRequestStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BastionExtensionRequestSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionExtensionRequestSpec{`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BastionExtensionRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionExtensionRequestStatus{`,
+		`ExpirationTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BastionIngressPolicy) String() string {
 	if this == nil {
 		return "nil"
@@ -684,6 +1121,19 @@ func (this *BastionList) String() string {
 	}, "")
 	return s
 }
+func (this *BastionSession) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionSession{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Timestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BastionSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -699,6 +1149,7 @@ func (this *BastionSpec) String() string {
 		`ProviderType:` + valueToStringGenerated(this.ProviderType) + `,`,
 		`SSHPublicKey:` + fmt.Sprintf("%v", this.SSHPublicKey) + `,`,
 		`Ingress:` + repeatedStringForIngress + `,`,
+		`SessionDuration:` + strings.Replace(fmt.Sprintf("%v", this.SessionDuration), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -712,12 +1163,19 @@ func (this *BastionStatus) String() string {
 		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForSessions := "[]BastionSession{"
+	for _, f := range this.Sessions {
+		repeatedStringForSessions += strings.Replace(strings.Replace(f.String(), "BastionSession", "BastionSession", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSessions += "}"
 	s := strings.Join([]string{`&BastionStatus{`,
 		`Ingress:` + strings.Replace(fmt.Sprintf("%v", this.Ingress), "LoadBalancerIngress", "v12.LoadBalancerIngress", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`LastHeartbeatTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ObservedGeneration:` + valueToStringGenerated(this.ObservedGeneration) + `,`,
+		`Sessions:` + repeatedStringForSessions + `,`,
+		`RecordedSessions:` + valueToStringGenerated(this.RecordedSessions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -727,10 +1185,474 @@ func valueToStringGenerated(v interface{}) string {
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Bastion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionExtensionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionExtensionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionExtensionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionExtensionRequestSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionExtensionRequestSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionExtensionRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionExtensionRequestStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionExtensionRequestStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionExtensionRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Bastion) Unmarshal(dAtA []byte) error {
+func (m *BastionIngressPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -753,15 +1675,15 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+			return fmt.Errorf("proto: BastionIngressPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BastionIngressPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IPBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -788,13 +1710,63 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IPBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -821,13 +1793,13 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -854,7 +1826,8 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Bastion{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -879,7 +1852,7 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BastionIngressPolicy) Unmarshal(dAtA []byte) error {
+func (m *BastionSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -902,17 +1875,17 @@ func (m *BastionIngressPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BastionIngressPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: BastionSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionIngressPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BastionSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -922,78 +1895,59 @@ func (m *BastionIngressPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IPBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = BastionSessionAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BastionList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BastionList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1020,13 +1974,13 @@ func (m *BastionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1053,8 +2007,10 @@ func (m *BastionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Bastion{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpirationTimestamp == nil {
+				m.ExpirationTimestamp = &v1.Time{}
+			}
+			if err := m.ExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1273,6 +2229,42 @@ func (m *BastionSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionDuration == nil {
+				m.SessionDuration = &v1.Duration{}
+			}
+			if err := m.SessionDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1485,6 +2477,60 @@ func (m *BastionStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ObservedGeneration = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, BastionSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedSessions", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordedSessions = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
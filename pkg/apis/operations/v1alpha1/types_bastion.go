@@ -70,6 +70,11 @@ type BastionSpec struct {
 	SSHPublicKey string `json:"sshPublicKey" protobuf:"bytes,4,opt,name=sshPublicKey"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress" protobuf:"bytes,5,opt,name=ingress"`
+	// SessionDuration is the requested duration of the bastion session. If it is set, the ExpirationTimestamp is
+	// not advanced by heartbeats anymore, but the session has to be extended explicitly via the `extend`
+	// subresource. This field is immutable.
+	// +optional
+	SessionDuration *metav1.Duration `json:"sessionDuration,omitempty" protobuf:"bytes,6,opt,name=sessionDuration"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -101,4 +106,66 @@ type BastionStatus struct {
 	// Bastion's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty" protobuf:"varint,5,opt,name=observedGeneration"`
+	// Sessions is the history of actions which were performed on the session of the Bastion.
+	// +optional
+	Sessions []BastionSession `json:"sessions,omitempty" protobuf:"bytes,6,rep,name=sessions"`
+	// RecordedSessions is the number of entries in Sessions for which an event has already been recorded for the
+	// project of the Bastion.
+	// +optional
+	RecordedSessions *int32 `json:"recordedSessions,omitempty" protobuf:"varint,7,opt,name=recordedSessions"`
+}
+
+// BastionSession is an entry in the session history of a Bastion.
+type BastionSession struct {
+	// Action is the action which was performed on the session of the Bastion.
+	Action BastionSessionAction `json:"action" protobuf:"bytes,1,opt,name=action,casttype=BastionSessionAction"`
+	// User is the name of the user who performed the action.
+	User string `json:"user" protobuf:"bytes,2,opt,name=user"`
+	// Timestamp is the time when the action was performed.
+	Timestamp metav1.Time `json:"timestamp" protobuf:"bytes,3,opt,name=timestamp"`
+	// ExpirationTimestamp is the expiration time of the Bastion after the action was performed.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,4,opt,name=expirationTimestamp"`
+}
+
+// BastionSessionAction is an action which is performed on the session of a Bastion.
+type BastionSessionAction string
+
+const (
+	// BastionSessionActionCreate indicates that the Bastion was created.
+	BastionSessionActionCreate BastionSessionAction = "Create"
+	// BastionSessionActionExtend indicates that the session of the Bastion was extended.
+	BastionSessionActionExtend BastionSessionAction = "Extend"
+	// BastionSessionActionExpire indicates that the Bastion expired and is deleted.
+	BastionSessionActionExpire BastionSessionAction = "Expire"
+	// BastionSessionActionDelete indicates that the Bastion is deleted because its Shoot is gone, is being deleted or
+	// was migrated to another Seed.
+	BastionSessionActionDelete BastionSessionAction = "Delete"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionExtensionRequest can be used to extend the session of a Bastion.
+type BastionExtensionRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec is the specification of the BastionExtensionRequest.
+	Spec BastionExtensionRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the BastionExtensionRequest.
+	// +optional
+	Status BastionExtensionRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// BastionExtensionRequestSpec contains the duration by which the session of the Bastion shall be extended.
+type BastionExtensionRequestSpec struct {
+	// Duration is the duration by which the ExpirationTimestamp of the Bastion is advanced.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+}
+
+// BastionExtensionRequestStatus is the status of the BastionExtensionRequest.
+type BastionExtensionRequestStatus struct {
+	// ExpirationTimestamp is the new expiration time of the Bastion.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp" protobuf:"bytes,1,opt,name=expirationTimestamp"`
 }
@@ -27,8 +27,8 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operations "github.com/gardener/gardener/pkg/apis/operations"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -50,6 +50,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionExtensionRequest)(nil), (*operations.BastionExtensionRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionExtensionRequest_To_operations_BastionExtensionRequest(a.(*BastionExtensionRequest), b.(*operations.BastionExtensionRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionExtensionRequest)(nil), (*BastionExtensionRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionExtensionRequest_To_v1alpha1_BastionExtensionRequest(a.(*operations.BastionExtensionRequest), b.(*BastionExtensionRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionExtensionRequestSpec)(nil), (*operations.BastionExtensionRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionExtensionRequestSpec_To_operations_BastionExtensionRequestSpec(a.(*BastionExtensionRequestSpec), b.(*operations.BastionExtensionRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionExtensionRequestSpec)(nil), (*BastionExtensionRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionExtensionRequestSpec_To_v1alpha1_BastionExtensionRequestSpec(a.(*operations.BastionExtensionRequestSpec), b.(*BastionExtensionRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionExtensionRequestStatus)(nil), (*operations.BastionExtensionRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionExtensionRequestStatus_To_operations_BastionExtensionRequestStatus(a.(*BastionExtensionRequestStatus), b.(*operations.BastionExtensionRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionExtensionRequestStatus)(nil), (*BastionExtensionRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionExtensionRequestStatus_To_v1alpha1_BastionExtensionRequestStatus(a.(*operations.BastionExtensionRequestStatus), b.(*BastionExtensionRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionIngressPolicy)(nil), (*operations.BastionIngressPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionIngressPolicy_To_operations_BastionIngressPolicy(a.(*BastionIngressPolicy), b.(*operations.BastionIngressPolicy), scope)
 	}); err != nil {
@@ -70,6 +100,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionSession)(nil), (*operations.BastionSession)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionSession_To_operations_BastionSession(a.(*BastionSession), b.(*operations.BastionSession), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionSession)(nil), (*BastionSession)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionSession_To_v1alpha1_BastionSession(a.(*operations.BastionSession), b.(*BastionSession), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionSpec)(nil), (*operations.BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(a.(*BastionSpec), b.(*operations.BastionSpec), scope)
 	}); err != nil {
@@ -125,6 +165,78 @@ func Convert_operations_Bastion_To_v1alpha1_Bastion(in *operations.Bastion, out
 	return autoConvert_operations_Bastion_To_v1alpha1_Bastion(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionExtensionRequest_To_operations_BastionExtensionRequest(in *BastionExtensionRequest, out *operations.BastionExtensionRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_BastionExtensionRequestSpec_To_operations_BastionExtensionRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_BastionExtensionRequestStatus_To_operations_BastionExtensionRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BastionExtensionRequest_To_operations_BastionExtensionRequest is an autogenerated conversion function.
+func Convert_v1alpha1_BastionExtensionRequest_To_operations_BastionExtensionRequest(in *BastionExtensionRequest, out *operations.BastionExtensionRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionExtensionRequest_To_operations_BastionExtensionRequest(in, out, s)
+}
+
+func autoConvert_operations_BastionExtensionRequest_To_v1alpha1_BastionExtensionRequest(in *operations.BastionExtensionRequest, out *BastionExtensionRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_BastionExtensionRequestSpec_To_v1alpha1_BastionExtensionRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_BastionExtensionRequestStatus_To_v1alpha1_BastionExtensionRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_BastionExtensionRequest_To_v1alpha1_BastionExtensionRequest is an autogenerated conversion function.
+func Convert_operations_BastionExtensionRequest_To_v1alpha1_BastionExtensionRequest(in *operations.BastionExtensionRequest, out *BastionExtensionRequest, s conversion.Scope) error {
+	return autoConvert_operations_BastionExtensionRequest_To_v1alpha1_BastionExtensionRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionExtensionRequestSpec_To_operations_BastionExtensionRequestSpec(in *BastionExtensionRequestSpec, out *operations.BastionExtensionRequestSpec, s conversion.Scope) error {
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1alpha1_BastionExtensionRequestSpec_To_operations_BastionExtensionRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_BastionExtensionRequestSpec_To_operations_BastionExtensionRequestSpec(in *BastionExtensionRequestSpec, out *operations.BastionExtensionRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionExtensionRequestSpec_To_operations_BastionExtensionRequestSpec(in, out, s)
+}
+
+func autoConvert_operations_BastionExtensionRequestSpec_To_v1alpha1_BastionExtensionRequestSpec(in *operations.BastionExtensionRequestSpec, out *BastionExtensionRequestSpec, s conversion.Scope) error {
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_operations_BastionExtensionRequestSpec_To_v1alpha1_BastionExtensionRequestSpec is an autogenerated conversion function.
+func Convert_operations_BastionExtensionRequestSpec_To_v1alpha1_BastionExtensionRequestSpec(in *operations.BastionExtensionRequestSpec, out *BastionExtensionRequestSpec, s conversion.Scope) error {
+	return autoConvert_operations_BastionExtensionRequestSpec_To_v1alpha1_BastionExtensionRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionExtensionRequestStatus_To_operations_BastionExtensionRequestStatus(in *BastionExtensionRequestStatus, out *operations.BastionExtensionRequestStatus, s conversion.Scope) error {
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_v1alpha1_BastionExtensionRequestStatus_To_operations_BastionExtensionRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BastionExtensionRequestStatus_To_operations_BastionExtensionRequestStatus(in *BastionExtensionRequestStatus, out *operations.BastionExtensionRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionExtensionRequestStatus_To_operations_BastionExtensionRequestStatus(in, out, s)
+}
+
+func autoConvert_operations_BastionExtensionRequestStatus_To_v1alpha1_BastionExtensionRequestStatus(in *operations.BastionExtensionRequestStatus, out *BastionExtensionRequestStatus, s conversion.Scope) error {
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_operations_BastionExtensionRequestStatus_To_v1alpha1_BastionExtensionRequestStatus is an autogenerated conversion function.
+func Convert_operations_BastionExtensionRequestStatus_To_v1alpha1_BastionExtensionRequestStatus(in *operations.BastionExtensionRequestStatus, out *BastionExtensionRequestStatus, s conversion.Scope) error {
+	return autoConvert_operations_BastionExtensionRequestStatus_To_v1alpha1_BastionExtensionRequestStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionIngressPolicy_To_operations_BastionIngressPolicy(in *BastionIngressPolicy, out *operations.BastionIngressPolicy, s conversion.Scope) error {
 	out.IPBlock = in.IPBlock
 	return nil
@@ -167,12 +279,39 @@ func Convert_operations_BastionList_To_v1alpha1_BastionList(in *operations.Basti
 	return autoConvert_operations_BastionList_To_v1alpha1_BastionList(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionSession_To_operations_BastionSession(in *BastionSession, out *operations.BastionSession, s conversion.Scope) error {
+	out.Action = operations.BastionSessionAction(in.Action)
+	out.User = in.User
+	out.Timestamp = in.Timestamp
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1alpha1_BastionSession_To_operations_BastionSession is an autogenerated conversion function.
+func Convert_v1alpha1_BastionSession_To_operations_BastionSession(in *BastionSession, out *operations.BastionSession, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionSession_To_operations_BastionSession(in, out, s)
+}
+
+func autoConvert_operations_BastionSession_To_v1alpha1_BastionSession(in *operations.BastionSession, out *BastionSession, s conversion.Scope) error {
+	out.Action = BastionSessionAction(in.Action)
+	out.User = in.User
+	out.Timestamp = in.Timestamp
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_operations_BastionSession_To_v1alpha1_BastionSession is an autogenerated conversion function.
+func Convert_operations_BastionSession_To_v1alpha1_BastionSession(in *operations.BastionSession, out *BastionSession, s conversion.Scope) error {
+	return autoConvert_operations_BastionSession_To_v1alpha1_BastionSession(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionSpec_To_operations_BastionSpec(in *BastionSpec, out *operations.BastionSpec, s conversion.Scope) error {
 	out.ShootRef = in.ShootRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]operations.BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.SessionDuration = (*v1.Duration)(unsafe.Pointer(in.SessionDuration))
 	return nil
 }
 
@@ -187,6 +326,7 @@ func autoConvert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.B
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.SessionDuration = (*v1.Duration)(unsafe.Pointer(in.SessionDuration))
 	return nil
 }
 
@@ -196,11 +336,13 @@ func Convert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.Basti
 }
 
 func autoConvert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatus, out *operations.BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.Sessions = *(*[]operations.BastionSession)(unsafe.Pointer(&in.Sessions))
+	out.RecordedSessions = (*int32)(unsafe.Pointer(in.RecordedSessions))
 	return nil
 }
 
@@ -210,11 +352,13 @@ func Convert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatu
 }
 
 func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.Sessions = *(*[]BastionSession)(unsafe.Pointer(&in.Sessions))
+	out.RecordedSessions = (*int32)(unsafe.Pointer(in.RecordedSessions))
 	return nil
 }
 
@@ -19,7 +19,9 @@ import (
 	"time"
 
 	"golang.org/x/crypto/ssh"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -75,6 +77,10 @@ func ValidateBastionSpec(spec *operations.BastionSpec, fldPath *field.Path) fiel
 		}
 	}
 
+	if spec.SessionDuration != nil && spec.SessionDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sessionDuration"), spec.SessionDuration.Duration.String(), "session duration must be positive"))
+	}
+
 	return allErrs
 }
 
@@ -84,12 +90,13 @@ func ValidateBastionSpecUpdate(newSpec, oldSpec *operations.BastionSpec, fldPath
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootRef.Name, oldSpec.ShootRef.Name, fldPath.Child("shootRef.name"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SSHPublicKey, oldSpec.SSHPublicKey, fldPath.Child("sshPublicKey"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SessionDuration, oldSpec.SessionDuration, fldPath.Child("sessionDuration"))...)
 
 	return allErrs
 }
 
 // ValidateBastionStatusUpdate validates the status field of a Bastion object.
-func ValidateBastionStatusUpdate(newBastion, _ *operations.Bastion) field.ErrorList {
+func ValidateBastionStatusUpdate(newBastion, oldBastion *operations.Bastion) field.ErrorList {
 	allErrs := field.ErrorList{}
 	now := time.Now()
 
@@ -97,5 +104,61 @@ func ValidateBastionStatusUpdate(newBastion, _ *operations.Bastion) field.ErrorL
 		allErrs = append(allErrs, field.Invalid(field.NewPath("status.lastHeartbeatTimestamp"), newBastion.Status.LastHeartbeatTimestamp, "last heartbeat must not be in the future"))
 	}
 
+	allErrs = append(allErrs, validateBastionSessionsUpdate(newBastion.Status.Sessions, oldBastion.Status.Sessions, field.NewPath("status", "sessions"))...)
+
+	if recordedSessions := newBastion.Status.RecordedSessions; recordedSessions != nil {
+		fldPath := field.NewPath("status", "recordedSessions")
+		if int(*recordedSessions) > len(newBastion.Status.Sessions) {
+			allErrs = append(allErrs, field.Invalid(fldPath, *recordedSessions, "must not exceed the number of sessions"))
+		}
+		if oldRecordedSessions := oldBastion.Status.RecordedSessions; oldRecordedSessions != nil && *recordedSessions < *oldRecordedSessions {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "must not be decreased"))
+		}
+	}
+
+	return allErrs
+}
+
+var availableBastionSessionActions = sets.New(
+	string(operations.BastionSessionActionCreate),
+	string(operations.BastionSessionActionExtend),
+	string(operations.BastionSessionActionExpire),
+	string(operations.BastionSessionActionDelete),
+)
+
+// validateBastionSessionsUpdate ensures that the session history of a Bastion is only appended to.
+func validateBastionSessionsUpdate(newSessions, oldSessions []operations.BastionSession, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(newSessions) < len(oldSessions) || !apiequality.Semantic.DeepEqual(newSessions[:len(oldSessions)], oldSessions) {
+		return append(allErrs, field.Forbidden(fldPath, "existing sessions must not be changed or removed"))
+	}
+
+	for i := len(oldSessions); i < len(newSessions); i++ {
+		idxPath := fldPath.Index(i)
+		session := newSessions[i]
+
+		if !availableBastionSessionActions.Has(string(session.Action)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("action"), session.Action, sets.List(availableBastionSessionActions)))
+		}
+		if len(session.User) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("user"), "user must be set"))
+		}
+		if session.Timestamp.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("timestamp"), "timestamp must be set"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateBastionExtensionRequest validates a BastionExtensionRequest object.
+func ValidateBastionExtensionRequest(extensionRequest *operations.BastionExtensionRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if extensionRequest.Spec.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "duration"), extensionRequest.Spec.Duration.Duration.String(), "duration must be positive"))
+	}
+
 	return allErrs
 }
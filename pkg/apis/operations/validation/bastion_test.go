@@ -15,6 +15,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -22,6 +24,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/operations"
 	. "github.com/gardener/gardener/pkg/apis/operations/validation"
@@ -149,6 +152,150 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.sshPublicKey"),
 			}))))
 		})
+
+		It("should allow a positive session duration", func() {
+			bastion.Spec.SessionDuration = &metav1.Duration{Duration: time.Hour}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid a non-positive session duration", func() {
+			bastion.Spec.SessionDuration = &metav1.Duration{}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.sessionDuration"),
+			}))))
+		})
+
+		It("should forbid changing the session duration", func() {
+			bastion.Spec.SessionDuration = &metav1.Duration{Duration: time.Hour}
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.SessionDuration = &metav1.Duration{Duration: 2 * time.Hour}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.sessionDuration"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateBastionStatusUpdate", func() {
+		BeforeEach(func() {
+			lastHeartbeat := metav1.Now()
+			bastion.Status.LastHeartbeatTimestamp = &lastHeartbeat
+			bastion.Status.Sessions = []operations.BastionSession{{
+				Action:    operations.BastionSessionActionCreate,
+				User:      "foo",
+				Timestamp: lastHeartbeat,
+			}}
+		})
+
+		It("should allow appending sessions", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.Sessions = append(newBastion.Status.Sessions, operations.BastionSession{
+				Action:    operations.BastionSessionActionExpire,
+				User:      "bar",
+				Timestamp: metav1.Now(),
+			})
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(BeEmpty())
+		})
+
+		It("should forbid changing existing sessions", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.Sessions[0].User = "bar"
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("status.sessions"),
+			}))))
+		})
+
+		It("should forbid removing sessions", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.Sessions = nil
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("status.sessions"),
+			}))))
+		})
+
+		It("should forbid invalid new sessions", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.Sessions = append(newBastion.Status.Sessions, operations.BastionSession{Action: "Foo"})
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.sessions[1].action"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.sessions[1].user"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.sessions[1].timestamp"),
+				})),
+			))
+		})
+
+		It("should allow increasing the number of recorded sessions", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.RecordedSessions = pointer.Int32(1)
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(BeEmpty())
+		})
+
+		It("should forbid recording more sessions than exist", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.RecordedSessions = pointer.Int32(2)
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("status.recordedSessions"),
+			}))))
+		})
+
+		It("should forbid decreasing the number of recorded sessions", func() {
+			bastion.Status.RecordedSessions = pointer.Int32(1)
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.RecordedSessions = pointer.Int32(0)
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("status.recordedSessions"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateBastionExtensionRequest", func() {
+		It("should allow a positive duration", func() {
+			extensionRequest := &operations.BastionExtensionRequest{
+				Spec: operations.BastionExtensionRequestSpec{Duration: metav1.Duration{Duration: time.Hour}},
+			}
+
+			Expect(ValidateBastionExtensionRequest(extensionRequest)).To(BeEmpty())
+		})
+
+		It("should forbid a non-positive duration", func() {
+			extensionRequest := &operations.BastionExtensionRequest{
+				Spec: operations.BastionExtensionRequestSpec{Duration: metav1.Duration{Duration: -time.Hour}},
+			}
+
+			Expect(ValidateBastionExtensionRequest(extensionRequest)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.duration"),
+			}))))
+		})
 	})
 })
 
@@ -23,7 +23,8 @@ package operations
 
 import (
 	core "github.com/gardener/gardener/pkg/apis/core"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -55,6 +56,68 @@ func (in *Bastion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionExtensionRequest) DeepCopyInto(out *BastionExtensionRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionExtensionRequest.
+func (in *BastionExtensionRequest) DeepCopy() *BastionExtensionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionExtensionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BastionExtensionRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionExtensionRequestSpec) DeepCopyInto(out *BastionExtensionRequestSpec) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionExtensionRequestSpec.
+func (in *BastionExtensionRequestSpec) DeepCopy() *BastionExtensionRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionExtensionRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionExtensionRequestStatus) DeepCopyInto(out *BastionExtensionRequestStatus) {
+	*out = *in
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionExtensionRequestStatus.
+func (in *BastionExtensionRequestStatus) DeepCopy() *BastionExtensionRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionExtensionRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionIngressPolicy) DeepCopyInto(out *BastionIngressPolicy) {
 	*out = *in
@@ -105,6 +168,27 @@ func (in *BastionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSession) DeepCopyInto(out *BastionSession) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSession.
+func (in *BastionSession) DeepCopy() *BastionSession {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 	*out = *in
@@ -126,6 +210,11 @@ func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SessionDuration != nil {
+		in, out := &in.SessionDuration, &out.SessionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -144,7 +233,7 @@ func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 	*out = *in
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
-		*out = new(v1.LoadBalancerIngress)
+		*out = new(corev1.LoadBalancerIngress)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Conditions != nil {
@@ -167,6 +256,18 @@ func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = make([]BastionSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RecordedSessions != nil {
+		in, out := &in.RecordedSessions, &out.RecordedSessions
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -156,6 +156,17 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		}
 
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeAPIServer(coreKubeAPIServerConfig, virtualCluster.Kubernetes.Version, true, gardenerutils.DefaultResourcesForEncryption(), path)...)
+
+		if kubeAPIServer.KubeAPIServerConfig.Authorization != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("authorization"), "the authorization webhook of the virtual garden cluster is managed by gardener-operator"))
+		}
+		if kubeAPIServer.KubeAPIServerConfig.StructuredAuthorization != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("structuredAuthorization"), "the authorization of the virtual garden cluster is managed by gardener-operator"))
+		}
+
+		if encryptionConfig := kubeAPIServer.KubeAPIServerConfig.EncryptionConfig; encryptionConfig != nil && encryptionConfig.Provider != nil && encryptionConfig.Provider.Type != gardencorev1beta1.EncryptionProviderTypeAESCBC {
+			allErrs = append(allErrs, field.NotSupported(path.Child("encryptionConfig", "provider", "type"), encryptionConfig.Provider.Type, []string{gardencorev1beta1.EncryptionProviderTypeAESCBC}))
+		}
 	}
 
 	if kubeControllerManager := virtualCluster.Kubernetes.KubeControllerManager; kubeControllerManager != nil && kubeControllerManager.KubeControllerManagerConfig != nil {
@@ -503,10 +514,10 @@ func validateEncryptionConfigUpdate(oldGarden, newGarden *operatorv1alpha1.Garde
 	}
 
 	currentEncryptedKubernetesResources := utils.FilterEntriesByFilterFn(newGarden.Status.EncryptedResources, gardenerutils.IsServedByKubeAPIServer)
-	allErrs = append(allErrs, gardencorevalidation.ValidateEncryptionConfigUpdate(newKubeAPIServerEncryptionConfig, oldKubeAPIServerEncryptionConfig, sets.New(currentEncryptedKubernetesResources...), etcdEncryptionKeyRotation, false, kubeAPIServerEncryptionConfigFldPath)...)
+	allErrs = append(allErrs, gardencorevalidation.ValidateEncryptionConfigUpdate(newKubeAPIServerEncryptionConfig, oldKubeAPIServerEncryptionConfig, nil, sets.New(currentEncryptedKubernetesResources...), etcdEncryptionKeyRotation, false, kubeAPIServerEncryptionConfigFldPath)...)
 
 	currentEncryptedGardenerResources := utils.FilterEntriesByFilterFn(newGarden.Status.EncryptedResources, gardenerutils.IsServedByGardenerAPIServer)
-	allErrs = append(allErrs, gardencorevalidation.ValidateEncryptionConfigUpdate(newGAPIServerEncryptionConfig, oldGAPIServerEncryptionConfig, sets.New(currentEncryptedGardenerResources...), etcdEncryptionKeyRotation, false, gAPIServerEncryptionConfigFldPath)...)
+	allErrs = append(allErrs, gardencorevalidation.ValidateEncryptionConfigUpdate(newGAPIServerEncryptionConfig, oldGAPIServerEncryptionConfig, nil, sets.New(currentEncryptedGardenerResources...), etcdEncryptionKeyRotation, false, gAPIServerEncryptionConfigFldPath)...)
 
 	return allErrs
 }
@@ -921,6 +921,42 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("KubeAPIServer", func() {
+				It("should forbid configuring an authorization webhook", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.KubeAPIServerConfig.Authorization = &gardencorev1beta1.Authorization{
+						Webhook: &gardencorev1beta1.AuthorizationWebhook{KubeconfigSecretName: "authz-webhook"},
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.authorization"),
+					}))))
+				})
+
+				It("should forbid configuring structured authorization", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.KubeAPIServerConfig.StructuredAuthorization = &gardencorev1beta1.StructuredAuthorization{
+						ConfigMapName: "authz-config",
+					}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.virtualCluster.kubernetes.kubeAPIServer.structuredAuthorization"),
+						"Detail": ContainSubstring("managed by gardener-operator"),
+					}))))
+				})
+
+				It("should forbid configuring a KMS encryption provider", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.KubeAPIServerConfig.EncryptionConfig = &gardencorev1beta1.EncryptionConfig{
+						Provider: &gardencorev1beta1.EncryptionProvider{Type: "kms-foo"},
+					}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.encryptionConfig.provider.type"),
+					}))))
+				})
+			})
+
 			Context("Gardener", func() {
 				Context("APIServer", func() {
 					BeforeEach(func() {
@@ -272,6 +272,10 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// DriftedResources is a list of objects that have been modified out of band, i.e., whose actual state differed from
+	// the desired state although the desired state did not change. It is only maintained if drift detection is enabled.
+	// +optional
+	DriftedResources []DriftedObjectReference `json:"driftedResources,omitempty"`
 }
 
 // ObjectReference is a reference to another object.
@@ -283,6 +287,16 @@ type ObjectReference struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// DriftedObjectReference is a reference to an object whose actual state differed from its desired state.
+type DriftedObjectReference struct {
+	corev1.ObjectReference `json:",inline"`
+	// Fields is a list of paths to the fields whose actual state differed from the desired state.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// LastDetectionTime is the last time the drift of the object was detected.
+	LastDetectionTime metav1.Time `json:"lastDetectionTime"`
+}
+
 const (
 	// ResourcesApplied is a condition type that indicates whether all resources are applied to the target cluster.
 	ResourcesApplied gardencorev1beta1.ConditionType = "ResourcesApplied"
@@ -290,6 +304,8 @@ const (
 	ResourcesHealthy gardencorev1beta1.ConditionType = "ResourcesHealthy"
 	// ResourcesProgressing is a condition type that indicates whether some resources are still progressing to be rolled out.
 	ResourcesProgressing gardencorev1beta1.ConditionType = "ResourcesProgressing"
+	// ResourcesDrifted is a condition type that indicates whether some resources have been modified out of band.
+	ResourcesDrifted gardencorev1beta1.ConditionType = "ResourcesDrifted"
 )
 
 // These are well-known reasons for Conditions.
@@ -321,4 +337,10 @@ const (
 	// ConditionChecksPending indicates that the `ResourcesProgressing` condition is `Unknown`,
 	// because the condition checks have not been completely executed yet for the current set of resources.
 	ConditionChecksPending = "ChecksPending"
+	// ConditionDriftDetected indicates that the `ResourcesDrifted` condition is `True`,
+	// because the actual state of some resources differed from their desired state.
+	ConditionDriftDetected = "DriftDetected"
+	// ConditionNoDriftDetected indicates that the `ResourcesDrifted` condition is `False`,
+	// because the actual state of all resources matched their desired state.
+	ConditionNoDriftDetected = "NoDriftDetected"
 )
@@ -28,6 +28,29 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedObjectReference) DeepCopyInto(out *DriftedObjectReference) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastDetectionTime.DeepCopyInto(&out.LastDetectionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedObjectReference.
+func (in *DriftedObjectReference) DeepCopy() *DriftedObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedResource) DeepCopyInto(out *ManagedResource) {
 	*out = *in
@@ -175,6 +198,13 @@ func (in *ManagedResourceStatus) DeepCopyInto(out *ManagedResourceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
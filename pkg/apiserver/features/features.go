@@ -23,6 +23,7 @@ import (
 // RegisterFeatureGates registers the feature gates of gardener-apiserver.
 func RegisterFeatureGates() {
 	utilruntime.Must(features.DefaultFeatureGate.Add(features.GetFeatures(
+		features.DualStackNetworking,
 		features.IPv6SingleStack,
 		features.MutableShootSpecNetworkingNodes,
 		features.ShootForceDeletion,
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
 	managedseedshoot "github.com/gardener/gardener/plugin/pkg/managedseed/shoot"
 	managedseedvalidator "github.com/gardener/gardener/plugin/pkg/managedseed/validator"
+	namespacedcloudprofilevalidator "github.com/gardener/gardener/plugin/pkg/namespacedcloudprofile/validator"
 	projectvalidator "github.com/gardener/gardener/plugin/pkg/project/validator"
 	seedvalidator "github.com/gardener/gardener/plugin/pkg/seed/validator"
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
@@ -57,6 +58,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	shootdnsrewriting.Register(plugins)
 	shootvalidator.Register(plugins)
 	seedvalidator.Register(plugins)
+	namespacedcloudprofilevalidator.Register(plugins)
 	controllerregistrationresources.Register(plugins)
 	projectvalidator.Register(plugins)
 	openidconnectpreset.Register(plugins)
@@ -35,6 +35,7 @@ type CoreInterface interface {
 	ControllerRegistrationsGetter
 	ExposureClassesGetter
 	InternalSecretsGetter
+	NamespacedCloudProfilesGetter
 	ProjectsGetter
 	QuotasGetter
 	SecretBindingsGetter
@@ -80,6 +81,10 @@ func (c *CoreClient) InternalSecrets(namespace string) InternalSecretInterface {
 	return newInternalSecrets(c, namespace)
 }
 
+func (c *CoreClient) NamespacedCloudProfiles(namespace string) NamespacedCloudProfileInterface {
+	return newNamespacedCloudProfiles(c, namespace)
+}
+
 func (c *CoreClient) Projects() ProjectInterface {
 	return newProjects(c)
 }
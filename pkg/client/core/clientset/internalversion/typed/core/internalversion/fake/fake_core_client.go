@@ -60,6 +60,10 @@ func (c *FakeCore) InternalSecrets(namespace string) internalversion.InternalSec
 	return &FakeInternalSecrets{c, namespace}
 }
 
+func (c *FakeCore) NamespacedCloudProfiles(namespace string) internalversion.NamespacedCloudProfileInterface {
+	return &FakeNamespacedCloudProfiles{c, namespace}
+}
+
 func (c *FakeCore) Projects() internalversion.ProjectInterface {
 	return &FakeProjects{c}
 }
@@ -40,6 +40,7 @@ type QuotasGetter interface {
 type QuotaInterface interface {
 	Create(ctx context.Context, quota *core.Quota, opts v1.CreateOptions) (*core.Quota, error)
 	Update(ctx context.Context, quota *core.Quota, opts v1.UpdateOptions) (*core.Quota, error)
+	UpdateStatus(ctx context.Context, quota *core.Quota, opts v1.UpdateOptions) (*core.Quota, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*core.Quota, error)
@@ -135,6 +136,22 @@ func (c *quotas) Update(ctx context.Context, quota *core.Quota, opts v1.UpdateOp
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *quotas) UpdateStatus(ctx context.Context, quota *core.Quota, opts v1.UpdateOptions) (result *core.Quota, err error) {
+	result = &core.Quota{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("quotas").
+		Name(quota.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(quota).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the quota and deletes it. Returns an error if one occurs.
 func (c *quotas) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
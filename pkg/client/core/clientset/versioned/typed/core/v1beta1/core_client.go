@@ -36,6 +36,7 @@ type CoreV1beta1Interface interface {
 	ControllerRegistrationsGetter
 	ExposureClassesGetter
 	InternalSecretsGetter
+	NamespacedCloudProfilesGetter
 	ProjectsGetter
 	QuotasGetter
 	SecretBindingsGetter
@@ -81,6 +82,10 @@ func (c *CoreV1beta1Client) InternalSecrets(namespace string) InternalSecretInte
 	return newInternalSecrets(c, namespace)
 }
 
+func (c *CoreV1beta1Client) NamespacedCloudProfiles(namespace string) NamespacedCloudProfileInterface {
+	return newNamespacedCloudProfiles(c, namespace)
+}
+
 func (c *CoreV1beta1Client) Projects() ProjectInterface {
 	return newProjects(c)
 }
@@ -0,0 +1,195 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NamespacedCloudProfilesGetter has a method to return a NamespacedCloudProfileInterface.
+// A group's client should implement this interface.
+type NamespacedCloudProfilesGetter interface {
+	NamespacedCloudProfiles(namespace string) NamespacedCloudProfileInterface
+}
+
+// NamespacedCloudProfileInterface has methods to work with NamespacedCloudProfile resources.
+type NamespacedCloudProfileInterface interface {
+	Create(ctx context.Context, namespacedCloudProfile *v1beta1.NamespacedCloudProfile, opts v1.CreateOptions) (*v1beta1.NamespacedCloudProfile, error)
+	Update(ctx context.Context, namespacedCloudProfile *v1beta1.NamespacedCloudProfile, opts v1.UpdateOptions) (*v1beta1.NamespacedCloudProfile, error)
+	UpdateStatus(ctx context.Context, namespacedCloudProfile *v1beta1.NamespacedCloudProfile, opts v1.UpdateOptions) (*v1beta1.NamespacedCloudProfile, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.NamespacedCloudProfile, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.NamespacedCloudProfileList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NamespacedCloudProfile, err error)
+	NamespacedCloudProfileExpansion
+}
+
+// namespacedCloudProfiles implements NamespacedCloudProfileInterface
+type namespacedCloudProfiles struct {
+	client rest.Interface
+	ns     string
+}
+
+// newNamespacedCloudProfiles returns a NamespacedCloudProfiles
+func newNamespacedCloudProfiles(c *CoreV1beta1Client, namespace string) *namespacedCloudProfiles {
+	return &namespacedCloudProfiles{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the namespacedCloudProfile, and returns the corresponding namespacedCloudProfile object, and an error if there is any.
+func (c *namespacedCloudProfiles) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.NamespacedCloudProfile, err error) {
+	result = &v1beta1.NamespacedCloudProfile{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NamespacedCloudProfiles that match those selectors.
+func (c *namespacedCloudProfiles) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.NamespacedCloudProfileList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.NamespacedCloudProfileList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested namespacedCloudProfiles.
+func (c *namespacedCloudProfiles) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a namespacedCloudProfile and creates it.  Returns the server's representation of the namespacedCloudProfile, and an error, if there is any.
+func (c *namespacedCloudProfiles) Create(ctx context.Context, namespacedCloudProfile *v1beta1.NamespacedCloudProfile, opts v1.CreateOptions) (result *v1beta1.NamespacedCloudProfile, err error) {
+	result = &v1beta1.NamespacedCloudProfile{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(namespacedCloudProfile).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a namespacedCloudProfile and updates it. Returns the server's representation of the namespacedCloudProfile, and an error, if there is any.
+func (c *namespacedCloudProfiles) Update(ctx context.Context, namespacedCloudProfile *v1beta1.NamespacedCloudProfile, opts v1.UpdateOptions) (result *v1beta1.NamespacedCloudProfile, err error) {
+	result = &v1beta1.NamespacedCloudProfile{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		Name(namespacedCloudProfile.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(namespacedCloudProfile).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *namespacedCloudProfiles) UpdateStatus(ctx context.Context, namespacedCloudProfile *v1beta1.NamespacedCloudProfile, opts v1.UpdateOptions) (result *v1beta1.NamespacedCloudProfile, err error) {
+	result = &v1beta1.NamespacedCloudProfile{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		Name(namespacedCloudProfile.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(namespacedCloudProfile).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the namespacedCloudProfile and deletes it. Returns an error if one occurs.
+func (c *namespacedCloudProfiles) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *namespacedCloudProfiles) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched namespacedCloudProfile.
+func (c *namespacedCloudProfiles) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NamespacedCloudProfile, err error) {
+	result = &v1beta1.NamespacedCloudProfile{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("namespacedcloudprofiles").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -40,6 +40,8 @@ type Interface interface {
 	ExposureClasses() ExposureClassInformer
 	// InternalSecrets returns a InternalSecretInformer.
 	InternalSecrets() InternalSecretInformer
+	// NamespacedCloudProfiles returns a NamespacedCloudProfileInformer.
+	NamespacedCloudProfiles() NamespacedCloudProfileInformer
 	// Projects returns a ProjectInformer.
 	Projects() ProjectInformer
 	// Quotas returns a QuotaInformer.
@@ -105,6 +107,11 @@ func (v *version) InternalSecrets() InternalSecretInformer {
 	return &internalSecretInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NamespacedCloudProfiles returns a NamespacedCloudProfileInformer.
+func (v *version) NamespacedCloudProfiles() NamespacedCloudProfileInformer {
+	return &namespacedCloudProfileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Projects returns a ProjectInformer.
 func (v *version) Projects() ProjectInformer {
 	return &projectInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
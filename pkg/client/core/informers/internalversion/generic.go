@@ -69,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().InternalVersion().ExposureClasses().Informer()}, nil
 	case core.SchemeGroupVersion.WithResource("internalsecrets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().InternalVersion().InternalSecrets().Informer()}, nil
+	case core.SchemeGroupVersion.WithResource("namespacedcloudprofiles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().InternalVersion().NamespacedCloudProfiles().Informer()}, nil
 	case core.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().InternalVersion().Projects().Informer()}, nil
 	case core.SchemeGroupVersion.WithResource("quotas"):
@@ -0,0 +1,99 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	core "github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NamespacedCloudProfileLister helps list NamespacedCloudProfiles.
+// All objects returned here must be treated as read-only.
+type NamespacedCloudProfileLister interface {
+	// List lists all NamespacedCloudProfiles in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*core.NamespacedCloudProfile, err error)
+	// NamespacedCloudProfiles returns an object that can list and get NamespacedCloudProfiles.
+	NamespacedCloudProfiles(namespace string) NamespacedCloudProfileNamespaceLister
+	NamespacedCloudProfileListerExpansion
+}
+
+// namespacedCloudProfileLister implements the NamespacedCloudProfileLister interface.
+type namespacedCloudProfileLister struct {
+	indexer cache.Indexer
+}
+
+// NewNamespacedCloudProfileLister returns a new NamespacedCloudProfileLister.
+func NewNamespacedCloudProfileLister(indexer cache.Indexer) NamespacedCloudProfileLister {
+	return &namespacedCloudProfileLister{indexer: indexer}
+}
+
+// List lists all NamespacedCloudProfiles in the indexer.
+func (s *namespacedCloudProfileLister) List(selector labels.Selector) (ret []*core.NamespacedCloudProfile, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*core.NamespacedCloudProfile))
+	})
+	return ret, err
+}
+
+// NamespacedCloudProfiles returns an object that can list and get NamespacedCloudProfiles.
+func (s *namespacedCloudProfileLister) NamespacedCloudProfiles(namespace string) NamespacedCloudProfileNamespaceLister {
+	return namespacedCloudProfileNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// NamespacedCloudProfileNamespaceLister helps list and get NamespacedCloudProfiles.
+// All objects returned here must be treated as read-only.
+type NamespacedCloudProfileNamespaceLister interface {
+	// List lists all NamespacedCloudProfiles in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*core.NamespacedCloudProfile, err error)
+	// Get retrieves the NamespacedCloudProfile from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*core.NamespacedCloudProfile, error)
+	NamespacedCloudProfileNamespaceListerExpansion
+}
+
+// namespacedCloudProfileNamespaceLister implements the NamespacedCloudProfileNamespaceLister
+// interface.
+type namespacedCloudProfileNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all NamespacedCloudProfiles in the indexer for a given namespace.
+func (s namespacedCloudProfileNamespaceLister) List(selector labels.Selector) (ret []*core.NamespacedCloudProfile, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*core.NamespacedCloudProfile))
+	})
+	return ret, err
+}
+
+// Get retrieves the NamespacedCloudProfile from the indexer for a given namespace and name.
+func (s namespacedCloudProfileNamespaceLister) Get(name string) (*core.NamespacedCloudProfile, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(core.Resource("namespacedcloudprofile"), name)
+	}
+	return obj.(*core.NamespacedCloudProfile), nil
+}
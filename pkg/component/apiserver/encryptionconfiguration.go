@@ -97,20 +97,14 @@ func ReconcileSecretETCDEncryptionConfiguration(
 	var (
 		keySecretOld, _         = secretsManager.Get(secretNameETCDEncryptionKey, secretsmanager.Old)
 		encryptionKeys          = etcdEncryptionAESKeys(keySecret, keySecretOld, config.EncryptWithCurrentKey)
+		encryptionProviders     = etcdEncryptionProviders(config, encryptionKeys)
 		encryptionConfiguration = &apiserverconfigv1.EncryptionConfiguration{
 			Resources: []apiserverconfigv1.ResourceConfiguration{
 				{
 					Resources: config.ResourcesToEncrypt,
-					Providers: []apiserverconfigv1.ProviderConfiguration{
-						{
-							AESCBC: &apiserverconfigv1.AESConfiguration{
-								Keys: encryptionKeys,
-							},
-						},
-						{
-							Identity: &apiserverconfigv1.IdentityConfiguration{},
-						},
-					},
+					Providers: append(encryptionProviders, apiserverconfigv1.ProviderConfiguration{
+						Identity: &apiserverconfigv1.IdentityConfiguration{},
+					}),
 				},
 			},
 		}
@@ -121,16 +115,11 @@ func ReconcileSecretETCDEncryptionConfiguration(
 		if removedResources.Len() > 0 {
 			encryptionConfiguration.Resources = append(encryptionConfiguration.Resources, apiserverconfigv1.ResourceConfiguration{
 				Resources: sets.List(removedResources),
-				Providers: []apiserverconfigv1.ProviderConfiguration{
+				Providers: append([]apiserverconfigv1.ProviderConfiguration{
 					{
 						Identity: &apiserverconfigv1.IdentityConfiguration{},
 					},
-					{
-						AESCBC: &apiserverconfigv1.AESConfiguration{
-							Keys: encryptionKeys,
-						},
-					},
-				},
+				}, encryptionProviders...),
 			})
 		}
 	}
@@ -158,6 +147,40 @@ func ReconcileSecretETCDEncryptionConfiguration(
 	return c.Patch(ctx, secretETCDEncryptionConfiguration, patch)
 }
 
+func etcdEncryptionProviders(config ETCDEncryptionConfig, encryptionKeys []apiserverconfigv1.Key) []apiserverconfigv1.ProviderConfiguration {
+	provider := etcdEncryptionProvider(config.ProviderType, encryptionKeys)
+	if config.PreviousProviderType == "" || config.PreviousProviderType == config.ProviderType {
+		return []apiserverconfigv1.ProviderConfiguration{provider}
+	}
+
+	// During the migration to a new encryption provider, the previous provider is kept for decrypting the resources
+	// which have not been re-encrypted yet. The new provider is only used for encryption once it is known to all API
+	// server instances.
+	previousProvider := etcdEncryptionProvider(config.PreviousProviderType, encryptionKeys)
+	if !config.EncryptWithCurrentKey {
+		return []apiserverconfigv1.ProviderConfiguration{previousProvider, provider}
+	}
+	return []apiserverconfigv1.ProviderConfiguration{provider, previousProvider}
+}
+
+func etcdEncryptionProvider(providerType string, encryptionKeys []apiserverconfigv1.Key) apiserverconfigv1.ProviderConfiguration {
+	if !isKMSProvider(providerType) {
+		return apiserverconfigv1.ProviderConfiguration{
+			AESCBC: &apiserverconfigv1.AESConfiguration{
+				Keys: encryptionKeys,
+			},
+		}
+	}
+
+	return apiserverconfigv1.ProviderConfiguration{
+		KMS: &apiserverconfigv1.KMSConfiguration{
+			APIVersion: "v2",
+			Name:       providerType,
+			Endpoint:   fmt.Sprintf("unix://%s/%s.sock", v1beta1constants.VolumeMountPathKMSPluginSocket, providerType),
+		},
+	}
+}
+
 func etcdEncryptionAESKeys(keySecretCurrent, keySecretOld *corev1.Secret, encryptWithCurrentKey bool) []apiserverconfigv1.Key {
 	if keySecretOld == nil {
 		return []apiserverconfigv1.Key{
@@ -184,7 +207,7 @@ func aesKeyFromSecretData(data map[string][]byte) apiserverconfigv1.Key {
 }
 
 // InjectEncryptionSettings injects the encryption settings into `gardener-apiserver` and `kube-apiserver` deployments.
-func InjectEncryptionSettings(deployment *appsv1.Deployment, secretETCDEncryptionConfiguration *corev1.Secret) {
+func InjectEncryptionSettings(deployment *appsv1.Deployment, secretETCDEncryptionConfiguration *corev1.Secret, config ETCDEncryptionConfig) {
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--encryption-provider-config=%s/%s", volumeMountPathEtcdEncryptionConfig, secretETCDEncryptionConfigurationDataKey))
 	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
 		Name:      volumeNameEtcdEncryptionConfig,
@@ -200,4 +223,21 @@ func InjectEncryptionSettings(deployment *appsv1.Deployment, secretETCDEncryptio
 			},
 		},
 	})
+
+	if !config.UsesKMSPlugin() {
+		return
+	}
+
+	// The KMS v2 plugins are injected as sidecar containers by the respective extensions and create their sockets in
+	// this shared volume.
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      v1beta1constants.VolumeNameKMSPluginSocket,
+		MountPath: v1beta1constants.VolumeMountPathKMSPluginSocket,
+	})
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: v1beta1constants.VolumeNameKMSPluginSocket,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
 }
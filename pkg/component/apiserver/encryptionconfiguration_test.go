@@ -269,6 +269,71 @@ resources:
 			Entry("encrypting with current", true),
 			Entry("encrypting with old", false),
 		)
+
+		It("should successfully deploy the ETCD encryption configuration secret resource with a KMS provider", func() {
+			config = ETCDEncryptionConfig{ResourcesToEncrypt: []string{"foo"}, EncryptedResources: []string{"foo"}, ProviderType: "kms-foo"}
+
+			actualSecretETCDEncryptionConfiguration := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "apiserver-encryption-config", Namespace: namespace}}
+			Expect(ReconcileSecretETCDEncryptionConfiguration(ctx, fakeClient, fakeSecretManager, config, actualSecretETCDEncryptionConfiguration, secretNameETCDEncryptionKey, encryptionRoleLabel)).To(Succeed())
+
+			Expect(string(actualSecretETCDEncryptionConfiguration.Data["encryption-configuration.yaml"])).To(Equal(`apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kmsplugin/kms-foo.sock
+      name: kms-foo
+  - identity: {}
+  resources:
+  - foo
+`))
+		})
+
+		DescribeTable("successfully deploy the ETCD encryption configuration secret resource during the migration of the encryption provider",
+			func(encryptWithCurrentKey bool) {
+				config = ETCDEncryptionConfig{
+					ResourcesToEncrypt:    []string{"foo"},
+					EncryptedResources:    []string{"foo"},
+					EncryptWithCurrentKey: encryptWithCurrentKey,
+					ProviderType:          "kms-foo",
+					PreviousProviderType:  "aescbc",
+				}
+
+				var (
+					aescbcProvider = `  - aescbc:
+      keys:
+      - name: key-62135596800
+        secret: ________________________________
+`
+					kmsProvider = `  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kmsplugin/kms-foo.sock
+      name: kms-foo
+`
+					providers = aescbcProvider + kmsProvider
+				)
+
+				if encryptWithCurrentKey {
+					providers = kmsProvider + aescbcProvider
+				}
+
+				actualSecretETCDEncryptionConfiguration := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "apiserver-encryption-config", Namespace: namespace}}
+				Expect(ReconcileSecretETCDEncryptionConfiguration(ctx, fakeClient, fakeSecretManager, config, actualSecretETCDEncryptionConfiguration, secretNameETCDEncryptionKey, encryptionRoleLabel)).To(Succeed())
+
+				Expect(string(actualSecretETCDEncryptionConfiguration.Data["encryption-configuration.yaml"])).To(Equal(`apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+` + providers + `  - identity: {}
+  resources:
+  - foo
+`))
+			},
+
+			Entry("encrypting with new provider", true),
+			Entry("encrypting with previous provider", false),
+		)
 	})
 
 	Describe("#InjectEncryptionSettings", func() {
@@ -278,7 +343,7 @@ resources:
 
 			secretETCDEncryptionConfiguration := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "etcd-enc-config"}}
 
-			InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
+			InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration, ETCDEncryptionConfig{})
 
 			Expect(deployment).To(Equal(&appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
@@ -308,5 +373,25 @@ resources:
 				},
 			}))
 		})
+
+		It("should inject the socket volume for KMS plugins", func() {
+			deployment := &appsv1.Deployment{}
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{})
+
+			secretETCDEncryptionConfiguration := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "etcd-enc-config"}}
+
+			InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration, ETCDEncryptionConfig{ProviderType: "aescbc", PreviousProviderType: "kms-foo"})
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "kms-plugin-socket",
+				MountPath: "/var/run/kmsplugin",
+			}))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "kms-plugin-socket",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			}))
+		})
 	})
 })
@@ -118,4 +118,20 @@ type ETCDEncryptionConfig struct {
 	ResourcesToEncrypt []string
 	// EncryptedResources are the resources which are currently encrypted.
 	EncryptedResources []string
+	// ProviderType is the type of the encryption provider which should be used for the encryption of resources. An
+	// empty value or `aescbc` denotes a static AES-CBC key, any other value denotes a KMS v2 plugin.
+	ProviderType string
+	// PreviousProviderType is the type of the encryption provider which was used for the encryption of resources before
+	// the migration to the provider of type `ProviderType`. It is only set while the resources are being re-encrypted.
+	// If `EncryptWithCurrentKey` is false then the previous provider is still used for encryption.
+	PreviousProviderType string
+}
+
+// UsesKMSPlugin returns true if any of the configured encryption providers is a KMS v2 plugin.
+func (c ETCDEncryptionConfig) UsesKMSPlugin() bool {
+	return isKMSProvider(c.ProviderType) || isKMSProvider(c.PreviousProviderType)
+}
+
+func isKMSProvider(providerType string) bool {
+	return providerType != "" && providerType != gardencorev1beta1.EncryptionProviderTypeAESCBC
 }
@@ -88,12 +88,17 @@ type Values struct {
 	ClusterDomain string
 	// ClusterIP is the IP address which should be used as `.spec.clusterIP` in the Service spec.
 	ClusterIP string
+	// SecondaryClusterIP is the IP address of the secondary IP family which should be added to `.spec.clusterIPs` in
+	// the Service spec. It is only set for dual-stack networking.
+	SecondaryClusterIP *string
 	// Image is the container image used for CoreDNS.
 	Image string
 	// PodAnnotations is the set of additional annotations to be used for the pods.
 	PodAnnotations map[string]string
 	// PodNetworkCIDR is the CIDR of the pod network.
 	PodNetworkCIDR string
+	// SecondaryPodNetworkCIDR is the CIDR of the secondary pod network. It is only set for dual-stack networking.
+	SecondaryPodNetworkCIDR *string
 	// NodeNetworkCIDR is the CIDR of the node network.
 	NodeNetworkCIDR *string
 	// AutoscalingMode indicates whether cluster proportional autoscaling is enabled.
@@ -697,6 +702,18 @@ import custom/*.server
 		})
 	}
 
+	if c.values.SecondaryClusterIP != nil {
+		ipFamilyPolicy := corev1.IPFamilyPolicyRequireDualStack
+		service.Spec.ClusterIPs = []string{c.values.ClusterIP, *c.values.SecondaryClusterIP}
+		service.Spec.IPFamilyPolicy = &ipFamilyPolicy
+	}
+
+	if c.values.SecondaryPodNetworkCIDR != nil {
+		networkPolicy.Spec.Ingress[0].From = append(networkPolicy.Spec.Ingress[0].From, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: *c.values.SecondaryPodNetworkCIDR},
+		})
+	}
+
 	if c.values.NodeNetworkCIDR != nil {
 		networkPolicy.Spec.Ingress[0].From = append(networkPolicy.Spec.Ingress[0].From, networkingv1.NetworkPolicyPeer{
 			IPBlock: &networkingv1.IPBlock{CIDR: *c.values.NodeNetworkCIDR},
@@ -46,14 +46,16 @@ var _ = Describe("CoreDNS", func() {
 	var (
 		ctx = context.TODO()
 
-		managedResourceName = "shoot-core-coredns"
-		namespace           = "some-namespace"
-		clusterDomain       = "foo.bar"
-		clusterIP           = "1.2.3.4"
-		image               = "some-image:some-tag"
-		cpaImage            = "cpa-image:cpa-tag"
-		podNetworkCIDR      = "5.6.7.8/9"
-		nodeNetworkCIDR     = "10.11.12.13/14"
+		managedResourceName     = "shoot-core-coredns"
+		namespace               = "some-namespace"
+		clusterDomain           = "foo.bar"
+		clusterIP               = "1.2.3.4"
+		image                   = "some-image:some-tag"
+		cpaImage                = "cpa-image:cpa-tag"
+		podNetworkCIDR          = "5.6.7.8/9"
+		secondaryClusterIP      = "2001:db8:2::a"
+		secondaryPodNetworkCIDR = "2001:db8:1::/48"
+		nodeNetworkCIDR         = "10.11.12.13/14"
 
 		c         client.Client
 		values    Values
@@ -180,7 +182,8 @@ metadata:
   name: coredns-custom
   namespace: kube-system
 `
-		serviceYAML = `apiVersion: v1
+		serviceYAMLFor = func(dualStack bool) string {
+			out := `apiVersion: v1
 kind: Service
 metadata:
   creationTimestamp: null
@@ -192,7 +195,15 @@ metadata:
   namespace: kube-system
 spec:
   clusterIP: ` + clusterIP + `
-  ports:
+`
+			if dualStack {
+				out += `  clusterIPs:
+  - ` + clusterIP + `
+  - ` + secondaryClusterIP + `
+  ipFamilyPolicy: RequireDualStack
+`
+			}
+			out += `  ports:
   - name: dns
     port: 53
     protocol: UDP
@@ -209,7 +220,10 @@ spec:
 status:
   loadBalancer: {}
 `
-		networkPolicyYAML = `apiVersion: networking.k8s.io/v1
+			return out
+		}
+		networkPolicyYAMLFor = func(dualStack bool) string {
+			out := `apiVersion: networking.k8s.io/v1
 kind: NetworkPolicy
 metadata:
   annotations:
@@ -234,7 +248,13 @@ spec:
       podSelector: {}
     - ipBlock:
         cidr: ` + podNetworkCIDR + `
-    - ipBlock:
+`
+			if dualStack {
+				out += `    - ipBlock:
+        cidr: ` + secondaryPodNetworkCIDR + `
+`
+			}
+			out += `    - ipBlock:
         cidr: ` + nodeNetworkCIDR + `
     ports:
     - port: 9153
@@ -253,6 +273,8 @@ spec:
   - Ingress
   - Egress
 `
+			return out
+		}
 		deploymentYAMLFor = func(apiserverHost string, podAnnotations map[string]string, keepReplicas bool, useHALabel bool) string {
 			out := `apiVersion: apps/v1
 kind: Deployment
@@ -629,6 +651,7 @@ status: {}
 			rewritingEnabled    bool
 			commonSuffixes      []string
 			k8sGreaterEquals126 bool
+			dualStack           bool
 		)
 
 		BeforeEach(func() {
@@ -637,6 +660,7 @@ status: {}
 			rewritingEnabled = false
 			commonSuffixes = []string{}
 			k8sGreaterEquals126 = false
+			dualStack = false
 		})
 
 		JustBeforeEach(func() {
@@ -687,8 +711,8 @@ status: {}
 			Expect(string(managedResourceSecret.Data["clusterrolebinding____system_coredns.yaml"])).To(Equal(clusterRoleBindingYAML))
 			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns.yaml"])).To(Equal(configMapYAML(rewritingEnabled, commonSuffixes)))
 			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns-custom.yaml"])).To(Equal(configMapCustomYAML))
-			Expect(string(managedResourceSecret.Data["service__kube-system__kube-dns.yaml"])).To(Equal(serviceYAML))
-			Expect(string(managedResourceSecret.Data["networkpolicy__kube-system__gardener.cloud--allow-dns.yaml"])).To(Equal(networkPolicyYAML))
+			Expect(string(managedResourceSecret.Data["service__kube-system__kube-dns.yaml"])).To(Equal(serviceYAMLFor(dualStack)))
+			Expect(string(managedResourceSecret.Data["networkpolicy__kube-system__gardener.cloud--allow-dns.yaml"])).To(Equal(networkPolicyYAMLFor(dualStack)))
 			if k8sGreaterEquals126 {
 				Expect(string(managedResourceSecret.Data["poddisruptionbudget__kube-system__coredns.yaml"])).To(Equal(pdbYAMLFor(true)))
 			} else {
@@ -769,6 +793,18 @@ status: {}
 			})
 		})
 
+		Context("w/ dual-stack networking", func() {
+			BeforeEach(func() {
+				dualStack = true
+				values.SecondaryClusterIP = &secondaryClusterIP
+				values.SecondaryPodNetworkCIDR = &secondaryPodNetworkCIDR
+			})
+
+			It("should successfully deploy all resources", func() {
+				Expect(string(managedResourceSecret.Data["deployment__kube-system__coredns.yaml"])).To(Equal(deploymentYAMLFor("", nil, true, true)))
+			})
+		})
+
 		Context("w/ cluster proportional autoscaler enabled", func() {
 			BeforeEach(func() {
 				cpaEnabled = true
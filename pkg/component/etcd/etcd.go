@@ -777,12 +777,24 @@ func (e *etcd) computeReplicas(existingEtcd *druidv1alpha1.Etcd) int32 {
 
 func (e *etcd) computeDefragmentationSchedule(existingEtcd *druidv1alpha1.Etcd) *string {
 	defragmentationSchedule := e.values.DefragmentationSchedule
-	if existingEtcd != nil && existingEtcd.Spec.Etcd.DefragmentationSchedule != nil {
+	if existingEtcd != nil && existingEtcd.Spec.Etcd.DefragmentationSchedule != nil &&
+		// Schedules restricted to certain days (maintenance weekdays or blackout periods) are always overwritten so that
+		// changes to these restrictions take effect.
+		!isDayRestrictedSchedule(defragmentationSchedule) && !isDayRestrictedSchedule(existingEtcd.Spec.Etcd.DefragmentationSchedule) {
 		defragmentationSchedule = existingEtcd.Spec.Etcd.DefragmentationSchedule
 	}
 	return defragmentationSchedule
 }
 
+// isDayRestrictedSchedule returns true if the month or day-of-week field of the given cron schedule is restricted.
+func isDayRestrictedSchedule(schedule *string) bool {
+	if schedule == nil {
+		return false
+	}
+	fields := strings.Fields(*schedule)
+	return len(fields) == 5 && (fields[3] != "*" || fields[4] != "*")
+}
+
 func (e *etcd) computeFullSnapshotSchedule(existingEtcd *druidv1alpha1.Etcd) *string {
 	fullSnapshotSchedule := &e.values.BackupConfig.FullSnapshotSchedule
 	if existingEtcd != nil && existingEtcd.Spec.Backup.FullSnapshotSchedule != nil {
@@ -885,6 +885,63 @@ var _ = Describe("Etcd", func() {
 			Expect(etcd.Deploy(ctx)).To(Succeed())
 		})
 
+		It("should successfully deploy (normal etcd) and overwrite the existing defragmentation schedule if it is restricted to certain days", func() {
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			existingDefragmentationSchedule := "0 1 * * 1"
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(ctx context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					(&druidv1alpha1.Etcd{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      etcdName,
+							Namespace: testNamespace,
+						},
+						Spec: druidv1alpha1.EtcdSpec{
+							Etcd: druidv1alpha1.EtcdConfig{
+								DefragmentationSchedule: &existingDefragmentationSchedule,
+							},
+						},
+						Status: druidv1alpha1.EtcdStatus{
+							Etcd: &druidv1alpha1.CrossVersionObjectReference{
+								Name: etcdName,
+							},
+						},
+					}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+					return nil
+				}),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(ctx context.Context, obj *druidv1alpha1.Etcd, _ client.Patch, _ ...client.PatchOption) {
+					// ignore status when comparing
+					obj.Status = druidv1alpha1.EtcdStatus{}
+
+					Expect(obj).To(DeepEqual(etcdObjFor(
+						class,
+						1,
+						nil,
+						defragmentationSchedule,
+						"",
+						nil,
+						nil,
+						secretNameCA,
+						secretNameClient,
+						secretNameServer,
+						nil,
+						nil,
+						false)))
+				}),
+				c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()).Do(func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(hvpaFor(class, 1, scaleDownUpdateMode)))
+				}),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
 		It("should successfully deploy (normal etcd) and keep the existing resource request settings (but not limits) to not interfer with HVPA controller", func() {
 			oldTimeNow := TimeNow
 			defer func() { TimeNow = oldTimeNow }()
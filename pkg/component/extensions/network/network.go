@@ -22,6 +22,7 @@ import (
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -61,6 +62,10 @@ type Values struct {
 	PodCIDR *net.IPNet
 	// ServiceCIDR is the Shoot's service CIDR in the Shoot VPC
 	ServiceCIDR *net.IPNet
+	// SecondaryPodCIDR is the Shoot's secondary pod CIDR in the Shoot VPC (only set for dual-stack networking)
+	SecondaryPodCIDR *net.IPNet
+	// SecondaryServiceCIDR is the Shoot's secondary service CIDR in the Shoot VPC (only set for dual-stack networking)
+	SecondaryServiceCIDR *net.IPNet
 }
 
 // New creates a new instance of DeployWaiter for a Network.
@@ -190,6 +195,13 @@ func (n *network) deploy(ctx context.Context, operation string) (extensionsv1alp
 			ServiceCIDR: n.values.ServiceCIDR.String(),
 		}
 
+		if n.values.SecondaryPodCIDR != nil {
+			n.network.Spec.SecondaryPodCIDR = pointer.String(n.values.SecondaryPodCIDR.String())
+		}
+		if n.values.SecondaryServiceCIDR != nil {
+			n.network.Spec.SecondaryServiceCIDR = pointer.String(n.values.SecondaryServiceCIDR.String())
+		}
+
 		return nil
 	})
 
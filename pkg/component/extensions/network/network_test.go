@@ -193,6 +193,31 @@ var _ = Describe("#Network", func() {
 				testFunc()
 			})
 		})
+
+		Context("dual-stack", func() {
+			BeforeEach(func() {
+				secondaryPodCIDR := net.IPNet{
+					IP:   net.ParseIP(networkPodV6IP),
+					Mask: net.CIDRMask(networkPodV6Mask, 128),
+				}
+				secondaryServiceCIDR := net.IPNet{
+					IP:   net.ParseIP(networkServiceV6IP),
+					Mask: net.CIDRMask(networkServiceV6Mask, 128),
+				}
+
+				values.SecondaryPodCIDR = &secondaryPodCIDR
+				values.SecondaryServiceCIDR = &secondaryServiceCIDR
+				values.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+
+				expected.Spec.SecondaryPodCIDR = &networkPodV6CIDR
+				expected.Spec.SecondaryServiceCIDR = &networkServiceV6CIDR
+				expected.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+			})
+
+			It("should create correct Network", func() {
+				testFunc()
+			})
+		})
 	})
 
 	Describe("#Wait", func() {
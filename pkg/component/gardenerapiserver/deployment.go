@@ -152,7 +152,7 @@ func (g *gardenerAPIServer) deployment(
 	apiserver.InjectDefaultSettings(deployment, "virtual-garden-", g.values.Values, secretCAETCD, secretETCDClient, secretServer)
 	apiserver.InjectAuditSettings(deployment, configMapAuditPolicy, secretAuditWebhookKubeconfig, g.values.Audit)
 	apiserver.InjectAdmissionSettings(deployment, configMapAdmissionConfigs, secretAdmissionKubeconfigs, g.values.Values)
-	apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
+	apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration, g.values.ETCDEncryption)
 
 	utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, secretGenericTokenKubeconfig.Name, secretVirtualGardenAccess.Secret.Name))
 	utilruntime.Must(references.InjectAnnotations(deployment))
@@ -230,6 +230,7 @@ var _ = Describe("GardenerScheduler", func() {
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{
 						"cloudprofiles",
+						"namespacedcloudprofiles",
 						"seeds",
 					},
 					Verbs: []string{"get", "list", "watch"},
@@ -237,6 +238,7 @@ var _ = Describe("GardenerScheduler", func() {
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{
+						"backupbuckets",
 						"shoots",
 						"shoots/status",
 					},
@@ -49,6 +49,7 @@ func (g *gardenerScheduler) clusterRole() *rbacv1.ClusterRole {
 				APIGroups: []string{gardencorev1beta1.GroupName},
 				Resources: []string{
 					"cloudprofiles",
+					"namespacedcloudprofiles",
 					"seeds",
 				},
 				Verbs: []string{"get", "list", "watch"},
@@ -56,6 +57,7 @@ func (g *gardenerScheduler) clusterRole() *rbacv1.ClusterRole {
 			{
 				APIGroups: []string{gardencorev1beta1.GroupName},
 				Resources: []string{
+					"backupbuckets",
 					"shoots",
 					"shoots/status",
 				},
@@ -241,6 +241,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 						"backupentries",
 						"cloudprofiles",
 						"controllerinstallations",
+						"namespacedcloudprofiles",
 						"quotas",
 						"projects",
 						"seeds",
@@ -415,6 +416,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					},
 					Verbs: []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"namespacedcloudprofiles"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{settingsv1alpha1.GroupName},
 					Resources: []string{"openidconnectpresets"},
@@ -425,6 +431,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{"bastions"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"bastions/extend"},
+					Verbs:     []string{"create"},
+				},
 				{
 					APIGroups: []string{rbacv1.GroupName},
 					Resources: []string{
@@ -509,6 +520,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 						"shoots",
 						"secretbindings",
 						"quotas",
+						"namespacedcloudprofiles",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
@@ -260,6 +260,7 @@ var _ = Describe("Virtual", func() {
 						"backupentries",
 						"cloudprofiles",
 						"controllerinstallations",
+						"namespacedcloudprofiles",
 						"quotas",
 						"projects",
 						"seeds",
@@ -433,6 +434,11 @@ var _ = Describe("Virtual", func() {
 					},
 					Verbs: []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"namespacedcloudprofiles"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{"settings.gardener.cloud"},
 					Resources: []string{"openidconnectpresets"},
@@ -443,6 +449,11 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{"bastions"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"bastions/extend"},
+					Verbs:     []string{"create"},
+				},
 				{
 					APIGroups: []string{"rbac.authorization.k8s.io"},
 					Resources: []string{
@@ -525,6 +536,7 @@ var _ = Describe("Virtual", func() {
 						"shoots",
 						"secretbindings",
 						"quotas",
+						"namespacedcloudprofiles",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
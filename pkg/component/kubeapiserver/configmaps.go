@@ -27,8 +27,10 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	apiserverv1alpha1 "k8s.io/apiserver/pkg/apis/apiserver/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	"github.com/gardener/gardener/pkg/component/vpnseedserver"
+	"github.com/gardener/gardener/pkg/controllerutils"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
@@ -56,6 +58,45 @@ const (
 	configMapAuditPolicyNamePrefix    = "audit-policy-config"
 	configMapEgressSelectorNamePrefix = "kube-apiserver-egress-selector-config"
 	configMapEgressSelectorDataKey    = "egress-selector-configuration.yaml"
+
+	// configMapAuthenticationConfigName is not unique because kube-apiserver watches the mounted file and reloads the
+	// configuration without requiring a restart.
+	configMapAuthenticationConfigName = "kube-apiserver-authentication-config"
+
+	configMapAuthorizationConfigNamePrefix = "kube-apiserver-authorization-config"
+)
+
+// ConfigMapAuthenticationConfigDataKey is the key in the data of the ConfigMap which contains the structured
+// authentication configuration for the kube-apiserver.
+const ConfigMapAuthenticationConfigDataKey = "config.yaml"
+
+// ConfigMapAuthorizationConfigDataKey is the key in the data of the ConfigMap which contains the structured
+// authorization configuration for the kube-apiserver.
+const ConfigMapAuthorizationConfigDataKey = "config.yaml"
+
+// The following types mirror the parts of the `apiserver.config.k8s.io` `AuthorizationConfiguration` API of
+// kube-apiserver v1.29 which are relevant for Gardener. They are not shipped with the vendored k8s.io/apiserver version
+// yet, hence they are declared here. The webhook configuration is kept opaque so that all of its fields are passed
+// through to kube-apiserver unchanged.
+type authorizationConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Authorizers []authorizerConfiguration `json:"authorizers"`
+}
+
+type authorizerConfiguration struct {
+	Type    string                 `json:"type"`
+	Name    string                 `json:"name"`
+	Webhook map[string]interface{} `json:"webhook,omitempty"`
+}
+
+const (
+	authorizerTypeNode    = "Node"
+	authorizerTypeRBAC    = "RBAC"
+	authorizerTypeWebhook = "Webhook"
+
+	authorizerNameNode = "node"
+	authorizerNameRBAC = "rbac"
 )
 
 func (k *kubeAPIServer) emptyConfigMap(name string) *corev1.ConfigMap {
@@ -108,3 +149,73 @@ func (k *kubeAPIServer) reconcileConfigMapEgressSelector(ctx context.Context, co
 
 	return client.IgnoreAlreadyExists(k.client.Client().Create(ctx, configMap))
 }
+
+func (k *kubeAPIServer) reconcileConfigMapAuthenticationConfig(ctx context.Context, configMap *corev1.ConfigMap) error {
+	if k.values.AuthenticationConfiguration == nil {
+		return kubernetesutils.DeleteObject(ctx, k.client.Client(), configMap)
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.client.Client(), configMap, func() error {
+		configMap.Labels = getLabels()
+		configMap.Data = map[string]string{ConfigMapAuthenticationConfigDataKey: *k.values.AuthenticationConfiguration}
+		return nil
+	})
+	return err
+}
+
+func (k *kubeAPIServer) reconcileConfigMapAuthorizationConfig(ctx context.Context, configMap *corev1.ConfigMap) error {
+	if k.values.AuthorizationConfiguration == nil {
+		// We don't delete the configmap here as we don't know its name (as it's unique). Instead, we rely on the usual
+		// garbage collection for unique secrets/configmaps.
+		return nil
+	}
+
+	data, err := k.computeAuthorizationConfiguration()
+	if err != nil {
+		return err
+	}
+
+	configMap.Data = map[string]string{ConfigMapAuthorizationConfigDataKey: string(data)}
+	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
+
+	return client.IgnoreAlreadyExists(k.client.Client().Create(ctx, configMap))
+}
+
+// computeAuthorizationConfiguration prepends the Node and RBAC authorizers to the webhook authorizers of the
+// configured AuthorizationConfiguration and points the webhooks to their mounted kubeconfigs.
+func (k *kubeAPIServer) computeAuthorizationConfiguration() ([]byte, error) {
+	config := &authorizationConfiguration{}
+	if err := yaml.Unmarshal([]byte(*k.values.AuthorizationConfiguration), config); err != nil {
+		return nil, fmt.Errorf("failed decoding authorization configuration: %w", err)
+	}
+
+	var authorizers []authorizerConfiguration
+	if !k.values.IsWorkerless {
+		authorizers = append(authorizers, authorizerConfiguration{Type: authorizerTypeNode, Name: authorizerNameNode})
+	}
+	authorizers = append(authorizers, authorizerConfiguration{Type: authorizerTypeRBAC, Name: authorizerNameRBAC})
+
+	for _, authorizer := range config.Authorizers {
+		if authorizer.Type != authorizerTypeWebhook {
+			return nil, fmt.Errorf("authorizer %q has unsupported type %q, only authorizers of type %q can be configured", authorizer.Name, authorizer.Type, authorizerTypeWebhook)
+		}
+		if authorizer.Name == authorizerNameNode || authorizer.Name == authorizerNameRBAC {
+			return nil, fmt.Errorf("authorizer name %q is reserved", authorizer.Name)
+		}
+		if _, ok := k.values.AuthorizationKubeconfigs[authorizer.Name]; !ok {
+			return nil, fmt.Errorf("missing kubeconfig for webhook authorizer %q", authorizer.Name)
+		}
+
+		if authorizer.Webhook == nil {
+			authorizer.Webhook = map[string]interface{}{}
+		}
+		authorizer.Webhook["connectionInfo"] = map[string]interface{}{
+			"type":           "KubeConfigFile",
+			"kubeConfigFile": fmt.Sprintf("%s/%s", volumeMountPathAuthorizationKubeconfigs, authorizationKubeconfigDataKey(authorizer.Name)),
+		}
+		authorizers = append(authorizers, authorizer)
+	}
+
+	config.Authorizers = authorizers
+	return yaml.Marshal(config)
+}
@@ -60,6 +60,8 @@ const (
 
 	volumeNameAuthenticationWebhookKubeconfig = "authentication-webhook-kubeconfig"
 	volumeNameAuthorizationWebhookKubeconfig  = "authorization-webhook-kubeconfig"
+	volumeNameAuthorizationConfig             = "authorization-config"
+	volumeNameAuthorizationKubeconfigs        = "authorization-kubeconfigs"
 	volumeNameCA                              = "ca"
 	volumeNameCAClient                        = "ca-client"
 	volumeNameCAFrontProxy                    = "ca-front-proxy"
@@ -70,6 +72,7 @@ const (
 	volumeNameKubeAPIServerToKubelet          = "kubelet-client"
 	volumeNameKubeAggregator                  = "kube-aggregator"
 	volumeNameOIDCCABundle                    = "oidc-cabundle"
+	volumeNameAuthenticationConfig            = "authentication-config"
 	volumeNameServiceAccountKey               = "service-account-key"
 	volumeNameServiceAccountKeyBundle         = "service-account-key-bundle"
 	volumeNameStaticToken                     = "static-token"
@@ -82,6 +85,8 @@ const (
 
 	volumeMountPathAuthenticationWebhookKubeconfig = "/etc/kubernetes/webhook/authentication"
 	volumeMountPathAuthorizationWebhookKubeconfig  = "/etc/kubernetes/webhook/authorization"
+	volumeMountPathAuthorizationConfig             = "/etc/kubernetes/structured/authz"
+	volumeMountPathAuthorizationKubeconfigs        = "/etc/kubernetes/structured/authz-kubeconfigs"
 	volumeMountPathCA                              = "/srv/kubernetes/ca"
 	volumeMountPathCAClient                        = "/srv/kubernetes/ca-client"
 	volumeMountPathCAFrontProxy                    = "/srv/kubernetes/ca-front-proxy"
@@ -92,6 +97,7 @@ const (
 	volumeMountPathKubeAPIServerToKubelet          = "/srv/kubernetes/apiserver-kubelet"
 	volumeMountPathKubeAggregator                  = "/srv/kubernetes/aggregator"
 	volumeMountPathOIDCCABundle                    = "/srv/kubernetes/oidc"
+	volumeMountPathAuthenticationConfig            = "/etc/kubernetes/structured/authn"
 	volumeMountPathServiceAccountKey               = "/srv/kubernetes/service-account-key"
 	volumeMountPathServiceAccountKeyBundle         = "/srv/kubernetes/service-account-key-bundle"
 	volumeMountPathStaticToken                     = "/srv/kubernetes/token"
@@ -116,6 +122,8 @@ func (k *kubeAPIServer) reconcileDeployment(
 	deployment *appsv1.Deployment,
 	serviceAccount *corev1.ServiceAccount,
 	configMapAuditPolicy *corev1.ConfigMap,
+	configMapAuthenticationConfig *corev1.ConfigMap,
+	configMapAuthorizationConfig *corev1.ConfigMap,
 	configMapAdmissionConfigs *corev1.ConfigMap,
 	secretAdmissionKubeconfigs *corev1.Secret,
 	configMapEgressSelector *corev1.ConfigMap,
@@ -133,6 +141,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 	secretAuditWebhookKubeconfig *corev1.Secret,
 	secretAuthenticationWebhookKubeconfig *corev1.Secret,
 	secretAuthorizationWebhookKubeconfig *corev1.Secret,
+	secretAuthorizationKubeconfigs *corev1.Secret,
 	tlsSNISecrets []tlsSNISecret,
 	fastRollout bool,
 ) error {
@@ -382,14 +391,15 @@ func (k *kubeAPIServer) reconcileDeployment(
 		apiserver.InjectDefaultSettings(deployment, k.values.NamePrefix, k.values.Values, secretCAETCD, secretETCDClient, secretServer)
 		apiserver.InjectAuditSettings(deployment, configMapAuditPolicy, secretAuditWebhookKubeconfig, k.values.Audit)
 		apiserver.InjectAdmissionSettings(deployment, configMapAdmissionConfigs, secretAdmissionKubeconfigs, k.values.Values)
-		apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
+		apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration, k.values.ETCDEncryption)
 		k.handleLifecycleSettings(deployment)
 		k.handleSNISettings(deployment)
 		k.handleTLSSNISettings(deployment, tlsSNISecrets)
 		k.handleOIDCSettings(deployment, secretOIDCCABundle)
+		k.handleStructuredAuthenticationSettings(deployment, configMapAuthenticationConfig)
 		k.handleServiceAccountSigningKeySettings(deployment)
 		k.handleAuthenticationSettings(deployment, secretAuthenticationWebhookKubeconfig)
-		k.handleAuthorizationSettings(deployment, secretAuthorizationWebhookKubeconfig)
+		k.handleAuthorizationSettings(deployment, secretAuthorizationWebhookKubeconfig, configMapAuthorizationConfig, secretAuthorizationKubeconfigs)
 		if err := k.handleVPNSettings(deployment, serviceAccount, configMapEgressSelector, secretHTTPProxy, secretHAVPNSeedClient, secretHAVPNSeedClientSeedTLSAuth); err != nil {
 			return err
 		}
@@ -477,7 +487,11 @@ func (k *kubeAPIServer) computeKubeAPIServerArgs() []string {
 		out = append(out, fmt.Sprintf("--service-account-max-token-expiration=%s", k.values.ServiceAccount.MaxTokenExpiration.Duration))
 	}
 
-	out = append(out, fmt.Sprintf("--service-cluster-ip-range=%s", k.values.ServiceNetworkCIDR))
+	serviceClusterIPRange := k.values.ServiceNetworkCIDR
+	if k.values.SecondaryServiceNetworkCIDR != nil {
+		serviceClusterIPRange += "," + *k.values.SecondaryServiceNetworkCIDR
+	}
+	out = append(out, fmt.Sprintf("--service-cluster-ip-range=%s", serviceClusterIPRange))
 	out = append(out, fmt.Sprintf("--secure-port=%d", kubeapiserverconstants.Port))
 	out = append(out, fmt.Sprintf("--token-auth-file=%s/%s", volumeMountPathStaticToken, secrets.DataKeyStaticTokenCSV))
 
@@ -971,6 +985,35 @@ func (k *kubeAPIServer) handleOIDCSettings(deployment *appsv1.Deployment, secret
 	}
 }
 
+func (k *kubeAPIServer) handleStructuredAuthenticationSettings(deployment *appsv1.Deployment, configMapAuthenticationConfig *corev1.ConfigMap) {
+	if k.values.AuthenticationConfiguration == nil {
+		return
+	}
+
+	// The configuration is mounted without subPath so that changes to the ConfigMap are propagated into the running pods.
+	// kube-apiserver versions < 1.30 do not reload the file dynamically, hence, a rollout is triggered in this case.
+	if version.ConstraintK8sLess130.Check(k.values.Version) {
+		metav1.SetMetaDataAnnotation(&deployment.Spec.Template.ObjectMeta, "checksum/configmap-"+configMapAuthenticationConfigName, utils.ComputeSHA256Hex([]byte(*k.values.AuthenticationConfiguration)))
+	}
+
+	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--authentication-config=%s/%s", volumeMountPathAuthenticationConfig, ConfigMapAuthenticationConfigDataKey))
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      volumeNameAuthenticationConfig,
+		MountPath: volumeMountPathAuthenticationConfig,
+		ReadOnly:  true,
+	})
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeNameAuthenticationConfig,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMapAuthenticationConfig.Name,
+				},
+			},
+		},
+	})
+}
+
 func (k *kubeAPIServer) handleServiceAccountSigningKeySettings(deployment *appsv1.Deployment) {
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--service-account-signing-key-file=%s/%s", volumeMountPathServiceAccountKey, secrets.DataKeyRSAPrivateKey))
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--service-account-key-file=%s/%s", volumeMountPathServiceAccountKeyBundle, secrets.DataKeyPrivateKeyBundle))
@@ -1093,7 +1136,12 @@ func (k *kubeAPIServer) handleAuthenticationSettings(deployment *appsv1.Deployme
 	}
 }
 
-func (k *kubeAPIServer) handleAuthorizationSettings(deployment *appsv1.Deployment, secretWebhookKubeconfig *corev1.Secret) {
+func (k *kubeAPIServer) handleAuthorizationSettings(deployment *appsv1.Deployment, secretWebhookKubeconfig *corev1.Secret, configMapAuthorizationConfig *corev1.ConfigMap, secretAuthorizationKubeconfigs *corev1.Secret) {
+	if k.values.AuthorizationConfiguration != nil {
+		k.handleStructuredAuthorizationSettings(deployment, configMapAuthorizationConfig, secretAuthorizationKubeconfigs)
+		return
+	}
+
 	authModes := []string{"RBAC"}
 
 	if !k.values.IsWorkerless {
@@ -1134,3 +1182,41 @@ func (k *kubeAPIServer) handleAuthorizationSettings(deployment *appsv1.Deploymen
 
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--authorization-mode="+strings.Join(authModes, ","))
 }
+
+func (k *kubeAPIServer) handleStructuredAuthorizationSettings(deployment *appsv1.Deployment, configMapAuthorizationConfig *corev1.ConfigMap, secretAuthorizationKubeconfigs *corev1.Secret) {
+	// The `--authorization-config` flag cannot be combined with the `--authorization-mode` and
+	// `--authorization-webhook-*` flags, the Node and RBAC authorizers are part of the configuration instead.
+	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--authorization-config=%s/%s", volumeMountPathAuthorizationConfig, ConfigMapAuthorizationConfigDataKey))
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts,
+		corev1.VolumeMount{
+			Name:      volumeNameAuthorizationConfig,
+			MountPath: volumeMountPathAuthorizationConfig,
+			ReadOnly:  true,
+		},
+		corev1.VolumeMount{
+			Name:      volumeNameAuthorizationKubeconfigs,
+			MountPath: volumeMountPathAuthorizationKubeconfigs,
+			ReadOnly:  true,
+		},
+	)
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes,
+		corev1.Volume{
+			Name: volumeNameAuthorizationConfig,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: configMapAuthorizationConfig.Name,
+					},
+				},
+			},
+		},
+		corev1.Volume{
+			Name: volumeNameAuthorizationKubeconfigs,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretAuthorizationKubeconfigs.Name,
+				},
+			},
+		},
+	)
+}
@@ -80,8 +80,18 @@ type Values struct {
 	// APIAudiences are identifiers of the API. The service account token authenticator will validate that tokens used
 	// against the API are bound to at least one of these audiences.
 	APIAudiences []string
+	// AuthenticationConfiguration contains the structured authentication configuration (`--authentication-config`)
+	// for the kube-apiserver.
+	AuthenticationConfiguration *string
 	// AuthenticationWebhook contains configuration for the authentication webhook.
 	AuthenticationWebhook *AuthenticationWebhook
+	// AuthorizationConfiguration contains the structured authorization configuration (`--authorization-config`) for
+	// the kube-apiserver. It must only contain authorizers of type `Webhook`, the Node and RBAC authorizers are prepended
+	// automatically. It cannot be combined with AuthorizationWebhook.
+	AuthorizationConfiguration *string
+	// AuthorizationKubeconfigs maps the names of the webhook authorizers in the AuthorizationConfiguration to their
+	// kubeconfigs.
+	AuthorizationKubeconfigs map[string][]byte
 	// AuthorizationWebhook contains configuration for the authorization webhook.
 	AuthorizationWebhook *AuthorizationWebhook
 	// DefaultNotReadyTolerationSeconds indicates the tolerationSeconds of the toleration for notReady:NoExecute
@@ -119,6 +129,8 @@ type Values struct {
 	ServiceAccount ServiceAccountConfig
 	// ServiceNetworkCIDR is the CIDR of the service network.
 	ServiceNetworkCIDR string
+	// SecondaryServiceNetworkCIDR is the CIDR of the secondary service network (only set for dual-stack networking).
+	SecondaryServiceNetworkCIDR *string
 	// SNI contains information for configuring SNI settings for the kube-apiserver.
 	SNI SNIConfig
 	// StaticTokenKubeconfigEnabled indicates whether static token kubeconfig secret will be created for shoot.
@@ -260,9 +272,12 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		secretAuditWebhookKubeconfig          = k.emptySecret(secretAuditWebhookKubeconfigNamePrefix)
 		secretAuthenticationWebhookKubeconfig = k.emptySecret(secretAuthenticationWebhookKubeconfigNamePrefix)
 		secretAuthorizationWebhookKubeconfig  = k.emptySecret(secretAuthorizationWebhookKubeconfigNamePrefix)
+		secretAuthorizationKubeconfigs        = k.emptySecret(secretAuthorizationKubeconfigsNamePrefix)
 		configMapAdmissionConfigs             = k.emptyConfigMap(configMapAdmissionNamePrefix)
 		secretAdmissionKubeconfigs            = k.emptySecret(secretAdmissionKubeconfigsNamePrefix)
 		configMapAuditPolicy                  = k.emptyConfigMap(configMapAuditPolicyNamePrefix)
+		configMapAuthenticationConfig         = k.emptyConfigMap(k.values.NamePrefix + configMapAuthenticationConfigName)
+		configMapAuthorizationConfig          = k.emptyConfigMap(configMapAuthorizationConfigNamePrefix)
 		configMapEgressSelector               = k.emptyConfigMap(configMapEgressSelectorNamePrefix)
 		configMapTerminationHandler           = k.emptyConfigMap(watchdogConfigMapNamePrefix)
 	)
@@ -299,6 +314,10 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if err := k.reconcileSecretAuthorizationKubeconfigs(ctx, secretAuthorizationKubeconfigs); err != nil {
+		return err
+	}
+
 	secretServiceAccountKey, err := k.reconcileSecretServiceAccountKey(ctx)
 	if err != nil {
 		return err
@@ -343,6 +362,14 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if err := k.reconcileConfigMapAuthenticationConfig(ctx, configMapAuthenticationConfig); err != nil {
+		return err
+	}
+
+	if err := k.reconcileConfigMapAuthorizationConfig(ctx, configMapAuthorizationConfig); err != nil {
+		return err
+	}
+
 	if err := k.reconcileConfigMapEgressSelector(ctx, configMapEgressSelector); err != nil {
 		return err
 	}
@@ -395,6 +422,8 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		deployment,
 		serviceAccount,
 		configMapAuditPolicy,
+		configMapAuthenticationConfig,
+		configMapAuthorizationConfig,
 		configMapAdmissionConfigs,
 		secretAdmissionKubeconfigs,
 		configMapEgressSelector,
@@ -412,6 +441,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		secretAuditWebhookKubeconfig,
 		secretAuthenticationWebhookKubeconfig,
 		secretAuthorizationWebhookKubeconfig,
+		secretAuthorizationKubeconfigs,
 		tlsSNISecrets,
 		k.values.FastRollout,
 	); err != nil {
@@ -445,6 +475,7 @@ func (k *kubeAPIServer) Destroy(ctx context.Context) error {
 		k.emptyHVPA(),
 		k.emptyPodDisruptionBudget(),
 		k.emptyDeployment(),
+		k.emptyConfigMap(k.values.NamePrefix+configMapAuthenticationConfigName),
 		k.emptyServiceAccount(),
 		k.emptyRoleHAVPN(),
 		k.emptyRoleBindingHAVPN(),
@@ -884,6 +884,31 @@ subjects:
 				}))
 			})
 
+			It("should successfully deploy and delete the authentication configuration configmap resource", func() {
+				values.AuthenticationConfiguration = pointer.String("some-authentication-config")
+				kapi = New(kubernetesInterface, namespace, sm, values)
+
+				configMapAuthenticationConfig := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-authentication-config", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuthenticationConfig), configMapAuthenticationConfig)).To(BeNotFoundError())
+
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuthenticationConfig), configMapAuthenticationConfig)).To(Succeed())
+				Expect(configMapAuthenticationConfig.Labels).To(Equal(map[string]string{"app": "kubernetes", "role": "apiserver"}))
+				Expect(configMapAuthenticationConfig.Immutable).To(BeNil())
+				Expect(configMapAuthenticationConfig.Data).To(Equal(map[string]string{"config.yaml": "some-authentication-config"}))
+
+				values.AuthenticationConfiguration = pointer.String("changed-authentication-config")
+				kapi = New(kubernetesInterface, namespace, sm, values)
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuthenticationConfig), configMapAuthenticationConfig)).To(Succeed())
+				Expect(configMapAuthenticationConfig.Data).To(Equal(map[string]string{"config.yaml": "changed-authentication-config"}))
+
+				values.AuthenticationConfiguration = nil
+				kapi = New(kubernetesInterface, namespace, sm, values)
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuthenticationConfig), configMapAuthenticationConfig)).To(BeNotFoundError())
+			})
+
 			It("should successfully deploy the ETCD encryption configuration secret resource", func() {
 				etcdEncryptionConfiguration := `apiVersion: apiserver.config.k8s.io/v1
 kind: EncryptionConfiguration
@@ -1655,6 +1680,127 @@ rules:
 					Entry("VPN is enabled but HA is disabled", VPNConfig{Enabled: true, HighAvailabilityEnabled: false}),
 				)
 			})
+
+			Context("structured authorization", func() {
+				var authorizationConfiguration string
+
+				BeforeEach(func() {
+					authorizationConfiguration = `apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthorizationConfiguration
+authorizers:
+- type: Webhook
+  name: foo
+  webhook:
+    timeout: 3s
+    failurePolicy: Deny
+    connectionInfo:
+      type: InClusterConfig
+`
+				})
+
+				newKubeAPIServer := func(isWorkerless bool, kubeconfigs map[string][]byte) Interface {
+					return New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							RuntimeVersion: runtimeVersion,
+						},
+						AuthorizationConfiguration: &authorizationConfiguration,
+						AuthorizationKubeconfigs:   kubeconfigs,
+						IsWorkerless:               isWorkerless,
+						Version:                    version,
+					})
+				}
+
+				DescribeTable("should successfully deploy the configmap and secret resources",
+					func(isWorkerless bool, expectedAuthorizationConfiguration string) {
+						kapi = newKubeAPIServer(isWorkerless, map[string][]byte{"foo": []byte("some-kubeconfig")})
+
+						configMap := &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-authorization-config", Namespace: namespace},
+							Data:       map[string]string{"config.yaml": expectedAuthorizationConfiguration},
+						}
+						Expect(kubernetesutils.MakeUnique(configMap)).To(Succeed())
+
+						secret := &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-authorization-kubeconfigs", Namespace: namespace},
+							Data:       map[string][]byte{"foo-kubeconfig.yaml": []byte("some-kubeconfig")},
+						}
+						Expect(kubernetesutils.MakeUnique(secret)).To(Succeed())
+
+						Expect(kapi.Deploy(ctx)).To(Succeed())
+
+						actualConfigMap := &corev1.ConfigMap{}
+						Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), actualConfigMap)).To(Succeed())
+						Expect(actualConfigMap.Data).To(Equal(configMap.Data))
+						Expect(actualConfigMap.Immutable).To(PointTo(BeTrue()))
+
+						actualSecret := &corev1.Secret{}
+						Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), actualSecret)).To(Succeed())
+						Expect(actualSecret.Data).To(Equal(secret.Data))
+						Expect(actualSecret.Immutable).To(PointTo(BeTrue()))
+					},
+
+					Entry("cluster with workers", false, `apiVersion: apiserver.config.k8s.io/v1alpha1
+authorizers:
+- name: node
+  type: Node
+- name: rbac
+  type: RBAC
+- name: foo
+  type: Webhook
+  webhook:
+    connectionInfo:
+      kubeConfigFile: /etc/kubernetes/structured/authz-kubeconfigs/foo-kubeconfig.yaml
+      type: KubeConfigFile
+    failurePolicy: Deny
+    timeout: 3s
+kind: AuthorizationConfiguration
+`),
+					Entry("workerless cluster", true, `apiVersion: apiserver.config.k8s.io/v1alpha1
+authorizers:
+- name: rbac
+  type: RBAC
+- name: foo
+  type: Webhook
+  webhook:
+    connectionInfo:
+      kubeConfigFile: /etc/kubernetes/structured/authz-kubeconfigs/foo-kubeconfig.yaml
+      type: KubeConfigFile
+    failurePolicy: Deny
+    timeout: 3s
+kind: AuthorizationConfiguration
+`),
+				)
+
+				It("should fail if a webhook authorizer has no kubeconfig", func() {
+					kapi = newKubeAPIServer(false, nil)
+
+					Expect(kapi.Deploy(ctx)).To(MatchError(ContainSubstring(`missing kubeconfig for webhook authorizer "foo"`)))
+				})
+
+				It("should fail if the configuration contains authorizers of other types", func() {
+					authorizationConfiguration = `apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthorizationConfiguration
+authorizers:
+- type: RBAC
+  name: my-rbac
+`
+					kapi = newKubeAPIServer(false, nil)
+
+					Expect(kapi.Deploy(ctx)).To(MatchError(ContainSubstring(`authorizer "my-rbac" has unsupported type "RBAC"`)))
+				})
+
+				It("should fail if the configuration uses a reserved authorizer name", func() {
+					authorizationConfiguration = `apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthorizationConfiguration
+authorizers:
+- type: Webhook
+  name: rbac
+`
+					kapi = newKubeAPIServer(false, map[string][]byte{"rbac": []byte("some-kubeconfig")})
+
+					Expect(kapi.Deploy(ctx)).To(MatchError(ContainSubstring(`authorizer name "rbac" is reserved`)))
+				})
+			})
 		})
 
 		Describe("Deployment", func() {
@@ -2885,6 +3031,23 @@ rules:
 					)))
 				})
 
+				It("should configure both service network CIDRs for dual-stack networking", func() {
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							RuntimeVersion: runtimeVersion,
+						},
+						Images:                      images,
+						ServiceNetworkCIDR:          "10.0.2.0/24",
+						SecondaryServiceNetworkCIDR: pointer.String("2001:db8:2::/108"),
+						Version:                     version,
+					})
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement(
+						"--service-cluster-ip-range=10.0.2.0/24,2001:db8:2::/108",
+					))
+				})
+
 				It("should configure the advertise address if SNI is enabled", func() {
 					advertiseAddress := "1.2.3.4"
 
@@ -3428,6 +3591,66 @@ rules:
 					))
 				})
 
+				Context("structured authentication", func() {
+					JustBeforeEach(func() {
+						values.AuthenticationConfiguration = pointer.String("some-authentication-config")
+					})
+
+					It("should properly configure the structured authentication settings", func() {
+						kapi = New(kubernetesInterface, namespace, sm, values)
+						deployAndRead()
+
+						Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement(
+							"--authentication-config=/etc/kubernetes/structured/authn/config.yaml",
+						))
+						Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(
+							corev1.VolumeMount{
+								Name:      "authentication-config",
+								MountPath: "/etc/kubernetes/structured/authn",
+								ReadOnly:  true,
+							},
+						))
+						Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(
+							corev1.Volume{
+								Name: "authentication-config",
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "kube-apiserver-authentication-config",
+										},
+									},
+								},
+							},
+						))
+					})
+
+					It("should trigger a rollout on changes for Kubernetes versions < 1.30", func() {
+						values.Version = semver.MustParse("1.29.1")
+						kapi = New(kubernetesInterface, namespace, sm, values)
+						deployAndRead()
+
+						Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue(
+							"checksum/configmap-kube-apiserver-authentication-config",
+							"3f5b8f5286d17f06e72c36c13a9399ac563ae35d00571164596b00f644986be8",
+						))
+					})
+
+					It("should rely on the dynamic reload for Kubernetes versions >= 1.30", func() {
+						values.Version = semver.MustParse("1.30.0")
+						kapi = New(kubernetesInterface, namespace, sm, values)
+						deployAndRead()
+
+						Expect(deployment.Spec.Template.Annotations).NotTo(HaveKey("checksum/configmap-kube-apiserver-authentication-config"))
+					})
+				})
+
+				It("should not configure the structured authentication settings if not provided", func() {
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(HavePrefix("--authentication-config=")))
+					Expect(deployment.Spec.Template.Spec.Volumes).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{"Name": Equal("authentication-config")})))
+				})
+
 				It("should properly configure the authorization settings with webhook", func() {
 					values.AuthorizationWebhook = &AuthorizationWebhook{
 						Kubeconfig:           []byte("foo"),
@@ -3463,6 +3686,65 @@ rules:
 						},
 					))
 				})
+
+				It("should properly configure the structured authorization settings", func() {
+					values.AuthorizationConfiguration = pointer.String(`apiVersion: apiserver.config.k8s.io/v1alpha1
+kind: AuthorizationConfiguration
+authorizers:
+- type: Webhook
+  name: foo
+`)
+					values.AuthorizationKubeconfigs = map[string][]byte{"foo": []byte("some-kubeconfig")}
+					kapi = New(kubernetesInterface, namespace, sm, values)
+					deployAndRead()
+
+					configMapList := &corev1.ConfigMapList{}
+					Expect(c.List(ctx, configMapList, client.InNamespace(namespace))).To(Succeed())
+					var configMapAuthorizationConfig *corev1.ConfigMap
+					for _, configMap := range configMapList.Items {
+						if strings.HasPrefix(configMap.Name, "kube-apiserver-authorization-config-") {
+							configMapAuthorizationConfig = configMap.DeepCopy()
+						}
+					}
+					Expect(configMapAuthorizationConfig).NotTo(BeNil())
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement(
+						"--authorization-config=/etc/kubernetes/structured/authz/config.yaml",
+					))
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(HavePrefix("--authorization-mode=")))
+					Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElements(
+						corev1.VolumeMount{
+							Name:      "authorization-config",
+							MountPath: "/etc/kubernetes/structured/authz",
+							ReadOnly:  true,
+						},
+						corev1.VolumeMount{
+							Name:      "authorization-kubeconfigs",
+							MountPath: "/etc/kubernetes/structured/authz-kubeconfigs",
+							ReadOnly:  true,
+						},
+					))
+					Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElements(
+						corev1.Volume{
+							Name: "authorization-config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: configMapAuthorizationConfig.Name,
+									},
+								},
+							},
+						},
+						corev1.Volume{
+							Name: "authorization-kubeconfigs",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "kube-apiserver-authorization-kubeconfigs-" + utils.ComputeSecretChecksum(map[string][]byte{"foo-kubeconfig.yaml": []byte("some-kubeconfig")})[:8],
+								},
+							},
+						},
+					))
+				})
 			})
 		})
 
@@ -46,6 +46,7 @@ const (
 	secretAuditWebhookKubeconfigNamePrefix          = "kube-apiserver-audit-webhook-kubeconfig"
 	secretAuthenticationWebhookKubeconfigNamePrefix = "kube-apiserver-authentication-webhook-kubeconfig"
 	secretAuthorizationWebhookKubeconfigNamePrefix  = "kube-apiserver-authorization-webhook-kubeconfig"
+	secretAuthorizationKubeconfigsNamePrefix        = "kube-apiserver-authorization-kubeconfigs"
 
 	secretETCDEncryptionConfigurationDataKey = "encryption-configuration.yaml"
 	secretAdmissionKubeconfigsNamePrefix     = "kube-apiserver-admission-kubeconfigs"
@@ -295,3 +296,23 @@ func (k *kubeAPIServer) reconcileSecretAuthorizationWebhookKubeconfig(ctx contex
 
 	return apiserver.ReconcileSecretWebhookKubeconfig(ctx, k.client.Client(), secret, k.values.AuthorizationWebhook.Kubeconfig)
 }
+
+func (k *kubeAPIServer) reconcileSecretAuthorizationKubeconfigs(ctx context.Context, secret *corev1.Secret) error {
+	if k.values.AuthorizationConfiguration == nil {
+		// We don't delete the secret here as we don't know its name (as it's unique). Instead, we rely on the usual
+		// garbage collection for unique secrets/configmaps.
+		return nil
+	}
+
+	secret.Data = make(map[string][]byte, len(k.values.AuthorizationKubeconfigs))
+	for authorizerName, kubeconfig := range k.values.AuthorizationKubeconfigs {
+		secret.Data[authorizationKubeconfigDataKey(authorizerName)] = kubeconfig
+	}
+	utilruntime.Must(kubernetesutils.MakeUnique(secret))
+
+	return client.IgnoreAlreadyExists(k.client.Client().Create(ctx, secret))
+}
+
+func authorizationKubeconfigDataKey(authorizerName string) string {
+	return authorizerName + "-kubeconfig.yaml"
+}
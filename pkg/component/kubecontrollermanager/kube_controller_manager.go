@@ -152,6 +152,10 @@ type Values struct {
 	PodNetwork *net.IPNet
 	// ServiceNetwork is the service CIDR of the target cluster.
 	ServiceNetwork *net.IPNet
+	// SecondaryPodNetwork is the secondary pod CIDR of the target cluster (only set for dual-stack networking).
+	SecondaryPodNetwork *net.IPNet
+	// SecondaryServiceNetwork is the secondary service CIDR of the target cluster (only set for dual-stack networking).
+	SecondaryServiceNetwork *net.IPNet
 	// ClusterSigningDuration is the value for the `--cluster-signing-duration` flag.
 	ClusterSigningDuration *time.Duration
 	// ControllerWorkers is used for configuring the workers for controllers.
@@ -655,14 +659,23 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 		if v := k.values.Config.NodeMonitorGracePeriod; v != nil {
 			nodeMonitorGracePeriod = *v
 		}
-		if k.values.Config.NodeCIDRMaskSize != nil {
+		clusterCIDR := k.values.PodNetwork.String()
+		if k.values.SecondaryPodNetwork != nil {
+			clusterCIDR += "," + k.values.SecondaryPodNetwork.String()
+
+			// In dual-stack mode, the kube-controller-manager only accepts the family-specific node CIDR mask size
+			// flags. The configured mask size applies to the primary pod network, the secondary one uses the default.
+			if k.values.Config.NodeCIDRMaskSize != nil {
+				command = append(command, fmt.Sprintf("--node-cidr-mask-size-%s=%d", ipFamilyOf(k.values.PodNetwork), *k.values.Config.NodeCIDRMaskSize))
+			}
+		} else if k.values.Config.NodeCIDRMaskSize != nil {
 			command = append(command, fmt.Sprintf("--node-cidr-mask-size=%d", *k.values.Config.NodeCIDRMaskSize))
 		}
 
 		command = append(command,
 			"--allocate-node-cidrs=true",
 			"--attach-detach-reconcile-sync-period=1m0s",
-			fmt.Sprintf("--cluster-cidr=%s", k.values.PodNetwork.String()),
+			fmt.Sprintf("--cluster-cidr=%s", clusterCIDR),
 			fmt.Sprintf("--cluster-signing-kubelet-client-cert-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyCertificateCA),
 			fmt.Sprintf("--cluster-signing-kubelet-client-key-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyPrivateKeyCA),
 			fmt.Sprintf("--cluster-signing-kubelet-serving-cert-file=%s/%s", volumeMountPathCAKubelet, secrets.DataKeyCertificateCA),
@@ -771,8 +784,13 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 	)
 
 	if k.values.ServiceNetwork != nil {
+		serviceClusterIPRange := k.values.ServiceNetwork.String()
+		if k.values.SecondaryServiceNetwork != nil {
+			serviceClusterIPRange += "," + k.values.SecondaryServiceNetwork.String()
+		}
+
 		command = append(command,
-			fmt.Sprintf("--service-cluster-ip-range=%s", k.values.ServiceNetwork.String()),
+			fmt.Sprintf("--service-cluster-ip-range=%s", serviceClusterIPRange),
 		)
 	}
 
@@ -864,3 +882,10 @@ func getTrimmedAPI(api string) string {
 
 	return api
 }
+
+func ipFamilyOf(network *net.IPNet) string {
+	if network.IP.To4() != nil {
+		return "ipv4"
+	}
+	return "ipv6"
+}
@@ -887,6 +887,62 @@ namespace: kube-system
 				"--controllers=*,bootstrapsigner,tokencleaner,-clusterrole-aggregation,-endpointslice,-endpointslicemirroring,-resource-claim-controller,-storage-version-gc",
 			),
 		)
+
+		Context("dual-stack", func() {
+			var (
+				secondaryPodCIDR, secondaryServiceCIDR *net.IPNet
+
+				deployAndGetCommand = func(config *gardencorev1beta1.KubeControllerManagerConfig) []string {
+					semverVersion, err := semver.NewVersion(version)
+					Expect(err).NotTo(HaveOccurred())
+
+					values = Values{
+						RuntimeVersion:          runtimeKubernetesVersion,
+						TargetVersion:           semverVersion,
+						Image:                   image,
+						Config:                  config,
+						PriorityClassName:       priorityClassName,
+						PodNetwork:              podCIDR,
+						ServiceNetwork:          serviceCIDR,
+						SecondaryPodNetwork:     secondaryPodCIDR,
+						SecondaryServiceNetwork: secondaryServiceCIDR,
+						ClusterSigningDuration:  clusterSigningDuration,
+						ControllerWorkers:       controllerWorkers,
+						ControllerSyncPeriods:   controllerSyncPeriods,
+					}
+					kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+					kubeControllerManager.SetReplicaCount(replicas)
+
+					Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+					actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+					return actualDeployment.Spec.Template.Spec.Containers[0].Command
+				}
+			)
+
+			BeforeEach(func() {
+				_, secondaryPodCIDR, _ = net.ParseCIDR("2001:db8:1::/48")
+				_, secondaryServiceCIDR, _ = net.ParseCIDR("2001:db8:2::/108")
+			})
+
+			It("should configure both IP families", func() {
+				command := deployAndGetCommand(emptyConfig)
+
+				Expect(command).To(ContainElements(
+					"--cluster-cidr=100.96.0.0/11,2001:db8:1::/48",
+					"--service-cluster-ip-range=100.64.0.0/13,2001:db8:2::/108",
+				))
+				Expect(command).NotTo(ContainElement(HavePrefix("--node-cidr-mask-size")))
+			})
+
+			It("should configure the node CIDR mask size for the primary IP family", func() {
+				command := deployAndGetCommand(configWithNodeCIDRMaskSize)
+
+				Expect(command).To(ContainElement("--node-cidr-mask-size-ipv4=26"))
+				Expect(command).NotTo(ContainElement(HavePrefix("--node-cidr-mask-size=")))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -98,7 +98,8 @@ type Values struct {
 	ImageAlpine string
 	// Kubeconfig is the kubeconfig which should be used to communicate with the kube-apiserver.
 	Kubeconfig []byte
-	// PodNetworkCIDR is the CIDR of the pod network. Only relevant when IPVSEnabled is false.
+	// PodNetworkCIDR is the CIDR of the pod network. For dual-stack networking, it contains the comma-separated CIDRs of
+	// both IP families. Only relevant when IPVSEnabled is false.
 	PodNetworkCIDR *string
 	// VPAEnabled states whether VerticalPodAutoscaler is enabled.
 	VPAEnabled bool
@@ -140,6 +140,8 @@ func computeAPIServerETCDEncryptionConfig(
 	etcdEncryptionKeyRotationPhase gardencorev1beta1.CredentialsRotationPhase,
 	resourcesToEncrypt []string,
 	encryptedResources []string,
+	encryptionProviderType string,
+	activeEncryptionProviderType string,
 ) (
 	apiserver.ETCDEncryptionConfig,
 	error,
@@ -149,6 +151,17 @@ func computeAPIServerETCDEncryptionConfig(
 		EncryptWithCurrentKey: true,
 		ResourcesToEncrypt:    resourcesToEncrypt,
 		EncryptedResources:    encryptedResources,
+		ProviderType:          activeEncryptionProviderType,
+	}
+
+	// A change of the encryption provider is only rolled out together with an ETCD encryption key rotation since this
+	// already takes care of re-encrypting all resources. The active provider is kept for decryption until the rotation
+	// is being completed.
+	if encryptionProviderType != activeEncryptionProviderType && etcdEncryptionKeyRotationPhase != "" && etcdEncryptionKeyRotationPhase != gardencorev1beta1.RotationCompleted {
+		config.ProviderType = encryptionProviderType
+		if etcdEncryptionKeyRotationPhase != gardencorev1beta1.RotationCompleting {
+			config.PreviousProviderType = activeEncryptionProviderType
+		}
 	}
 
 	if etcdEncryptionKeyRotationPhase == gardencorev1beta1.RotationPreparing {
@@ -225,6 +238,24 @@ func GetResourcesForEncryptionFromConfig(encryptionConfig *gardencorev1beta1.Enc
 	return sets.List(sets.New(encryptionConfig.Resources...))
 }
 
+// GetEncryptionProviderFromConfig returns the encryption provider from the EncryptionConfig.
+func GetEncryptionProviderFromConfig(encryptionConfig *gardencorev1beta1.EncryptionConfig) *gardencorev1beta1.EncryptionProvider {
+	if encryptionConfig == nil {
+		return nil
+	}
+
+	return encryptionConfig.Provider
+}
+
+// GetEncryptionProviderType returns the type of the given encryption provider. It defaults to the `aescbc` type.
+func GetEncryptionProviderType(encryptionProvider *gardencorev1beta1.EncryptionProvider) string {
+	if encryptionProvider == nil {
+		return gardencorev1beta1.EncryptionProviderTypeAESCBC
+	}
+
+	return encryptionProvider.Type
+}
+
 // NormalizeResources returns the list of resources after trimming the suffix '.' if present.
 // This is needed for core resources which can be specified as '<resource>.' as well.
 func NormalizeResources(resources []string) []string {
@@ -134,6 +134,8 @@ func DeployGardenerAPIServer(
 		etcdEncryptionKeyRotationPhase,
 		append(resourcesToEncrypt, sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())...),
 		append(encryptedResources, sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())...),
+		gardencorev1beta1.EncryptionProviderTypeAESCBC,
+		gardencorev1beta1.EncryptionProviderTypeAESCBC,
 	)
 	if err != nil {
 		return err
@@ -434,7 +434,7 @@ var _ = Describe("GardenerAPIServer", func() {
 			Entry("no rotation",
 				gardencorev1beta1.CredentialsRotationPhase(""),
 				nil,
-				apiserver.ETCDEncryptionConfig{RotationPhase: "", EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())},
+				apiserver.ETCDEncryptionConfig{RotationPhase: "", EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), ProviderType: "aescbc"},
 				nil,
 			),
 			Entry("preparing phase, new key already populated",
@@ -448,7 +448,7 @@ var _ = Describe("GardenerAPIServer", func() {
 						},
 					})).To(Succeed())
 				},
-				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationPreparing, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())},
+				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationPreparing, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), ProviderType: "aescbc"},
 				nil,
 			),
 			Entry("preparing phase, new key not yet populated",
@@ -468,10 +468,11 @@ var _ = Describe("GardenerAPIServer", func() {
 						EncryptWithCurrentKey: true,
 						ResourcesToEncrypt:    sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()),
 						EncryptedResources:    sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()),
+						ProviderType:          "aescbc",
 					})
 					gardenerAPIServer.EXPECT().Deploy(ctx)
 				},
-				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationPreparing, EncryptWithCurrentKey: false, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())},
+				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationPreparing, EncryptWithCurrentKey: false, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), ProviderType: "aescbc"},
 				func() {
 					deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gardener-apiserver", Namespace: namespace}}
 					Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
@@ -481,7 +482,7 @@ var _ = Describe("GardenerAPIServer", func() {
 			Entry("prepared phase",
 				gardencorev1beta1.RotationPrepared,
 				nil,
-				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationPrepared, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())},
+				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationPrepared, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), ProviderType: "aescbc"},
 				nil,
 			),
 			Entry("completing phase",
@@ -495,7 +496,7 @@ var _ = Describe("GardenerAPIServer", func() {
 						},
 					})).To(Succeed())
 				},
-				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationCompleting, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())},
+				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationCompleting, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), ProviderType: "aescbc"},
 				func() {
 					deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gardener-apiserver", Namespace: namespace}}
 					Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
@@ -505,7 +506,7 @@ var _ = Describe("GardenerAPIServer", func() {
 			Entry("completed phase",
 				gardencorev1beta1.RotationCompleted,
 				nil,
-				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationCompleted, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())},
+				apiserver.ETCDEncryptionConfig{RotationPhase: gardencorev1beta1.RotationCompleted, EncryptWithCurrentKey: true, ResourcesToEncrypt: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), EncryptedResources: sets.List(gardenerutils.DefaultGardenerResourcesForEncryption()), ProviderType: "aescbc"},
 				nil,
 			),
 		)
@@ -526,6 +527,7 @@ var _ = Describe("GardenerAPIServer", func() {
 						"internalsecrets.core.gardener.cloud",
 						"shootstates.core.gardener.cloud",
 					},
+					ProviderType: "aescbc",
 				}
 
 				gardenerAPIServer.EXPECT().SetETCDEncryptionConfig(expectedETCDEncryptionConfig)
@@ -553,6 +555,7 @@ var _ = Describe("GardenerAPIServer", func() {
 						"internalsecrets.core.gardener.cloud",
 						"shootstates.core.gardener.cloud",
 					},
+					ProviderType: "aescbc",
 				}
 
 				gardenerAPIServer.EXPECT().SetETCDEncryptionConfig(expectedETCDEncryptionConfig)
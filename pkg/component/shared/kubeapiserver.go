@@ -92,6 +92,7 @@ func NewKubeAPIServer(
 	apiServerConfig *gardencorev1beta1.KubeAPIServerConfig,
 	autoscalingConfig apiserver.AutoscalingConfig,
 	serviceNetworkCIDR string,
+	secondaryServiceNetworkCIDR *string,
 	vpnConfig kubeapiserver.VPNConfig,
 	priorityClassName string,
 	isWorkerless bool,
@@ -115,6 +116,9 @@ func NewKubeAPIServer(
 		disabledAdmissionPlugins            []gardencorev1beta1.AdmissionPlugin
 		apiAudiences                        = []string{"kubernetes", "gardener"}
 		auditConfig                         *apiserver.AuditConfig
+		authenticationConfiguration         *string
+		authorizationConfiguration          *string
+		authorizationKubeconfigs            map[string][]byte
 		defaultNotReadyTolerationSeconds    *int64
 		defaultUnreachableTolerationSeconds *int64
 		eventTTL                            *metav1.Duration
@@ -147,6 +151,16 @@ func NewKubeAPIServer(
 			return nil, err
 		}
 
+		authenticationConfiguration, err = computeKubeAPIServerAuthenticationConfig(ctx, resourceConfigClient, objectMeta, apiServerConfig.StructuredAuthentication)
+		if err != nil {
+			return nil, err
+		}
+
+		authorizationConfiguration, authorizationKubeconfigs, err = computeKubeAPIServerAuthorizationConfig(ctx, resourceConfigClient, objectMeta, apiServerConfig.StructuredAuthorization)
+		if err != nil {
+			return nil, err
+		}
+
 		defaultNotReadyTolerationSeconds = apiServerConfig.DefaultNotReadyTolerationSeconds
 		defaultUnreachableTolerationSeconds = apiServerConfig.DefaultUnreachableTolerationSeconds
 		eventTTL = apiServerConfig.EventTTL
@@ -181,7 +195,10 @@ func NewKubeAPIServer(
 			},
 			AnonymousAuthenticationEnabled:      v1beta1helper.AnonymousAuthenticationEnabled(apiServerConfig),
 			APIAudiences:                        apiAudiences,
+			AuthenticationConfiguration:         authenticationConfiguration,
 			AuthenticationWebhook:               authenticationWebhookConfig,
+			AuthorizationConfiguration:          authorizationConfiguration,
+			AuthorizationKubeconfigs:            authorizationKubeconfigs,
 			AuthorizationWebhook:                authorizationWebhookConfig,
 			DefaultNotReadyTolerationSeconds:    defaultNotReadyTolerationSeconds,
 			DefaultUnreachableTolerationSeconds: defaultUnreachableTolerationSeconds,
@@ -194,6 +211,7 @@ func NewKubeAPIServer(
 			ResourcesToStoreInETCDEvents:        resourcesToStoreInETCDEvents,
 			RuntimeConfig:                       runtimeConfig,
 			ServiceNetworkCIDR:                  serviceNetworkCIDR,
+			SecondaryServiceNetworkCIDR:         secondaryServiceNetworkCIDR,
 			StaticTokenKubeconfigEnabled:        staticTokenKubeconfigEnabled,
 			Version:                             targetVersion,
 			VPN:                                 vpnConfig,
@@ -215,6 +233,8 @@ func DeployKubeAPIServer(
 	externalServer string,
 	resourcesToEncrypt []string,
 	encryptedResources []string,
+	encryptionProviderType string,
+	activeEncryptionProviderType string,
 	etcdEncryptionKeyRotationPhase gardencorev1beta1.CredentialsRotationPhase,
 	serviceAccountKeyRotationPhase gardencorev1beta1.CredentialsRotationPhase,
 	wantScaleDown bool,
@@ -258,6 +278,8 @@ func DeployKubeAPIServer(
 		etcdEncryptionKeyRotationPhase,
 		append(resourcesToEncrypt, sets.List(gardenerutils.DefaultResourcesForEncryption())...),
 		append(encryptedResources, sets.List(gardenerutils.DefaultResourcesForEncryption())...),
+		encryptionProviderType,
+		activeEncryptionProviderType,
 	)
 	if err != nil {
 		return err
@@ -375,6 +397,92 @@ func computeEnabledKubeAPIServerAdmissionPlugins(defaultPlugins, configuredPlugi
 	return admissionPlugins
 }
 
+func computeKubeAPIServerAuthenticationConfig(
+	ctx context.Context,
+	cl client.Client,
+	objectMeta metav1.ObjectMeta,
+	config *gardencorev1beta1.StructuredAuthentication,
+) (
+	*string,
+	error,
+) {
+	if config == nil {
+		return nil, nil
+	}
+
+	var (
+		key       = kubernetesutils.Key(objectMeta.Namespace, config.ConfigMapName)
+		configMap = &corev1.ConfigMap{}
+	)
+
+	if err := cl.Get(ctx, key, configMap); err != nil {
+		// Ignore missing authentication configuration on cluster deletion to prevent failing redeployments of the
+		// API server in case the end-user deleted the configmap before/simultaneously to the deletion.
+		if !apierrors.IsNotFound(err) || objectMeta.DeletionTimestamp == nil {
+			return nil, fmt.Errorf("retrieving authentication configuration from the ConfigMap %s failed: %w", key, err)
+		}
+		return nil, nil
+	}
+
+	authenticationConfiguration, ok := configMap.Data[kubeapiserver.ConfigMapAuthenticationConfigDataKey]
+	if !ok {
+		return nil, fmt.Errorf("missing '.data[%s]' in authentication configuration ConfigMap %s", kubeapiserver.ConfigMapAuthenticationConfigDataKey, key)
+	}
+
+	return &authenticationConfiguration, nil
+}
+
+func computeKubeAPIServerAuthorizationConfig(
+	ctx context.Context,
+	cl client.Client,
+	objectMeta metav1.ObjectMeta,
+	config *gardencorev1beta1.StructuredAuthorization,
+) (
+	*string,
+	map[string][]byte,
+	error,
+) {
+	if config == nil {
+		return nil, nil, nil
+	}
+
+	var (
+		key       = kubernetesutils.Key(objectMeta.Namespace, config.ConfigMapName)
+		configMap = &corev1.ConfigMap{}
+	)
+
+	if err := cl.Get(ctx, key, configMap); err != nil {
+		// Ignore missing authorization configuration on cluster deletion to prevent failing redeployments of the
+		// API server in case the end-user deleted the configmap before/simultaneously to the deletion.
+		if !apierrors.IsNotFound(err) || objectMeta.DeletionTimestamp == nil {
+			return nil, nil, fmt.Errorf("retrieving authorization configuration from the ConfigMap %s failed: %w", key, err)
+		}
+		return nil, nil, nil
+	}
+
+	authorizationConfiguration, ok := configMap.Data[kubeapiserver.ConfigMapAuthorizationConfigDataKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("missing '.data[%s]' in authorization configuration ConfigMap %s", kubeapiserver.ConfigMapAuthorizationConfigDataKey, key)
+	}
+
+	kubeconfigs := make(map[string][]byte, len(config.Kubeconfigs))
+	for _, reference := range config.Kubeconfigs {
+		secretKey := kubernetesutils.Key(objectMeta.Namespace, reference.SecretName)
+
+		kubeconfig, err := gardenerutils.FetchKubeconfigFromSecret(ctx, cl, secretKey)
+		if err != nil {
+			// Ignore missing kubeconfigs on cluster deletion for the same reason as above.
+			if !apierrors.IsNotFound(err) || objectMeta.DeletionTimestamp == nil {
+				return nil, nil, fmt.Errorf("failed reading kubeconfig for authorizer %q from referenced secret %s: %w", reference.AuthorizerName, secretKey, err)
+			}
+			return nil, nil, nil
+		}
+		kubeconfigs[reference.AuthorizerName] = kubeconfig
+	}
+
+	return &authorizationConfiguration, kubeconfigs, nil
+}
+
 func computeKubeAPIServerReplicas(autoscalingConfig apiserver.AutoscalingConfig, deployment *appsv1.Deployment, wantScaleDown bool) *int32 {
 	switch {
 	case autoscalingConfig.Replicas != nil:
@@ -44,6 +44,8 @@ func NewKubeControllerManager(
 	hvpaConfig *kubecontrollermanager.HVPAConfig,
 	podNetwork *net.IPNet,
 	serviceNetwork *net.IPNet,
+	secondaryPodNetwork *net.IPNet,
+	secondaryServiceNetwork *net.IPNet,
 	clusterSigningDuration *time.Duration,
 	controllerWorkers kubecontrollermanager.ControllerWorkers,
 	controllerSyncPeriods kubecontrollermanager.ControllerSyncPeriods,
@@ -62,19 +64,21 @@ func NewKubeControllerManager(
 		runtimeNamespace,
 		secretsManager,
 		kubecontrollermanager.Values{
-			RuntimeVersion:         runtimeVersion,
-			TargetVersion:          targetVersion,
-			Image:                  image.String(),
-			Config:                 config,
-			PriorityClassName:      priorityClassName,
-			NamePrefix:             namePrefix,
-			HVPAConfig:             hvpaConfig,
-			IsWorkerless:           isWorkerless,
-			PodNetwork:             podNetwork,
-			ServiceNetwork:         serviceNetwork,
-			ClusterSigningDuration: clusterSigningDuration,
-			ControllerWorkers:      controllerWorkers,
-			ControllerSyncPeriods:  controllerSyncPeriods,
+			RuntimeVersion:          runtimeVersion,
+			TargetVersion:           targetVersion,
+			Image:                   image.String(),
+			Config:                  config,
+			PriorityClassName:       priorityClassName,
+			NamePrefix:              namePrefix,
+			HVPAConfig:              hvpaConfig,
+			IsWorkerless:            isWorkerless,
+			PodNetwork:              podNetwork,
+			ServiceNetwork:          serviceNetwork,
+			SecondaryPodNetwork:     secondaryPodNetwork,
+			SecondaryServiceNetwork: secondaryServiceNetwork,
+			ClusterSigningDuration:  clusterSigningDuration,
+			ControllerWorkers:       controllerWorkers,
+			ControllerSyncPeriods:   controllerSyncPeriods,
 		},
 	), nil
 }
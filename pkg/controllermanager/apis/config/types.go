@@ -63,6 +63,8 @@ type ControllerManagerControllerConfiguration struct {
 	Event *EventControllerConfiguration
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	ExposureClass *ExposureClassControllerConfiguration
+	// NamespacedCloudProfile defines the configuration of the NamespacedCloudProfile controller.
+	NamespacedCloudProfile *NamespacedCloudProfileControllerConfiguration
 	// Project defines the configuration of the Project controller.
 	Project *ProjectControllerConfiguration
 	// Quota defines the configuration of the Quota controller.
@@ -153,6 +155,14 @@ type ExposureClassControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// NamespacedCloudProfileControllerConfiguration defines the configuration of the NamespacedCloudProfile
+// controller.
+type NamespacedCloudProfileControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
 // ProjectControllerConfiguration defines the configuration of the
 // Project controller.
 type ProjectControllerConfiguration struct {
@@ -181,6 +181,13 @@ func SetDefaults_ExposureClassControllerConfiguration(obj *ExposureClassControll
 	}
 }
 
+// SetDefaults_NamespacedCloudProfileControllerConfiguration sets defaults for the NamespacedCloudProfileControllerConfiguration.
+func SetDefaults_NamespacedCloudProfileControllerConfiguration(obj *NamespacedCloudProfileControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = pointer.Int(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_QuotaControllerConfiguration sets defaults for the QuotaControllerConfiguration.
 func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -308,6 +315,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ExposureClass == nil {
 		obj.ExposureClass = &ExposureClassControllerConfiguration{}
 	}
+	if obj.NamespacedCloudProfile == nil {
+		obj.NamespacedCloudProfile = &NamespacedCloudProfileControllerConfiguration{}
+	}
 	if obj.Project == nil {
 		obj.Project = &ProjectControllerConfiguration{}
 	}
@@ -429,6 +429,31 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("NamespacedCloudProfileControllerConfiguration defaulting", func() {
+		It("should default NamespacedCloudProfileControllerConfiguration correctly", func() {
+			expected := &NamespacedCloudProfileControllerConfiguration{
+				ConcurrentSyncs: pointer.Int(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NamespacedCloudProfile).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					NamespacedCloudProfile: &NamespacedCloudProfileControllerConfiguration{
+						ConcurrentSyncs: pointer.Int(10),
+					},
+				},
+			}
+			expected := obj.Controllers.NamespacedCloudProfile.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NamespacedCloudProfile).To(Equal(expected))
+		})
+	})
+
 	Describe("QuotaControllerConfiguration defaulting", func() {
 		It("should default QuotaControllerConfiguration correctly", func() {
 			expected := &QuotaControllerConfiguration{
@@ -73,6 +73,9 @@ type ControllerManagerControllerConfiguration struct {
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	// +optional
 	ExposureClass *ExposureClassControllerConfiguration `json:"exposureClass,omitempty"`
+	// NamespacedCloudProfile defines the configuration of the NamespacedCloudProfile controller.
+	// +optional
+	NamespacedCloudProfile *NamespacedCloudProfileControllerConfiguration `json:"namespacedCloudProfile,omitempty"`
 	// Project defines the configuration of the Project controller.
 	// +optional
 	Project *ProjectControllerConfiguration `json:"project,omitempty"`
@@ -184,6 +187,15 @@ type ExposureClassControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// NamespacedCloudProfileControllerConfiguration defines the configuration of the NamespacedCloudProfile
+// controller.
+type NamespacedCloudProfileControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ProjectControllerConfiguration defines the configuration of the
 // Project controller.
 type ProjectControllerConfiguration struct {
@@ -149,6 +149,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespacedCloudProfileControllerConfiguration)(nil), (*config.NamespacedCloudProfileControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespacedCloudProfileControllerConfiguration_To_config_NamespacedCloudProfileControllerConfiguration(a.(*NamespacedCloudProfileControllerConfiguration), b.(*config.NamespacedCloudProfileControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamespacedCloudProfileControllerConfiguration)(nil), (*NamespacedCloudProfileControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamespacedCloudProfileControllerConfiguration_To_v1alpha1_NamespacedCloudProfileControllerConfiguration(a.(*config.NamespacedCloudProfileControllerConfiguration), b.(*NamespacedCloudProfileControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectControllerConfiguration)(nil), (*config.ProjectControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(a.(*ProjectControllerConfiguration), b.(*config.ProjectControllerConfiguration), scope)
 	}); err != nil {
@@ -502,6 +512,7 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.ControllerRegistration = (*config.ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.Event = (*config.EventControllerConfiguration)(unsafe.Pointer(in.Event))
 	out.ExposureClass = (*config.ExposureClassControllerConfiguration)(unsafe.Pointer(in.ExposureClass))
+	out.NamespacedCloudProfile = (*config.NamespacedCloudProfileControllerConfiguration)(unsafe.Pointer(in.NamespacedCloudProfile))
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(config.ProjectControllerConfiguration)
@@ -544,6 +555,7 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.ControllerRegistration = (*ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.Event = (*EventControllerConfiguration)(unsafe.Pointer(in.Event))
 	out.ExposureClass = (*ExposureClassControllerConfiguration)(unsafe.Pointer(in.ExposureClass))
+	out.NamespacedCloudProfile = (*NamespacedCloudProfileControllerConfiguration)(unsafe.Pointer(in.NamespacedCloudProfile))
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
@@ -664,6 +676,26 @@ func Convert_config_ManagedSeedSetControllerConfiguration_To_v1alpha1_ManagedSee
 	return autoConvert_config_ManagedSeedSetControllerConfiguration_To_v1alpha1_ManagedSeedSetControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_NamespacedCloudProfileControllerConfiguration_To_config_NamespacedCloudProfileControllerConfiguration(in *NamespacedCloudProfileControllerConfiguration, out *config.NamespacedCloudProfileControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_NamespacedCloudProfileControllerConfiguration_To_config_NamespacedCloudProfileControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_NamespacedCloudProfileControllerConfiguration_To_config_NamespacedCloudProfileControllerConfiguration(in *NamespacedCloudProfileControllerConfiguration, out *config.NamespacedCloudProfileControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespacedCloudProfileControllerConfiguration_To_config_NamespacedCloudProfileControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_NamespacedCloudProfileControllerConfiguration_To_v1alpha1_NamespacedCloudProfileControllerConfiguration(in *config.NamespacedCloudProfileControllerConfiguration, out *NamespacedCloudProfileControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_NamespacedCloudProfileControllerConfiguration_To_v1alpha1_NamespacedCloudProfileControllerConfiguration is an autogenerated conversion function.
+func Convert_config_NamespacedCloudProfileControllerConfiguration_To_v1alpha1_NamespacedCloudProfileControllerConfiguration(in *config.NamespacedCloudProfileControllerConfiguration, out *NamespacedCloudProfileControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_NamespacedCloudProfileControllerConfiguration_To_v1alpha1_NamespacedCloudProfileControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(in *ProjectControllerConfiguration, out *config.ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MinimumLifetimeDays = (*int)(unsafe.Pointer(in.MinimumLifetimeDays))
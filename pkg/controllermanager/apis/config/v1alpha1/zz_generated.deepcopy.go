@@ -216,6 +216,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ExposureClassControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespacedCloudProfile != nil {
+		in, out := &in.NamespacedCloudProfile, &out.NamespacedCloudProfile
+		*out = new(NamespacedCloudProfileControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
@@ -386,6 +391,27 @@ func (in *ManagedSeedSetControllerConfiguration) DeepCopy() *ManagedSeedSetContr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfileControllerConfiguration) DeepCopyInto(out *NamespacedCloudProfileControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileControllerConfiguration.
+func (in *NamespacedCloudProfileControllerConfiguration) DeepCopy() *NamespacedCloudProfileControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfileControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerConfiguration) {
 	*out = *in
@@ -60,6 +60,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ExposureClass != nil {
 		SetDefaults_ExposureClassControllerConfiguration(in.Controllers.ExposureClass)
 	}
+	if in.Controllers.NamespacedCloudProfile != nil {
+		SetDefaults_NamespacedCloudProfileControllerConfiguration(in.Controllers.NamespacedCloudProfile)
+	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
 	}
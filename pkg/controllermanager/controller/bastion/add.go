@@ -52,7 +52,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	c, err := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
-		For(&operationsv1alpha1.Bastion{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&operationsv1alpha1.Bastion{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, r.SessionsChangedPredicate()))).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0),
 		}).
@@ -96,6 +96,27 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 	)
 }
 
+// SessionsChangedPredicate returns a predicate which returns true when sessions were added to the history of a Bastion.
+func (r *Reconciler) SessionsChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(_ event.CreateEvent) bool { return false },
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldBastion, ok := e.ObjectOld.(*operationsv1alpha1.Bastion)
+			if !ok {
+				return false
+			}
+			newBastion, ok := e.ObjectNew.(*operationsv1alpha1.Bastion)
+			if !ok {
+				return false
+			}
+
+			return len(oldBastion.Status.Sessions) != len(newBastion.Status.Sessions)
+		},
+	}
+}
+
 // MapShootToBastions is a mapper.MapFunc for mapping shoots to referencing Bastions.
 func (r *Reconciler) MapShootToBastions(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
 	shoot, ok := obj.(*gardencorev1beta1.Shoot)
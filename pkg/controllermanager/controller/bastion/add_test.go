@@ -143,6 +143,40 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("SessionsChangedPredicate", func() {
+		var (
+			p   predicate.Predicate
+			obj *operationsv1alpha1.Bastion
+		)
+
+		BeforeEach(func() {
+			p = reconciler.SessionsChangedPredicate()
+			obj = &operationsv1alpha1.Bastion{Status: operationsv1alpha1.BastionStatus{
+				Sessions: []operationsv1alpha1.BastionSession{{Action: operationsv1alpha1.BastionSessionActionCreate}},
+			}}
+		})
+
+		It("should return false for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: obj})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: obj})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: obj})).To(BeFalse())
+		})
+
+		It("should return false if the sessions did not change", func() {
+			objNew := obj.DeepCopy()
+			objNew.Status.RecordedSessions = pointer.Int32(1)
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: obj, ObjectNew: objNew})).To(BeFalse())
+		})
+
+		It("should return true if a session was added", func() {
+			objNew := obj.DeepCopy()
+			objNew.Status.Sessions = append(objNew.Status.Sessions, operationsv1alpha1.BastionSession{Action: operationsv1alpha1.BastionSessionActionExtend})
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: obj, ObjectNew: objNew})).To(BeTrue())
+		})
+	})
+
 	Describe("MapShootToBastions", func() {
 		var (
 			ctx        = context.TODO()
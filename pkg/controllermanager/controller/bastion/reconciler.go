@@ -18,9 +18,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-logr/logr"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -29,6 +32,7 @@ import (
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -60,6 +64,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	// record the sessions which were added to the history since the last reconciliation; this is best-effort and must
+	// not prevent the deletion of the bastion below, failures are retried when the bastion is requeued
+	recordErr := r.recordSessionEvents(ctx, bastion)
+	if recordErr != nil {
+		log.Error(recordErr, "Failed recording events for bastion sessions")
+	}
+
 	shootKey := kubernetesutils.Key(bastion.Namespace, bastion.Spec.ShootRef.Name)
 	log = log.WithValues("shoot", shootKey)
 
@@ -71,7 +82,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		// is gone as well and without that, cleanly destroying a Bastion is not possible.
 		if apierrors.IsNotFound(err) {
 			log.Info("Deleting bastion because target shoot is gone")
-			return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+			return reconcile.Result{}, r.delete(ctx, log, bastion, operationsv1alpha1.BastionSessionActionDelete)
 		}
 		return reconcile.Result{}, fmt.Errorf("could not get shoot %v: %w", shootKey, err)
 	}
@@ -79,7 +90,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// delete the bastion if the shoot is marked for deletion
 	if shoot.DeletionTimestamp != nil {
 		log.Info("Deleting bastion because target shoot is in deletion")
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.delete(ctx, log, bastion, operationsv1alpha1.BastionSessionActionDelete)
 	}
 
 	// the Shoot for this bastion has been migrated to another Seed, we have to garbage-collect
@@ -92,19 +103,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if !apiequality.Semantic.DeepEqual(shoot.Spec.SeedName, bastion.Spec.SeedName) {
 		log.Info("Deleting bastion because the referenced Shoot has been migrated to another Seed",
 			"oldSeedName", bastion.Spec.SeedName, "newSeedName", shoot.Spec.SeedName)
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.delete(ctx, log, bastion, operationsv1alpha1.BastionSessionActionDelete)
 	}
 
 	// delete the bastion once it has expired
 	if bastion.Status.ExpirationTimestamp != nil && r.Clock.Now().After(bastion.Status.ExpirationTimestamp.Time) {
 		log.Info("Deleting expired bastion", "expirationTimestamp", bastion.Status.ExpirationTimestamp.Time)
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.delete(ctx, log, bastion, operationsv1alpha1.BastionSessionActionExpire)
 	}
 
 	// delete the bastion once it has reached its maximum lifetime
 	if r.Clock.Since(bastion.CreationTimestamp.Time) > r.Config.MaxLifetime.Duration {
 		log.Info("Deleting bastion because it reached its maximum lifetime", "creationTimestamp", bastion.CreationTimestamp.Time, "maxLifetime", r.Config.MaxLifetime.Duration)
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.delete(ctx, log, bastion, operationsv1alpha1.BastionSessionActionExpire)
 	}
 
 	// requeue when the Bastion expires or reaches its lifetime, whichever is sooner
@@ -120,6 +131,73 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("the bastion should already have been deleted")
 	}
 
+	if recordErr != nil {
+		return reconcile.Result{}, recordErr
+	}
+
 	log.V(1).Info("Requeuing Bastion", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
+
+// delete records the given action in the session history of the given bastion and as an event for its project before
+// deleting it. Recording the action is best-effort, i.e., failures are only logged and never prevent the deletion.
+func (r *Reconciler) delete(ctx context.Context, log logr.Logger, bastion *operationsv1alpha1.Bastion, action operationsv1alpha1.BastionSessionAction) error {
+	if err := r.recordSession(ctx, bastion, action); err != nil {
+		log.Error(err, "Failed recording bastion session before deletion", "action", action)
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+}
+
+func (r *Reconciler) recordSession(ctx context.Context, bastion *operationsv1alpha1.Bastion, action operationsv1alpha1.BastionSessionAction) error {
+	// The action might have been recorded already in a previous reconciliation which failed to delete the bastion.
+	if sessions := bastion.Status.Sessions; len(sessions) == 0 || sessions[len(sessions)-1].Action != action {
+		session := operationsv1alpha1.BastionSession{
+			Action:    action,
+			Timestamp: metav1.NewTime(r.Clock.Now()),
+		}
+		if action == operationsv1alpha1.BastionSessionActionExpire {
+			session.ExpirationTimestamp = bastion.Status.ExpirationTimestamp
+		}
+
+		// The user of the session is set by the API server.
+		patch := client.MergeFromWithOptions(bastion.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		bastion.Status.Sessions = append(bastion.Status.Sessions, session)
+		if err := r.Client.Status().Patch(ctx, bastion, patch); err != nil {
+			return err
+		}
+	}
+
+	return r.recordSessionEvents(ctx, bastion)
+}
+
+// recordSessionEvents records an event for the project of the given bastion for every session which was added to its
+// history since the last reconciliation. Sessions are recorded by the API server, hence, the events are only emitted
+// once the respective action was persisted successfully. The number of recorded sessions is tracked in the status of
+// the bastion, which cannot be changed by its users.
+func (r *Reconciler) recordSessionEvents(ctx context.Context, bastion *operationsv1alpha1.Bastion) error {
+	recordedSessions := int(pointer.Int32Deref(bastion.Status.RecordedSessions, 0))
+	if recordedSessions >= len(bastion.Status.Sessions) {
+		return nil
+	}
+
+	// Bastions are usually created in project namespaces, however, this is not enforced. Without a project, the events
+	// are recorded for the bastion itself.
+	var project metav1.Object
+	if p, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, bastion.Namespace); err == nil {
+		project = p
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed getting project for namespace %s: %w", bastion.Namespace, err)
+	}
+
+	for _, session := range bastion.Status.Sessions[recordedSessions:] {
+		event := gardenerutils.NewBastionSessionEvent(project, bastion, bastion.Spec.ShootRef.Name, session.Action, session.User, session.ExpirationTimestamp, "gardener-controller-manager", session.Timestamp.Time)
+		if err := r.Client.Create(ctx, event); err != nil {
+			return fmt.Errorf("failed recording event for %s action on bastion session: %w", session.Action, err)
+		}
+	}
+
+	patch := client.MergeFrom(bastion.DeepCopy())
+	bastion.Status.RecordedSessions = pointer.Int32(int32(len(bastion.Status.Sessions)))
+	return client.IgnoreNotFound(r.Client.Status().Patch(ctx, bastion, patch))
+}
@@ -16,16 +16,20 @@ package bastion_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -34,14 +38,17 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/bastion"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 var _ = Describe("Controller", func() {
 	var (
-		mockCtrl   *gomock.Controller
-		mockClient *mockclient.MockClient
-		reconciler reconcile.Reconciler
+		mockCtrl         *gomock.Controller
+		mockClient       *mockclient.MockClient
+		mockStatusWriter *mockclient.MockStatusWriter
+		reconciler       reconcile.Reconciler
+		fakeErr          = fmt.Errorf("fake")
 
 		namespace   = "garden-dev"
 		bastionName = "bastion"
@@ -54,6 +61,7 @@ var _ = Describe("Controller", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockClient = mockclient.NewMockClient(mockCtrl)
+		mockStatusWriter = mockclient.NewMockStatusWriter(mockCtrl)
 		reconciler = &Reconciler{
 			Client: mockClient,
 			Config: config.BastionControllerConfiguration{
@@ -95,6 +103,92 @@ var _ = Describe("Controller", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should record events for new sessions of alive Bastions", func() {
+			created := time.Now().Add(-maxLifetime / 2)
+			expires := time.Now().Add(time.Hour)
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, bastionName), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, &expires)
+				obj.Status.Sessions = []operationsv1alpha1.BastionSession{
+					{Action: operationsv1alpha1.BastionSessionActionCreate, User: "foo"},
+					{Action: operationsv1alpha1.BastionSessionActionExtend, User: "bar", ExpirationTimestamp: &metav1.Time{Time: expires}},
+				}
+				obj.Status.RecordedSessions = pointer.Int32(1)
+				return nil
+			})
+
+			expectProject(mockClient)
+			mockClient.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Event{})).DoAndReturn(func(_ context.Context, event *corev1.Event, _ ...client.CreateOption) error {
+				Expect(event.Namespace).To(Equal(namespace))
+				Expect(event.InvolvedObject.Name).To(Equal("dev"))
+				Expect(event.Related.Name).To(Equal(bastionName))
+				Expect(event.Reason).To(Equal(gardenerutils.EventReasonBastionSessionExtended))
+				Expect(event.Message).To(And(
+					ContainSubstring(`"bar"`),
+					ContainSubstring(shootName),
+					ContainSubstring(expires.UTC().Format(time.RFC3339)),
+				))
+				return nil
+			})
+			expectRecordedSessions(mockClient, mockStatusWriter, 2)
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, shootName), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should record events for the Bastion itself if its namespace does not belong to a project", func() {
+			created := time.Now().Add(-maxLifetime / 2)
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, bastionName), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, nil)
+				obj.Status.Sessions = []operationsv1alpha1.BastionSession{{Action: operationsv1alpha1.BastionSessionActionCreate, User: "foo"}}
+				return nil
+			})
+
+			mockClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ProjectList{}), gomock.Any())
+			mockClient.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Event{})).DoAndReturn(func(_ context.Context, event *corev1.Event, _ ...client.CreateOption) error {
+				Expect(event.InvolvedObject.Kind).To(Equal("Bastion"))
+				Expect(event.InvolvedObject.Name).To(Equal(bastionName))
+				Expect(event.Reason).To(Equal(gardenerutils.EventReasonBastionSessionCreated))
+				return nil
+			})
+			expectRecordedSessions(mockClient, mockStatusWriter, 1)
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, shootName), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error after checking alive Bastions if the events for new sessions cannot be recorded", func() {
+			created := time.Now().Add(-maxLifetime / 2)
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, bastionName), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, nil)
+				obj.Status.Sessions = []operationsv1alpha1.BastionSession{{Action: operationsv1alpha1.BastionSessionActionCreate, User: "foo"}}
+				return nil
+			})
+
+			expectProject(mockClient)
+			mockClient.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Event{})).Return(fakeErr)
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, shootName), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+			Expect(err).To(MatchError(ContainSubstring(fakeErr.Error())))
+		})
+
 		It("should requeue soon-to-expire Bastions", func() {
 			now := time.Now()
 			remaining := 30 * time.Second
@@ -145,6 +239,7 @@ var _ = Describe("Controller", func() {
 				return nil
 			})
 
+			expectSession(mockClient, mockStatusWriter, namespace, bastionName, operationsv1alpha1.BastionSessionActionDelete, gardenerutils.EventReasonBastionSessionDeleted)
 			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
 
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
@@ -168,6 +263,7 @@ var _ = Describe("Controller", func() {
 				return nil
 			})
 
+			expectSession(mockClient, mockStatusWriter, namespace, bastionName, operationsv1alpha1.BastionSessionActionDelete, gardenerutils.EventReasonBastionSessionDeleted)
 			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
 
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
@@ -189,6 +285,60 @@ var _ = Describe("Controller", func() {
 				return nil
 			})
 
+			expectSession(mockClient, mockStatusWriter, namespace, bastionName, operationsv1alpha1.BastionSessionActionExpire, gardenerutils.EventReasonBastionSessionExpired)
+			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should delete expired Bastions even if the expiration cannot be recorded", func() {
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, shootName), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, bastionName), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				created := time.Now().Add(-maxLifetime / 2)
+				expires := time.Now().Add(-5 * time.Second)
+
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, &expires)
+				obj.Status.Sessions = []operationsv1alpha1.BastionSession{{Action: operationsv1alpha1.BastionSessionActionCreate}}
+				return nil
+			})
+
+			// recording the pending session fails
+			mockClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ProjectList{}), gomock.Any()).Return(fakeErr)
+			// recording the expiration fails
+			mockClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}), gomock.Any()).Return(fakeErr)
+			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not record the expiration of Bastions twice", func() {
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, shootName), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, bastionName), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				created := time.Now().Add(-maxLifetime / 2)
+				expires := time.Now().Add(-5 * time.Second)
+
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, &expires)
+				obj.Status.Sessions = []operationsv1alpha1.BastionSession{
+					{Action: operationsv1alpha1.BastionSessionActionCreate},
+					{Action: operationsv1alpha1.BastionSessionActionExpire},
+				}
+				obj.Status.RecordedSessions = pointer.Int32(2)
+				return nil
+			})
+
 			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
 
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
@@ -209,6 +359,7 @@ var _ = Describe("Controller", func() {
 				return nil
 			})
 
+			expectSession(mockClient, mockStatusWriter, namespace, bastionName, operationsv1alpha1.BastionSessionActionExpire, gardenerutils.EventReasonBastionSessionExpired)
 			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
 
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
@@ -232,6 +383,7 @@ var _ = Describe("Controller", func() {
 				return nil
 			})
 
+			expectSession(mockClient, mockStatusWriter, namespace, bastionName, operationsv1alpha1.BastionSessionActionDelete, gardenerutils.EventReasonBastionSessionDeleted)
 			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
 
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
@@ -253,6 +405,7 @@ var _ = Describe("Controller", func() {
 				return nil
 			})
 
+			expectSession(mockClient, mockStatusWriter, namespace, bastionName, operationsv1alpha1.BastionSessionActionDelete, gardenerutils.EventReasonBastionSessionDeleted)
 			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
 
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
@@ -262,6 +415,40 @@ var _ = Describe("Controller", func() {
 	})
 })
 
+func expectSession(mockClient *mockclient.MockClient, mockStatusWriter *mockclient.MockStatusWriter, namespace, bastionName string, action operationsv1alpha1.BastionSessionAction, reason string) {
+	mockClient.EXPECT().Status().Return(mockStatusWriter)
+	mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj *operationsv1alpha1.Bastion, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+		ExpectWithOffset(3, obj.Status.Sessions).To(HaveLen(1))
+		ExpectWithOffset(3, obj.Status.Sessions[0].Action).To(Equal(action))
+		return nil
+	})
+
+	expectProject(mockClient)
+	mockClient.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Event{})).DoAndReturn(func(_ context.Context, event *corev1.Event, _ ...client.CreateOption) error {
+		ExpectWithOffset(3, event.Namespace).To(Equal(namespace))
+		ExpectWithOffset(3, event.InvolvedObject.Name).To(Equal("dev"))
+		ExpectWithOffset(3, event.Related.Name).To(Equal(bastionName))
+		ExpectWithOffset(3, event.Reason).To(Equal(reason))
+		return nil
+	})
+	expectRecordedSessions(mockClient, mockStatusWriter, 1)
+}
+
+func expectProject(mockClient *mockclient.MockClient) {
+	mockClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ProjectList{}), gomock.Any()).DoAndReturn(func(_ context.Context, list *gardencorev1beta1.ProjectList, _ ...client.ListOption) error {
+		list.Items = []gardencorev1beta1.Project{{ObjectMeta: metav1.ObjectMeta{Name: "dev", UID: types.UID("project-uid")}}}
+		return nil
+	})
+}
+
+func expectRecordedSessions(mockClient *mockclient.MockClient, mockStatusWriter *mockclient.MockStatusWriter, recordedSessions int32) {
+	mockClient.EXPECT().Status().Return(mockStatusWriter)
+	mockStatusWriter.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj *operationsv1alpha1.Bastion, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+		ExpectWithOffset(3, obj.Status.RecordedSessions).To(PointTo(Equal(recordedSessions)))
+		return nil
+	})
+}
+
 func newBastion(namespace string, name string, shootName string, seedName *string, createdAt *time.Time, expiresAt *time.Time) operationsv1alpha1.Bastion {
 	bastion := operationsv1alpha1.Bastion{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,107 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacedcloudprofile
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "namespacedcloudprofile"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.NamespacedCloudProfile{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.CloudProfile{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapCloudProfileToNamespacedCloudProfiles), mapper.UpdateWithNew, c.GetLogger()),
+		r.CloudProfilePredicate(),
+	)
+}
+
+// CloudProfilePredicate returns a predicate which returns true for all events except for updates which do not change
+// the spec of the CloudProfile.
+func (r *Reconciler) CloudProfilePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCloudProfile, ok := e.ObjectOld.(*gardencorev1beta1.CloudProfile)
+			if !ok {
+				return false
+			}
+			newCloudProfile, ok := e.ObjectNew.(*gardencorev1beta1.CloudProfile)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldCloudProfile.Spec, newCloudProfile.Spec)
+		},
+	}
+}
+
+// MapCloudProfileToNamespacedCloudProfiles is a mapper.MapFunc for mapping a CloudProfile to the
+// NamespacedCloudProfiles referencing it as parent.
+func (r *Reconciler) MapCloudProfileToNamespacedCloudProfiles(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	cloudProfile, ok := obj.(*gardencorev1beta1.CloudProfile)
+	if !ok {
+		return nil
+	}
+
+	namespacedCloudProfileList := &gardencorev1beta1.NamespacedCloudProfileList{}
+	if err := reader.List(ctx, namespacedCloudProfileList); err != nil {
+		log.Error(err, "Failed to list NamespacedCloudProfiles")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, namespacedCloudProfile := range namespacedCloudProfileList.Items {
+		if namespacedCloudProfile.Spec.Parent.Kind == gardencorev1beta1.CloudProfileReferenceKindCloudProfile && namespacedCloudProfile.Spec.Parent.Name == cloudProfile.Name {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&namespacedCloudProfile)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacedcloudprofile_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/namespacedcloudprofile"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		reconciler   *Reconciler
+		cloudProfile *gardencorev1beta1.CloudProfile
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "parent"},
+			Spec:       gardencorev1beta1.CloudProfileSpec{Type: "local"},
+		}
+	})
+
+	Describe("#CloudProfilePredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.CloudProfilePredicate()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: cloudProfile})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: cloudProfile})).To(BeTrue())
+		})
+
+		It("should return false if the spec did not change", func() {
+			newCloudProfile := cloudProfile.DeepCopy()
+			newCloudProfile.Labels = map[string]string{"foo": "bar"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cloudProfile, ObjectNew: newCloudProfile})).To(BeFalse())
+		})
+
+		It("should return true if the spec changed", func() {
+			newCloudProfile := cloudProfile.DeepCopy()
+			newCloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{Name: "type"}}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cloudProfile, ObjectNew: newCloudProfile})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapCloudProfileToNamespacedCloudProfiles", func() {
+		var fakeClient client.Client
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		})
+
+		It("should map to all namespaced cloud profiles referencing the cloud profile", func() {
+			for _, obj := range []*gardencorev1beta1.NamespacedCloudProfile{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ncp-1", Namespace: "garden-foo"},
+					Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "parent"}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ncp-2", Namespace: "garden-bar"},
+					Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "parent"}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ncp-3", Namespace: "garden-foo"},
+					Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "other"}},
+				},
+			} {
+				Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			Expect(reconciler.MapCloudProfileToNamespacedCloudProfiles(ctx, log, fakeClient, cloudProfile)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "ncp-1", Namespace: "garden-foo"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "ncp-2", Namespace: "garden-bar"}},
+			))
+		})
+
+		It("should return nil if the object is not a cloud profile", func() {
+			Expect(reconciler.MapCloudProfileToNamespacedCloudProfiles(ctx, log, fakeClient, &gardencorev1beta1.Shoot{})).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,311 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacedcloudprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles NamespacedCloudProfiles.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.NamespacedCloudProfileControllerConfiguration
+	Recorder record.EventRecorder
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+	if err := r.Client.Get(ctx, request.NamespacedName, namespacedCloudProfile); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// The deletionTimestamp labels the NamespacedCloudProfile as intended to get deleted. Before deletion, it has to be
+	// ensured that no Shoots are assigned to the NamespacedCloudProfile anymore. If this is the case then the controller
+	// will remove the finalizers from the NamespacedCloudProfile so that it can be garbage collected.
+	if namespacedCloudProfile.DeletionTimestamp != nil {
+		if !sets.New(namespacedCloudProfile.Finalizers...).Has(gardencorev1beta1.GardenerName) {
+			return reconcile.Result{}, nil
+		}
+
+		associatedShoots, err := controllerutils.DetermineShootsAssociatedTo(ctx, r.Client, namespacedCloudProfile)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if len(associatedShoots) == 0 {
+			log.Info("No Shoots are referencing the NamespacedCloudProfile, deletion accepted")
+
+			if controllerutil.ContainsFinalizer(namespacedCloudProfile, gardencorev1beta1.GardenerName) {
+				log.Info("Removing finalizer")
+				if err := controllerutils.RemoveFinalizers(ctx, r.Client, namespacedCloudProfile, gardencorev1beta1.GardenerName); err != nil {
+					return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+				}
+			}
+
+			return reconcile.Result{}, nil
+		}
+
+		message := fmt.Sprintf("Cannot delete NamespacedCloudProfile, because the following Shoots are still referencing it: %+v", associatedShoots)
+		r.Recorder.Event(namespacedCloudProfile, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, message)
+		return reconcile.Result{}, fmt.Errorf(message)
+	}
+
+	if !controllerutil.ContainsFinalizer(namespacedCloudProfile, gardencorev1beta1.GardenerName) {
+		log.Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.Client, namespacedCloudProfile, gardencorev1beta1.GardenerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	parentCloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: namespacedCloudProfile.Spec.Parent.Name}, parentCloudProfile); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading parent CloudProfile %q: %w", namespacedCloudProfile.Spec.Parent.Name, err)
+	}
+
+	cloudProfileSpec, err := MergeCloudProfileSpec(parentCloudProfile.Spec, namespacedCloudProfile.Spec)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed merging NamespacedCloudProfile into parent CloudProfile %q: %w", parentCloudProfile.Name, err)
+	}
+
+	patch := client.MergeFrom(namespacedCloudProfile.DeepCopy())
+	namespacedCloudProfile.Status.CloudProfileSpec = cloudProfileSpec
+	namespacedCloudProfile.Status.ObservedGeneration = namespacedCloudProfile.Generation
+	if err := r.Client.Status().Patch(ctx, namespacedCloudProfile, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status of NamespacedCloudProfile: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// MergeCloudProfileSpec computes the effective CloudProfileSpec by merging the given NamespacedCloudProfileSpec into
+// the spec of the parent CloudProfile. Machine types, volume types and machine image versions not present in the parent
+// are added. For Kubernetes and machine image versions present in the parent, only the expiration date can be extended.
+// The provider config is merged into the provider config of the parent, see mergeProviderConfig.
+func MergeCloudProfileSpec(parentSpec gardencorev1beta1.CloudProfileSpec, namespacedSpec gardencorev1beta1.NamespacedCloudProfileSpec) (gardencorev1beta1.CloudProfileSpec, error) {
+	spec := *parentSpec.DeepCopy()
+
+	providerConfig, err := mergeProviderConfig(spec.ProviderConfig, namespacedSpec.ProviderConfig)
+	if err != nil {
+		return gardencorev1beta1.CloudProfileSpec{}, err
+	}
+	spec.ProviderConfig = providerConfig
+
+	if namespacedSpec.Kubernetes != nil {
+		for _, version := range namespacedSpec.Kubernetes.Versions {
+			for i, parentVersion := range spec.Kubernetes.Versions {
+				if parentVersion.Version == version.Version {
+					spec.Kubernetes.Versions[i].ExpirationDate = laterExpirationDate(parentVersion.ExpirationDate, version.ExpirationDate)
+				}
+			}
+		}
+	}
+
+	for _, image := range namespacedSpec.MachineImages {
+		parentImageIndex := -1
+		for i, parentImage := range spec.MachineImages {
+			if parentImage.Name == image.Name {
+				parentImageIndex = i
+				break
+			}
+		}
+
+		if parentImageIndex == -1 {
+			spec.MachineImages = append(spec.MachineImages, *image.DeepCopy())
+			continue
+		}
+
+		parentImage := &spec.MachineImages[parentImageIndex]
+		for _, version := range image.Versions {
+			parentVersionIndex := -1
+			for i, parentVersion := range parentImage.Versions {
+				if parentVersion.Version == version.Version {
+					parentVersionIndex = i
+					break
+				}
+			}
+
+			if parentVersionIndex == -1 {
+				parentImage.Versions = append(parentImage.Versions, *version.DeepCopy())
+				continue
+			}
+
+			parentImage.Versions[parentVersionIndex].ExpirationDate = laterExpirationDate(parentImage.Versions[parentVersionIndex].ExpirationDate, version.ExpirationDate)
+		}
+	}
+
+	machineTypeNames := sets.New[string]()
+	for _, machineType := range spec.MachineTypes {
+		machineTypeNames.Insert(machineType.Name)
+	}
+	for _, machineType := range namespacedSpec.MachineTypes {
+		if !machineTypeNames.Has(machineType.Name) {
+			spec.MachineTypes = append(spec.MachineTypes, *machineType.DeepCopy())
+		}
+	}
+
+	volumeTypeNames := sets.New[string]()
+	for _, volumeType := range spec.VolumeTypes {
+		volumeTypeNames.Insert(volumeType.Name)
+	}
+	for _, volumeType := range namespacedSpec.VolumeTypes {
+		if !volumeTypeNames.Has(volumeType.Name) {
+			spec.VolumeTypes = append(spec.VolumeTypes, *volumeType.DeepCopy())
+		}
+	}
+
+	return spec, nil
+}
+
+// providerConfigMergeKeys are the fields which identify the elements of lists in provider configs, e.g., machine images
+// are identified by their name and machine image versions by their version.
+var providerConfigMergeKeys = []string{"name", "version"}
+
+// mergeProviderConfig merges the given provider config of a NamespacedCloudProfile into the provider config of its
+// parent CloudProfile. Objects are merged recursively and lists of objects are merged by the fields in
+// providerConfigMergeKeys, so that, e.g., the provider-specific images for additional machine image versions can be
+// added. All other values of the NamespacedCloudProfile take precedence.
+func mergeProviderConfig(parent, namespaced *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if namespaced == nil || len(namespaced.Raw) == 0 {
+		return parent, nil
+	}
+	if parent == nil || len(parent.Raw) == 0 {
+		return namespaced.DeepCopy(), nil
+	}
+
+	var parentConfig, namespacedConfig map[string]interface{}
+	if err := json.Unmarshal(parent.Raw, &parentConfig); err != nil {
+		return nil, fmt.Errorf("failed decoding provider config of parent CloudProfile: %w", err)
+	}
+	if err := json.Unmarshal(namespaced.Raw, &namespacedConfig); err != nil {
+		return nil, fmt.Errorf("failed decoding provider config: %w", err)
+	}
+
+	raw, err := json.Marshal(mergeValues(parentConfig, namespacedConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding merged provider config: %w", err)
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+func mergeValues(parent, namespaced interface{}) interface{} {
+	switch namespacedValue := namespaced.(type) {
+	case map[string]interface{}:
+		parentValue, ok := parent.(map[string]interface{})
+		if !ok {
+			return namespacedValue
+		}
+		for key, value := range namespacedValue {
+			parentValue[key] = mergeValues(parentValue[key], value)
+		}
+		return parentValue
+
+	case []interface{}:
+		parentValue, ok := parent.([]interface{})
+		if !ok {
+			return namespacedValue
+		}
+		return mergeLists(parentValue, namespacedValue)
+	}
+
+	return namespaced
+}
+
+func mergeLists(parent, namespaced []interface{}) []interface{} {
+	mergeKey := listMergeKey(parent, namespaced)
+	if mergeKey == "" {
+		return namespaced
+	}
+
+	for _, value := range namespaced {
+		element := value.(map[string]interface{})
+
+		index := -1
+		for i, parentElement := range parent {
+			if parentElement.(map[string]interface{})[mergeKey] == element[mergeKey] {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			parent = append(parent, element)
+			continue
+		}
+		parent[index] = mergeValues(parent[index], element)
+	}
+
+	return parent
+}
+
+// listMergeKey returns the first of the providerConfigMergeKeys which is a string field of all elements of the given lists.
+// It returns an empty string if the lists cannot be merged.
+func listMergeKey(lists ...[]interface{}) string {
+	for _, key := range providerConfigMergeKeys {
+		found := true
+		for _, list := range lists {
+			for _, value := range list {
+				element, ok := value.(map[string]interface{})
+				if !ok {
+					return ""
+				}
+				if _, ok := element[key].(string); !ok {
+					found = false
+				}
+			}
+		}
+		if found {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// laterExpirationDate returns the later one of the given expiration dates. A nil parent expiration date means that the
+// version never expires, hence it cannot be extended.
+func laterExpirationDate(parent, override *metav1.Time) *metav1.Time {
+	if parent == nil || override == nil || !override.After(parent.Time) {
+		return parent
+	}
+	return override.DeepCopy()
+}
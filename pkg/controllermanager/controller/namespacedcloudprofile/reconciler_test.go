@@ -0,0 +1,290 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacedcloudprofile_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/namespacedcloudprofile"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		cloudProfile           *gardencorev1beta1.CloudProfile
+		namespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+		request                reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.NamespacedCloudProfile{}).
+			Build()
+		reconciler = &Reconciler{Client: fakeClient, Recorder: &record.FakeRecorder{}}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "parent"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type:         "local",
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "parent-type"}},
+			},
+		}
+		namespacedCloudProfile = &gardencorev1beta1.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-dev", Generation: 1},
+			Spec: gardencorev1beta1.NamespacedCloudProfileSpec{
+				Parent:       gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "parent"},
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "custom-type"}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(namespacedCloudProfile)}
+	})
+
+	It("should do nothing if the object is gone", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should add the finalizer and compute the effective cloud profile", func() {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespacedCloudProfile), namespacedCloudProfile)).To(Succeed())
+		Expect(namespacedCloudProfile.Finalizers).To(ConsistOf("gardener"))
+		Expect(namespacedCloudProfile.Status.ObservedGeneration).To(Equal(namespacedCloudProfile.Generation))
+		Expect(namespacedCloudProfile.Status.CloudProfileSpec.Type).To(Equal("local"))
+		Expect(namespacedCloudProfile.Status.CloudProfileSpec.MachineTypes).To(ConsistOf(
+			HaveField("Name", "parent-type"),
+			HaveField("Name", "custom-type"),
+		))
+	})
+
+	It("should return an error if the parent cloud profile does not exist", func() {
+		Expect(fakeClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed reading parent CloudProfile")))
+	})
+
+	Context("deletion", func() {
+		BeforeEach(func() {
+			namespacedCloudProfile.Finalizers = []string{"gardener"}
+			Expect(fakeClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+		})
+
+		It("should remove the finalizer if no shoot references the namespaced cloud profile", func() {
+			Expect(fakeClient.Delete(ctx, namespacedCloudProfile)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespacedCloudProfile), namespacedCloudProfile)).To(BeNotFoundError())
+		})
+
+		It("should not remove the finalizer if a shoot references the namespaced cloud profile", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespacedCloudProfile.Namespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: "parent",
+					CloudProfile:     &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: namespacedCloudProfile.Name},
+				},
+			})).To(Succeed())
+			Expect(fakeClient.Delete(ctx, namespacedCloudProfile)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("following Shoots are still referencing it: [garden-dev/shoot]")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespacedCloudProfile), namespacedCloudProfile)).To(Succeed())
+			Expect(namespacedCloudProfile.Finalizers).To(ConsistOf("gardener"))
+		})
+	})
+
+	Describe("#MergeCloudProfileSpec", func() {
+		var (
+			now        = metav1.Now()
+			later      = metav1.NewTime(now.Add(24 * time.Hour))
+			earlier    = metav1.NewTime(now.Add(-24 * time.Hour))
+			parentSpec gardencorev1beta1.CloudProfileSpec
+		)
+
+		BeforeEach(func() {
+			parentSpec = gardencorev1beta1.CloudProfileSpec{
+				Type: "local",
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.28.0", ExpirationDate: &now},
+						{Version: "1.27.0"},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name: "image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &now}},
+					},
+				}},
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "type", Usable: pointer.Bool(true)}},
+				VolumeTypes:  []gardencorev1beta1.VolumeType{{Name: "volume", Usable: pointer.Bool(true)}},
+			}
+		})
+
+		It("should return the parent spec if nothing is overridden", func() {
+			Expect(MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{})).To(Equal(parentSpec))
+		})
+
+		It("should only extend the expiration date of existing Kubernetes versions", func() {
+			spec, err := MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{
+				Kubernetes: &gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.28.0", ExpirationDate: &later},
+						{Version: "1.27.0", ExpirationDate: &later},
+						{Version: "1.26.0", ExpirationDate: &later},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(spec.Kubernetes.Versions).To(Equal([]gardencorev1beta1.ExpirableVersion{
+				{Version: "1.28.0", ExpirationDate: &later},
+				{Version: "1.27.0"},
+			}))
+		})
+
+		It("should not shorten the expiration date of existing versions", func() {
+			spec, err := MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{
+				Kubernetes: &gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.28.0", ExpirationDate: &earlier}},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name: "image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &earlier}},
+					},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(spec.Kubernetes.Versions[0].ExpirationDate).To(Equal(&now))
+			Expect(spec.MachineImages[0].Versions[0].ExpirationDate).To(Equal(&now))
+		})
+
+		It("should add machine images and versions and extend expiration dates of existing versions", func() {
+			spec, err := MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{
+				MachineImages: []gardencorev1beta1.MachineImage{
+					{
+						Name: "image",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &later}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0"}},
+						},
+					},
+					{
+						Name: "custom-image",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(spec.MachineImages).To(Equal([]gardencorev1beta1.MachineImage{
+				{
+					Name: "image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &later}},
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0"}},
+					},
+				},
+				{
+					Name: "custom-image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+					},
+				},
+			}))
+		})
+
+		It("should add machine and volume types which are not present in the parent", func() {
+			spec, err := MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "type", Usable: pointer.Bool(false)}, {Name: "custom-type"}},
+				VolumeTypes:  []gardencorev1beta1.VolumeType{{Name: "volume", Usable: pointer.Bool(false)}, {Name: "custom-volume"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(spec.MachineTypes).To(Equal([]gardencorev1beta1.MachineType{{Name: "type", Usable: pointer.Bool(true)}, {Name: "custom-type"}}))
+			Expect(spec.VolumeTypes).To(Equal([]gardencorev1beta1.VolumeType{{Name: "volume", Usable: pointer.Bool(true)}, {Name: "custom-volume"}}))
+		})
+
+		It("should use the provider config if the parent does not have one", func() {
+			spec, err := MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(spec.ProviderConfig).To(Equal(&runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}))
+		})
+
+		It("should merge the provider config into the one of the parent", func() {
+			parentSpec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","settings":{"a":"1","b":"2"},"zones":["z1"],"machineImages":[{"name":"image","versions":[{"version":"1.0.0","image":"image:1.0.0"}]}]}`)}
+
+			spec, err := MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"settings":{"b":"3"},"zones":["z2"],"machineImages":[{"name":"image","versions":[{"version":"2.0.0","image":"image:2.0.0"}]},{"name":"custom-image","versions":[{"version":"1.0.0","image":"custom-image:1.0.0"}]}]}`)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(spec.ProviderConfig.Raw).To(MatchJSON(`{
+  "apiVersion": "local.provider.extensions.gardener.cloud/v1alpha1",
+  "kind": "CloudProfileConfig",
+  "settings": {"a": "1", "b": "3"},
+  "zones": ["z2"],
+  "machineImages": [
+    {"name": "image", "versions": [{"version": "1.0.0", "image": "image:1.0.0"}, {"version": "2.0.0", "image": "image:2.0.0"}]},
+    {"name": "custom-image", "versions": [{"version": "1.0.0", "image": "custom-image:1.0.0"}]}
+  ]
+}`))
+		})
+
+		It("should fail if the provider config cannot be decoded", func() {
+			parentSpec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{}`)}
+
+			_, err := MergeCloudProfileSpec(parentSpec, gardencorev1beta1.NamespacedCloudProfileSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`["foo"]`)},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed decoding provider config")))
+		})
+	})
+})
@@ -15,32 +15,138 @@
 package quota
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 )
 
 // ControllerName is the name of this controller.
 const ControllerName = "quota"
 
 // AddToManager adds Reconciler to the given manager.
-func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
 
-	return builder.
+	for _, collector := range []prometheus.Collector{quotaUsed, quotaLimit} {
+		if err := metrics.Registry.Register(collector); err != nil && !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+			return fmt.Errorf("failed registering quota metrics: %w", err)
+		}
+	}
+
+	c, err := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
-		For(&gardencorev1beta1.Quota{}).
+		For(&gardencorev1beta1.Quota{}, builder.WithPredicates(r.QuotaPredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0),
 		}).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.SecretBinding{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapSecretBindingToQuotas), mapper.UpdateWithOldAndNew, c.GetLogger()),
+		r.SecretBindingPredicate(),
+	); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.Shoot{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapShootToQuotas), mapper.UpdateWithNew, c.GetLogger()),
+		predicate.GenerationChangedPredicate{},
+	)
+}
+
+// QuotaPredicate returns a predicate which returns true for all events except for updates which neither change the
+// generation nor concern a Quota in deletion. The deletion is checked explicitly because the API server does not increase
+// the generation of objects which do not have one yet when their deletion timestamp is set.
+func (r *Reconciler) QuotaPredicate() predicate.Predicate {
+	return predicate.Or(predicate.GenerationChangedPredicate{}, predicateutils.IsDeleting())
+}
+
+// SecretBindingPredicate returns a predicate which returns true for all events except for updates which do not change
+// the referenced quotas.
+func (r *Reconciler) SecretBindingPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSecretBinding, ok := e.ObjectOld.(*gardencorev1beta1.SecretBinding)
+			if !ok {
+				return false
+			}
+			newSecretBinding, ok := e.ObjectNew.(*gardencorev1beta1.SecretBinding)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldSecretBinding.Quotas, newSecretBinding.Quotas)
+		},
+	}
+}
+
+// MapSecretBindingToQuotas is a mapper.MapFunc for mapping a SecretBinding to the Quotas it references.
+func (r *Reconciler) MapSecretBindingToQuotas(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	secretBinding, ok := obj.(*gardencorev1beta1.SecretBinding)
+	if !ok {
+		return nil
+	}
+
+	return quotasToRequests(secretBinding)
+}
+
+// MapShootToQuotas is a mapper.MapFunc for mapping a Shoot to the Quotas referenced by its SecretBinding.
+func (r *Reconciler) MapShootToQuotas(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok || shoot.Spec.SecretBindingName == nil {
+		return nil
+	}
+
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to get SecretBinding for Shoot", "shoot", client.ObjectKeyFromObject(shoot))
+		}
+		return nil
+	}
+
+	return quotasToRequests(secretBinding)
+}
+
+func quotasToRequests(secretBinding *gardencorev1beta1.SecretBinding) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, quotaRef := range secretBinding.Quotas {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: quotaRef.Namespace, Name: quotaRef.Name}})
+	}
+	return requests
 }
@@ -0,0 +1,165 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/quota"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		reconciler    *Reconciler
+		secretBinding *gardencorev1beta1.SecretBinding
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-foo"},
+			Quotas: []corev1.ObjectReference{
+				{Name: "quota-1", Namespace: "garden"},
+				{Name: "quota-2", Namespace: "garden-foo"},
+			},
+		}
+	})
+
+	Describe("#QuotaPredicate", func() {
+		var (
+			p     predicate.Predicate
+			quota *gardencorev1beta1.Quota
+		)
+
+		BeforeEach(func() {
+			p = reconciler.QuotaPredicate()
+			quota = &gardencorev1beta1.Quota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "garden"}}
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: quota})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: quota})).To(BeTrue())
+		})
+
+		It("should return false if the generation did not change", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.Labels = map[string]string{"foo": "bar"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: quota, ObjectNew: newQuota})).To(BeFalse())
+		})
+
+		It("should return true if the generation changed", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.Generation++
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: quota, ObjectNew: newQuota})).To(BeTrue())
+		})
+
+		It("should return true if the deletion timestamp was set without changing the generation", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.DeletionTimestamp = &metav1.Time{}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: quota, ObjectNew: newQuota})).To(BeTrue())
+		})
+	})
+
+	Describe("#SecretBindingPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.SecretBindingPredicate()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: secretBinding})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: secretBinding})).To(BeTrue())
+		})
+
+		It("should return false if the quotas did not change", func() {
+			newSecretBinding := secretBinding.DeepCopy()
+			newSecretBinding.Labels = map[string]string{"foo": "bar"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: secretBinding, ObjectNew: newSecretBinding})).To(BeFalse())
+		})
+
+		It("should return true if the quotas changed", func() {
+			newSecretBinding := secretBinding.DeepCopy()
+			newSecretBinding.Quotas = newSecretBinding.Quotas[:1]
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: secretBinding, ObjectNew: newSecretBinding})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapSecretBindingToQuotas", func() {
+		It("should map the SecretBinding to the referenced quotas", func() {
+			Expect(reconciler.MapSecretBindingToQuotas(ctx, log, nil, secretBinding)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "quota-1", Namespace: "garden"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "quota-2", Namespace: "garden-foo"}},
+			))
+		})
+	})
+
+	Describe("#MapShootToQuotas", func() {
+		var (
+			fakeClient client.Client
+			shoot      *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SecretBindingName: pointer.String("binding")},
+			}
+		})
+
+		It("should map the Shoot to the quotas referenced by its SecretBinding", func() {
+			Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+
+			Expect(reconciler.MapShootToQuotas(ctx, log, fakeClient, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "quota-1", Namespace: "garden"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "quota-2", Namespace: "garden-foo"}},
+			))
+		})
+
+		It("should return nil if the SecretBinding does not exist", func() {
+			Expect(reconciler.MapShootToQuotas(ctx, log, fakeClient, shoot)).To(BeEmpty())
+		})
+
+		It("should return nil if the Shoot does not reference a SecretBinding", func() {
+			shoot.Spec.SecretBindingName = nil
+
+			Expect(reconciler.MapShootToQuotas(ctx, log, fakeClient, shoot)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// quotaUsed defines the gauge garden_quota_used which reports the amount of resources allocated by the consumers
+	// of a quota per metric.
+	quotaUsed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "garden",
+			Subsystem: "quota",
+			Name:      "used",
+			Help:      "Amount of resources allocated by the shoots consuming a quota. For quotas with 'project' scope, the usage is reported per project namespace.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"project_namespace",
+			"metric",
+		},
+	)
+
+	// quotaLimit defines the gauge garden_quota_limit which reports the limits of a quota per metric.
+	quotaLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "garden",
+			Subsystem: "quota",
+			Name:      "limit",
+			Help:      "Limit of a quota. The type label distinguishes hard limits from soft limits.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"metric",
+			"type",
+		},
+	)
+)
@@ -17,11 +17,20 @@ package quota
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -37,6 +46,7 @@ import (
 type Reconciler struct {
 	Client   client.Client
 	Config   config.QuotaControllerConfiguration
+	Clock    clock.Clock
 	Recorder record.EventRecorder
 }
 
@@ -51,6 +61,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.Client.Get(ctx, request.NamespacedName, quota); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -79,6 +90,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 				}
 			}
 
+			deleteMetrics(client.ObjectKeyFromObject(quota))
 			return reconcile.Result{}, nil
 		}
 
@@ -94,5 +106,81 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, r.reconcileStatus(ctx, log, quota)
+}
+
+func (r *Reconciler) reconcileStatus(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) error {
+	usage, err := r.computeUsage(ctx, quota)
+	if err != nil {
+		return fmt.Errorf("failed computing usage: %w", err)
+	}
+
+	reportMetrics(quota, usage)
+
+	if quota.Status.ObservedGeneration == quota.Generation && apiequality.Semantic.DeepEqual(quota.Status.Usage, usage) {
+		return nil
+	}
+
+	r.recordSoftLimitEvents(quota, usage)
+
+	log.V(1).Info("Updating usage")
+	patch := client.MergeFrom(quota.DeepCopy())
+	quota.Status.ObservedGeneration = quota.Generation
+	quota.Status.LastUpdateTime = &metav1.Time{Time: r.Clock.Now().UTC()}
+	quota.Status.Usage = usage
+	if err := r.Client.Status().Patch(ctx, quota, patch); err != nil {
+		return fmt.Errorf("failed updating status: %w", err)
+	}
+
+	return nil
+}
+
+// recordSoftLimitEvents records an event for each entry of the given usage which exceeds at least one of the soft limits
+// of the quota. It is only called if the usage or the limits changed, repeated events are aggregated by the recorder.
+func (r *Reconciler) recordSoftLimitEvents(quota *gardencorev1beta1.Quota, usage []gardencorev1beta1.QuotaUsage) {
+	for _, u := range usage {
+		var exceeded []string
+		for metric, limit := range quota.Spec.SoftMetrics {
+			if used := u.Used[metric]; used.Cmp(limit) > 0 {
+				exceeded = append(exceeded, fmt.Sprintf("%s (used %s, limit %s)", metric, used.String(), limit.String()))
+			}
+		}
+		if len(exceeded) == 0 {
+			continue
+		}
+		sort.Strings(exceeded)
+
+		message := "Soft limits exceeded for " + strings.Join(exceeded, ", ")
+		if u.Namespace != nil {
+			message += " in project namespace " + *u.Namespace
+		}
+		r.Recorder.Event(quota, corev1.EventTypeWarning, v1beta1constants.EventQuotaSoftLimitExceeded, message)
+	}
+}
+
+func reportMetrics(quota *gardencorev1beta1.Quota, usage []gardencorev1beta1.QuotaUsage) {
+	deleteMetrics(client.ObjectKeyFromObject(quota))
+
+	metrics := sets.New[corev1.ResourceName]()
+	for metric, quantity := range quota.Spec.Metrics {
+		metrics.Insert(metric)
+		quotaLimit.WithLabelValues(quota.Namespace, quota.Name, string(metric), "hard").Set(quantity.AsApproximateFloat64())
+	}
+	for metric, quantity := range quota.Spec.SoftMetrics {
+		metrics.Insert(metric)
+		quotaLimit.WithLabelValues(quota.Namespace, quota.Name, string(metric), "soft").Set(quantity.AsApproximateFloat64())
+	}
+
+	for _, u := range usage {
+		for metric := range metrics {
+			used := u.Used[metric]
+			quotaUsed.WithLabelValues(quota.Namespace, quota.Name, pointer.StringDeref(u.Namespace, ""), string(metric)).Set(used.AsApproximateFloat64())
+		}
+	}
+}
+
+func deleteMetrics(key types.NamespacedName) {
+	labels := prometheus.Labels{"namespace": key.Namespace, "name": key.Name}
+	quotaUsed.DeletePartialMatch(labels)
+	quotaLimit.DeletePartialMatch(labels)
 }
@@ -16,13 +16,19 @@ package quota
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -38,6 +44,7 @@ var _ = Describe("Reconciler", func() {
 	var (
 		ctx        = context.TODO()
 		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
 		reconciler reconcile.Reconciler
 
 		quotaName     string
@@ -46,14 +53,21 @@ var _ = Describe("Reconciler", func() {
 	)
 
 	BeforeEach(func() {
-		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Quota{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
 
 		quotaName = "test-quota"
-		reconciler = &Reconciler{Client: fakeClient, Recorder: &record.FakeRecorder{}}
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: &record.FakeRecorder{}}
 		quota = &gardencorev1beta1.Quota{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: quotaName,
 			},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Scope: corev1.ObjectReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+				},
+			},
 		}
 
 		secretBinding = &gardencorev1beta1.SecretBinding{
@@ -88,6 +102,196 @@ var _ = Describe("Reconciler", func() {
 		})
 	})
 
+	Context("usage", func() {
+		var (
+			cloudProfile *gardencorev1beta1.CloudProfile
+
+			newShoot func(namespace, name, secretBindingName string) *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			quota.Spec.Metrics = corev1.ResourceList{
+				"cpu":    resource.MustParse("100"),
+				"shoots": resource.MustParse("10"),
+			}
+			quota.Spec.SoftMetrics = corev1.ResourceList{
+				"nodes": resource.MustParse("50"),
+			}
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineTypes: []gardencorev1beta1.MachineType{{
+						Name:   "machine-type",
+						CPU:    resource.MustParse("4"),
+						GPU:    resource.MustParse("0"),
+						Memory: resource.MustParse("16Gi"),
+					}},
+					VolumeTypes: []gardencorev1beta1.VolumeType{{
+						Name:  "volume-type",
+						Class: "standard",
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			newShoot = func(namespace, name, secretBindingName string) *gardencorev1beta1.Shoot {
+				return &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: gardencorev1beta1.ShootSpec{
+						CloudProfileName:  cloudProfile.Name,
+						SecretBindingName: pointer.String(secretBindingName),
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{
+								Name:    "worker",
+								Machine: gardencorev1beta1.Machine{Type: "machine-type"},
+								Maximum: 2,
+								Volume:  &gardencorev1beta1.Volume{Type: pointer.String("volume-type"), VolumeSize: "20Gi"},
+							}},
+						},
+					},
+				}
+			}
+
+			for _, obj := range []client.Object{
+				&gardencorev1beta1.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-foo"},
+					Quotas:     []corev1.ObjectReference{{Name: quotaName}},
+				},
+				&gardencorev1beta1.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-bar"},
+					Quotas:     []corev1.ObjectReference{{Name: quotaName}},
+				},
+				&gardencorev1beta1.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-binding", Namespace: "garden-foo"},
+				},
+				newShoot("garden-foo", "shoot-b", "binding"),
+				newShoot("garden-foo", "shoot-a", "binding"),
+				newShoot("garden-foo", "shoot-c", "other-binding"),
+				newShoot("garden-bar", "shoot", "binding"),
+			} {
+				Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+			}
+		})
+
+		It("should report the usage of all consumers for quotas with 'secret' scope", func() {
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			Expect(quota.Status.ObservedGeneration).To(Equal(quota.Generation))
+			Expect(quota.Status.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now().Truncate(time.Second)))
+			Expect(quota.Status.Usage).To(HaveLen(1))
+			Expect(quota.Status.Usage[0].Namespace).To(BeNil())
+			Expect(quota.Status.Usage[0].Used).To(HaveLen(3))
+			Expect(quota.Status.Usage[0].Used.Cpu().String()).To(Equal("24"))
+			Expect(quota.Status.Usage[0].Used.Name("shoots", resource.DecimalSI).String()).To(Equal("3"))
+			Expect(quota.Status.Usage[0].Used.Name("nodes", resource.DecimalSI).String()).To(Equal("6"))
+			Expect(quota.Status.Usage[0].Consumers).To(Equal([]gardencorev1beta1.QuotaConsumer{
+				{Namespace: "garden-bar", Name: "shoot"},
+				{Namespace: "garden-foo", Name: "shoot-a"},
+				{Namespace: "garden-foo", Name: "shoot-b"},
+			}))
+
+			Expect(testutil.ToFloat64(quotaUsed.WithLabelValues("", quotaName, "", "cpu"))).To(Equal(float64(24)))
+			Expect(testutil.ToFloat64(quotaUsed.WithLabelValues("", quotaName, "", "nodes"))).To(Equal(float64(6)))
+			Expect(testutil.ToFloat64(quotaLimit.WithLabelValues("", quotaName, "cpu", "hard"))).To(Equal(float64(100)))
+			Expect(testutil.ToFloat64(quotaLimit.WithLabelValues("", quotaName, "nodes", "soft"))).To(Equal(float64(50)))
+		})
+
+		It("should report the usage per project for quotas with 'project' scope", func() {
+			quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			Expect(quota.Status.Usage).To(HaveLen(2))
+			Expect(quota.Status.Usage[0].Namespace).To(PointTo(Equal("garden-bar")))
+			Expect(quota.Status.Usage[0].Used.Cpu().String()).To(Equal("8"))
+			Expect(quota.Status.Usage[0].Consumers).To(ConsistOf(gardencorev1beta1.QuotaConsumer{Namespace: "garden-bar", Name: "shoot"}))
+			Expect(quota.Status.Usage[1].Namespace).To(PointTo(Equal("garden-foo")))
+			Expect(quota.Status.Usage[1].Used.Cpu().String()).To(Equal("16"))
+			Expect(quota.Status.Usage[1].Consumers).To(HaveLen(2))
+
+			Expect(testutil.ToFloat64(quotaUsed.WithLabelValues("", quotaName, "garden-foo", "cpu"))).To(Equal(float64(16)))
+		})
+
+		It("should not update the status if the usage did not change", func() {
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			lastUpdateTime := quota.Status.LastUpdateTime
+
+			fakeClock.Step(time.Hour)
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			Expect(quota.Status.LastUpdateTime).To(Equal(lastUpdateTime))
+		})
+
+		Context("soft limits", func() {
+			var recorder *record.FakeRecorder
+
+			BeforeEach(func() {
+				recorder = record.NewFakeRecorder(10)
+				reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: recorder}
+			})
+
+			It("should record an event if soft limits are exceeded", func() {
+				quota.Spec.SoftMetrics = corev1.ResourceList{
+					"nodes":  resource.MustParse("4"),
+					"shoots": resource.MustParse("2"),
+				}
+				Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(Equal("Warning QuotaSoftLimitExceeded Soft limits exceeded for nodes (used 6, limit 4), shoots (used 3, limit 2)")))
+
+				_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).NotTo(Receive())
+			})
+
+			It("should record an event per project namespace for quotas with 'project' scope", func() {
+				quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+				quota.Spec.SoftMetrics = corev1.ResourceList{"nodes": resource.MustParse("3")}
+				Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(Equal("Warning QuotaSoftLimitExceeded Soft limits exceeded for nodes (used 4, limit 3) in project namespace garden-foo")))
+				Expect(recorder.Events).NotTo(Receive())
+			})
+
+			It("should not record an event if no soft limit is exceeded", func() {
+				Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).NotTo(Receive())
+			})
+		})
+
+		It("should fail if the machine type of a consumer is unknown", func() {
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			shoot := newShoot("garden-foo", "shoot-d", "binding")
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).To(MatchError(ContainSubstring("machineType unknown not found")))
+		})
+	})
+
 	Context("when deletion timestamp set", func() {
 		BeforeEach(func() {
 			quota.Finalizers = []string{finalizerName}
@@ -121,5 +325,13 @@ var _ = Describe("Reconciler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(BeNotFoundError())
 		})
+
+		It("should delete the metrics of the Quota", func() {
+			quotaLimit.WithLabelValues("", quotaName, "cpu", "hard").Set(1)
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testutil.CollectAndCount(quotaLimit)).To(BeZero())
+		})
 	})
 })
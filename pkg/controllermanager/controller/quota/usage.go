@@ -0,0 +1,235 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// computeUsage computes the usage of the given quota by summing up the resources of all shoots which are bound to it
+// via a SecretBinding. The usage of quotas with 'project' scope is computed per project namespace.
+func (r *Reconciler) computeUsage(ctx context.Context, quota *gardencorev1beta1.Quota) ([]gardencorev1beta1.QuotaUsage, error) {
+	scope, err := v1beta1helper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList); err != nil {
+		return nil, fmt.Errorf("failed listing SecretBindings: %w", err)
+	}
+
+	secretBindingNamesPerNamespace := make(map[string]sets.Set[string])
+	for _, binding := range secretBindingList.Items {
+		for _, quotaRef := range binding.Quotas {
+			if quotaRef.Name == quota.Name && quotaRef.Namespace == quota.Namespace {
+				if _, ok := secretBindingNamesPerNamespace[binding.Namespace]; !ok {
+					secretBindingNamesPerNamespace[binding.Namespace] = sets.New[string]()
+				}
+				secretBindingNamesPerNamespace[binding.Namespace].Insert(binding.Name)
+			}
+		}
+	}
+
+	var (
+		usages        = make(map[string]*gardencorev1beta1.QuotaUsage)
+		cloudProfiles = make(map[string]*gardencorev1beta1.CloudProfile)
+	)
+
+	if scope == "secret" {
+		usages[""] = &gardencorev1beta1.QuotaUsage{}
+	}
+
+	for namespace, secretBindingNames := range secretBindingNamesPerNamespace {
+		key := ""
+		if scope == "project" {
+			key = namespace
+		}
+
+		usage, ok := usages[key]
+		if !ok {
+			usage = &gardencorev1beta1.QuotaUsage{Namespace: pointer.String(namespace)}
+			usages[key] = usage
+		}
+
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed listing Shoots in namespace %s: %w", namespace, err)
+		}
+
+		for _, shoot := range shootList.Items {
+			if !secretBindingNames.Has(pointer.StringDeref(shoot.Spec.SecretBindingName, "")) {
+				continue
+			}
+
+			cloudProfileKey := shoot.Spec.CloudProfileName
+			if shoot.Spec.CloudProfile != nil && shoot.Spec.CloudProfile.Kind == gardencorev1beta1.CloudProfileReferenceKindNamespacedCloudProfile {
+				cloudProfileKey = shoot.Namespace + "/" + shoot.Spec.CloudProfile.Name
+			}
+
+			cloudProfile, ok := cloudProfiles[cloudProfileKey]
+			if !ok {
+				cloudProfile, err = gardenerutils.GetCloudProfile(ctx, r.Client, &shoot)
+				if err != nil {
+					return nil, fmt.Errorf("failed reading cloud profile %s: %w", cloudProfileKey, err)
+				}
+				cloudProfiles[cloudProfileKey] = cloudProfile
+			}
+
+			resources, err := shootResources(&shoot, cloudProfile)
+			if err != nil {
+				return nil, fmt.Errorf("failed computing resources of Shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+			}
+
+			for metric, quantity := range resources {
+				if !constrainsMetric(quota, metric) {
+					continue
+				}
+				if usage.Used == nil {
+					usage.Used = make(corev1.ResourceList)
+				}
+				used := usage.Used[metric]
+				used.Add(quantity)
+				usage.Used[metric] = used
+			}
+			usage.Consumers = append(usage.Consumers, gardencorev1beta1.QuotaConsumer{Namespace: shoot.Namespace, Name: shoot.Name})
+		}
+	}
+
+	var result []gardencorev1beta1.QuotaUsage
+	for _, usage := range usages {
+		sort.Slice(usage.Consumers, func(i, j int) bool {
+			if usage.Consumers[i].Namespace != usage.Consumers[j].Namespace {
+				return usage.Consumers[i].Namespace < usage.Consumers[j].Namespace
+			}
+			return usage.Consumers[i].Name < usage.Consumers[j].Name
+		})
+		result = append(result, *usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return pointer.StringDeref(result[i].Namespace, "") < pointer.StringDeref(result[j].Namespace, "")
+	})
+
+	return result, nil
+}
+
+func constrainsMetric(quota *gardencorev1beta1.Quota, metric corev1.ResourceName) bool {
+	_, hard := quota.Spec.Metrics[metric]
+	_, soft := quota.Spec.SoftMetrics[metric]
+	return hard || soft
+}
+
+// shootResources computes the resources which are allocated by the given shoot. It follows the same rules as the
+// QuotaValidator admission plugin, i.e. the maximum number of nodes of the worker pools is used.
+func shootResources(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (corev1.ResourceList, error) {
+	var (
+		countLB   int64 = 1
+		resources       = make(corev1.ResourceList)
+	)
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		var machineType *gardencorev1beta1.MachineType
+		for _, m := range cloudProfile.Spec.MachineTypes {
+			if m.Name == worker.Machine.Type {
+				machineType = m.DeepCopy()
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
+		}
+
+		volume := worker.Volume
+		if volume == nil && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+			volume = &gardencorev1beta1.Volume{
+				Type:       &machineType.Storage.Type,
+				VolumeSize: machineType.Storage.StorageSize.String(),
+			}
+		}
+
+		var volumeClass string
+		if volume != nil {
+			if machineType.Storage != nil {
+				volumeClass = machineType.Storage.Class
+			} else {
+				for _, v := range cloudProfile.Spec.VolumeTypes {
+					if volume.Type != nil && v.Name == *volume.Type {
+						volumeClass = v.Class
+						break
+					}
+				}
+			}
+		}
+		if len(volumeClass) == 0 {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
+		}
+
+		addQuantity(resources, core.QuotaMetricCPU, machineType.CPU, worker.Maximum)
+		addQuantity(resources, core.QuotaMetricGPU, machineType.GPU, worker.Maximum)
+		addQuantity(resources, core.QuotaMetricMemory, machineType.Memory, worker.Maximum)
+		addQuantity(resources, core.QuotaMetricNodes, *resource.NewQuantity(1, resource.DecimalSI), worker.Maximum)
+		addQuantity(resources, corev1.ResourceName(core.QuotaMetricMachineTypePrefix+machineType.Name), *resource.NewQuantity(1, resource.DecimalSI), worker.Maximum)
+
+		size := resource.MustParse("0Gi")
+		if volume != nil {
+			var err error
+			if size, err = resource.ParseQuantity(volume.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeClass {
+		case gardencorev1beta1.VolumeClassStandard:
+			addQuantity(resources, core.QuotaMetricStorageStandard, size, worker.Maximum)
+		case gardencorev1beta1.VolumeClassPremium:
+			addQuantity(resources, core.QuotaMetricStoragePremium, size, worker.Maximum)
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeClass)
+		}
+	}
+
+	if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+	resources[core.QuotaMetricShoots] = *resource.NewQuantity(1, resource.DecimalSI)
+
+	if v1beta1helper.IsHAControlPlaneConfigured(shoot) {
+		resources[core.QuotaMetricHighlyAvailableControlPlanes] = *resource.NewQuantity(1, resource.DecimalSI)
+	}
+
+	return resources, nil
+}
+
+func addQuantity(resources corev1.ResourceList, metric corev1.ResourceName, quantity resource.Quantity, multiplier int32) {
+	value := resources[metric]
+	for i := int32(0); i < multiplier; i++ {
+		value.Add(quantity)
+	}
+	resources[metric] = value
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// credentialsRotation describes the state of a credentials rotation together with its policy.
+type credentialsRotation struct {
+	policy             *gardencorev1beta1.CredentialsRotationPolicy
+	phase              gardencorev1beta1.CredentialsRotationPhase
+	lastInitiationTime *metav1.Time
+	startOperation     string
+	completeOperation  string
+}
+
+// getCredentialsRotationOperation returns the operation which must be triggered to rotate credentials according to the
+// rotation policies of the shoot. At most one operation is triggered per maintenance time window. Completing a
+// two-phase rotation which has been prepared takes precedence over starting a new rotation.
+func getCredentialsRotationOperation(shoot *gardencorev1beta1.Shoot, now time.Time) string {
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.CredentialsRotation == nil {
+		return ""
+	}
+
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return ""
+	}
+
+	rotations := credentialsRotations(shoot)
+
+	for _, rotation := range rotations {
+		if rotation.policy != nil && rotation.completeOperation != "" && rotation.phase == gardencorev1beta1.RotationPrepared {
+			return rotation.completeOperation
+		}
+	}
+
+	if !isShootReadyForRotationStart(shoot.Status.LastOperation) {
+		return ""
+	}
+
+	for _, rotation := range rotations {
+		if rotation.policy == nil {
+			continue
+		}
+
+		if len(rotation.phase) > 0 && rotation.phase != gardencorev1beta1.RotationCompleted {
+			continue
+		}
+
+		lastInitiationTime := shoot.CreationTimestamp.Time
+		if rotation.lastInitiationTime != nil {
+			lastInitiationTime = rotation.lastInitiationTime.Time
+		}
+
+		if now.Sub(lastInitiationTime) >= rotation.policy.Interval.Duration {
+			return rotation.startOperation
+		}
+	}
+
+	return ""
+}
+
+// credentialsRotations returns the credentials rotations of the shoot in the order in which they are considered.
+func credentialsRotations(shoot *gardencorev1beta1.Shoot) []credentialsRotation {
+	var (
+		policies = shoot.Spec.Maintenance.CredentialsRotation
+		status   = &gardencorev1beta1.ShootCredentialsRotation{}
+	)
+
+	if shoot.Status.Credentials != nil && shoot.Status.Credentials.Rotation != nil {
+		status = shoot.Status.Credentials.Rotation
+	}
+
+	ca := credentialsRotation{
+		policy:            policies.CertificateAuthorities,
+		startOperation:    v1beta1constants.OperationRotateCAStart,
+		completeOperation: v1beta1constants.OperationRotateCAComplete,
+	}
+	if status.CertificateAuthorities != nil {
+		ca.phase = status.CertificateAuthorities.Phase
+		ca.lastInitiationTime = status.CertificateAuthorities.LastInitiationTime
+	}
+
+	serviceAccountKey := credentialsRotation{
+		policy:            policies.ServiceAccountKey,
+		startOperation:    v1beta1constants.OperationRotateServiceAccountKeyStart,
+		completeOperation: v1beta1constants.OperationRotateServiceAccountKeyComplete,
+	}
+	if status.ServiceAccountKey != nil {
+		serviceAccountKey.phase = status.ServiceAccountKey.Phase
+		serviceAccountKey.lastInitiationTime = status.ServiceAccountKey.LastInitiationTime
+	}
+
+	etcdEncryptionKey := credentialsRotation{
+		policy:            policies.ETCDEncryptionKey,
+		startOperation:    v1beta1constants.OperationRotateETCDEncryptionKeyStart,
+		completeOperation: v1beta1constants.OperationRotateETCDEncryptionKeyComplete,
+	}
+	if status.ETCDEncryptionKey != nil {
+		etcdEncryptionKey.phase = status.ETCDEncryptionKey.Phase
+		etcdEncryptionKey.lastInitiationTime = status.ETCDEncryptionKey.LastInitiationTime
+	}
+
+	sshKeypair := credentialsRotation{
+		policy:         policies.SSHKeypair,
+		startOperation: v1beta1constants.ShootOperationRotateSSHKeypair,
+	}
+	if !v1beta1helper.ShootEnablesSSHAccess(shoot) {
+		sshKeypair.policy = nil
+	}
+	if status.SSHKeypair != nil {
+		sshKeypair.lastInitiationTime = status.SSHKeypair.LastInitiationTime
+	}
+
+	observability := credentialsRotation{
+		policy:         policies.Observability,
+		startOperation: v1beta1constants.OperationRotateObservabilityCredentials,
+	}
+	if status.Observability != nil {
+		observability.lastInitiationTime = status.Observability.LastInitiationTime
+	}
+
+	return []credentialsRotation{ca, serviceAccountKey, etcdEncryptionKey, sshKeypair, observability}
+}
+
+// isShootReadyForRotationStart mirrors the API validation which only allows starting a rotation once the shoot was
+// created successfully.
+func isShootReadyForRotationStart(lastOperation *gardencorev1beta1.LastOperation) bool {
+	if lastOperation == nil {
+		return false
+	}
+	if lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate || lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore {
+		return lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+	}
+	return lastOperation.Type == gardencorev1beta1.LastOperationTypeReconcile
+}
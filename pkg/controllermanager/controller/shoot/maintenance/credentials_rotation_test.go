@@ -0,0 +1,157 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+var _ = Describe("Credentials rotation", func() {
+	var (
+		now   time.Time
+		day   = 24 * time.Hour
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.NewTime(now.Add(-200 * day)),
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Maintenance: &gardencorev1beta1.Maintenance{
+					CredentialsRotation: &gardencorev1beta1.MaintenanceCredentialsRotation{},
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+	})
+
+	Describe("#getCredentialsRotationOperation", func() {
+		It("should not trigger any operation if no policies are configured", func() {
+			shoot.Spec.Maintenance.CredentialsRotation = nil
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should start the rotation if the interval has passed since the creation of the shoot", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.CertificateAuthorities = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(Equal(v1beta1constants.OperationRotateCAStart))
+		})
+
+		It("should not start the rotation if the interval has not passed since the last initiation", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.CertificateAuthorities = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				CertificateAuthorities: &gardencorev1beta1.CARotation{
+					Phase:              gardencorev1beta1.RotationCompleted,
+					LastInitiationTime: &metav1.Time{Time: now.Add(-30 * day)},
+				},
+			}}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should complete a prepared rotation before starting other rotations", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.CertificateAuthorities = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+			shoot.Spec.Maintenance.CredentialsRotation.ServiceAccountKey = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				ServiceAccountKey: &gardencorev1beta1.ServiceAccountKeyRotation{
+					Phase:              gardencorev1beta1.RotationPrepared,
+					LastInitiationTime: &metav1.Time{Time: now.Add(-1 * day)},
+				},
+			}}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(Equal(v1beta1constants.OperationRotateServiceAccountKeyComplete))
+		})
+
+		It("should not start a rotation which is still in progress", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.ETCDEncryptionKey = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationPreparing},
+			}}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should trigger single-phase rotations", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.Observability = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 30 * day}}
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				Observability: &gardencorev1beta1.ObservabilityRotation{LastInitiationTime: &metav1.Time{Time: now.Add(-31 * day)}},
+			}}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(Equal(v1beta1constants.OperationRotateObservabilityCredentials))
+		})
+
+		It("should not rotate the ssh-keypair if ssh access is disabled", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.SSHKeypair = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 30 * day}}
+			shoot.Spec.Provider.WorkersSettings = &gardencorev1beta1.WorkersSettings{SSHAccess: &gardencorev1beta1.SSHAccess{Enabled: false}}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should not trigger any operation if the shoot is hibernated", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.CertificateAuthorities = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(BeEmpty())
+		})
+
+		It("should not start a rotation if the shoot was not created successfully", func() {
+			shoot.Spec.Maintenance.CredentialsRotation.CertificateAuthorities = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeCreate,
+				State: gardencorev1beta1.LastOperationStateError,
+			}
+
+			Expect(getCredentialsRotationOperation(shoot, now)).To(BeEmpty())
+		})
+	})
+
+	Describe("#maintainOperation", func() {
+		BeforeEach(func() {
+			shoot.Spec.Maintenance.CredentialsRotation.CertificateAuthorities = &gardencorev1beta1.CredentialsRotationPolicy{Interval: metav1.Duration{Duration: 180 * day}}
+		})
+
+		It("should set the rotation operation annotation", func() {
+			Expect(maintainOperation(shoot, now)).To(Equal(v1beta1constants.OperationRotateCAStart))
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCAStart))
+		})
+
+		It("should prefer an operation requested by the user", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerMaintenanceOperation, v1beta1constants.ShootOperationRotateKubeconfigCredentials)
+
+			Expect(maintainOperation(shoot, now)).To(Equal(v1beta1constants.ShootOperationRotateKubeconfigCredentials))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerMaintenanceOperation))
+		})
+	})
+})
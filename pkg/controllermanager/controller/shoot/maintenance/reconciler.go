@@ -40,7 +40,6 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
-	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -73,7 +72,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	requeueAfter, nextMaintenance := requeueAfterDuration(shoot)
+	requeueAfter, nextMaintenance := requeueAfterDuration(shoot, r.Clock)
 
 	if !mustMaintainNow(shoot, r.Clock) {
 		log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
@@ -89,12 +88,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
-func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
+func requeueAfterDuration(shoot *gardencorev1beta1.Shoot, clock clock.Clock) (time.Duration, time.Time) {
 	var (
-		now             = time.Now()
-		window          = gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot)
-		duration        = window.RandomDurationUntilNext(now, false)
-		nextMaintenance = time.Now().UTC().Add(duration)
+		now             = clock.Now()
+		schedule        = gardenerutils.EffectiveShootMaintenanceSchedule(shoot)
+		duration        = schedule.RandomDurationUntilNext(now, false)
+		nextMaintenance = now.UTC().Add(duration)
 	)
 
 	return duration, nextMaintenance
@@ -113,6 +112,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	log.Info("Maintaining Shoot")
 
 	var (
+		now             = r.Clock.Now()
 		maintainedShoot = shoot.DeepCopy()
 		// for maintenance operations unrelated to machine images and Kubernetes versions
 		operations []string
@@ -122,8 +122,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	workerToKubernetesUpdate := make(map[string]updateResult)
 	workerToMachineImageUpdate := make(map[string]updateResult)
 
-	cloudProfile := &gardencorev1beta1.CloudProfile{}
-	if err = r.Client.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
 		return err
 	}
 
@@ -219,7 +219,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
-	operation := maintainOperation(maintainedShoot)
+	operation := maintainOperation(maintainedShoot, now)
 	if operation != "" {
 		operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
 	}
@@ -284,7 +284,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 
 	// update shoot spec changes in maintenance call
 	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
-	_ = maintainOperation(shoot)
+	_ = maintainOperation(shoot, now)
 	maintainTasks(shoot, r.Config)
 
 	// try to maintain shoot, but don't retry on conflict, because a conflict means that we potentially operated on stale
@@ -386,7 +386,7 @@ func (r *Reconciler) recordMaintenanceEventsForPool(workerToUpdateResult map[str
 	}
 }
 
-func maintainOperation(shoot *gardencorev1beta1.Shoot) string {
+func maintainOperation(shoot *gardencorev1beta1.Shoot, now time.Time) string {
 	var operation string
 	if hasMaintainNowAnnotation(shoot) {
 		delete(shoot.Annotations, v1beta1constants.GardenerOperation)
@@ -404,6 +404,11 @@ func maintainOperation(shoot *gardencorev1beta1.Shoot) string {
 		}
 	default:
 		operation = getOperation(shoot)
+		if operation == v1beta1constants.GardenerOperationReconcile {
+			if rotationOperation := getCredentialsRotationOperation(shoot, now); rotationOperation != "" {
+				operation = rotationOperation
+			}
+		}
 		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 		delete(shoot.Annotations, v1beta1constants.GardenerMaintenanceOperation)
 	}
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -1322,6 +1323,94 @@ var _ = Describe("Shoot Maintenance", func() {
 			Expect(shoot.Spec.Provider.Workers[1].Maximum).To(Equal(int32(2)))
 		})
 	})
+
+	Describe("maintenance time window", func() {
+		var (
+			shoot     *gardencorev1beta1.Shoot
+			fakeClock *testclock.FakeClock
+
+			// 2023-10-16 is a Monday.
+			monday = time.Date(2023, 10, 16, 0, 0, 0, 0, time.UTC)
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(monday)
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: "220000+0000",
+							End:   "230000+0000",
+						},
+						TimeWindowWeekdays: []string{"Mon"},
+					},
+				},
+			}
+		})
+
+		Describe("#mustMaintainNow", func() {
+			It("should maintain the shoot in the time window on a configured weekday", func() {
+				fakeClock.SetTime(monday.Add(22*time.Hour + 10*time.Minute))
+				Expect(mustMaintainNow(shoot, fakeClock)).To(BeTrue())
+			})
+
+			It("should not maintain the shoot in the time window on other weekdays", func() {
+				fakeClock.SetTime(monday.AddDate(0, 0, 1).Add(22*time.Hour + 10*time.Minute))
+				Expect(mustMaintainNow(shoot, fakeClock)).To(BeFalse())
+			})
+
+			It("should maintain the shoot in an additional range", func() {
+				shoot.Spec.Maintenance.AdditionalTimeRanges = []gardencorev1beta1.MaintenanceTimeRange{{Begin: "030000+0000", End: "040000+0000"}}
+
+				fakeClock.SetTime(monday.AddDate(0, 0, 3).Add(3*time.Hour + 10*time.Minute))
+				Expect(mustMaintainNow(shoot, fakeClock)).To(BeTrue())
+			})
+
+			It("should not maintain the shoot during a blackout", func() {
+				shoot.Spec.Maintenance.Blackouts = []gardencorev1beta1.MaintenanceBlackout{{
+					Begin: metav1.NewTime(monday),
+					End:   metav1.NewTime(monday.AddDate(0, 0, 1)),
+				}}
+
+				fakeClock.SetTime(monday.Add(22*time.Hour + 10*time.Minute))
+				Expect(mustMaintainNow(shoot, fakeClock)).To(BeFalse())
+			})
+
+			It("should maintain the shoot during a blackout if it is annotated with maintain", func() {
+				shoot.Annotations = map[string]string{"gardener.cloud/operation": "maintain"}
+				shoot.Spec.Maintenance.Blackouts = []gardencorev1beta1.MaintenanceBlackout{{
+					Begin: metav1.NewTime(monday),
+					End:   metav1.NewTime(monday.AddDate(0, 0, 1)),
+				}}
+
+				fakeClock.SetTime(monday.Add(22*time.Hour + 10*time.Minute))
+				Expect(mustMaintainNow(shoot, fakeClock)).To(BeTrue())
+			})
+		})
+
+		Describe("#requeueAfterDuration", func() {
+			It("should schedule the next maintenance on the next configured weekday", func() {
+				fakeClock.SetTime(monday.AddDate(0, 0, 1).Add(12 * time.Hour))
+
+				requeueAfter, nextMaintenance := requeueAfterDuration(shoot, fakeClock)
+				Expect(nextMaintenance).To(Equal(fakeClock.Now().Add(requeueAfter)))
+				Expect(nextMaintenance).To(BeTemporally(">=", monday.AddDate(0, 0, 7).Add(22*time.Hour)))
+				Expect(nextMaintenance).To(BeTemporally("<=", monday.AddDate(0, 0, 7).Add(22*time.Hour+45*time.Minute)))
+			})
+
+			It("should schedule the next maintenance after a blackout", func() {
+				shoot.Spec.Maintenance.Blackouts = []gardencorev1beta1.MaintenanceBlackout{{
+					Begin: metav1.NewTime(monday.AddDate(0, 0, 7)),
+					End:   metav1.NewTime(monday.AddDate(0, 0, 8)),
+				}}
+				fakeClock.SetTime(monday.AddDate(0, 0, 1).Add(12 * time.Hour))
+
+				_, nextMaintenance := requeueAfterDuration(shoot, fakeClock)
+				Expect(nextMaintenance).To(BeTemporally(">=", monday.AddDate(0, 0, 14).Add(22*time.Hour)))
+				Expect(nextMaintenance).To(BeTemporally("<=", monday.AddDate(0, 0, 14).Add(22*time.Hour+45*time.Minute)))
+			})
+		})
+	})
 })
 
 func assertWorkerMachineImageVersion(worker *gardencorev1beta1.Worker, imageName string, imageVersion string) {
@@ -53,7 +53,10 @@ func Predicate(oldObj, newObj client.Object) bool {
 	}
 
 	return shootDNSFieldChanged(oldShoot, newShoot) ||
-		shootKubeAPIServerAuditConfigFieldChanged(oldShoot, newShoot) || !v1beta1helper.ShootResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources)
+		shootKubeAPIServerAuditConfigFieldChanged(oldShoot, newShoot) ||
+		shootKubeAPIServerStructuredAuthenticationFieldChanged(oldShoot, newShoot) ||
+		shootKubeAPIServerAuthorizationWebhookFieldChanged(oldShoot, newShoot) ||
+		shootKubeAPIServerStructuredAuthorizationFieldChanged(oldShoot, newShoot) || !v1beta1helper.ShootResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources)
 }
 
 func shootDNSFieldChanged(oldShoot, newShoot *gardencorev1beta1.Shoot) bool {
@@ -64,6 +67,18 @@ func shootKubeAPIServerAuditConfigFieldChanged(oldShoot, newShoot *gardencorev1b
 	return !apiequality.Semantic.Equalities.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig, newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig)
 }
 
+func shootKubeAPIServerStructuredAuthenticationFieldChanged(oldShoot, newShoot *gardencorev1beta1.Shoot) bool {
+	return v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer)
+}
+
+func shootKubeAPIServerAuthorizationWebhookFieldChanged(oldShoot, newShoot *gardencorev1beta1.Shoot) bool {
+	return v1beta1helper.GetShootAuthorizationWebhookKubeconfigSecretName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthorizationWebhookKubeconfigSecretName(newShoot.Spec.Kubernetes.KubeAPIServer)
+}
+
+func shootKubeAPIServerStructuredAuthorizationFieldChanged(oldShoot, newShoot *gardencorev1beta1.Shoot) bool {
+	return !apiequality.Semantic.Equalities.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization, newShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization)
+}
+
 func getReferencedSecretNames(obj client.Object) []string {
 	shoot, ok := obj.(*gardencorev1beta1.Shoot)
 	if !ok {
@@ -72,6 +87,10 @@ func getReferencedSecretNames(obj client.Object) []string {
 
 	var out []string
 	out = append(out, secretNamesForDNSProviders(shoot)...)
+	if secretName := v1beta1helper.GetShootAuthorizationWebhookKubeconfigSecretName(shoot.Spec.Kubernetes.KubeAPIServer); secretName != "" {
+		out = append(out, secretName)
+	}
+	out = append(out, v1beta1helper.GetShootAuthorizationConfigurationKubeconfigSecretNames(shoot.Spec.Kubernetes.KubeAPIServer)...)
 	out = append(out, namesForReferencedResources(shoot, "Secret")...)
 	return out
 }
@@ -86,6 +105,12 @@ func getReferencedConfigMapNames(obj client.Object) []string {
 	if configMapRef := getAuditPolicyConfigMapRef(shoot.Spec.Kubernetes.KubeAPIServer); configMapRef != nil {
 		out = append(out, configMapRef.Name)
 	}
+	if configMapName := v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" {
+		out = append(out, configMapName)
+	}
+	if configMapName := v1beta1helper.GetShootAuthorizationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" {
+		out = append(out, configMapName)
+	}
 	out = append(out, namesForReferencedResources(shoot, "ConfigMap")...)
 	return out
 }
@@ -73,6 +73,31 @@ var _ = Describe("Add", func() {
 			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
 		})
 
+		It("should return true because the structured authentication field changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication = &gardencorev1beta1.StructuredAuthentication{
+				ConfigMapName: "authentication-config",
+			}
+			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
+		})
+
+		It("should return true because the authorization webhook field changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.KubeAPIServer.Authorization = &gardencorev1beta1.Authorization{
+				Webhook: &gardencorev1beta1.AuthorizationWebhook{KubeconfigSecretName: "authorization-webhook"},
+			}
+			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
+		})
+
+		It("should return true because the structured authorization field changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization = &gardencorev1beta1.StructuredAuthorization{
+				ConfigMapName: "authorization-config",
+				Kubeconfigs:   []gardencorev1beta1.AuthorizerKubeconfigReference{{AuthorizerName: "foo", SecretName: "foo-kubeconfig"}},
+			}
+			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
+		})
+
 		It("should return true because the resources field changed", func() {
 			oldShoot := shoot.DeepCopy()
 			shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
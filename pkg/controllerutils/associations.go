@@ -28,7 +28,7 @@ import (
 )
 
 // DetermineShootsAssociatedTo gets a <shootLister> to determine the Shoots resources which are associated
-// to given <obj> (either a CloudProfile, Seed, Secretbinding a or a ExposureClass object).
+// to given <obj> (either a CloudProfile, NamespacedCloudProfile, Seed, Secretbinding a or a ExposureClass object).
 func DetermineShootsAssociatedTo(ctx context.Context, gardenClient client.Reader, obj interface{}) ([]string, error) {
 	shootList := &gardencorev1beta1.ShootList{}
 	if err := gardenClient.List(ctx, shootList); err != nil {
@@ -44,6 +44,12 @@ func DetermineShootsAssociatedTo(ctx context.Context, gardenClient client.Reader
 			if shoot.Spec.CloudProfileName == cloudProfile.Name {
 				associatedShoots = append(associatedShoots, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
 			}
+		case *gardencorev1beta1.NamespacedCloudProfile:
+			namespacedCloudProfile := obj.(*gardencorev1beta1.NamespacedCloudProfile)
+			if shoot.Spec.CloudProfile != nil && shoot.Spec.CloudProfile.Kind == gardencorev1beta1.CloudProfileReferenceKindNamespacedCloudProfile &&
+				shoot.Spec.CloudProfile.Name == namespacedCloudProfile.Name && shoot.Namespace == namespacedCloudProfile.Namespace {
+				associatedShoots = append(associatedShoots, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+			}
 		case *gardencorev1beta1.Seed:
 			seed := obj.(*gardencorev1beta1.Seed)
 			if shoot.Spec.SeedName != nil && *shoot.Spec.SeedName == seed.Name {
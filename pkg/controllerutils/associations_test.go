@@ -90,6 +90,10 @@ var _ = Describe("Associations", func() {
 			&gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}}, func(s *gardencorev1beta1.Shoot, obj client.Object) {
 				s.Spec.CloudProfileName = obj.GetName()
 			}, BeNil()),
+		Entry("should return shoots associated to namespacedcloudprofile",
+			&gardencorev1beta1.NamespacedCloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "namespacedcloudprofile", Namespace: namespace}}, func(s *gardencorev1beta1.Shoot, obj client.Object) {
+				s.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: obj.GetName()}
+			}, BeNil()),
 		Entry("should return shoots associated to seed",
 			&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}, func(s *gardencorev1beta1.Shoot, obj client.Object) {
 				s.Spec.SeedName = pointer.String(obj.GetName())
@@ -53,6 +53,11 @@ const (
 	// alpha: v1.63.0
 	IPv6SingleStack featuregate.Feature = "IPv6SingleStack"
 
+	// DualStackNetworking allows creating shoot clusters with IPv4 and IPv6 dual-stack networking and adding a second IP
+	// family to existing shoot clusters.
+	// alpha: v1.88.0
+	DualStackNetworking featuregate.Feature = "DualStackNetworking"
+
 	// MutableShootSpecNetworkingNodes allows updating the field `spec.networking.nodes`.
 	// owner: @axel7born @ScheererJ @DockToFuture @kon-angelo
 	// alpha: v1.64.0
@@ -125,6 +130,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	DefaultSeccompProfile:              {Default: false, PreRelease: featuregate.Alpha},
 	CoreDNSQueryRewriting:              {Default: false, PreRelease: featuregate.Alpha},
 	IPv6SingleStack:                    {Default: false, PreRelease: featuregate.Alpha},
+	DualStackNetworking:                {Default: false, PreRelease: featuregate.Alpha},
 	MutableShootSpecNetworkingNodes:    {Default: false, PreRelease: featuregate.Alpha},
 	WorkerlessShoots:                   {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
 	ShootForceDeletion:                 {Default: false, PreRelease: featuregate.Alpha},
@@ -20,11 +20,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // SeedNameFromSeedConfig returns an empty string if the given seed config is nil, or the
@@ -125,3 +127,19 @@ func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *m
 	}
 	return nil
 }
+
+// SecretsManagerDataStore returns the external data store for the secrets manager if configured, otherwise it returns nil.
+func SecretsManagerDataStore(c *config.GardenletConfiguration) (secretsmanager.DataStore, error) {
+	if c == nil || c.SecretsManager == nil || c.SecretsManager.ExternalDataStore == nil || c.SecretsManager.ExternalDataStore.Vault == nil {
+		return nil, nil
+	}
+
+	vault := c.SecretsManager.ExternalDataStore.Vault
+	return secretsmanager.NewVaultDataStore(secretsmanager.VaultDataStoreOptions{
+		Server:     vault.Server,
+		MountPath:  vault.MountPath,
+		PathPrefix: pointer.StringDeref(vault.PathPrefix, ""),
+		TokenFile:  vault.TokenFile,
+		CAFile:     pointer.StringDeref(vault.CAFile, ""),
+	})
+}
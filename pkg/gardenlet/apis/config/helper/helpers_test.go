@@ -318,4 +318,34 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#SecretsManagerDataStore", func() {
+		It("should return nil when no external data store is configured", func() {
+			Expect(SecretsManagerDataStore(nil)).To(BeNil())
+			Expect(SecretsManagerDataStore(&config.GardenletConfiguration{})).To(BeNil())
+			Expect(SecretsManagerDataStore(&config.GardenletConfiguration{SecretsManager: &config.SecretsManagerConfig{}})).To(BeNil())
+		})
+
+		It("should return a data store when an external data store is configured", func() {
+			Expect(SecretsManagerDataStore(&config.GardenletConfiguration{
+				SecretsManager: &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{
+					Server:    "https://vault.example.com:8200",
+					MountPath: "secret",
+					TokenFile: "/var/run/secrets/vault/token",
+				}}},
+			})).NotTo(BeNil())
+		})
+
+		It("should fail when the CA bundle of the Vault server cannot be read", func() {
+			_, err := SecretsManagerDataStore(&config.GardenletConfiguration{
+				SecretsManager: &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{
+					Server:    "https://vault.example.com:8200",
+					MountPath: "secret",
+					TokenFile: "/var/run/secrets/vault/token",
+					CAFile:    pointer.String("/does/not/exist"),
+				}}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed reading Vault CA bundle")))
+		})
+	})
 })
@@ -73,6 +73,8 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// SecretsManager contains optional settings for the secrets manager.
+	SecretsManager *SecretsManagerConfig
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -295,6 +297,26 @@ type ShootStateControllerConfiguration struct {
 	// SyncPeriod is the duration how often the existing resources are reconciled (how
 	// often the health check of Seed clusters is performed
 	SyncPeriod *metav1.Duration
+	// Backup configures pushing encrypted snapshots of the ShootStates into the backup buckets of the shoots. If not set,
+	// ShootStates are only persisted in the garden cluster.
+	Backup *ShootStateBackupConfiguration
+}
+
+// ShootStateBackupConfiguration defines the configuration for pushing ShootState snapshots into backup buckets.
+type ShootStateBackupConfiguration struct {
+	// EncryptionKeySecretRef references a secret in the seed cluster containing the AES key (16, 24, or 32 bytes) used
+	// for encrypting the snapshots in its data key `key`.
+	EncryptionKeySecretRef corev1.SecretReference
+	// Retention configures how many snapshots are kept per shoot.
+	Retention *ShootStateBackupRetention
+}
+
+// ShootStateBackupRetention defines the retention of ShootState snapshots.
+type ShootStateBackupRetention struct {
+	// Last is the number of most recent snapshots which are kept.
+	Last *int
+	// Daily is the number of most recent days for which the last snapshot of the day is kept.
+	Daily *int
 }
 
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
@@ -561,3 +583,31 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// SecretsManagerConfig contains settings for the secrets manager.
+type SecretsManagerConfig struct {
+	// ExternalDataStore configures a storage backend for sensitive data of secrets (e.g., private keys of certificate
+	// authorities) which should not be stored in the Kubernetes Secrets.
+	ExternalDataStore *ExternalDataStore
+}
+
+// ExternalDataStore contains the configuration of the external data store of the secrets manager.
+type ExternalDataStore struct {
+	// Vault configures a HashiCorp Vault KV (version 2) secrets engine as the external data store.
+	Vault *VaultDataStore
+}
+
+// VaultDataStore contains the configuration of a HashiCorp Vault KV (version 2) secrets engine.
+type VaultDataStore struct {
+	// Server is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Server string
+	// MountPath is the path at which the KV (version 2) secrets engine is mounted.
+	MountPath string
+	// PathPrefix is prepended to the paths of all secrets in the secrets engine.
+	PathPrefix *string
+	// TokenFile is the path of the file containing the Vault token. It is read for every request, hence, the token
+	// can be renewed externally (e.g., by a Vault agent).
+	TokenFile string
+	// CAFile is the path of the file containing the CA bundle used to verify the certificate of the Vault server.
+	CAFile *string
+}
@@ -375,6 +375,19 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	if obj.SyncPeriod == nil {
 		obj.SyncPeriod = &metav1.Duration{Duration: 6 * time.Hour}
 	}
+	if obj.Backup != nil && obj.Backup.Retention == nil {
+		obj.Backup.Retention = &ShootStateBackupRetention{}
+	}
+}
+
+// SetDefaults_ShootStateBackupRetention sets defaults for the retention of ShootState snapshots.
+func SetDefaults_ShootStateBackupRetention(obj *ShootStateBackupRetention) {
+	if obj.Last == nil {
+		obj.Last = pointer.Int(10)
+	}
+	if obj.Daily == nil {
+		obj.Daily = pointer.Int(7)
+	}
 }
 
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
@@ -451,6 +451,34 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootState.ConcurrentSyncs).To(PointTo(Equal(10)))
 			Expect(obj.Controllers.ShootState.SyncPeriod).To(PointTo(Equal(syncPeriod)))
 		})
+
+		It("should default the retention of the backup configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootState: &ShootStateControllerConfiguration{
+					Backup: &ShootStateBackupConfiguration{},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootState.Backup.Retention.Last).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootState.Backup.Retention.Daily).To(PointTo(Equal(7)))
+		})
+
+		It("should not overwrite already set values for the retention of the backup configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootState: &ShootStateControllerConfiguration{
+					Backup: &ShootStateBackupConfiguration{
+						Retention: &ShootStateBackupRetention{Last: pointer.Int(3), Daily: pointer.Int(0)},
+					},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootState.Backup.Retention.Last).To(PointTo(Equal(3)))
+			Expect(obj.Controllers.ShootState.Backup.Retention.Daily).To(PointTo(Equal(0)))
+		})
 	})
 
 	Describe("NetworkPolicyControllerConfiguration defaulting", func() {
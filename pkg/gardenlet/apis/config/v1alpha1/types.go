@@ -90,6 +90,9 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// SecretsManager contains optional settings for the secrets manager.
+	// +optional
+	SecretsManager *SecretsManagerConfig `json:"secretsManager,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -363,6 +366,30 @@ type ShootStateControllerConfiguration struct {
 	// often the health check of Seed clusters is performed
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Backup configures pushing encrypted snapshots of the ShootStates into the backup buckets of the shoots. If not set,
+	// ShootStates are only persisted in the garden cluster.
+	// +optional
+	Backup *ShootStateBackupConfiguration `json:"backup,omitempty"`
+}
+
+// ShootStateBackupConfiguration defines the configuration for pushing ShootState snapshots into backup buckets.
+type ShootStateBackupConfiguration struct {
+	// EncryptionKeySecretRef references a secret in the seed cluster containing the AES key (16, 24, or 32 bytes) used
+	// for encrypting the snapshots in its data key `key`.
+	EncryptionKeySecretRef corev1.SecretReference `json:"encryptionKeySecretRef"`
+	// Retention configures how many snapshots are kept per shoot.
+	// +optional
+	Retention *ShootStateBackupRetention `json:"retention,omitempty"`
+}
+
+// ShootStateBackupRetention defines the retention of ShootState snapshots.
+type ShootStateBackupRetention struct {
+	// Last is the number of most recent snapshots which are kept. Defaults to 10.
+	// +optional
+	Last *int `json:"last,omitempty"`
+	// Daily is the number of most recent days for which the last snapshot of the day is kept. Defaults to 7.
+	// +optional
+	Daily *int `json:"daily,omitempty"`
 }
 
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
@@ -724,3 +751,35 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// SecretsManagerConfig contains settings for the secrets manager.
+type SecretsManagerConfig struct {
+	// ExternalDataStore configures a storage backend for sensitive data of secrets (e.g., private keys of certificate
+	// authorities) which should not be stored in the Kubernetes Secrets.
+	// +optional
+	ExternalDataStore *ExternalDataStore `json:"externalDataStore,omitempty"`
+}
+
+// ExternalDataStore contains the configuration of the external data store of the secrets manager.
+type ExternalDataStore struct {
+	// Vault configures a HashiCorp Vault KV (version 2) secrets engine as the external data store.
+	// +optional
+	Vault *VaultDataStore `json:"vault,omitempty"`
+}
+
+// VaultDataStore contains the configuration of a HashiCorp Vault KV (version 2) secrets engine.
+type VaultDataStore struct {
+	// Server is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Server string `json:"server"`
+	// MountPath is the path at which the KV (version 2) secrets engine is mounted.
+	MountPath string `json:"mountPath"`
+	// PathPrefix is prepended to the paths of all secrets in the secrets engine.
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+	// TokenFile is the path of the file containing the Vault token. It is read for every request, hence, the token
+	// can be renewed externally (e.g., by a Vault agent).
+	TokenFile string `json:"tokenFile"`
+	// CAFile is the path of the file containing the CA bundle used to verify the certificate of the Vault server.
+	// +optional
+	CAFile *string `json:"caFile,omitempty"`
+}
@@ -173,6 +173,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExternalDataStore)(nil), (*config.ExternalDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(a.(*ExternalDataStore), b.(*config.ExternalDataStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExternalDataStore)(nil), (*ExternalDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(a.(*config.ExternalDataStore), b.(*ExternalDataStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenClientConnection)(nil), (*config.GardenClientConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenClientConnection_To_config_GardenClientConnection(a.(*GardenClientConnection), b.(*config.GardenClientConnection), scope)
 	}); err != nil {
@@ -323,6 +333,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretsManagerConfig)(nil), (*config.SecretsManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(a.(*SecretsManagerConfig), b.(*config.SecretsManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SecretsManagerConfig)(nil), (*SecretsManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(a.(*config.SecretsManagerConfig), b.(*SecretsManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedCareControllerConfiguration)(nil), (*config.SeedCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(a.(*SeedCareControllerConfiguration), b.(*config.SeedCareControllerConfiguration), scope)
 	}); err != nil {
@@ -443,6 +463,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootStateBackupConfiguration)(nil), (*config.ShootStateBackupConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootStateBackupConfiguration_To_config_ShootStateBackupConfiguration(a.(*ShootStateBackupConfiguration), b.(*config.ShootStateBackupConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootStateBackupConfiguration)(nil), (*ShootStateBackupConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootStateBackupConfiguration_To_v1alpha1_ShootStateBackupConfiguration(a.(*config.ShootStateBackupConfiguration), b.(*ShootStateBackupConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootStateBackupRetention)(nil), (*config.ShootStateBackupRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootStateBackupRetention_To_config_ShootStateBackupRetention(a.(*ShootStateBackupRetention), b.(*config.ShootStateBackupRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootStateBackupRetention)(nil), (*ShootStateBackupRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootStateBackupRetention_To_v1alpha1_ShootStateBackupRetention(a.(*config.ShootStateBackupRetention), b.(*ShootStateBackupRetention), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootStateControllerConfiguration)(nil), (*config.ShootStateControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(a.(*ShootStateControllerConfiguration), b.(*config.ShootStateControllerConfiguration), scope)
 	}); err != nil {
@@ -483,6 +523,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VaultDataStore)(nil), (*config.VaultDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VaultDataStore_To_config_VaultDataStore(a.(*VaultDataStore), b.(*config.VaultDataStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.VaultDataStore)(nil), (*VaultDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_VaultDataStore_To_v1alpha1_VaultDataStore(a.(*config.VaultDataStore), b.(*VaultDataStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*core.SeedTemplate)(nil), (*v1beta1.SeedTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_core_SeedTemplate_To_v1beta1_SeedTemplate(a.(*core.SeedTemplate), b.(*v1beta1.SeedTemplate), scope)
 	}); err != nil {
@@ -792,6 +842,26 @@ func Convert_config_ExposureClassHandler_To_v1alpha1_ExposureClassHandler(in *co
 	return autoConvert_config_ExposureClassHandler_To_v1alpha1_ExposureClassHandler(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(in *ExternalDataStore, out *config.ExternalDataStore, s conversion.Scope) error {
+	out.Vault = (*config.VaultDataStore)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore is an autogenerated conversion function.
+func Convert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(in *ExternalDataStore, out *config.ExternalDataStore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(in, out, s)
+}
+
+func autoConvert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(in *config.ExternalDataStore, out *ExternalDataStore, s conversion.Scope) error {
+	out.Vault = (*VaultDataStore)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore is an autogenerated conversion function.
+func Convert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(in *config.ExternalDataStore, out *ExternalDataStore, s conversion.Scope) error {
+	return autoConvert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(in, out, s)
+}
+
 func autoConvert_v1alpha1_GardenClientConnection_To_config_GardenClientConnection(in *GardenClientConnection, out *config.GardenClientConnection, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
@@ -915,6 +985,7 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.SecretsManager = (*config.SecretsManagerConfig)(unsafe.Pointer(in.SecretsManager))
 	return nil
 }
 
@@ -992,6 +1063,7 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.SecretsManager = (*SecretsManagerConfig)(unsafe.Pointer(in.SecretsManager))
 	return nil
 }
 
@@ -1300,6 +1372,26 @@ func Convert_config_SNIIngress_To_v1alpha1_SNIIngress(in *config.SNIIngress, out
 	return autoConvert_config_SNIIngress_To_v1alpha1_SNIIngress(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(in *SecretsManagerConfig, out *config.SecretsManagerConfig, s conversion.Scope) error {
+	out.ExternalDataStore = (*config.ExternalDataStore)(unsafe.Pointer(in.ExternalDataStore))
+	return nil
+}
+
+// Convert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(in *SecretsManagerConfig, out *config.SecretsManagerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(in, out, s)
+}
+
+func autoConvert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(in *config.SecretsManagerConfig, out *SecretsManagerConfig, s conversion.Scope) error {
+	out.ExternalDataStore = (*ExternalDataStore)(unsafe.Pointer(in.ExternalDataStore))
+	return nil
+}
+
+// Convert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig is an autogenerated conversion function.
+func Convert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(in *config.SecretsManagerConfig, out *SecretsManagerConfig, s conversion.Scope) error {
+	return autoConvert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(in *SeedCareControllerConfiguration, out *config.SeedCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
@@ -1588,9 +1680,54 @@ func Convert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in *config.Sho
 	return autoConvert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootStateBackupConfiguration_To_config_ShootStateBackupConfiguration(in *ShootStateBackupConfiguration, out *config.ShootStateBackupConfiguration, s conversion.Scope) error {
+	out.EncryptionKeySecretRef = in.EncryptionKeySecretRef
+	out.Retention = (*config.ShootStateBackupRetention)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_v1alpha1_ShootStateBackupConfiguration_To_config_ShootStateBackupConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootStateBackupConfiguration_To_config_ShootStateBackupConfiguration(in *ShootStateBackupConfiguration, out *config.ShootStateBackupConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootStateBackupConfiguration_To_config_ShootStateBackupConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootStateBackupConfiguration_To_v1alpha1_ShootStateBackupConfiguration(in *config.ShootStateBackupConfiguration, out *ShootStateBackupConfiguration, s conversion.Scope) error {
+	out.EncryptionKeySecretRef = in.EncryptionKeySecretRef
+	out.Retention = (*ShootStateBackupRetention)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_config_ShootStateBackupConfiguration_To_v1alpha1_ShootStateBackupConfiguration is an autogenerated conversion function.
+func Convert_config_ShootStateBackupConfiguration_To_v1alpha1_ShootStateBackupConfiguration(in *config.ShootStateBackupConfiguration, out *ShootStateBackupConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootStateBackupConfiguration_To_v1alpha1_ShootStateBackupConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootStateBackupRetention_To_config_ShootStateBackupRetention(in *ShootStateBackupRetention, out *config.ShootStateBackupRetention, s conversion.Scope) error {
+	out.Last = (*int)(unsafe.Pointer(in.Last))
+	out.Daily = (*int)(unsafe.Pointer(in.Daily))
+	return nil
+}
+
+// Convert_v1alpha1_ShootStateBackupRetention_To_config_ShootStateBackupRetention is an autogenerated conversion function.
+func Convert_v1alpha1_ShootStateBackupRetention_To_config_ShootStateBackupRetention(in *ShootStateBackupRetention, out *config.ShootStateBackupRetention, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootStateBackupRetention_To_config_ShootStateBackupRetention(in, out, s)
+}
+
+func autoConvert_config_ShootStateBackupRetention_To_v1alpha1_ShootStateBackupRetention(in *config.ShootStateBackupRetention, out *ShootStateBackupRetention, s conversion.Scope) error {
+	out.Last = (*int)(unsafe.Pointer(in.Last))
+	out.Daily = (*int)(unsafe.Pointer(in.Daily))
+	return nil
+}
+
+// Convert_config_ShootStateBackupRetention_To_v1alpha1_ShootStateBackupRetention is an autogenerated conversion function.
+func Convert_config_ShootStateBackupRetention_To_v1alpha1_ShootStateBackupRetention(in *config.ShootStateBackupRetention, out *ShootStateBackupRetention, s conversion.Scope) error {
+	return autoConvert_config_ShootStateBackupRetention_To_v1alpha1_ShootStateBackupRetention(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(in *ShootStateControllerConfiguration, out *config.ShootStateControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Backup = (*config.ShootStateBackupConfiguration)(unsafe.Pointer(in.Backup))
 	return nil
 }
 
@@ -1602,6 +1739,7 @@ func Convert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateCont
 func autoConvert_config_ShootStateControllerConfiguration_To_v1alpha1_ShootStateControllerConfiguration(in *config.ShootStateControllerConfiguration, out *ShootStateControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Backup = (*ShootStateBackupConfiguration)(unsafe.Pointer(in.Backup))
 	return nil
 }
 
@@ -1673,3 +1811,31 @@ func autoConvert_config_Vali_To_v1alpha1_Vali(in *config.Vali, out *Vali, s conv
 func Convert_config_Vali_To_v1alpha1_Vali(in *config.Vali, out *Vali, s conversion.Scope) error {
 	return autoConvert_config_Vali_To_v1alpha1_Vali(in, out, s)
 }
+
+func autoConvert_v1alpha1_VaultDataStore_To_config_VaultDataStore(in *VaultDataStore, out *config.VaultDataStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.MountPath = in.MountPath
+	out.PathPrefix = (*string)(unsafe.Pointer(in.PathPrefix))
+	out.TokenFile = in.TokenFile
+	out.CAFile = (*string)(unsafe.Pointer(in.CAFile))
+	return nil
+}
+
+// Convert_v1alpha1_VaultDataStore_To_config_VaultDataStore is an autogenerated conversion function.
+func Convert_v1alpha1_VaultDataStore_To_config_VaultDataStore(in *VaultDataStore, out *config.VaultDataStore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VaultDataStore_To_config_VaultDataStore(in, out, s)
+}
+
+func autoConvert_config_VaultDataStore_To_v1alpha1_VaultDataStore(in *config.VaultDataStore, out *VaultDataStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.MountPath = in.MountPath
+	out.PathPrefix = (*string)(unsafe.Pointer(in.PathPrefix))
+	out.TokenFile = in.TokenFile
+	out.CAFile = (*string)(unsafe.Pointer(in.CAFile))
+	return nil
+}
+
+// Convert_config_VaultDataStore_To_v1alpha1_VaultDataStore is an autogenerated conversion function.
+func Convert_config_VaultDataStore_To_v1alpha1_VaultDataStore(in *config.VaultDataStore, out *VaultDataStore, s conversion.Scope) error {
+	return autoConvert_config_VaultDataStore_To_v1alpha1_VaultDataStore(in, out, s)
+}
@@ -85,6 +85,11 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
+			if in.Controllers.ShootState.Backup != nil {
+				if in.Controllers.ShootState.Backup.Retention != nil {
+					SetDefaults_ShootStateBackupRetention(in.Controllers.ShootState.Backup.Retention)
+				}
+			}
 		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
@@ -17,6 +17,7 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -75,6 +76,9 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		if cfg.Controllers.NetworkPolicy != nil {
 			allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(cfg.Controllers.NetworkPolicy, fldPath.Child("controllers", "networkPolicy"))...)
 		}
+		if cfg.Controllers.ShootState != nil {
+			allErrs = append(allErrs, validateShootStateControllerConfiguration(cfg.Controllers.ShootState, fldPath.Child("controllers", "shootState"))...)
+		}
 	}
 
 	if cfg.LogLevel != "" {
@@ -140,6 +144,8 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(pointer.Int64Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, validateSecretsManagerConfiguration(cfg.SecretsManager, fldPath.Child("secretsManager"))...)
+
 	return allErrs
 }
 
@@ -247,6 +253,41 @@ func validateNetworkPolicyControllerConfiguration(cfg *config.NetworkPolicyContr
 	return allErrs
 }
 
+func validateShootStateControllerConfiguration(cfg *config.ShootStateControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if backup := cfg.Backup; backup != nil {
+		backupPath := fldPath.Child("backup")
+
+		if len(backup.EncryptionKeySecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(backupPath.Child("encryptionKeySecretRef", "name"), "must provide the name of the encryption key secret"))
+		}
+		if len(backup.EncryptionKeySecretRef.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(backupPath.Child("encryptionKeySecretRef", "namespace"), "must provide the namespace of the encryption key secret"))
+		}
+
+		if backup.Retention != nil {
+			retentionPath := backupPath.Child("retention")
+
+			if backup.Retention.Last != nil {
+				allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*backup.Retention.Last), retentionPath.Child("last"))...)
+			}
+			if backup.Retention.Daily != nil {
+				allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*backup.Retention.Daily), retentionPath.Child("daily"))...)
+			}
+			if pointer.IntDeref(backup.Retention.Last, 0) == 0 && pointer.IntDeref(backup.Retention.Daily, 0) == 0 {
+				allErrs = append(allErrs, field.Invalid(retentionPath, backup.Retention, "must keep at least one snapshot"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 var availableShootPurposes = sets.New(
 	string(gardencore.ShootPurposeEvaluation),
 	string(gardencore.ShootPurposeTesting),
@@ -280,3 +321,31 @@ func validateBastionControllerConfiguration(cfg *config.BastionControllerConfigu
 
 	return allErrs
 }
+
+func validateSecretsManagerConfiguration(cfg *config.SecretsManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil || cfg.ExternalDataStore == nil {
+		return allErrs
+	}
+
+	vault := cfg.ExternalDataStore.Vault
+	vaultPath := fldPath.Child("externalDataStore", "vault")
+	if vault == nil {
+		return append(allErrs, field.Required(vaultPath, "must configure a data store backend"))
+	}
+
+	if len(vault.Server) == 0 {
+		allErrs = append(allErrs, field.Required(vaultPath.Child("server"), "must provide the address of the Vault server"))
+	} else if u, err := url.Parse(vault.Server); err != nil || (u.Scheme != "https" && u.Scheme != "http") || len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("server"), vault.Server, "must be a valid http or https URL"))
+	}
+	if len(vault.MountPath) == 0 {
+		allErrs = append(allErrs, field.Required(vaultPath.Child("mountPath"), "must provide the mount path of the KV secrets engine"))
+	}
+	if len(vault.TokenFile) == 0 {
+		allErrs = append(allErrs, field.Required(vaultPath.Child("tokenFile"), "must provide the path of the token file"))
+	}
+
+	return allErrs
+}
@@ -391,6 +391,59 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("shoot state controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.ShootState = &config.ShootStateControllerConfiguration{
+					ConcurrentSyncs: &concurrentSyncs,
+					Backup: &config.ShootStateBackupConfiguration{
+						EncryptionKeySecretRef: corev1.SecretReference{Name: "shootstate-backup", Namespace: "garden"},
+						Retention:              &config.ShootStateBackupRetention{Last: pointer.Int(10), Daily: pointer.Int(7)},
+					},
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid incomplete backup configuration", func() {
+				cfg.Controllers.ShootState.Backup.EncryptionKeySecretRef = corev1.SecretReference{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootState.backup.encryptionKeySecretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootState.backup.encryptionKeySecretRef.namespace"),
+					})),
+				))
+			})
+
+			It("should forbid negative retention values", func() {
+				cfg.Controllers.ShootState.Backup.Retention.Last = pointer.Int(-1)
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootState.backup.retention.last"),
+					})),
+				))
+			})
+
+			It("should forbid a retention which does not keep any snapshot", func() {
+				cfg.Controllers.ShootState.Backup.Retention = &config.ShootStateBackupRetention{Last: pointer.Int(0), Daily: pointer.Int(0)}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootState.backup.retention"),
+					})),
+				))
+			})
+		})
+
 		Context("network policy controller", func() {
 			BeforeEach(func() {
 				cfg.Controllers.NetworkPolicy = &config.NetworkPolicyControllerConfiguration{}
@@ -642,6 +695,63 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("secretsManager", func() {
+			It("should pass with a valid external data store", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{
+					Server:    "https://vault.example.com:8200",
+					MountPath: "secret",
+					TokenFile: "/var/run/secrets/vault/token",
+				}}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if no backend of the external data store is configured", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("secretsManager.externalDataStore.vault"),
+					})),
+				))
+			})
+
+			It("should fail if the Vault configuration is incomplete", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{}}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("secretsManager.externalDataStore.vault.server"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("secretsManager.externalDataStore.vault.mountPath"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("secretsManager.externalDataStore.vault.tokenFile"),
+					})),
+				))
+			})
+
+			It("should fail if the Vault server address is invalid", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{
+					Server:    "vault.example.com",
+					MountPath: "secret",
+					TokenFile: "/var/run/secrets/vault/token",
+				}}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretsManager.externalDataStore.vault.server"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
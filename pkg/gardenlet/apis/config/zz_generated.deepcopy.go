@@ -366,6 +366,27 @@ func (in *ExposureClassHandler) DeepCopy() *ExposureClassHandler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDataStore) DeepCopyInto(out *ExternalDataStore) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultDataStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDataStore.
+func (in *ExternalDataStore) DeepCopy() *ExternalDataStore {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDataStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 	*out = *in
@@ -513,6 +534,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretsManager != nil {
+		in, out := &in.SecretsManager, &out.SecretsManager
+		*out = new(SecretsManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -941,6 +967,27 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsManagerConfig) DeepCopyInto(out *SecretsManagerConfig) {
+	*out = *in
+	if in.ExternalDataStore != nil {
+		in, out := &in.ExternalDataStore, &out.ExternalDataStore
+		*out = new(ExternalDataStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManagerConfig.
+func (in *SecretsManagerConfig) DeepCopy() *SecretsManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -1263,6 +1310,54 @@ func (in *ShootNodeLogging) DeepCopy() *ShootNodeLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootStateBackupConfiguration) DeepCopyInto(out *ShootStateBackupConfiguration) {
+	*out = *in
+	out.EncryptionKeySecretRef = in.EncryptionKeySecretRef
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ShootStateBackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStateBackupConfiguration.
+func (in *ShootStateBackupConfiguration) DeepCopy() *ShootStateBackupConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootStateBackupConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootStateBackupRetention) DeepCopyInto(out *ShootStateBackupRetention) {
+	*out = *in
+	if in.Last != nil {
+		in, out := &in.Last, &out.Last
+		*out = new(int)
+		**out = **in
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStateBackupRetention.
+func (in *ShootStateBackupRetention) DeepCopy() *ShootStateBackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootStateBackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -1276,6 +1371,11 @@ func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControl
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(ShootStateBackupConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1356,3 +1456,29 @@ func (in *Vali) DeepCopy() *Vali {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultDataStore) DeepCopyInto(out *VaultDataStore) {
+	*out = *in
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.CAFile != nil {
+		in, out := &in.CAFile, &out.CAFile
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultDataStore.
+func (in *VaultDataStore) DeepCopy() *VaultDataStore {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultDataStore)
+	in.DeepCopyInto(out)
+	return out
+}
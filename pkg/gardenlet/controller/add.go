@@ -41,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot"
 	"github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -55,6 +56,7 @@ func AddToManager(
 	shootClientMap clientmap.ClientMap,
 	cfg *config.GardenletConfiguration,
 	healthManager healthz.Manager,
+	flowTracer flow.Tracer,
 ) error {
 	identity, err := gardenerutils.DetermineIdentity()
 	if err != nil {
@@ -119,7 +121,7 @@ func AddToManager(
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
 
-	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, *cfg, identity, gardenClusterIdentity); err != nil {
+	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, *cfg, identity, gardenClusterIdentity, flowTracer); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
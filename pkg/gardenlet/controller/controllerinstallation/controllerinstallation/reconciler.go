@@ -59,6 +59,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -77,6 +78,7 @@ type Reconciler struct {
 	Clock                 clock.Clock
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
+	HelmRegistry          oci.Interface
 }
 
 // Reconcile reconciles ControllerInstallations and deploys them into the seed cluster.
@@ -162,6 +164,8 @@ func (r *Reconciler) reconcile(
 	var helmDeployment struct {
 		// chart is a Helm chart tarball.
 		Chart []byte `json:"chart,omitempty"`
+		// OCIRepository is a reference to a Helm chart in an OCI registry. It is mutually exclusive with Chart.
+		OCIRepository *oci.Repository `json:"ociRepository,omitempty"`
 		// Values is a map of values for the given chart.
 		Values map[string]interface{} `json:"values,omitempty"`
 	}
@@ -171,6 +175,21 @@ func (r *Reconciler) reconcile(
 		return reconcile.Result{}, err
 	}
 
+	if helmDeployment.OCIRepository != nil {
+		if len(helmDeployment.Chart) > 0 {
+			err := fmt.Errorf("chart and ociRepository are mutually exclusive")
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("chart Information is invalid: %+v", err))
+			return reconcile.Result{}, err
+		}
+
+		chart, err := r.HelmRegistry.Pull(seedCtx, helmDeployment.OCIRepository)
+		if err != nil {
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartCannotBePulled", fmt.Sprintf("chart cannot be pulled from %s: %+v", helmDeployment.OCIRepository, err))
+			return reconcile.Result{}, err
+		}
+		helmDeployment.Chart = chart
+	}
+
 	namespace := getNamespaceForControllerInstallation(controllerInstallation)
 	if _, err := controllerutils.GetAndCreateOrMergePatch(seedCtx, r.SeedClientSet.Client(), namespace, func() error {
 		metav1.SetMetaDataLabel(&namespace.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleExtension)
@@ -170,7 +170,7 @@ func (vp *valuesHelper) getGardenletDeploymentValues(deployment *seedmanagementv
 	// make sure map is initialized
 	deploymentValues = utils.InitValuesMap(deploymentValues)
 
-	// Set imageVectorOverwrite and componentImageVectorOverwrites from parent
+	// Set imageVectorOverwrite, componentImageVectorOverwrites and imageVectorPolicy from parent
 	parentImageVectorOverwrite, err := getParentImageVectorOverwrite()
 	if err != nil {
 		return nil, err
@@ -189,6 +189,15 @@ func (vp *valuesHelper) getGardenletDeploymentValues(deployment *seedmanagementv
 		deploymentValues["componentImageVectorOverwrites"] = *parentComponentImageVectorOverwrites
 	}
 
+	parentImageVectorPolicy, err := getParentImageVectorPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	if parentImageVectorPolicy != nil {
+		deploymentValues["imageVectorPolicy"] = *parentImageVectorPolicy
+	}
+
 	return deploymentValues, nil
 }
 
@@ -323,3 +332,15 @@ func getParentComponentImageVectorOverwrites() (*string, error) {
 	}
 	return componentImageVectorOverwrites, nil
 }
+
+func getParentImageVectorPolicy() (*string, error) {
+	var imageVectorPolicy *string
+	if policyPath := os.Getenv(imagevectorutils.PolicyEnv); len(policyPath) > 0 {
+		data, err := os.ReadFile(policyPath)
+		if err != nil {
+			return nil, err
+		}
+		imageVectorPolicy = pointer.String(string(data))
+	}
+	return imageVectorPolicy, nil
+}
@@ -37,8 +37,8 @@ import (
 
 var _ = Describe("ValuesHelper", func() {
 	var (
-		imageVectorOverwritePath, componentImageVectorOverwritesPath string
-		gardenKubeconfigPath, seedKubeconfigPath                     string
+		imageVectorOverwritePath, componentImageVectorOverwritesPath, imageVectorPolicyPath string
+		gardenKubeconfigPath, seedKubeconfigPath                                            string
 
 		cleanupFuncs []func()
 
@@ -62,10 +62,12 @@ var _ = Describe("ValuesHelper", func() {
 		cleanupFuncs = []func(){
 			test.WithTempFile("", "image-vector-overwrite", []byte("image vector overwrite"), &imageVectorOverwritePath),
 			test.WithTempFile("", "component-image-vector-overwrites", []byte("component image vector overwrites"), &componentImageVectorOverwritesPath),
+			test.WithTempFile("", "image-vector-policy", []byte("image vector policy"), &imageVectorPolicyPath),
 			test.WithTempFile("", "garden-kubeconfig", []byte("garden kubeconfig"), &gardenKubeconfigPath),
 			test.WithTempFile("", "seed-kubeconfig", []byte("seed kubeconfig"), &seedKubeconfigPath),
 			test.WithEnvVar(imagevector.OverrideEnv, imageVectorOverwritePath),
 			test.WithEnvVar(imagevector.ComponentOverrideEnv, componentImageVectorOverwritesPath),
+			test.WithEnvVar(imagevector.PolicyEnv, imageVectorPolicyPath),
 		}
 
 		parentConfig = &config.GardenletConfiguration{
@@ -243,6 +245,7 @@ var _ = Describe("ValuesHelper", func() {
 				"vpa":                            true,
 				"imageVectorOverwrite":           "image vector overwrite",
 				"componentImageVectorOverwrites": "component image vector overwrites",
+				"imageVectorPolicy":              "image vector policy",
 				"config": map[string]interface{}{
 					"apiVersion": "gardenlet.config.gardener.cloud/v1alpha1",
 					"kind":       "GardenletConfiguration",
@@ -56,6 +56,11 @@ func AddToManager(
 		}
 	}
 
+	componentImageVectors, err = imagevectorutils.ComponentImageVectorsWithEnvPolicy(componentImageVectors)
+	if err != nil {
+		return fmt.Errorf("failed applying image vector policy to component-specific image vector overrides: %w", err)
+	}
+
 	if err := (&care.Reconciler{
 		Config:         *cfg.Controllers.SeedCare,
 		SeedName:       cfg.SeedConfig.Name,
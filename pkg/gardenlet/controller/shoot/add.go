@@ -33,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // AddToManager adds all Shoot controllers to the given manager.
@@ -46,6 +47,7 @@ func AddToManager(
 	cfg config.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	gardenClusterIdentity string,
+	flowTracer flow.Tracer,
 ) error {
 	var responsibleForUnmanagedSeed bool
 	if err := gardenCluster.GetAPIReader().Get(ctx, client.ObjectKey{Name: cfg.SeedConfig.Name, Namespace: v1beta1constants.GardenNamespace}, &seedmanagementv1alpha1.ManagedSeed{}); err != nil {
@@ -64,6 +66,7 @@ func AddToManager(
 		Identity:                    identity,
 		GardenClusterIdentity:       gardenClusterIdentity,
 		ShootStateControllerEnabled: shootStateControllerEnabled,
+		FlowTracer:                  flowTracer,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
@@ -17,6 +17,7 @@ package care
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -213,7 +214,8 @@ func (c *Constraint) CheckIfCACertificateValiditiesAcceptable(ctx context.Contex
 
 	expiringCACertificates := make(map[string]time.Time, len(secretList.Items))
 	for _, secret := range secretList.Items {
-		if secret.Data[secretsutils.DataKeyCertificateCA] == nil || secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil {
+		if secret.Data[secretsutils.DataKeyCertificateCA] == nil ||
+			(secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil && !slices.Contains(secretsmanager.ExternalDataKeys(&secret), secretsutils.DataKeyPrivateKeyCA)) {
 			continue
 		}
 
@@ -439,6 +439,42 @@ var _ = Describe("health check", func() {
 				false,
 				oscSecretMeta,
 				PointTo(beConditionWithStatusAndCodes(gardencorev1beta1.ConditionFalse, gardencorev1beta1.ErrorConfigurationProblem))),
+			Entry("operating system config not applied to node",
+				true,
+				kubernetesVersion,
+				[]corev1.Node{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   nodeName,
+							Labels: labels.Set{"worker.gardener.cloud/pool": workerPoolName1, "worker.gardener.cloud/kubernetes-version": kubernetesVersion.Original()},
+						},
+						Status: corev1.NodeStatus{
+							Conditions: []corev1.NodeCondition{
+								{
+									Type:   corev1.NodeReady,
+									Status: corev1.ConditionTrue,
+								},
+								{
+									Type:    "OperatingSystemConfigApplied",
+									Status:  corev1.ConditionFalse,
+									Reason:  "OSCRolledBack",
+									Message: `unit "foo": bar`,
+								},
+							},
+							NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubernetesVersion.Original()},
+						},
+					},
+				},
+				[]gardencorev1beta1.Worker{
+					{
+						Name:    workerPoolName1,
+						Maximum: 10,
+						Minimum: 1,
+					},
+				},
+				true,
+				oscSecretMeta,
+				PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "OperatingSystemConfigNotApplied", fmt.Sprintf(`Operating system config could not be applied to node %q in worker group %q: condition "OperatingSystemConfigApplied" has invalid status False (expected True) due to OSCRolledBack: unit "foo": bar`, nodeName, workerPoolName1)))),
 			Entry("not enough nodes in worker pool",
 				false,
 				kubernetesVersion,
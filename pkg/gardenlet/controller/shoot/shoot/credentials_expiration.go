@@ -0,0 +1,80 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/operation"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// reportCredentialExpirations reports the expiration dates of the certificate authorities and certificates managed by
+// gardenlet for the shoot in its status and in the credential expiration metric.
+func reportCredentialExpirations(ctx context.Context, o *operation.Operation) error {
+	certificateExpirations, err := secretsmanager.CertificateExpirations(ctx, o.SeedClientSet.Client(), o.Shoot.SeedNamespace, v1beta1constants.SecretManagerIdentityGardenlet)
+	if err != nil {
+		return err
+	}
+
+	expirations := make([]gardencorev1beta1.CredentialExpiration, 0, len(certificateExpirations))
+	for _, certificateExpiration := range certificateExpirations {
+		credentialType := gardencorev1beta1.CredentialTypeCertificate
+		if certificateExpiration.CA {
+			credentialType = gardencorev1beta1.CredentialTypeCertificateAuthority
+		}
+
+		expirations = append(expirations, gardencorev1beta1.CredentialExpiration{
+			Name:           certificateExpiration.Name,
+			Type:           credentialType,
+			ExpirationTime: metav1.NewTime(certificateExpiration.ValidUntil),
+		})
+	}
+
+	shoot := o.Shoot.GetInfo()
+	recordCredentialExpirations(shoot, expirations)
+
+	if shoot.Status.Credentials != nil && equality.Semantic.DeepEqual(shoot.Status.Credentials.Expirations, expirations) {
+		return nil
+	}
+
+	return o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+		if shoot.Status.Credentials == nil {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{}
+		}
+		shoot.Status.Credentials.Expirations = expirations
+		return nil
+	})
+}
+
+// recordCredentialExpirations replaces the credential expiration metrics of the given shoot.
+func recordCredentialExpirations(shoot *gardencorev1beta1.Shoot, expirations []gardencorev1beta1.CredentialExpiration) {
+	forgetCredentialExpirations(shoot)
+
+	for _, expiration := range expirations {
+		credentialExpirationTimestamp.WithLabelValues(shoot.Namespace, shoot.Name, expiration.Name, string(expiration.Type)).Set(float64(expiration.ExpirationTime.Unix()))
+	}
+}
+
+// forgetCredentialExpirations removes the credential expiration metrics of the given shoot.
+func forgetCredentialExpirations(shoot *gardencorev1beta1.Shoot) {
+	credentialExpirationTimestamp.DeletePartialMatch(prometheus.Labels{"namespace": shoot.Namespace, "shoot": shoot.Name})
+}
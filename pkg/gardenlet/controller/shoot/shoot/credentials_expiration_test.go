@@ -0,0 +1,128 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/operation"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+var _ = Describe("Credential expirations", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		shoot        *gardencorev1beta1.Shoot
+		o            *operation.Operation
+
+		seedNamespace = "shoot--garden-foo--bar"
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+
+		o = &operation.Operation{
+			Logger:        logr.Discard(),
+			GardenClient:  gardenClient,
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Shoot:         &shootpkg.Shoot{SeedNamespace: seedNamespace},
+		}
+		o.Shoot.SetInfo(shoot)
+
+		for _, secret := range []*corev1.Secret{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca-123", Namespace: seedNamespace, Labels: map[string]string{
+					"name":             "ca",
+					"managed-by":       "secrets-manager",
+					"manager-identity": "gardenlet",
+					"valid-until-time": "1900000000",
+				}},
+				Data: map[string][]byte{"ca.crt": []byte("crt"), "ca.key": []byte("key")},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-456", Namespace: seedNamespace, Labels: map[string]string{
+					"name":             "kube-apiserver",
+					"managed-by":       "secrets-manager",
+					"manager-identity": "gardenlet",
+					"valid-until-time": "1800000000",
+				}},
+				Data: map[string][]byte{"tls.crt": []byte("crt"), "tls.key": []byte("key")},
+			},
+		} {
+			Expect(seedClient.Create(ctx, secret)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		forgetCredentialExpirations(shoot)
+	})
+
+	Describe("#reportCredentialExpirations", func() {
+		It("should report the expiration dates in the shoot status and the metrics", func() {
+			Expect(reportCredentialExpirations(ctx, o)).To(Succeed())
+
+			expectedExpirations := []gardencorev1beta1.CredentialExpiration{
+				{Name: "ca", Type: gardencorev1beta1.CredentialTypeCertificateAuthority, ExpirationTime: metav1.NewTime(time.Unix(1900000000, 0))},
+				{Name: "kube-apiserver", Type: gardencorev1beta1.CredentialTypeCertificate, ExpirationTime: metav1.NewTime(time.Unix(1800000000, 0))},
+			}
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Credentials).NotTo(BeNil())
+			Expect(shoot.Status.Credentials.Expirations).To(HaveLen(2))
+			for i, expiration := range shoot.Status.Credentials.Expirations {
+				Expect(expiration.Name).To(Equal(expectedExpirations[i].Name))
+				Expect(expiration.Type).To(Equal(expectedExpirations[i].Type))
+				Expect(expiration.ExpirationTime.Equal(&expectedExpirations[i].ExpirationTime)).To(BeTrue())
+			}
+
+			Expect(testutil.ToFloat64(credentialExpirationTimestamp.WithLabelValues("garden-foo", "bar", "ca", "CertificateAuthority"))).To(Equal(float64(1900000000)))
+			Expect(testutil.ToFloat64(credentialExpirationTimestamp.WithLabelValues("garden-foo", "bar", "kube-apiserver", "Certificate"))).To(Equal(float64(1800000000)))
+		})
+
+		It("should remove the metrics of credentials which are no longer managed", func() {
+			Expect(reportCredentialExpirations(ctx, o)).To(Succeed())
+			Expect(testutil.CollectAndCount(credentialExpirationTimestamp)).To(Equal(2))
+
+			Expect(seedClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-456", Namespace: seedNamespace}})).To(Succeed())
+
+			Expect(reportCredentialExpirations(ctx, o)).To(Succeed())
+			Expect(testutil.CollectAndCount(credentialExpirationTimestamp)).To(Equal(1))
+
+			forgetCredentialExpirations(shoot)
+			Expect(testutil.CollectAndCount(credentialExpirationTimestamp)).To(Equal(0))
+		})
+	})
+})
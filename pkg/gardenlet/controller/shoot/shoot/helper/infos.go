@@ -78,7 +78,7 @@ type ControllerInfos struct {
 	isFailed                              bool
 	isUpToDate                            bool
 	confineSpecUpdateRollout              bool
-	maintenanceTimeWindow                 *timewindow.MaintenanceTimeWindow
+	maintenanceSchedule                   *timewindow.MaintenanceSchedule
 	isNowInEffectiveMaintenanceTimeWindow bool
 	alreadyReconciledDuringThisTimeWindow bool
 
@@ -103,7 +103,7 @@ func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock,
 		isFailed:                              gardenerutils.IsShootFailedAndUpToDate(shoot),
 		isUpToDate:                            gardenerutils.IsObservedAtLatestGenerationAndSucceeded(shoot),
 		confineSpecUpdateRollout:              v1beta1helper.ShootConfinesSpecUpdateRollout(shoot.Spec.Maintenance),
-		maintenanceTimeWindow:                 gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot),
+		maintenanceSchedule:                   gardenerutils.EffectiveShootMaintenanceSchedule(shoot),
 		isNowInEffectiveMaintenanceTimeWindow: gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, clock),
 		alreadyReconciledDuringThisTimeWindow: gardenerutils.LastReconciliationDuringThisTimeWindow(shoot, clock),
 
@@ -199,12 +199,12 @@ func (i ControllerInfos) enqueueAfter() time.Duration {
 	// if the shoot is in its maintenance time window right now but has not been reconciled in the time window so far,
 	// schedule the regular reconciliation for a random time in this time window
 	if i.isNowInEffectiveMaintenanceTimeWindow && !i.alreadyReconciledDuringThisTimeWindow {
-		return i.maintenanceTimeWindow.RandomDurationUntilNext(i.clock.Now(), true)
+		return i.maintenanceSchedule.RandomDurationUntilNext(i.clock.Now(), true)
 	}
 
 	// shoot reconciliations are confined, and the shoot's maintenance time window is not met, or it was already
 	// reconciled in this time window -> schedule a reconciliation during the next maintenance time window
-	return i.maintenanceTimeWindow.RandomDurationUntilNext(i.clock.Now(), false)
+	return i.maintenanceSchedule.RandomDurationUntilNext(i.clock.Now(), false)
 }
 
 func (i ControllerInfos) requeueAfter() reconcile.Result {
@@ -229,5 +229,5 @@ func (i ControllerInfos) requeueAfter() reconcile.Result {
 
 	// shoot reconciliations are confined, and the shoot's maintenance time window is not met, or it was already
 	// reconciled in this time window -> schedule a reconciliation during the next maintenance time window
-	return reconcile.Result{RequeueAfter: i.maintenanceTimeWindow.RandomDurationUntilNext(i.clock.Now(), false)}
+	return reconcile.Result{RequeueAfter: i.maintenanceSchedule.RandomDurationUntilNext(i.clock.Now(), false)}
 }
@@ -0,0 +1,36 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// credentialExpirationTimestamp defines the gauge credential_expiration_timestamp_seconds which reports the expiration
+// dates of the certificate authorities and certificates managed for shoots.
+var credentialExpirationTimestamp = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "gardenlet",
+		Subsystem: "shoot",
+		Name:      "credential_expiration_timestamp_seconds",
+		Help:      "Unix timestamp of the expiration date of a certificate authority or certificate managed for a shoot.",
+	},
+	[]string{
+		"namespace",
+		"shoot",
+		"name",
+		"type",
+	},
+)
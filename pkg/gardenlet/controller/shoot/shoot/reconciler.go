@@ -75,6 +75,8 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	// FlowTracer is used to trace the executions of the shoot flows. Defaults to flow.NoopTracer.
+	FlowTracer flow.Tracer
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -265,8 +267,8 @@ func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoo
 		return nil, reconcile.Result{}, fmt.Errorf("cannot find Project for namespace '%s'", shoot.Namespace)
 	}
 
-	cloudProfile := &gardencorev1beta1.CloudProfile{}
-	if err := r.GardenClient.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.GardenClient, shoot)
+	if err != nil {
 		return nil, reconcile.Result{}, err
 	}
 
@@ -383,6 +385,7 @@ func (r *Reconciler) initializeOperation(
 	op, err := operation.
 		NewBuilder().
 		WithLogger(log).
+		WithClock(r.Clock).
 		WithConfig(&r.Config).
 		WithGardenerInfo(r.Identity).
 		WithGardenClusterIdentity(r.GardenClusterIdentity).
@@ -447,6 +450,8 @@ func (r *Reconciler) finalizeShootMigration(ctx context.Context, shoot *gardenco
 		return reconcile.Result{}, err
 	}
 
+	forgetCredentialExpirations(shoot)
+
 	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventMigrationPrepared, "Prepared Shoot cluster for migration")
 	return reconcile.Result{}, r.patchShootStatusOperationSuccess(ctx, shoot, o.Shoot.SeedNamespace, nil, gardencorev1beta1.LastOperationTypeMigrate)
 }
@@ -476,6 +481,8 @@ func (r *Reconciler) removeFinalizerFromShoot(ctx context.Context, log logr.Logg
 		return err
 	}
 
+	forgetCredentialExpirations(shoot)
+
 	if controllerutil.ContainsFinalizer(shoot, gardencorev1beta1.GardenerName) {
 		log.Info("Removing finalizer")
 		if err := controllerutils.RemoveFinalizers(ctx, r.GardenClient, shoot, gardencorev1beta1.GardenerName); err != nil {
@@ -501,6 +508,10 @@ func (r *Reconciler) removeFinalizerFromShoot(ctx context.Context, log logr.Logg
 	})
 }
 
+func flowTracingAttributes(shoot *gardencorev1beta1.Shoot) map[string]string {
+	return map[string]string{"shoot": client.ObjectKeyFromObject(shoot).String()}
+}
+
 func (r *Reconciler) newProgressReporter(reporterFn flow.ProgressReporterFn) flow.ProgressReporter {
 	if r.Config.Controllers.Shoot != nil && r.Config.Controllers.Shoot.ProgressReportPeriod != nil {
 		return flow.NewDelayingProgressReporter(clock.RealClock{}, reporterFn, r.Config.Controllers.Shoot.ProgressReportPeriod.Duration)
@@ -717,11 +717,17 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Fn:           flow.TaskFn(botanist.DeleteSeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(syncPoint, destroyInternalDomainDNSRecord, destroyReferencedResources, waitUntilEtcdDeleted),
 		})
-		_ = g.Add(flow.Task{
+		waitUntilNamespaceDeleted = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespace in Seed has been deleted",
 			Fn:           botanist.WaitUntilSeedNamespaceDeleted,
 			Dependencies: flow.NewTaskIDs(deleteNamespace),
 		})
+		// The data of the secrets is only deleted after the namespace is gone, i.e., when no secret can be created anymore.
+		_ = g.Add(flow.Task{
+			Name:         "Deleting secrets data in external data store",
+			Fn:           flow.TaskFn(botanist.DeleteSecretsDataStoreEntries).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilNamespaceDeleted),
+		})
 		_ = g.Add(flow.Task{
 			Name: "Deleting Shoot State",
 			Fn: func(ctx context.Context) error {
@@ -734,10 +740,12 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 	)
 
 	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorCleaner:     o.CleanShootTaskError,
-		ErrorContext:     errorContext,
+		Log:               o.Logger,
+		ProgressReporter:  r.newProgressReporter(o.ReportShootProgress),
+		ErrorCleaner:      o.CleanShootTaskError,
+		ErrorContext:      errorContext,
+		Tracer:            r.FlowTracer,
+		TracingAttributes: flowTracingAttributes(o.Shoot.GetInfo()),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -182,10 +182,12 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 	)
 
 	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorCleaner:     o.CleanShootTaskError,
-		ErrorContext:     errorContext,
+		Log:               o.Logger,
+		ProgressReporter:  r.newProgressReporter(o.ReportShootProgress),
+		ErrorCleaner:      o.CleanShootTaskError,
+		ErrorContext:      errorContext,
+		Tracer:            r.FlowTracer,
+		TracingAttributes: flowTracingAttributes(o.Shoot.GetInfo()),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -410,6 +410,9 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn:           flow.TaskFn(botanist.InitializeDesiredShootClients).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, waitUntilControlPlaneExposureReady, waitUntilControlPlaneExposureDeleted, deployInternalDomainDNSRecord, deployGardenerAccess),
 		})
+		// Only tasks which neither wait for conditions nor provide in-memory state to their dependents are resumable, see
+		// flow.Task. Most deploy tasks compute secrets or component values which later tasks rely on, hence they always
+		// run again. Rewriting the encrypted data is expensive for large clusters and does not have such dependents.
 		rewriteResourcesAddLabel = g.Add(flow.Task{
 			Name: "Labeling resources after modification of encryption config or to encrypt them with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
@@ -418,6 +421,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf: v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing &&
 				apiequality.Semantic.DeepEqual(o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources),
 			Dependencies: flow.NewTaskIDs(initializeShootClients),
+			Resumable:    true,
 		})
 		snapshotETCD = g.Add(flow.Task{
 			Name: "Snapshotting ETCD after modification of encryption config or resources are re-encrypted with new ETCD encryption key",
@@ -428,6 +432,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				(v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing &&
 					apiequality.Semantic.DeepEqual(o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources)),
 			Dependencies: flow.NewTaskIDs(rewriteResourcesAddLabel),
+			Resumable:    true,
 		})
 		_ = g.Add(flow.Task{
 			Name: "Removing label from resources after modification of encryption config or rotation of ETCD encryption key",
@@ -450,6 +455,18 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 					}
 				}
 
+				// All resources have been re-encrypted with the configured encryption provider during the preparation of
+				// the ETCD encryption key rotation, hence it becomes the active provider.
+				if v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) == gardencorev1beta1.RotationCompleting &&
+					!apiequality.Semantic.DeepEqual(o.Shoot.EncryptionProvider, o.Shoot.ActiveEncryptionProvider) {
+					if err := o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+						shoot.Status.EncryptionProvider = o.Shoot.EncryptionProvider.DeepCopy()
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+
 				return nil
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
 			SkipIf: v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationCompleting &&
@@ -904,11 +921,21 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 
 	f := g.Compile()
 
+	// The states of failed executions are only persisted if a ShootState is maintained for the shoot anyway, see
+	// shootstate.NewFlowStateStore.
+	var flowStateStore flow.StateStore
+	if r.ShootStateControllerEnabled || isRestoring {
+		flowStateStore = shootstate.NewFlowStateStore(o.GardenClient, o.Shoot.GetInfo(), operationType)
+	}
+
 	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorContext:     errorContext,
-		ErrorCleaner:     o.CleanShootTaskError,
+		Log:               o.Logger,
+		ProgressReporter:  r.newProgressReporter(o.ReportShootProgress),
+		ErrorContext:      errorContext,
+		ErrorCleaner:      o.CleanShootTaskError,
+		Tracer:            r.FlowTracer,
+		TracingAttributes: flowTracingAttributes(o.Shoot.GetInfo()),
+		StateStore:        flowStateStore,
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -919,6 +946,13 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
 	}
 
+	o.Logger.Info("Reporting expiration dates of managed credentials")
+	if err := reportCredentialExpirations(ctx, o); err != nil {
+		// The expiration dates are informational only, hence failing to report them must not fail the reconciliation.
+		// They are reported again with the next reconciliation.
+		o.Logger.Error(err, "Failed to report expiration dates of managed credentials")
+	}
+
 	if !r.ShootStateControllerEnabled && botanist.IsRestorePhase() {
 		o.Logger.Info("Deleting Shoot State after successful restoration")
 		if err := shootstate.Delete(ctx, botanist.GardenClient, botanist.Shoot.GetInfo()); err != nil {
@@ -0,0 +1,113 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+// encryptionKeyDataKey is the data key of the encryption key secret which contains the AES key.
+const encryptionKeyDataKey = "key"
+
+// backupShootState hands an encrypted snapshot of the given ShootState over to the extension responsible for the
+// BackupEntry of the shoot if the last handed over snapshot is older than the ShootState. The snapshot is written into
+// a secret in the control plane namespace of the shoot, and the extension BackupEntry is triggered to be reconciled.
+// The extension then stores the snapshot in the backup bucket (see the BackupEntry extension contract).
+func (r *Reconciler) backupShootState(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, shootState *gardencorev1beta1.ShootState, lastBackup time.Time) error {
+	backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID)
+	if err != nil {
+		return err
+	}
+
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: backupEntryName}, backupEntry); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Skipping ShootState snapshot because shoot has no BackupEntry")
+			return nil
+		}
+		return fmt.Errorf("failed reading extension BackupEntry %s: %w", backupEntryName, err)
+	}
+
+	secret := shootstate.NewSnapshotSecret(shoot.Status.TechnicalID)
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed reading ShootState snapshot secret: %w", err)
+	}
+
+	snapshotTime, err := shootstate.SnapshotTime(secret)
+	if err != nil {
+		return err
+	}
+	if !snapshotTime.Before(lastBackup.Truncate(time.Second)) {
+		return nil
+	}
+
+	key, err := r.encryptionKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := shootstate.EncryptSnapshot(&shootState.Spec, key)
+	if err != nil {
+		return err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, secret, func() error {
+		shootstate.SetSnapshot(secret, data, lastBackup, shootstate.SnapshotRetention{
+			Last:  pointer.IntDeref(r.Config.Backup.Retention.Last, 0),
+			Daily: pointer.IntDeref(r.Config.Backup.Retention.Daily, 0),
+		})
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed writing ShootState snapshot secret: %w", err)
+	}
+
+	patch := client.MergeFrom(backupEntry.DeepCopy())
+	metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	if err := r.SeedClient.Patch(ctx, backupEntry, patch); err != nil {
+		return fmt.Errorf("failed triggering reconciliation of extension BackupEntry %s: %w", backupEntryName, err)
+	}
+
+	log.Info("Handed over ShootState snapshot to extension BackupEntry", "backupEntry", backupEntryName, "snapshotTime", lastBackup)
+	return nil
+}
+
+func (r *Reconciler) encryptionKey(ctx context.Context) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: r.Config.Backup.EncryptionKeySecretRef.Namespace, Name: r.Config.Backup.EncryptionKeySecretRef.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading ShootState snapshot encryption key secret: %w", err)
+	}
+
+	key, ok := secret.Data[encryptionKeyDataKey]
+	if !ok {
+		return nil, fmt.Errorf("ShootState snapshot encryption key secret %s does not contain data key %q", client.ObjectKeyFromObject(secret), encryptionKeyDataKey)
+	}
+	return key, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Backup", func() {
+	var (
+		ctx = context.TODO()
+		key = []byte("0123456789abcdef0123456789abcdef")
+
+		fakeGardenClient client.Client
+		fakeSeedClient   client.Client
+		fakeClock        *testclock.FakeClock
+		reconciler       *Reconciler
+
+		shoot          *gardencorev1beta1.Shoot
+		shootState     *gardencorev1beta1.ShootState
+		backupEntry    *extensionsv1alpha1.BackupEntry
+		snapshotSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			GardenClient: fakeGardenClient,
+			SeedClient:   fakeSeedClient,
+			Config: config.ShootStateControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: 6 * time.Hour},
+				Backup: &config.ShootStateBackupConfiguration{
+					EncryptionKeySecretRef: corev1.SecretReference{Name: "shootstate-backup", Namespace: "garden"},
+					Retention:              &config.ShootStateBackupRetention{Last: pointer.Int(2), Daily: pointer.Int(3)},
+				},
+			},
+			Clock:    fakeClock,
+			SeedName: "seed",
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String("seed")},
+			Status: gardencorev1beta1.ShootStatus{
+				UID:           "shoot-uid",
+				TechnicalID:   "shoot--my-project--my-shoot",
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+		shootState = &gardencorev1beta1.ShootState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-shoot",
+				Namespace:   "garden-my-project",
+				Annotations: map[string]string{v1beta1constants.GardenerTimestamp: fakeClock.Now().Add(-time.Hour).Format(time.RFC3339)},
+			},
+			Spec: gardencorev1beta1.ShootStateSpec{
+				Gardener: []gardencorev1beta1.GardenerResourceData{{
+					Name: "ca",
+					Type: "secret",
+					Data: runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				}},
+			},
+		}
+		backupEntry = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--my-project--my-shoot--shoot-uid"},
+			Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: "bucket"},
+		}
+		snapshotSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shoot-state-snapshot", Namespace: "shoot--my-project--my-shoot"}}
+
+		Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+		Expect(fakeSeedClient.Create(ctx, backupEntry)).To(Succeed())
+		Expect(fakeSeedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "shootstate-backup", Namespace: "garden"},
+			Data:       map[string][]byte{"key": key},
+		})).To(Succeed())
+	})
+
+	It("should hand over an encrypted snapshot of the ShootState to the extension BackupEntry", func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(snapshotSecret), snapshotSecret)).To(Succeed())
+		Expect(snapshotSecret.Annotations).To(Equal(map[string]string{
+			"shootstate.gardener.cloud/snapshot-time":   "2023-10-10T11:00:00Z",
+			"shootstate.gardener.cloud/retention-last":  "2",
+			"shootstate.gardener.cloud/retention-daily": "3",
+		}))
+		Expect(shootstate.DecryptSnapshot(snapshotSecret.Data["snapshot"], key)).To(Equal(&shootState.Spec))
+
+		Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+		Expect(backupEntry.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
+	})
+
+	It("should not hand over another snapshot if the ShootState did not change", func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+		delete(backupEntry.Annotations, "gardener.cloud/operation")
+		Expect(fakeSeedClient.Update(ctx, backupEntry)).To(Succeed())
+		Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(snapshotSecret), snapshotSecret)).To(Succeed())
+		resourceVersion := snapshotSecret.ResourceVersion
+
+		fakeClock.Step(time.Hour)
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(snapshotSecret), snapshotSecret)).To(Succeed())
+		Expect(snapshotSecret.ResourceVersion).To(Equal(resourceVersion))
+		Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+		Expect(backupEntry.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+	})
+
+	It("should not hand over a snapshot if the shoot has no BackupEntry", func() {
+		Expect(fakeSeedClient.Delete(ctx, backupEntry)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(snapshotSecret), snapshotSecret)).To(BeNotFoundError())
+	})
+
+	It("should fail if the encryption key secret does not exist", func() {
+		Expect(fakeSeedClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shootstate-backup", Namespace: "garden"}})).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).To(MatchError(ContainSubstring("failed reading ShootState snapshot encryption key secret")))
+	})
+})
@@ -88,14 +88,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed fetching ShootState %s: %w", client.ObjectKeyFromObject(shoot), err)
 	}
 
-	var lastBackup time.Time
-	if v, ok := shootState.Annotations[v1beta1constants.GardenerTimestamp]; ok {
-		var err error
-		lastBackup, err = time.Parse(time.RFC3339, v)
-		if err != nil {
-			return reconcile.Result{}, fmt.Errorf("failed parsing timestamp %q on ShootState object: %w", v, err)
-		}
-		lastBackup = lastBackup.UTC()
+	lastBackup, err := lastBackupTime(shootState)
+	if err != nil {
+		return reconcile.Result{}, err
 	}
 
 	if nextBackupDue := lastBackup.Add(r.Config.SyncPeriod.Duration); nextBackupDue.Before(r.Clock.Now().UTC()) {
@@ -103,16 +98,41 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		if err := shootstate.Deploy(ctx, r.Clock, r.GardenClient, r.SeedClient, shoot, true); err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed performing periodic ShootState backup: %w", err)
 		}
-		lastBackup = r.Clock.Now()
+
+		if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed fetching ShootState %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+		if lastBackup, err = lastBackupTime(shootState); err != nil {
+			return reconcile.Result{}, err
+		}
 	} else {
 		log.Info("No need to perform periodic ShootState backup yet", "lastBackup", lastBackup.Round(time.Minute), "syncPeriod", r.Config.SyncPeriod.Duration)
 	}
 
+	if r.Config.Backup != nil && !lastBackup.IsZero() {
+		if err := r.backupShootState(ctx, log, shoot, shootState, lastBackup); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed handing over ShootState snapshot for backup bucket: %w", err)
+		}
+	}
+
 	requeueAfter, nextBackup := r.requeueAfter(lastBackup)
 	log.Info("Scheduled next periodic ShootState backup for Shoot", "duration", requeueAfter.Round(time.Minute), "nextBackup", nextBackup.Round(time.Minute))
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
+func lastBackupTime(shootState *gardencorev1beta1.ShootState) (time.Time, error) {
+	v, ok := shootState.Annotations[v1beta1constants.GardenerTimestamp]
+	if !ok {
+		return time.Time{}, nil
+	}
+
+	lastBackup, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed parsing timestamp %q on ShootState object: %w", v, err)
+	}
+	return lastBackup.UTC(), nil
+}
+
 func (r *Reconciler) requeueAfter(lastBackup time.Time) (time.Duration, time.Time) {
 	var (
 		nextRegularBackup = lastBackup.Add(r.Config.SyncPeriod.Duration)
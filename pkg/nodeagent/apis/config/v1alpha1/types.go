@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -53,8 +54,25 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+
+	// EventReasonOSCApplied is the reason for events and conditions on a Node when the operating system configuration
+	// has been applied successfully.
+	EventReasonOSCApplied = "OSCApplied"
+	// EventReasonOSCApplyFailed is the reason for events and conditions on a Node when the application of the operating
+	// system configuration failed and there is no previously applied configuration which could be restored.
+	EventReasonOSCApplyFailed = "OSCApplyFailed"
+	// EventReasonOSCRolledBack is the reason for events and conditions on a Node when the application of the operating
+	// system configuration failed and the last applied configuration has been restored.
+	EventReasonOSCRolledBack = "OSCRolledBack"
+	// EventReasonOSCRollbackFailed is the reason for events and conditions on a Node when the application of the
+	// operating system configuration failed and the last applied configuration could not be restored either.
+	EventReasonOSCRollbackFailed = "OSCRollbackFailed"
 )
 
+// NodeConditionTypeOperatingSystemConfigApplied is a constant for a condition type on a Node describing whether the
+// operating system configuration has been applied successfully.
+const NodeConditionTypeOperatingSystemConfigApplied corev1.NodeConditionType = "OperatingSystemConfigApplied"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NodeAgentConfiguration defines the configuration for the gardener-node-agent.
@@ -78,19 +78,33 @@ type files struct {
 	deleted []extensionsv1alpha1.File
 }
 
-func computeOperatingSystemConfigChanges(fs afero.Afero, newOSC *extensionsv1alpha1.OperatingSystemConfig) (*operatingSystemConfigChanges, error) {
+func readLastAppliedOperatingSystemConfig(fs afero.Afero) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	oscRaw, err := fs.ReadFile(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, oscRaw, osc); err != nil {
+		return nil, fmt.Errorf("unable to decode the old OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	return osc, nil
+}
+
+// computeOperatingSystemConfigChanges computes the changes which have to be applied to the node in order to get from
+// the old to the new OSC. The old OSC is nil if no OSC was applied to the node so far.
+func computeOperatingSystemConfigChanges(oldOSC, newOSC *extensionsv1alpha1.OperatingSystemConfig) *operatingSystemConfigChanges {
 	changes := &operatingSystemConfigChanges{}
 
 	// osc.files and osc.unit.files should be changed the same way by OSC controller.
 	// The reason for assigning files to units is the detection of changes which require the restart of a unit.
 	newOSCFiles := collectAllFiles(newOSC)
 
-	oldOSCRaw, err := fs.ReadFile(lastAppliedOperatingSystemConfigFilePath)
-	if err != nil {
-		if !errors.Is(err, afero.ErrFileNotFound) {
-			return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
-		}
-
+	if oldOSC == nil {
 		var unitChanges []changedUnit
 		for _, unit := range mergeUnits(newOSC.Spec.Units, newOSC.Status.ExtensionUnits) {
 			unitChanges = append(unitChanges, changedUnit{
@@ -101,12 +115,7 @@ func computeOperatingSystemConfigChanges(fs afero.Afero, newOSC *extensionsv1alp
 
 		changes.files.changed = newOSCFiles
 		changes.units.changed = unitChanges
-		return changes, nil
-	}
-
-	oldOSC := &extensionsv1alpha1.OperatingSystemConfig{}
-	if err := runtime.DecodeInto(decoder, oldOSCRaw, oldOSC); err != nil {
-		return nil, fmt.Errorf("unable to decode the old OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+		return changes
 	}
 
 	oldOSCFiles := collectAllFiles(oldOSC)
@@ -120,7 +129,7 @@ func computeOperatingSystemConfigChanges(fs afero.Afero, newOSC *extensionsv1alp
 		changes.files,
 	)
 
-	return changes, nil
+	return changes
 }
 
 func computeUnitDiffs(oldUnits, newUnits []extensionsv1alpha1.Unit, fileDiffs files) units {
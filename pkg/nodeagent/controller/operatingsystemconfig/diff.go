@@ -0,0 +1,170 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// Diff describes the changes which would be performed on the node when applying an operating system config.
+type Diff struct {
+	// ChangedFiles are the paths of the files which would be created or updated.
+	ChangedFiles []string
+	// DeletedFiles are the paths of the files which would be deleted.
+	DeletedFiles []string
+	// ChangedUnits are the units which would be created or updated.
+	ChangedUnits []UnitDiff
+	// DeletedUnits are the names of the units which would be deleted.
+	DeletedUnits []string
+	// Commands are the systemd commands which would be executed (in this order).
+	Commands []string
+}
+
+// UnitDiff describes the changes which would be performed for a single systemd unit.
+type UnitDiff struct {
+	// Name is the name of the unit.
+	Name string
+	// ChangedDropIns are the names of the drop-ins which would be created or updated.
+	ChangedDropIns []string
+	// DeletedDropIns are the names of the drop-ins which would be deleted.
+	DeletedDropIns []string
+}
+
+// ComputeDiff computes the changes which would be performed on the node when applying the operating system config
+// contained in the given secret. It does neither write to the file system nor execute any systemd commands.
+func ComputeDiff(fs afero.Afero, secret *corev1.Secret) (*Diff, error) {
+	osc, _, _, err := extractOSCFromSecret(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed extracting OSC from secret: %w", err)
+	}
+
+	lastAppliedOSC, err := readLastAppliedOperatingSystemConfig(fs)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading the last applied OSC: %w", err)
+	}
+
+	var (
+		changes = computeOperatingSystemConfigChanges(lastAppliedOSC, osc)
+		diff    = &Diff{}
+
+		mustRestartGardenerNodeAgent bool
+		unitCommands                 []string
+	)
+
+	for _, file := range changes.files.changed {
+		diff.ChangedFiles = append(diff.ChangedFiles, file.Path)
+	}
+	for _, file := range changes.files.deleted {
+		diff.DeletedFiles = append(diff.DeletedFiles, file.Path)
+	}
+
+	// The computation of the commands mirrors the order in which the reconciler applies the changes.
+	for _, unit := range changes.units.changed {
+		unitDiff := UnitDiff{Name: unit.Name}
+		for _, dropIn := range unit.dropIns.changed {
+			unitDiff.ChangedDropIns = append(unitDiff.ChangedDropIns, dropIn.Name)
+		}
+		for _, dropIn := range unit.dropIns.deleted {
+			unitDiff.DeletedDropIns = append(unitDiff.DeletedDropIns, dropIn.Name)
+		}
+		diff.ChangedUnits = append(diff.ChangedUnits, unitDiff)
+
+		if mustEnableUnit(unit) {
+			diff.Commands = append(diff.Commands, "systemctl enable "+unit.Name)
+		} else {
+			diff.Commands = append(diff.Commands, "systemctl disable "+unit.Name)
+		}
+
+		switch {
+		case unit.Name == nodeagentv1alpha1.UnitName:
+			mustRestartGardenerNodeAgent = true
+		case mustStopUnit(unit):
+			unitCommands = append(unitCommands, "systemctl stop "+unit.Name)
+		default:
+			unitCommands = append(unitCommands, "systemctl restart "+unit.Name)
+		}
+	}
+
+	for _, unit := range changes.units.deleted {
+		diff.DeletedUnits = append(diff.DeletedUnits, unit.Name)
+
+		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+		unitFileExists, err := fs.Exists(unitFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to check whether unit file %q exists: %w", unitFilePath, err)
+		}
+
+		if unitFileExists {
+			diff.Commands = append(diff.Commands, "systemctl disable "+unit.Name, "systemctl stop "+unit.Name)
+		}
+	}
+
+	diff.Commands = append(diff.Commands, "systemctl daemon-reload")
+	diff.Commands = append(diff.Commands, unitCommands...)
+	if mustRestartGardenerNodeAgent {
+		diff.Commands = append(diff.Commands, "systemctl restart "+nodeagentv1alpha1.UnitName)
+	}
+
+	return diff, nil
+}
+
+// Print writes a human-readable representation of the diff to the given writer.
+func (d *Diff) Print(w io.Writer) error {
+	var out []string
+
+	printList := func(title string, entries []string) {
+		if len(entries) == 0 {
+			return
+		}
+		out = append(out, title+":")
+		for _, entry := range entries {
+			out = append(out, "  "+entry)
+		}
+	}
+
+	printList("Files to be created or updated", d.ChangedFiles)
+	printList("Files to be deleted", d.DeletedFiles)
+
+	if len(d.ChangedUnits) > 0 {
+		out = append(out, "Units to be created or updated:")
+		for _, unit := range d.ChangedUnits {
+			out = append(out, "  "+unit.Name)
+			for _, dropIn := range unit.ChangedDropIns {
+				out = append(out, "    drop-in to be created or updated: "+dropIn)
+			}
+			for _, dropIn := range unit.DeletedDropIns {
+				out = append(out, "    drop-in to be deleted: "+dropIn)
+			}
+		}
+	}
+
+	printList("Units to be deleted", d.DeletedUnits)
+	printList("Commands to be executed", d.Commands)
+
+	for _, line := range out {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
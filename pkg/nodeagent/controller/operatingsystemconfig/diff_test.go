@@ -0,0 +1,174 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+var _ = Describe("Diff", func() {
+	var (
+		fs     afero.Afero
+		osc    *extensionsv1alpha1.OperatingSystemConfig
+		secret *corev1.Secret
+
+		file1, file2 extensionsv1alpha1.File
+		unit1, unit2 extensionsv1alpha1.Unit
+
+		encode = func(osc *extensionsv1alpha1.OperatingSystemConfig) []byte {
+			data, err := json.Marshal(osc)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return data
+		}
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		file1 = extensionsv1alpha1.File{
+			Path:    "/example/file1",
+			Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "file1"}},
+		}
+		file2 = extensionsv1alpha1.File{
+			Path:    "/example/file2",
+			Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "file2"}},
+		}
+		unit1 = extensionsv1alpha1.Unit{
+			Name:    "unit1",
+			Content: pointer.String("#unit1"),
+			DropIns: []extensionsv1alpha1.DropIn{{Name: "drop", Content: "#drop"}},
+		}
+		unit2 = extensionsv1alpha1.Unit{
+			Name:    "unit2",
+			Enable:  pointer.Bool(false),
+			Command: extensionsv1alpha1.UnitCommandPtr(extensionsv1alpha1.CommandStop),
+			Content: pointer.String("#unit2"),
+		}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(), Kind: "OperatingSystemConfig"},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Files: []extensionsv1alpha1.File{file1, file2},
+				Units: []extensionsv1alpha1.Unit{unit1, unit2},
+			},
+		}
+
+		secret = &corev1.Secret{Data: map[string][]byte{"osc.yaml": encode(osc)}}
+	})
+
+	Describe("#ComputeDiff", func() {
+		It("should fail when the secret does not contain an OSC", func() {
+			_, err := ComputeDiff(fs, &corev1.Secret{})
+			Expect(err).To(MatchError(ContainSubstring("no osc.yaml key found in OSC secret")))
+		})
+
+		It("should compute the diff when there is no last applied OSC", func() {
+			diff, err := ComputeDiff(fs, secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(diff).To(Equal(&Diff{
+				ChangedFiles: []string{file1.Path, file2.Path},
+				ChangedUnits: []UnitDiff{
+					{Name: unit1.Name, ChangedDropIns: []string{"drop"}},
+					{Name: unit2.Name},
+				},
+				Commands: []string{
+					"systemctl enable unit1",
+					"systemctl disable unit2",
+					"systemctl daemon-reload",
+					"systemctl restart unit1",
+					"systemctl stop unit2",
+				},
+			}))
+		})
+
+		It("should compute the diff against the last applied OSC", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", encode(osc), 0644)).To(Succeed())
+			Expect(fs.WriteFile("/etc/systemd/system/unit2", []byte("#unit2"), 0600)).To(Succeed())
+
+			osc.Spec.Files = []extensionsv1alpha1.File{file1}
+			osc.Spec.Files[0].Content.Inline = &extensionsv1alpha1.FileContentInline{Data: "changed"}
+			osc.Spec.Units = []extensionsv1alpha1.Unit{unit1, {Name: "gardener-node-agent.service", Content: pointer.String("#gna")}}
+			osc.Spec.Units[0].DropIns = nil
+			secret.Data["osc.yaml"] = encode(osc)
+
+			diff, err := ComputeDiff(fs, secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(diff).To(Equal(&Diff{
+				ChangedFiles: []string{file1.Path},
+				DeletedFiles: []string{file2.Path},
+				ChangedUnits: []UnitDiff{
+					{Name: unit1.Name, DeletedDropIns: []string{"drop"}},
+					{Name: "gardener-node-agent.service"},
+				},
+				DeletedUnits: []string{unit2.Name},
+				Commands: []string{
+					"systemctl enable unit1",
+					"systemctl enable gardener-node-agent.service",
+					"systemctl disable unit2",
+					"systemctl stop unit2",
+					"systemctl daemon-reload",
+					"systemctl restart unit1",
+					"systemctl restart gardener-node-agent.service",
+				},
+			}))
+		})
+
+		It("should not modify the file system", func() {
+			_, err := ComputeDiff(afero.Afero{Fs: afero.NewReadOnlyFs(fs)}, secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			exists, err := fs.Exists(file1.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	Describe("#Print", func() {
+		It("should print the diff", func() {
+			buf := &bytes.Buffer{}
+			Expect((&Diff{
+				ChangedFiles: []string{"/foo"},
+				ChangedUnits: []UnitDiff{{Name: "bar", ChangedDropIns: []string{"baz"}, DeletedDropIns: []string{"qux"}}},
+				DeletedUnits: []string{"quux"},
+				Commands:     []string{"systemctl daemon-reload"},
+			}).Print(buf)).To(Succeed())
+
+			Expect(buf.String()).To(Equal(`Files to be created or updated:
+  /foo
+Units to be created or updated:
+  bar
+    drop-in to be created or updated: baz
+    drop-in to be deleted: qux
+Units to be deleted:
+  quux
+Commands to be executed:
+  systemctl daemon-reload
+`))
+		})
+	})
+})
@@ -23,9 +23,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -52,6 +54,51 @@ import (
 
 const lastAppliedOperatingSystemConfigFilePath = nodeagentv1alpha1.BaseDir + "/last-applied-osc.yaml"
 
+const (
+	objectKindFile = "file"
+	objectKindUnit = "unit"
+)
+
+// applyError is returned when a file or unit could not be applied to the node. It keeps track of the failing object
+// so that it can be reported in the node condition.
+type applyError struct {
+	objectKind string
+	objectName string
+	err        error
+}
+
+func (e *applyError) Error() string {
+	return e.err.Error()
+}
+
+func (e *applyError) Unwrap() error {
+	return e.err
+}
+
+// describeApplyFailure returns a message listing the files and units which could not be applied.
+func describeApplyFailure(err error) string {
+	var (
+		errs     = []error{err}
+		multiErr *multierror.Error
+		messages []string
+	)
+
+	if errors.As(err, &multiErr) {
+		errs = multiErr.Errors
+	}
+
+	for _, e := range errs {
+		var applyErr *applyError
+		if !errors.As(e, &applyErr) {
+			messages = append(messages, e.Error())
+			continue
+		}
+		messages = append(messages, fmt.Sprintf("%s %q: %v", applyErr.objectKind, applyErr.objectName, applyErr.err))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
 // Reconciler decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
 type Reconciler struct {
@@ -93,9 +140,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed extracting OSC from secret: %w", err)
 	}
 
-	oscChanges, err := computeOperatingSystemConfigChanges(r.FS, osc)
+	lastAppliedOSC, err := readLastAppliedOperatingSystemConfig(r.FS)
 	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed calculating the OSC changes: %w", err)
+		return reconcile.Result{}, fmt.Errorf("failed reading the last applied OSC: %w", err)
 	}
 
 	if node != nil && node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
@@ -103,35 +150,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	log.Info("Applying new or changed files")
-	if err := r.applyChangedFiles(ctx, log, oscChanges.files.changed); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed files: %w", err)
-	}
-
-	log.Info("Applying new or changed units")
-	if err := r.applyChangedUnits(ctx, log, oscChanges.units.changed); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
-	}
+	oscChanges := computeOperatingSystemConfigChanges(lastAppliedOSC, osc)
 
-	log.Info("Removing no longer needed units")
-	if err := r.removeDeletedUnits(ctx, log, node, oscChanges.units.deleted); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted units: %w", err)
-	}
-
-	log.Info("Reloading systemd daemon")
-	if err := r.DBus.DaemonReload(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
-	}
-
-	log.Info("Executing unit commands (start/stop)")
-	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, oscChanges.units.changed)
+	mustRestartGardenerNodeAgent, err := r.applyChanges(ctx, log, node, oscChanges)
 	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
-	}
-
-	log.Info("Removing no longer needed files")
-	if err := r.removeDeletedFiles(log, oscChanges.files.deleted); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
+		return reconcile.Result{}, r.rollback(ctx, log, node, lastAppliedOSC, osc, err)
 	}
 
 	log.Info("Successfully applied operating system config",
@@ -165,7 +188,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed removing bootstrap token file %q: %w", nodeagentv1alpha1.BootstrapTokenFilePath, err)
 	}
 
-	r.Recorder.Event(node, corev1.EventTypeNormal, "OSCApplied", "Operating system config has been applied successfully")
+	r.Recorder.Event(node, corev1.EventTypeNormal, nodeagentv1alpha1.EventReasonOSCApplied, "Operating system config has been applied successfully")
+	if err := r.patchNodeCondition(ctx, corev1.ConditionTrue, nodeagentv1alpha1.EventReasonOSCApplied, "Operating system config has been applied successfully"); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
@@ -194,6 +221,130 @@ func (r *Reconciler) getNode(ctx context.Context) (*metav1.PartialObjectMetadata
 	return node, nil
 }
 
+func (r *Reconciler) applyChanges(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, changes *operatingSystemConfigChanges) (bool, error) {
+	log.Info("Applying new or changed files")
+	if err := r.applyChangedFiles(ctx, log, changes.files.changed); err != nil {
+		return false, fmt.Errorf("failed applying changed files: %w", err)
+	}
+
+	log.Info("Applying new or changed units")
+	if err := r.applyChangedUnits(ctx, log, changes.units.changed); err != nil {
+		return false, fmt.Errorf("failed applying changed units: %w", err)
+	}
+
+	log.Info("Removing no longer needed units")
+	if err := r.removeDeletedUnits(ctx, log, node, changes.units.deleted); err != nil {
+		return false, fmt.Errorf("failed removing deleted units: %w", err)
+	}
+
+	log.Info("Reloading systemd daemon")
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return false, fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	log.Info("Executing unit commands (start/stop)")
+	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, changes.units.changed)
+	if err != nil {
+		return false, fmt.Errorf("failed executing unit commands: %w", err)
+	}
+
+	log.Info("Removing no longer needed files")
+	if err := r.removeDeletedFiles(log, changes.files.deleted); err != nil {
+		return false, fmt.Errorf("failed removing deleted files: %w", err)
+	}
+
+	return mustRestartGardenerNodeAgent, nil
+}
+
+// rollback restores the files and units of the last applied OSC after the application of the new OSC has failed, and
+// re-executes the unit commands. This prevents that the node is left with a half-applied configuration. The returned
+// error is the one which should be reported by the reconciler.
+func (r *Reconciler) rollback(
+	ctx context.Context,
+	log logr.Logger,
+	node *metav1.PartialObjectMetadata,
+	lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig,
+	failedOSC *extensionsv1alpha1.OperatingSystemConfig,
+	applyErr error,
+) error {
+	failureDetails := describeApplyFailure(applyErr)
+	applyErr = fmt.Errorf("failed applying operating system config: %w", applyErr)
+
+	if lastAppliedOSC == nil {
+		log.Info("No operating system config was applied to this node so far, nothing to roll back")
+		r.reportApplyFailure(ctx, log, node, nodeagentv1alpha1.EventReasonOSCApplyFailed, fmt.Sprintf("Operating system config could not be applied: %s", failureDetails))
+		return applyErr
+	}
+
+	log.Error(applyErr, "Rolling back to last applied operating system config")
+	// The restart of the gardener-node-agent unit can be ignored here since the running process was not yet restarted
+	// with the failed configuration.
+	if _, err := r.applyChanges(ctx, log, node, computeOperatingSystemConfigChanges(failedOSC, lastAppliedOSC)); err != nil {
+		r.reportApplyFailure(ctx, log, node, nodeagentv1alpha1.EventReasonOSCRollbackFailed, fmt.Sprintf("Operating system config could not be applied (%s), and rolling back to the last applied version failed as well: %s", failureDetails, describeApplyFailure(err)))
+		return fmt.Errorf("%w, rolling back to last applied operating system config failed as well: %w", applyErr, err)
+	}
+
+	log.Info("Successfully rolled back to last applied operating system config")
+	r.reportApplyFailure(ctx, log, node, nodeagentv1alpha1.EventReasonOSCRolledBack, fmt.Sprintf("Operating system config could not be applied and was rolled back to the last applied version: %s", failureDetails))
+	return fmt.Errorf("%w (rolled back to last applied operating system config)", applyErr)
+}
+
+// reportApplyFailure records a warning event and updates the node condition. Errors are only logged since the failed
+// application of the operating system config is the more relevant error to be returned by the reconciler.
+func (r *Reconciler) reportApplyFailure(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, reason, message string) {
+	if node == nil {
+		return
+	}
+
+	r.Recorder.Event(node, corev1.EventTypeWarning, reason, message)
+	if err := r.patchNodeCondition(ctx, corev1.ConditionFalse, reason, message); err != nil {
+		log.Error(err, "Failed updating node condition")
+	}
+}
+
+func (r *Reconciler) patchNodeCondition(ctx context.Context, status corev1.ConditionStatus, reason, message string) error {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: r.NodeName}}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+		return fmt.Errorf("failed reading node %q for updating its %s condition: %w", r.NodeName, nodeagentv1alpha1.NodeConditionTypeOperatingSystemConfigApplied, err)
+	}
+
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+	setNodeCondition(node, nodeagentv1alpha1.NodeConditionTypeOperatingSystemConfigApplied, status, reason, message)
+	if err := r.Client.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching %s condition of node %q: %w", nodeagentv1alpha1.NodeConditionTypeOperatingSystemConfigApplied, r.NodeName, err)
+	}
+
+	return nil
+}
+
+func setNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason, message string) {
+	now := metav1.Now()
+
+	for i, condition := range node.Status.Conditions {
+		if condition.Type != conditionType {
+			continue
+		}
+
+		if condition.Status != status {
+			node.Status.Conditions[i].LastTransitionTime = now
+		}
+		node.Status.Conditions[i].Status = status
+		node.Status.Conditions[i].Reason = reason
+		node.Status.Conditions[i].Message = message
+		node.Status.Conditions[i].LastHeartbeatTime = now
+		return
+	}
+
+	node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastHeartbeatTime:  now,
+		LastTransitionTime: now,
+	})
+}
+
 var (
 	etcSystemdSystem                   = path.Join("/", "etc", "systemd", "system")
 	defaultFilePermissions os.FileMode = 0600
@@ -207,40 +358,48 @@ func (r *Reconciler) applyChangedFiles(ctx context.Context, log logr.Logger, fil
 	defer func() { utilruntime.HandleError(r.FS.RemoveAll(tmpDir)) }()
 
 	for _, file := range files {
-		permissions := defaultFilePermissions
-		if file.Permissions != nil {
-			permissions = fs.FileMode(*file.Permissions)
+		if err := r.applyChangedFile(ctx, log, tmpDir, file); err != nil {
+			return &applyError{objectKind: objectKindFile, objectName: file.Path, err: err}
 		}
+	}
 
-		switch {
-		case file.Content.Inline != nil:
-			if err := r.FS.MkdirAll(filepath.Dir(file.Path), fs.ModeDir); err != nil {
-				return fmt.Errorf("unable to create directory %q: %w", file.Path, err)
-			}
+	return nil
+}
 
-			data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
-			if err != nil {
-				return fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
-			}
+func (r *Reconciler) applyChangedFile(ctx context.Context, log logr.Logger, tmpDir string, file extensionsv1alpha1.File) error {
+	permissions := defaultFilePermissions
+	if file.Permissions != nil {
+		permissions = fs.FileMode(*file.Permissions)
+	}
 
-			tmpFilePath := filepath.Join(tmpDir, filepath.Base(file.Path))
-			if err := r.FS.WriteFile(tmpFilePath, data, permissions); err != nil {
-				return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
-			}
+	switch {
+	case file.Content.Inline != nil:
+		if err := r.FS.MkdirAll(filepath.Dir(file.Path), fs.ModeDir); err != nil {
+			return fmt.Errorf("unable to create directory %q: %w", file.Path, err)
+		}
 
-			if err := r.FS.Rename(tmpFilePath, file.Path); err != nil {
-				return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err)
-			}
+		data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+		if err != nil {
+			return fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
+		}
+
+		tmpFilePath := filepath.Join(tmpDir, filepath.Base(file.Path))
+		if err := r.FS.WriteFile(tmpFilePath, data, permissions); err != nil {
+			return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
+		}
 
-			log.Info("Successfully applied new or changed file", "path", file.Path)
+		if err := r.FS.Rename(tmpFilePath, file.Path); err != nil {
+			return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err)
+		}
 
-		case file.Content.ImageRef != nil:
-			if err := r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, permissions); err != nil {
-				return fmt.Errorf("unable to copy file %q from image %q to %q: %w", file.Content.ImageRef.FilePathInImage, file.Content.ImageRef.Image, file.Path, err)
-			}
+		log.Info("Successfully applied new or changed file", "path", file.Path)
 
-			log.Info("Successfully applied new or changed file from image", "path", file.Path, "image", file.Content.ImageRef.Image)
+	case file.Content.ImageRef != nil:
+		if err := r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, permissions); err != nil {
+			return fmt.Errorf("unable to copy file %q from image %q to %q: %w", file.Content.ImageRef.FilePathInImage, file.Content.ImageRef.Image, file.Path, err)
 		}
+
+		log.Info("Successfully applied new or changed file from image", "path", file.Path, "image", file.Content.ImageRef.Image)
 	}
 
 	return nil
@@ -249,7 +408,7 @@ func (r *Reconciler) applyChangedFiles(ctx context.Context, log logr.Logger, fil
 func (r *Reconciler) removeDeletedFiles(log logr.Logger, files []extensionsv1alpha1.File) error {
 	for _, file := range files {
 		if err := r.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-			return fmt.Errorf("unable to delete no longer needed file %q: %w", file.Path, err)
+			return &applyError{objectKind: objectKindFile, objectName: file.Path, err: fmt.Errorf("unable to delete no longer needed file %q: %w", file.Path, err)}
 		}
 
 		log.Info("Successfully removed no longer needed file", "path", file.Path)
@@ -260,116 +419,132 @@ func (r *Reconciler) removeDeletedFiles(log logr.Logger, files []extensionsv1alp
 
 func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, units []changedUnit) error {
 	for _, unit := range units {
-		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+		if err := r.applyChangedUnit(ctx, log, unit); err != nil {
+			return &applyError{objectKind: objectKindUnit, objectName: unit.Name, err: err}
+		}
+	}
 
-		if unit.Content != nil {
-			oldUnitContent, err := r.FS.ReadFile(unitFilePath)
-			if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-				return fmt.Errorf("unable to read existing unit file %q for %q: %w", unitFilePath, unit.Name, err)
-			}
+	return nil
+}
 
-			newUnitContent := []byte(*unit.Content)
-			if !bytes.Equal(newUnitContent, oldUnitContent) {
-				if err := r.FS.WriteFile(unitFilePath, newUnitContent, defaultFilePermissions); err != nil {
-					return fmt.Errorf("unable to write unit file %q for %q: %w", unitFilePath, unit.Name, err)
-				}
-				log.Info("Successfully applied new or changed unit file", "path", unitFilePath)
-			}
+func (r *Reconciler) applyChangedUnit(ctx context.Context, log logr.Logger, unit changedUnit) error {
+	unitFilePath := path.Join(etcSystemdSystem, unit.Name)
 
-			// ensure file permissions are restored in case somebody changed them manually
-			if err := r.FS.Chmod(unitFilePath, defaultFilePermissions); err != nil {
-				return fmt.Errorf("unable to ensure permissions for unit file %q for %q: %w", unitFilePath, unit.Name, err)
+	if unit.Content != nil {
+		oldUnitContent, err := r.FS.ReadFile(unitFilePath)
+		if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("unable to read existing unit file %q for %q: %w", unitFilePath, unit.Name, err)
+		}
+
+		newUnitContent := []byte(*unit.Content)
+		if !bytes.Equal(newUnitContent, oldUnitContent) {
+			if err := r.FS.WriteFile(unitFilePath, newUnitContent, defaultFilePermissions); err != nil {
+				return fmt.Errorf("unable to write unit file %q for %q: %w", unitFilePath, unit.Name, err)
 			}
+			log.Info("Successfully applied new or changed unit file", "path", unitFilePath)
 		}
 
-		dropInDirectory := unitFilePath + ".d"
+		// ensure file permissions are restored in case somebody changed them manually
+		if err := r.FS.Chmod(unitFilePath, defaultFilePermissions); err != nil {
+			return fmt.Errorf("unable to ensure permissions for unit file %q for %q: %w", unitFilePath, unit.Name, err)
+		}
+	}
 
-		if len(unit.DropIns) == 0 {
-			if err := r.FS.RemoveAll(dropInDirectory); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-				return fmt.Errorf("unable to delete systemd drop-in folder for unit %q: %w", unit.Name, err)
-			}
-		} else {
-			if err := r.FS.MkdirAll(dropInDirectory, fs.ModeDir); err != nil {
-				return fmt.Errorf("unable to create drop-in directory %q for unit %q: %w", dropInDirectory, unit.Name, err)
-			}
+	dropInDirectory := unitFilePath + ".d"
 
-			for _, dropIn := range unit.dropIns.changed {
-				dropInFilePath := path.Join(dropInDirectory, dropIn.Name)
+	if len(unit.DropIns) == 0 {
+		if err := r.FS.RemoveAll(dropInDirectory); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("unable to delete systemd drop-in folder for unit %q: %w", unit.Name, err)
+		}
+	} else {
+		if err := r.FS.MkdirAll(dropInDirectory, fs.ModeDir); err != nil {
+			return fmt.Errorf("unable to create drop-in directory %q for unit %q: %w", dropInDirectory, unit.Name, err)
+		}
 
-				oldDropInContent, err := r.FS.ReadFile(dropInFilePath)
-				if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-					return fmt.Errorf("unable to read existing drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
-				}
+		for _, dropIn := range unit.dropIns.changed {
+			dropInFilePath := path.Join(dropInDirectory, dropIn.Name)
 
-				newDropInContent := []byte(dropIn.Content)
-				if !bytes.Equal(newDropInContent, oldDropInContent) {
-					if err := r.FS.WriteFile(dropInFilePath, newDropInContent, defaultFilePermissions); err != nil {
-						return fmt.Errorf("unable to write drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
-					}
-					log.Info("Successfully applied new or changed drop-in file for unit", "path", dropInFilePath, "unit", unit.Name)
-				}
+			oldDropInContent, err := r.FS.ReadFile(dropInFilePath)
+			if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("unable to read existing drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
+			}
 
-				// ensure file permissions are restored in case somebody changed them manually
-				if err := r.FS.Chmod(dropInFilePath, defaultFilePermissions); err != nil {
-					return fmt.Errorf("unable to ensure permissions for drop-in file %q for unit %q: %w", unitFilePath, unit.Name, err)
+			newDropInContent := []byte(dropIn.Content)
+			if !bytes.Equal(newDropInContent, oldDropInContent) {
+				if err := r.FS.WriteFile(dropInFilePath, newDropInContent, defaultFilePermissions); err != nil {
+					return fmt.Errorf("unable to write drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
 				}
+				log.Info("Successfully applied new or changed drop-in file for unit", "path", dropInFilePath, "unit", unit.Name)
 			}
 
-			for _, dropIn := range unit.dropIns.deleted {
-				dropInFilePath := path.Join(dropInDirectory, dropIn.Name)
-				if err := r.FS.Remove(dropInFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-					return fmt.Errorf("unable to delete drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
-				}
-				log.Info("Successfully removed no longer needed drop-in file for unit", "path", dropInFilePath, "unitName", unit.Name)
+			// ensure file permissions are restored in case somebody changed them manually
+			if err := r.FS.Chmod(dropInFilePath, defaultFilePermissions); err != nil {
+				return fmt.Errorf("unable to ensure permissions for drop-in file %q for unit %q: %w", unitFilePath, unit.Name, err)
 			}
 		}
 
-		if unit.Name == nodeagentv1alpha1.UnitName || pointer.BoolDeref(unit.Enable, true) {
-			if err := r.DBus.Enable(ctx, unit.Name); err != nil {
-				return fmt.Errorf("unable to enable unit %q: %w", unit.Name, err)
+		for _, dropIn := range unit.dropIns.deleted {
+			dropInFilePath := path.Join(dropInDirectory, dropIn.Name)
+			if err := r.FS.Remove(dropInFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("unable to delete drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
 			}
-			log.Info("Successfully enabled unit", "unitName", unit.Name)
-		} else {
-			if err := r.DBus.Disable(ctx, unit.Name); err != nil {
-				return fmt.Errorf("unable to disable unit %q: %w", unit.Name, err)
-			}
-			log.Info("Successfully disabled unit", "unitName", unit.Name)
+			log.Info("Successfully removed no longer needed drop-in file for unit", "path", dropInFilePath, "unitName", unit.Name)
 		}
 	}
 
+	if mustEnableUnit(unit) {
+		if err := r.DBus.Enable(ctx, unit.Name); err != nil {
+			return fmt.Errorf("unable to enable unit %q: %w", unit.Name, err)
+		}
+		log.Info("Successfully enabled unit", "unitName", unit.Name)
+	} else {
+		if err := r.DBus.Disable(ctx, unit.Name); err != nil {
+			return fmt.Errorf("unable to disable unit %q: %w", unit.Name, err)
+		}
+		log.Info("Successfully disabled unit", "unitName", unit.Name)
+	}
+
 	return nil
 }
 
 func (r *Reconciler) removeDeletedUnits(ctx context.Context, log logr.Logger, node client.Object, units []extensionsv1alpha1.Unit) error {
 	for _, unit := range units {
-		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
-
-		unitFileExists, err := r.fileExists(unitFilePath)
-		if err != nil {
-			return fmt.Errorf("unable to check whether unit file %q exists: %w", unitFilePath, err)
+		if err := r.removeDeletedUnit(ctx, log, node, unit); err != nil {
+			return &applyError{objectKind: objectKindUnit, objectName: unit.Name, err: err}
 		}
+	}
 
-		if unitFileExists {
-			if err := r.DBus.Disable(ctx, unit.Name); err != nil {
-				return fmt.Errorf("unable to disable deleted unit %q: %w", unit.Name, err)
-			}
+	return nil
+}
 
-			if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
-				return fmt.Errorf("unable to stop deleted unit %q: %w", unit.Name, err)
-			}
+func (r *Reconciler) removeDeletedUnit(ctx context.Context, log logr.Logger, node client.Object, unit extensionsv1alpha1.Unit) error {
+	unitFilePath := path.Join(etcSystemdSystem, unit.Name)
 
-			if err := r.FS.Remove(unitFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-				return fmt.Errorf("unable to delete systemd unit file of deleted unit %q: %w", unit.Name, err)
-			}
+	unitFileExists, err := r.fileExists(unitFilePath)
+	if err != nil {
+		return fmt.Errorf("unable to check whether unit file %q exists: %w", unitFilePath, err)
+	}
+
+	if unitFileExists {
+		if err := r.DBus.Disable(ctx, unit.Name); err != nil {
+			return fmt.Errorf("unable to disable deleted unit %q: %w", unit.Name, err)
 		}
 
-		if err := r.FS.RemoveAll(unitFilePath + ".d"); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-			return fmt.Errorf("unable to delete systemd drop-in folder of deleted unit %q: %w", unit.Name, err)
+		if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
+			return fmt.Errorf("unable to stop deleted unit %q: %w", unit.Name, err)
 		}
 
-		log.Info("Successfully removed no longer needed unit", "unitName", unit.Name)
+		if err := r.FS.Remove(unitFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("unable to delete systemd unit file of deleted unit %q: %w", unit.Name, err)
+		}
 	}
 
+	if err := r.FS.RemoveAll(unitFilePath + ".d"); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("unable to delete systemd drop-in folder of deleted unit %q: %w", unit.Name, err)
+	}
+
+	log.Info("Successfully removed no longer needed unit", "unitName", unit.Name)
+
 	return nil
 }
 
@@ -388,14 +563,14 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 		}
 
 		fns = append(fns, func(ctx context.Context) error {
-			if !pointer.BoolDeref(unit.Enable, true) || (unit.Command != nil && *unit.Command == extensionsv1alpha1.CommandStop) {
+			if mustStopUnit(unit) {
 				if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
-					return fmt.Errorf("unable to stop unit %q: %w", unit.Name, err)
+					return &applyError{objectKind: objectKindUnit, objectName: unit.Name, err: fmt.Errorf("unable to stop unit %q: %w", unit.Name, err)}
 				}
 				log.Info("Successfully stopped unit", "unitName", unit.Name)
 			} else {
 				if err := r.DBus.Restart(ctx, r.Recorder, node, unit.Name); err != nil {
-					return fmt.Errorf("unable to restart unit %q: %w", unit.Name, err)
+					return &applyError{objectKind: objectKindUnit, objectName: unit.Name, err: fmt.Errorf("unable to restart unit %q: %w", unit.Name, err)}
 				}
 				log.Info("Successfully restarted unit", "unitName", unit.Name)
 			}
@@ -407,6 +582,14 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 	return mustRestartGardenerNodeAgent, flow.Parallel(fns...)(ctx)
 }
 
+func mustEnableUnit(unit changedUnit) bool {
+	return unit.Name == nodeagentv1alpha1.UnitName || pointer.BoolDeref(unit.Enable, true)
+}
+
+func mustStopUnit(unit changedUnit) bool {
+	return !pointer.BoolDeref(unit.Enable, true) || (unit.Command != nil && *unit.Command == extensionsv1alpha1.CommandStop)
+}
+
 func (r *Reconciler) fileExists(path string) (bool, error) {
 	if _, err := r.FS.Stat(path); err != nil {
 		if errors.Is(err, afero.ErrFileNotFound) {
@@ -54,7 +54,8 @@ type SystemdAction struct {
 type DBus struct {
 	Actions []SystemdAction
 
-	mutex sync.Mutex
+	mutex                 sync.Mutex
+	injectedRestartErrors map[string]error
 }
 
 var _ dbus.DBus = &DBus{}
@@ -100,6 +101,17 @@ func (d *DBus) Enable(_ context.Context, unitNames ...string) error {
 	return nil
 }
 
+// InjectRestartError makes subsequent restarts of the unit with the given name fail with the given error.
+func (d *DBus) InjectRestartError(unitName string, err error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.injectedRestartErrors == nil {
+		d.injectedRestartErrors = make(map[string]error)
+	}
+	d.injectedRestartErrors[unitName] = err
+}
+
 // Restart implements dbus.DBus.
 func (d *DBus) Restart(_ context.Context, _ record.EventRecorder, _ runtime.Object, unitName string) error {
 	d.mutex.Lock()
@@ -109,7 +121,7 @@ func (d *DBus) Restart(_ context.Context, _ record.EventRecorder, _ runtime.Obje
 		Action:    ActionRestart,
 		UnitNames: []string{unitName},
 	})
-	return nil
+	return d.injectedRestartErrors[unitName]
 }
 
 // Reboot implements dbus.DBus.
@@ -47,6 +47,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting":                                   schema_pkg_apis_core_v1beta1_Alerting(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig":                                schema_pkg_apis_core_v1beta1_AuditConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy":                                schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Authorization":                              schema_pkg_apis_core_v1beta1_Authorization(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizationWebhook":                       schema_pkg_apis_core_v1beta1_AuthorizationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizerKubeconfigReference":              schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone":                           schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucket":                               schema_pkg_apis_core_v1beta1_BackupBucket(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketList":                           schema_pkg_apis_core_v1beta1_BackupBucketList(ref),
@@ -61,6 +64,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI":                                        schema_pkg_apis_core_v1beta1_CRI(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfile":                               schema_pkg_apis_core_v1beta1_CloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileList":                           schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference":                      schema_pkg_apis_core_v1beta1_CloudProfileReference(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec":                           schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler":                          schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                                  schema_pkg_apis_core_v1beta1_Condition(ref),
@@ -81,6 +85,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS":                                    schema_pkg_apis_core_v1beta1_CoreDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling":                         schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting":                           schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialExpiration":                       schema_pkg_apis_core_v1beta1_CredentialExpiration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialsRotationPolicy":                  schema_pkg_apis_core_v1beta1_CredentialsRotationPolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS":                                        schema_pkg_apis_core_v1beta1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude":                          schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider":                                schema_pkg_apis_core_v1beta1_DNSProvider(ref),
@@ -88,6 +94,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DeploymentRef":                              schema_pkg_apis_core_v1beta1_DeploymentRef(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation":                  schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig":                           schema_pkg_apis_core_v1beta1_EncryptionConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionProvider":                         schema_pkg_apis_core_v1beta1_EncryptionProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExpirableVersion":                           schema_pkg_apis_core_v1beta1_ExpirableVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClass":                              schema_pkg_apis_core_v1beta1_ExposureClass(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassList":                          schema_pkg_apis_core_v1beta1_ExposureClassList(ref),
@@ -129,10 +136,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                         schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                schema_pkg_apis_core_v1beta1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                      schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceBlackout":                        schema_pkg_apis_core_v1beta1_MaintenanceBlackout(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceCredentialsRotation":             schema_pkg_apis_core_v1beta1_MaintenanceCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeRange":                       schema_pkg_apis_core_v1beta1_MaintenanceTimeRange(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                      schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                    schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                 schema_pkg_apis_core_v1beta1_Monitoring(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference":                     schema_pkg_apis_core_v1beta1_NamedResourceReference(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfile":                     schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileList":                 schema_pkg_apis_core_v1beta1_NamespacedCloudProfileList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec":                 schema_pkg_apis_core_v1beta1_NamespacedCloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileStatus":               schema_pkg_apis_core_v1beta1_NamespacedCloudProfileStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking":                                 schema_pkg_apis_core_v1beta1_Networking(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress":                               schema_pkg_apis_core_v1beta1_NginxIngress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS":                               schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
@@ -147,8 +161,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations":                         schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider":                                   schema_pkg_apis_core_v1beta1_Provider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota":                                      schema_pkg_apis_core_v1beta1_Quota(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaConsumer":                              schema_pkg_apis_core_v1beta1_QuotaConsumer(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                  schema_pkg_apis_core_v1beta1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                                  schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus":                                schema_pkg_apis_core_v1beta1_QuotaStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsage":                                 schema_pkg_apis_core_v1beta1_QuotaUsage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                     schema_pkg_apis_core_v1beta1_Region(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                               schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                     schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
@@ -198,6 +215,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec":                             schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus":                                schema_pkg_apis_core_v1beta1_ShootStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate":                              schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication":                   schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization":                    schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents":                           schema_pkg_apis_core_v1beta1_SystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration":                                 schema_pkg_apis_core_v1beta1_Toleration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                      schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
@@ -209,8 +228,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionExtensionRequest":             schema_pkg_apis_operations_v1alpha1_BastionExtensionRequest(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionExtensionRequestSpec":         schema_pkg_apis_operations_v1alpha1_BastionExtensionRequestSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionExtensionRequestStatus":       schema_pkg_apis_operations_v1alpha1_BastionExtensionRequestStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy":                schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionList":                         schema_pkg_apis_operations_v1alpha1_BastionList(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSession":                      schema_pkg_apis_operations_v1alpha1_BastionSession(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                         schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                       schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet":                       schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref),
@@ -991,6 +1014,100 @@ func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_Authorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Authorization contains settings related to authorization for the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook contains settings related to an authorization webhook. It is consulted after the Node and RBAC authorizers, i.e., only for requests which were not already allowed by them.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizationWebhook"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizationWebhook"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuthorizationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthorizationWebhook contains settings related to an authorization webhook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubeconfigSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeconfigSecretName is the name of a secret in the project namespace which contains the kubeconfig for the authorization webhook under the data key `kubeconfig`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cacheAuthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheAuthorizedTTL is the duration to cache 'authorized' responses from the webhook authorizer.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cacheUnauthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheUnauthorizedTTL is the duration to cache 'unauthorized' responses from the webhook authorizer.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the API version of the authorization.k8s.io SubjectAccessReview to send to and expect from the webhook. Possible values are `v1beta1` and `v1`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kubeconfigSecretName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthorizerKubeconfigReference is a reference for a kubeconfig for an authorization webhook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"authorizerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthorizerName is the name of a webhook authorizer in the AuthorizationConfiguration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the project namespace which contains the kubeconfig for the authorization webhook under the data key `kubeconfig`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"authorizerName", "secretName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AvailabilityZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1204,7 +1321,7 @@ func schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref common.ReferenceCallback)
 					},
 					"seedName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SeedName holds the name of the seed allocated to BackupBucket for running controller. This field is immutable.",
+							Description: "SeedName holds the name of the seed allocated to BackupBucket for running controller. If it is not set, the gardener-scheduler assigns a seed. This field is immutable once set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1622,6 +1739,36 @@ func schema_pkg_apis_core_v1beta1_CloudProfileList(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_CloudProfileReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudProfileReference holds the information about a CloudProfile or a NamespacedCloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind contains a CloudProfile kind, i.e., either `CloudProfile` or `NamespacedCloudProfile`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name contains the name of the referenced CloudProfile or NamespacedCloudProfile.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2622,6 +2769,68 @@ func schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_CredentialExpiration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CredentialExpiration contains information about the expiration of a certificate authority or certificate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the credential.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the credential.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTime is the time when the credential expires.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "type", "expirationTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CredentialsRotationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CredentialsRotationPolicy is a policy for automatically rotating credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the minimum duration between the initiation of two rotations. Once it has passed since the last initiation (or the creation of the shoot), the rotation is triggered in the next maintenance time window. For two-phase rotations, the completion is triggered in the subsequent maintenance time window.",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"interval"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2891,10 +3100,48 @@ func schema_pkg_apis_core_v1beta1_EncryptionConfig(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider contains information about the encryption provider which is used for the encryption of resources in etcd. If not set, a static AES-CBC key managed by Gardener is used. See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionProvider"),
+						},
+					},
 				},
 				Required: []string{"resources"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionProvider"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EncryptionProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EncryptionProvider contains information about the encryption provider of the API server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the encryption provider. The type `aescbc` denotes a static AES-CBC key managed by Gardener. Any other value denotes a KMS v2 plugin which is deployed as a sidecar of the kube-apiserver by the extension of the same type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to the extension of the KMS v2 plugin.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3279,6 +3526,22 @@ func schema_pkg_apis_core_v1beta1_GardenerResourceData(ref common.ReferenceCallb
 							},
 						},
 					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are annotations of the object",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "type", "data"},
 			},
@@ -3763,11 +4026,29 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig"),
 						},
 					},
+					"structuredAuthentication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StructuredAuthentication contains configuration settings for structured authentication for the kube-apiserver. This field is only available for Kubernetes v1.29 or later and cannot be combined with `oidcConfig`.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication"),
+						},
+					},
+					"authorization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Authorization contains settings related to authorization for the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Authorization"),
+						},
+					},
+					"structuredAuthorization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StructuredAuthorization contains configuration settings for structured authorization for the kube-apiserver. This field is only available for Kubernetes v1.29 or later and cannot be combined with `authorization.webhook`.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Authorization", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -4960,11 +5241,60 @@ func schema_pkg_apis_core_v1beta1_Maintenance(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"credentialsRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsRotation contains policies for automatically rotating the shoot credentials during the maintenance time window.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceCredentialsRotation"),
+						},
+					},
+					"timeWindowWeekdays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeWindowWeekdays restricts the time window to the given days of the week (Mon, Tue, Wed, Thu, Fri, Sat, Sun). The day is determined by the beginning of the time window in its time zone. If not present, the time window applies to every day.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"additionalTimeRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalTimeRanges are further time ranges in which maintenance operations may be performed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeRange"),
+									},
+								},
+							},
+						},
+					},
+					"blackouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blackouts are periods in which no maintenance operations are performed, even if they overlap with the time window or one of the additional time ranges (e.g., an end-of-quarter freeze).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceBlackout"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceBlackout", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceCredentialsRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeRange", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
 	}
 }
 
@@ -4997,27 +5327,154 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_MaintenanceBlackout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
+				Description: "MaintenanceBlackout is a period in which no maintenance operations are performed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"begin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Begin is the beginning of the blackout period.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "End is the end of the blackout period.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is an optional description of why maintenance operations are not allowed during this period.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceCredentialsRotation contains policies for automatically rotating the shoot credentials during the maintenance time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities is the rotation policy for the certificate authorities.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialsRotationPolicy"),
+						},
+					},
+					"serviceAccountKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountKey is the rotation policy for the service account signing key.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialsRotationPolicy"),
+						},
+					},
+					"etcdEncryptionKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDEncryptionKey is the rotation policy for the ETCD encryption key.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialsRotationPolicy"),
+						},
+					},
+					"sshKeypair": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeypair is the rotation policy for the ssh-keypair.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialsRotationPolicy"),
+						},
+					},
+					"observability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Observability is the rotation policy for the observability credentials.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialsRotationPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialsRotationPolicy"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceTimeRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceTimeRange is a further time range in which maintenance operations may be performed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the beginning of the time range in the format HHMMSS+ZONE, e.g. \"220000+0100\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of the time range in the format HHMMSS+ZONE, e.g. \"220000+0100\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weekdays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weekdays restricts the time range to the given days of the week (Mon, Tue, Wed, Thu, Fri, Sat, Sun). The day is determined by the beginning of the time range in its time zone. If not present, the time range applies to every day.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -5099,6 +5556,232 @@ func schema_pkg_apis_core_v1beta1_NamedResourceReference(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedCloudProfile represents certain properties about a provider environment which are only available in the namespace of a project. It extends the CloudProfile it references as its parent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the provider environment properties.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the NamespacedCloudProfile.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamespacedCloudProfileList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedCloudProfileList is a collection of NamespacedCloudProfiles.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of NamespacedCloudProfiles.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfile"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfile", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamespacedCloudProfileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedCloudProfileSpec is the specification of a NamespacedCloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains overrides of the expiration dates of Kubernetes versions of the parent CloudProfile.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings"),
+						},
+					},
+					"machineImages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains additional machine images and versions as well as overrides of the expiration dates of machine image versions of the parent CloudProfile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage"),
+									},
+								},
+							},
+						},
+					},
+					"machineTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains additional machine types which are not part of the parent CloudProfile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType"),
+									},
+								},
+							},
+						},
+					},
+					"volumeTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeTypes contains additional volume types which are not part of the parent CloudProfile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType"),
+									},
+								},
+							},
+						},
+					},
+					"parent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parent contains a reference to the CloudProfile which is extended by this NamespacedCloudProfile.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference"),
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig contains provider-specific configuration which is merged into the provider-specific configuration of the parent CloudProfile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"parent"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamespacedCloudProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedCloudProfileStatus holds the most recently observed status of the NamespacedCloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cloudProfileSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudProfileSpec is the effective specification of the NamespacedCloudProfile, i.e., the specification of the parent CloudProfile merged with the specification of the NamespacedCloudProfile.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this NamespacedCloudProfile.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5142,7 +5825,7 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 					},
 					"ipFamilies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable. See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md. Defaults to [\"IPv4\"].",
+							Description: "IPFamilies specifies the IP protocol versions to use for shoot networking. The first IP family is the primary one, the networks configured in the fields above belong to it. This field is immutable, however, a second IP family can be added to single-stack shoots. See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md. Defaults to [\"IPv4\"].",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5155,6 +5838,27 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"secondaryPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecondaryPods is the CIDR of the pod network of the secondary IP family in case of dual-stack networking. This field is immutable once it is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secondaryNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecondaryNodes is the CIDR of the node network of the secondary IP family in case of dual-stack networking. This field is immutable once it is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secondaryServices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecondaryServices is the CIDR of the service network of the secondary IP family in case of dual-stack networking. This field is immutable once it is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5866,11 +6570,48 @@ func schema_pkg_apis_core_v1beta1_Quota(ref common.ReferenceCallback) common.Ope
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the current usage of the Quota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_QuotaConsumer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaConsumer is a shoot which consumes a Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
 	}
 }
 
@@ -5890,58 +6631,160 @@ func schema_pkg_apis_core_v1beta1_QuotaList(ref common.ReferenceCallback) common
 					},
 					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Quotas.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaSpec is the specification of a Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterLifetimeDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics is a list of resources which will be put under constraints.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"softMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoftMetrics is a list of resources with soft limits. Exceeding a soft limit does not deny the request but results in a warning and an event for the project.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"metrics", "scope"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_QuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaStatus is the status of a Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Quota.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"metadata": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "LastUpdateTime is the last time the usage of the Quota was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"usage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Quotas.",
+							Description: "Usage is the current usage of the Quota. Quotas with 'secret' scope have exactly one entry for all consumers. The limits of quotas with 'project' scope apply per project, hence, there is one entry per project namespace.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsage"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsage", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_QuotaUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "QuotaSpec is the specification of a Quota.",
+				Description: "QuotaUsage is the usage of a Quota by the shoots of a project or of all projects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterLifetimeDays": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Namespace is the project namespace the usage belongs to. It is only set for quotas with 'project' scope.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"metrics": {
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metrics is a list of resources which will be put under constraints.",
+							Description: "Used is the amount of resources which is allocated by the consumers for each metric constrained by the Quota.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5954,19 +6797,25 @@ func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
-					"scope": {
+					"consumers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+							Description: "Consumers is the list of shoots which consume the Quota.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaConsumer"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"metrics", "scope"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaConsumer", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -7540,11 +8389,25 @@ func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback)
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"),
 						},
 					},
+					"expirations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expirations contains the expiration dates of the certificate authorities and certificates managed for the shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialExpiration"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CredentialExpiration", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"},
 	}
 }
 
@@ -7942,12 +8805,18 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"cloudProfile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudProfile contains a reference to a CloudProfile or a NamespacedCloudProfile. If it refers to a NamespacedCloudProfile, its parent must be the CloudProfile referenced by CloudProfileName.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference"),
+						},
+					},
 				},
 				Required: []string{"cloudProfileName", "kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
 	}
 }
 
@@ -8292,12 +9161,18 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"encryptionProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionProvider is the encryption provider which is currently used for the encryption of resources in etcd. It is only updated to the provider configured in the specification once all resources have been re-encrypted, see https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionProvider"),
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8330,6 +9205,74 @@ func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StructuredAuthentication contains authentication config for kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthenticationConfiguration for the kube-apiserver.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"configMapName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StructuredAuthorization contains authorization config for kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthorizationConfiguration for the kube-apiserver. It must only contain authorizers of type `Webhook`, the Node and RBAC authorizers are always prepended by Gardener.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubeconfigs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"authorizerName",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubeconfigs is a list of references for kubeconfigs for the authorization webhooks. Each webhook authorizer in the AuthorizationConfiguration must be referenced here.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizerKubeconfigReference"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"configMapName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizerKubeconfigReference"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8902,6 +9845,103 @@ func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_operations_v1alpha1_BastionExtensionRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionExtensionRequest can be used to extend the session of a Bastion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the BastionExtensionRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionExtensionRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the BastionExtensionRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionExtensionRequestStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionExtensionRequestSpec", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionExtensionRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionExtensionRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionExtensionRequestSpec contains the duration by which the session of the Bastion shall be extended.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the duration by which the ExpirationTimestamp of the Bastion is advanced.",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionExtensionRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionExtensionRequestStatus is the status of the BastionExtensionRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the new expiration time of the Bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8976,6 +10016,51 @@ func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_operations_v1alpha1_BastionSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionSession is an entry in the session history of a Bastion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action which was performed on the session of the Bastion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the name of the user who performed the action.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is the time when the action was performed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the expiration time of the Bastion after the action was performed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"action", "user", "timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9026,12 +10111,18 @@ func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"sessionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionDuration is the requested duration of the bastion session. If it is set, the ExpirationTimestamp is not advanced by heartbeats anymore, but the session has to be extended explicitly via the `extend` subresource. This field is immutable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"shootRef", "sshPublicKey", "ingress"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -9087,11 +10178,32 @@ func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallb
 							Format:      "int64",
 						},
 					},
+					"sessions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sessions is the history of actions which were performed on the session of the Bastion.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSession"),
+									},
+								},
+							},
+						},
+					},
+					"recordedSessions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordedSessions is the number of entries in Sessions for which an event has already been recorded for the project of the Bastion.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "k8s.io/api/core/v1.LoadBalancerIngress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSession", "k8s.io/api/core/v1.LoadBalancerIngress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
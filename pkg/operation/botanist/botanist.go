@@ -28,6 +28,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/etcd"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/operation"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -64,6 +65,11 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		}
 	}
 
+	o.SecretsDataStore, err = gardenlethelper.SecretsManagerDataStore(b.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating external data store for secrets manager: %w", err)
+	}
+
 	o.SecretsManager, err = secretsmanager.New(
 		ctx,
 		b.Logger.WithName("secretsmanager"),
@@ -74,6 +80,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		secretsmanager.Config{
 			CASecretAutoRotation: false,
 			SecretNamesToTimes:   b.lastSecretRotationStartTimes(),
+			DataStore:            o.SecretsDataStore,
 		},
 	)
 	if err != nil {
@@ -54,6 +54,13 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 		KubernetesVersion:               b.Shoot.KubernetesVersion,
 	}
 
+	if b.Shoot.Networks.SecondaryCoreDNS != nil {
+		values.SecondaryClusterIP = pointer.String(b.Shoot.Networks.SecondaryCoreDNS.String())
+	}
+	if b.Shoot.Networks.SecondaryPods != nil {
+		values.SecondaryPodNetworkCIDR = pointer.String(b.Shoot.Networks.SecondaryPods.String())
+	}
+
 	if b.ShootUsesDNS() {
 		values.APIServerHost = pointer.String(b.outOfClusterAPIServerFQDN())
 	}
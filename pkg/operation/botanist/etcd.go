@@ -16,6 +16,7 @@ package botanist
 
 import (
 	"context"
+	"time"
 
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -33,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
@@ -40,9 +42,16 @@ import (
 // NewEtcd is a function exposed for testing.
 var NewEtcd = etcd.New
 
+// hvpaBlackoutLookahead is the duration before a blackout period in which the scale-down of etcd is already disabled. It
+// covers a full week so that the next reconciliation (at the latest in the next maintenance time window) can re-enable
+// it. The update mode only changes when a blackout period enters this lookahead or ends, not on every reconciliation.
+const hvpaBlackoutLookahead = 8 * 24 * time.Hour
+
 // DefaultEtcd returns a deployer for the etcd.
 func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, error) {
-	defragmentationSchedule, err := determineDefragmentationSchedule(b.Shoot.GetInfo(), b.ManagedSeed, class)
+	now := b.Clock.Now()
+
+	defragmentationSchedule, err := determineDefragmentationSchedule(b.Shoot.GetInfo(), b.ManagedSeed, class, now)
 	if err != nil {
 		return nil, err
 	}
@@ -79,19 +88,27 @@ func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, e
 	e.SetHVPAConfig(&etcd.HVPAConfig{
 		Enabled:               hvpaEnabled,
 		MaintenanceTimeWindow: *b.Shoot.GetInfo().Spec.Maintenance.TimeWindow,
-		ScaleDownUpdateMode:   getScaleDownUpdateMode(class, b.Shoot),
+		ScaleDownUpdateMode:   getScaleDownUpdateMode(class, b.Shoot, now),
 	})
 
 	return e, nil
 }
 
-func getScaleDownUpdateMode(c etcd.Class, s *shoot.Shoot) *string {
+func getScaleDownUpdateMode(c etcd.Class, s *shoot.Shoot, now time.Time) *string {
 	if c == etcd.ClassImportant && (s.Purpose == gardencorev1beta1.ShootPurposeProduction || s.Purpose == gardencorev1beta1.ShootPurposeInfrastructure) {
 		return pointer.String(hvpav1alpha1.UpdateModeOff)
 	}
 	if metav1.HasAnnotation(s.GetInfo().ObjectMeta, v1beta1constants.ShootAlphaControlPlaneScaleDownDisabled) {
 		return pointer.String(hvpav1alpha1.UpdateModeOff)
 	}
+	// HVPA only supports a daily maintenance time window, hence, scaling down is disabled if the time window is restricted
+	// to certain weekdays or if a blackout period is active or about to begin.
+	if maintenance := s.GetInfo().Spec.Maintenance; maintenance != nil && len(maintenance.TimeWindowWeekdays) > 0 {
+		return pointer.String(hvpav1alpha1.UpdateModeOff)
+	}
+	if gardenerutils.EffectiveShootMaintenanceSchedule(s.GetInfo()).HasBlackoutBetween(now, now.Add(hvpaBlackoutLookahead)) {
+		return pointer.String(hvpav1alpha1.UpdateModeOff)
+	}
 	return pointer.String(hvpav1alpha1.UpdateModeMaintenanceWindow)
 }
 
@@ -262,21 +279,37 @@ func determineBackupSchedule(shoot *gardencorev1beta1.Shoot) (string, error) {
 	)
 }
 
-func determineDefragmentationSchedule(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, class etcd.Class) (string, error) {
+func determineDefragmentationSchedule(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, class etcd.Class, now time.Time) (string, error) {
 	scheduleFormat := "%d %d */3 * *"
 	if managedSeed != nil && class == etcd.ClassImportant {
 		// defrag important etcds of ManagedSeeds daily in the maintenance window
 		scheduleFormat = "%d %d * * *"
 	}
 
-	return timewindow.DetermineSchedule(
+	mutate := timewindow.RandomizeWithinTimeWindow
+	if weekdays := shoot.Spec.Maintenance.TimeWindowWeekdays; len(weekdays) > 0 {
+		// only defrag on the days of the week on which the maintenance time window applies
+		maintenanceRange, err := timewindow.ParseMaintenanceRange(shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End, weekdays)
+		if err != nil {
+			return "", err
+		}
+		mutate = timewindow.RandomizeWithinMaintenanceRange(maintenanceRange)
+	}
+
+	schedule, err := timewindow.DetermineSchedule(
 		scheduleFormat,
 		shoot.Spec.Maintenance.TimeWindow.Begin,
 		shoot.Spec.Maintenance.TimeWindow.End,
 		shoot.Status.UID,
 		shoot.CreationTimestamp,
-		timewindow.RandomizeWithinTimeWindow,
+		mutate,
 	)
+	if err != nil {
+		return "", err
+	}
+
+	// do not defrag during blackout periods
+	return timewindow.ScheduleOutsideBlackouts(schedule, gardenerutils.EffectiveShootMaintenanceSchedule(shoot).Blackouts(), now)
 }
 
 func getEtcdReplicas(shoot *gardencorev1beta1.Shoot) int32 {
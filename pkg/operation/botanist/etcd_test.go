@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -100,6 +101,8 @@ var _ = Describe("Etcd", func() {
 		var hvpaEnabled = true
 
 		BeforeEach(func() {
+			// 2023-10-16 is a Monday.
+			botanist.Clock = testclock.NewFakeClock(time.Date(2023, 10, 16, 10, 0, 0, 0, time.UTC))
 			botanist.SecretsManager = sm
 			botanist.SeedClientSet = kubernetesClient
 			botanist.Seed = &seedpkg.Seed{}
@@ -113,7 +116,10 @@ var _ = Describe("Etcd", func() {
 						Version: "1.25.2",
 					},
 					Maintenance: &gardencorev1beta1.Maintenance{
-						TimeWindow: &maintenanceTimeWindow,
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: maintenanceTimeWindow.Begin,
+							End:   maintenanceTimeWindow.End,
+						},
 					},
 				},
 			})
@@ -242,6 +248,71 @@ var _ = Describe("Etcd", func() {
 			})
 		})
 
+		Context("weekdays and blackouts", func() {
+			var validator *newEtcdValidator
+
+			BeforeEach(func() {
+				botanist.ManagedSeed = nil
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, hvpaEnabled))
+
+				validator = &newEtcdValidator{
+					expectedClient:          Equal(c),
+					expectedLogger:          BeAssignableToTypeOf(logr.Logger{}),
+					expectedNamespace:       Equal(namespace),
+					expectedSecretsManager:  Equal(sm),
+					expectedRole:            Equal(role),
+					expectedClass:           Equal(class),
+					expectedReplicas:        PointTo(Equal(int32(1))),
+					expectedStorageCapacity: Equal("10Gi"),
+					expectedHVPAConfig: Equal(&etcd.HVPAConfig{
+						Enabled:               hvpaEnabled,
+						MaintenanceTimeWindow: maintenanceTimeWindow,
+						ScaleDownUpdateMode:   pointer.String(hvpav1alpha1.UpdateModeOff),
+					}),
+					expectedHighAvailabilityEnabled: Equal(false),
+				}
+				DeferCleanup(test.WithVar(&NewEtcd, validator.NewEtcd))
+			})
+
+			It("should restrict the defragmentation to the weekdays of the time window and disable scale-down", func() {
+				botanist.Shoot.GetInfo().Spec.Maintenance.TimeWindowWeekdays = []string{"Mon", "Thu"}
+				validator.expectedDefragmentationSchedule = Equal(pointer.String("34 12 * * 1,4"))
+
+				etcd, err := botanist.DefaultEtcd(role, class)
+				Expect(etcd).NotTo(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not defragment during an active blackout and disable scale-down", func() {
+				botanist.Shoot.GetInfo().Spec.Maintenance.Blackouts = []gardencorev1beta1.MaintenanceBlackout{{
+					Begin: metav1.Date(2023, 10, 15, 0, 0, 0, 0, time.UTC),
+					End:   metav1.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC),
+				}}
+				validator.expectedDefragmentationSchedule = Equal(pointer.String("34 12 1,4,7,10,13,22,25,28,31 * *"))
+
+				etcd, err := botanist.DefaultEtcd(role, class)
+				Expect(etcd).NotTo(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not change the defragmentation schedule and scale-down for distant blackouts", func() {
+				botanist.Shoot.GetInfo().Spec.Maintenance.Blackouts = []gardencorev1beta1.MaintenanceBlackout{{
+					Begin: metav1.Date(2023, 12, 20, 0, 0, 0, 0, time.UTC),
+					End:   metav1.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				}}
+				validator.expectedDefragmentationSchedule = Equal(pointer.String("34 12 */3 * *"))
+				validator.expectedHVPAConfig = Equal(&etcd.HVPAConfig{
+					Enabled:               hvpaEnabled,
+					MaintenanceTimeWindow: maintenanceTimeWindow,
+					ScaleDownUpdateMode:   pointer.String(hvpav1alpha1.UpdateModeMaintenanceWindow),
+				})
+
+				etcd, err := botanist.DefaultEtcd(role, class)
+				Expect(etcd).NotTo(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		It("should return an error because the maintenance time window cannot be parsed", func() {
 			defer test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, true)()
 			botanist.Shoot.GetInfo().Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
@@ -285,7 +356,10 @@ var _ = Describe("Etcd", func() {
 			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
 				Spec: gardencorev1beta1.ShootSpec{
 					Maintenance: &gardencorev1beta1.Maintenance{
-						TimeWindow: &maintenanceTimeWindow,
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: maintenanceTimeWindow.Begin,
+							End:   maintenanceTimeWindow.End,
+						},
 					},
 				},
 				Status: gardencorev1beta1.ShootStatus{
@@ -24,6 +24,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/extension"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // DefaultExtension creates the default deployer for the Extension custom resources.
@@ -33,7 +34,7 @@ func (b *Botanist) DefaultExtension(ctx context.Context) (extension.Interface, e
 		return nil, err
 	}
 
-	extensions, err := mergeExtensions(controllerRegistrations.Items, b.Shoot.GetInfo().Spec.Extensions, b.Shoot.SeedNamespace, b.Shoot.IsWorkerless)
+	extensions, err := mergeExtensions(controllerRegistrations.Items, append(gardenerutils.GetEncryptionProviderExtensions(b.Shoot.GetInfo()), b.Shoot.GetInfo().Spec.Extensions...), b.Shoot.SeedNamespace, b.Shoot.IsWorkerless)
 	if err != nil {
 		return nil, fmt.Errorf("cannot calculate required extensions for shoot %s: %w", b.Shoot.GetInfo().Name, err)
 	}
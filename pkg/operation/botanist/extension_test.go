@@ -328,6 +328,35 @@ var _ = Describe("Extensions", func() {
 				BeEmpty(),
 			),
 		)
+
+		It("should add the extension of the KMS encryption provider", func() {
+			botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				EncryptionConfig: &gardencorev1beta1.EncryptionConfig{
+					Provider: &gardencorev1beta1.EncryptionProvider{Type: fooExtensionType, ProviderConfig: &providerConfig},
+				},
+			}
+
+			gardenClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerRegistrationList{})).DoAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				(&gardencorev1beta1.ControllerRegistrationList{Items: []gardencorev1beta1.ControllerRegistration{fooRegistration}}).DeepCopyInto(list.(*gardencorev1beta1.ControllerRegistrationList))
+				return nil
+			})
+
+			ext, err := botanist.DefaultExtension(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ext.Extensions()).To(HaveKeyWithValue(
+				Equal(fooExtensionType),
+				MatchFields(IgnoreExtras, Fields{
+					"Extension": MatchFields(IgnoreExtras, Fields{
+						"Spec": MatchFields(IgnoreExtras, Fields{
+							"DefaultSpec": MatchAllFields(Fields{
+								"Type":           Equal(fooExtensionType),
+								"ProviderConfig": PointTo(Equal(providerConfig)),
+							}),
+						}),
+					}),
+				}),
+			))
+		})
 	})
 
 	Describe("#DeployExtensions", func() {
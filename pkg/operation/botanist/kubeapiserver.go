@@ -18,10 +18,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -40,9 +44,10 @@ import (
 // DefaultKubeAPIServer returns a deployer for the kube-apiserver.
 func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Interface, error) {
 	var (
-		pods, services string
-		nodes          *string
-		vpnConfig      = kubeapiserver.VPNConfig{
+		pods, services    string
+		nodes             *string
+		secondaryServices *string
+		vpnConfig         = kubeapiserver.VPNConfig{
 			Enabled: false,
 		}
 	)
@@ -54,6 +59,9 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		if b.Shoot.Networks.Services != nil {
 			services = b.Shoot.Networks.Services.String()
 		}
+		if b.Shoot.Networks.SecondaryServices != nil {
+			secondaryServices = pointer.String(b.Shoot.Networks.SecondaryServices.String())
+		}
 	}
 
 	if b.Shoot.GetInfo().Spec.Networking != nil {
@@ -69,6 +77,11 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		vpnConfig.HighAvailabilityNumberOfShootClients = b.Shoot.VPNHighAvailabilityNumberOfShootClients
 	}
 
+	authorizationWebhookConfig, err := b.computeKubeAPIServerAuthorizationWebhookConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return shared.NewKubeAPIServer(
 		ctx,
 		b.SeedClientSet,
@@ -82,18 +95,53 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer,
 		b.computeKubeAPIServerAutoscalingConfig(),
 		services,
+		secondaryServices,
 		vpnConfig,
 		v1beta1constants.PriorityClassNameShootControlPlane500,
 		b.Shoot.IsWorkerless,
 		b.Shoot.GetInfo().Spec.Kubernetes.EnableStaticTokenKubeconfig,
 		nil,
 		nil,
-		nil,
+		authorizationWebhookConfig,
 		nil,
 		features.DefaultFeatureGate.Enabled(features.APIServerFastRollout),
 	)
 }
 
+func (b *Botanist) computeKubeAPIServerAuthorizationWebhookConfig(ctx context.Context) (*kubeapiserver.AuthorizationWebhook, error) {
+	apiServerConfig := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer
+	if apiServerConfig == nil || apiServerConfig.Authorization == nil || apiServerConfig.Authorization.Webhook == nil {
+		return nil, nil
+	}
+	config := apiServerConfig.Authorization.Webhook
+
+	key := client.ObjectKey{Namespace: b.Shoot.GetInfo().Namespace, Name: config.KubeconfigSecretName}
+	kubeconfig, err := gardenerutils.FetchKubeconfigFromSecret(ctx, b.GardenClient, key)
+	if err != nil {
+		// Ignore a missing secret on cluster deletion to prevent failing redeployments of the API server in case the
+		// end-user deleted the secret before/simultaneously to the deletion.
+		if apierrors.IsNotFound(err) && b.Shoot.GetInfo().DeletionTimestamp != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading kubeconfig for authorization webhook from referenced secret %s: %w", key, err)
+	}
+
+	var cacheAuthorizedTTL, cacheUnauthorizedTTL *time.Duration
+	if config.CacheAuthorizedTTL != nil {
+		cacheAuthorizedTTL = &config.CacheAuthorizedTTL.Duration
+	}
+	if config.CacheUnauthorizedTTL != nil {
+		cacheUnauthorizedTTL = &config.CacheUnauthorizedTTL.Duration
+	}
+
+	return &kubeapiserver.AuthorizationWebhook{
+		Kubeconfig:           kubeconfig,
+		CacheAuthorizedTTL:   cacheAuthorizedTTL,
+		CacheUnauthorizedTTL: cacheUnauthorizedTTL,
+		Version:              config.Version,
+	}, nil
+}
+
 func (b *Botanist) computeKubeAPIServerAutoscalingConfig() apiserver.AutoscalingConfig {
 	var (
 		hvpaEnabled               = features.DefaultFeatureGate.Enabled(features.HVPA)
@@ -247,6 +295,8 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context) error {
 		externalServer,
 		b.Shoot.ResourcesToEncrypt,
 		b.Shoot.EncryptedResources,
+		shared.GetEncryptionProviderType(b.Shoot.EncryptionProvider),
+		shared.GetEncryptionProviderType(b.Shoot.ActiveEncryptionProvider),
 		v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(b.Shoot.GetInfo().Status.Credentials),
 		v1beta1helper.GetShootServiceAccountKeyRotationPhase(b.Shoot.GetInfo().Status.Credentials),
 		b.Shoot.HibernationEnabled,
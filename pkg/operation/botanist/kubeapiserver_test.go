@@ -17,6 +17,7 @@ package botanist
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -368,6 +369,71 @@ var _ = Describe("KubeAPIServer", func() {
 				),
 			)
 		})
+
+		Describe("AuthorizationWebhook", func() {
+			BeforeEach(func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+					Authorization: &gardencorev1beta1.Authorization{
+						Webhook: &gardencorev1beta1.AuthorizationWebhook{
+							KubeconfigSecretName: "authz-webhook",
+							CacheAuthorizedTTL:   &metav1.Duration{Duration: time.Minute},
+							Version:              pointer.String("v1"),
+						},
+					},
+				}
+				botanist.Shoot.SetInfo(shoot)
+			})
+
+			It("should not configure an authorization webhook if none is specified", func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Kubernetes.KubeAPIServer = nil
+				botanist.Shoot.SetInfo(shoot)
+
+				kubeAPIServer, err := botanist.DefaultKubeAPIServer(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().AuthorizationWebhook).To(BeNil())
+			})
+
+			It("should configure the authorization webhook with the kubeconfig from the referenced secret", func() {
+				Expect(gardenClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "authz-webhook", Namespace: projectNamespace},
+					Data:       map[string][]byte{"kubeconfig": []byte("some-kubeconfig")},
+				})).To(Succeed())
+
+				kubeAPIServer, err := botanist.DefaultKubeAPIServer(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().AuthorizationWebhook).To(Equal(&kubeapiserver.AuthorizationWebhook{
+					Kubeconfig:         []byte("some-kubeconfig"),
+					CacheAuthorizedTTL: pointer.Duration(time.Minute),
+					Version:            pointer.String("v1"),
+				}))
+			})
+
+			It("should fail if the referenced secret does not exist", func() {
+				_, err := botanist.DefaultKubeAPIServer(ctx)
+				Expect(err).To(MatchError(ContainSubstring("failed reading kubeconfig for authorization webhook")))
+			})
+
+			It("should fail if the referenced secret does not contain a kubeconfig", func() {
+				Expect(gardenClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "authz-webhook", Namespace: projectNamespace},
+				})).To(Succeed())
+
+				_, err := botanist.DefaultKubeAPIServer(ctx)
+				Expect(err).To(MatchError(ContainSubstring("the secret's field 'kubeconfig' is either not present or empty")))
+			})
+
+			It("should ignore a missing secret if the shoot is being deleted", func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				botanist.Shoot.SetInfo(shoot)
+
+				kubeAPIServer, err := botanist.DefaultKubeAPIServer(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().AuthorizationWebhook).To(BeNil())
+			})
+		})
 	})
 
 	DescribeTable("#resourcesRequirementsForKubeAPIServer",
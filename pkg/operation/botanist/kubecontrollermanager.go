@@ -41,10 +41,12 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		scaleDownUpdateMode = hvpav1alpha1.UpdateModeOff
 	}
 
-	var services, pods *net.IPNet
+	var services, pods, secondaryServices, secondaryPods *net.IPNet
 	if b.Shoot.Networks != nil {
 		services = b.Shoot.Networks.Services
 		pods = b.Shoot.Networks.Pods
+		secondaryServices = b.Shoot.Networks.SecondaryServices
+		secondaryPods = b.Shoot.Networks.SecondaryPods
 	}
 
 	return shared.NewKubeControllerManager(
@@ -64,6 +66,8 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		},
 		pods,
 		services,
+		secondaryPods,
+		secondaryServices,
 		nil,
 		kubecontrollermanager.ControllerWorkers{},
 		kubecontrollermanager.ControllerSyncPeriods{},
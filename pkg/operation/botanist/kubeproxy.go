@@ -23,7 +23,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
-	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/imagevector"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -46,6 +45,11 @@ func (b *Botanist) DefaultKubeProxy() (kubeproxy.Interface, error) {
 		featureGates = kubeProxyConfig.FeatureGates
 	}
 
+	podNetworkCIDR := b.Shoot.Networks.Pods.String()
+	if b.Shoot.Networks.SecondaryPods != nil {
+		podNetworkCIDR += "," + b.Shoot.Networks.SecondaryPods.String()
+	}
+
 	return kubeproxy.New(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
@@ -53,7 +57,7 @@ func (b *Botanist) DefaultKubeProxy() (kubeproxy.Interface, error) {
 			IPVSEnabled:    b.Shoot.IPVSEnabled(),
 			FeatureGates:   featureGates,
 			ImageAlpine:    imageAlpine.String(),
-			PodNetworkCIDR: pointer.String(b.Shoot.Networks.Pods.String()),
+			PodNetworkCIDR: &podNetworkCIDR,
 			VPAEnabled:     b.Shoot.WantsVerticalPodAutoscaler,
 			PSPDisabled:    b.Shoot.PSPDisabled,
 		},
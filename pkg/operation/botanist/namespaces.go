@@ -39,6 +39,7 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/namespaces"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
 
@@ -267,5 +268,9 @@ func (b *Botanist) getShootRequiredExtensionTypes(ctx context.Context) (sets.Set
 		}
 	}
 
+	for _, extension := range gardenerutils.GetEncryptionProviderExtensions(b.Shoot.GetInfo()) {
+		types.Insert(extension.Type)
+	}
+
 	return types, nil
 }
@@ -237,6 +237,24 @@ var _ = Describe("Namespaces", func() {
 			))
 		})
 
+		It("should successfully deploy the namespace with the extension labels of KMS encryption providers", func() {
+			defaultShootInfo.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				EncryptionConfig: &gardencorev1beta1.EncryptionConfig{
+					Provider: &gardencorev1beta1.EncryptionProvider{Type: extensionType1},
+				},
+			}
+			defaultShootInfo.Status.EncryptionProvider = &gardencorev1beta1.EncryptionProvider{Type: extensionType2}
+			botanist.Shoot.SetInfo(defaultShootInfo)
+
+			Expect(botanist.DeploySeedNamespace(ctx)).To(Succeed())
+
+			defaultExpectations("", 1)
+			Expect(botanist.SeedNamespaceObject.Labels).To(And(
+				HaveKeyWithValue("extensions.gardener.cloud/"+extensionType1, "true"),
+				HaveKeyWithValue("extensions.gardener.cloud/"+extensionType2, "true"),
+			))
+		})
+
 		It("should successfully deploy the namespace when failure tolerance type is zone", func() {
 			defaultShootInfo.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{
 				HighAvailability: &gardencorev1beta1.HighAvailability{
@@ -40,6 +40,9 @@ func (b *Botanist) DefaultNetwork() component.DeployMigrateWaiter {
 			ProviderConfig: b.Shoot.GetInfo().Spec.Networking.ProviderConfig,
 			PodCIDR:        b.Shoot.Networks.Pods,
 			ServiceCIDR:    b.Shoot.Networks.Services,
+
+			SecondaryPodCIDR:     b.Shoot.Networks.SecondaryPods,
+			SecondaryServiceCIDR: b.Shoot.Networks.SecondaryServices,
 		},
 		network.DefaultInterval,
 		network.DefaultSevereThreshold,
@@ -70,6 +70,17 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 	return flow.Sequential(taskFns...)(ctx)
 }
 
+// DeleteSecretsDataStoreEntries deletes the data of all secrets in the shoot namespace in the seed which is persisted
+// in the external data store of the secrets manager (if configured). It must only be called when the shoot is deleted
+// since the data is still needed for restoring the secrets after a migration.
+func (b *Botanist) DeleteSecretsDataStoreEntries(ctx context.Context) error {
+	if b.SecretsDataStore == nil {
+		return nil
+	}
+
+	return b.SecretsDataStore.DeleteAll(ctx, b.Shoot.SeedNamespace)
+}
+
 func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 	rotation := make(map[string]time.Time)
 
@@ -126,10 +137,13 @@ func (b *Botanist) restoreSecretsFromShootStateForSecretsManagerAdoption(ctx con
 		}
 
 		fns = append(fns, func(ctx context.Context) error {
+			// The ShootState does not contain data which is stored in an external data store but only the annotations
+			// referencing it, i.e., the data is still loaded from the data store when it is needed.
 			objectMeta := metav1.ObjectMeta{
-				Name:      entry.Name,
-				Namespace: b.Shoot.SeedNamespace,
-				Labels:    entry.Labels,
+				Name:        entry.Name,
+				Namespace:   b.Shoot.SeedNamespace,
+				Labels:      entry.Labels,
+				Annotations: entry.Annotations,
 			}
 
 			data := make(map[string][]byte)
@@ -179,6 +193,12 @@ func (b *Botanist) caCertGenerateOptionsFor(configName string) []secretsmanager.
 		options = append(options, secretsmanager.IgnoreOldSecrets())
 	}
 
+	// The private keys of the client and kubelet CAs are mounted into the kube-controller-manager for signing
+	// certificates, hence they must remain in the secrets.
+	if configName != v1beta1constants.SecretNameCAClient && configName != v1beta1constants.SecretNameCAKubelet {
+		options = append(options, secretsmanager.StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+	}
+
 	if configName == v1beta1constants.SecretNameCAClient {
 		return options
 	}
@@ -108,6 +108,29 @@ var _ = Describe("Secrets", func() {
 		botanist.Shoot.SetShootState(&gardencorev1beta1.ShootState{})
 	})
 
+	Describe("#DeleteSecretsDataStoreEntries", func() {
+		It("should do nothing if no data store is configured", func() {
+			Expect(botanist.DeleteSecretsDataStoreEntries(ctx)).To(Succeed())
+		})
+
+		It("should delete the data of all secrets in the shoot namespace", func() {
+			data := map[string][]byte{"ca.key": []byte("private-key")}
+
+			botanist.SecretsDataStore = secretsmanager.NewFileDataStore(GinkgoT().TempDir())
+			Expect(botanist.SecretsDataStore.Write(ctx, seedNamespace, "ca", data)).To(Succeed())
+			Expect(botanist.SecretsDataStore.Write(ctx, seedNamespace, "ca-etcd", data)).To(Succeed())
+			Expect(botanist.SecretsDataStore.Write(ctx, "shoot--foo--other", "ca", data)).To(Succeed())
+
+			Expect(botanist.DeleteSecretsDataStoreEntries(ctx)).To(Succeed())
+
+			_, err := botanist.SecretsDataStore.Read(ctx, seedNamespace, "ca")
+			Expect(err).To(HaveOccurred())
+			_, err = botanist.SecretsDataStore.Read(ctx, seedNamespace, "ca-etcd")
+			Expect(err).To(HaveOccurred())
+			Expect(botanist.SecretsDataStore.Read(ctx, "shoot--foo--other", "ca")).To(Equal(data))
+		})
+	})
+
 	Describe("#InitializeSecretsManagement", func() {
 		Context("when shoot is not in restoration phase", func() {
 			It("should generate the certificate authorities and sync cluster and client CA to the garden", func() {
@@ -247,10 +270,11 @@ var _ = Describe("Secrets", func() {
 								Data:   runtime.RawExtension{Raw: rawData("data-for", "ca-etcd")},
 							},
 							{
-								Name:   "non-ca-secret",
-								Type:   "secret",
-								Labels: map[string]string{"managed-by": "secrets-manager", "manager-identity": fakesecretsmanager.ManagerIdentity},
-								Data:   runtime.RawExtension{Raw: rawData("data-for", "non-ca-secret")},
+								Name:        "non-ca-secret",
+								Type:        "secret",
+								Labels:      map[string]string{"managed-by": "secrets-manager", "manager-identity": fakesecretsmanager.ManagerIdentity},
+								Annotations: map[string]string{"external-data-keys": "key"},
+								Data:        runtime.RawExtension{Raw: rawData("data-for", "non-ca-secret")},
 							},
 							{
 								Name:   "extension-foo-secret",
@@ -290,6 +314,7 @@ var _ = Describe("Secrets", func() {
 				secret := &corev1.Secret{}
 				Expect(seedClient.Get(ctx, kubernetesutils.Key(seedNamespace, "non-ca-secret"), secret)).To(Succeed())
 				Expect(secret.Labels).To(Equal(map[string]string{"managed-by": "secrets-manager", "manager-identity": fakesecretsmanager.ManagerIdentity}))
+				Expect(secret.Annotations).To(Equal(map[string]string{"external-data-keys": "key"}))
 				Expect(secret.Data).To(Equal(map[string][]byte{"data-for": []byte(secret.Name)}))
 
 				By("Verify external secrets got restored")
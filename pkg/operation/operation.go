@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -73,9 +74,16 @@ func NewBuilder() *Builder {
 		shootFunc: func(context.Context, client.Reader, *garden.Garden, *seed.Seed) (*shootpkg.Shoot, error) {
 			return nil, fmt.Errorf("shoot object is required but not set")
 		},
+		clock: clock.RealClock{},
 	}
 }
 
+// WithClock sets the clock attribute at the Builder.
+func (b *Builder) WithClock(clock clock.Clock) *Builder {
+	b.clock = clock
+	return b
+}
+
 // WithConfig sets the configFunc attribute at the Builder.
 func (b *Builder) WithConfig(cfg *config.GardenletConfiguration) *Builder {
 	b.configFunc = func() (*config.GardenletConfiguration, error) { return cfg, nil }
@@ -191,6 +199,7 @@ func (b *Builder) Build(
 		GardenClient:   gardenClient,
 		SeedClientSet:  seedClientSet,
 		ShootClientMap: shootClientMap,
+		Clock:          b.clock,
 	}
 
 	config, err := b.configFunc()
@@ -47,7 +47,7 @@ func NewBuilder() *Builder {
 		shootObjectFunc: func(context.Context) (*gardencorev1beta1.Shoot, error) {
 			return nil, fmt.Errorf("shoot object is required but not set")
 		},
-		cloudProfileFunc: func(context.Context, string) (*gardencorev1beta1.CloudProfile, error) {
+		cloudProfileFunc: func(context.Context, *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error) {
 			return nil, fmt.Errorf("cloudprofile object is required but not set")
 		},
 		shootSecretFunc: func(context.Context, string, string) (*corev1.Secret, error) {
@@ -76,23 +76,24 @@ func (b *Builder) WithShootObjectFromCluster(seedClient kubernetes.Interface, sh
 
 // WithCloudProfileObject sets the cloudProfileFunc attribute at the Builder.
 func (b *Builder) WithCloudProfileObject(cloudProfileObject *gardencorev1beta1.CloudProfile) *Builder {
-	b.cloudProfileFunc = func(context.Context, string) (*gardencorev1beta1.CloudProfile, error) { return cloudProfileObject, nil }
+	b.cloudProfileFunc = func(context.Context, *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error) {
+		return cloudProfileObject, nil
+	}
 	return b
 }
 
-// WithCloudProfileObjectFrom sets the cloudProfileFunc attribute at the Builder after fetching it from the
-// given reader.
+// WithCloudProfileObjectFrom sets the cloudProfileFunc attribute at the Builder after fetching the effective
+// CloudProfile of the shoot from the given reader.
 func (b *Builder) WithCloudProfileObjectFrom(reader client.Reader) *Builder {
-	b.cloudProfileFunc = func(ctx context.Context, name string) (*gardencorev1beta1.CloudProfile, error) {
-		obj := &gardencorev1beta1.CloudProfile{}
-		return obj, reader.Get(ctx, kubernetesutils.Key(name), obj)
+	b.cloudProfileFunc = func(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error) {
+		return gardenerutils.GetCloudProfile(ctx, reader, shoot)
 	}
 	return b
 }
 
 // WithCloudProfileObjectFromCluster sets the cloudProfileFunc attribute at the Builder.
 func (b *Builder) WithCloudProfileObjectFromCluster(seedClient kubernetes.Interface, shootNamespace string) *Builder {
-	b.cloudProfileFunc = func(ctx context.Context, _ string) (*gardencorev1beta1.CloudProfile, error) {
+	b.cloudProfileFunc = func(ctx context.Context, _ *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error) {
 		cluster, err := gardenerextensions.GetCluster(ctx, seedClient.Client(), shootNamespace)
 		if err != nil {
 			return nil, err
@@ -166,7 +167,7 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	}
 	shoot.SetInfo(shootObject)
 
-	cloudProfile, err := b.cloudProfileFunc(ctx, shootObject.Spec.CloudProfileName)
+	cloudProfile, err := b.cloudProfileFunc(ctx, shootObject)
 	if err != nil {
 		return nil, err
 	}
@@ -247,10 +248,12 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 
 	if shoot.GetInfo().Spec.Kubernetes.KubeAPIServer != nil {
 		shoot.ResourcesToEncrypt = sharedcomponent.NormalizeResources(sharedcomponent.GetResourcesForEncryptionFromConfig(shoot.GetInfo().Spec.Kubernetes.KubeAPIServer.EncryptionConfig))
+		shoot.EncryptionProvider = sharedcomponent.GetEncryptionProviderFromConfig(shoot.GetInfo().Spec.Kubernetes.KubeAPIServer.EncryptionConfig)
 	}
 	if len(shoot.GetInfo().Status.EncryptedResources) > 0 {
 		shoot.EncryptedResources = sharedcomponent.NormalizeResources(shoot.GetInfo().Status.EncryptedResources)
 	}
+	shoot.ActiveEncryptionProvider = shoot.GetInfo().Status.EncryptionProvider
 
 	if b.seed == nil {
 		return nil, fmt.Errorf("seed object is required but not set")
@@ -517,10 +520,31 @@ func ToNetworks(s *gardencorev1beta1.Shoot, workerless bool) (*Networks, error)
 		return nil, fmt.Errorf("cannot calculate CoreDNS ClusterIP: %w", err)
 	}
 
-	return &Networks{
+	networks := &Networks{
 		CoreDNS:   coreDNS,
 		Pods:      pods,
 		Services:  svc,
 		APIServer: apiserver,
-	}, nil
+	}
+
+	if s.Spec.Networking.SecondaryPods != nil {
+		_, networks.SecondaryPods, err = net.ParseCIDR(*s.Spec.Networking.SecondaryPods)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse shoot's secondary pods cidr %w", err)
+		}
+	}
+
+	if s.Spec.Networking.SecondaryServices != nil {
+		_, networks.SecondaryServices, err = net.ParseCIDR(*s.Spec.Networking.SecondaryServices)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse shoot's secondary service cidr %w", err)
+		}
+
+		networks.SecondaryCoreDNS, err = utils.ComputeOffsetIP(networks.SecondaryServices, 10)
+		if err != nil {
+			return nil, fmt.Errorf("cannot calculate secondary CoreDNS ClusterIP: %w", err)
+		}
+	}
+
+	return networks, nil
 }
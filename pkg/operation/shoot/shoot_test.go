@@ -84,6 +84,17 @@ var _ = Describe("shoot", func() {
 				})))
 			})
 
+			It("returns correct network (dual-stack Shoot)", func() {
+				shoot.Spec.Networking.SecondaryPods = pointer.String("2001:db8:1::/48")
+				shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:2::/108")
+				result, err := ToNetworks(shoot, false)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.SecondaryPods.String()).To(Equal("2001:db8:1::/48"))
+				Expect(result.SecondaryServices.String()).To(Equal("2001:db8:2::/108"))
+				Expect(result.SecondaryCoreDNS.String()).To(Equal("2001:db8:2::a"))
+			})
+
 			It("returns err if serviceCIDR is nil (workerless Shoot)", func() {
 				shoot.Spec.Networking.Pods = nil
 				shoot.Spec.Networking.Services = nil
@@ -113,6 +124,12 @@ var _ = Describe("shoot", func() {
 				Entry("coreDNS cannot be calculated", func(s *gardencorev1beta1.Shoot) {
 					s.Spec.Networking.Services = pointer.String("10.0.0.0/29")
 				}),
+				Entry("secondary pods is invalid", func(s *gardencorev1beta1.Shoot) {
+					s.Spec.Networking.SecondaryPods = pointer.String("foo")
+				}),
+				Entry("secondary services is invalid", func(s *gardencorev1beta1.Shoot) {
+					s.Spec.Networking.SecondaryServices = pointer.String("foo")
+				}),
 			)
 		})
 
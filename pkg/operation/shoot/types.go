@@ -64,7 +64,7 @@ import (
 // Builder is an object that builds Shoot objects.
 type Builder struct {
 	shootObjectFunc  func(context.Context) (*gardencorev1beta1.Shoot, error)
-	cloudProfileFunc func(context.Context, string) (*gardencorev1beta1.CloudProfile, error)
+	cloudProfileFunc func(context.Context, *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error)
 	shootSecretFunc  func(context.Context, string, string) (*corev1.Secret, error)
 	seed             *gardencorev1beta1.Seed
 	exposureClass    *gardencorev1beta1.ExposureClass
@@ -112,6 +112,8 @@ type Shoot struct {
 	CloudConfigExecutionMaxDelaySeconds int
 	ResourcesToEncrypt                  []string
 	EncryptedResources                  []string
+	EncryptionProvider                  *gardencorev1beta1.EncryptionProvider
+	ActiveEncryptionProvider            *gardencorev1beta1.EncryptionProvider
 
 	Components *Components
 }
@@ -208,4 +210,10 @@ type Networks struct {
 	APIServer net.IP
 	// CoreDNS is the ClusterIP of kube-system/coredns Service
 	CoreDNS net.IP
+	// SecondaryPods is the secondary pods subnet of dual-stack shoots
+	SecondaryPods *net.IPNet
+	// SecondaryServices is the secondary services subnet of dual-stack shoots
+	SecondaryServices *net.IPNet
+	// SecondaryCoreDNS is the secondary ClusterIP of kube-system/coredns Service of dual-stack shoots
+	SecondaryCoreDNS net.IP
 }
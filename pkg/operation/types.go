@@ -20,6 +20,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -44,16 +45,19 @@ type Builder struct {
 	secretsFunc               func() (map[string]*corev1.Secret, error)
 	seedFunc                  func(context.Context) (*seed.Seed, error)
 	shootFunc                 func(context.Context, client.Reader, *garden.Garden, *seed.Seed) (*shoot.Shoot, error)
+	clock                     clock.Clock
 }
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
 type Operation struct {
-	secrets        map[string]*corev1.Secret
-	secretsMutex   sync.RWMutex
-	SecretsManager secretsmanager.Interface
+	secrets          map[string]*corev1.Secret
+	secretsMutex     sync.RWMutex
+	SecretsManager   secretsmanager.Interface
+	SecretsDataStore secretsmanager.DataStore
 
 	Config                *config.GardenletConfiguration
 	Logger                logr.Logger
+	Clock                 clock.Clock
 	GardenerInfo          *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	Garden                *garden.Garden
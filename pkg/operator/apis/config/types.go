@@ -50,6 +50,8 @@ type OperatorConfiguration struct {
 	Controllers ControllerConfiguration
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeTolerationConfiguration
+	// SecretsManager contains optional settings for the secrets manager.
+	SecretsManager *SecretsManagerConfig
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -126,3 +128,31 @@ type NodeTolerationConfiguration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// SecretsManagerConfig contains settings for the secrets manager.
+type SecretsManagerConfig struct {
+	// ExternalDataStore configures a storage backend for sensitive data of secrets (e.g., private keys of certificate
+	// authorities) which should not be stored in the Kubernetes Secrets.
+	ExternalDataStore *ExternalDataStore
+}
+
+// ExternalDataStore contains the configuration of the external data store of the secrets manager.
+type ExternalDataStore struct {
+	// Vault configures a HashiCorp Vault KV (version 2) secrets engine as the external data store.
+	Vault *VaultDataStore
+}
+
+// VaultDataStore contains the configuration of a HashiCorp Vault KV (version 2) secrets engine.
+type VaultDataStore struct {
+	// Server is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Server string
+	// MountPath is the path at which the KV (version 2) secrets engine is mounted.
+	MountPath string
+	// PathPrefix is prepended to the paths of all secrets in the secrets engine.
+	PathPrefix *string
+	// TokenFile is the path of the file containing the Vault token. It is read for every request, hence, the token
+	// can be renewed externally (e.g., by a Vault agent).
+	TokenFile string
+	// CAFile is the path of the file containing the CA bundle used to verify the certificate of the Vault server.
+	CAFile *string
+}
@@ -53,6 +53,9 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// SecretsManager contains optional settings for the secrets manager.
+	// +optional
+	SecretsManager *SecretsManagerConfig `json:"secretsManager,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -147,3 +150,35 @@ const (
 	// DefaultLockObjectName is the default lock name for leader election.
 	DefaultLockObjectName = "gardener-operator-leader-election"
 )
+
+// SecretsManagerConfig contains settings for the secrets manager.
+type SecretsManagerConfig struct {
+	// ExternalDataStore configures a storage backend for sensitive data of secrets (e.g., private keys of certificate
+	// authorities) which should not be stored in the Kubernetes Secrets.
+	// +optional
+	ExternalDataStore *ExternalDataStore `json:"externalDataStore,omitempty"`
+}
+
+// ExternalDataStore contains the configuration of the external data store of the secrets manager.
+type ExternalDataStore struct {
+	// Vault configures a HashiCorp Vault KV (version 2) secrets engine as the external data store.
+	// +optional
+	Vault *VaultDataStore `json:"vault,omitempty"`
+}
+
+// VaultDataStore contains the configuration of a HashiCorp Vault KV (version 2) secrets engine.
+type VaultDataStore struct {
+	// Server is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Server string `json:"server"`
+	// MountPath is the path at which the KV (version 2) secrets engine is mounted.
+	MountPath string `json:"mountPath"`
+	// PathPrefix is prepended to the paths of all secrets in the secrets engine.
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+	// TokenFile is the path of the file containing the Vault token. It is read for every request, hence, the token
+	// can be renewed externally (e.g., by a Vault agent).
+	TokenFile string `json:"tokenFile"`
+	// CAFile is the path of the file containing the CA bundle used to verify the certificate of the Vault server.
+	// +optional
+	CAFile *string `json:"caFile,omitempty"`
+}
@@ -61,6 +61,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExternalDataStore)(nil), (*config.ExternalDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(a.(*ExternalDataStore), b.(*config.ExternalDataStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExternalDataStore)(nil), (*ExternalDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(a.(*config.ExternalDataStore), b.(*ExternalDataStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenCareControllerConfiguration)(nil), (*config.GardenCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenCareControllerConfiguration_To_config_GardenCareControllerConfiguration(a.(*GardenCareControllerConfiguration), b.(*config.GardenCareControllerConfiguration), scope)
 	}); err != nil {
@@ -111,6 +121,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretsManagerConfig)(nil), (*config.SecretsManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(a.(*SecretsManagerConfig), b.(*config.SecretsManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SecretsManagerConfig)(nil), (*SecretsManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(a.(*config.SecretsManagerConfig), b.(*SecretsManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -131,6 +151,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VaultDataStore)(nil), (*config.VaultDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VaultDataStore_To_config_VaultDataStore(a.(*VaultDataStore), b.(*config.VaultDataStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.VaultDataStore)(nil), (*VaultDataStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_VaultDataStore_To_v1alpha1_VaultDataStore(a.(*config.VaultDataStore), b.(*VaultDataStore), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -192,6 +222,26 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(in *ExternalDataStore, out *config.ExternalDataStore, s conversion.Scope) error {
+	out.Vault = (*config.VaultDataStore)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore is an autogenerated conversion function.
+func Convert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(in *ExternalDataStore, out *config.ExternalDataStore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExternalDataStore_To_config_ExternalDataStore(in, out, s)
+}
+
+func autoConvert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(in *config.ExternalDataStore, out *ExternalDataStore, s conversion.Scope) error {
+	out.Vault = (*VaultDataStore)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore is an autogenerated conversion function.
+func Convert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(in *config.ExternalDataStore, out *ExternalDataStore, s conversion.Scope) error {
+	return autoConvert_config_ExternalDataStore_To_v1alpha1_ExternalDataStore(in, out, s)
+}
+
 func autoConvert_v1alpha1_GardenCareControllerConfiguration_To_config_GardenCareControllerConfiguration(in *GardenCareControllerConfiguration, out *config.GardenCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
@@ -311,6 +361,7 @@ func autoConvert_v1alpha1_OperatorConfiguration_To_config_OperatorConfiguration(
 		return err
 	}
 	out.NodeToleration = (*config.NodeTolerationConfiguration)(unsafe.Pointer(in.NodeToleration))
+	out.SecretsManager = (*config.SecretsManagerConfig)(unsafe.Pointer(in.SecretsManager))
 	return nil
 }
 
@@ -348,6 +399,7 @@ func autoConvert_config_OperatorConfiguration_To_v1alpha1_OperatorConfiguration(
 		return err
 	}
 	out.NodeToleration = (*NodeTolerationConfiguration)(unsafe.Pointer(in.NodeToleration))
+	out.SecretsManager = (*SecretsManagerConfig)(unsafe.Pointer(in.SecretsManager))
 	return nil
 }
 
@@ -356,6 +408,26 @@ func Convert_config_OperatorConfiguration_To_v1alpha1_OperatorConfiguration(in *
 	return autoConvert_config_OperatorConfiguration_To_v1alpha1_OperatorConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(in *SecretsManagerConfig, out *config.SecretsManagerConfig, s conversion.Scope) error {
+	out.ExternalDataStore = (*config.ExternalDataStore)(unsafe.Pointer(in.ExternalDataStore))
+	return nil
+}
+
+// Convert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(in *SecretsManagerConfig, out *config.SecretsManagerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretsManagerConfig_To_config_SecretsManagerConfig(in, out, s)
+}
+
+func autoConvert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(in *config.SecretsManagerConfig, out *SecretsManagerConfig, s conversion.Scope) error {
+	out.ExternalDataStore = (*ExternalDataStore)(unsafe.Pointer(in.ExternalDataStore))
+	return nil
+}
+
+// Convert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig is an autogenerated conversion function.
+func Convert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(in *config.SecretsManagerConfig, out *SecretsManagerConfig, s conversion.Scope) error {
+	return autoConvert_config_SecretsManagerConfig_To_v1alpha1_SecretsManagerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -405,3 +477,31 @@ func autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *
 func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *config.ServerConfiguration, out *ServerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_VaultDataStore_To_config_VaultDataStore(in *VaultDataStore, out *config.VaultDataStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.MountPath = in.MountPath
+	out.PathPrefix = (*string)(unsafe.Pointer(in.PathPrefix))
+	out.TokenFile = in.TokenFile
+	out.CAFile = (*string)(unsafe.Pointer(in.CAFile))
+	return nil
+}
+
+// Convert_v1alpha1_VaultDataStore_To_config_VaultDataStore is an autogenerated conversion function.
+func Convert_v1alpha1_VaultDataStore_To_config_VaultDataStore(in *VaultDataStore, out *config.VaultDataStore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VaultDataStore_To_config_VaultDataStore(in, out, s)
+}
+
+func autoConvert_config_VaultDataStore_To_v1alpha1_VaultDataStore(in *config.VaultDataStore, out *VaultDataStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.MountPath = in.MountPath
+	out.PathPrefix = (*string)(unsafe.Pointer(in.PathPrefix))
+	out.TokenFile = in.TokenFile
+	out.CAFile = (*string)(unsafe.Pointer(in.CAFile))
+	return nil
+}
+
+// Convert_config_VaultDataStore_To_v1alpha1_VaultDataStore is an autogenerated conversion function.
+func Convert_config_VaultDataStore_To_v1alpha1_VaultDataStore(in *config.VaultDataStore, out *VaultDataStore, s conversion.Scope) error {
+	return autoConvert_config_VaultDataStore_To_v1alpha1_VaultDataStore(in, out, s)
+}
@@ -15,6 +15,7 @@
 package validation
 
 import (
+	"net/url"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -43,6 +44,8 @@ func ValidateOperatorConfiguration(conf *config.OperatorConfiguration) field.Err
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
 
+	allErrs = append(allErrs, validateSecretsManagerConfiguration(conf.SecretsManager, field.NewPath("secretsManager"))...)
+
 	return allErrs
 }
 
@@ -118,3 +121,31 @@ func validateNodeTolerationConfiguration(conf *config.NodeTolerationConfiguratio
 
 	return allErrs
 }
+
+func validateSecretsManagerConfiguration(cfg *config.SecretsManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil || cfg.ExternalDataStore == nil {
+		return allErrs
+	}
+
+	vault := cfg.ExternalDataStore.Vault
+	vaultPath := fldPath.Child("externalDataStore", "vault")
+	if vault == nil {
+		return append(allErrs, field.Required(vaultPath, "must configure a data store backend"))
+	}
+
+	if len(vault.Server) == 0 {
+		allErrs = append(allErrs, field.Required(vaultPath.Child("server"), "must provide the address of the Vault server"))
+	} else if u, err := url.Parse(vault.Server); err != nil || (u.Scheme != "https" && u.Scheme != "http") || len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("server"), vault.Server, "must be a valid http or https URL"))
+	}
+	if len(vault.MountPath) == 0 {
+		allErrs = append(allErrs, field.Required(vaultPath.Child("mountPath"), "must provide the mount path of the KV secrets engine"))
+	}
+	if len(vault.TokenFile) == 0 {
+		allErrs = append(allErrs, field.Required(vaultPath.Child("tokenFile"), "must provide the path of the token file"))
+	}
+
+	return allErrs
+}
@@ -219,4 +219,61 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("secrets manager", func() {
+		It("should pass with a valid external data store", func() {
+			conf.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{
+				Server:    "https://vault.example.com:8200",
+				MountPath: "secret",
+				TokenFile: "/var/run/secrets/vault/token",
+			}}}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail if no backend of the external data store is configured", func() {
+			conf.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{}}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("secretsManager.externalDataStore.vault"),
+				})),
+			))
+		})
+
+		It("should fail if the Vault configuration is incomplete", func() {
+			conf.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{}}}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("secretsManager.externalDataStore.vault.server"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("secretsManager.externalDataStore.vault.mountPath"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("secretsManager.externalDataStore.vault.tokenFile"),
+				})),
+			))
+		})
+
+		It("should fail if the Vault server address is invalid", func() {
+			conf.SecretsManager = &config.SecretsManagerConfig{ExternalDataStore: &config.ExternalDataStore{Vault: &config.VaultDataStore{
+				Server:    "vault.example.com",
+				MountPath: "secret",
+				TokenFile: "/var/run/secrets/vault/token",
+			}}}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("secretsManager.externalDataStore.vault.server"),
+				})),
+			))
+		})
+	})
 })
@@ -64,6 +64,27 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDataStore) DeepCopyInto(out *ExternalDataStore) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultDataStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDataStore.
+func (in *ExternalDataStore) DeepCopy() *ExternalDataStore {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDataStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenCareControllerConfiguration) DeepCopyInto(out *GardenCareControllerConfiguration) {
 	*out = *in
@@ -201,6 +222,11 @@ func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 		*out = new(NodeTolerationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretsManager != nil {
+		in, out := &in.SecretsManager, &out.SecretsManager
+		*out = new(SecretsManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -222,6 +248,27 @@ func (in *OperatorConfiguration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsManagerConfig) DeepCopyInto(out *SecretsManagerConfig) {
+	*out = *in
+	if in.ExternalDataStore != nil {
+		in, out := &in.ExternalDataStore, &out.ExternalDataStore
+		*out = new(ExternalDataStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManagerConfig.
+func (in *SecretsManagerConfig) DeepCopy() *SecretsManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -264,3 +311,29 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultDataStore) DeepCopyInto(out *VaultDataStore) {
+	*out = *in
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.CAFile != nil {
+		in, out := &in.CAFile, &out.CAFile
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultDataStore.
+func (in *VaultDataStore) DeepCopy() *VaultDataStore {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultDataStore)
+	in.DeepCopyInto(out)
+	return out
+}
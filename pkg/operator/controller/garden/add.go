@@ -50,6 +50,11 @@ func AddToManager(
 		}
 	}
 
+	componentImageVectors, err = imagevectorutils.ComponentImageVectorsWithEnvPolicy(componentImageVectors)
+	if err != nil {
+		return fmt.Errorf("failed applying image vector policy to component-specific image vector overrides: %w", err)
+	}
+
 	gardenClientMap, err := clientmapbuilder.
 		NewGardenClientMapBuilder().
 		WithRuntimeClient(mgr.GetClient()).
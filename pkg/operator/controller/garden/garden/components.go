@@ -565,6 +565,7 @@ func (r *Reconciler) newKubeAPIServer(
 		apiServerConfig,
 		defaultAPIServerAutoscalingConfig(garden),
 		garden.Spec.VirtualCluster.Networking.Services,
+		nil,
 		kubeapiserver.VPNConfig{Enabled: false},
 		v1beta1constants.PriorityClassNameGardenSystem500,
 		true,
@@ -677,6 +678,8 @@ func (r *Reconciler) newKubeControllerManager(
 		&kubecontrollermanager.HVPAConfig{Enabled: hvpaEnabled()},
 		nil,
 		services,
+		nil,
+		nil,
 		certificateSigningDuration,
 		kubecontrollermanager.ControllerWorkers{
 			GarbageCollector:    pointer.Int(250),
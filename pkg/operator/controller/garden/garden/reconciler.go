@@ -91,6 +91,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed parsing version %q for virtual cluster: %w", garden.Spec.VirtualCluster.Kubernetes.Version, err)
 	}
 
+	dataStore, err := r.secretsDataStore()
+	if err != nil {
+		return reconcile.Result{}, r.updateStatusOperationError(ctx, garden, fmt.Errorf("failed creating external data store for secrets manager: %w", err), operationType)
+	}
+
 	secretsManager, err := secretsmanager.New(
 		ctx,
 		log.WithName("secretsmanager"),
@@ -101,6 +106,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		secretsmanager.Config{
 			CASecretAutoRotation: true,
 			SecretNamesToTimes:   lastSecretRotationStartTimes(garden),
+			DataStore:            dataStore,
 		},
 	)
 	if err != nil {
@@ -432,9 +438,30 @@ func caCertGenerateOptionsFor(name string, rotationPhase gardencorev1beta1.Crede
 		options = append(options, secretsmanager.IgnoreOldSecrets())
 	}
 
+	// The private key of the client CA is mounted into the kube-controller-manager for signing certificates, hence it
+	// must remain in the secret.
+	if name != v1beta1constants.SecretNameCAClient {
+		options = append(options, secretsmanager.StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+	}
+
 	return options
 }
 
+func (r *Reconciler) secretsDataStore() (secretsmanager.DataStore, error) {
+	if r.Config.SecretsManager == nil || r.Config.SecretsManager.ExternalDataStore == nil || r.Config.SecretsManager.ExternalDataStore.Vault == nil {
+		return nil, nil
+	}
+
+	vault := r.Config.SecretsManager.ExternalDataStore.Vault
+	return secretsmanager.NewVaultDataStore(secretsmanager.VaultDataStoreOptions{
+		Server:     vault.Server,
+		MountPath:  vault.MountPath,
+		PathPrefix: pointer.StringDeref(vault.PathPrefix, ""),
+		TokenFile:  vault.TokenFile,
+		CAFile:     pointer.StringDeref(vault.CAFile, ""),
+	})
+}
+
 func lastSecretRotationStartTimes(garden *operatorv1alpha1.Garden) map[string]time.Time {
 	rotation := make(map[string]time.Time)
 
@@ -16,7 +16,10 @@ package backupentry
 
 import (
 	"context"
+	"errors"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 
 	etcddruidutils "github.com/gardener/etcd-druid/pkg/utils"
@@ -26,6 +29,7 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
 
 type actuator struct {
@@ -52,3 +56,49 @@ func (a *actuator) Delete(_ context.Context, log logr.Logger, be *extensionsv1al
 	log.Info("Deleting directory", "path", path)
 	return os.RemoveAll(path)
 }
+
+func (a *actuator) ShootStateSnapshotStore(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.BackupEntry) (shootstate.SnapshotStore, error) {
+	return &snapshotStore{dir: a.backBucketPath}, nil
+}
+
+// snapshotStore stores ShootState snapshots in the local backup buckets, i.e., there is one sub-directory per bucket.
+type snapshotStore struct {
+	dir string
+}
+
+func (s *snapshotStore) path(bucketName, key string) string {
+	return filepath.Join(s.dir, bucketName, filepath.FromSlash(key))
+}
+
+func (s *snapshotStore) Put(_ context.Context, bucketName, key string, data []byte) error {
+	p := s.path(bucketName, key)
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0600)
+}
+
+func (s *snapshotStore) List(_ context.Context, bucketName, prefix string) ([]string, error) {
+	entries, err := os.ReadDir(s.path(bucketName, prefix))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			keys = append(keys, path.Join(prefix, entry.Name()))
+		}
+	}
+	return keys, nil
+}
+
+func (s *snapshotStore) Delete(_ context.Context, bucketName, key string) error {
+	if err := os.Remove(s.path(bucketName, key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacedcloudprofile_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	namespacedcloudprofileregistry "github.com/gardener/gardener/pkg/registry/core/namespacedcloudprofile"
+)
+
+var _ = Describe("Strategy", func() {
+	var namespacedCloudProfile *core.NamespacedCloudProfile
+
+	BeforeEach(func() {
+		namespacedCloudProfile = &core.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "profile",
+				Namespace:  "garden-dev",
+				Generation: 1,
+			},
+			Spec: core.NamespacedCloudProfileSpec{
+				Parent: core.CloudProfileReference{Kind: "CloudProfile", Name: "aws"},
+			},
+			Status: core.NamespacedCloudProfileStatus{
+				CloudProfileSpec:   core.CloudProfileSpec{Type: "aws"},
+				ObservedGeneration: 1,
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should set the generation and drop the status", func() {
+			namespacedCloudProfile.Generation = 0
+
+			namespacedcloudprofileregistry.Strategy.PrepareForCreate(context.TODO(), namespacedCloudProfile)
+
+			Expect(namespacedCloudProfile.Generation).To(Equal(int64(1)))
+			Expect(namespacedCloudProfile.Status).To(Equal(core.NamespacedCloudProfileStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should increase the generation and keep the status if the spec has changed", func() {
+			newNamespacedCloudProfile := namespacedCloudProfile.DeepCopy()
+			newNamespacedCloudProfile.Spec.VolumeTypes = []core.VolumeType{{Name: "foo", Class: "standard"}}
+			newNamespacedCloudProfile.Status = core.NamespacedCloudProfileStatus{}
+
+			namespacedcloudprofileregistry.Strategy.PrepareForUpdate(context.TODO(), newNamespacedCloudProfile, namespacedCloudProfile)
+
+			Expect(newNamespacedCloudProfile.Generation).To(Equal(int64(2)))
+			Expect(newNamespacedCloudProfile.Status).To(Equal(namespacedCloudProfile.Status))
+		})
+
+		It("should increase the generation if the deletion timestamp was set", func() {
+			newNamespacedCloudProfile := namespacedCloudProfile.DeepCopy()
+			newNamespacedCloudProfile.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+			namespacedcloudprofileregistry.Strategy.PrepareForUpdate(context.TODO(), newNamespacedCloudProfile, namespacedCloudProfile)
+
+			Expect(newNamespacedCloudProfile.Generation).To(Equal(int64(2)))
+		})
+
+		It("should not increase the generation if the spec has not changed", func() {
+			newNamespacedCloudProfile := namespacedCloudProfile.DeepCopy()
+			newNamespacedCloudProfile.Labels = map[string]string{"foo": "bar"}
+
+			namespacedcloudprofileregistry.Strategy.PrepareForUpdate(context.TODO(), newNamespacedCloudProfile, namespacedCloudProfile)
+
+			Expect(newNamespacedCloudProfile.Generation).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should keep the spec", func() {
+			newNamespacedCloudProfile := namespacedCloudProfile.DeepCopy()
+			newNamespacedCloudProfile.Spec.Parent.Name = "gcp"
+			newNamespacedCloudProfile.Status.ObservedGeneration = 2
+
+			namespacedcloudprofileregistry.StatusStrategy.PrepareForUpdate(context.TODO(), newNamespacedCloudProfile, namespacedCloudProfile)
+
+			Expect(newNamespacedCloudProfile.Spec).To(Equal(namespacedCloudProfile.Spec))
+			Expect(newNamespacedCloudProfile.Status.ObservedGeneration).To(Equal(int64(2)))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/registry/core/quota"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx = context.TODO()
+
+		oldQuota, newQuota *core.Quota
+	)
+
+	BeforeEach(func() {
+		oldQuota = &core.Quota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "quota",
+				Namespace:  "garden",
+				Generation: 1,
+			},
+			Spec: core.QuotaSpec{
+				Metrics: corev1.ResourceList{"cpu": resource.MustParse("10")},
+			},
+			Status: core.QuotaStatus{
+				ObservedGeneration: 1,
+				Usage:              []core.QuotaUsage{{Used: corev1.ResourceList{"cpu": resource.MustParse("2")}}},
+			},
+		}
+		newQuota = oldQuota.DeepCopy()
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should reset the status and set the generation", func() {
+			newQuota.Generation = 0
+
+			quota.Strategy.PrepareForCreate(ctx, newQuota)
+
+			Expect(newQuota.Generation).To(Equal(int64(1)))
+			Expect(newQuota.Status).To(Equal(core.QuotaStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should keep the status and the generation if the spec is unchanged", func() {
+			newQuota.Status = core.QuotaStatus{}
+
+			quota.Strategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+			Expect(newQuota.Generation).To(Equal(int64(1)))
+			Expect(newQuota.Status).To(Equal(oldQuota.Status))
+		})
+
+		It("should increase the generation if the spec changes", func() {
+			newQuota.Spec.Metrics["cpu"] = resource.MustParse("20")
+
+			quota.Strategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+			Expect(newQuota.Generation).To(Equal(int64(2)))
+		})
+
+		It("should increase the generation if the deletion timestamp is set", func() {
+			newQuota.DeletionTimestamp = &metav1.Time{}
+
+			quota.Strategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+			Expect(newQuota.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should keep the spec", func() {
+			newQuota.Spec.Metrics["cpu"] = resource.MustParse("20")
+			newQuota.Status.Usage = nil
+
+			quota.StatusStrategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+			Expect(newQuota.Spec).To(Equal(oldQuota.Spec))
+			Expect(newQuota.Status.Usage).To(BeNil())
+		})
+	})
+})
@@ -35,6 +35,7 @@ import (
 	controllerregistrationstore "github.com/gardener/gardener/pkg/registry/core/controllerregistration/storage"
 	exposureclassstore "github.com/gardener/gardener/pkg/registry/core/exposureclass/storage"
 	internalsecretstore "github.com/gardener/gardener/pkg/registry/core/internalsecret/storage"
+	namespacedcloudprofilestore "github.com/gardener/gardener/pkg/registry/core/namespacedcloudprofile/storage"
 	projectstore "github.com/gardener/gardener/pkg/registry/core/project/storage"
 	quotastore "github.com/gardener/gardener/pkg/registry/core/quota/storage"
 	secretbindingstore "github.com/gardener/gardener/pkg/registry/core/secretbinding/storage"
@@ -93,12 +94,17 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	storage["internalsecrets"] = internalsecretstore.NewREST(restOptionsGetter)
 
+	namespacedCloudProfileStorage := namespacedcloudprofilestore.NewStorage(restOptionsGetter)
+	storage["namespacedcloudprofiles"] = namespacedCloudProfileStorage.NamespacedCloudProfile
+	storage["namespacedcloudprofiles/status"] = namespacedCloudProfileStorage.Status
+
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
 	storage["projects"] = projectStorage.Project
 	storage["projects/status"] = projectStorage.Status
 
 	quotaStorage := quotastore.NewStorage(restOptionsGetter)
 	storage["quotas"] = quotaStorage.Quota
+	storage["quotas/status"] = quotaStorage.Status
 
 	secretBindingStorage := secretbindingstore.NewStorage(restOptionsGetter)
 	storage["secretbindings"] = secretBindingStorage.SecretBinding
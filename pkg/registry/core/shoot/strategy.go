@@ -63,6 +63,12 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 
 	shoot.Generation = 1
 	shoot.Status = core.ShootStatus{}
+
+	// There is no data in etcd yet which would have to be migrated, hence the configured encryption provider can be used
+	// right away.
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.EncryptionConfig != nil && kubeAPIServer.EncryptionConfig.Provider != nil {
+		shoot.Status.EncryptionProvider = kubeAPIServer.EncryptionConfig.Provider.DeepCopy()
+	}
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
@@ -63,6 +63,45 @@ var _ = Describe("Strategy", func() {
 		})
 	})
 
+	Describe("#PrepareForCreate", func() {
+		It("should reset the status", func() {
+			shoot := &core.Shoot{
+				Status: core.ShootStatus{
+					EncryptedResources: []string{"configmaps"},
+				},
+			}
+
+			shootregistry.NewStrategy(0).PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Generation).To(Equal(int64(1)))
+			Expect(shoot.Status).To(Equal(core.ShootStatus{}))
+		})
+
+		It("should set the configured encryption provider in the status", func() {
+			shoot := &core.Shoot{
+				Spec: core.ShootSpec{
+					Kubernetes: core.Kubernetes{
+						KubeAPIServer: &core.KubeAPIServerConfig{
+							EncryptionConfig: &core.EncryptionConfig{
+								Provider: &core.EncryptionProvider{
+									Type:           "kms-foo",
+									ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			shootregistry.NewStrategy(0).PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Status.EncryptionProvider).To(Equal(&core.EncryptionProvider{
+				Type:           "kms-foo",
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+			}))
+		})
+	})
+
 	Describe("#PrepareForUpdate", func() {
 		Context("seedName change", func() {
 			var (
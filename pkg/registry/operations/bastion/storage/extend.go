@@ -0,0 +1,119 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsvalidation "github.com/gardener/gardener/pkg/apis/operations/validation"
+	"github.com/gardener/gardener/pkg/registry/operations/bastion"
+)
+
+// ExtendREST implements the REST endpoint for extending the session of a Bastion.
+type ExtendREST struct {
+	store *genericregistry.Store
+}
+
+var _ = rest.NamedCreater(&ExtendREST{})
+
+// New returns an instance of the object.
+func (r *ExtendREST) New() runtime.Object {
+	return &operations.BastionExtensionRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *ExtendREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Create extends the session of the Bastion by the requested duration and returns the new expiration timestamp in the
+// status of the BastionExtensionRequest.
+func (r *ExtendREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	extensionRequest, ok := obj.(*operations.BastionExtensionRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a BastionExtensionRequest: %T", obj))
+	}
+
+	if errs := operationsvalidation.ValidateBastionExtensionRequest(extensionRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(operations.Kind("BastionExtensionRequest"), name, errs)
+	}
+
+	updatedObj, _, err := r.store.Update(
+		ctx,
+		name,
+		&extendUpdatedObjectInfo{name: name, duration: extensionRequest.Spec.Duration.Duration},
+		rest.ValidateAllObjectFunc,
+		rest.ValidateAllObjectUpdateFunc,
+		false,
+		&metav1.UpdateOptions{DryRun: options.DryRun},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedBastion := updatedObj.(*operations.Bastion)
+	extensionRequest.Status.ExpirationTimestamp = *extendedBastion.Status.ExpirationTimestamp
+
+	return extensionRequest, nil
+}
+
+// extendUpdatedObjectInfo extends the session of an existing Bastion.
+type extendUpdatedObjectInfo struct {
+	name     string
+	duration time.Duration
+}
+
+func (i *extendUpdatedObjectInfo) Preconditions() *metav1.Preconditions {
+	return nil
+}
+
+func (i *extendUpdatedObjectInfo) UpdatedObject(ctx context.Context, oldObj runtime.Object) (runtime.Object, error) {
+	obj, ok := oldObj.DeepCopyObject().(*operations.Bastion)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected existing object type to be Bastion, got %T", oldObj))
+	}
+	if len(obj.ResourceVersion) == 0 {
+		return nil, apierrors.NewNotFound(operations.Resource("bastions"), i.name)
+	}
+
+	if obj.DeletionTimestamp != nil {
+		return nil, apierrors.NewConflict(operations.Resource("bastions/extend"), i.name, fmt.Errorf("bastion is already being deleted"))
+	}
+	if obj.Spec.SessionDuration == nil {
+		return nil, apierrors.NewBadRequest("bastion does not have a session duration, its expiration is advanced by heartbeats")
+	}
+	if obj.Status.ExpirationTimestamp == nil || !time.Now().Before(obj.Status.ExpirationTimestamp.Time) {
+		return nil, apierrors.NewConflict(operations.Resource("bastions/extend"), i.name, fmt.Errorf("bastion session has already expired"))
+	}
+
+	bastion.ExtendSession(ctx, obj, i.duration)
+	return obj, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var _ = Describe("Extend", func() {
+	var (
+		ctx        context.Context
+		bastion    *operations.Bastion
+		expires    metav1.Time
+		objectInfo *extendUpdatedObjectInfo
+	)
+
+	BeforeEach(func() {
+		ctx = request.WithUser(context.TODO(), &user.DefaultInfo{Name: "foo"})
+		expires = metav1.NewTime(time.Now().Add(time.Hour))
+
+		bastion = &operations.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "bastion",
+				Namespace:       "garden-dev",
+				ResourceVersion: "1",
+			},
+			Spec: operations.BastionSpec{
+				SessionDuration: &metav1.Duration{Duration: time.Hour},
+			},
+			Status: operations.BastionStatus{
+				ExpirationTimestamp: &expires,
+				Sessions:            []operations.BastionSession{{Action: operations.BastionSessionActionCreate, User: "bar"}},
+			},
+		}
+
+		objectInfo = &extendUpdatedObjectInfo{name: bastion.Name, duration: 30 * time.Minute}
+	})
+
+	It("should extend the session of the bastion", func() {
+		obj, err := objectInfo.UpdatedObject(ctx, bastion)
+		Expect(err).NotTo(HaveOccurred())
+
+		extendedBastion := obj.(*operations.Bastion)
+		Expect(extendedBastion.Status.ExpirationTimestamp.Time).To(Equal(expires.Add(30 * time.Minute)))
+		Expect(extendedBastion.Status.Sessions).To(HaveLen(2))
+		Expect(extendedBastion.Status.Sessions[1].Action).To(Equal(operations.BastionSessionActionExtend))
+		Expect(extendedBastion.Status.Sessions[1].User).To(Equal("foo"))
+
+		By("Ensure the old object was not mutated")
+		Expect(bastion.Status.ExpirationTimestamp.Time).To(Equal(expires.Time))
+		Expect(bastion.Status.Sessions).To(HaveLen(1))
+	})
+
+	It("should fail if the bastion does not have a session duration", func() {
+		bastion.Spec.SessionDuration = nil
+
+		_, err := objectInfo.UpdatedObject(ctx, bastion)
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("should fail if the session of the bastion already expired", func() {
+		bastion.Status.ExpirationTimestamp = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+
+		_, err := objectInfo.UpdatedObject(ctx, bastion)
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+	})
+
+	It("should fail if the bastion is in deletion", func() {
+		bastion.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+		_, err := objectInfo.UpdatedObject(ctx, bastion)
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+	})
+
+	It("should fail if the bastion does not exist", func() {
+		bastion.ResourceVersion = ""
+
+		_, err := objectInfo.UpdatedObject(ctx, bastion)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
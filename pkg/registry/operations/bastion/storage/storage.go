@@ -33,24 +33,26 @@ type REST struct {
 	*genericregistry.Store
 }
 
-// BastionStorage implements the storage for Bastions and their status subresource.
+// BastionStorage implements the storage for Bastions and their status and extend subresources.
 type BastionStorage struct {
 	Bastion *REST
 	Status  *StatusREST
+	Extend  *ExtendREST
 }
 
 // NewStorage creates a new BastionStorage object.
 func NewStorage(optsGetter generic.RESTOptionsGetter) BastionStorage {
-	bastionRest, bastionStatusRest := NewREST(optsGetter)
+	bastionRest, bastionStatusRest, bastionExtendRest := NewREST(optsGetter)
 
 	return BastionStorage{
 		Bastion: bastionRest,
 		Status:  bastionStatusRest,
+		Extend:  bastionExtendRest,
 	}
 }
 
 // NewREST returns a RESTStorage object that will work against bastions.
-func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST, *ExtendREST) {
 	store := &genericregistry.Store{
 		NewFunc:                   func() runtime.Object { return &operations.Bastion{} },
 		NewListFunc:               func() runtime.Object { return &operations.BastionList{} },
@@ -76,7 +78,11 @@ func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 
 	statusStore := *store
 	statusStore.UpdateStrategy = bastion.StatusStrategy
-	return &REST{store}, &StatusREST{store: &statusStore}
+
+	extendStore := *store
+	extendStore.UpdateStrategy = bastion.ExtendStrategy
+
+	return &REST{store}, &StatusREST{store: &statusStore}, &ExtendREST{store: &extendStore}
 }
 
 // Implement CategoriesProvider
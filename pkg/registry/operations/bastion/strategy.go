@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
@@ -56,19 +57,33 @@ func (bastionStrategy) NamespaceScoped() bool {
 	return true
 }
 
-func (s bastionStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+func (s bastionStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	bastion := obj.(*operations.Bastion)
 	bastion.Generation = 1
 
 	s.heartbeat(bastion)
+
+	if bastion.Spec.SessionDuration != nil {
+		expires := metav1.NewTime(bastion.Status.LastHeartbeatTimestamp.Add(bastion.Spec.SessionDuration.Duration))
+		bastion.Status.ExpirationTimestamp = &expires
+	}
+
+	bastion.Status.Sessions = []operations.BastionSession{
+		newSession(ctx, operations.BastionSessionActionCreate, *bastion.Status.LastHeartbeatTimestamp, bastion.Status.ExpirationTimestamp),
+	}
+	// events for the sessions are recorded by the Bastion controller of gardener-controller-manager
+	bastion.Status.RecordedSessions = nil
 }
 
 func (s bastionStrategy) heartbeat(bastion *operations.Bastion) {
 	now := metav1.NewTime(time.Now())
-	expires := metav1.NewTime(now.Add(s.timeToLive))
-
 	bastion.Status.LastHeartbeatTimestamp = &now
-	bastion.Status.ExpirationTimestamp = &expires
+
+	// The expiration of bastions with a session duration is only advanced when their session is extended explicitly.
+	if bastion.Spec.SessionDuration == nil {
+		expires := metav1.NewTime(now.Add(s.timeToLive))
+		bastion.Status.ExpirationTimestamp = &expires
+	}
 
 	if bastion.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationKeepalive {
 		delete(bastion.Annotations, v1beta1constants.GardenerOperation)
@@ -150,11 +165,19 @@ type bastionStatusStrategy struct {
 // StatusStrategy defines the storage strategy for the status subresource of Bastions.
 var StatusStrategy = bastionStatusStrategy{Strategy}
 
-func (s bastionStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+func (s bastionStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newBastion := obj.(*operations.Bastion)
 	oldBastion := old.(*operations.Bastion)
 	newBastion.Spec = oldBastion.Spec
 
+	setSessionUsers(ctx, newBastion, oldBastion)
+
+	// the expiration of bastions with a session duration can only be changed by extending their session
+	if newBastion.Spec.SessionDuration != nil {
+		newBastion.Status.ExpirationTimestamp = oldBastion.Status.ExpirationTimestamp
+		return
+	}
+
 	// recalculate to prevent manipulation
 	expires := metav1.NewTime(newBastion.Status.LastHeartbeatTimestamp.Add(s.timeToLive))
 	newBastion.Status.ExpirationTimestamp = &expires
@@ -164,6 +187,60 @@ func (bastionStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.
 	return operationsvalidation.ValidateBastionStatusUpdate(obj.(*operations.Bastion), old.(*operations.Bastion))
 }
 
+type bastionExtendStrategy struct {
+	bastionStrategy
+}
+
+// ExtendStrategy defines the storage strategy for the extend subresource of Bastions.
+var ExtendStrategy = bastionExtendStrategy{Strategy}
+
+func (bastionExtendStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newBastion := obj.(*operations.Bastion)
+	oldBastion := old.(*operations.Bastion)
+	newBastion.Spec = oldBastion.Spec
+
+	setSessionUsers(ctx, newBastion, oldBastion)
+}
+
+func (bastionExtendStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateBastionStatusUpdate(obj.(*operations.Bastion), old.(*operations.Bastion))
+}
+
+// ExtendSession advances the expiration timestamp of the given bastion by the given duration and records the
+// extension in its session history.
+func ExtendSession(ctx context.Context, bastion *operations.Bastion, duration time.Duration) {
+	expires := metav1.NewTime(bastion.Status.ExpirationTimestamp.Add(duration))
+	bastion.Status.ExpirationTimestamp = &expires
+	bastion.Status.Sessions = append(bastion.Status.Sessions, newSession(ctx, operations.BastionSessionActionExtend, metav1.NewTime(time.Now()), &expires))
+}
+
+func newSession(ctx context.Context, action operations.BastionSessionAction, timestamp metav1.Time, expirationTimestamp *metav1.Time) operations.BastionSession {
+	session := operations.BastionSession{
+		Action:              action,
+		Timestamp:           timestamp,
+		ExpirationTimestamp: expirationTimestamp.DeepCopy(),
+	}
+
+	if userInfo, ok := request.UserFrom(ctx); ok {
+		session.User = userInfo.GetName()
+	}
+
+	return session
+}
+
+// setSessionUsers sets the user of the request to all sessions which are newly added to the history to prevent
+// recording actions on behalf of other users.
+func setSessionUsers(ctx context.Context, newBastion, oldBastion *operations.Bastion) {
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok {
+		return
+	}
+
+	for i := len(oldBastion.Status.Sessions); i < len(newBastion.Status.Sessions); i++ {
+		newBastion.Status.Sessions[i].User = userInfo.GetName()
+	}
+}
+
 // ToSelectableFields returns a field set that represents the object
 func ToSelectableFields(bastion *operations.Bastion) fields.Set {
 	// The purpose of allocation with a given number of elements is to reduce
@@ -16,6 +16,7 @@ package bastion
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -23,6 +24,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/pointer"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -106,6 +110,33 @@ var _ = Describe("PrepareForCreate", func() {
 		Strategy.PrepareForCreate(context.TODO(), &bastion)
 		Expect(bastion.Annotations[v1beta1constants.GardenerOperation]).To(BeEmpty())
 	})
+
+	It("should record the creation in the session history", func() {
+		bastion := operations.Bastion{Status: operations.BastionStatus{RecordedSessions: pointer.Int32(1)}}
+		ctx := request.WithUser(context.TODO(), &user.DefaultInfo{Name: "foo"})
+
+		Strategy.PrepareForCreate(ctx, &bastion)
+
+		Expect(bastion.Status.Sessions).To(ConsistOf(operations.BastionSession{
+			Action:              operations.BastionSessionActionCreate,
+			User:                "foo",
+			Timestamp:           *bastion.Status.LastHeartbeatTimestamp,
+			ExpirationTimestamp: bastion.Status.ExpirationTimestamp,
+		}))
+		Expect(bastion.Status.RecordedSessions).To(BeNil())
+	})
+
+	It("should calculate the expiration based on the session duration", func() {
+		bastion := operations.Bastion{
+			Spec: operations.BastionSpec{
+				SessionDuration: &metav1.Duration{Duration: 4 * time.Hour},
+			},
+		}
+
+		Strategy.PrepareForCreate(context.TODO(), &bastion)
+
+		Expect(bastion.Status.ExpirationTimestamp.Time).To(Equal(bastion.Status.LastHeartbeatTimestamp.Add(4 * time.Hour)))
+	})
 })
 
 var _ = Describe("PrepareForUpdate", func() {
@@ -189,6 +220,71 @@ var _ = Describe("PrepareForUpdate", func() {
 	})
 })
 
+var _ = Describe("StatusStrategy", func() {
+	var oldBastion, newBastion *operations.Bastion
+
+	BeforeEach(func() {
+		lastHeartbeat := metav1.NewTime(time.Now().Add(-time.Minute))
+		expires := metav1.NewTime(lastHeartbeat.Add(4 * time.Hour))
+
+		oldBastion = &operations.Bastion{
+			Status: operations.BastionStatus{
+				LastHeartbeatTimestamp: &lastHeartbeat,
+				ExpirationTimestamp:    &expires,
+				Sessions:               []operations.BastionSession{{Action: operations.BastionSessionActionCreate, User: "foo"}},
+			},
+		}
+		newBastion = oldBastion.DeepCopy()
+	})
+
+	It("should recalculate the expiration of bastions without session duration", func() {
+		StatusStrategy.PrepareForUpdate(context.TODO(), newBastion, oldBastion)
+
+		Expect(newBastion.Status.ExpirationTimestamp.Time).To(Equal(newBastion.Status.LastHeartbeatTimestamp.Add(TimeToLive)))
+	})
+
+	It("should keep the expiration of bastions with session duration", func() {
+		oldBastion.Spec.SessionDuration = &metav1.Duration{Duration: 4 * time.Hour}
+		newBastion.Spec.SessionDuration = &metav1.Duration{Duration: 4 * time.Hour}
+		newBastion.Status.ExpirationTimestamp = &metav1.Time{Time: time.Now().Add(24 * time.Hour)}
+
+		StatusStrategy.PrepareForUpdate(context.TODO(), newBastion, oldBastion)
+
+		Expect(newBastion.Status.ExpirationTimestamp).To(Equal(oldBastion.Status.ExpirationTimestamp))
+	})
+
+	It("should set the user of the request for new sessions", func() {
+		newBastion.Status.Sessions = append(newBastion.Status.Sessions, operations.BastionSession{Action: operations.BastionSessionActionExpire, User: "bar"})
+		ctx := request.WithUser(context.TODO(), &user.DefaultInfo{Name: "system:gardener-controller-manager"})
+
+		StatusStrategy.PrepareForUpdate(ctx, newBastion, oldBastion)
+
+		Expect(newBastion.Status.Sessions).To(HaveLen(2))
+		Expect(newBastion.Status.Sessions[0].User).To(Equal("foo"))
+		Expect(newBastion.Status.Sessions[1].User).To(Equal("system:gardener-controller-manager"))
+	})
+})
+
+var _ = Describe("ExtendSession", func() {
+	It("should advance the expiration and record the extension", func() {
+		expires := metav1.NewTime(time.Now().Add(time.Hour))
+		bastion := &operations.Bastion{
+			Status: operations.BastionStatus{
+				ExpirationTimestamp: &expires,
+			},
+		}
+		ctx := request.WithUser(context.TODO(), &user.DefaultInfo{Name: "foo"})
+
+		ExtendSession(ctx, bastion, 2*time.Hour)
+
+		Expect(bastion.Status.ExpirationTimestamp.Time).To(Equal(expires.Add(2 * time.Hour)))
+		Expect(bastion.Status.Sessions).To(HaveLen(1))
+		Expect(bastion.Status.Sessions[0].Action).To(Equal(operations.BastionSessionActionExtend))
+		Expect(bastion.Status.Sessions[0].User).To(Equal("foo"))
+		Expect(bastion.Status.Sessions[0].ExpirationTimestamp).To(Equal(bastion.Status.ExpirationTimestamp))
+	})
+})
+
 var _ = Describe("heartbeat", func() {
 	It("should delete keepalive annotation", func() {
 		bastion := operations.Bastion{
@@ -217,6 +313,23 @@ var _ = Describe("heartbeat", func() {
 
 		Expect(expires).Should(BeTemporally(">", heartbeat))
 	})
+
+	It("should not advance the expiration of bastions with session duration", func() {
+		expires := metav1.NewTime(time.Now().Add(time.Minute))
+		bastion := operations.Bastion{
+			Spec: operations.BastionSpec{
+				SessionDuration: &metav1.Duration{Duration: time.Hour},
+			},
+			Status: operations.BastionStatus{
+				ExpirationTimestamp: &expires,
+			},
+		}
+
+		Strategy.heartbeat(&bastion)
+
+		Expect(bastion.Status.LastHeartbeatTimestamp).NotTo(BeNil())
+		Expect(bastion.Status.ExpirationTimestamp).To(Equal(&expires))
+	})
 })
 
 func newBastion(shootName string, seedName string) *operations.Bastion {
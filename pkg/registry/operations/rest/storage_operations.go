@@ -47,6 +47,7 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	bastionStorage := bastionstore.NewStorage(restOptionsGetter)
 	storage["bastions"] = bastionStorage.Bastion
 	storage["bastions/status"] = bastionStorage.Status
+	storage["bastions/extend"] = bastionStorage.Extend
 
 	return storage
 }
@@ -155,6 +155,33 @@ type ManagedResourceControllerConfig struct {
 	// will have key `resources.gardener.cloud/managed-by`.
 	// Default: gardener
 	ManagedByLabelValue *string
+	// DriftDetection is the configuration for detecting objects which were modified out of band.
+	DriftDetection *DriftDetectionConfig
+}
+
+// DriftDetectionMode is a type alias for the drift detection mode string.
+type DriftDetectionMode string
+
+const (
+	// DriftDetectionModeRevert reports drifted objects and reverts them to their desired state.
+	DriftDetectionModeRevert DriftDetectionMode = "Revert"
+	// DriftDetectionModeReport only reports drifted objects but does not revert them to their desired state.
+	DriftDetectionModeReport DriftDetectionMode = "Report"
+)
+
+// DriftDetectionConfig is the configuration for detecting objects managed by ManagedResources which were modified out
+// of band, i.e., whose actual state differs from the desired state although the desired state did not change.
+type DriftDetectionConfig struct {
+	// Enabled defines whether drift detection is enabled.
+	Enabled bool
+	// Mode defines how drifted objects are handled. With `Revert`, drifted objects are reported and reverted to their
+	// desired state. With `Report`, drifted objects are only reported.
+	Mode *DriftDetectionMode
+	// RetentionPeriod is the duration for which drifted objects are still reported in the ManagedResource status after
+	// the drift has been detected the last time.
+	RetentionPeriod *metav1.Duration
+	// Metrics defines whether metrics about drifted objects are exposed.
+	Metrics bool
 }
 
 // NetworkPolicyControllerConfig is the configuration for the networkpolicy controller.
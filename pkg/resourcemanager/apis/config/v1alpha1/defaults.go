@@ -148,6 +148,17 @@ func SetDefaults_ManagedResourceControllerConfig(obj *ManagedResourceControllerC
 	}
 }
 
+// SetDefaults_DriftDetectionConfig sets defaults for the DriftDetectionConfig object.
+func SetDefaults_DriftDetectionConfig(obj *DriftDetectionConfig) {
+	if obj.Mode == nil {
+		mode := DriftDetectionModeRevert
+		obj.Mode = &mode
+	}
+	if obj.RetentionPeriod == nil {
+		obj.RetentionPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+}
+
 // SetDefaults_SecretControllerConfig sets defaults for the SecretControllerConfig object.
 func SetDefaults_SecretControllerConfig(obj *SecretControllerConfig) {
 	if obj.ConcurrentSyncs == nil {
@@ -354,6 +354,29 @@ var _ = Describe("ResourceManager defaulting", func() {
 			Expect(obj.Controllers.ManagedResource.AlwaysUpdate).To(PointTo(BeTrue()))
 			Expect(obj.Controllers.ManagedResource.ManagedByLabelValue).To(PointTo(Equal("foo")))
 		})
+
+		It("should default the DriftDetectionConfig", func() {
+			obj.Controllers.ManagedResource = ManagedResourceControllerConfig{DriftDetection: &DriftDetectionConfig{Enabled: true}}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedResource.DriftDetection.Mode).To(PointTo(Equal(DriftDetectionModeRevert)))
+			Expect(obj.Controllers.ManagedResource.DriftDetection.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+		})
+
+		It("should not overwrite already set values for DriftDetectionConfig", func() {
+			mode := DriftDetectionModeReport
+			obj.Controllers.ManagedResource = ManagedResourceControllerConfig{DriftDetection: &DriftDetectionConfig{
+				Enabled:         true,
+				Mode:            &mode,
+				RetentionPeriod: &metav1.Duration{Duration: time.Hour},
+			}}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedResource.DriftDetection.Mode).To(PointTo(Equal(DriftDetectionModeReport)))
+			Expect(obj.Controllers.ManagedResource.DriftDetection.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
 	})
 
 	Describe("SecretControllerConfig defaulting", func() {
@@ -172,6 +172,39 @@ type ManagedResourceControllerConfig struct {
 	// Default: gardener
 	// +optional
 	ManagedByLabelValue *string `json:"managedByLabelValue,omitempty"`
+	// DriftDetection is the configuration for detecting objects which were modified out of band.
+	// +optional
+	DriftDetection *DriftDetectionConfig `json:"driftDetection,omitempty"`
+}
+
+// DriftDetectionMode is a type alias for the drift detection mode string.
+type DriftDetectionMode string
+
+const (
+	// DriftDetectionModeRevert reports drifted objects and reverts them to their desired state.
+	DriftDetectionModeRevert DriftDetectionMode = "Revert"
+	// DriftDetectionModeReport only reports drifted objects but does not revert them to their desired state.
+	DriftDetectionModeReport DriftDetectionMode = "Report"
+)
+
+// DriftDetectionConfig is the configuration for detecting objects managed by ManagedResources which were modified out
+// of band, i.e., whose actual state differs from the desired state although the desired state did not change.
+type DriftDetectionConfig struct {
+	// Enabled defines whether drift detection is enabled.
+	Enabled bool `json:"enabled"`
+	// Mode defines how drifted objects are handled. With `Revert`, drifted objects are reported and reverted to their
+	// desired state. With `Report`, drifted objects are only reported.
+	// Default: Revert
+	// +optional
+	Mode *DriftDetectionMode `json:"mode,omitempty"`
+	// RetentionPeriod is the duration for which drifted objects are still reported in the ManagedResource status after
+	// the drift has been detected the last time.
+	// Default: 24h
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+	// Metrics defines whether metrics about drifted objects are exposed.
+	// +optional
+	Metrics bool `json:"metrics,omitempty"`
 }
 
 // NetworkPolicyControllerConfig is the configuration for the networkpolicy controller.
@@ -60,6 +60,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DriftDetectionConfig)(nil), (*config.DriftDetectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(a.(*DriftDetectionConfig), b.(*config.DriftDetectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DriftDetectionConfig)(nil), (*DriftDetectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(a.(*config.DriftDetectionConfig), b.(*DriftDetectionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EndpointSliceHintsWebhookConfig)(nil), (*config.EndpointSliceHintsWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EndpointSliceHintsWebhookConfig_To_config_EndpointSliceHintsWebhookConfig(a.(*EndpointSliceHintsWebhookConfig), b.(*config.EndpointSliceHintsWebhookConfig), scope)
 	}); err != nil {
@@ -381,6 +391,32 @@ func Convert_config_ClientConnection_To_v1alpha1_ClientConnection(in *config.Cli
 	return autoConvert_config_ClientConnection_To_v1alpha1_ClientConnection(in, out, s)
 }
 
+func autoConvert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(in *DriftDetectionConfig, out *config.DriftDetectionConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Mode = (*config.DriftDetectionMode)(unsafe.Pointer(in.Mode))
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	out.Metrics = in.Metrics
+	return nil
+}
+
+// Convert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(in *DriftDetectionConfig, out *config.DriftDetectionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(in, out, s)
+}
+
+func autoConvert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(in *config.DriftDetectionConfig, out *DriftDetectionConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Mode = (*DriftDetectionMode)(unsafe.Pointer(in.Mode))
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	out.Metrics = in.Metrics
+	return nil
+}
+
+// Convert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig is an autogenerated conversion function.
+func Convert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(in *config.DriftDetectionConfig, out *DriftDetectionConfig, s conversion.Scope) error {
+	return autoConvert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_EndpointSliceHintsWebhookConfig_To_config_EndpointSliceHintsWebhookConfig(in *EndpointSliceHintsWebhookConfig, out *config.EndpointSliceHintsWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -592,6 +628,7 @@ func autoConvert_v1alpha1_ManagedResourceControllerConfig_To_config_ManagedResou
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.AlwaysUpdate = (*bool)(unsafe.Pointer(in.AlwaysUpdate))
 	out.ManagedByLabelValue = (*string)(unsafe.Pointer(in.ManagedByLabelValue))
+	out.DriftDetection = (*config.DriftDetectionConfig)(unsafe.Pointer(in.DriftDetection))
 	return nil
 }
 
@@ -605,6 +642,7 @@ func autoConvert_config_ManagedResourceControllerConfig_To_v1alpha1_ManagedResou
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.AlwaysUpdate = (*bool)(unsafe.Pointer(in.AlwaysUpdate))
 	out.ManagedByLabelValue = (*string)(unsafe.Pointer(in.ManagedByLabelValue))
+	out.DriftDetection = (*DriftDetectionConfig)(unsafe.Pointer(in.DriftDetection))
 	return nil
 }
 
@@ -71,6 +71,32 @@ func (in *ClientConnection) DeepCopy() *ClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionConfig) DeepCopyInto(out *DriftDetectionConfig) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(DriftDetectionMode)
+		**out = **in
+	}
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionConfig.
+func (in *DriftDetectionConfig) DeepCopy() *DriftDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointSliceHintsWebhookConfig) DeepCopyInto(out *EndpointSliceHintsWebhookConfig) {
 	*out = *in
@@ -271,6 +297,11 @@ func (in *ManagedResourceControllerConfig) DeepCopyInto(out *ManagedResourceCont
 		*out = new(string)
 		**out = **in
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -128,6 +128,24 @@ func validateManagedResourceControllerConfiguration(conf config.ManagedResourceC
 		allErrs = append(allErrs, field.Required(fldPath.Child("managedByLabelValue"), "must specify value of managed-by label"))
 	}
 
+	if conf.DriftDetection != nil && conf.DriftDetection.Enabled {
+		allErrs = append(allErrs, validateDriftDetectionConfiguration(*conf.DriftDetection, fldPath.Child("driftDetection"))...)
+	}
+
+	return allErrs
+}
+
+func validateDriftDetectionConfiguration(conf config.DriftDetectionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if supportedModes := sets.New(config.DriftDetectionModeRevert, config.DriftDetectionModeReport); conf.Mode == nil || !supportedModes.Has(*conf.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), conf.Mode, []string{string(config.DriftDetectionModeRevert), string(config.DriftDetectionModeReport)}))
+	}
+
+	if conf.RetentionPeriod == nil || conf.RetentionPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionPeriod"), conf.RetentionPeriod, "must not be negative"))
+	}
+
 	return allErrs
 }
 
@@ -320,6 +320,31 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+				It("should return errors because the drift detection configuration is invalid", func() {
+					mode := config.DriftDetectionMode("foo")
+					conf.Controllers.ManagedResource.DriftDetection = &config.DriftDetectionConfig{
+						Enabled:         true,
+						Mode:            &mode,
+						RetentionPeriod: &metav1.Duration{Duration: -time.Hour},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("controllers.managedResources.driftDetection.mode"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.managedResources.driftDetection.retentionPeriod"),
+						})),
+					))
+				})
+
+				It("should not validate the drift detection configuration if it is disabled", func() {
+					conf.Controllers.ManagedResource.DriftDetection = &config.DriftDetectionConfig{}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
 			})
 		})
 
@@ -0,0 +1,230 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedresource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// maxDriftedFields is the maximum number of changed fields which are reported per drifted object to limit the size of
+// the ManagedResource status.
+const maxDriftedFields = 10
+
+// ignoredDriftFields are fields which are maintained by the API server and not part of the desired state.
+var ignoredDriftFields = [][]string{
+	{"metadata", "resourceVersion"},
+	{"metadata", "managedFields"},
+	{"metadata", "generation"},
+	{"status"},
+}
+
+func (r *Reconciler) driftDetectionEnabled() bool {
+	return r.Config.DriftDetection != nil && r.Config.DriftDetection.Enabled
+}
+
+// driftMetricsEnabled returns whether the drift detection metrics are registered and shall be recorded.
+func (r *Reconciler) driftMetricsEnabled() bool {
+	return r.driftDetectionEnabled() && r.Config.DriftDetection.Metrics
+}
+
+func (r *Reconciler) revertDrift() bool {
+	if !r.driftDetectionEnabled() || r.Config.DriftDetection.Mode == nil {
+		return true
+	}
+	return *r.Config.DriftDetection.Mode != config.DriftDetectionModeReport
+}
+
+// changedFields returns the sorted paths to all fields which are set in the manifest of an object and whose actual
+// value differs from the desired value. The desired object is the result of merging the manifest into the actual
+// object. Fields which are not set in the manifest (e.g., fields defaulted by the API server) are not compared. Lists
+// are compared element-wise if their lengths match, otherwise they are compared as a whole.
+func changedFields(actual, desired, manifest *unstructured.Unstructured) []string {
+	actualObj, desiredObj, manifestObj := actual.DeepCopy().Object, desired.DeepCopy().Object, manifest.DeepCopy().Object
+	for _, fields := range ignoredDriftFields {
+		unstructured.RemoveNestedField(actualObj, fields...)
+		unstructured.RemoveNestedField(desiredObj, fields...)
+		unstructured.RemoveNestedField(manifestObj, fields...)
+	}
+
+	var paths []string
+	collectChangedFields(actualObj, desiredObj, manifestObj, nil, &paths)
+	sort.Strings(paths)
+	return paths
+}
+
+func collectChangedFields(actual, desired, manifest interface{}, path *field.Path, paths *[]string) {
+	switch manifestValue := manifest.(type) {
+	case nil:
+		// the field is not set in the manifest
+		return
+
+	case map[string]interface{}:
+		actualMap, actualIsMap := actual.(map[string]interface{})
+		desiredMap, desiredIsMap := desired.(map[string]interface{})
+		if actualIsMap && desiredIsMap {
+			for key := range manifestValue {
+				collectChangedFields(actualMap[key], desiredMap[key], manifestValue[key], childPath(path, key), paths)
+			}
+			return
+		}
+
+	case []interface{}:
+		actualList, actualIsList := actual.([]interface{})
+		desiredList, desiredIsList := desired.([]interface{})
+		if actualIsList && desiredIsList && len(actualList) == len(desiredList) && len(desiredList) == len(manifestValue) {
+			for i := range manifestValue {
+				collectChangedFields(actualList[i], desiredList[i], manifestValue[i], indexPath(path, i), paths)
+			}
+			return
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(actual, desired) && path != nil {
+		*paths = append(*paths, path.String())
+	}
+}
+
+func childPath(path *field.Path, key string) *field.Path {
+	if path == nil {
+		return field.NewPath(key)
+	}
+	// keys of labels and annotations usually contain dots, hence they are rendered as map keys
+	if strings.ContainsAny(key, "./") {
+		return path.Key(key)
+	}
+	return path.Child(key)
+}
+
+func indexPath(path *field.Path, index int) *field.Path {
+	if path == nil {
+		return field.NewPath("").Index(index)
+	}
+	return path.Index(index)
+}
+
+func driftedObjectReference(obj *unstructured.Unstructured, fields []string, now time.Time) resourcesv1alpha1.DriftedObjectReference {
+	if len(fields) > maxDriftedFields {
+		fields = fields[:maxDriftedFields]
+	}
+
+	ref := resourcesv1alpha1.DriftedObjectReference{
+		Fields:            fields,
+		LastDetectionTime: metav1.NewTime(now),
+	}
+	ref.APIVersion, ref.Kind = obj.GetAPIVersion(), obj.GetKind()
+	ref.Namespace, ref.Name = obj.GetNamespace(), obj.GetName()
+	return ref
+}
+
+// mergeDriftedResources merges the newly detected drifted objects into the already reported ones. Reported objects
+// which are no longer managed or whose drift was detected the last time before the retention period are removed.
+func mergeDriftedResources(
+	reported []resourcesv1alpha1.DriftedObjectReference,
+	detected []resourcesv1alpha1.DriftedObjectReference,
+	resources []resourcesv1alpha1.ObjectReference,
+	now time.Time,
+	retentionPeriod time.Duration,
+) []resourcesv1alpha1.DriftedObjectReference {
+	var (
+		managed = make(map[string]struct{}, len(resources))
+		merged  = make(map[string]resourcesv1alpha1.DriftedObjectReference, len(reported)+len(detected))
+		result  []resourcesv1alpha1.DriftedObjectReference
+	)
+
+	for _, ref := range resources {
+		managed[objectKeyByReference(ref)] = struct{}{}
+	}
+
+	for _, ref := range reported {
+		if now.Sub(ref.LastDetectionTime.Time) > retentionPeriod {
+			continue
+		}
+		merged[driftedObjectKey(ref)] = ref
+	}
+
+	for _, ref := range detected {
+		merged[driftedObjectKey(ref)] = ref
+	}
+
+	for key, ref := range merged {
+		if _, ok := managed[key]; ok {
+			result = append(result, ref)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return driftedObjectKey(result[i]) < driftedObjectKey(result[j])
+	})
+
+	return result
+}
+
+func driftedObjectKey(ref resourcesv1alpha1.DriftedObjectReference) string {
+	return objectKey(ref.GroupVersionKind().Group, ref.Kind, ref.Namespace, ref.Name)
+}
+
+func (r *Reconciler) updatedConditionResourcesDrifted(mr *resourcesv1alpha1.ManagedResource, driftedResources []resourcesv1alpha1.DriftedObjectReference) gardencorev1beta1.Condition {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+
+	if len(driftedResources) == 0 {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionNoDriftDetected,
+			"The actual state of all resources matches their desired state.")
+	}
+
+	objects := make([]string, 0, len(driftedResources))
+	for _, ref := range driftedResources {
+		objects = append(objects, fmt.Sprintf("%s %s", ref.Kind, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}))
+	}
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionDriftDetected,
+		fmt.Sprintf("The actual state of %d resource(s) was modified out of band: %s", len(objects), strings.Join(objects, ", ")))
+}
+
+// recordDrift increments the drift counter for all detected drifted objects which transitioned to the drifted state.
+// Drift is reverted in the `Revert` mode, hence, every detection is a new drift. In the `Report` mode, the modifications
+// are kept and detected again in every reconciliation, hence, objects which are already reported in the status of the
+// ManagedResource are not counted again.
+func (r *Reconciler) recordDrift(mr *resourcesv1alpha1.ManagedResource, driftedResources []resourcesv1alpha1.DriftedObjectReference) {
+	if !r.driftMetricsEnabled() {
+		return
+	}
+
+	reported := make(map[string]struct{}, len(mr.Status.DriftedResources))
+	if !r.revertDrift() {
+		for _, ref := range mr.Status.DriftedResources {
+			reported[driftedObjectKey(ref)] = struct{}{}
+		}
+	}
+
+	for _, ref := range driftedResources {
+		if _, ok := reported[driftedObjectKey(ref)]; ok {
+			continue
+		}
+		driftedObjectsTotal.WithLabelValues(mr.Namespace, mr.Name, ref.Kind).Inc()
+	}
+}
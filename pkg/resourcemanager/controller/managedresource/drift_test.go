@@ -0,0 +1,381 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedresource
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+var _ = Describe("Drift", func() {
+	var (
+		now = time.Date(2023, 10, 16, 12, 0, 0, 0, time.UTC)
+
+		driftedRef = func(kind, name string, fields []string, detectionTime time.Time) resourcesv1alpha1.DriftedObjectReference {
+			return resourcesv1alpha1.DriftedObjectReference{
+				ObjectReference:   corev1.ObjectReference{APIVersion: "v1", Kind: kind, Namespace: "default", Name: name},
+				Fields:            fields,
+				LastDetectionTime: metav1.NewTime(detectionTime),
+			}
+		}
+		managedRef = func(kind, name string) resourcesv1alpha1.ObjectReference {
+			return resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: kind, Namespace: "default", Name: name}}
+		}
+	)
+
+	Describe("#changedFields", func() {
+		var actual, desired, manifest *unstructured.Unstructured
+
+		BeforeEach(func() {
+			actual = &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":            "foo",
+					"resourceVersion": "42",
+					"labels":          map[string]interface{}{"app.kubernetes.io/name": "foo"},
+				},
+				"data": map[string]interface{}{"key": "value"},
+			}}
+			desired = actual.DeepCopy()
+			manifest = actual.DeepCopy()
+			manifest.SetResourceVersion("")
+		})
+
+		It("should return nothing if the objects are equal", func() {
+			Expect(changedFields(actual, desired, manifest)).To(BeEmpty())
+		})
+
+		It("should ignore fields maintained by the API server", func() {
+			desired.SetResourceVersion("")
+			desired.Object["status"] = map[string]interface{}{"foo": "bar"}
+			manifest.Object["status"] = map[string]interface{}{"foo": "bar"}
+
+			Expect(changedFields(actual, desired, manifest)).To(BeEmpty())
+		})
+
+		It("should ignore fields which are not set in the manifest", func() {
+			actual.Object["data"] = map[string]interface{}{"key": "value", "other": "value"}
+			actual.SetAnnotations(map[string]string{"foo": "bar"})
+
+			Expect(changedFields(actual, desired, manifest)).To(BeEmpty())
+		})
+
+		It("should return the sorted paths of all changed fields", func() {
+			actual.Object["data"] = map[string]interface{}{"key": "tampered", "other": "value"}
+			actual.SetLabels(map[string]string{"app.kubernetes.io/name": "bar"})
+
+			Expect(changedFields(actual, desired, manifest)).To(Equal([]string{
+				"data.key",
+				"metadata.labels[app.kubernetes.io/name]",
+			}))
+		})
+
+		It("should compare lists as a whole if their lengths differ", func() {
+			actual.Object["spec"] = map[string]interface{}{"items": []interface{}{"a", "b"}}
+			desired.Object["spec"] = map[string]interface{}{"items": []interface{}{"a"}}
+			manifest.Object["spec"] = map[string]interface{}{"items": []interface{}{"a"}}
+
+			Expect(changedFields(actual, desired, manifest)).To(Equal([]string{"spec.items"}))
+		})
+
+		Context("Deployment", func() {
+			var deployment *appsv1.Deployment
+
+			BeforeEach(func() {
+				deployment = &appsv1.Deployment{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: pointer.Int32(1),
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "foo"}},
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{
+									Name:  "foo",
+									Image: "foo:v1",
+									Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+								}},
+							},
+						},
+					},
+				}
+				manifest = toUnstructured(deployment)
+
+				// simulate the defaulting of the API server
+				deployment.ResourceVersion = "42"
+				deployment.Spec.Strategy = appsv1.DeploymentStrategy{
+					Type: appsv1.RollingUpdateDeploymentStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateDeployment{
+						MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+						MaxSurge:       &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+					},
+				}
+				deployment.Spec.RevisionHistoryLimit = pointer.Int32(10)
+				deployment.Spec.ProgressDeadlineSeconds = pointer.Int32(600)
+				deployment.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyAlways
+				deployment.Spec.Template.Spec.DNSPolicy = corev1.DNSClusterFirst
+				deployment.Spec.Template.Spec.SchedulerName = corev1.DefaultSchedulerName
+				deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = pointer.Int64(30)
+				deployment.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+				deployment.Spec.Template.Spec.Containers[0].TerminationMessagePath = corev1.TerminationMessagePathDefault
+				deployment.Spec.Template.Spec.Containers[0].Ports[0].Protocol = corev1.ProtocolTCP
+				deployment.Status = appsv1.DeploymentStatus{Replicas: 1, ReadyReplicas: 1}
+			})
+
+			It("should ignore fields defaulted by the API server", func() {
+				actual = toUnstructured(deployment)
+				desired = mergedObject(manifest, actual, false)
+
+				Expect(changedFields(actual, desired, manifest)).To(BeEmpty())
+			})
+
+			It("should ignore preserved replicas", func() {
+				deployment.Spec.Replicas = pointer.Int32(3)
+				actual = toUnstructured(deployment)
+				desired = mergedObject(manifest, actual, true)
+
+				Expect(changedFields(actual, desired, manifest)).To(BeEmpty())
+			})
+
+			It("should return the changed fields of the pod template", func() {
+				deployment.Spec.Replicas = pointer.Int32(3)
+				deployment.Spec.Template.Spec.Containers[0].Image = "foo:tampered"
+				deployment.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort = 9090
+				actual = toUnstructured(deployment)
+				desired = mergedObject(manifest, actual, false)
+
+				Expect(changedFields(actual, desired, manifest)).To(Equal([]string{
+					"spec.replicas",
+					"spec.template.spec.containers[0].image",
+					"spec.template.spec.containers[0].ports[0].containerPort",
+				}))
+			})
+		})
+
+		Context("Service", func() {
+			var service *corev1.Service
+
+			BeforeEach(func() {
+				service = &corev1.Service{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+					Spec: corev1.ServiceSpec{
+						Selector: map[string]string{"app": "foo"},
+						Ports:    []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)}},
+					},
+				}
+				manifest = toUnstructured(service)
+
+				// simulate the defaulting of the API server
+				service.ResourceVersion = "42"
+				service.Spec.Type = corev1.ServiceTypeClusterIP
+				service.Spec.ClusterIP = "10.0.0.1"
+				service.Spec.ClusterIPs = []string{"10.0.0.1"}
+				service.Spec.SessionAffinity = corev1.ServiceAffinityNone
+				service.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+				service.Spec.IPFamilyPolicy = (*corev1.IPFamilyPolicy)(pointer.String(string(corev1.IPFamilyPolicySingleStack)))
+				service.Spec.InternalTrafficPolicy = (*corev1.ServiceInternalTrafficPolicy)(pointer.String(string(corev1.ServiceInternalTrafficPolicyCluster)))
+				service.Spec.Ports[0].Protocol = corev1.ProtocolTCP
+			})
+
+			It("should ignore fields defaulted by the API server", func() {
+				actual = toUnstructured(service)
+				desired = mergedObject(manifest, actual, false)
+
+				Expect(changedFields(actual, desired, manifest)).To(BeEmpty())
+			})
+
+			It("should return the changed fields", func() {
+				service.Spec.Selector = map[string]string{"app": "bar"}
+				service.Spec.Ports[0].TargetPort = intstr.FromInt(9090)
+				actual = toUnstructured(service)
+				desired = mergedObject(manifest, actual, false)
+
+				Expect(changedFields(actual, desired, manifest)).To(Equal([]string{
+					"spec.ports[0].targetPort",
+					"spec.selector.app",
+				}))
+			})
+		})
+	})
+
+	Describe("#driftedObjectReference", func() {
+		It("should limit the number of reported fields", func() {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("v1")
+			obj.SetKind("ConfigMap")
+			obj.SetNamespace("default")
+			obj.SetName("foo")
+
+			fields := make([]string, 0, maxDriftedFields+5)
+			for i := 0; i < maxDriftedFields+5; i++ {
+				fields = append(fields, "data.key")
+			}
+
+			ref := driftedObjectReference(obj, fields, now)
+			Expect(ref.ObjectReference).To(Equal(corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "foo"}))
+			Expect(ref.Fields).To(HaveLen(maxDriftedFields))
+			Expect(ref.LastDetectionTime.Time).To(Equal(now))
+		})
+	})
+
+	Describe("#mergeDriftedResources", func() {
+		It("should merge detected into reported objects and drop expired or unmanaged ones", func() {
+			reported := []resourcesv1alpha1.DriftedObjectReference{
+				driftedRef("ConfigMap", "still-reported", []string{"data"}, now.Add(-time.Hour)),
+				driftedRef("ConfigMap", "expired", []string{"data"}, now.Add(-25*time.Hour)),
+				driftedRef("ConfigMap", "unmanaged", []string{"data"}, now.Add(-time.Hour)),
+				driftedRef("Secret", "detected-again", []string{"data"}, now.Add(-time.Hour)),
+			}
+			detected := []resourcesv1alpha1.DriftedObjectReference{
+				driftedRef("Secret", "detected-again", []string{"type"}, now),
+				driftedRef("Service", "new", []string{"spec.ports"}, now),
+			}
+			resources := []resourcesv1alpha1.ObjectReference{
+				managedRef("ConfigMap", "still-reported"),
+				managedRef("ConfigMap", "expired"),
+				managedRef("Secret", "detected-again"),
+				managedRef("Service", "new"),
+			}
+
+			Expect(mergeDriftedResources(reported, detected, resources, now, 24*time.Hour)).To(Equal([]resourcesv1alpha1.DriftedObjectReference{
+				driftedRef("ConfigMap", "still-reported", []string{"data"}, now.Add(-time.Hour)),
+				driftedRef("Secret", "detected-again", []string{"type"}, now),
+				driftedRef("Service", "new", []string{"spec.ports"}, now),
+			}))
+		})
+	})
+
+	Describe("#updatedConditionResourcesDrifted", func() {
+		var (
+			r  *Reconciler
+			mr *resourcesv1alpha1.ManagedResource
+		)
+
+		BeforeEach(func() {
+			r = &Reconciler{Clock: testclock.NewFakeClock(now)}
+			mr = &resourcesv1alpha1.ManagedResource{}
+		})
+
+		It("should report that no drift was detected", func() {
+			condition := r.updatedConditionResourcesDrifted(mr, nil)
+
+			Expect(condition.Type).To(Equal(resourcesv1alpha1.ResourcesDrifted))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionNoDriftDetected))
+		})
+
+		It("should report the drifted objects", func() {
+			condition := r.updatedConditionResourcesDrifted(mr, []resourcesv1alpha1.DriftedObjectReference{
+				driftedRef("ConfigMap", "foo", []string{"data"}, now),
+			})
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionDriftDetected))
+			Expect(condition.Message).To(Equal("The actual state of 1 resource(s) was modified out of band: ConfigMap default/foo"))
+		})
+	})
+
+	Describe("#recordDrift", func() {
+		var (
+			mr       *resourcesv1alpha1.ManagedResource
+			detected []resourcesv1alpha1.DriftedObjectReference
+		)
+
+		BeforeEach(func() {
+			mr = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			detected = []resourcesv1alpha1.DriftedObjectReference{driftedRef("ConfigMap", "foo", []string{"data"}, now)}
+			driftedObjectsTotal.Reset()
+		})
+
+		It("should not record drift if metrics are disabled", func() {
+			r := &Reconciler{Config: config.ManagedResourceControllerConfig{DriftDetection: &config.DriftDetectionConfig{Enabled: true}}}
+			r.recordDrift(mr, detected)
+
+			Expect(testutil.CollectAndCount(driftedObjectsTotal)).To(BeZero())
+		})
+
+		It("should record drift if metrics are enabled", func() {
+			r := &Reconciler{Config: config.ManagedResourceControllerConfig{DriftDetection: &config.DriftDetectionConfig{Enabled: true, Metrics: true}}}
+			r.recordDrift(mr, detected)
+
+			Expect(testutil.ToFloat64(driftedObjectsTotal.WithLabelValues("bar", "foo", "ConfigMap"))).To(Equal(float64(1)))
+		})
+
+		It("should record drift of already reported objects again in revert mode", func() {
+			r := &Reconciler{Config: config.ManagedResourceControllerConfig{DriftDetection: &config.DriftDetectionConfig{Enabled: true, Metrics: true}}}
+			mr.Status.DriftedResources = detected
+
+			r.recordDrift(mr, detected)
+			r.recordDrift(mr, detected)
+
+			Expect(testutil.ToFloat64(driftedObjectsTotal.WithLabelValues("bar", "foo", "ConfigMap"))).To(Equal(float64(2)))
+		})
+
+		It("should only record the transition to the drifted state in report mode", func() {
+			mode := config.DriftDetectionModeReport
+			r := &Reconciler{Config: config.ManagedResourceControllerConfig{DriftDetection: &config.DriftDetectionConfig{Enabled: true, Metrics: true, Mode: &mode}}}
+
+			r.recordDrift(mr, detected)
+			mr.Status.DriftedResources = detected
+			r.recordDrift(mr, detected)
+			r.recordDrift(mr, append(detected, driftedRef("Secret", "foo", []string{"data"}, now)))
+
+			Expect(testutil.ToFloat64(driftedObjectsTotal.WithLabelValues("bar", "foo", "ConfigMap"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(driftedObjectsTotal.WithLabelValues("bar", "foo", "Secret"))).To(Equal(float64(1)))
+		})
+	})
+
+	Describe("#revertDrift", func() {
+		It("should revert drift by default", func() {
+			Expect((&Reconciler{}).revertDrift()).To(BeTrue())
+		})
+
+		It("should not revert drift in report mode", func() {
+			mode := config.DriftDetectionModeReport
+			r := &Reconciler{Config: config.ManagedResourceControllerConfig{DriftDetection: &config.DriftDetectionConfig{Enabled: true, Mode: &mode}}}
+			Expect(r.revertDrift()).To(BeFalse())
+		})
+	})
+})
+
+func toUnstructured(obj interface{}) *unstructured.Unstructured {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return &unstructured.Unstructured{Object: object}
+}
+
+func mergedObject(manifest, actual *unstructured.Unstructured, preserveReplicas bool) *unstructured.Unstructured {
+	desired := actual.DeepCopy()
+	ExpectWithOffset(1, merge("origin", manifest.DeepCopy(), desired, false, nil, false, nil, preserveReplicas, false)).To(Succeed())
+	return desired
+}
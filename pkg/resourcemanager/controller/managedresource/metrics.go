@@ -0,0 +1,34 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedresource
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// driftedObjectsTotal defines the counter drifted_objects_total. It is only registered if drift detection metrics are
+// enabled.
+var driftedObjectsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "gardener_resource_manager",
+		Name:      "drifted_objects_total",
+		Help:      "Total number of times objects managed by ManagedResources transitioned to a state which differs from their desired state.",
+	},
+	[]string{
+		"namespace",
+		"managed_resource",
+		"kind",
+	},
+)
@@ -267,6 +267,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 				var found bool
 				newObj.oldInformation, found = existingResourcesIndex.Lookup(objectReference)
+				newObj.detectDrift = found
 				decodedObj = nil
 
 				if ignoreMode(obj) {
@@ -288,6 +289,14 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	// calculate the checksum for the referenced secrets data.
 	secretsDataChecksum := hex.EncodeToString(hash.Sum(nil))
 
+	// Drift can only be detected for objects whose desired state did not change since the last reconciliation,
+	// otherwise differences between the actual and the desired state are expected.
+	if !r.driftDetectionEnabled() || mr.Status.ObservedGeneration != mr.Generation || pointer.StringDeref(mr.Status.SecretsDataChecksum, "") != secretsDataChecksum {
+		for i := range newResourcesObjects {
+			newResourcesObjects[i].detectDrift = false
+		}
+	}
+
 	// sort object references before updating status, to keep consistent ordering
 	// (otherwise, the order will be different on each update)
 	sortObjectReferences(newResourcesObjectReferences)
@@ -353,7 +362,9 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	}
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
+	detectedDriftedResources, err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences)
+	r.recordDrift(mr, detectedDriftedResources)
+	if err != nil {
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
@@ -368,7 +379,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
 	}
 
-	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
+	updatedConditions := []gardencorev1beta1.Condition{conditionResourcesApplied}
+	if r.driftDetectionEnabled() {
+		mr.Status.DriftedResources = mergeDriftedResources(mr.Status.DriftedResources, detectedDriftedResources, newResourcesObjectReferences, r.Clock.Now(), r.Config.DriftDetection.RetentionPeriod.Duration)
+		updatedConditions = append(updatedConditions, r.updatedConditionResourcesDrifted(mr, mr.Status.DriftedResources))
+	} else {
+		mr.Status.DriftedResources = nil
+		mr.Status.Conditions = v1beta1helper.RemoveConditions(mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+	}
+
+	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, updatedConditions...); err != nil {
 		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 	}
 
@@ -471,7 +491,9 @@ func (r *Reconciler) updateConditionsForDeletion(ctx context.Context, mr *resour
 	return updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing)
 }
 
-func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) error {
+// applyNewResources applies the given objects to the target cluster. If drift detection is enabled, it returns the
+// objects whose actual state differed from their desired state.
+func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) ([]resourcesv1alpha1.DriftedObjectReference, error) {
 	newResourcesObjects = sortByKind(newResourcesObjects)
 
 	// get all HPA and HVPA targetRefs to check if we should prevent overwriting replicas and/or resource requirements.
@@ -479,15 +501,18 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 	// and therefore don't interfere with the resource manager.
 	horizontallyScaledObjects, verticallyScaledObjects, err := computeAllScaledObjectKeys(ctx, r.TargetClient)
 	if err != nil {
-		return fmt.Errorf("failed to compute all HPA and HVPA target ref object keys: %w", err)
+		return nil, fmt.Errorf("failed to compute all HPA and HVPA target ref object keys: %w", err)
 	}
 
+	var driftedResources []resourcesv1alpha1.DriftedObjectReference
+
 	for _, obj := range newResourcesObjects {
 		var (
 			current            = obj.obj.DeepCopy()
 			resource           = unstructuredToString(obj.obj)
 			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
 			scaledVertically   = isScaled(obj.obj, verticallyScaledObjects, equivalences)
+			driftedFields      []string
 		)
 
 		resourceLogger := log.WithValues("resource", resource)
@@ -512,22 +537,48 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
 			}
 
-			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally, scaledVertically)
+			// The object only exists in the target cluster if it has a resource version, otherwise it is about to be
+			// created and cannot have drifted.
+			detectDrift := obj.detectDrift && current.GetResourceVersion() != ""
+
+			var actual *unstructured.Unstructured
+			if detectDrift {
+				actual = current.DeepCopy()
+			}
+
+			if err := merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally, scaledVertically); err != nil {
+				return err
+			}
+
+			if detectDrift {
+				driftedFields = changedFields(actual, current, obj.obj)
+				if len(driftedFields) > 0 && !r.revertDrift() {
+					// keep the actual state, drifted objects are only reported
+					actual.DeepCopyInto(current)
+				}
+			}
+
+			return nil
 		})
 		if err != nil {
 			if apierrors.IsConflict(err) {
-				return err
+				return driftedResources, err
 			}
 
 			if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
 				if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
-					return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+					return driftedResources, fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
 				}
 				// return error directly, so that the create after delete will be retried
-				return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+				return driftedResources, fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
 			}
 
-			return fmt.Errorf("error during apply of object %q: %s", resource, err)
+			return driftedResources, fmt.Errorf("error during apply of object %q: %s", resource, err)
+		}
+
+		if len(driftedFields) > 0 {
+			resourceLogger.Info("Detected drift of resource", "fields", driftedFields, "reverted", r.revertDrift())
+			driftedResources = append(driftedResources, driftedObjectReference(obj.obj, driftedFields, r.Clock.Now()))
 		}
 
 		switch operationResult {
@@ -540,7 +591,7 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 		}
 	}
 
-	return nil
+	return driftedResources, nil
 }
 
 // computeAllScaledObjectKeys returns two sets containing object keys (in the form `Group/Kind/Namespace/Name`).
@@ -1020,6 +1071,7 @@ type object struct {
 	oldInformation            resourcesv1alpha1.ObjectReference
 	forceOverwriteLabels      bool
 	forceOverwriteAnnotations bool
+	detectDrift               bool
 }
 
 type decodingError struct {
@@ -46,6 +46,35 @@ var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
+const (
+	// FilterPluginSeedUtilization is a filter plugin which filters out seeds whose utilization of allocatable shoots
+	// exceeds a configured percentage.
+	FilterPluginSeedUtilization FilterPluginName = "SeedUtilization"
+
+	// ScorePluginLeastShoots is a score plugin which prefers seeds with a smaller number of scheduled shoots.
+	ScorePluginLeastShoots ScorePluginName = "LeastShoots"
+	// ScorePluginSeedCapacity is a score plugin which prefers seeds with a larger share of free allocatable shoots.
+	ScorePluginSeedCapacity ScorePluginName = "SeedCapacity"
+	// ScorePluginShootsPerZone is a score plugin which prefers seeds with a smaller number of scheduled shoots per zone.
+	ScorePluginShootsPerZone ScorePluginName = "ShootsPerZone"
+	// ScorePluginSeedLabels is a score plugin which prefers seeds matching configured label selectors.
+	ScorePluginSeedLabels ScorePluginName = "SeedLabels"
+	// ScorePluginProviderCost is a score plugin which prefers seeds with lower configured provider cost hints.
+	ScorePluginProviderCost ScorePluginName = "ProviderCost"
+)
+
+// FilterPlugins defines all currently implemented filter plugins.
+var FilterPlugins = []FilterPluginName{FilterPluginSeedUtilization}
+
+// ScorePlugins defines all currently implemented score plugins.
+var ScorePlugins = []ScorePluginName{ScorePluginLeastShoots, ScorePluginSeedCapacity, ScorePluginShootsPerZone, ScorePluginSeedLabels, ScorePluginProviderCost}
+
+// FilterPluginName is the name of a filter plugin of the Shoot to Seed scheduler.
+type FilterPluginName string
+
+// ScorePluginName is the name of a score plugin of the Shoot to Seed scheduler.
+type ScorePluginName string
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SchedulerConfiguration provides the configuration for the Gardener scheduler
@@ -113,6 +142,78 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy
+	// Plugins configures the filter and score plugins which are used to determine the seed for a shoot.
+	Plugins *ShootSchedulerPlugins
+}
+
+// ShootSchedulerPlugins configures the filter and score plugins of the Shoot to Seed scheduler.
+type ShootSchedulerPlugins struct {
+	// Filter is a list of filter plugins which are executed in addition to the built-in filters. A seed is only
+	// considered as a candidate if it passes all filter plugins.
+	Filter []FilterPlugin
+	// Score is a list of score plugins which are used to rank the seed candidates. The seed with the highest weighted
+	// sum of all scores is chosen. If empty, the seed with the least number of scheduled shoots is chosen.
+	Score []ScorePlugin
+}
+
+// FilterPlugin configures a filter plugin of the Shoot to Seed scheduler.
+type FilterPlugin struct {
+	// Name is the name of the filter plugin.
+	Name FilterPluginName
+	// SeedUtilization contains the arguments for the SeedUtilization filter plugin.
+	SeedUtilization *SeedUtilizationArgs
+}
+
+// ScorePlugin configures a score plugin of the Shoot to Seed scheduler.
+type ScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name ScorePluginName
+	// Weight is the weight of the score plugin's score when computing the total score of a seed. Defaults to 1.
+	Weight *int32
+	// SeedLabels contains the arguments for the SeedLabels score plugin.
+	SeedLabels *SeedLabelsArgs
+	// ProviderCost contains the arguments for the ProviderCost score plugin.
+	ProviderCost *ProviderCostArgs
+}
+
+// SeedUtilizationArgs contains the arguments for the SeedUtilization filter plugin.
+type SeedUtilizationArgs struct {
+	// MaxUtilizationPercentage is the maximum percentage of the allocatable shoots of a seed which may be used.
+	// Seeds exceeding this percentage are filtered out. Seeds without allocatable shoots are never filtered out.
+	MaxUtilizationPercentage int32
+}
+
+// SeedLabelsArgs contains the arguments for the SeedLabels score plugin.
+type SeedLabelsArgs struct {
+	// Preferences is a list of label selectors with weights. The score of a seed is the sum of the weights of all
+	// preferences whose selector matches the seed's labels.
+	Preferences []SeedLabelPreference
+}
+
+// SeedLabelPreference is a label selector with a weight.
+type SeedLabelPreference struct {
+	// Selector is the label selector which is matched against the seed's labels.
+	Selector metav1.LabelSelector
+	// Weight is the weight which is added to the seed's score if the selector matches.
+	Weight int32
+}
+
+// ProviderCostArgs contains the arguments for the ProviderCost score plugin.
+type ProviderCostArgs struct {
+	// Costs is a list of cost hints for seed providers. Seeds with lower costs are preferred. Seeds without a matching
+	// cost hint are treated like the most expensive configured hint.
+	Costs []ProviderCostHint
+}
+
+// ProviderCostHint is a cost hint for seeds of a provider type and (optionally) region.
+type ProviderCostHint struct {
+	// Type is the provider type of the seed.
+	Type string
+	// Region is the region of the seed. If not set, the cost hint applies to all regions of the provider type which
+	// are not configured explicitly.
+	Region *string
+	// Cost is the relative cost of hosting a shoot control plane on a seed of this provider type and region.
+	Cost int32
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/pointer"
 )
 
 // SetDefaults_SchedulerConfiguration sets defaults for the configuration of the Gardener scheduler.
@@ -54,6 +55,14 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
+
+	if obj.Shoot.Plugins != nil {
+		for i := range obj.Shoot.Plugins.Score {
+			if obj.Shoot.Plugins.Score[i].Weight == nil {
+				obj.Shoot.Plugins.Score[i].Weight = pointer.Int32(1)
+			}
+		}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -98,6 +98,24 @@ var _ = Describe("Defaults", func() {
 				},
 			}))
 		})
+
+		It("should default the weight of score plugins", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				Plugins: &schedulerv1alpha1.ShootSchedulerPlugins{
+					Score: []schedulerv1alpha1.ScorePlugin{
+						{Name: schedulerv1alpha1.ScorePluginLeastShoots},
+						{Name: schedulerv1alpha1.ScorePluginSeedCapacity, Weight: pointer.Int32(3)},
+					},
+				},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Plugins.Score).To(Equal([]schedulerv1alpha1.ScorePlugin{
+				{Name: schedulerv1alpha1.ScorePluginLeastShoots, Weight: pointer.Int32(1)},
+				{Name: schedulerv1alpha1.ScorePluginSeedCapacity, Weight: pointer.Int32(3)},
+			}))
+		})
 	})
 
 	Describe("ServerConfiguration defaulting", func() {
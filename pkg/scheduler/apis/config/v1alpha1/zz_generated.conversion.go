@@ -48,6 +48,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FilterPlugin)(nil), (*config.FilterPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FilterPlugin_To_config_FilterPlugin(a.(*FilterPlugin), b.(*config.FilterPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FilterPlugin)(nil), (*FilterPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FilterPlugin_To_v1alpha1_FilterPlugin(a.(*config.FilterPlugin), b.(*FilterPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProviderCostArgs)(nil), (*config.ProviderCostArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProviderCostArgs_To_config_ProviderCostArgs(a.(*ProviderCostArgs), b.(*config.ProviderCostArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProviderCostArgs)(nil), (*ProviderCostArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProviderCostArgs_To_v1alpha1_ProviderCostArgs(a.(*config.ProviderCostArgs), b.(*ProviderCostArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProviderCostHint)(nil), (*config.ProviderCostHint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProviderCostHint_To_config_ProviderCostHint(a.(*ProviderCostHint), b.(*config.ProviderCostHint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProviderCostHint)(nil), (*ProviderCostHint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProviderCostHint_To_v1alpha1_ProviderCostHint(a.(*config.ProviderCostHint), b.(*ProviderCostHint), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SchedulerConfiguration)(nil), (*config.SchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(a.(*SchedulerConfiguration), b.(*config.SchedulerConfiguration), scope)
 	}); err != nil {
@@ -68,6 +98,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScorePlugin)(nil), (*config.ScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin(a.(*ScorePlugin), b.(*config.ScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScorePlugin)(nil), (*ScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin(a.(*config.ScorePlugin), b.(*ScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedLabelPreference)(nil), (*config.SeedLabelPreference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedLabelPreference_To_config_SeedLabelPreference(a.(*SeedLabelPreference), b.(*config.SeedLabelPreference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedLabelPreference)(nil), (*SeedLabelPreference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedLabelPreference_To_v1alpha1_SeedLabelPreference(a.(*config.SeedLabelPreference), b.(*SeedLabelPreference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedLabelsArgs)(nil), (*config.SeedLabelsArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedLabelsArgs_To_config_SeedLabelsArgs(a.(*SeedLabelsArgs), b.(*config.SeedLabelsArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedLabelsArgs)(nil), (*SeedLabelsArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedLabelsArgs_To_v1alpha1_SeedLabelsArgs(a.(*config.SeedLabelsArgs), b.(*SeedLabelsArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedUtilizationArgs)(nil), (*config.SeedUtilizationArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedUtilizationArgs_To_config_SeedUtilizationArgs(a.(*SeedUtilizationArgs), b.(*config.SeedUtilizationArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedUtilizationArgs)(nil), (*SeedUtilizationArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedUtilizationArgs_To_v1alpha1_SeedUtilizationArgs(a.(*config.SeedUtilizationArgs), b.(*SeedUtilizationArgs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -98,6 +168,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootSchedulerPlugins)(nil), (*config.ShootSchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootSchedulerPlugins_To_config_ShootSchedulerPlugins(a.(*ShootSchedulerPlugins), b.(*config.ShootSchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootSchedulerPlugins)(nil), (*ShootSchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootSchedulerPlugins_To_v1alpha1_ShootSchedulerPlugins(a.(*config.ShootSchedulerPlugins), b.(*ShootSchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -121,6 +201,72 @@ func Convert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketS
 	return autoConvert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketSchedulerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_FilterPlugin_To_config_FilterPlugin(in *FilterPlugin, out *config.FilterPlugin, s conversion.Scope) error {
+	out.Name = config.FilterPluginName(in.Name)
+	out.SeedUtilization = (*config.SeedUtilizationArgs)(unsafe.Pointer(in.SeedUtilization))
+	return nil
+}
+
+// Convert_v1alpha1_FilterPlugin_To_config_FilterPlugin is an autogenerated conversion function.
+func Convert_v1alpha1_FilterPlugin_To_config_FilterPlugin(in *FilterPlugin, out *config.FilterPlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FilterPlugin_To_config_FilterPlugin(in, out, s)
+}
+
+func autoConvert_config_FilterPlugin_To_v1alpha1_FilterPlugin(in *config.FilterPlugin, out *FilterPlugin, s conversion.Scope) error {
+	out.Name = FilterPluginName(in.Name)
+	out.SeedUtilization = (*SeedUtilizationArgs)(unsafe.Pointer(in.SeedUtilization))
+	return nil
+}
+
+// Convert_config_FilterPlugin_To_v1alpha1_FilterPlugin is an autogenerated conversion function.
+func Convert_config_FilterPlugin_To_v1alpha1_FilterPlugin(in *config.FilterPlugin, out *FilterPlugin, s conversion.Scope) error {
+	return autoConvert_config_FilterPlugin_To_v1alpha1_FilterPlugin(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProviderCostArgs_To_config_ProviderCostArgs(in *ProviderCostArgs, out *config.ProviderCostArgs, s conversion.Scope) error {
+	out.Costs = *(*[]config.ProviderCostHint)(unsafe.Pointer(&in.Costs))
+	return nil
+}
+
+// Convert_v1alpha1_ProviderCostArgs_To_config_ProviderCostArgs is an autogenerated conversion function.
+func Convert_v1alpha1_ProviderCostArgs_To_config_ProviderCostArgs(in *ProviderCostArgs, out *config.ProviderCostArgs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProviderCostArgs_To_config_ProviderCostArgs(in, out, s)
+}
+
+func autoConvert_config_ProviderCostArgs_To_v1alpha1_ProviderCostArgs(in *config.ProviderCostArgs, out *ProviderCostArgs, s conversion.Scope) error {
+	out.Costs = *(*[]ProviderCostHint)(unsafe.Pointer(&in.Costs))
+	return nil
+}
+
+// Convert_config_ProviderCostArgs_To_v1alpha1_ProviderCostArgs is an autogenerated conversion function.
+func Convert_config_ProviderCostArgs_To_v1alpha1_ProviderCostArgs(in *config.ProviderCostArgs, out *ProviderCostArgs, s conversion.Scope) error {
+	return autoConvert_config_ProviderCostArgs_To_v1alpha1_ProviderCostArgs(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProviderCostHint_To_config_ProviderCostHint(in *ProviderCostHint, out *config.ProviderCostHint, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_v1alpha1_ProviderCostHint_To_config_ProviderCostHint is an autogenerated conversion function.
+func Convert_v1alpha1_ProviderCostHint_To_config_ProviderCostHint(in *ProviderCostHint, out *config.ProviderCostHint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProviderCostHint_To_config_ProviderCostHint(in, out, s)
+}
+
+func autoConvert_config_ProviderCostHint_To_v1alpha1_ProviderCostHint(in *config.ProviderCostHint, out *ProviderCostHint, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_config_ProviderCostHint_To_v1alpha1_ProviderCostHint is an autogenerated conversion function.
+func Convert_config_ProviderCostHint_To_v1alpha1_ProviderCostHint(in *config.ProviderCostHint, out *ProviderCostHint, s conversion.Scope) error {
+	return autoConvert_config_ProviderCostHint_To_v1alpha1_ProviderCostHint(in, out, s)
+}
+
 func autoConvert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(in *SchedulerConfiguration, out *config.SchedulerConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -221,6 +367,94 @@ func Convert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerContro
 	return autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in *ScorePlugin, out *config.ScorePlugin, s conversion.Scope) error {
+	out.Name = config.ScorePluginName(in.Name)
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	out.SeedLabels = (*config.SeedLabelsArgs)(unsafe.Pointer(in.SeedLabels))
+	out.ProviderCost = (*config.ProviderCostArgs)(unsafe.Pointer(in.ProviderCost))
+	return nil
+}
+
+// Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin is an autogenerated conversion function.
+func Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in *ScorePlugin, out *config.ScorePlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in, out, s)
+}
+
+func autoConvert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in *config.ScorePlugin, out *ScorePlugin, s conversion.Scope) error {
+	out.Name = ScorePluginName(in.Name)
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	out.SeedLabels = (*SeedLabelsArgs)(unsafe.Pointer(in.SeedLabels))
+	out.ProviderCost = (*ProviderCostArgs)(unsafe.Pointer(in.ProviderCost))
+	return nil
+}
+
+// Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin is an autogenerated conversion function.
+func Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in *config.ScorePlugin, out *ScorePlugin, s conversion.Scope) error {
+	return autoConvert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedLabelPreference_To_config_SeedLabelPreference(in *SeedLabelPreference, out *config.SeedLabelPreference, s conversion.Scope) error {
+	out.Selector = in.Selector
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_SeedLabelPreference_To_config_SeedLabelPreference is an autogenerated conversion function.
+func Convert_v1alpha1_SeedLabelPreference_To_config_SeedLabelPreference(in *SeedLabelPreference, out *config.SeedLabelPreference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedLabelPreference_To_config_SeedLabelPreference(in, out, s)
+}
+
+func autoConvert_config_SeedLabelPreference_To_v1alpha1_SeedLabelPreference(in *config.SeedLabelPreference, out *SeedLabelPreference, s conversion.Scope) error {
+	out.Selector = in.Selector
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_config_SeedLabelPreference_To_v1alpha1_SeedLabelPreference is an autogenerated conversion function.
+func Convert_config_SeedLabelPreference_To_v1alpha1_SeedLabelPreference(in *config.SeedLabelPreference, out *SeedLabelPreference, s conversion.Scope) error {
+	return autoConvert_config_SeedLabelPreference_To_v1alpha1_SeedLabelPreference(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedLabelsArgs_To_config_SeedLabelsArgs(in *SeedLabelsArgs, out *config.SeedLabelsArgs, s conversion.Scope) error {
+	out.Preferences = *(*[]config.SeedLabelPreference)(unsafe.Pointer(&in.Preferences))
+	return nil
+}
+
+// Convert_v1alpha1_SeedLabelsArgs_To_config_SeedLabelsArgs is an autogenerated conversion function.
+func Convert_v1alpha1_SeedLabelsArgs_To_config_SeedLabelsArgs(in *SeedLabelsArgs, out *config.SeedLabelsArgs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedLabelsArgs_To_config_SeedLabelsArgs(in, out, s)
+}
+
+func autoConvert_config_SeedLabelsArgs_To_v1alpha1_SeedLabelsArgs(in *config.SeedLabelsArgs, out *SeedLabelsArgs, s conversion.Scope) error {
+	out.Preferences = *(*[]SeedLabelPreference)(unsafe.Pointer(&in.Preferences))
+	return nil
+}
+
+// Convert_config_SeedLabelsArgs_To_v1alpha1_SeedLabelsArgs is an autogenerated conversion function.
+func Convert_config_SeedLabelsArgs_To_v1alpha1_SeedLabelsArgs(in *config.SeedLabelsArgs, out *SeedLabelsArgs, s conversion.Scope) error {
+	return autoConvert_config_SeedLabelsArgs_To_v1alpha1_SeedLabelsArgs(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedUtilizationArgs_To_config_SeedUtilizationArgs(in *SeedUtilizationArgs, out *config.SeedUtilizationArgs, s conversion.Scope) error {
+	out.MaxUtilizationPercentage = in.MaxUtilizationPercentage
+	return nil
+}
+
+// Convert_v1alpha1_SeedUtilizationArgs_To_config_SeedUtilizationArgs is an autogenerated conversion function.
+func Convert_v1alpha1_SeedUtilizationArgs_To_config_SeedUtilizationArgs(in *SeedUtilizationArgs, out *config.SeedUtilizationArgs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedUtilizationArgs_To_config_SeedUtilizationArgs(in, out, s)
+}
+
+func autoConvert_config_SeedUtilizationArgs_To_v1alpha1_SeedUtilizationArgs(in *config.SeedUtilizationArgs, out *SeedUtilizationArgs, s conversion.Scope) error {
+	out.MaxUtilizationPercentage = in.MaxUtilizationPercentage
+	return nil
+}
+
+// Convert_config_SeedUtilizationArgs_To_v1alpha1_SeedUtilizationArgs is an autogenerated conversion function.
+func Convert_config_SeedUtilizationArgs_To_v1alpha1_SeedUtilizationArgs(in *config.SeedUtilizationArgs, out *SeedUtilizationArgs, s conversion.Scope) error {
+	return autoConvert_config_SeedUtilizationArgs_To_v1alpha1_SeedUtilizationArgs(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -268,6 +502,7 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 func autoConvert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfiguration(in *ShootSchedulerConfiguration, out *config.ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = config.CandidateDeterminationStrategy(in.Strategy)
+	out.Plugins = (*config.ShootSchedulerPlugins)(unsafe.Pointer(in.Plugins))
 	return nil
 }
 
@@ -279,6 +514,7 @@ func Convert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfig
 func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = CandidateDeterminationStrategy(in.Strategy)
+	out.Plugins = (*ShootSchedulerPlugins)(unsafe.Pointer(in.Plugins))
 	return nil
 }
 
@@ -286,3 +522,25 @@ func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerCo
 func Convert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootSchedulerPlugins_To_config_ShootSchedulerPlugins(in *ShootSchedulerPlugins, out *config.ShootSchedulerPlugins, s conversion.Scope) error {
+	out.Filter = *(*[]config.FilterPlugin)(unsafe.Pointer(&in.Filter))
+	out.Score = *(*[]config.ScorePlugin)(unsafe.Pointer(&in.Score))
+	return nil
+}
+
+// Convert_v1alpha1_ShootSchedulerPlugins_To_config_ShootSchedulerPlugins is an autogenerated conversion function.
+func Convert_v1alpha1_ShootSchedulerPlugins_To_config_ShootSchedulerPlugins(in *ShootSchedulerPlugins, out *config.ShootSchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootSchedulerPlugins_To_config_ShootSchedulerPlugins(in, out, s)
+}
+
+func autoConvert_config_ShootSchedulerPlugins_To_v1alpha1_ShootSchedulerPlugins(in *config.ShootSchedulerPlugins, out *ShootSchedulerPlugins, s conversion.Scope) error {
+	out.Filter = *(*[]FilterPlugin)(unsafe.Pointer(&in.Filter))
+	out.Score = *(*[]ScorePlugin)(unsafe.Pointer(&in.Score))
+	return nil
+}
+
+// Convert_config_ShootSchedulerPlugins_To_v1alpha1_ShootSchedulerPlugins is an autogenerated conversion function.
+func Convert_config_ShootSchedulerPlugins_To_v1alpha1_ShootSchedulerPlugins(in *config.ShootSchedulerPlugins, out *ShootSchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_config_ShootSchedulerPlugins_To_v1alpha1_ShootSchedulerPlugins(in, out, s)
+}
@@ -42,6 +42,71 @@ func (in *BackupBucketSchedulerConfiguration) DeepCopy() *BackupBucketSchedulerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterPlugin) DeepCopyInto(out *FilterPlugin) {
+	*out = *in
+	if in.SeedUtilization != nil {
+		in, out := &in.SeedUtilization, &out.SeedUtilization
+		*out = new(SeedUtilizationArgs)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterPlugin.
+func (in *FilterPlugin) DeepCopy() *FilterPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderCostArgs) DeepCopyInto(out *ProviderCostArgs) {
+	*out = *in
+	if in.Costs != nil {
+		in, out := &in.Costs, &out.Costs
+		*out = make([]ProviderCostHint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCostArgs.
+func (in *ProviderCostArgs) DeepCopy() *ProviderCostArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderCostArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderCostHint) DeepCopyInto(out *ProviderCostHint) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCostHint.
+func (in *ProviderCostHint) DeepCopy() *ProviderCostHint {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderCostHint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerConfiguration) DeepCopyInto(out *SchedulerConfiguration) {
 	*out = *in
@@ -98,7 +163,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -113,6 +178,93 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScorePlugin) DeepCopyInto(out *ScorePlugin) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SeedLabels != nil {
+		in, out := &in.SeedLabels, &out.SeedLabels
+		*out = new(SeedLabelsArgs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderCost != nil {
+		in, out := &in.ProviderCost, &out.ProviderCost
+		*out = new(ProviderCostArgs)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScorePlugin.
+func (in *ScorePlugin) DeepCopy() *ScorePlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ScorePlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedLabelPreference) DeepCopyInto(out *SeedLabelPreference) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedLabelPreference.
+func (in *SeedLabelPreference) DeepCopy() *SeedLabelPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedLabelPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedLabelsArgs) DeepCopyInto(out *SeedLabelsArgs) {
+	*out = *in
+	if in.Preferences != nil {
+		in, out := &in.Preferences, &out.Preferences
+		*out = make([]SeedLabelPreference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedLabelsArgs.
+func (in *SeedLabelsArgs) DeepCopy() *SeedLabelsArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedLabelsArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedUtilizationArgs) DeepCopyInto(out *SeedUtilizationArgs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedUtilizationArgs.
+func (in *SeedUtilizationArgs) DeepCopy() *SeedUtilizationArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedUtilizationArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -158,6 +310,11 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(ShootSchedulerPlugins)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -170,3 +327,33 @@ func (in *ShootSchedulerConfiguration) DeepCopy() *ShootSchedulerConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSchedulerPlugins) DeepCopyInto(out *ShootSchedulerPlugins) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = make([]FilterPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = make([]ScorePlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSchedulerPlugins.
+func (in *ShootSchedulerPlugins) DeepCopy() *ShootSchedulerPlugins {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSchedulerPlugins)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -16,6 +16,7 @@ package validation
 
 import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -57,6 +58,9 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfig.Schedul
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		if schedulers.Shoot.Plugins != nil {
+			allErrs = append(allErrs, validatePlugins(schedulers.Shoot.Plugins, fldPath.Child("shoot", "plugins"))...)
+		}
 	}
 
 	return allErrs
@@ -79,3 +83,86 @@ func validateStrategy(strategy schedulerconfig.CandidateDeterminationStrategy, f
 
 	return allErrs
 }
+
+func validatePlugins(plugins *schedulerconfig.ShootSchedulerPlugins, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var supportedFilterPlugins []string
+	for _, p := range schedulerconfig.FilterPlugins {
+		supportedFilterPlugins = append(supportedFilterPlugins, string(p))
+	}
+
+	filterPluginNames := sets.New[schedulerconfig.FilterPluginName]()
+	for i, plugin := range plugins.Filter {
+		idxPath := fldPath.Child("filter").Index(i)
+
+		if filterPluginNames.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		filterPluginNames.Insert(plugin.Name)
+
+		switch plugin.Name {
+		case schedulerconfig.FilterPluginSeedUtilization:
+			if plugin.SeedUtilization == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("seedUtilization"), "arguments are required for this plugin"))
+				continue
+			}
+			if p := plugin.SeedUtilization.MaxUtilizationPercentage; p <= 0 || p > 100 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("seedUtilization", "maxUtilizationPercentage"), p, "must be in the range (0, 100]"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, supportedFilterPlugins))
+		}
+	}
+
+	var supportedScorePlugins []string
+	for _, p := range schedulerconfig.ScorePlugins {
+		supportedScorePlugins = append(supportedScorePlugins, string(p))
+	}
+
+	scorePluginNames := sets.New[schedulerconfig.ScorePluginName]()
+	for i, plugin := range plugins.Score {
+		idxPath := fldPath.Child("score").Index(i)
+
+		if scorePluginNames.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		scorePluginNames.Insert(plugin.Name)
+
+		if plugin.Weight != nil && *plugin.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, "must be greater than 0"))
+		}
+
+		switch plugin.Name {
+		case schedulerconfig.ScorePluginLeastShoots, schedulerconfig.ScorePluginSeedCapacity, schedulerconfig.ScorePluginShootsPerZone:
+		case schedulerconfig.ScorePluginSeedLabels:
+			if plugin.SeedLabels == nil || len(plugin.SeedLabels.Preferences) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("seedLabels", "preferences"), "at least one preference is required for this plugin"))
+				continue
+			}
+			for j, preference := range plugin.SeedLabels.Preferences {
+				prefPath := idxPath.Child("seedLabels", "preferences").Index(j)
+				allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&plugin.SeedLabels.Preferences[j].Selector, metav1validation.LabelSelectorValidationOptions{}, prefPath.Child("selector"))...)
+				if preference.Weight <= 0 {
+					allErrs = append(allErrs, field.Invalid(prefPath.Child("weight"), preference.Weight, "must be greater than 0"))
+				}
+			}
+		case schedulerconfig.ScorePluginProviderCost:
+			if plugin.ProviderCost == nil || len(plugin.ProviderCost.Costs) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("providerCost", "costs"), "at least one cost hint is required for this plugin"))
+				continue
+			}
+			for j, cost := range plugin.ProviderCost.Costs {
+				costPath := idxPath.Child("providerCost", "costs").Index(j)
+				if len(cost.Type) == 0 {
+					allErrs = append(allErrs, field.Required(costPath.Child("type"), "provider type must be set"))
+				}
+				allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cost.Cost), costPath.Child("cost"))...)
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, supportedScorePlugins))
+		}
+	}
+
+	return allErrs
+}
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	schedulerconfig "github.com/gardener/gardener/pkg/scheduler/apis/config"
 )
@@ -103,5 +104,123 @@ var _ = Describe("gardener-scheduler", func() {
 				}))))
 			})
 		})
+
+		Context("Validate shoot scheduler plugins", func() {
+			It("should pass because the plugin configuration is valid", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.Plugins = &schedulerconfig.ShootSchedulerPlugins{
+					Filter: []schedulerconfig.FilterPlugin{
+						{Name: schedulerconfig.FilterPluginSeedUtilization, SeedUtilization: &schedulerconfig.SeedUtilizationArgs{MaxUtilizationPercentage: 90}},
+					},
+					Score: []schedulerconfig.ScorePlugin{
+						{Name: schedulerconfig.ScorePluginLeastShoots, Weight: pointer.Int32(1)},
+						{Name: schedulerconfig.ScorePluginSeedCapacity, Weight: pointer.Int32(2)},
+						{Name: schedulerconfig.ScorePluginShootsPerZone, Weight: pointer.Int32(1)},
+						{Name: schedulerconfig.ScorePluginSeedLabels, Weight: pointer.Int32(1), SeedLabels: &schedulerconfig.SeedLabelsArgs{
+							Preferences: []schedulerconfig.SeedLabelPreference{{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}, Weight: 10}},
+						}},
+						{Name: schedulerconfig.ScorePluginProviderCost, Weight: pointer.Int32(1), ProviderCost: &schedulerconfig.ProviderCostArgs{
+							Costs: []schedulerconfig.ProviderCostHint{{Type: "foo", Cost: 1}, {Type: "foo", Region: pointer.String("bar"), Cost: 2}},
+						}},
+					},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because of unknown or duplicate plugins", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.Plugins = &schedulerconfig.ShootSchedulerPlugins{
+					Filter: []schedulerconfig.FilterPlugin{
+						{Name: "foo"},
+					},
+					Score: []schedulerconfig.ScorePlugin{
+						{Name: schedulerconfig.ScorePluginLeastShoots},
+						{Name: schedulerconfig.ScorePluginLeastShoots},
+						{Name: "bar"},
+					},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("schedulers.shoot.plugins.filter[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("schedulers.shoot.plugins.score[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("schedulers.shoot.plugins.score[2].name"),
+					})),
+				))
+			})
+
+			It("should fail because of invalid plugin arguments", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.Plugins = &schedulerconfig.ShootSchedulerPlugins{
+					Filter: []schedulerconfig.FilterPlugin{
+						{Name: schedulerconfig.FilterPluginSeedUtilization, SeedUtilization: &schedulerconfig.SeedUtilizationArgs{MaxUtilizationPercentage: 101}},
+					},
+					Score: []schedulerconfig.ScorePlugin{
+						{Name: schedulerconfig.ScorePluginLeastShoots, Weight: pointer.Int32(0)},
+						{Name: schedulerconfig.ScorePluginSeedLabels, SeedLabels: &schedulerconfig.SeedLabelsArgs{
+							Preferences: []schedulerconfig.SeedLabelPreference{{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}},
+						}},
+						{Name: schedulerconfig.ScorePluginProviderCost, ProviderCost: &schedulerconfig.ProviderCostArgs{
+							Costs: []schedulerconfig.ProviderCostHint{{Cost: -1}},
+						}},
+					},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.plugins.filter[0].seedUtilization.maxUtilizationPercentage"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.plugins.score[0].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.plugins.score[1].seedLabels.preferences[0].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.plugins.score[2].providerCost.costs[0].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.plugins.score[2].providerCost.costs[0].cost"),
+					})),
+				))
+			})
+
+			It("should fail because of missing plugin arguments", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.Plugins = &schedulerconfig.ShootSchedulerPlugins{
+					Filter: []schedulerconfig.FilterPlugin{
+						{Name: schedulerconfig.FilterPluginSeedUtilization},
+					},
+					Score: []schedulerconfig.ScorePlugin{
+						{Name: schedulerconfig.ScorePluginSeedLabels},
+						{Name: schedulerconfig.ScorePluginProviderCost},
+					},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.plugins.filter[0].seedUtilization"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.plugins.score[0].seedLabels.preferences"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.plugins.score[1].providerCost.costs"),
+					})),
+				))
+			})
+		})
 	})
 })
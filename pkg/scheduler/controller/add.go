@@ -20,11 +20,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/controller/backupbucket"
 	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
 // AddToManager adds all scheduler controllers to the given manager.
 func AddToManager(mgr manager.Manager, cfg *config.SchedulerConfiguration) error {
+	if err := (&backupbucket.Reconciler{
+		Config: cfg.Schedulers.BackupBucket,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding BackupBucket controller: %w", err)
+	}
+
 	if err := (&shoot.Reconciler{
 		Config: cfg.Schedulers.Shoot,
 	}).AddToManager(mgr); err != nil {
@@ -0,0 +1,184 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	schedulerutils "github.com/gardener/gardener/pkg/scheduler/utils"
+)
+
+// Reconciler schedules backup buckets to seeds.
+type Reconciler struct {
+	Client   client.Client
+	Config   *config.BackupBucketSchedulerConfiguration
+	Recorder record.EventRecorder
+}
+
+// Reconcile schedules backup buckets to seeds.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	backupBucket := &gardencorev1beta1.BackupBucket{}
+	if err := r.Client.Get(ctx, request.NamespacedName, backupBucket); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if backupBucket.Spec.SeedName != nil {
+		log.Info("BackupBucket already scheduled onto seed, nothing left to do", "seed", *backupBucket.Spec.SeedName)
+		return reconcile.Result{}, nil
+	}
+
+	if backupBucket.DeletionTimestamp != nil {
+		log.Info("Ignoring backup bucket because it has been marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	seed, err := r.determineSeed(ctx, backupBucket)
+	if err != nil {
+		r.Recorder.Eventf(backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventSchedulingFailed, "Failed to schedule BackupBucket: %s", err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to determine seed for backup bucket: %w", err)
+	}
+
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	backupBucket.Spec.SeedName = &seed.Name
+	if err := r.Client.Patch(ctx, backupBucket, patch); err != nil {
+		r.Recorder.Eventf(backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventSchedulingFailed, "Failed to schedule BackupBucket: %s", err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to assign seed to backup bucket: %w", err)
+	}
+
+	log.Info(
+		"BackupBucket successfully scheduled to seed",
+		"providerType", backupBucket.Spec.Provider.Type,
+		"region", backupBucket.Spec.Provider.Region,
+		"seed", seed.Name,
+	)
+
+	r.Recorder.Eventf(backupBucket, corev1.EventTypeNormal, gardencorev1beta1.EventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
+	return reconcile.Result{}, nil
+}
+
+// determineSeed returns an appropriate seed for the given backup bucket. Only usable seeds with the same provider type
+// as the backup bucket are considered. Seeds in the same region as the backup bucket are preferred. Among the
+// remaining candidates, the seed with the least number of backup buckets is chosen.
+func (r *Reconciler) determineSeed(ctx context.Context, backupBucket *gardencorev1beta1.BackupBucket) (*gardencorev1beta1.Seed, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return nil, err
+	}
+	backupBucketList := &gardencorev1beta1.BackupBucketList{}
+	if err := r.Client.List(ctx, backupBucketList); err != nil {
+		return nil, err
+	}
+
+	filteredSeeds, err := filterUsableSeeds(seedList.Items)
+	if err != nil {
+		return nil, err
+	}
+	filteredSeeds, err = filterSeedsMatchingProvider(filteredSeeds, backupBucket)
+	if err != nil {
+		return nil, err
+	}
+	filteredSeeds = preferSeedsInSameRegion(filteredSeeds, backupBucket)
+
+	return getSeedWithLeastBackupBuckets(filteredSeeds, backupBucketList.Items), nil
+}
+
+func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	var matchingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if schedulerutils.IsUsableSeed(&seed) {
+			matchingSeeds = append(matchingSeeds, seed)
+		}
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seedList))
+	}
+	return matchingSeeds, nil
+}
+
+func filterSeedsMatchingProvider(seedList []gardencorev1beta1.Seed, backupBucket *gardencorev1beta1.BackupBucket) ([]gardencorev1beta1.Seed, error) {
+	var matchingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if seed.Spec.Provider.Type == backupBucket.Spec.Provider.Type {
+			matchingSeeds = append(matchingSeeds, seed)
+		}
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, fmt.Errorf("none out of the %d seeds has a matching provider for %q", len(seedList), backupBucket.Spec.Provider.Type)
+	}
+	return matchingSeeds, nil
+}
+
+// preferSeedsInSameRegion returns the seeds in the same region as the backup bucket. If there are none, all given seeds
+// are returned.
+func preferSeedsInSameRegion(seedList []gardencorev1beta1.Seed, backupBucket *gardencorev1beta1.BackupBucket) []gardencorev1beta1.Seed {
+	var matchingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if seed.Spec.Provider.Region == backupBucket.Spec.Provider.Region {
+			matchingSeeds = append(matchingSeeds, seed)
+		}
+	}
+
+	if len(matchingSeeds) == 0 {
+		return seedList
+	}
+	return matchingSeeds
+}
+
+// getSeedWithLeastBackupBuckets finds the best candidate (i.e. the one managing the smallest number of backup buckets
+// right now).
+func getSeedWithLeastBackupBuckets(seedList []gardencorev1beta1.Seed, backupBucketList []gardencorev1beta1.BackupBucket) *gardencorev1beta1.Seed {
+	seedUsage := make(map[string]int)
+	for _, backupBucket := range backupBucketList {
+		if backupBucket.Spec.SeedName != nil {
+			seedUsage[*backupBucket.Spec.SeedName]++
+		}
+	}
+
+	var (
+		bestCandidate gardencorev1beta1.Seed
+		min           *int
+	)
+
+	for _, seed := range seedList {
+		if numberOfBackupBuckets := seedUsage[seed.Name]; min == nil || numberOfBackupBuckets < *min {
+			bestCandidate = seed
+			min = &numberOfBackupBuckets
+		}
+	}
+
+	return &bestCandidate
+}
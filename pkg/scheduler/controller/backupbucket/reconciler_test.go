@@ -0,0 +1,145 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/backupbucket"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		backupBucket *gardencorev1beta1.BackupBucket
+
+		newSeed = func(name, providerType, region string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: providerType, Region: region},
+					Settings: &gardencorev1beta1.SeedSettings{
+						Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+					},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+
+		reconcileAndGetSeedName = func() (*string, error) {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(backupBucket)})
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+			return backupBucket.Spec.SeedName, err
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		recorder = record.NewFakeRecorder(1)
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Config:   &config.BackupBucketSchedulerConfiguration{ConcurrentSyncs: 1},
+			Recorder: recorder,
+		}
+
+		backupBucket = &gardencorev1beta1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+			Spec: gardencorev1beta1.BackupBucketSpec{
+				Provider: gardencorev1beta1.BackupBucketProvider{Type: "foo", Region: "europe"},
+			},
+		}
+	})
+
+	It("should do nothing if the backup bucket is already scheduled", func() {
+		backupBucket.Spec.SeedName = pointer.String("some-seed")
+		Expect(fakeClient.Create(ctx, backupBucket)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed", "foo", "europe"))).To(Succeed())
+
+		Expect(reconcileAndGetSeedName()).To(PointTo(Equal("some-seed")))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should schedule the backup bucket to a seed in the same region", func() {
+		Expect(fakeClient.Create(ctx, backupBucket)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-1", "foo", "asia"))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-2", "foo", "europe"))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-3", "bar", "europe"))).To(Succeed())
+
+		Expect(reconcileAndGetSeedName()).To(PointTo(Equal("seed-2")))
+		Expect(recorder.Events).To(Receive(Equal("Normal SchedulingSuccessful Scheduled to seed 'seed-2'")))
+	})
+
+	It("should schedule the backup bucket to a seed in another region if there is none in the same region", func() {
+		Expect(fakeClient.Create(ctx, backupBucket)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-1", "foo", "asia"))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-2", "bar", "europe"))).To(Succeed())
+
+		Expect(reconcileAndGetSeedName()).To(PointTo(Equal("seed-1")))
+	})
+
+	It("should schedule the backup bucket to the seed with the least backup buckets", func() {
+		Expect(fakeClient.Create(ctx, backupBucket)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-1", "foo", "europe"))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-2", "foo", "europe"))).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-bucket"},
+			Spec:       gardencorev1beta1.BackupBucketSpec{SeedName: pointer.String("seed-1")},
+		})).To(Succeed())
+
+		Expect(reconcileAndGetSeedName()).To(PointTo(Equal("seed-2")))
+	})
+
+	It("should not schedule the backup bucket to unusable seeds", func() {
+		seed := newSeed("seed", "foo", "europe")
+		seed.Spec.Settings.Scheduling.Visible = false
+
+		Expect(fakeClient.Create(ctx, backupBucket)).To(Succeed())
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+		seedName, err := reconcileAndGetSeedName()
+		Expect(err).To(MatchError(ContainSubstring("none of the 1 seeds is valid for scheduling")))
+		Expect(seedName).To(BeNil())
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning SchedulingFailed Failed to schedule BackupBucket")))
+	})
+
+	It("should fail if there is no seed with a matching provider", func() {
+		Expect(fakeClient.Create(ctx, backupBucket)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed", "bar", "europe"))).To(Succeed())
+
+		seedName, err := reconcileAndGetSeedName()
+		Expect(err).To(MatchError(ContainSubstring(`none out of the 1 seeds has a matching provider for "foo"`)))
+		Expect(seedName).To(BeNil())
+	})
+})
@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,13 +56,14 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		Complete(r)
 }
 
-// ShootPredicate is a predicate that returns true if a shoot is not assigned to a seed
-// and the default scheduler is configured.
+// ShootPredicate is a predicate that returns true if a shoot is not assigned to a seed, the default scheduler is
+// configured, and the shoot is not being imported from a ShootState archive.
 func (r *Reconciler) ShootPredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
 			return shoot.Spec.SeedName == nil &&
-				pointer.StringDeref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName
+				pointer.StringDeref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName &&
+				!metav1.HasAnnotation(shoot.ObjectMeta, v1beta1constants.AnnotationShootImportInProgress)
 		}
 		return false
 	})
@@ -17,6 +17,7 @@ package shoot_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -84,6 +85,19 @@ var _ = Describe("Add", func() {
 			})
 		})
 
+		Context("shoot is being imported", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/import-in-progress", "true")
+			})
+
+			It("should be false", func() {
+				Expect(predicate.Create(createEvent)).To(BeFalse())
+				Expect(predicate.Update(updateEvent)).To(BeFalse())
+				Expect(predicate.Delete(deleteEvent)).To(BeFalse())
+				Expect(predicate.Generic(genericEvent)).To(BeFalse())
+			})
+		})
+
 		Context("shoot defines schedulerName", func() {
 			Context("default-scheduler", func() {
 				BeforeEach(func() {
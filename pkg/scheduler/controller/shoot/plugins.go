@@ -0,0 +1,282 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// maxScore is the score a seed gets from a score plugin if it is the best of all candidates.
+const maxScore = 100
+
+// filterPlugin checks whether the given seed is a suitable candidate for the given shoot. It returns an error
+// describing the reason if the seed must be filtered out.
+type filterPlugin func(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int) error
+
+// scorePlugin computes the raw score of the given seed for the given shoot. A higher score is better. Raw scores are
+// normalized over all candidates before they are weighted and summed up.
+type scorePlugin func(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int) float64
+
+type weightedScorePlugin struct {
+	name   config.ScorePluginName
+	weight int32
+	score  scorePlugin
+}
+
+// defaultScorePlugins are used if no score plugins are configured. They preserve the original behaviour of choosing the
+// seed with the least number of scheduled shoots.
+var defaultScorePlugins = []weightedScorePlugin{{name: config.ScorePluginLeastShoots, weight: 1, score: scoreLeastShoots}}
+
+func newPlugins(plugins *config.ShootSchedulerPlugins) ([]filterPlugin, []weightedScorePlugin, error) {
+	if plugins == nil {
+		return nil, defaultScorePlugins, nil
+	}
+
+	var filterPlugins []filterPlugin
+	for _, plugin := range plugins.Filter {
+		filter, err := newFilterPlugin(plugin)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed creating filter plugin %q: %w", plugin.Name, err)
+		}
+		filterPlugins = append(filterPlugins, filter)
+	}
+
+	if len(plugins.Score) == 0 {
+		return filterPlugins, defaultScorePlugins, nil
+	}
+
+	var scorePlugins []weightedScorePlugin
+	for _, plugin := range plugins.Score {
+		score, err := newScorePlugin(plugin)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed creating score plugin %q: %w", plugin.Name, err)
+		}
+
+		weight := int32(1)
+		if plugin.Weight != nil {
+			weight = *plugin.Weight
+		}
+		scorePlugins = append(scorePlugins, weightedScorePlugin{name: plugin.Name, weight: weight, score: score})
+	}
+
+	return filterPlugins, scorePlugins, nil
+}
+
+func newFilterPlugin(plugin config.FilterPlugin) (filterPlugin, error) {
+	switch plugin.Name {
+	case config.FilterPluginSeedUtilization:
+		if plugin.SeedUtilization == nil {
+			return nil, fmt.Errorf("missing arguments")
+		}
+		return newSeedUtilizationFilter(plugin.SeedUtilization.MaxUtilizationPercentage), nil
+	default:
+		return nil, fmt.Errorf("unknown filter plugin")
+	}
+}
+
+func newScorePlugin(plugin config.ScorePlugin) (scorePlugin, error) {
+	switch plugin.Name {
+	case config.ScorePluginLeastShoots:
+		return scoreLeastShoots, nil
+	case config.ScorePluginSeedCapacity:
+		return scoreSeedCapacity, nil
+	case config.ScorePluginShootsPerZone:
+		return scoreShootsPerZone, nil
+	case config.ScorePluginSeedLabels:
+		if plugin.SeedLabels == nil {
+			return nil, fmt.Errorf("missing arguments")
+		}
+		return newSeedLabelsScorer(plugin.SeedLabels.Preferences)
+	case config.ScorePluginProviderCost:
+		if plugin.ProviderCost == nil {
+			return nil, fmt.Errorf("missing arguments")
+		}
+		return newProviderCostScorer(plugin.ProviderCost.Costs), nil
+	default:
+		return nil, fmt.Errorf("unknown score plugin")
+	}
+}
+
+// newSeedUtilizationFilter returns a filter plugin which filters out seeds whose utilization of allocatable shoots
+// would exceed the given percentage when the shoot was scheduled to them.
+func newSeedUtilizationFilter(maxUtilizationPercentage int32) filterPlugin {
+	return func(_ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int) error {
+		allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+		if !ok || allocatableShoots.Value() <= 0 {
+			return nil
+		}
+
+		if int64(seedUsage[seed.Name]+1)*100 > int64(maxUtilizationPercentage)*allocatableShoots.Value() {
+			return fmt.Errorf("seed would exceed the maximum utilization of %d%% of its allocatable shoots", maxUtilizationPercentage)
+		}
+		return nil
+	}
+}
+
+// scoreLeastShoots prefers seeds with a smaller number of scheduled shoots.
+func scoreLeastShoots(_ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int) float64 {
+	return -float64(seedUsage[seed.Name])
+}
+
+// scoreSeedCapacity prefers seeds with a larger share of free allocatable shoots. Seeds without allocatable shoots are
+// considered to have unlimited capacity.
+func scoreSeedCapacity(_ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int) float64 {
+	allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+	if !ok || allocatableShoots.Value() <= 0 {
+		return 1
+	}
+	return float64(allocatableShoots.Value()-int64(seedUsage[seed.Name])) / float64(allocatableShoots.Value())
+}
+
+// scoreShootsPerZone prefers seeds with a smaller number of scheduled shoots per zone.
+func scoreShootsPerZone(_ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int) float64 {
+	zones := len(seed.Spec.Provider.Zones)
+	if zones == 0 {
+		zones = 1
+	}
+	return -float64(seedUsage[seed.Name]) / float64(zones)
+}
+
+// newSeedLabelsScorer returns a score plugin which prefers seeds matching the given label selectors.
+func newSeedLabelsScorer(preferences []config.SeedLabelPreference) (scorePlugin, error) {
+	type weightedSelector struct {
+		selector labels.Selector
+		weight   int32
+	}
+
+	var selectors []weightedSelector
+	for i := range preferences {
+		selector, err := metav1.LabelSelectorAsSelector(&preferences[i].Selector)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed: %w", err)
+		}
+		selectors = append(selectors, weightedSelector{selector: selector, weight: preferences[i].Weight})
+	}
+
+	return func(_ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, _ map[string]int) float64 {
+		var score float64
+		for _, s := range selectors {
+			if s.selector.Matches(labels.Set(seed.Labels)) {
+				score += float64(s.weight)
+			}
+		}
+		return score
+	}, nil
+}
+
+// newProviderCostScorer returns a score plugin which prefers seeds with lower provider cost hints. Seeds without a
+// matching cost hint are treated like the most expensive configured hint.
+func newProviderCostScorer(hints []config.ProviderCostHint) scorePlugin {
+	var (
+		costPerType       = make(map[string]int32)
+		costPerTypeRegion = make(map[string]int32)
+		maxCost           int32
+	)
+
+	for _, hint := range hints {
+		if hint.Region != nil {
+			costPerTypeRegion[hint.Type+"/"+*hint.Region] = hint.Cost
+		} else {
+			costPerType[hint.Type] = hint.Cost
+		}
+		if hint.Cost > maxCost {
+			maxCost = hint.Cost
+		}
+	}
+
+	return func(_ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, _ map[string]int) float64 {
+		if cost, ok := costPerTypeRegion[seed.Spec.Provider.Type+"/"+seed.Spec.Provider.Region]; ok {
+			return -float64(cost)
+		}
+		if cost, ok := costPerType[seed.Spec.Provider.Type]; ok {
+			return -float64(cost)
+		}
+		return -float64(maxCost)
+	}
+}
+
+// scoreSeeds computes the total score of all given seeds. Each plugin's raw scores are normalized to [0, maxScore]
+// over all seeds, multiplied with the plugin's weight and summed up.
+func scoreSeeds(shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, seedUsage map[string]int, scorePlugins []weightedScorePlugin) []float64 {
+	totalScores := make([]float64, len(seedList))
+
+	for _, plugin := range scorePlugins {
+		rawScores := make([]float64, len(seedList))
+		for i := range seedList {
+			rawScores[i] = plugin.score(shoot, &seedList[i], seedUsage)
+		}
+
+		for i, score := range normalizeScores(rawScores) {
+			totalScores[i] += float64(plugin.weight) * score
+		}
+	}
+
+	return totalScores
+}
+
+func normalizeScores(scores []float64) []float64 {
+	if len(scores) == 0 {
+		return scores
+	}
+
+	lowest, highest := scores[0], scores[0]
+	for _, score := range scores {
+		if score < lowest {
+			lowest = score
+		}
+		if score > highest {
+			highest = score
+		}
+	}
+
+	normalized := make([]float64, len(scores))
+	for i, score := range scores {
+		if highest == lowest {
+			normalized[i] = maxScore
+			continue
+		}
+		normalized[i] = (score - lowest) / (highest - lowest) * maxScore
+	}
+	return normalized
+}
+
+// getSeedWithHighestScore finds the best candidate, i.e. the one with the highest total score. If multiple seeds have
+// the same total score, the first one is chosen.
+func getSeedWithHighestScore(shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, shootList []gardencorev1beta1.Shoot, scorePlugins []weightedScorePlugin) (*gardencorev1beta1.Seed, error) {
+	if len(seedList) == 0 {
+		return nil, fmt.Errorf("no seed candidates to score")
+	}
+
+	var (
+		seedUsage = v1beta1helper.CalculateSeedUsage(shootList)
+		scores    = scoreSeeds(shoot, seedList, seedUsage, scorePlugins)
+		best      = 0
+	)
+
+	for i := range seedList {
+		if scores[i] > scores[best] {
+			best = i
+		}
+	}
+
+	return &seedList[best], nil
+}
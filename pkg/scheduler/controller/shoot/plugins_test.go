@@ -0,0 +1,182 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+var _ = Describe("Plugins", func() {
+	var (
+		shoot *gardencorev1beta1.Shoot
+		seeds []gardencorev1beta1.Seed
+
+		shootsOnSeed = func(seedName string, count int) []gardencorev1beta1.Shoot {
+			var shoots []gardencorev1beta1.Shoot
+			for i := 0; i < count; i++ {
+				shoots = append(shoots, gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SeedName: pointer.String(seedName)}})
+			}
+			return shoots
+		}
+
+		determineSeed = func(plugins *config.ShootSchedulerPlugins, shootList []gardencorev1beta1.Shoot) string {
+			_, scorePlugins, err := newPlugins(plugins)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+			seed, err := getSeedWithHighestScore(shoot, seeds, shootList, scorePlugins)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return seed.Name
+		}
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{}
+		seeds = []gardencorev1beta1.Seed{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-1", Labels: map[string]string{"tier": "premium"}},
+				Spec:       gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: "eu", Zones: []string{"a"}}},
+				Status:     gardencorev1beta1.SeedStatus{Allocatable: allocatableShoots(100)},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-2"},
+				Spec:       gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "bar", Region: "us", Zones: []string{"a", "b", "c"}}},
+				Status:     gardencorev1beta1.SeedStatus{Allocatable: allocatableShoots(10)},
+			},
+		}
+	})
+
+	Describe("#newPlugins", func() {
+		It("should return the default score plugins if no plugins are configured", func() {
+			filterPlugins, scorePlugins, err := newPlugins(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterPlugins).To(BeEmpty())
+			Expect(scorePlugins).To(HaveLen(1))
+			Expect(scorePlugins[0].name).To(Equal(config.ScorePluginLeastShoots))
+		})
+
+		It("should fail for unknown plugins", func() {
+			_, _, err := newPlugins(&config.ShootSchedulerPlugins{Score: []config.ScorePlugin{{Name: "foo"}}})
+			Expect(err).To(MatchError(ContainSubstring(`failed creating score plugin "foo"`)))
+		})
+
+		It("should fail for plugins with missing arguments", func() {
+			_, _, err := newPlugins(&config.ShootSchedulerPlugins{Filter: []config.FilterPlugin{{Name: config.FilterPluginSeedUtilization}}})
+			Expect(err).To(MatchError(ContainSubstring("missing arguments")))
+		})
+	})
+
+	Describe("SeedUtilization filter", func() {
+		var filter filterPlugin
+
+		BeforeEach(func() {
+			filter = newSeedUtilizationFilter(80)
+		})
+
+		It("should not filter seeds without allocatable shoots", func() {
+			seeds[0].Status.Allocatable = nil
+			Expect(filter(shoot, &seeds[0], map[string]int{"seed-1": 1000})).To(Succeed())
+		})
+
+		It("should not filter seeds below the maximum utilization", func() {
+			Expect(filter(shoot, &seeds[1], map[string]int{"seed-2": 7})).To(Succeed())
+		})
+
+		It("should filter seeds which would exceed the maximum utilization", func() {
+			Expect(filter(shoot, &seeds[1], map[string]int{"seed-2": 8})).To(MatchError("seed would exceed the maximum utilization of 80% of its allocatable shoots"))
+		})
+	})
+
+	Describe("#getSeedWithHighestScore", func() {
+		It("should choose the seed with the least shoots by default", func() {
+			Expect(determineSeed(nil, shootsOnSeed("seed-1", 3))).To(Equal("seed-2"))
+			Expect(determineSeed(nil, shootsOnSeed("seed-2", 3))).To(Equal("seed-1"))
+		})
+
+		It("should choose the first seed if all seeds have the same score", func() {
+			Expect(determineSeed(nil, nil)).To(Equal("seed-1"))
+		})
+
+		It("should choose the seed with the most free capacity", func() {
+			plugins := &config.ShootSchedulerPlugins{Score: []config.ScorePlugin{{Name: config.ScorePluginSeedCapacity}}}
+			shootList := append(shootsOnSeed("seed-1", 50), shootsOnSeed("seed-2", 2)...)
+
+			Expect(determineSeed(plugins, shootList)).To(Equal("seed-2"))
+		})
+
+		It("should choose the seed with the least shoots per zone", func() {
+			plugins := &config.ShootSchedulerPlugins{Score: []config.ScorePlugin{{Name: config.ScorePluginShootsPerZone}}}
+			shootList := append(shootsOnSeed("seed-1", 2), shootsOnSeed("seed-2", 3)...)
+
+			Expect(determineSeed(plugins, shootList)).To(Equal("seed-2"))
+		})
+
+		It("should choose the seed matching the label preferences", func() {
+			plugins := &config.ShootSchedulerPlugins{Score: []config.ScorePlugin{{Name: config.ScorePluginSeedLabels, SeedLabels: &config.SeedLabelsArgs{
+				Preferences: []config.SeedLabelPreference{{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "premium"}}, Weight: 1}},
+			}}}}
+
+			Expect(determineSeed(plugins, shootsOnSeed("seed-1", 3))).To(Equal("seed-1"))
+		})
+
+		It("should choose the seed with the lowest provider cost", func() {
+			plugins := &config.ShootSchedulerPlugins{Score: []config.ScorePlugin{{Name: config.ScorePluginProviderCost, ProviderCost: &config.ProviderCostArgs{
+				Costs: []config.ProviderCostHint{
+					{Type: "foo", Cost: 1},
+					{Type: "bar", Cost: 5},
+					{Type: "bar", Region: pointer.String("us"), Cost: 10},
+				},
+			}}}}
+
+			Expect(determineSeed(plugins, shootsOnSeed("seed-1", 3))).To(Equal("seed-1"))
+		})
+
+		It("should treat seeds without a cost hint like the most expensive ones", func() {
+			plugins := &config.ShootSchedulerPlugins{Score: []config.ScorePlugin{{Name: config.ScorePluginProviderCost, ProviderCost: &config.ProviderCostArgs{
+				Costs: []config.ProviderCostHint{{Type: "bar", Cost: 5}},
+			}}}}
+
+			Expect(determineSeed(plugins, nil)).To(Equal("seed-1"))
+		})
+
+		It("should take the weights of the score plugins into account", func() {
+			var (
+				shootList = shootsOnSeed("seed-1", 3)
+				plugins   = &config.ShootSchedulerPlugins{Score: []config.ScorePlugin{
+					{Name: config.ScorePluginLeastShoots, Weight: pointer.Int32(1)},
+					{Name: config.ScorePluginSeedLabels, Weight: pointer.Int32(2), SeedLabels: &config.SeedLabelsArgs{
+						Preferences: []config.SeedLabelPreference{{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "premium"}}, Weight: 1}},
+					}},
+				}}
+			)
+
+			Expect(determineSeed(plugins, shootList)).To(Equal("seed-1"))
+
+			plugins.Score[0].Weight = pointer.Int32(3)
+			Expect(determineSeed(plugins, shootList)).To(Equal("seed-2"))
+		})
+	})
+})
+
+func allocatableShoots(shoots int64) corev1.ResourceList {
+	return corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(shoots, resource.DecimalSI)}
+}
@@ -37,7 +37,8 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
-	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	schedulerutils "github.com/gardener/gardener/pkg/scheduler/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 )
 
@@ -130,55 +131,146 @@ func (r *Reconciler) determineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
-	seedList := &gardencorev1beta1.SeedList{}
-	if err := r.Client.List(ctx, seedList); err != nil {
-		return nil, err
-	}
-	shootList := &gardencorev1beta1.ShootList{}
-	if err := r.Client.List(ctx, shootList); err != nil {
-		return nil, err
-	}
-	cloudProfile := &gardencorev1beta1.CloudProfile{}
-	if err := r.Client.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
-		return nil, err
-	}
-	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
+	s, err := r.prepareScheduling(ctx, log, shoot)
 	if err != nil {
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
+	filteredSeeds := s.seeds
+	for _, step := range s.steps {
+		if filteredSeeds, err = step.filter(filteredSeeds); err != nil {
+			return nil, err
+		}
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
+	return getSeedWithHighestScore(shoot, filteredSeeds, s.shoots, s.scorePlugins)
+}
+
+// scheduling contains everything required for determining the seed for a shoot.
+type scheduling struct {
+	seeds        []gardencorev1beta1.Seed
+	shoots       []gardencorev1beta1.Shoot
+	steps        []schedulingStep
+	scorePlugins []weightedScorePlugin
+}
+
+// schedulingStep narrows down the list of seed candidates. filter returns an error if no candidate is left. reason
+// describes why the given seed is filtered out by this step.
+type schedulingStep struct {
+	name   string
+	filter func([]gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+	reason func(*gardencorev1beta1.Seed) string
+}
+
+func (r *Reconciler) prepareScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*scheduling, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList.Items, filteredSeeds)
+	regionConfig, err := r.getRegionConfigMap(ctx, log, shoot.Spec.CloudProfileName)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
+	filterPlugins, scorePlugins, err := newPlugins(r.Config.Plugins)
 	if err != nil {
 		return nil, err
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList.Items)
+
+	seedUsage := v1beta1helper.CalculateSeedUsage(shootList.Items)
+
+	return &scheduling{
+		seeds:  seedList.Items,
+		shoots: shootList.Items,
+		steps: []schedulingStep{
+			{
+				name:   "SeedUsability",
+				filter: filterUsableSeeds,
+				reason: func(*gardencorev1beta1.Seed) string {
+					return "seed is not valid for scheduling (deleting, invisible or not ready)"
+				},
+			},
+			{
+				name: "CloudProfileSeedSelector",
+				filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsMatchingLabelSelector(seeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
+				},
+				reason: func(*gardencorev1beta1.Seed) string {
+					return "seed does not have the labels required by the seed selector of the CloudProfile"
+				},
+			},
+			{
+				name: "ShootSeedSelector",
+				filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsMatchingLabelSelector(seeds, shoot.Spec.SeedSelector, "Shoot")
+				},
+				reason: func(*gardencorev1beta1.Seed) string {
+					return "seed does not have the labels required by the seed selector of the Shoot"
+				},
+			},
+			{
+				name: "ProviderType",
+				filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsMatchingProviders(cloudProfile, shoot, seeds)
+				},
+				reason: func(seed *gardencorev1beta1.Seed) string {
+					return fmt.Sprintf("seed provider type %q does not match the provider type %q of the Shoot", seed.Spec.Provider.Type, shoot.Spec.Provider.Type)
+				},
+			},
+			{
+				name: "ZonalControlPlane",
+				filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsForZonalShootControlPlanes(seeds, shoot)
+				},
+				reason: func(*gardencorev1beta1.Seed) string {
+					return "seed does not have at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"
+				},
+			},
+			{
+				name: "IPFamilies",
+				filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsSupportingIPFamilies(seeds, shoot)
+				},
+				reason: func(seed *gardencorev1beta1.Seed) string {
+					return fmt.Sprintf("seed IP families %v do not support the IP families %v of the Shoot", seed.Spec.Networks.IPFamilies, shoot.Spec.Networking.IPFamilies)
+				},
+			},
+			{
+				name: "SeedEligibility",
+				filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterCandidates(shoot, shootList.Items, seeds, filterPlugins)
+				},
+				reason: func(seed *gardencorev1beta1.Seed) string {
+					if err := checkCandidate(shoot, seed, seedUsage, filterPlugins); err != nil {
+						return err.Error()
+					}
+					return ""
+				},
+			},
+			{
+				name: "Strategy",
+				filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return applyStrategy(log, shoot, seeds, r.Config.Strategy, regionConfig)
+				},
+				reason: func(*gardencorev1beta1.Seed) string {
+					if shoot.Spec.Purpose != nil && *shoot.Spec.Purpose == gardencorev1beta1.ShootPurposeTesting {
+						return fmt.Sprintf("seed is not a candidate for shoots with purpose %q", gardencorev1beta1.ShootPurposeTesting)
+					}
+					return fmt.Sprintf("seed is not a candidate of strategy %q for region %q", r.Config.Strategy, shoot.Spec.Region)
+				},
+			},
+		},
+		scorePlugins: scorePlugins,
+	}, nil
 }
 
-func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
+func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfileName string) (*corev1.ConfigMap, error) {
 	regionConfigList := &corev1.ConfigMapList{}
 	if err := r.Client.List(ctx, regionConfigList, client.InNamespace(r.GardenNamespace), client.MatchingLabels{v1beta1constants.SchedulingPurpose: v1beta1constants.SchedulingPurposeRegionConfig}); err != nil {
 		return nil, err
@@ -188,33 +280,29 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 	for _, regionConf := range regionConfigList.Items {
 		profileNames := strings.Split(regionConf.Annotations[v1beta1constants.AnnotationSchedulingCloudProfiles], ",")
 		for _, name := range profileNames {
-			if name != cloudProfile.Name {
+			if name != cloudProfileName {
 				continue
 			}
 			if regionConfig == nil {
 				regionConfig = regionConf.DeepCopy()
 			} else {
-				log.Info("Duplicate scheduler region config found", "configMap", client.ObjectKeyFromObject(&regionConf), "cloudProfileName", cloudProfile.Name, "chosenConfigMap", client.ObjectKeyFromObject(regionConfig))
+				log.Info("Duplicate scheduler region config found", "configMap", client.ObjectKeyFromObject(&regionConf), "cloudProfileName", cloudProfileName, "chosenConfigMap", client.ObjectKeyFromObject(regionConfig))
 			}
 			break
 		}
 	}
 
 	if regionConfig == nil {
-		log.Info("No region config found", "cloudProfileName", cloudProfile.Name)
+		log.Info("No region config found", "cloudProfileName", cloudProfileName)
 	}
 	return regionConfig, nil
 }
 
-func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
-}
-
 func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
 	var matchingSeeds []gardencorev1beta1.Seed
 
 	for _, seed := range seedList {
-		if isUsableSeed(&seed) {
+		if schedulerutils.IsUsableSeed(&seed) {
 			matchingSeeds = append(matchingSeeds, seed)
 		}
 	}
@@ -284,6 +372,25 @@ func filterSeedsForZonalShootControlPlanes(seedList []gardencorev1beta1.Seed, sh
 	return seedList, nil
 }
 
+// filterSeedsSupportingIPFamilies filters seeds which support all IP families of dual-stack shoots.
+func filterSeedsSupportingIPFamilies(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.Seed, error) {
+	if shoot.Spec.Networking == nil || !gardencorev1beta1.IsDualStack(shoot.Spec.Networking.IPFamilies) {
+		return seedList, nil
+	}
+
+	var matchingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if v1beta1helper.SeedSupportsIPFamilies(seed.Spec.Networks.IPFamilies, shoot.Spec.Networking.IPFamilies) {
+			matchingSeeds = append(matchingSeeds, seed)
+		}
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, fmt.Errorf("none of the %d seeds supports the IP families %v", len(seedList), shoot.Spec.Networking.IPFamilies)
+	}
+	return matchingSeeds, nil
+}
+
 func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, strategy config.CandidateDeterminationStrategy, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
@@ -308,7 +415,7 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, filterPlugins []filterPlugin) ([]gardencorev1beta1.Seed, error) {
 	var (
 		candidates      []gardencorev1beta1.Seed
 		candidateErrors = make(map[string]error)
@@ -316,20 +423,8 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []gardencorev1be
 	)
 
 	for _, seed := range seedList {
-		if shoot.Spec.Networking != nil {
-			if disjointed, err := networksAreDisjointed(&seed, shoot); !disjointed {
-				candidateErrors[seed.Name] = err
-				continue
-			}
-		}
-
-		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
-			candidateErrors[seed.Name] = fmt.Errorf("shoot does not tolerate the seed's taints")
-			continue
-		}
-
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
-			candidateErrors[seed.Name] = fmt.Errorf("seed does not have available capacity for shoots")
+		if err := checkCandidate(shoot, &seed, seedUsage, filterPlugins); err != nil {
+			candidateErrors[seed.Name] = err
 			continue
 		}
 
@@ -342,22 +437,32 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []gardencorev1be
 	return candidates, nil
 }
 
-// getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
-	var (
-		bestCandidate gardencorev1beta1.Seed
-		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
-	)
-
-	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
-			bestCandidate = seed
-			min = &numberOfManagedShoots
+// checkCandidate returns an error describing the reason if the given seed is not eligible for hosting the given shoot.
+func checkCandidate(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int, filterPlugins []filterPlugin) error {
+	if shoot.Spec.Networking != nil {
+		if disjointed, err := networksAreDisjointed(seed, shoot); !disjointed {
+			return err
 		}
 	}
 
-	return &bestCandidate, nil
+	if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+		return fmt.Errorf("shoot does not tolerate the seed's taints")
+	}
+
+	if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+		return fmt.Errorf("seed does not have available capacity for shoots")
+	}
+
+	return runFilterPlugins(filterPlugins, shoot, seed, seedUsage)
+}
+
+func runFilterPlugins(filterPlugins []filterPlugin, shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, seedUsage map[string]int) error {
+	for _, filter := range filterPlugins {
+		if err := filter(shoot, seed, seedUsage); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
@@ -508,6 +613,18 @@ func networksAreDisjointed(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta
 		errorMessages = append(errorMessages, e.ErrorBody())
 	}
 
+	for _, e := range cidrvalidation.ValidateSecondaryNetworkDisjointedness(
+		field.NewPath(""),
+		shoot.Spec.Networking.SecondaryNodes,
+		shoot.Spec.Networking.SecondaryPods,
+		shoot.Spec.Networking.SecondaryServices,
+		seed.Spec.Networks.Nodes,
+		seed.Spec.Networks.Pods,
+		seed.Spec.Networks.Services,
+	) {
+		errorMessages = append(errorMessages, e.ErrorBody())
+	}
+
 	return len(errorMessages) == 0, fmt.Errorf("invalid networks: %s", errorMessages)
 }
 
@@ -519,21 +636,3 @@ func errorMapToString(errs map[string]error) string {
 	res = strings.TrimSuffix(res, ", ") + "}"
 	return res
 }
-
-func verifySeedReadiness(seed *gardencorev1beta1.Seed) bool {
-	if seed.Status.LastOperation == nil {
-		return false
-	}
-
-	if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
-		return false
-	}
-
-	if seed.Spec.Backup != nil {
-		if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedBackupBucketsReady); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
-			return false
-		}
-	}
-
-	return true
-}
@@ -20,7 +20,6 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -281,6 +280,41 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(multiZonalSeed.Name))
 		})
+
+		It("should find a dual-stack seed cluster for a dual-stack shoot", func() {
+			dualStackSeed := seedBase.DeepCopy()
+			dualStackSeed.Name = "seed-dual-stack"
+			dualStackSeed.Spec.Networks.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+
+			shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+			shoot.Spec.Networking.SecondaryPods = pointer.String("2001:db8:1::/48")
+			shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:3::/108")
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, dualStackSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(dualStackSeed.Name))
+		})
+
+		It("should fail because no seed supports the IP families of a dual-stack shoot", func() {
+			seed.Spec.Networks.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}
+
+			shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+			shoot.Spec.Networking.SecondaryPods = pointer.String("2001:db8:1::/48")
+			shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:3::/108")
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError("none of the 1 seeds supports the IP families [IPv4 IPv6]"))
+			Expect(bestSeed).To(BeNil())
+		})
 	})
 
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using 'MinimalDistance' seed determination strategy", func() {
@@ -682,6 +716,28 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster due to secondary network disjointedness", func() {
+			seed.Spec.Networks = gardencorev1beta1.SeedNetworks{
+				IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6, gardencorev1beta1.IPFamilyIPv4},
+				Pods:       "2001:db8:11::/48",
+				Services:   "2001:db8:12::/108",
+			}
+			shoot.Spec.Networking = &gardencorev1beta1.Networking{
+				IPFamilies:        []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6},
+				Pods:              pointer.String("10.50.0.0/16"),
+				Services:          pointer.String("10.60.0.0/16"),
+				SecondaryPods:     pointer.String(seed.Spec.Networks.Pods),
+				SecondaryServices: pointer.String("2001:db8:3::/108"),
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("shoot secondary pod network intersects with seed pod network")))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to non-tolerated taints", func() {
 			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
 			shoot.Spec.Tolerations = nil
@@ -711,6 +767,27 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster due to a configured filter plugin", func() {
+			schedulerConfiguration.Schedulers.Shoot.Plugins = &config.ShootSchedulerPlugins{
+				Filter: []config.FilterPlugin{{Name: config.FilterPluginSeedUtilization, SeedUtilization: &config.SeedUtilizationArgs{MaxUtilizationPercentage: 50}}},
+			}
+			seed.Status.Allocatable = corev1.ResourceList{
+				gardencorev1beta1.ResourceShoots: resource.MustParse("2"),
+			}
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &seed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("seed would exceed the maximum utilization of 50% of its allocatable shoots")))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to no shoot networks specified and no defaults", func() {
 			seed.Spec.Networks.ShootDefaults = nil
 			shoot.Spec.Networking = &gardencorev1beta1.Networking{}
@@ -888,48 +965,3 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 })
-
-var _ = DescribeTable("condition is false",
-	func(conditionType gardencorev1beta1.ConditionType, deleteCondition, backup bool, expected gomegatypes.GomegaMatcher) {
-		var seedBackup *gardencorev1beta1.SeedBackup
-		if backup {
-			seedBackup = &gardencorev1beta1.SeedBackup{}
-		}
-
-		seed := &gardencorev1beta1.Seed{
-			Spec: gardencorev1beta1.SeedSpec{
-				Backup: seedBackup,
-			},
-			Status: gardencorev1beta1.SeedStatus{
-				Conditions: []gardencorev1beta1.Condition{
-					{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
-					{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
-					{Type: gardencorev1beta1.SeedExtensionsReady, Status: gardencorev1beta1.ConditionTrue},
-				},
-				LastOperation: &gardencorev1beta1.LastOperation{},
-			},
-		}
-
-		for i, cond := range seed.Status.Conditions {
-			if cond.Type == conditionType {
-				if deleteCondition {
-					seed.Status.Conditions = append(seed.Status.Conditions[:i], seed.Status.Conditions[i+1:]...)
-				} else {
-					seed.Status.Conditions[i].Status = gardencorev1beta1.ConditionFalse
-				}
-				break
-			}
-		}
-
-		Expect(verifySeedReadiness(seed)).To(expected)
-	},
-
-	Entry("SeedGardenletReady is missing", gardencorev1beta1.SeedGardenletReady, true, true, BeFalse()),
-	Entry("SeedGardenletReady is false", gardencorev1beta1.SeedGardenletReady, false, true, BeFalse()),
-	Entry("SeedBackupBucketsReady is missing", gardencorev1beta1.SeedBackupBucketsReady, true, true, BeFalse()),
-	Entry("SeedBackupBucketsReady is missing but no backup specified", gardencorev1beta1.SeedBackupBucketsReady, true, false, BeTrue()),
-	Entry("SeedBackupBucketsReady is false", gardencorev1beta1.SeedBackupBucketsReady, false, true, BeFalse()),
-	Entry("SeedBackupBucketsReady is false but no backup specified", gardencorev1beta1.SeedBackupBucketsReady, false, false, BeTrue()),
-	Entry("SeedExtensionsReady is missing", gardencorev1beta1.SeedExtensionsReady, true, true, BeTrue()),
-	Entry("SeedExtensionsReady is false", gardencorev1beta1.SeedExtensionsReady, false, true, BeTrue()),
-)
@@ -0,0 +1,111 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// SimulationResult is the result of a scheduling simulation for a shoot.
+type SimulationResult struct {
+	// ChosenSeed is the name of the seed the shoot would be scheduled to.
+	ChosenSeed *string `json:"chosenSeed,omitempty"`
+	// Error is the reason reported for the shoot if no seed could be determined.
+	Error *string `json:"error,omitempty"`
+	// Candidates are the seeds which passed all filters, ordered by their total score (highest first).
+	Candidates []SimulatedCandidate `json:"candidates,omitempty"`
+	// FilteredSeeds are the seeds which were filtered out, in the order of the scheduling steps.
+	FilteredSeeds []SimulatedFilteredSeed `json:"filteredSeeds,omitempty"`
+}
+
+// SimulatedCandidate is a seed which passed all filters of a scheduling simulation.
+type SimulatedCandidate struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Score is the total weighted score of the seed.
+	Score float64 `json:"score"`
+}
+
+// SimulatedFilteredSeed is a seed which was filtered out during a scheduling simulation.
+type SimulatedFilteredSeed struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Step is the name of the scheduling step which filtered out the seed.
+	Step string `json:"step"`
+	// Reason describes why the seed was filtered out.
+	Reason string `json:"reason"`
+}
+
+// Simulate determines the seed the given shoot would be scheduled to without binding the shoot. In contrast to the
+// actual scheduling, it reports the candidates with their scores and the reason why each other seed was filtered out.
+// Failing to determine a seed is reported in the result, an error is only returned if the simulation could not be run.
+func (r *Reconciler) Simulate(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SimulationResult, error) {
+	s, err := r.prepareScheduling(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result        = &SimulationResult{}
+		filteredSeeds = s.seeds
+	)
+
+	for _, step := range s.steps {
+		remainingSeeds, err := step.filter(filteredSeeds)
+
+		remainingSeedNames := make(map[string]struct{}, len(remainingSeeds))
+		for _, seed := range remainingSeeds {
+			remainingSeedNames[seed.Name] = struct{}{}
+		}
+
+		for i := range filteredSeeds {
+			if _, ok := remainingSeedNames[filteredSeeds[i].Name]; !ok {
+				result.FilteredSeeds = append(result.FilteredSeeds, SimulatedFilteredSeed{
+					Name:   filteredSeeds[i].Name,
+					Step:   step.name,
+					Reason: step.reason(&filteredSeeds[i]),
+				})
+			}
+		}
+
+		if err != nil {
+			result.Error = pointer.String(err.Error())
+			return result, nil
+		}
+		filteredSeeds = remainingSeeds
+	}
+
+	scores := scoreSeeds(shoot, filteredSeeds, v1beta1helper.CalculateSeedUsage(s.shoots), s.scorePlugins)
+	for i, seed := range filteredSeeds {
+		result.Candidates = append(result.Candidates, SimulatedCandidate{Name: seed.Name, Score: scores[i]})
+	}
+	// Sort stably to keep the seed order for equal scores, hence the first candidate is the chosen one, see
+	// getSeedWithHighestScore.
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		return result.Candidates[i].Score > result.Candidates[j].Score
+	})
+
+	if len(result.Candidates) > 0 {
+		result.ChosenSeed = pointer.String(result.Candidates[0].Name)
+	}
+	return result, nil
+}
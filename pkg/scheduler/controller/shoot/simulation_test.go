@@ -0,0 +1,150 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Simulation", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+
+		newSeed = func(name, providerType, region string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: providerType, Region: region},
+					Networks: gardencorev1beta1.SeedNetworks{
+						Nodes:    pointer.String("10.10.0.0/16"),
+						Pods:     "10.20.0.0/16",
+						Services: "10.30.0.0/16",
+					},
+					Settings: &gardencorev1beta1.SeedSettings{
+						Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+					},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &config.ShootSchedulerConfiguration{Strategy: config.SameRegion},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: "cloudprofile",
+				Region:           "europe",
+				Provider:         gardencorev1beta1.Provider{Type: "foo", Workers: []gardencorev1beta1.Worker{{Name: "worker"}}},
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:    pointer.String("10.40.0.0/16"),
+					Pods:     pointer.String("10.50.0.0/16"),
+					Services: pointer.String("10.60.0.0/16"),
+				},
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+	})
+
+	It("should report the candidates, the filtered seeds and the chosen seed", func() {
+		invisibleSeed := newSeed("invisible", "foo", "europe")
+		invisibleSeed.Spec.Settings.Scheduling.Visible = false
+		overlappingSeed := newSeed("overlapping", "foo", "europe")
+		overlappingSeed.Spec.Networks.Pods = "10.50.0.0/16"
+		taintedSeed := newSeed("tainted", "foo", "europe")
+		taintedSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+
+		for _, seed := range []*gardencorev1beta1.Seed{
+			invisibleSeed,
+			newSeed("other-provider", "bar", "europe"),
+			overlappingSeed,
+			taintedSeed,
+			newSeed("other-region", "foo", "asia"),
+			newSeed("seed-1", "foo", "europe"),
+			newSeed("seed-2", "foo", "europe"),
+		} {
+			Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		}
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-shoot", Namespace: "garden-dev"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: pointer.String("seed-1")},
+		})).To(Succeed())
+
+		result, err := reconciler.Simulate(ctx, logr.Discard(), shoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.Error).To(BeNil())
+		Expect(result.ChosenSeed).To(PointTo(Equal("seed-2")))
+		Expect(result.Candidates).To(Equal([]SimulatedCandidate{
+			{Name: "seed-2", Score: 100},
+			{Name: "seed-1", Score: 0},
+		}))
+		Expect(result.FilteredSeeds).To(ConsistOf(
+			SimulatedFilteredSeed{Name: "invisible", Step: "SeedUsability", Reason: "seed is not valid for scheduling (deleting, invisible or not ready)"},
+			SimulatedFilteredSeed{Name: "other-provider", Step: "ProviderType", Reason: `seed provider type "bar" does not match the provider type "foo" of the Shoot`},
+			MatchFields(IgnoreExtras, Fields{"Name": Equal("overlapping"), "Step": Equal("SeedEligibility"), "Reason": ContainSubstring("invalid networks")}),
+			SimulatedFilteredSeed{Name: "tainted", Step: "SeedEligibility", Reason: "shoot does not tolerate the seed's taints"},
+			SimulatedFilteredSeed{Name: "other-region", Step: "Strategy", Reason: `seed is not a candidate of strategy "SameRegion" for region "europe"`},
+		))
+	})
+
+	It("should report the error if no seed can be determined", func() {
+		Expect(fakeClient.Create(ctx, newSeed("seed", "bar", "europe"))).To(Succeed())
+
+		result, err := reconciler.Simulate(ctx, logr.Discard(), shoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.ChosenSeed).To(BeNil())
+		Expect(result.Candidates).To(BeEmpty())
+		Expect(result.Error).To(PointTo(Equal(`none out of the 1 seeds has a matching provider for "foo"`)))
+		Expect(result.FilteredSeeds).To(ConsistOf(
+			SimulatedFilteredSeed{Name: "seed", Step: "ProviderType", Reason: `seed provider type "bar" does not match the provider type "foo" of the Shoot`},
+		))
+	})
+
+	It("should fail if the cloud profile does not exist", func() {
+		shoot.Spec.CloudProfileName = "does-not-exist"
+
+		_, err := reconciler.Simulate(ctx, logr.Discard(), shoot)
+		Expect(err).To(BeNotFoundError())
+	})
+})
@@ -0,0 +1,93 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// graphExport is the JSON representation of a compiled Flow.
+type graphExport struct {
+	Name     string       `json:"name"`
+	Revision string       `json:"revision"`
+	Tasks    []taskExport `json:"tasks"`
+}
+
+type taskExport struct {
+	ID           TaskID      `json:"id"`
+	Dependencies TaskIDSlice `json:"dependencies,omitempty"`
+	Skip         bool        `json:"skip,omitempty"`
+	Resumable    bool        `json:"resumable,omitempty"`
+}
+
+func (f *Flow) export() graphExport {
+	dependencies := make(map[TaskID]TaskIDs, len(f.nodes))
+	for id, n := range f.nodes {
+		for target := range n.targetIDs {
+			if dependencies[target] == nil {
+				dependencies[target] = NewTaskIDs()
+			}
+			dependencies[target].Insert(id)
+		}
+	}
+
+	export := graphExport{Name: f.name, Revision: f.Revision()}
+	for _, id := range f.taskIDs().List() {
+		task := taskExport{ID: id, Skip: f.nodes[id].skip, Resumable: f.nodes[id].resumable}
+		if deps := dependencies[id]; deps.Len() > 0 {
+			task.Dependencies = deps.List()
+		}
+		export.Tasks = append(export.Tasks, task)
+	}
+	return export
+}
+
+func (f *Flow) taskIDs() TaskIDs {
+	ids := NewTaskIDs()
+	for id := range f.nodes {
+		ids.Insert(id)
+	}
+	return ids
+}
+
+// MarshalJSON returns the compiled graph of the flow as JSON, i.e., its name, revision and tasks with their
+// dependencies.
+func (f *Flow) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.export())
+}
+
+// DOT returns the compiled graph of the flow in the DOT language, e.g., for rendering it with Graphviz. Skipped tasks
+// are drawn dashed.
+func (f *Flow) DOT() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "digraph %s {\n", strconv.Quote(f.name))
+	for _, task := range f.export().Tasks {
+		if task.Skip {
+			fmt.Fprintf(&sb, "  %s [style=dashed];\n", strconv.Quote(string(task.ID)))
+		} else {
+			fmt.Fprintf(&sb, "  %s;\n", strconv.Quote(string(task.ID)))
+		}
+		for _, dependency := range task.Dependencies {
+			fmt.Fprintf(&sb, "  %s -> %s;\n", strconv.Quote(string(dependency)), strconv.Quote(string(task.ID)))
+		}
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
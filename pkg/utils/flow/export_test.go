@@ -0,0 +1,67 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Export", func() {
+	var f *flow.Flow
+
+	BeforeEach(func() {
+		var (
+			g = flow.NewGraph("foo")
+			x = g.Add(flow.Task{Name: "x", Resumable: true})
+			y = g.Add(flow.Task{Name: "y", SkipIf: true})
+			_ = g.Add(flow.Task{Name: "z", Dependencies: flow.NewTaskIDs(x, y)})
+		)
+		f = g.Compile()
+	})
+
+	Describe("#MarshalJSON", func() {
+		It("should export the compiled graph as JSON", func() {
+			data, err := json.Marshal(f)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{
+  "name": "foo",
+  "revision": "` + f.Revision() + `",
+  "tasks": [
+    {"id": "x", "resumable": true},
+    {"id": "y", "skip": true},
+    {"id": "z", "dependencies": ["x", "y"]}
+  ]
+}`))
+		})
+	})
+
+	Describe("#DOT", func() {
+		It("should export the compiled graph in the DOT language", func() {
+			Expect(f.DOT()).To(Equal(`digraph "foo" {
+  "x";
+  "y" [style=dashed];
+  "z";
+  "x" -> "z";
+  "y" -> "z";
+}
+`))
+		})
+	})
+})
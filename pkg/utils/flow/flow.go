@@ -80,6 +80,7 @@ type node struct {
 	required  int
 	fn        TaskFn
 	skip      bool
+	resumable bool
 }
 
 func (n *node) String() string {
@@ -106,6 +107,13 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// StateStore is used to persist the succeeded tasks of a failed execution, so that resumable tasks are not re-run
+	// by the next execution. The state is deleted once an execution succeeds.
+	StateStore StateStore
+	// Tracer is used to trace the flow execution and its tasks. Defaults to NoopTracer.
+	Tracer Tracer
+	// TracingAttributes are passed to the Tracer to identify the flow execution, e.g., the reconciled object.
+	TracingAttributes map[string]string
 }
 
 // Run starts an execution of a Flow.
@@ -178,6 +186,11 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	tracer := opts.Tracer
+	if tracer == nil {
+		tracer = NoopTracer
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
@@ -186,6 +199,10 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.StateStore,
+		NewTaskIDs(),
+		tracer,
+		opts.TracingAttributes,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -201,6 +218,11 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	stateStore       StateStore
+	// previouslySucceeded are the tasks which succeeded in a previous execution of the same flow revision.
+	previouslySucceeded TaskIDs
+	tracer              Tracer
+	tracingAttributes   map[string]string
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -225,10 +247,22 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	}
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
+
+	if node.resumable && e.previouslySucceeded.Has(id) {
+		log.Info("Succeeded in previous execution, not running it again")
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil}
+		}()
+
+		return
+	}
+
 	go func() {
 		start := time.Now().UTC()
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
+		taskCtx, span := e.tracer.StartTask(ctx, e.flow.name, id)
+		err := node.fn(taskCtx)
+		span.End(err)
 		end := time.Now().UTC()
 		log.V(1).Info("Finished", "duration", end.Sub(start))
 
@@ -286,6 +320,8 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Starting")
+	ctx, span := e.tracer.StartFlow(ctx, e.flow.name, e.tracingAttributes)
+	e.loadState(ctx)
 	e.reportProgress(ctx)
 
 	var (
@@ -324,7 +360,56 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Finished")
-	return e.result(cancelErr)
+	err := e.result(cancelErr)
+	e.saveState(ctx, err)
+	span.End(err)
+	return err
+}
+
+func (e *execution) loadState(ctx context.Context) {
+	if e.stateStore == nil {
+		return
+	}
+
+	state, err := e.stateStore.Load(ctx, e.flow.name)
+	if err != nil {
+		e.log.Error(err, "Failed loading state of previous execution, running all tasks")
+		return
+	}
+	if state == nil {
+		return
+	}
+
+	if revision := e.flow.Revision(); state.Revision != revision {
+		e.log.Info("Ignoring state of previous execution because of different flow revision", "previousRevision", state.Revision, "revision", revision)
+		return
+	}
+
+	for _, id := range state.Succeeded {
+		e.previouslySucceeded.Insert(TaskID(id))
+	}
+	e.log.Info("Resuming previous execution", "succeededTasks", e.previouslySucceeded.Len())
+}
+
+func (e *execution) saveState(ctx context.Context, flowErr error) {
+	if e.stateStore == nil {
+		return
+	}
+
+	if flowErr == nil {
+		if err := e.stateStore.Delete(ctx, e.flow.name); err != nil {
+			e.log.Error(err, "Failed deleting state of execution")
+		}
+		return
+	}
+
+	if err := e.stateStore.Save(ctx, &State{
+		FlowName:  e.flow.name,
+		Revision:  e.flow.Revision(),
+		Succeeded: e.stats.Succeeded.StringList(),
+	}); err != nil {
+		e.log.Error(err, "Failed saving state of execution")
+	}
 }
 
 func (e *execution) result(cancelErr error) error {
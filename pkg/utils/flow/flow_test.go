@@ -179,6 +179,103 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+
+		Context("with state store", func() {
+			var (
+				list       *AtomicStringList
+				stateStore flow.StateStore
+				failY      bool
+
+				newFlow = func(extraTasks ...string) *flow.Flow {
+					mkListAppender := func(value string) flow.TaskFn {
+						return func(ctx context.Context) error {
+							list.Append(value)
+							if value == "y" && failY {
+								return errors.New("err")
+							}
+							return nil
+						}
+					}
+
+					var (
+						g = flow.NewGraph("foo")
+						x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x"), Resumable: true})
+						w = g.Add(flow.Task{Name: "w", Fn: mkListAppender("w")})
+						_ = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y"), Dependencies: flow.NewTaskIDs(x, w)})
+					)
+					for _, name := range extraTasks {
+						g.Add(flow.Task{Name: name, Fn: mkListAppender(name)})
+					}
+					return g.Compile()
+				}
+			)
+
+			BeforeEach(func() {
+				list = NewAtomicStringList()
+				stateStore = flow.NewInMemoryStateStore()
+				failY = true
+			})
+
+			It("should persist the succeeded tasks of a failed execution", func() {
+				f := newFlow()
+				Expect(f.Run(ctx, flow.Opts{StateStore: stateStore})).NotTo(Succeed())
+
+				Expect(stateStore.Load(ctx, "foo")).To(Equal(&flow.State{
+					FlowName:  "foo",
+					Revision:  f.Revision(),
+					Succeeded: []string{"w", "x"},
+				}))
+			})
+
+			It("should not run resumable tasks again which succeeded in the previous execution", func() {
+				Expect(newFlow().Run(ctx, flow.Opts{StateStore: stateStore})).NotTo(Succeed())
+				Expect(list.Values()).To(ConsistOf("x", "w", "y"))
+
+				list = NewAtomicStringList()
+				failY = false
+				Expect(newFlow().Run(ctx, flow.Opts{StateStore: stateStore})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("w", "y"))
+			})
+
+			It("should delete the state after a successful execution", func() {
+				Expect(newFlow().Run(ctx, flow.Opts{StateStore: stateStore})).NotTo(Succeed())
+
+				failY = false
+				Expect(newFlow().Run(ctx, flow.Opts{StateStore: stateStore})).To(Succeed())
+				Expect(stateStore.Load(ctx, "foo")).To(BeNil())
+
+				list = NewAtomicStringList()
+				Expect(newFlow().Run(ctx, flow.Opts{StateStore: stateStore})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("x", "w", "y"))
+			})
+
+			It("should ignore the state of a different flow revision", func() {
+				Expect(newFlow().Run(ctx, flow.Opts{StateStore: stateStore})).NotTo(Succeed())
+
+				list = NewAtomicStringList()
+				failY = false
+				Expect(newFlow("z").Run(ctx, flow.Opts{StateStore: stateStore})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("x", "w", "y", "z"))
+			})
+		})
+	})
+
+	Describe("#Revision", func() {
+		It("should only change if the graph changes", func() {
+			newGraph := func() *flow.Graph {
+				g := flow.NewGraph("foo")
+				x := g.Add(flow.Task{Name: "x"})
+				g.Add(flow.Task{Name: "y", Dependencies: flow.NewTaskIDs(x)})
+				return g
+			}
+
+			revision := newGraph().Compile().Revision()
+			Expect(newGraph().Compile().Revision()).To(Equal(revision))
+
+			g := newGraph()
+			g.Add(flow.Task{Name: "z", Dependencies: flow.NewTaskIDs(flow.TaskID("y"))})
+			Expect(g.Compile().Revision()).NotTo(Equal(revision))
+		})
 	})
 
 	Describe("#Sequential", func() {
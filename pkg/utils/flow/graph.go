@@ -25,6 +25,11 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// Resumable marks the task as idempotent and not required to be re-run once it succeeded, i.e., it must not provide
+	// any in-memory state to the tasks depending on it (e.g., secrets generated by a secrets manager). If the flow is run
+	// with a StateStore, the task is not executed again when a failed execution of the same flow revision is resumed.
+	// Tasks waiting for conditions which can change without changing the flow revision must not be resumable.
+	Resumable bool
 }
 
 // Spec returns the TaskSpec of a task.
@@ -33,6 +38,7 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.Resumable,
 	}
 }
 
@@ -42,6 +48,7 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	Resumable    bool
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -95,6 +102,7 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.resumable = taskSpec.Resumable
 		node.required = taskSpec.Dependencies.Len()
 	}
 
@@ -0,0 +1,162 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// ExecutionHistory is a Tracer which keeps the given number of most recent Flow executions in memory. It serves
+// them as JSON via HTTP, newest first.
+type ExecutionHistory struct {
+	clock clock.Clock
+	size  int
+
+	lock       sync.RWMutex
+	executions []*ExecutionRecord
+}
+
+// ExecutionRecord is the record of a Flow execution.
+type ExecutionRecord struct {
+	// FlowName is the name of the flow.
+	FlowName string `json:"flowName"`
+	// Attributes are the attributes identifying the execution.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// SpanRecord is the record of the flow span.
+	SpanRecord
+	// Tasks are the records of the executed tasks in the order they were started.
+	Tasks []*TaskRecord `json:"tasks,omitempty"`
+}
+
+// TaskRecord is the record of a task execution.
+type TaskRecord struct {
+	// ID is the ID of the task.
+	ID TaskID `json:"id"`
+	// SpanRecord is the record of the task span.
+	SpanRecord
+}
+
+// SpanRecord contains the timing and result of a span.
+type SpanRecord struct {
+	// Start is the time the span was started.
+	Start time.Time `json:"start"`
+	// End is the time the span ended. It is nil while the span is running.
+	End *time.Time `json:"end,omitempty"`
+	// Duration is the duration of the span. It is empty while the span is running.
+	Duration string `json:"duration,omitempty"`
+	// Error is the error the span ended with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+var _ Tracer = &ExecutionHistory{}
+
+// NewExecutionHistory returns a new ExecutionHistory keeping the given number of executions.
+func NewExecutionHistory(clock clock.Clock, size int) *ExecutionHistory {
+	return &ExecutionHistory{clock: clock, size: size}
+}
+
+type executionRecordKey struct{}
+
+// StartFlow implements Tracer.
+func (h *ExecutionHistory) StartFlow(ctx context.Context, flowName string, attributes map[string]string) (context.Context, Span) {
+	record := &ExecutionRecord{
+		FlowName:   flowName,
+		Attributes: attributes,
+		SpanRecord: SpanRecord{Start: h.clock.Now().UTC()},
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.executions = append(h.executions, record)
+	if len(h.executions) > h.size {
+		h.executions = h.executions[len(h.executions)-h.size:]
+	}
+
+	return context.WithValue(ctx, executionRecordKey{}, record), &historySpan{history: h, record: &record.SpanRecord}
+}
+
+// StartTask implements Tracer.
+func (h *ExecutionHistory) StartTask(ctx context.Context, _ string, id TaskID) (context.Context, Span) {
+	execution, ok := ctx.Value(executionRecordKey{}).(*ExecutionRecord)
+	if !ok {
+		return ctx, noopSpan{}
+	}
+
+	record := &TaskRecord{
+		ID:         id,
+		SpanRecord: SpanRecord{Start: h.clock.Now().UTC()},
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	execution.Tasks = append(execution.Tasks, record)
+	return ctx, &historySpan{history: h, record: &record.SpanRecord}
+}
+
+// Executions returns copies of the recorded executions, newest first.
+func (h *ExecutionHistory) Executions() []ExecutionRecord {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	out := make([]ExecutionRecord, 0, len(h.executions))
+	for i := len(h.executions) - 1; i >= 0; i-- {
+		execution := *h.executions[i]
+		execution.Tasks = make([]*TaskRecord, 0, len(h.executions[i].Tasks))
+		for _, task := range h.executions[i].Tasks {
+			t := *task
+			execution.Tasks = append(execution.Tasks, &t)
+		}
+		out = append(out, execution)
+	}
+	return out
+}
+
+// ServeHTTP serves the recorded executions as JSON.
+func (h *ExecutionHistory) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	data, err := json.MarshalIndent(h.Executions(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+type historySpan struct {
+	history *ExecutionHistory
+	record  *SpanRecord
+}
+
+func (s *historySpan) End(err error) {
+	end := s.history.clock.Now().UTC()
+
+	s.history.lock.Lock()
+	defer s.history.lock.Unlock()
+
+	s.record.End = &end
+	s.record.Duration = end.Sub(s.record.Start).String()
+	if err != nil {
+		s.record.Error = err.Error()
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("ExecutionHistory", func() {
+	var (
+		ctx       = context.Background()
+		fakeClock *testclock.FakeClock
+		history   *flow.ExecutionHistory
+
+		newFlow = func(name string, err error) *flow.Flow {
+			g := flow.NewGraph(name)
+			x := g.Add(flow.Task{Name: "x", Fn: func(context.Context) error {
+				fakeClock.Step(time.Second)
+				return nil
+			}})
+			g.Add(flow.Task{Name: "y", Fn: func(context.Context) error {
+				fakeClock.Step(2 * time.Second)
+				return err
+			}, Dependencies: flow.NewTaskIDs(x)})
+			return g.Compile()
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+		history = flow.NewExecutionHistory(fakeClock, 2)
+	})
+
+	It("should record the flow executions and their tasks", func() {
+		Expect(newFlow("foo", errors.New("err")).Run(ctx, flow.Opts{
+			Tracer:            history,
+			TracingAttributes: map[string]string{"shoot": "garden-dev/bar"},
+		})).NotTo(Succeed())
+
+		executions := history.Executions()
+		Expect(executions).To(HaveLen(1))
+
+		execution := executions[0]
+		Expect(execution.FlowName).To(Equal("foo"))
+		Expect(execution.Attributes).To(Equal(map[string]string{"shoot": "garden-dev/bar"}))
+		Expect(execution.Duration).To(Equal("3s"))
+		Expect(execution.Error).To(ContainSubstring(`task "y" failed: err`))
+
+		Expect(execution.Tasks).To(HaveLen(2))
+		Expect(execution.Tasks[0].ID).To(Equal(flow.TaskID("x")))
+		Expect(execution.Tasks[0].Duration).To(Equal("1s"))
+		Expect(execution.Tasks[0].Error).To(BeEmpty())
+		Expect(execution.Tasks[1].ID).To(Equal(flow.TaskID("y")))
+		Expect(execution.Tasks[1].Duration).To(Equal("2s"))
+		Expect(execution.Tasks[1].Error).To(Equal("err"))
+	})
+
+	It("should only keep the most recent executions", func() {
+		for _, name := range []string{"foo", "bar", "baz"} {
+			Expect(newFlow(name, nil).Run(ctx, flow.Opts{Tracer: history})).To(Succeed())
+		}
+
+		executions := history.Executions()
+		Expect(executions).To(HaveLen(2))
+		Expect(executions[0].FlowName).To(Equal("baz"))
+		Expect(executions[1].FlowName).To(Equal("bar"))
+	})
+
+	It("should serve the executions as JSON", func() {
+		Expect(newFlow("foo", nil).Run(ctx, flow.Opts{Tracer: history})).To(Succeed())
+
+		recorder := httptest.NewRecorder()
+		history.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/flows", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"flowName": "foo"`))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"duration": "3s"`))
+	})
+})
@@ -0,0 +1,102 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// State is the persisted completion state of a Flow execution. It is used to resume a failed execution without
+// re-running the resumable tasks which already succeeded.
+type State struct {
+	// FlowName is the name of the flow.
+	FlowName string `json:"flowName"`
+	// Revision is the revision of the flow's graph, see Flow.Revision. The state is ignored if the revision does not
+	// match the revision of the flow to run.
+	Revision string `json:"revision"`
+	// Succeeded are the IDs of the tasks which succeeded.
+	Succeeded []string `json:"succeeded,omitempty"`
+}
+
+// StateStore persists the State of Flow executions.
+type StateStore interface {
+	// Load returns the persisted state of the flow with the given name or nil if there is none.
+	Load(ctx context.Context, flowName string) (*State, error)
+	// Save persists the given state.
+	Save(ctx context.Context, state *State) error
+	// Delete removes the persisted state of the flow with the given name.
+	Delete(ctx context.Context, flowName string) error
+}
+
+// NewInMemoryStateStore returns a StateStore which keeps the states in memory. It can be used for resuming flows
+// within the same process.
+func NewInMemoryStateStore() StateStore {
+	return &inMemoryStateStore{states: make(map[string]State)}
+}
+
+type inMemoryStateStore struct {
+	lock   sync.RWMutex
+	states map[string]State
+}
+
+func (s *inMemoryStateStore) Load(_ context.Context, flowName string) (*State, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	state, ok := s.states[flowName]
+	if !ok {
+		return nil, nil
+	}
+	state.Succeeded = append([]string(nil), state.Succeeded...)
+	return &state, nil
+}
+
+func (s *inMemoryStateStore) Save(_ context.Context, state *State) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.states[state.FlowName] = State{
+		FlowName:  state.FlowName,
+		Revision:  state.Revision,
+		Succeeded: append([]string(nil), state.Succeeded...),
+	}
+	return nil
+}
+
+func (s *inMemoryStateStore) Delete(_ context.Context, flowName string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.states, flowName)
+	return nil
+}
+
+// Revision computes the revision of the flow's graph. It only depends on the IDs of the tasks and their dependencies,
+// i.e., it changes whenever tasks are added, removed, renamed or rewired.
+func (f *Flow) Revision() string {
+	lines := make([]string, 0, len(f.nodes))
+	for id, n := range f.nodes {
+		lines = append(lines, string(id)+"->"+strings.Join(n.targetIDs.StringList(), ","))
+	}
+	sort.Strings(lines)
+
+	hash := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(hash[:])[:16]
+}
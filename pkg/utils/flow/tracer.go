@@ -0,0 +1,95 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer traces Flow executions and their tasks.
+type Tracer interface {
+	// StartFlow is called when the execution of the flow with the given name starts. The given attributes identify the
+	// execution, e.g., the reconciled object. The returned context is used for starting the task spans.
+	StartFlow(ctx context.Context, flowName string, attributes map[string]string) (context.Context, Span)
+	// StartTask is called when the task with the given ID starts. The returned context is passed to the task.
+	StartTask(ctx context.Context, flowName string, id TaskID) (context.Context, Span)
+}
+
+// Span is a traced execution of a flow or task.
+type Span interface {
+	// End ends the span. The given error is the result of the execution, nil means success.
+	End(err error)
+}
+
+// NoopTracer is a Tracer which does nothing. It is used if no tracer is configured.
+var NoopTracer Tracer = noopTracer{}
+
+type noopTracer struct{}
+
+func (noopTracer) StartFlow(ctx context.Context, _ string, _ map[string]string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+func (noopTracer) StartTask(ctx context.Context, _ string, _ TaskID) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) End(error) {}
+
+// NewOpenTelemetryTracer returns a Tracer which creates OpenTelemetry spans with the given tracer. Task spans are
+// children of the span of their flow.
+func NewOpenTelemetryTracer(tracer trace.Tracer) Tracer {
+	return &openTelemetryTracer{tracer: tracer}
+}
+
+type openTelemetryTracer struct {
+	tracer trace.Tracer
+}
+
+func (t *openTelemetryTracer) StartFlow(ctx context.Context, flowName string, attributes map[string]string) (context.Context, Span) {
+	attrs := []attribute.KeyValue{attribute.String(logKeyFlow, flowName)}
+	for key, value := range attributes {
+		attrs = append(attrs, attribute.String(key, value))
+	}
+
+	ctx, span := t.tracer.Start(ctx, flowName, trace.WithAttributes(attrs...))
+	return ctx, &openTelemetrySpan{span: span}
+}
+
+func (t *openTelemetryTracer) StartTask(ctx context.Context, flowName string, id TaskID) (context.Context, Span) {
+	ctx, span := t.tracer.Start(ctx, string(id), trace.WithAttributes(
+		attribute.String(logKeyFlow, flowName),
+		attribute.String(logKeyTask, string(id)),
+	))
+	return ctx, &openTelemetrySpan{span: span}
+}
+
+type openTelemetrySpan struct {
+	span trace.Span
+}
+
+func (s *openTelemetrySpan) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
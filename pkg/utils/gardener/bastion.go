@@ -0,0 +1,124 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+const (
+	// EventReasonBastionSessionCreated is the reason of events which are recorded for projects when a Bastion is
+	// created.
+	EventReasonBastionSessionCreated = "BastionSessionCreated"
+	// EventReasonBastionSessionExtended is the reason of events which are recorded for projects when the session of a
+	// Bastion is extended.
+	EventReasonBastionSessionExtended = "BastionSessionExtended"
+	// EventReasonBastionSessionExpired is the reason of events which are recorded for projects when a Bastion expired.
+	EventReasonBastionSessionExpired = "BastionSessionExpired"
+	// EventReasonBastionSessionDeleted is the reason of events which are recorded for projects when a Bastion is
+	// deleted because of its Shoot.
+	EventReasonBastionSessionDeleted = "BastionSessionDeleted"
+)
+
+// NewBastionSessionEvent returns an event for the given project which records the action performed on the session of
+// the given bastion (the shoot name is optional). The event refers to the bastion as related object and is created in
+// the namespace of the bastion (i.e., the project namespace), so that the access to the nodes of shoots can be audited
+// by the project members. If the project is nil (e.g., because the namespace does not belong to a project), the event
+// is recorded for the bastion itself.
+func NewBastionSessionEvent(
+	project metav1.Object,
+	bastion metav1.Object,
+	shootName string,
+	action operationsv1alpha1.BastionSessionAction,
+	user string,
+	expirationTimestamp *metav1.Time,
+	component string,
+	now time.Time,
+) *corev1.Event {
+	subject := fmt.Sprintf("bastion %q", bastion.GetName())
+	if shootName != "" {
+		subject += fmt.Sprintf(" for shoot %q", shootName)
+	}
+
+	var reason, message string
+	switch action {
+	case operationsv1alpha1.BastionSessionActionCreate:
+		reason = EventReasonBastionSessionCreated
+		message = fmt.Sprintf("User %q created %s", user, subject)
+	case operationsv1alpha1.BastionSessionActionExtend:
+		reason = EventReasonBastionSessionExtended
+		message = fmt.Sprintf("User %q extended the session of %s", user, subject)
+	case operationsv1alpha1.BastionSessionActionDelete:
+		reason = EventReasonBastionSessionDeleted
+		message = fmt.Sprintf("Session of %s was terminated because the shoot is gone, is being deleted or was migrated", subject)
+	default:
+		reason = EventReasonBastionSessionExpired
+		message = fmt.Sprintf("Session of %s expired", subject)
+	}
+
+	if expirationTimestamp != nil {
+		message += fmt.Sprintf(", it expires at %s", expirationTimestamp.UTC().Format(time.RFC3339))
+	}
+
+	var (
+		eventTime  = metav1.NewTime(now)
+		bastionRef = corev1.ObjectReference{
+			APIVersion: operationsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Bastion",
+			Namespace:  bastion.GetNamespace(),
+			Name:       bastion.GetName(),
+			UID:        bastion.GetUID(),
+		}
+		event = &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: bastion.GetName() + ".",
+				Namespace:    bastion.GetNamespace(),
+			},
+			InvolvedObject:      bastionRef,
+			Action:              string(action),
+			Reason:              reason,
+			Message:             message,
+			Type:                corev1.EventTypeNormal,
+			Source:              corev1.EventSource{Component: component},
+			ReportingController: component,
+			FirstTimestamp:      eventTime,
+			LastTimestamp:       eventTime,
+			Count:               1,
+		}
+	)
+
+	if project != nil {
+		event.GenerateName = project.GetName() + "."
+		// Projects are cluster-scoped, however, the namespace of the involved object must match the namespace of the
+		// event.
+		event.InvolvedObject = corev1.ObjectReference{
+			APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+			Kind:       "Project",
+			Namespace:  bastion.GetNamespace(),
+			Name:       project.GetName(),
+			UID:        project.GetUID(),
+		}
+		event.Related = &bastionRef
+	}
+
+	return event
+}
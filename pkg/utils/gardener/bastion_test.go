@@ -0,0 +1,117 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Bastion", func() {
+	Describe("#NewBastionSessionEvent", func() {
+		var (
+			project *gardencorev1beta1.Project
+			bastion *operationsv1alpha1.Bastion
+			now     time.Time
+		)
+
+		BeforeEach(func() {
+			project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev", UID: "project-uid"}}
+			bastion = &operationsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: "bastion", Namespace: "garden-dev", UID: "bastion-uid"}}
+			now = time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+		})
+
+		It("should return an event for the creation of a bastion", func() {
+			expirationTimestamp := metav1.NewTime(now.Add(time.Hour))
+
+			Expect(NewBastionSessionEvent(project, bastion, "shoot", operationsv1alpha1.BastionSessionActionCreate, "foo", &expirationTimestamp, "component", now)).To(Equal(&corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "dev.",
+					Namespace:    "garden-dev",
+				},
+				InvolvedObject: corev1.ObjectReference{
+					APIVersion: "core.gardener.cloud/v1beta1",
+					Kind:       "Project",
+					Namespace:  "garden-dev",
+					Name:       "dev",
+					UID:        "project-uid",
+				},
+				Related: &corev1.ObjectReference{
+					APIVersion: "operations.gardener.cloud/v1alpha1",
+					Kind:       "Bastion",
+					Namespace:  "garden-dev",
+					Name:       "bastion",
+					UID:        "bastion-uid",
+				},
+				Action:              "Create",
+				Reason:              "BastionSessionCreated",
+				Message:             `User "foo" created bastion "bastion" for shoot "shoot", it expires at 2023-11-01T11:00:00Z`,
+				Type:                corev1.EventTypeNormal,
+				Source:              corev1.EventSource{Component: "component"},
+				ReportingController: "component",
+				FirstTimestamp:      metav1.NewTime(now),
+				LastTimestamp:       metav1.NewTime(now),
+				Count:               1,
+			}))
+		})
+
+		It("should return an event for the extension of a bastion session without shoot", func() {
+			event := NewBastionSessionEvent(project, bastion, "", operationsv1alpha1.BastionSessionActionExtend, "foo", nil, "component", now)
+
+			Expect(event.Action).To(Equal("Extend"))
+			Expect(event.Reason).To(Equal("BastionSessionExtended"))
+			Expect(event.Message).To(Equal(`User "foo" extended the session of bastion "bastion"`))
+		})
+
+		It("should return an event for the expiration of a bastion", func() {
+			event := NewBastionSessionEvent(project, bastion, "shoot", operationsv1alpha1.BastionSessionActionExpire, "", nil, "component", now)
+
+			Expect(event.Action).To(Equal("Expire"))
+			Expect(event.Reason).To(Equal("BastionSessionExpired"))
+			Expect(event.Message).To(Equal(`Session of bastion "bastion" for shoot "shoot" expired`))
+		})
+
+		It("should return an event for the deletion of a bastion", func() {
+			event := NewBastionSessionEvent(project, bastion, "shoot", operationsv1alpha1.BastionSessionActionDelete, "", nil, "component", now)
+
+			Expect(event.Action).To(Equal("Delete"))
+			Expect(event.Reason).To(Equal("BastionSessionDeleted"))
+			Expect(event.Message).To(Equal(`Session of bastion "bastion" for shoot "shoot" was terminated because the shoot is gone, is being deleted or was migrated`))
+		})
+
+		It("should return an event for the bastion if there is no project", func() {
+			event := NewBastionSessionEvent(nil, bastion, "shoot", operationsv1alpha1.BastionSessionActionCreate, "foo", nil, "component", now)
+
+			Expect(event.GenerateName).To(Equal("bastion."))
+			Expect(event.Namespace).To(Equal("garden-dev"))
+			Expect(event.InvolvedObject).To(Equal(corev1.ObjectReference{
+				APIVersion: "operations.gardener.cloud/v1alpha1",
+				Kind:       "Bastion",
+				Namespace:  "garden-dev",
+				Name:       "bastion",
+				UID:        "bastion-uid",
+			}))
+			Expect(event.Related).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,51 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// GetCloudProfile returns the effective CloudProfile for the given shoot. If the shoot references a
+// NamespacedCloudProfile, a CloudProfile is constructed from its status. Otherwise, the CloudProfile referenced by
+// spec.cloudProfileName is returned.
+func GetCloudProfile(ctx context.Context, reader client.Reader, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error) {
+	if shoot.Spec.CloudProfile == nil || shoot.Spec.CloudProfile.Kind != gardencorev1beta1.CloudProfileReferenceKindNamespacedCloudProfile {
+		cloudProfile := &gardencorev1beta1.CloudProfile{}
+		if err := reader.Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, cloudProfile); err != nil {
+			return nil, err
+		}
+		return cloudProfile, nil
+	}
+
+	namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Spec.CloudProfile.Name}, namespacedCloudProfile); err != nil {
+		return nil, err
+	}
+
+	if namespacedCloudProfile.Status.CloudProfileSpec.Type == "" {
+		return nil, fmt.Errorf("namespaced cloud profile %s has not yet been reconciled", client.ObjectKeyFromObject(namespacedCloudProfile))
+	}
+
+	return &gardencorev1beta1.CloudProfile{
+		ObjectMeta: namespacedCloudProfile.ObjectMeta,
+		Spec:       namespacedCloudProfile.Status.CloudProfileSpec,
+	}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("CloudProfile", func() {
+	Describe("#GetCloudProfile", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+
+			cloudProfile           *gardencorev1beta1.CloudProfile
+			namespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+			shoot                  *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "parent"},
+				Spec:       gardencorev1beta1.CloudProfileSpec{Type: "local"},
+			}
+			namespacedCloudProfile = &gardencorev1beta1.NamespacedCloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-dev"},
+				Spec: gardencorev1beta1.NamespacedCloudProfileSpec{
+					Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "parent"},
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec:       gardencorev1beta1.ShootSpec{CloudProfileName: "parent"},
+			}
+
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		})
+
+		It("should return the referenced CloudProfile", func() {
+			result, err := GetCloudProfile(ctx, fakeClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Name).To(Equal("parent"))
+			Expect(result.Spec).To(Equal(cloudProfile.Spec))
+		})
+
+		It("should return an error if the referenced CloudProfile does not exist", func() {
+			shoot.Spec.CloudProfileName = "missing"
+
+			result, err := GetCloudProfile(ctx, fakeClient, shoot)
+			Expect(err).To(BeNotFoundError())
+			Expect(result).To(BeNil())
+		})
+
+		Context("shoot references a NamespacedCloudProfile", func() {
+			BeforeEach(func() {
+				shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+			})
+
+			It("should return the effective CloudProfile of the NamespacedCloudProfile", func() {
+				namespacedCloudProfile.Status.CloudProfileSpec = gardencorev1beta1.CloudProfileSpec{
+					Type:         "local",
+					MachineTypes: []gardencorev1beta1.MachineType{{Name: "custom-type"}},
+				}
+				Expect(fakeClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+
+				result, err := GetCloudProfile(ctx, fakeClient, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Name).To(Equal("custom"))
+				Expect(result.Namespace).To(Equal("garden-dev"))
+				Expect(result.Spec.Type).To(Equal("local"))
+				Expect(result.Spec.MachineTypes).To(ConsistOf(HaveField("Name", "custom-type")))
+			})
+
+			It("should return an error if the NamespacedCloudProfile does not exist", func() {
+				result, err := GetCloudProfile(ctx, fakeClient, shoot)
+				Expect(err).To(BeNotFoundError())
+				Expect(result).To(BeNil())
+			})
+
+			It("should return an error if the NamespacedCloudProfile has not yet been reconciled", func() {
+				Expect(fakeClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+
+				result, err := GetCloudProfile(ctx, fakeClient, shoot)
+				Expect(err).To(MatchError(ContainSubstring("has not yet been reconciled")))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
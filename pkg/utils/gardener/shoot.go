@@ -80,7 +80,7 @@ func IsShootFailedAndUpToDate(shoot *gardencorev1beta1.Shoot) bool {
 // IsNowInEffectiveShootMaintenanceTimeWindow checks if the current time is in the effective
 // maintenance time window of the Shoot.
 func IsNowInEffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot, clock clock.Clock) bool {
-	return EffectiveShootMaintenanceTimeWindow(shoot).Contains(clock.Now())
+	return EffectiveShootMaintenanceSchedule(shoot).Contains(clock.Now())
 }
 
 // LastReconciliationDuringThisTimeWindow returns true if <now> is contained in the given effective maintenance time
@@ -92,12 +92,12 @@ func LastReconciliationDuringThisTimeWindow(shoot *gardencorev1beta1.Shoot, cloc
 	}
 
 	var (
-		timeWindow         = EffectiveShootMaintenanceTimeWindow(shoot)
+		schedule           = EffectiveShootMaintenanceSchedule(shoot)
 		now                = clock.Now()
 		lastReconciliation = shoot.Status.LastOperation.LastUpdateTime.Time
 	)
 
-	return timeWindow.Contains(lastReconciliation) && now.UTC().Sub(lastReconciliation.UTC()) <= gardencorev1beta1.MaintenanceTimeWindowDurationMaximum
+	return schedule.Contains(lastReconciliation) && now.UTC().Sub(lastReconciliation.UTC()) <= gardencorev1beta1.MaintenanceTimeWindowDurationMaximum
 }
 
 // IsObservedAtLatestGenerationAndSucceeded checks whether the Shoot's generation has changed or if the LastOperation status
@@ -142,7 +142,8 @@ func EffectiveMaintenanceTimeWindow(timeWindow *timewindow.MaintenanceTimeWindow
 	return timeWindow.WithEnd(timeWindow.End().Add(0, -15, 0))
 }
 
-// EffectiveShootMaintenanceTimeWindow returns the effective MaintenanceTimeWindow of the given Shoot.
+// EffectiveShootMaintenanceTimeWindow returns the effective MaintenanceTimeWindow of the given Shoot. It does not
+// consider the weekdays, additional time ranges and blackouts, see EffectiveShootMaintenanceSchedule.
 func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewindow.MaintenanceTimeWindow {
 	maintenance := shoot.Spec.Maintenance
 	if maintenance == nil || maintenance.TimeWindow == nil {
@@ -157,6 +158,42 @@ func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewi
 	return EffectiveMaintenanceTimeWindow(timeWindow)
 }
 
+// EffectiveShootMaintenanceSchedule returns the effective MaintenanceSchedule of the given Shoot. It consists of the
+// effective time windows of the maintenance time window and all additional time ranges (restricted to the configured
+// weekdays) as well as the configured blackout periods.
+func EffectiveShootMaintenanceSchedule(shoot *gardencorev1beta1.Shoot) *timewindow.MaintenanceSchedule {
+	maintenance := shoot.Spec.Maintenance
+	if maintenance == nil || maintenance.TimeWindow == nil {
+		return timewindow.AlwaysMaintenanceSchedule
+	}
+
+	maintenanceRange, err := timewindow.ParseMaintenanceRange(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End, maintenance.TimeWindowWeekdays)
+	if err != nil {
+		return timewindow.AlwaysMaintenanceSchedule
+	}
+
+	ranges := []*timewindow.MaintenanceRange{effectiveMaintenanceRange(maintenanceRange)}
+	for _, additionalRange := range maintenance.AdditionalTimeRanges {
+		maintenanceRange, err := timewindow.ParseMaintenanceRange(additionalRange.Begin, additionalRange.End, additionalRange.Weekdays)
+		if err != nil {
+			// Invalid ranges are rejected by the validation, skip them to not widen the schedule.
+			continue
+		}
+		ranges = append(ranges, effectiveMaintenanceRange(maintenanceRange))
+	}
+
+	var blackouts []timewindow.Blackout
+	for _, blackout := range maintenance.Blackouts {
+		blackouts = append(blackouts, timewindow.NewBlackout(blackout.Begin.Time, blackout.End.Time))
+	}
+
+	return timewindow.NewMaintenanceSchedule(ranges, blackouts)
+}
+
+func effectiveMaintenanceRange(maintenanceRange *timewindow.MaintenanceRange) *timewindow.MaintenanceRange {
+	return maintenanceRange.WithWindow(EffectiveMaintenanceTimeWindow(maintenanceRange.Window()))
+}
+
 // GetShootNameFromOwnerReferences attempts to get the name of the Shoot object which owns the passed in object.
 // If it is not owned by a Shoot, an empty string is returned.
 func GetShootNameFromOwnerReferences(objectMeta metav1.Object) string {
@@ -628,6 +665,10 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 		}
 	}
 
+	for _, extension := range GetEncryptionProviderExtensions(shoot) {
+		requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.ExtensionResource, extension.Type))
+	}
+
 	for _, pool := range shoot.Spec.Provider.Workers {
 		if pool.Machine.Image != nil {
 			requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.OperatingSystemConfigResource, pool.Machine.Image.Name))
@@ -672,6 +713,34 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 	return requiredExtensions
 }
 
+// GetEncryptionProviderExtensions returns the extensions deploying the KMS v2 plugins which are used as encryption
+// providers for the given shoot. While the resources are being migrated to a new encryption provider, the extension of
+// the currently active provider is returned as well.
+func GetEncryptionProviderExtensions(shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Extension {
+	var (
+		extensions []gardencorev1beta1.Extension
+		types      = sets.New[string]()
+	)
+
+	for _, provider := range []*gardencorev1beta1.EncryptionProvider{getEncryptionProvider(shoot), shoot.Status.EncryptionProvider} {
+		if provider == nil || provider.Type == gardencorev1beta1.EncryptionProviderTypeAESCBC || types.Has(provider.Type) {
+			continue
+		}
+
+		extensions = append(extensions, gardencorev1beta1.Extension{Type: provider.Type, ProviderConfig: provider.ProviderConfig})
+		types.Insert(provider.Type)
+	}
+
+	return extensions
+}
+
+func getEncryptionProvider(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.EncryptionProvider {
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.EncryptionConfig != nil {
+		return kubeAPIServer.EncryptionConfig.Provider
+	}
+	return nil
+}
+
 // ExtensionsID returns an identifier for the given extension kind/type.
 func ExtensionsID(extensionKind, extensionType string) string {
 	return fmt.Sprintf("%s/%s", extensionKind, extensionType)
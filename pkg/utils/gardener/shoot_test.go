@@ -266,6 +266,78 @@ var _ = Describe("Shoot", func() {
 				timewindow.NewMaintenanceTime(1, 45, 0))),
 	)
 
+	DescribeTable("#EffectiveShootMaintenanceSchedule",
+		func(shoot *gardencorev1beta1.Shoot, schedule *timewindow.MaintenanceSchedule) {
+			Expect(EffectiveShootMaintenanceSchedule(shoot)).To(Equal(schedule))
+		},
+
+		Entry("no maintenance section",
+			&gardencorev1beta1.Shoot{},
+			timewindow.AlwaysMaintenanceSchedule),
+		Entry("no time window",
+			&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{},
+				},
+			},
+			timewindow.AlwaysMaintenanceSchedule),
+		Entry("invalid time window",
+			&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{},
+					},
+				},
+			},
+			timewindow.AlwaysMaintenanceSchedule),
+		Entry("valid time window",
+			&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: "010000+0000",
+							End:   "020000+0000",
+						},
+					},
+				},
+			},
+			timewindow.NewMaintenanceSchedule([]*timewindow.MaintenanceRange{
+				timewindow.NewMaintenanceRange(timewindow.NewMaintenanceTimeWindow(
+					timewindow.NewMaintenanceTime(1, 0, 0),
+					timewindow.NewMaintenanceTime(1, 45, 0)), nil, time.UTC),
+			}, nil)),
+		Entry("valid time window with weekdays, additional ranges and blackouts",
+			&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: "010000+0000",
+							End:   "020000+0000",
+						},
+						TimeWindowWeekdays: []string{"Mon"},
+						AdditionalTimeRanges: []gardencorev1beta1.MaintenanceTimeRange{
+							{Begin: "220000+0000", End: "230000+0000", Weekdays: []string{"Sat"}},
+							{Begin: "invalid", End: "invalid"},
+						},
+						Blackouts: []gardencorev1beta1.MaintenanceBlackout{{
+							Begin: metav1.Date(2023, 12, 20, 0, 0, 0, 0, time.UTC),
+							End:   metav1.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+						}},
+					},
+				},
+			},
+			timewindow.NewMaintenanceSchedule([]*timewindow.MaintenanceRange{
+				timewindow.NewMaintenanceRange(timewindow.NewMaintenanceTimeWindow(
+					timewindow.NewMaintenanceTime(1, 0, 0),
+					timewindow.NewMaintenanceTime(1, 45, 0)), []time.Weekday{time.Monday}, time.UTC),
+				timewindow.NewMaintenanceRange(timewindow.NewMaintenanceTimeWindow(
+					timewindow.NewMaintenanceTime(22, 0, 0),
+					timewindow.NewMaintenanceTime(22, 45, 0)), []time.Weekday{time.Saturday}, time.UTC),
+			}, []timewindow.Blackout{
+				timewindow.NewBlackout(time.Date(2023, 12, 20, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+			})),
+	)
+
 	DescribeTable("#GetShootNameFromOwnerReferences",
 		func(ownerRefs []metav1.OwnerReference, expectedName string) {
 			obj := &gardencorev1beta1.BackupEntry{
@@ -1182,6 +1254,22 @@ var _ = Describe("Shoot", func() {
 			)))
 		})
 
+		It("should compute the correct list of required extensions (shoot migrates between KMS encryption providers)", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				EncryptionConfig: &gardencorev1beta1.EncryptionConfig{
+					Provider: &gardencorev1beta1.EncryptionProvider{Type: "kms-new"},
+				},
+			}
+			shoot.Status.EncryptionProvider = &gardencorev1beta1.EncryptionProvider{Type: "kms-old"}
+
+			result := ComputeRequiredExtensionsForShoot(shoot, seed, controllerRegistrationList, internalDomain, externalDomain)
+
+			Expect(result).To(And(
+				HaveKey(ExtensionsID(extensionsv1alpha1.ExtensionResource, "kms-new")),
+				HaveKey(ExtensionsID(extensionsv1alpha1.ExtensionResource, "kms-old")),
+			))
+		})
+
 		It("should compute the correct list of required extensions (workerless Shoot)", func() {
 			shoot.Spec.Provider.Workers = nil
 
@@ -1253,6 +1341,57 @@ var _ = Describe("Shoot", func() {
 		})
 	})
 
+	Describe("#GetEncryptionProviderExtensions", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{}
+		})
+
+		It("should return nothing if no encryption provider is configured", func() {
+			Expect(GetEncryptionProviderExtensions(shoot)).To(BeEmpty())
+		})
+
+		It("should return nothing for the aescbc encryption provider", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				EncryptionConfig: &gardencorev1beta1.EncryptionConfig{
+					Provider: &gardencorev1beta1.EncryptionProvider{Type: "aescbc"},
+				},
+			}
+			shoot.Status.EncryptionProvider = &gardencorev1beta1.EncryptionProvider{Type: "aescbc"}
+
+			Expect(GetEncryptionProviderExtensions(shoot)).To(BeEmpty())
+		})
+
+		It("should return the extension of the configured KMS provider", func() {
+			providerConfig := &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				EncryptionConfig: &gardencorev1beta1.EncryptionConfig{
+					Provider: &gardencorev1beta1.EncryptionProvider{Type: "kms-foo", ProviderConfig: providerConfig},
+				},
+			}
+			shoot.Status.EncryptionProvider = &gardencorev1beta1.EncryptionProvider{Type: "kms-foo"}
+
+			Expect(GetEncryptionProviderExtensions(shoot)).To(ConsistOf(
+				gardencorev1beta1.Extension{Type: "kms-foo", ProviderConfig: providerConfig},
+			))
+		})
+
+		It("should return the extensions of the configured and the active KMS provider during a migration", func() {
+			providerConfig := &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				EncryptionConfig: &gardencorev1beta1.EncryptionConfig{
+					Provider: &gardencorev1beta1.EncryptionProvider{Type: "aescbc"},
+				},
+			}
+			shoot.Status.EncryptionProvider = &gardencorev1beta1.EncryptionProvider{Type: "kms-foo", ProviderConfig: providerConfig}
+
+			Expect(GetEncryptionProviderExtensions(shoot)).To(ConsistOf(
+				gardencorev1beta1.Extension{Type: "kms-foo", ProviderConfig: providerConfig},
+			))
+		})
+	})
+
 	Describe("#ExtensionsID", func() {
 		It("should return the expected identifier", func() {
 			Expect(ExtensionsID("foo", "bar")).To(Equal("foo/bar"))
@@ -0,0 +1,274 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// ArchiveVersion is the version of the ShootState archive format written by EncodeArchive.
+const ArchiveVersion = "v1"
+
+// Archive contains everything which is needed to restore the control plane of a shoot in another garden.
+type Archive struct {
+	// ExportTime is the time when the archive was exported.
+	ExportTime metav1.Time `json:"exportTime"`
+	// Shoot is the exported shoot.
+	Shoot gardencorev1beta1.Shoot `json:"shoot"`
+	// ShootState is the exported ShootState of the shoot.
+	ShootState gardencorev1beta1.ShootState `json:"shootState"`
+	// SecretBinding is the SecretBinding referenced by the shoot. It only contains the reference to the secret, but not
+	// the secret itself.
+	SecretBinding *gardencorev1beta1.SecretBinding `json:"secretBinding,omitempty"`
+	// BackupEntry is the BackupEntry of the shoot.
+	BackupEntry *gardencorev1beta1.BackupEntry `json:"backupEntry,omitempty"`
+}
+
+// sealedArchive is the serialized form of an archive.
+type sealedArchive struct {
+	// Version is the version of the archive format.
+	Version string `json:"version"`
+	// EphemeralPublicKey is the ephemeral X25519 public key which was used together with the recipient's key for
+	// deriving the encryption key of the payload.
+	EphemeralPublicKey []byte `json:"ephemeralPublicKey"`
+	// Nonce is the AES-GCM nonce used for encrypting the payload.
+	Nonce []byte `json:"nonce"`
+	// Payload is the encrypted JSON encoded archive.
+	Payload []byte `json:"payload"`
+	// Signature is the ed25519 signature of the ephemeral public key, the nonce and the encrypted payload.
+	Signature []byte `json:"signature"`
+}
+
+// archiveKeyDerivationInfo is the HKDF info used for deriving the encryption key of an archive.
+const archiveKeyDerivationInfo = "gardener-shootstate-archive"
+
+// EncodeArchive serializes the given archive, encrypts it for the given X25519 public key of the recipient and signs
+// it with the given private key.
+func EncodeArchive(archive *Archive, signingKey ed25519.PrivateKey, encryptionKey *ecdh.PublicKey) ([]byte, error) {
+	payload, err := json.Marshal(archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling archive: %w", err)
+	}
+
+	ephemeralKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating ephemeral key: %w", err)
+	}
+
+	sharedSecret, err := ephemeralKey.ECDH(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing shared secret: %w", err)
+	}
+
+	aead, err := newArchiveAEAD(sharedSecret, ephemeralKey.PublicKey(), encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	sealed := &sealedArchive{
+		Version:            ArchiveVersion,
+		EphemeralPublicKey: ephemeralKey.PublicKey().Bytes(),
+		Nonce:              nonce,
+		Payload:            aead.Seal(nil, nonce, payload, []byte(ArchiveVersion)),
+	}
+	sealed.Signature = ed25519.Sign(signingKey, sealed.signedData())
+
+	return json.MarshalIndent(sealed, "", "  ")
+}
+
+// DecodeArchive verifies the signature of the given serialized archive with the given public key, decrypts it with the
+// given X25519 private key and deserializes it.
+func DecodeArchive(data []byte, verificationKey ed25519.PublicKey, decryptionKey *ecdh.PrivateKey) (*Archive, error) {
+	sealed := &sealedArchive{}
+	if err := json.Unmarshal(data, sealed); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling archive: %w", err)
+	}
+
+	if sealed.Version != ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %q, only %q is supported", sealed.Version, ArchiveVersion)
+	}
+
+	if !ed25519.Verify(verificationKey, sealed.signedData(), sealed.Signature) {
+		return nil, errors.New("signature of archive is invalid")
+	}
+
+	ephemeralPublicKey, err := ecdh.X25519().NewPublicKey(sealed.EphemeralPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing ephemeral public key: %w", err)
+	}
+
+	sharedSecret, err := decryptionKey.ECDH(ephemeralPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing shared secret: %w", err)
+	}
+
+	aead, err := newArchiveAEAD(sharedSecret, ephemeralPublicKey, decryptionKey.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("nonce of archive has invalid length %d", len(sealed.Nonce))
+	}
+
+	payload, err := aead.Open(nil, sealed.Nonce, sealed.Payload, []byte(sealed.Version))
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting archive, it might have been encrypted for another key: %w", err)
+	}
+
+	archive := &Archive{}
+	if err := json.Unmarshal(payload, archive); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling archive payload: %w", err)
+	}
+	return archive, nil
+}
+
+func (s *sealedArchive) signedData() []byte {
+	data := make([]byte, 0, len(s.EphemeralPublicKey)+len(s.Nonce)+len(s.Payload))
+	data = append(data, s.EphemeralPublicKey...)
+	data = append(data, s.Nonce...)
+	return append(data, s.Payload...)
+}
+
+// newArchiveAEAD derives the AES-256 key for the payload of an archive from the given shared secret and returns the
+// corresponding AES-GCM cipher.
+func newArchiveAEAD(sharedSecret []byte, ephemeralPublicKey, recipientPublicKey *ecdh.PublicKey) (cipher.AEAD, error) {
+	salt := append(ephemeralPublicKey.Bytes(), recipientPublicKey.Bytes()...)
+
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, sharedSecret, salt, []byte(archiveKeyDerivationInfo)), key); err != nil {
+		return nil, fmt.Errorf("failed deriving encryption key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// ParseSigningKey parses a PEM encoded ed25519 private key in PKCS #8 form.
+func ParseSigningKey(data []byte) (ed25519.PrivateKey, error) {
+	key, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is of type %T, only ed25519 keys are supported", key)
+	}
+	return privateKey, nil
+}
+
+// ParseVerificationKey parses a PEM encoded ed25519 public key in PKIX form.
+func ParseVerificationKey(data []byte) (ed25519.PublicKey, error) {
+	key, err := parsePublicKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key is of type %T, only ed25519 keys are supported", key)
+	}
+	return publicKey, nil
+}
+
+// ParseEncryptionKey parses a PEM encoded X25519 public key in PKIX form.
+func ParseEncryptionKey(data []byte) (*ecdh.PublicKey, error) {
+	key, err := parsePublicKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, ok := key.(*ecdh.PublicKey)
+	if !ok || publicKey.Curve() != ecdh.X25519() {
+		return nil, fmt.Errorf("public key is of type %T, only X25519 keys are supported", key)
+	}
+	return publicKey, nil
+}
+
+// ParseDecryptionKey parses a PEM encoded X25519 private key in PKCS #8 form.
+func ParseDecryptionKey(data []byte) (*ecdh.PrivateKey, error) {
+	key, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, ok := key.(*ecdh.PrivateKey)
+	if !ok || privateKey.Curve() != ecdh.X25519() {
+		return nil, fmt.Errorf("private key is of type %T, only X25519 keys are supported", key)
+	}
+	return privateKey, nil
+}
+
+func parsePrivateKey(data []byte) (interface{}, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing private key: %w", err)
+	}
+	return key, nil
+}
+
+func parsePublicKey(data []byte) (interface{}, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public key: %w", err)
+	}
+	return key, nil
+}
+
+// cleanObjectMeta removes all metadata which is specific to the garden the object was read from.
+func cleanObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
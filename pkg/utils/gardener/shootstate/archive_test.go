@@ -0,0 +1,347 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate_test
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+var _ = Describe("Archive", func() {
+	var (
+		ctx       = context.TODO()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+
+		publicKey     ed25519.PublicKey
+		privateKey    ed25519.PrivateKey
+		decryptionKey *ecdh.PrivateKey
+
+		sourceClient client.Client
+		targetClient client.Client
+
+		shoot         *gardencorev1beta1.Shoot
+		shootState    *gardencorev1beta1.ShootState
+		secretBinding *gardencorev1beta1.SecretBinding
+		backupEntry   *gardencorev1beta1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		var err error
+		publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		decryptionKey, err = ecdh.X25519().GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "my-shoot",
+				Namespace:  "garden-my-project",
+				Labels:     map[string]string{"foo": "bar"},
+				Finalizers: []string{"gardener"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SecretBindingName: pointer.String("my-secret"),
+				SeedName:          pointer.String("source-seed"),
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				UID:             "shoot-uid",
+				TechnicalID:     "shoot--my-project--my-shoot",
+				ClusterIdentity: pointer.String("identity"),
+				SeedName:        pointer.String("source-seed"),
+			},
+		}
+		shootState = &gardencorev1beta1.ShootState{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"},
+			Spec: gardencorev1beta1.ShootStateSpec{
+				Gardener: []gardencorev1beta1.GardenerResourceData{{
+					Name: "ca",
+					Type: "secret",
+					Data: runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				}},
+			},
+		}
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "garden-my-project"},
+			SecretRef:  corev1.SecretReference{Name: "my-secret", Namespace: "garden-my-project"},
+		}
+		backupEntry = &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--my-project--my-shoot--shoot-uid", Namespace: "garden-my-project"},
+			Spec: gardencorev1beta1.BackupEntrySpec{
+				BucketName: "bucket",
+				SeedName:   pointer.String("source-seed"),
+			},
+		}
+
+		for _, obj := range []client.Object{shoot, shootState, secretBinding, backupEntry} {
+			Expect(sourceClient.Create(ctx, obj)).To(Succeed())
+		}
+	})
+
+	Describe("#Export", func() {
+		It("should fail if the ShootState does not exist", func() {
+			Expect(sourceClient.Delete(ctx, shootState)).To(Succeed())
+
+			_, err := Export(ctx, sourceClient, fakeClock, shoot.Namespace, shoot.Name)
+			Expect(err).To(MatchError(ContainSubstring("failed reading ShootState")))
+		})
+
+		It("should export all objects without garden specific metadata", func() {
+			archive, err := Export(ctx, sourceClient, fakeClock, shoot.Namespace, shoot.Name)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(archive.ExportTime.Time).To(Equal(fakeClock.Now()))
+			Expect(archive.Shoot.Kind).To(Equal("Shoot"))
+			Expect(archive.Shoot.ObjectMeta).To(Equal(metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project", Labels: map[string]string{"foo": "bar"}}))
+			Expect(archive.Shoot.Status.UID).To(Equal(shoot.Status.UID))
+			Expect(archive.ShootState.Spec).To(Equal(shootState.Spec))
+			Expect(archive.SecretBinding.SecretRef).To(Equal(secretBinding.SecretRef))
+			Expect(archive.BackupEntry.Spec.BucketName).To(Equal("bucket"))
+			Expect(archive.BackupEntry.ResourceVersion).To(BeEmpty())
+		})
+
+		It("should export the shoot without BackupEntry if it does not exist", func() {
+			Expect(sourceClient.Delete(ctx, backupEntry)).To(Succeed())
+
+			archive, err := Export(ctx, sourceClient, fakeClock, shoot.Namespace, shoot.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archive.BackupEntry).To(BeNil())
+		})
+	})
+
+	Describe("#EncodeArchive, #DecodeArchive", func() {
+		var archive *Archive
+
+		BeforeEach(func() {
+			var err error
+			archive, err = Export(ctx, sourceClient, fakeClock, shoot.Namespace, shoot.Name)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should decode an encoded archive", func() {
+			data, err := EncodeArchive(archive, privateKey, decryptionKey.PublicKey())
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := DecodeArchive(data, publicKey, decryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.ShootState.Spec).To(Equal(archive.ShootState.Spec))
+			Expect(decoded.ExportTime.Equal(&archive.ExportTime)).To(BeTrue())
+		})
+
+		It("should reject an archive signed with another key", func() {
+			otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			data, err := EncodeArchive(archive, privateKey, decryptionKey.PublicKey())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecodeArchive(data, otherPublicKey, decryptionKey)
+			Expect(err).To(MatchError("signature of archive is invalid"))
+		})
+
+		It("should not contain the archive in plain text", func() {
+			data, err := EncodeArchive(archive, privateKey, decryptionKey.PublicKey())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(string(data)).NotTo(ContainSubstring("my-shoot"))
+			Expect(string(data)).NotTo(ContainSubstring(base64.StdEncoding.EncodeToString([]byte(`"name":"my-shoot"`))))
+		})
+
+		It("should reject an archive encrypted for another key", func() {
+			otherDecryptionKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			data, err := EncodeArchive(archive, privateKey, otherDecryptionKey.PublicKey())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecodeArchive(data, publicKey, decryptionKey)
+			Expect(err).To(MatchError(ContainSubstring("failed decrypting archive")))
+		})
+
+		It("should reject a tampered archive", func() {
+			data, err := EncodeArchive(archive, privateKey, decryptionKey.PublicKey())
+			Expect(err).NotTo(HaveOccurred())
+
+			sealed := map[string]interface{}{}
+			Expect(json.Unmarshal(data, &sealed)).To(Succeed())
+			archive.Shoot.Name = "other"
+			otherData, err := EncodeArchive(archive, privateKey, decryptionKey.PublicKey())
+			Expect(err).NotTo(HaveOccurred())
+			other := map[string]interface{}{}
+			Expect(json.Unmarshal(otherData, &other)).To(Succeed())
+			sealed["payload"] = other["payload"]
+			data, err = json.Marshal(sealed)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecodeArchive(data, publicKey, decryptionKey)
+			Expect(err).To(MatchError("signature of archive is invalid"))
+		})
+
+		It("should reject an archive with unsupported version", func() {
+			_, err := DecodeArchive([]byte(`{"version":"v0"}`), publicKey, decryptionKey)
+			Expect(err).To(MatchError(ContainSubstring(`unsupported archive version "v0"`)))
+		})
+	})
+
+	Describe("#ParseSigningKey, #ParseVerificationKey, #ParseEncryptionKey, #ParseDecryptionKey", func() {
+		encodePrivateKey := func(key interface{}) []byte {
+			GinkgoHelper()
+			data, err := x509.MarshalPKCS8PrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: data})
+		}
+
+		encodePublicKey := func(key interface{}) []byte {
+			GinkgoHelper()
+			data, err := x509.MarshalPKIXPublicKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data})
+		}
+
+		It("should parse PEM encoded keys", func() {
+			Expect(ParseSigningKey(encodePrivateKey(privateKey))).To(Equal(privateKey))
+			Expect(ParseVerificationKey(encodePublicKey(publicKey))).To(Equal(publicKey))
+			Expect(ParseDecryptionKey(encodePrivateKey(decryptionKey))).To(Equal(decryptionKey))
+			Expect(ParseEncryptionKey(encodePublicKey(decryptionKey.PublicKey()))).To(Equal(decryptionKey.PublicKey()))
+		})
+
+		It("should fail for keys of the wrong type", func() {
+			_, err := ParseSigningKey(encodePrivateKey(decryptionKey))
+			Expect(err).To(MatchError(ContainSubstring("only ed25519 keys are supported")))
+
+			_, err = ParseEncryptionKey(encodePublicKey(publicKey))
+			Expect(err).To(MatchError(ContainSubstring("only X25519 keys are supported")))
+		})
+
+		It("should fail for non-PEM data", func() {
+			_, err := ParseVerificationKey([]byte("foo"))
+			Expect(err).To(MatchError("no PEM block found"))
+		})
+	})
+
+	Describe("#Import", func() {
+		var archive *Archive
+
+		BeforeEach(func() {
+			var err error
+			archive, err = Export(ctx, sourceClient, fakeClock, shoot.Namespace, shoot.Name)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should recreate the objects as migrated shoot", func() {
+			imported, err := Import(ctx, targetClient, archive, ImportOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported.Spec.SeedName).To(BeNil())
+
+			importedShoot := &gardencorev1beta1.Shoot{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(shoot), importedShoot)).To(Succeed())
+			Expect(importedShoot.Spec.SeedName).To(BeNil())
+			Expect(importedShoot.Spec.SchedulerName).To(BeNil())
+			Expect(importedShoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/import-in-progress"))
+			Expect(importedShoot.Status.UID).To(Equal(shoot.Status.UID))
+			Expect(importedShoot.Status.TechnicalID).To(Equal(shoot.Status.TechnicalID))
+			Expect(importedShoot.Status.ClusterIdentity).To(Equal(shoot.Status.ClusterIdentity))
+			Expect(importedShoot.Status.SeedName).To(BeNil())
+			Expect(importedShoot.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeMigrate))
+			Expect(importedShoot.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+
+			importedShootState := &gardencorev1beta1.ShootState{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(shootState), importedShootState)).To(Succeed())
+			Expect(importedShootState.Spec).To(Equal(shootState.Spec))
+
+			importedSecretBinding := &gardencorev1beta1.SecretBinding{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secretBinding), importedSecretBinding)).To(Succeed())
+			Expect(importedSecretBinding.SecretRef).To(Equal(secretBinding.SecretRef))
+
+			importedBackupEntry := &gardencorev1beta1.BackupEntry{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), importedBackupEntry)).To(Succeed())
+			Expect(importedBackupEntry.Spec.BucketName).To(Equal("bucket"))
+			Expect(importedBackupEntry.Spec.SeedName).To(BeNil())
+			Expect(importedBackupEntry.OwnerReferences).To(ConsistOf(HaveField("Name", "my-shoot")))
+		})
+
+		It("should import the objects into another namespace", func() {
+			_, err := Import(ctx, targetClient, archive, ImportOptions{Namespace: "garden-other"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "garden-other", Name: "my-shoot"}, &gardencorev1beta1.Shoot{})).To(Succeed())
+
+			importedSecretBinding := &gardencorev1beta1.SecretBinding{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "garden-other", Name: "my-secret"}, importedSecretBinding)).To(Succeed())
+			Expect(importedSecretBinding.SecretRef.Namespace).To(Equal("garden-other"))
+		})
+
+		It("should bind the shoot to the given seed", func() {
+			var (
+				boundSeedName    *string
+				boundAnnotations map[string]string
+			)
+			targetClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						if subResourceName == "binding" {
+							boundSeedName = obj.(*gardencorev1beta1.Shoot).Spec.SeedName
+							boundAnnotations = obj.GetAnnotations()
+						}
+						return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+					},
+				}).
+				Build()
+
+			_, err := Import(ctx, targetClient, archive, ImportOptions{SeedName: "target-seed"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(boundSeedName).To(PointTo(Equal("target-seed")))
+			Expect(boundAnnotations).To(HaveKeyWithValue("shoot.gardener.cloud/import-in-progress", "true"))
+
+			importedShoot := &gardencorev1beta1.Shoot{}
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(shoot), importedShoot)).To(Succeed())
+			Expect(importedShoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/import-in-progress"))
+		})
+
+		It("should fail if the shoot already exists", func() {
+			Expect(targetClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"}})).To(Succeed())
+
+			_, err := Import(ctx, targetClient, archive, ImportOptions{})
+			Expect(err).To(MatchError(ContainSubstring("failed creating shoot")))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Export reads the shoot with the given namespace and name together with its ShootState, SecretBinding and BackupEntry
+// and returns them as archive.
+func Export(ctx context.Context, c client.Reader, clock clock.Clock, namespace, name string) (*Archive, error) {
+	archive := &Archive{ExportTime: metav1.NewTime(clock.Now().UTC())}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, shoot); err != nil {
+		return nil, fmt.Errorf("failed reading shoot %s/%s: %w", namespace, name, err)
+	}
+	archive.Shoot = gardencorev1beta1.Shoot{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+		ObjectMeta: cleanObjectMeta(shoot.ObjectMeta),
+		Spec:       shoot.Spec,
+		Status:     shoot.Status,
+	}
+
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, shootState); err != nil {
+		return nil, fmt.Errorf("failed reading ShootState of shoot %s/%s: %w", namespace, name, err)
+	}
+	archive.ShootState = gardencorev1beta1.ShootState{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "ShootState"},
+		ObjectMeta: cleanObjectMeta(shootState.ObjectMeta),
+		Spec:       shootState.Spec,
+	}
+
+	if shoot.Spec.SecretBindingName != nil {
+		secretBinding := &gardencorev1beta1.SecretBinding{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+			return nil, fmt.Errorf("failed reading SecretBinding of shoot %s/%s: %w", namespace, name, err)
+		}
+		archive.SecretBinding = &gardencorev1beta1.SecretBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "SecretBinding"},
+			ObjectMeta: cleanObjectMeta(secretBinding.ObjectMeta),
+			SecretRef:  secretBinding.SecretRef,
+			Quotas:     secretBinding.Quotas,
+			Provider:   secretBinding.Provider,
+		}
+	}
+
+	if shoot.Status.TechnicalID != "" && shoot.Status.UID != "" {
+		backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		backupEntry := &gardencorev1beta1.BackupEntry{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: backupEntryName}, backupEntry); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed reading BackupEntry of shoot %s/%s: %w", namespace, name, err)
+			}
+		} else {
+			archive.BackupEntry = &gardencorev1beta1.BackupEntry{
+				TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "BackupEntry"},
+				ObjectMeta: cleanObjectMeta(backupEntry.ObjectMeta),
+				Spec:       backupEntry.Spec,
+			}
+		}
+	}
+
+	return archive, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// flowState is the data persisted in the ShootState for a failed flow execution.
+type flowState struct {
+	// Generation is the generation of the shoot the flow was executed for.
+	Generation int64 `json:"generation"`
+	// OperationType is the type of the operation the flow was executed for.
+	OperationType gardencorev1beta1.LastOperationType `json:"operationType"`
+	// State is the state of the flow execution.
+	State flow.State `json:"state"`
+}
+
+// NewFlowStateStore returns a flow.StateStore which persists the states of failed flow executions in the ShootState of
+// the given shoot in the garden cluster. The ShootState is not created by the store, i.e., states are only persisted if
+// the ShootState already exists (e.g., because the ShootState controller is enabled or the shoot is being restored).
+// Persisted states are only considered for the same generation of the shoot and the same operation type, i.e., they
+// are ignored as soon as the shoot specification changes or another operation is performed.
+func NewFlowStateStore(gardenClient client.Client, shoot *gardencorev1beta1.Shoot, operationType gardencorev1beta1.LastOperationType) flow.StateStore {
+	return &flowStateStore{
+		client:        gardenClient,
+		shoot:         shoot,
+		operationType: operationType,
+	}
+}
+
+type flowStateStore struct {
+	client        client.Client
+	shoot         *gardencorev1beta1.Shoot
+	operationType gardencorev1beta1.LastOperationType
+}
+
+func (s *flowStateStore) Load(ctx context.Context, flowName string) (*flow.State, error) {
+	shootState, err := s.getShootState(ctx)
+	if err != nil || shootState == nil {
+		return nil, err
+	}
+
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	data := gardenerData.Get(flowStateDataName(flowName))
+	if data == nil || data.Type != v1beta1constants.DataTypeFlowState {
+		return nil, nil
+	}
+
+	state := &flowState{}
+	if err := json.Unmarshal(data.Data.Raw, state); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling state of flow %q: %w", flowName, err)
+	}
+
+	if state.Generation != s.shoot.Generation || state.OperationType != s.operationType {
+		return nil, nil
+	}
+	return &state.State, nil
+}
+
+func (s *flowStateStore) Save(ctx context.Context, state *flow.State) error {
+	shootState, err := s.getShootState(ctx)
+	if err != nil || shootState == nil {
+		return err
+	}
+
+	raw, err := json.Marshal(&flowState{
+		Generation:    s.shoot.Generation,
+		OperationType: s.operationType,
+		State:         *state,
+	})
+	if err != nil {
+		return fmt.Errorf("failed marshalling state of flow %q: %w", state.FlowName, err)
+	}
+
+	patch := client.StrategicMergeFrom(shootState.DeepCopy())
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	gardenerData.Upsert(&gardencorev1beta1.GardenerResourceData{
+		Name: flowStateDataName(state.FlowName),
+		Type: v1beta1constants.DataTypeFlowState,
+		Data: runtime.RawExtension{Raw: raw},
+	})
+	shootState.Spec.Gardener = gardenerData
+	return client.IgnoreNotFound(s.client.Patch(ctx, shootState, patch))
+}
+
+func (s *flowStateStore) Delete(ctx context.Context, flowName string) error {
+	shootState, err := s.getShootState(ctx)
+	if err != nil || shootState == nil {
+		return err
+	}
+
+	name := flowStateDataName(flowName)
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	if gardenerData.Get(name) == nil {
+		return nil
+	}
+
+	patch := client.StrategicMergeFrom(shootState.DeepCopy())
+	gardenerData.Delete(name)
+	shootState.Spec.Gardener = gardenerData
+	return client.IgnoreNotFound(s.client.Patch(ctx, shootState, patch))
+}
+
+func (s *flowStateStore) getShootState(ctx context.Context) (*gardencorev1beta1.ShootState, error) {
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := s.client.Get(ctx, client.ObjectKey{Name: s.shoot.Name, Namespace: s.shoot.Namespace}, shootState); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading ShootState: %w", err)
+	}
+	return shootState, nil
+}
+
+// isCurrentFlowState returns true if the given data is the state of a failed flow execution for the current generation
+// of the given shoot.
+func isCurrentFlowState(data gardencorev1beta1.GardenerResourceData, shoot *gardencorev1beta1.Shoot) bool {
+	if data.Type != v1beta1constants.DataTypeFlowState {
+		return false
+	}
+
+	state := &flowState{}
+	if err := json.Unmarshal(data.Data.Raw, state); err != nil {
+		return false
+	}
+	return state.Generation == shoot.Generation
+}
+
+func flowStateDataName(flowName string) string {
+	return "flow-state-" + strings.Join(strings.Fields(strings.ToLower(flowName)), "-")
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("FlowStateStore", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeGardenClient client.Client
+		shoot            *gardencorev1beta1.Shoot
+		shootState       *gardencorev1beta1.ShootState
+		store            flow.StateStore
+		state            *flow.State
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "my-shoot",
+				Namespace:  "garden-my-project",
+				Generation: 3,
+			},
+		}
+		shootState = &gardencorev1beta1.ShootState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-shoot",
+				Namespace: "garden-my-project",
+			},
+		}
+
+		store = NewFlowStateStore(fakeGardenClient, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		state = &flow.State{
+			FlowName:  "Shoot cluster reconciliation",
+			Revision:  "abc",
+			Succeeded: []string{"foo", "bar"},
+		}
+	})
+
+	It("should return nil if there is no ShootState", func() {
+		Expect(store.Load(ctx, state.FlowName)).To(BeNil())
+	})
+
+	It("should not create a ShootState when saving the state", func() {
+		Expect(store.Save(ctx, state)).To(Succeed())
+		Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(BeNotFoundError())
+	})
+
+	It("should save the state in the existing ShootState and load it", func() {
+		Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+		Expect(store.Save(ctx, state)).To(Succeed())
+
+		Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+		Expect(shootState.Spec.Gardener).To(ConsistOf(And(
+			HaveField("Name", "flow-state-shoot-cluster-reconciliation"),
+			HaveField("Type", v1beta1constants.DataTypeFlowState),
+		)))
+
+		Expect(store.Load(ctx, state.FlowName)).To(Equal(state))
+	})
+
+	It("should keep other data of an existing ShootState", func() {
+		shootState.Spec.Gardener = []gardencorev1beta1.GardenerResourceData{{Name: "ca", Type: v1beta1constants.DataTypeSecret}}
+		Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+
+		Expect(store.Save(ctx, state)).To(Succeed())
+		Expect(store.Delete(ctx, state.FlowName)).To(Succeed())
+
+		Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+		Expect(shootState.Spec.Gardener).To(ConsistOf(HaveField("Name", "ca")))
+		Expect(store.Load(ctx, state.FlowName)).To(BeNil())
+	})
+
+	It("should ignore the saved state for another generation of the shoot", func() {
+		Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+		Expect(store.Save(ctx, state)).To(Succeed())
+
+		shoot.Generation++
+		Expect(store.Load(ctx, state.FlowName)).To(BeNil())
+	})
+
+	It("should ignore the saved state for another operation type", func() {
+		Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+		Expect(store.Save(ctx, state)).To(Succeed())
+
+		store = NewFlowStateStore(fakeGardenClient, shoot, gardencorev1beta1.LastOperationTypeRestore)
+		Expect(store.Load(ctx, state.FlowName)).To(BeNil())
+	})
+
+	It("should only skip succeeded resumable tasks when resuming a flow after a restart", func() {
+		Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+
+		var (
+			executed []string
+			failWait = true
+
+			newFlow = func() *flow.Flow {
+				g := flow.NewGraph(state.FlowName)
+				deploy := g.Add(flow.Task{Name: "Deploying component", Fn: func(context.Context) error {
+					executed = append(executed, "deploy")
+					return nil
+				}})
+				rewrite := g.Add(flow.Task{Name: "Rewriting resources", Resumable: true, Fn: func(context.Context) error {
+					executed = append(executed, "rewrite")
+					return nil
+				}, Dependencies: flow.NewTaskIDs(deploy)})
+				_ = g.Add(flow.Task{Name: "Waiting for component", Fn: func(context.Context) error {
+					executed = append(executed, "wait")
+					if failWait {
+						return errors.New("fake")
+					}
+					return nil
+				}, Dependencies: flow.NewTaskIDs(rewrite)})
+				return g.Compile()
+			}
+		)
+
+		Expect(newFlow().Run(ctx, flow.Opts{StateStore: store})).NotTo(Succeed())
+		Expect(executed).To(Equal([]string{"deploy", "rewrite", "wait"}))
+
+		// simulate a restart of gardenlet, i.e., the flow is rebuilt and only the persisted state is available
+		executed, failWait = nil, false
+		store = NewFlowStateStore(fakeGardenClient, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(newFlow().Run(ctx, flow.Opts{StateStore: store})).To(Succeed())
+		Expect(executed).To(Equal([]string{"deploy", "wait"}))
+	})
+
+	It("should not create a ShootState when deleting the state", func() {
+		Expect(store.Delete(ctx, state.FlowName)).To(Succeed())
+		Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(BeNotFoundError())
+	})
+})
@@ -0,0 +1,134 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ImportOptions are options for importing an archive.
+type ImportOptions struct {
+	// Namespace is the namespace into which the objects shall be imported. If empty, the namespace of the exported
+	// shoot is used.
+	Namespace string
+	// SeedName is the name of the seed to which the imported shoot shall be bound. If empty, the shoot is not bound and
+	// must be bound to a seed manually.
+	SeedName string
+}
+
+// Import recreates the objects of the given archive in the garden. The shoot is marked as migrated so that the
+// responsible gardenlet restores its control plane from the imported ShootState once the shoot is bound to a seed.
+// Until all objects are imported, the shoot is annotated with v1beta1constants.AnnotationShootImportInProgress which
+// prevents the gardener-scheduler from assigning a seed before the status was restored.
+func Import(ctx context.Context, c client.Client, archive *Archive, opts ImportOptions) (*gardencorev1beta1.Shoot, error) {
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = archive.Shoot.Namespace
+	}
+
+	if archive.SecretBinding != nil {
+		secretBinding := archive.SecretBinding.DeepCopy()
+		if secretBinding.SecretRef.Namespace == archive.Shoot.Namespace {
+			secretBinding.SecretRef.Namespace = namespace
+		}
+		secretBinding.ObjectMeta = importedObjectMeta(secretBinding.ObjectMeta, namespace)
+
+		if err := c.Create(ctx, secretBinding); client.IgnoreAlreadyExists(err) != nil {
+			return nil, fmt.Errorf("failed creating SecretBinding %s: %w", client.ObjectKeyFromObject(secretBinding), err)
+		}
+	}
+
+	shootState := archive.ShootState.DeepCopy()
+	shootState.ObjectMeta = importedObjectMeta(shootState.ObjectMeta, namespace)
+	if err := c.Create(ctx, shootState); err != nil {
+		return nil, fmt.Errorf("failed creating ShootState %s: %w", client.ObjectKeyFromObject(shootState), err)
+	}
+
+	shoot := &gardencorev1beta1.Shoot{
+		ObjectMeta: importedObjectMeta(archive.Shoot.ObjectMeta, namespace),
+		Spec:       *archive.Shoot.Spec.DeepCopy(),
+	}
+	shoot.Spec.SeedName = nil
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootImportInProgress, "true")
+	if err := c.Create(ctx, shoot); err != nil {
+		return nil, fmt.Errorf("failed creating shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.UID = archive.Shoot.Status.UID
+	shoot.Status.TechnicalID = archive.Shoot.Status.TechnicalID
+	shoot.Status.ClusterIdentity = archive.Shoot.Status.ClusterIdentity
+	shoot.Status.Credentials = archive.Shoot.Status.Credentials.DeepCopy()
+	shoot.Status.EncryptedResources = archive.Shoot.Status.EncryptedResources
+	shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+		Type:           gardencorev1beta1.LastOperationTypeMigrate,
+		State:          gardencorev1beta1.LastOperationStateSucceeded,
+		Progress:       100,
+		Description:    fmt.Sprintf("Shoot was imported from an archive exported at %s.", archive.ExportTime.UTC().Format(metav1.RFC3339Micro)),
+		LastUpdateTime: metav1.Now(),
+	}
+	if err := c.Status().Patch(ctx, shoot, patch); err != nil {
+		return nil, fmt.Errorf("failed patching status of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	if archive.BackupEntry != nil {
+		backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		backupEntry := &gardencorev1beta1.BackupEntry{
+			ObjectMeta: importedObjectMeta(archive.BackupEntry.ObjectMeta, namespace),
+			Spec:       *archive.BackupEntry.Spec.DeepCopy(),
+		}
+		backupEntry.Name = backupEntryName
+		backupEntry.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))}
+		backupEntry.Spec.SeedName = nil
+
+		if err := c.Create(ctx, backupEntry); client.IgnoreAlreadyExists(err) != nil {
+			return nil, fmt.Errorf("failed creating BackupEntry %s: %w", client.ObjectKeyFromObject(backupEntry), err)
+		}
+	}
+
+	if opts.SeedName != "" {
+		shoot.Spec.SeedName = pointer.String(opts.SeedName)
+		if err := c.SubResource("binding").Update(ctx, shoot); err != nil {
+			return nil, fmt.Errorf("failed binding shoot %s to seed %s: %w", client.ObjectKeyFromObject(shoot), opts.SeedName, err)
+		}
+	}
+
+	patch = client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.AnnotationShootImportInProgress)
+	if err := c.Patch(ctx, shoot, patch); err != nil {
+		return nil, fmt.Errorf("failed removing annotation %s from shoot %s: %w", v1beta1constants.AnnotationShootImportInProgress, client.ObjectKeyFromObject(shoot), err)
+	}
+
+	return shoot, nil
+}
+
+func importedObjectMeta(meta metav1.ObjectMeta, namespace string) metav1.ObjectMeta {
+	out := cleanObjectMeta(*meta.DeepCopy())
+	out.Namespace = namespace
+	return out
+}
@@ -59,6 +59,16 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 		metav1.SetMetaDataAnnotation(&shootState.ObjectMeta, v1beta1constants.GardenerTimestamp, clock.Now().UTC().Format(time.RFC3339))
 
 		if overwriteSpec {
+			// The states of failed flow executions are not computed from the seed but persisted by the running operation,
+			// hence they must be kept. States of previous generations of the shoot are never resumed, so they are dropped.
+			gardenerData := v1beta1helper.GardenerResourceDataList(spec.Gardener)
+			for _, data := range shootState.Spec.Gardener {
+				if isCurrentFlowState(data, shoot) {
+					gardenerData.Upsert(data.DeepCopy())
+				}
+			}
+			spec.Gardener = gardenerData
+
 			shootState.Spec = *spec
 			return nil
 		}
@@ -187,16 +197,26 @@ func computeSecretsToPersist(
 		}
 
 		dataList = append(dataList, gardencorev1beta1.GardenerResourceData{
-			Name:   secret.Name,
-			Labels: secret.Labels,
-			Type:   v1beta1constants.DataTypeSecret,
-			Data:   runtime.RawExtension{Raw: dataJSON},
+			Name:        secret.Name,
+			Labels:      secret.Labels,
+			Annotations: externalDataAnnotations(&secret),
+			Type:        v1beta1constants.DataTypeSecret,
+			Data:        runtime.RawExtension{Raw: dataJSON},
 		})
 	}
 
 	return dataList, nil
 }
 
+// externalDataAnnotations returns the annotations of the given secret which reference data persisted in an external
+// data store. Only the references are part of the ShootState, the data itself remains in the external data store.
+func externalDataAnnotations(secret *corev1.Secret) map[string]string {
+	if value, ok := secret.Annotations[secretsmanager.AnnotationKeyExternalDataKeys]; ok {
+		return map[string]string{secretsmanager.AnnotationKeyExternalDataKeys: value}
+	}
+	return nil
+}
+
 func computeExtensionsDataAndResources(
 	ctx context.Context,
 	seedClient client.Client,
@@ -33,9 +33,11 @@ import (
 
 	"github.com/gardener/gardener/pkg/api/extensions"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -84,6 +86,24 @@ var _ = Describe("ShootState", func() {
 			Expect(shootState.Annotations).To(HaveKeyWithValue("gardener.cloud/timestamp", fakeClock.Now().UTC().Format(time.RFC3339)))
 		})
 
+		It("should only persist the references to externally stored data of secrets", func() {
+			dataStore := secretsmanager.NewFileDataStore(GinkgoT().TempDir())
+
+			secret := newSecret("secret1", seedNamespace, true)
+			secret.Data["key"] = []byte("external")
+			Expect(secretsmanager.CreateSecret(ctx, fakeSeedClient, dataStore, secret, []string{"key"})).To(Succeed())
+
+			Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+			Expect(shootState.Spec.Gardener).To(ContainElement(gardencorev1beta1.GardenerResourceData{
+				Name:        "secret1",
+				Type:        "secret",
+				Data:        runtime.RawExtension{Raw: []byte(`{"secret1":"c29tZS1kYXRh"}`)},
+				Labels:      map[string]string{"managed-by": "secrets-manager", "persist": "true"},
+				Annotations: map[string]string{"external-data-keys": "key"},
+			}))
+		})
+
 		Context("with data to backup", func() {
 			var (
 				existingGardenerData   = []gardencorev1beta1.GardenerResourceData{{Name: "some-data"}}
@@ -227,6 +247,30 @@ var _ = Describe("ShootState", func() {
 				Expect(shootState.Spec).To(Equal(expectedSpec))
 			})
 
+			It("should keep the current states of failed flow executions and drop stale ones when overwriting the spec", func() {
+				shoot.Generation = 2
+				flowState := gardencorev1beta1.GardenerResourceData{
+					Name: "flow-state-foo",
+					Type: v1beta1constants.DataTypeFlowState,
+					Data: runtime.RawExtension{Raw: []byte(`{"generation":2}`)},
+				}
+				staleFlowState := gardencorev1beta1.GardenerResourceData{
+					Name: "flow-state-bar",
+					Type: v1beta1constants.DataTypeFlowState,
+					Data: runtime.RawExtension{Raw: []byte(`{"generation":1}`)},
+				}
+
+				patch := client.MergeFrom(shootState.DeepCopy())
+				shootState.Spec.Gardener = append(shootState.Spec.Gardener, flowState, staleFlowState)
+				Expect(fakeGardenClient.Patch(ctx, shootState, patch)).To(Succeed())
+
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+				Expect(shootState.Spec.Gardener).To(ContainElement(flowState))
+				Expect(shootState.Spec.Gardener).NotTo(ContainElement(staleFlowState))
+				Expect(shootState.Spec.Gardener).NotTo(ContainElement(existingGardenerData[0]))
+			})
+
 			It("should compute the expected spec for both gardener and extensions data and keep existing data in the spec", func() {
 				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
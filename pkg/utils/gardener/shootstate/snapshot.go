@@ -0,0 +1,284 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// SnapshotSecretName is the name of the secret in the control plane namespace of a shoot which contains the latest
+	// encrypted ShootState snapshot. It is written by gardenlet and read by the controller responsible for the
+	// BackupEntry of the shoot which stores the snapshot in the backup bucket.
+	SnapshotSecretName = "shoot-state-snapshot"
+	// SnapshotSecretDataKey is the data key of the snapshot secret which contains the encrypted snapshot.
+	SnapshotSecretDataKey = "snapshot"
+	// SnapshotAnnotationTime is the annotation key on the snapshot secret whose value contains the time of the snapshot
+	// in RFC3339 format.
+	SnapshotAnnotationTime = "shootstate.gardener.cloud/snapshot-time"
+	// SnapshotAnnotationRetentionLast is the annotation key on the snapshot secret whose value contains the number of
+	// most recent snapshots which are kept in the backup bucket.
+	SnapshotAnnotationRetentionLast = "shootstate.gardener.cloud/retention-last"
+	// SnapshotAnnotationRetentionDaily is the annotation key on the snapshot secret whose value contains the number of
+	// most recent days for which the last snapshot of the day is kept in the backup bucket.
+	SnapshotAnnotationRetentionDaily = "shootstate.gardener.cloud/retention-daily"
+	// SnapshotPrefix is the prefix below the BackupEntry location in which the ShootState snapshots are stored.
+	SnapshotPrefix = "shootstate"
+
+	// snapshotTimeLayout is the layout of the timestamps in the names of the ShootState snapshots.
+	snapshotTimeLayout = "20060102T150405Z"
+	// snapshotSuffix is the suffix of the names of the ShootState snapshots.
+	snapshotSuffix = ".enc"
+)
+
+// SnapshotStore stores ShootState snapshots in backup buckets.
+type SnapshotStore interface {
+	// Put stores the given data under the given key in the bucket.
+	Put(ctx context.Context, bucketName, key string, data []byte) error
+	// List returns the keys of all objects in the bucket whose key starts with the given prefix.
+	List(ctx context.Context, bucketName, prefix string) ([]string, error)
+	// Delete removes the object with the given key from the bucket. It does not return an error if the object does not
+	// exist.
+	Delete(ctx context.Context, bucketName, key string) error
+}
+
+// SnapshotRetention defines how many ShootState snapshots are kept in the backup bucket.
+type SnapshotRetention struct {
+	// Last is the number of most recent snapshots which are kept.
+	Last int
+	// Daily is the number of most recent days for which the last snapshot of the day is kept.
+	Daily int
+}
+
+// NewSnapshotSecret returns the snapshot secret for the given control plane namespace. Its data is not set.
+func NewSnapshotSecret(namespace string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SnapshotSecretName, Namespace: namespace}}
+}
+
+// SetSnapshot sets the given encrypted snapshot, its time and the retention on the given snapshot secret.
+func SetSnapshot(secret *corev1.Secret, data []byte, snapshotTime time.Time, retention SnapshotRetention) {
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, SnapshotAnnotationTime, snapshotTime.UTC().Format(time.RFC3339))
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, SnapshotAnnotationRetentionLast, strconv.Itoa(retention.Last))
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, SnapshotAnnotationRetentionDaily, strconv.Itoa(retention.Daily))
+	secret.Data = map[string][]byte{SnapshotSecretDataKey: data}
+}
+
+// SnapshotTime returns the time of the snapshot contained in the given snapshot secret. It returns the zero time if
+// the secret does not contain a snapshot.
+func SnapshotTime(secret *corev1.Secret) (time.Time, error) {
+	v, ok := secret.Annotations[SnapshotAnnotationTime]
+	if !ok {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed parsing snapshot time %q: %w", v, err)
+	}
+	return t.UTC(), nil
+}
+
+// StoreSnapshot stores the snapshot contained in the given snapshot secret in the given bucket below the given prefix
+// if the latest stored snapshot is older. Afterwards, all snapshots which are no longer covered by the retention
+// configured in the secret are deleted.
+func StoreSnapshot(ctx context.Context, log logr.Logger, store SnapshotStore, bucketName, prefix string, secret *corev1.Secret) error {
+	snapshotTime, err := SnapshotTime(secret)
+	if err != nil {
+		return err
+	}
+	data, ok := secret.Data[SnapshotSecretDataKey]
+	if snapshotTime.IsZero() || !ok {
+		return nil
+	}
+
+	retention, err := snapshotRetention(secret)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := listSnapshots(ctx, store, bucketName, prefix)
+	if err != nil {
+		return fmt.Errorf("failed listing ShootState snapshots: %w", err)
+	}
+
+	if len(snapshots) == 0 || snapshots[0].time.Before(snapshotTime.Truncate(time.Second)) {
+		s := snapshot{key: path.Join(prefix, snapshotTime.Format(snapshotTimeLayout)+snapshotSuffix), time: snapshotTime.Truncate(time.Second)}
+		if err := store.Put(ctx, bucketName, s.key, data); err != nil {
+			return fmt.Errorf("failed storing ShootState snapshot %s: %w", s.key, err)
+		}
+		log.Info("Stored ShootState snapshot", "bucketName", bucketName, "key", s.key)
+
+		snapshots = append([]snapshot{s}, snapshots...)
+	}
+
+	for _, s := range expiredSnapshots(snapshots, retention) {
+		if err := store.Delete(ctx, bucketName, s.key); err != nil {
+			return fmt.Errorf("failed deleting expired ShootState snapshot %s: %w", s.key, err)
+		}
+		log.Info("Deleted expired ShootState snapshot", "bucketName", bucketName, "key", s.key)
+	}
+
+	return nil
+}
+
+func snapshotRetention(secret *corev1.Secret) (SnapshotRetention, error) {
+	var retention SnapshotRetention
+
+	for annotation, value := range map[string]*int{
+		SnapshotAnnotationRetentionLast:  &retention.Last,
+		SnapshotAnnotationRetentionDaily: &retention.Daily,
+	} {
+		v, err := strconv.Atoi(secret.Annotations[annotation])
+		if err != nil {
+			return SnapshotRetention{}, fmt.Errorf("failed parsing annotation %s of snapshot secret: %w", annotation, err)
+		}
+		*value = v
+	}
+
+	// Always keep at least the latest snapshot.
+	if retention.Last == 0 && retention.Daily == 0 {
+		retention.Last = 1
+	}
+	return retention, nil
+}
+
+// snapshot is a ShootState snapshot in a backup bucket.
+type snapshot struct {
+	key  string
+	time time.Time
+}
+
+// listSnapshots returns the snapshots in the given bucket and prefix ordered from the newest to the oldest one.
+func listSnapshots(ctx context.Context, store SnapshotStore, bucketName, prefix string) ([]snapshot, error) {
+	keys, err := store.List(ctx, bucketName, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []snapshot
+	for _, key := range keys {
+		name := path.Base(key)
+		if !strings.HasSuffix(name, snapshotSuffix) {
+			continue
+		}
+
+		t, err := time.Parse(snapshotTimeLayout, strings.TrimSuffix(name, snapshotSuffix))
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, snapshot{key: key, time: t})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].time.After(snapshots[j].time) })
+	return snapshots, nil
+}
+
+// expiredSnapshots returns the snapshots which are not covered by the given retention. The snapshots must be ordered
+// from the newest to the oldest one.
+func expiredSnapshots(snapshots []snapshot, retention SnapshotRetention) []snapshot {
+	var (
+		days = map[string]struct{}{}
+
+		expired []snapshot
+	)
+
+	for i, s := range snapshots {
+		keep := i < retention.Last
+
+		if day := s.time.UTC().Format(time.DateOnly); len(days) < retention.Daily {
+			if _, ok := days[day]; !ok {
+				days[day] = struct{}{}
+				keep = true
+			}
+		}
+
+		if !keep {
+			expired = append(expired, s)
+		}
+	}
+
+	return expired
+}
+
+// EncryptSnapshot serializes the given ShootState spec and encrypts it with AES-GCM using the given key. The random
+// nonce is prepended to the returned cipher text.
+func EncryptSnapshot(spec *gardencorev1beta1.ShootStateSpec, key []byte) ([]byte, error) {
+	plainText, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling ShootState spec: %w", err)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, plainText, nil), nil
+}
+
+// DecryptSnapshot decrypts the given snapshot created by EncryptSnapshot with the given key and returns the contained
+// ShootState spec.
+func DecryptSnapshot(data, key []byte) (*gardencorev1beta1.ShootStateSpec, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("snapshot is too short")
+	}
+
+	plainText, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting snapshot: %w", err)
+	}
+
+	spec := &gardencorev1beta1.ShootStateSpec{}
+	if err := json.Unmarshal(plainText, spec); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling ShootState spec: %w", err)
+	}
+	return spec, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate_test
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		key  = []byte("0123456789abcdef0123456789abcdef")
+		spec = &gardencorev1beta1.ShootStateSpec{
+			Gardener: []gardencorev1beta1.GardenerResourceData{{
+				Name: "ca",
+				Type: "secret",
+				Data: runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+			}},
+		}
+	)
+
+	Describe("#EncryptSnapshot, #DecryptSnapshot", func() {
+		It("should decrypt an encrypted snapshot", func() {
+			data, err := EncryptSnapshot(spec, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring("foo"))
+
+			Expect(DecryptSnapshot(data, key)).To(Equal(spec))
+		})
+
+		It("should fail decrypting a snapshot with another key", func() {
+			data, err := EncryptSnapshot(spec, key)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecryptSnapshot(data, []byte("fedcba9876543210fedcba9876543210"))
+			Expect(err).To(MatchError(ContainSubstring("failed decrypting snapshot")))
+		})
+
+		It("should fail for invalid key sizes", func() {
+			_, err := EncryptSnapshot(spec, []byte("foo"))
+			Expect(err).To(MatchError(ContainSubstring("invalid key size")))
+		})
+	})
+
+	Describe("#StoreSnapshot", func() {
+		const (
+			bucketName = "bucket"
+			prefix     = "shoot--my-project--my-shoot--shoot-uid/shootstate"
+		)
+
+		var (
+			ctx          = context.TODO()
+			snapshotTime = time.Date(2023, 10, 10, 11, 0, 0, 0, time.UTC)
+
+			store  *memorySnapshotStore
+			secret = NewSnapshotSecret("shoot--my-project--my-shoot")
+		)
+
+		BeforeEach(func() {
+			store = &memorySnapshotStore{objects: map[string][]byte{}}
+			SetSnapshot(secret, []byte("snapshot"), snapshotTime, SnapshotRetention{Last: 2, Daily: 2})
+		})
+
+		It("should store the snapshot", func() {
+			Expect(StoreSnapshot(ctx, logr.Discard(), store, bucketName, prefix, secret)).To(Succeed())
+
+			Expect(store.objects).To(Equal(map[string][]byte{prefix + "/20231010T110000Z.enc": []byte("snapshot")}))
+		})
+
+		It("should not store the snapshot again if it was already stored", func() {
+			Expect(StoreSnapshot(ctx, logr.Discard(), store, bucketName, prefix, secret)).To(Succeed())
+			store.objects[prefix+"/20231010T110000Z.enc"] = []byte("stored")
+
+			Expect(StoreSnapshot(ctx, logr.Discard(), store, bucketName, prefix, secret)).To(Succeed())
+			Expect(store.objects).To(Equal(map[string][]byte{prefix + "/20231010T110000Z.enc": []byte("stored")}))
+		})
+
+		It("should do nothing if the secret does not contain a snapshot", func() {
+			Expect(StoreSnapshot(ctx, logr.Discard(), store, bucketName, prefix, NewSnapshotSecret("shoot--my-project--my-shoot"))).To(Succeed())
+			Expect(store.objects).To(BeEmpty())
+		})
+
+		It("should delete snapshots which are not covered by the retention", func() {
+			for _, name := range []string{
+				"20231010T060000Z.enc",
+				"20231010T000000Z.enc",
+				"20231009T180000Z.enc",
+				"20231009T120000Z.enc",
+				"20231008T120000Z.enc",
+				"unrelated-file",
+			} {
+				store.objects[prefix+"/"+name] = []byte("data")
+			}
+
+			Expect(StoreSnapshot(ctx, logr.Discard(), store, bucketName, prefix, secret)).To(Succeed())
+			Expect(store.keys()).To(ConsistOf(
+				prefix+"/20231010T110000Z.enc",
+				prefix+"/20231010T060000Z.enc",
+				prefix+"/20231009T180000Z.enc",
+				prefix+"/unrelated-file",
+			))
+		})
+
+		It("should always keep the latest snapshot", func() {
+			SetSnapshot(secret, []byte("snapshot"), snapshotTime, SnapshotRetention{})
+			store.objects[prefix+"/20231010T060000Z.enc"] = []byte("data")
+
+			Expect(StoreSnapshot(ctx, logr.Discard(), store, bucketName, prefix, secret)).To(Succeed())
+			Expect(store.keys()).To(ConsistOf(prefix + "/20231010T110000Z.enc"))
+		})
+	})
+})
+
+type memorySnapshotStore struct {
+	objects map[string][]byte
+}
+
+func (m *memorySnapshotStore) keys() []string {
+	var keys []string
+	for key := range m.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (m *memorySnapshotStore) Put(_ context.Context, _, key string, data []byte) error {
+	m.objects[key] = data
+	return nil
+}
+
+func (m *memorySnapshotStore) List(_ context.Context, _, prefix string) ([]string, error) {
+	var keys []string
+	for key := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memorySnapshotStore) Delete(_ context.Context, _, key string) error {
+	delete(m.objects, key)
+	return nil
+}
@@ -169,19 +169,22 @@ func Merge(vectors ...ImageVector) ImageVector {
 
 // WithEnvOverride checks if an environment variable with the key IMAGEVECTOR_OVERWRITE is set.
 // If yes, it reads the ImageVector at the value of the variable and merges it with the given one.
+// Afterwards, the Policy referenced by the IMAGEVECTOR_POLICY environment variable is applied (see WithEnvPolicy).
 // Otherwise, it returns the unmodified ImageVector.
 func WithEnvOverride(vector ImageVector) (ImageVector, error) {
-	overwritePath := os.Getenv(OverrideEnv)
-	if len(overwritePath) == 0 {
-		return vector, nil
-	}
+	var override ImageVector
 
-	override, err := ReadFile(overwritePath)
-	if err != nil {
-		return nil, err
+	if overwritePath := os.Getenv(OverrideEnv); len(overwritePath) > 0 {
+		var err error
+		override, err = ReadFile(overwritePath)
+		if err != nil {
+			return nil, err
+		}
+
+		vector = Merge(vector, override)
 	}
 
-	return Merge(vector, override), nil
+	return WithEnvPolicy(vector, override)
 }
 
 // String implements Stringer.
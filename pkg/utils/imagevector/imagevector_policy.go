@@ -0,0 +1,177 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagevector
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// PolicyEnv is the name of the environment variable pointing to a file containing the Policy which is applied to
+	// all image vectors.
+	PolicyEnv = "IMAGEVECTOR_POLICY"
+)
+
+// ReadPolicy reads a Policy from the given bytes.
+func ReadPolicy(buf []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := yaml.Unmarshal(buf, policy); err != nil {
+		return nil, err
+	}
+
+	if errs := ValidatePolicy(policy, nil); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return policy, nil
+}
+
+// ReadPolicyFile reads a Policy from the file with the given name.
+func ReadPolicyFile(name string) (*Policy, error) {
+	buf, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadPolicy(buf)
+}
+
+// WithEnvPolicy checks if an environment variable with the key IMAGEVECTOR_POLICY is set.
+// If yes, it reads the Policy at the value of the variable and applies it to the given ImageVector. If the policy
+// enforces digests, only the images contained in the given overwrite are required to be referenced by digest. Images
+// falling back to the given (default) ImageVector are exempted, as they are referenced by the tags of the release.
+// Otherwise, it returns the unmodified ImageVector.
+func WithEnvPolicy(vector, overwrite ImageVector) (ImageVector, error) {
+	policy, err := readEnvPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	overwritten := make(map[imageSourceKey]struct{}, len(overwrite))
+	for _, source := range overwrite {
+		overwritten[computeKey(source)] = struct{}{}
+	}
+
+	return applyPolicy(vector, policy, func(source *ImageSource) bool {
+		_, ok := overwritten[computeKey(source)]
+		return !ok
+	})
+}
+
+// ComponentImageVectorsWithEnvPolicy checks if an environment variable with the key IMAGEVECTOR_POLICY is set.
+// If yes, it reads the Policy at the value of the variable and applies it to the image vector overwrites of all given
+// components. Otherwise, it returns the unmodified ComponentImageVectors.
+func ComponentImageVectorsWithEnvPolicy(componentImageVectors ComponentImageVectors) (ComponentImageVectors, error) {
+	policy, err := readEnvPolicy()
+	if err != nil || policy == nil {
+		return componentImageVectors, err
+	}
+
+	out := make(ComponentImageVectors, len(componentImageVectors))
+	for name, imageVectorOverwrite := range componentImageVectors {
+		vector, err := Read([]byte(imageVectorOverwrite))
+		if err != nil {
+			return nil, fmt.Errorf("failed reading image vector overwrite of component %q: %w", name, err)
+		}
+
+		vector, err = ApplyPolicy(vector, policy)
+		if err != nil {
+			return nil, fmt.Errorf("failed applying policy to image vector overwrite of component %q: %w", name, err)
+		}
+
+		buf, err := yaml.Marshal(&struct {
+			Images ImageVector `json:"images"`
+		}{vector})
+		if err != nil {
+			return nil, err
+		}
+		out[name] = string(buf)
+	}
+
+	return out, nil
+}
+
+func readEnvPolicy() (*Policy, error) {
+	policyPath := os.Getenv(PolicyEnv)
+	if len(policyPath) == 0 {
+		return nil, nil
+	}
+
+	return ReadPolicyFile(policyPath)
+}
+
+// ApplyPolicy returns a copy of the given ImageVector whose repositories are rewritten according to the registry
+// mirrors of the given policy. If the policy enforces digests, an error is returned for all images which are not
+// referenced by digest.
+func ApplyPolicy(vector ImageVector, policy *Policy) (ImageVector, error) {
+	return applyPolicy(vector, policy, func(*ImageSource) bool { return false })
+}
+
+func applyPolicy(vector ImageVector, policy *Policy, exemptFromDigestEnforcement func(*ImageSource) bool) (ImageVector, error) {
+	if policy == nil {
+		return vector, nil
+	}
+
+	var (
+		out     = make(ImageVector, 0, len(vector))
+		allErrs = field.ErrorList{}
+	)
+
+	for i, source := range vector {
+		s := *source
+		s.Repository = policy.rewriteRepository(s.Repository)
+		out = append(out, &s)
+
+		if policy.EnforceDigests && !exemptFromDigestEnforcement(source) {
+			allErrs = append(allErrs, validateImageSourceDigest(&s, field.NewPath("images").Index(i))...)
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return nil, allErrs.ToAggregate()
+	}
+
+	return out, nil
+}
+
+// rewriteRepository rewrites the given repository with the registry mirror with the longest matching source. A source
+// only matches at path boundaries, i.e. `example.com/foo` matches `example.com/foo/bar` but not `example.com/foobar`.
+func (p *Policy) rewriteRepository(repository string) string {
+	var match *RegistryMirror
+
+	for i, mirror := range p.RegistryMirrors {
+		if repository != mirror.Source && !strings.HasPrefix(repository, mirror.Source+"/") {
+			continue
+		}
+		if match == nil || len(mirror.Source) > len(match.Source) {
+			match = &p.RegistryMirrors[i]
+		}
+	}
+
+	if match == nil {
+		return repository
+	}
+	return match.Mirror + strings.TrimPrefix(repository, match.Source)
+}
+
+// IsDigestReference returns true if the image source is referenced by digest.
+func (i *ImageSource) IsDigestReference() bool {
+	return i.Tag != nil && strings.HasPrefix(*i.Tag, SHA256TagPrefix)
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagevector_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("policy", func() {
+	var digest = "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	Describe("#ReadPolicy", func() {
+		It("should read the policy", func() {
+			policy, err := ReadPolicy([]byte(`
+registryMirrors:
+- source: europe-docker.pkg.dev/gardener-project/releases
+  mirror: registry.internal/gardener
+enforceDigests: true
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).To(Equal(&Policy{
+				RegistryMirrors: []RegistryMirror{{Source: "europe-docker.pkg.dev/gardener-project/releases", Mirror: "registry.internal/gardener"}},
+				EnforceDigests:  true,
+			}))
+		})
+
+		It("should return an empty policy if nothing is configured", func() {
+			Expect(ReadPolicy([]byte(`{}`))).To(Equal(&Policy{}))
+		})
+
+		It("should fail for invalid policies", func() {
+			_, err := ReadPolicy([]byte(`
+registryMirrors:
+- source: example.com/
+  mirror: registry.internal
+`))
+			Expect(err).To(MatchError(ContainSubstring("registryMirrors[0].source")))
+		})
+	})
+
+	Describe("#ApplyPolicy", func() {
+		var vector ImageVector
+
+		BeforeEach(func() {
+			vector = ImageVector{
+				{Name: "a", Repository: "europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet", Tag: pointer.String("v1.0.0")},
+				{Name: "b", Repository: "europe-docker.pkg.dev/gardener-project/releases-foo/bar", Tag: pointer.String(digest)},
+				{Name: "c", Repository: "registry.k8s.io/pause", Tag: pointer.String("3.9")},
+				{Name: "d", Repository: "registry.k8s.io", Tag: pointer.String("3.9")},
+			}
+		})
+
+		It("should return the vector as-is without policy", func() {
+			Expect(ApplyPolicy(vector, nil)).To(Equal(vector))
+		})
+
+		It("should rewrite the repositories with the longest matching mirror", func() {
+			out, err := ApplyPolicy(vector, &Policy{RegistryMirrors: []RegistryMirror{
+				{Source: "europe-docker.pkg.dev", Mirror: "registry.internal/all"},
+				{Source: "europe-docker.pkg.dev/gardener-project/releases", Mirror: "registry.internal/gardener"},
+				{Source: "registry.k8s.io", Mirror: "registry.internal:5000/k8s"},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out).To(Equal(ImageVector{
+				{Name: "a", Repository: "registry.internal/gardener/gardener/gardenlet", Tag: pointer.String("v1.0.0")},
+				{Name: "b", Repository: "registry.internal/all/gardener-project/releases-foo/bar", Tag: pointer.String(digest)},
+				{Name: "c", Repository: "registry.internal:5000/k8s/pause", Tag: pointer.String("3.9")},
+				{Name: "d", Repository: "registry.internal:5000/k8s", Tag: pointer.String("3.9")},
+			}))
+			Expect(vector[0].Repository).To(Equal("europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet"))
+		})
+
+		It("should not rewrite repositories only sharing a prefix which is not a path boundary", func() {
+			out, err := ApplyPolicy(vector, &Policy{RegistryMirrors: []RegistryMirror{
+				{Source: "europe-docker.pkg.dev/gardener-project/releases", Mirror: "registry.internal/gardener"},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[1].Repository).To(Equal("europe-docker.pkg.dev/gardener-project/releases-foo/bar"))
+		})
+
+		It("should fail for images which are not referenced by digest if digests are enforced", func() {
+			_, err := ApplyPolicy(vector, &Policy{EnforceDigests: true})
+			Expect(err).To(And(
+				MatchError(ContainSubstring("images[0].tag")),
+				MatchError(ContainSubstring("images[2].tag")),
+				MatchError(ContainSubstring("images[3].tag")),
+				Not(MatchError(ContainSubstring("images[1].tag"))),
+			))
+		})
+
+		It("should succeed if all images are referenced by digest", func() {
+			vector = ImageVector{vector[1]}
+			Expect(ApplyPolicy(vector, &Policy{EnforceDigests: true})).To(Equal(vector))
+		})
+	})
+
+	Describe("#ComponentImageVectorsWithEnvPolicy", func() {
+		var componentImageVectors ComponentImageVectors
+
+		BeforeEach(func() {
+			componentImageVectors = ComponentImageVectors{
+				"foo": `
+images:
+- name: foo
+  repository: registry.k8s.io/foo
+  tag: "` + digest + `"
+`,
+			}
+		})
+
+		It("should keep the component image vectors as-is if the env variable is not set", func() {
+			Expect(ComponentImageVectorsWithEnvPolicy(componentImageVectors)).To(Equal(componentImageVectors))
+		})
+
+		It("should apply the policy referenced by the env variable", func() {
+			file, cleanup := withTempFile("imagevector-policy", []byte(`
+registryMirrors:
+- source: registry.k8s.io
+  mirror: registry.internal/k8s
+enforceDigests: true
+`))
+
+			defer cleanup()
+			defer test.WithEnvVar(PolicyEnv, file.Name())()
+
+			out, err := ComponentImageVectorsWithEnvPolicy(componentImageVectors)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveKey("foo"))
+			Expect(Read([]byte(out["foo"]))).To(Equal(ImageVector{
+				{Name: "foo", Repository: "registry.internal/k8s/foo", Tag: pointer.String(digest)},
+			}))
+		})
+
+		It("should fail if the policy referenced by the env variable enforces digests", func() {
+			componentImageVectors["bar"] = `
+images:
+- name: bar
+  repository: registry.k8s.io/bar
+  tag: v1.0.0
+`
+			file, cleanup := withTempFile("imagevector-policy", []byte(`enforceDigests: true`))
+
+			defer cleanup()
+			defer test.WithEnvVar(PolicyEnv, file.Name())()
+
+			_, err := ComponentImageVectorsWithEnvPolicy(componentImageVectors)
+			Expect(err).To(MatchError(ContainSubstring(`component "bar"`)))
+		})
+	})
+})
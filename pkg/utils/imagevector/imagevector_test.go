@@ -322,6 +322,63 @@ images:
 				Expect(WithEnvOverride(vector)).To(Equal(ImageVector{image1Src1, image2Src1}))
 			})
 
+			It("should apply the policy referenced by the env variable without an override", func() {
+				file, cleanup := withTempFile("imagevector-policy", []byte(`
+registryMirrors:
+- source: `+repo2+`
+  mirror: mirror.example.com/`+repo2+`
+`))
+
+				defer cleanup()
+				defer test.WithEnvVar(PolicyEnv, file.Name())()
+
+				mirrored := *image2Src1
+				mirrored.Repository = "mirror.example.com/" + repo2
+
+				Expect(WithEnvOverride(ImageVector{image1Src1, image2Src1})).To(Equal(ImageVector{image1Src1, &mirrored}))
+			})
+
+			It("should apply the policy referenced by the env variable after merging the override", func() {
+				overrideFile, cleanupOverride := withTempFile("imagevector", []byte(image1Src1VectorJSON))
+				policyFile, cleanupPolicy := withTempFile("imagevector-policy", []byte(`
+registryMirrors:
+- source: `+repo1+`
+  mirror: mirror.example.com/`+repo1+`
+`))
+
+				defer cleanupOverride()
+				defer cleanupPolicy()
+				defer test.WithEnvVar(OverrideEnv, overrideFile.Name())()
+				defer test.WithEnvVar(PolicyEnv, policyFile.Name())()
+
+				mirrored := *image1Src1
+				mirrored.Repository = "mirror.example.com/" + repo1
+
+				Expect(WithEnvOverride(ImageVector{image1Src3, image2Src1})).To(Equal(ImageVector{&mirrored, image2Src1}))
+			})
+
+			It("should exempt images of the default vector if the policy referenced by the env variable enforces digests", func() {
+				file, cleanup := withTempFile("imagevector-policy", []byte(`enforceDigests: true`))
+
+				defer cleanup()
+				defer test.WithEnvVar(PolicyEnv, file.Name())()
+
+				Expect(WithEnvOverride(ImageVector{image1Src1, image2Src1})).To(Equal(ImageVector{image1Src1, image2Src1}))
+			})
+
+			It("should fail for overwritten images if the policy referenced by the env variable enforces digests", func() {
+				overrideFile, cleanupOverride := withTempFile("imagevector", []byte(image1Src1VectorJSON))
+				policyFile, cleanupPolicy := withTempFile("imagevector-policy", []byte(`enforceDigests: true`))
+
+				defer cleanupOverride()
+				defer cleanupPolicy()
+				defer test.WithEnvVar(OverrideEnv, overrideFile.Name())()
+				defer test.WithEnvVar(PolicyEnv, policyFile.Name())()
+
+				_, err := WithEnvOverride(ImageVector{image1Src3, image2Src1})
+				Expect(err).To(MatchError(And(ContainSubstring("must be referenced by digest"), ContainSubstring("images[0]"))))
+			})
+
 			It("should keep the vector as-is if the env variable is not set", func() {
 				Expect(WithEnvOverride(image1Src1Vector)).To(Equal(image1Src1Vector))
 			})
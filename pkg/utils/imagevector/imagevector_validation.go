@@ -15,6 +15,8 @@
 package imagevector
 
 import (
+	"strings"
+
 	"github.com/Masterminds/semver/v3"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -30,6 +32,54 @@ func ValidateImageVector(imageVector ImageVector, fldPath *field.Path) field.Err
 	return allErrs
 }
 
+// ValidateImageVectorDigests validates that all images of the given ImageVector are referenced by digest.
+func ValidateImageVectorDigests(imageVector ImageVector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, imageSource := range imageVector {
+		allErrs = append(allErrs, validateImageSourceDigest(imageSource, fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateImageSourceDigest(imageSource *ImageSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !imageSource.IsDigestReference() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tag"), imageSource.Tag, "image "+imageSource.Name+" must be referenced by digest ("+SHA256TagPrefix+"...) since digests are enforced"))
+	}
+
+	return allErrs
+}
+
+// ValidatePolicy validates the given Policy.
+func ValidatePolicy(policy *Policy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	sources := make(map[string]struct{}, len(policy.RegistryMirrors))
+	for i, mirror := range policy.RegistryMirrors {
+		idxPath := fldPath.Child("registryMirrors").Index(i)
+
+		if mirror.Source == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("source"), "source is required"))
+		} else if strings.HasSuffix(mirror.Source, "/") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("source"), mirror.Source, "source must not end with a slash"))
+		} else if _, ok := sources[mirror.Source]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("source"), mirror.Source))
+		}
+		sources[mirror.Source] = struct{}{}
+
+		if mirror.Mirror == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("mirror"), "mirror is required"))
+		} else if strings.HasSuffix(mirror.Mirror, "/") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("mirror"), mirror.Mirror, "mirror must not end with a slash"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateComponentImageVectors validates the given ComponentImageVectors.
 func ValidateComponentImageVectors(componentImageVectors ComponentImageVectors, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -95,6 +95,73 @@ var _ = Describe("validation", func() {
 		})
 	})
 
+	Describe("#ValidateImageVectorDigests", func() {
+		It("should allow images referenced by digest", func() {
+			errorList := ValidateImageVectorDigests(imageVector("test-image1", "test-repo", "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", "", ""), field.NewPath("images"))
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid images referenced by tag or without tag", func() {
+			vector := append(imageVector("test-image1", "test-repo", "test-tag", "", ""), &ImageSource{Name: "test-image2", Repository: "test-repo"})
+			errorList := ValidateImageVectorDigests(vector, field.NewPath("images"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("images[0].tag"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("images[1].tag"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidatePolicy", func() {
+		It("should allow valid policies", func() {
+			errorList := ValidatePolicy(&Policy{RegistryMirrors: []RegistryMirror{
+				{Source: "example.com", Mirror: "registry.internal"},
+				{Source: "example.com/foo", Mirror: "registry.internal/foo"},
+			}}, nil)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid policies", func() {
+			errorList := ValidatePolicy(&Policy{RegistryMirrors: []RegistryMirror{
+				{},
+				{Source: "example.com/", Mirror: "registry.internal/"},
+				{Source: "example.com", Mirror: "registry.internal"},
+				{Source: "example.com", Mirror: "registry.internal"},
+			}}, nil)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("registryMirrors[0].source"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("registryMirrors[0].mirror"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("registryMirrors[1].source"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("registryMirrors[1].mirror"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("registryMirrors[3].source"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateComponentImageVectors", func() {
 		It("should allow valid component image vectors", func() {
 			errorList := ValidateComponentImageVectors(componentImageVectors("test-component1", imageVector("test-image1", "test-repo", "test-tag", ">= 1.6, < 1.8", ">= 1.8")), field.NewPath("components"))
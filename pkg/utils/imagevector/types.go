@@ -41,6 +41,22 @@ type Image struct {
 // ImageVector is a list of image sources.
 type ImageVector []*ImageSource
 
+// Policy contains rules which are applied to all images of an image vector.
+type Policy struct {
+	// RegistryMirrors is a list of mirrors to which the repositories of the images are rewritten.
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty" yaml:"registryMirrors,omitempty"`
+	// EnforceDigests requires all images to be referenced by digest instead of by tag.
+	EnforceDigests bool `json:"enforceDigests,omitempty" yaml:"enforceDigests,omitempty"`
+}
+
+// RegistryMirror rewrites repositories starting with the source prefix to the mirror prefix.
+type RegistryMirror struct {
+	// Source is a registry host or a repository prefix, e.g. `europe-docker.pkg.dev/gardener-project/releases`.
+	Source string `json:"source" yaml:"source"`
+	// Mirror is the registry host or repository prefix replacing the source, e.g. `registry.internal/gardener`.
+	Mirror string `json:"mirror" yaml:"mirror"`
+}
+
 // ComponentImageVector contains an image vector overwrite for a component deployed by Gardener.
 type ComponentImageVector struct {
 	Name                 string `json:"name" yaml:"name"`
@@ -35,6 +35,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
 
@@ -161,7 +162,7 @@ func (b *HealthChecker) checkStatefulSets(condition gardencorev1beta1.Condition,
 // kubeletConfigProblemRegex is used to check if an error occurred due to a kubelet configuration problem.
 var kubeletConfigProblemRegex = regexp.MustCompile(`(?i)(KubeletHasInsufficientMemory|KubeletHasDiskPressure|KubeletHasInsufficientPID)`)
 
-// CheckNodes whether the given nodes are ready and the version in the node status is of the same major-minor as given in 'workerGroupKubernetesVersion'.
+// CheckNodes whether the given nodes are ready, the operating system config was applied successfully, and the version in the node status is of the same major-minor as given in 'workerGroupKubernetesVersion'.
 func (b *HealthChecker) CheckNodes(condition gardencorev1beta1.Condition, nodes []corev1.Node, workerGroupName string, workerGroupKubernetesVersion *semver.Version) *gardencorev1beta1.Condition {
 	for _, object := range nodes {
 		if err := health.CheckNode(&object); err != nil {
@@ -178,6 +179,11 @@ func (b *HealthChecker) CheckNodes(condition gardencorev1beta1.Condition, nodes
 			return &c
 		}
 
+		if err := health.CheckOptionalNodeCondition(&object, nodeagentv1alpha1.NodeConditionTypeOperatingSystemConfigApplied); err != nil {
+			c := v1beta1helper.FailedCondition(b.clock, b.lastOperation, b.conditionThresholds, condition, "OperatingSystemConfigNotApplied", fmt.Sprintf("Operating system config could not be applied to node %q in worker group %q: %v", object.Name, workerGroupName, err))
+			return &c
+		}
+
 		sameMajorMinor, err := semver.NewConstraint("~ " + object.Status.NodeInfo.KubeletVersion)
 		if err != nil {
 			c := v1beta1helper.FailedCondition(b.clock, b.lastOperation, b.conditionThresholds, condition, "VersionParseError", fmt.Sprintf("Error checking for same major minor Kubernetes version for node %q: %+v", object.Name, err))
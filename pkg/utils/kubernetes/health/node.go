@@ -67,3 +67,15 @@ func CheckNode(node *corev1.Node) error {
 
 	return nil
 }
+
+// CheckOptionalNodeCondition checks whether the condition of the given type reports `corev1.ConditionTrue` for the
+// given Node. Nodes which do not have the condition (e.g., because the component maintaining it is not running on the
+// node or did not report it yet) are considered healthy.
+func CheckOptionalNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType) error {
+	condition := getNodeCondition(node.Status.Conditions, conditionType)
+	if condition == nil {
+		return nil
+	}
+
+	return checkConditionState(string(condition.Type), string(corev1.ConditionTrue), string(condition.Status), condition.Reason, condition.Message)
+}
@@ -39,4 +39,20 @@ var _ = Describe("Node", func() {
 			}, HaveOccurred()),
 		)
 	})
+
+	Describe("CheckOptionalNodeCondition", func() {
+		DescribeTable("nodes",
+			func(node *corev1.Node, matcher types.GomegaMatcher) {
+				err := health.CheckOptionalNodeCondition(node, "OperatingSystemConfigApplied")
+				Expect(err).To(matcher)
+			},
+			Entry("no condition", &corev1.Node{}, BeNil()),
+			Entry("condition indicating true", &corev1.Node{
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionTrue}}},
+			}, BeNil()),
+			Entry("condition not indicating true", &corev1.Node{
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionFalse, Reason: "OSCRolledBack", Message: `unit "foo": bar`}}},
+			}, MatchError(`condition "OperatingSystemConfigApplied" has invalid status False (expected True) due to OSCRolledBack: unit "foo": bar`)),
+		)
+	})
 })
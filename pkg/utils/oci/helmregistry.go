@@ -0,0 +1,410 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+const (
+	// MediaTypeHelmChartContent is the media type of the layer containing the Helm chart archive.
+	MediaTypeHelmChartContent = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	// maxManifestSize is the maximum size of a manifest which is read from a registry.
+	maxManifestSize = 4 << 20
+	// maxChartSize is the maximum size of a chart archive which is read from a registry.
+	maxChartSize = 64 << 20
+	// requestTimeout is the timeout of a single request to a registry (including reading the response body).
+	requestTimeout = 2 * time.Minute
+
+	cacheSize = 64
+	// Pulled charts are cached by the digest of their manifest, i.e. cache entries never become stale. The TTL only
+	// frees memory of charts which are no longer used.
+	cacheTTL = 24 * time.Hour
+)
+
+type helmRegistry struct {
+	secretReader client.Reader
+	httpClient   *http.Client
+	scheme       string
+	cache        *cache.LRUExpireCache
+}
+
+// NewHelmRegistry returns a new Interface for pulling Helm charts from OCI registries. Pull secrets are read from the
+// `garden` namespace with the given reader. Pulled charts are cached in memory by the digest of their manifest. Cached
+// charts are only returned if the registry still grants access to their manifest with the given pull secret.
+func NewHelmRegistry(secretReader client.Reader) Interface {
+	return &helmRegistry{
+		secretReader: secretReader,
+		httpClient:   &http.Client{Timeout: requestTimeout},
+		scheme:       "https",
+		cache:        cache.NewLRUExpireCache(cacheSize),
+	}
+}
+
+func (h *helmRegistry) Pull(ctx context.Context, repository *Repository) ([]byte, error) {
+	host, name, err := repository.hostAndName()
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.Tag == "" && repository.Digest == "" {
+		return nil, fmt.Errorf("either a tag or a digest must be specified for repository %q", repository.Repository)
+	}
+
+	if repository.Digest != "" {
+		if err := digest.Digest(repository.Digest).Validate(); err != nil {
+			return nil, fmt.Errorf("invalid digest %q: %w", repository.Digest, err)
+		}
+	}
+
+	creds, err := h.credentials(ctx, host, repository.PullSecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &registryClient{
+		httpClient:  h.httpClient,
+		baseURL:     fmt.Sprintf("%s://%s/v2/%s", h.scheme, host, name),
+		credentials: creds,
+	}
+
+	if repository.Digest != "" {
+		if chart, ok := h.cache.Get(cacheKey(repository.Repository, repository.Digest)); ok {
+			// The cache is shared by all callers, hence check that the given credentials grant access to the chart.
+			if err := c.head(ctx, "manifests/"+repository.Digest, ocispecv1.MediaTypeImageManifest); err != nil {
+				return nil, fmt.Errorf("failed checking access to manifest of %s: %w", repository, err)
+			}
+			return chart.([]byte), nil
+		}
+	}
+
+	manifestBytes, err := c.get(ctx, "manifests/"+repository.reference(), ocispecv1.MediaTypeImageManifest, maxManifestSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching manifest of %s: %w", repository, err)
+	}
+
+	manifestDigest := digest.FromBytes(manifestBytes)
+	if repository.Digest != "" {
+		expected := digest.Digest(repository.Digest)
+		if actual := expected.Algorithm().FromBytes(manifestBytes); actual != expected {
+			return nil, fmt.Errorf("digest of manifest of %s does not match, got %s", repository, actual)
+		}
+		manifestDigest = expected
+	}
+
+	key := cacheKey(repository.Repository, manifestDigest.String())
+	if chart, ok := h.cache.Get(key); ok {
+		return chart.([]byte), nil
+	}
+
+	manifest := &ocispecv1.Manifest{}
+	if err := json.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, fmt.Errorf("failed decoding manifest of %s: %w", repository, err)
+	}
+
+	layer, err := chartLayer(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest of %s: %w", repository, err)
+	}
+
+	chart, err := c.get(ctx, "blobs/"+layer.Digest.String(), "", layer.Size)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching chart of %s: %w", repository, err)
+	}
+
+	if int64(len(chart)) != layer.Size {
+		return nil, fmt.Errorf("size of chart of %s does not match, expected %d bytes but got %d bytes", repository, layer.Size, len(chart))
+	}
+	if actual := layer.Digest.Algorithm().FromBytes(chart); actual != layer.Digest {
+		return nil, fmt.Errorf("digest of chart of %s does not match, expected %s but got %s", repository, layer.Digest, actual)
+	}
+
+	h.cache.Add(key, chart, cacheTTL)
+	return chart, nil
+}
+
+func (h *helmRegistry) credentials(ctx context.Context, host string, pullSecretRef *corev1.LocalObjectReference) (*credentials, error) {
+	if pullSecretRef == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := h.secretReader.Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: pullSecretRef.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading pull secret %q: %w", pullSecretRef.Name, err)
+	}
+
+	if secret.Type != corev1.SecretTypeDockerConfigJson {
+		return nil, fmt.Errorf("pull secret %q must be of type %q", pullSecretRef.Name, corev1.SecretTypeDockerConfigJson)
+	}
+
+	dockerConfig := &struct {
+		Auths map[string]struct {
+			Username string `json:"username,omitempty"`
+			Password string `json:"password,omitempty"`
+			Auth     string `json:"auth,omitempty"`
+		} `json:"auths"`
+	}{}
+	if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], dockerConfig); err != nil {
+		return nil, fmt.Errorf("failed decoding pull secret %q: %w", pullSecretRef.Name, err)
+	}
+
+	for _, key := range []string{host, "https://" + host} {
+		entry, ok := dockerConfig.Auths[key]
+		if !ok {
+			continue
+		}
+
+		if entry.Username == "" && entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("failed decoding auth of registry %q in pull secret %q: %w", host, pullSecretRef.Name, err)
+			}
+			entry.Username, entry.Password, _ = strings.Cut(string(decoded), ":")
+		}
+
+		return &credentials{username: entry.Username, password: entry.Password}, nil
+	}
+
+	return nil, fmt.Errorf("pull secret %q does not contain credentials for registry %q", pullSecretRef.Name, host)
+}
+
+func cacheKey(repository, manifestDigest string) string {
+	return repository + "@" + manifestDigest
+}
+
+func chartLayer(manifest *ocispecv1.Manifest) (*ocispecv1.Descriptor, error) {
+	var layer *ocispecv1.Descriptor
+	for i := range manifest.Layers {
+		if manifest.Layers[i].MediaType != MediaTypeHelmChartContent {
+			continue
+		}
+		if layer != nil {
+			return nil, fmt.Errorf("found more than one layer with media type %q", MediaTypeHelmChartContent)
+		}
+		layer = &manifest.Layers[i]
+	}
+
+	if layer == nil {
+		return nil, fmt.Errorf("found no layer with media type %q", MediaTypeHelmChartContent)
+	}
+	if err := layer.Digest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid digest of chart layer: %w", err)
+	}
+	if layer.Size <= 0 || layer.Size > maxChartSize {
+		return nil, fmt.Errorf("size of chart layer must be in the range (0, %d], got %d", maxChartSize, layer.Size)
+	}
+	return layer, nil
+}
+
+type credentials struct {
+	username string
+	password string
+}
+
+// registryClient is a minimal client for the OCI distribution API supporting anonymous, basic and token
+// authentication.
+type registryClient struct {
+	httpClient    *http.Client
+	baseURL       string
+	credentials   *credentials
+	authorization string
+}
+
+func (c *registryClient) get(ctx context.Context, path, accept string, maxSize int64) ([]byte, error) {
+	response, err := c.request(ctx, http.MethodGet, path, accept)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %q", response.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxSize {
+		return nil, fmt.Errorf("response exceeds the maximum size of %d bytes", maxSize)
+	}
+	return body, nil
+}
+
+func (c *registryClient) head(ctx context.Context, path, accept string) error {
+	response, err := c.request(ctx, http.MethodHead, path, accept)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %q", response.Status)
+	}
+	return nil
+}
+
+// request performs the given request and authenticates it if the registry responds with an authentication challenge.
+func (c *registryClient) request(ctx context.Context, method, path, accept string) (*http.Response, error) {
+	response, err := c.do(ctx, method, c.baseURL+"/"+path, accept, c.authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusUnauthorized {
+		return response, nil
+	}
+
+	challenge := response.Header.Get("WWW-Authenticate")
+	_ = response.Body.Close()
+
+	if c.authorization, err = c.authorize(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, method, c.baseURL+"/"+path, accept, c.authorization)
+}
+
+func (c *registryClient) do(ctx context.Context, method, url, accept, authorization string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	return c.httpClient.Do(request)
+}
+
+// authorize computes the value of the authorization header for the given authentication challenge.
+func (c *registryClient) authorize(ctx context.Context, challenge string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.credentials == nil {
+			return "", fmt.Errorf("registry requires basic authentication but no pull secret is configured")
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(c.credentials.username+":"+c.credentials.password)), nil
+
+	case "bearer":
+		token, err := c.fetchToken(ctx, params)
+		if err != nil {
+			return "", fmt.Errorf("failed fetching registry token: %w", err)
+		}
+		return "Bearer " + token, nil
+
+	default:
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+func (c *registryClient) fetchToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid realm %q", params["realm"])
+	}
+
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if value := params[key]; value != "" {
+			query.Set(key, value)
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.credentials != nil {
+		request.SetBasicAuth(c.credentials.username, c.credentials.password)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response status %q", response.Status)
+	}
+
+	token := &struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxManifestSize)).Decode(token); err != nil {
+		return "", err
+	}
+
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("token response does not contain a token")
+}
+
+// parseChallenge parses a WWW-Authenticate header value like `Bearer realm="https://auth.example.com",service="foo"`
+// into the authentication scheme and its parameters.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[key] = value[1:]
+				break
+			}
+			params[key], rest = value[1:end+1], value[end+2:]
+		} else {
+			params[key], rest, _ = strings.Cut(value, ",")
+		}
+
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+	}
+
+	return scheme, params
+}
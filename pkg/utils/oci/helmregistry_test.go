@@ -0,0 +1,295 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+// testRegistry is a minimal stand-in for an OCI registry which requires token authentication.
+type testRegistry struct {
+	server    *httptest.Server
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	requests  atomic.Int32
+	// headRequests counts the HEAD requests, i.e., the requests which do not transfer content.
+	headRequests atomic.Int32
+}
+
+func newTestRegistry() *testRegistry {
+	r := &testRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(r.serve))
+	return r
+}
+
+func (r *testRegistry) host() string {
+	return strings.TrimPrefix(r.server.URL, "https://")
+}
+
+func (r *testRegistry) push(tag string, chart []byte, mediaType string) digest.Digest {
+	chartDigest := digest.FromBytes(chart)
+	r.blobs[chartDigest.String()] = chart
+
+	manifest, err := json.Marshal(&ocispecv1.Manifest{
+		MediaType: ocispecv1.MediaTypeImageManifest,
+		Layers:    []ocispecv1.Descriptor{{MediaType: mediaType, Digest: chartDigest, Size: int64(len(chart))}},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	manifestDigest := digest.FromBytes(manifest)
+	r.manifests[tag] = manifest
+	r.manifests[manifestDigest.String()] = manifest
+	return manifestDigest
+}
+
+func (r *testRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	r.requests.Add(1)
+	if req.Method == http.MethodHead {
+		r.headRequests.Add(1)
+	}
+
+	if req.URL.Path == "/token" {
+		if username, password, ok := req.BasicAuth(); !ok || username != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if req.URL.Query().Get("scope") != "repository:charts/foo:pull" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"secret-token"}`))
+		return
+	}
+
+	if req.Header.Get("Authorization") != "Bearer secret-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:charts/foo:pull"`, r.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var content []byte
+	switch {
+	case strings.HasPrefix(req.URL.Path, "/v2/charts/foo/manifests/"):
+		content = r.manifests[strings.TrimPrefix(req.URL.Path, "/v2/charts/foo/manifests/")]
+	case strings.HasPrefix(req.URL.Path, "/v2/charts/foo/blobs/"):
+		content = r.blobs[strings.TrimPrefix(req.URL.Path, "/v2/charts/foo/blobs/")]
+	}
+
+	if content == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write(content)
+}
+
+var _ = Describe("HelmRegistry", func() {
+	var (
+		ctx = context.TODO()
+
+		registry    *testRegistry
+		fakeClient  client.Client
+		ociRegistry Interface
+
+		chart      = []byte("chart-archive")
+		pullSecret *corev1.Secret
+		repository *Repository
+	)
+
+	BeforeEach(func() {
+		registry = newTestRegistry()
+		DeferCleanup(registry.server.Close)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		ociRegistry = &helmRegistry{
+			secretReader: fakeClient,
+			httpClient:   registry.server.Client(),
+			scheme:       "https",
+			cache:        cache.NewLRUExpireCache(cacheSize),
+		}
+
+		pullSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "garden"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + registry.host() + `":{"auth":"dXNlcjpwYXNz"}}}`),
+			},
+		}
+		Expect(fakeClient.Create(ctx, pullSecret)).To(Succeed())
+
+		repository = &Repository{
+			Repository:    registry.host() + "/charts/foo",
+			Tag:           "1.0.0",
+			PullSecretRef: &corev1.LocalObjectReference{Name: pullSecret.Name},
+		}
+	})
+
+	Describe("#Pull", func() {
+		It("should pull the chart by tag", func() {
+			registry.push("1.0.0", chart, MediaTypeHelmChartContent)
+
+			Expect(ociRegistry.Pull(ctx, repository)).To(Equal(chart))
+		})
+
+		It("should pull the chart by digest and serve it from the cache afterwards", func() {
+			repository.Tag = ""
+			repository.Digest = registry.push("1.0.0", chart, MediaTypeHelmChartContent).String()
+
+			Expect(ociRegistry.Pull(ctx, repository)).To(Equal(chart))
+			requests := registry.requests.Load()
+
+			Expect(ociRegistry.Pull(ctx, repository)).To(Equal(chart))
+			// unauthorized manifest request, token request, authorized manifest request (all without fetching the chart)
+			Expect(registry.requests.Load()).To(Equal(requests + 3))
+			Expect(registry.headRequests.Load()).To(BeEquivalentTo(2))
+		})
+
+		It("should not serve cached charts pulled by digest without valid credentials", func() {
+			repository.Tag = ""
+			repository.Digest = registry.push("1.0.0", chart, MediaTypeHelmChartContent).String()
+
+			Expect(ociRegistry.Pull(ctx, repository)).To(Equal(chart))
+
+			repository.PullSecretRef = nil
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("failed checking access to manifest")))
+
+			pullSecret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{"` + registry.host() + `":{"username":"user","password":"wrong"}}}`)
+			Expect(fakeClient.Update(ctx, pullSecret)).To(Succeed())
+			repository.PullSecretRef = &corev1.LocalObjectReference{Name: pullSecret.Name}
+			_, err = ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("failed checking access to manifest")))
+		})
+
+		It("should only fetch the manifest for cached charts pulled by tag", func() {
+			registry.push("1.0.0", chart, MediaTypeHelmChartContent)
+
+			Expect(ociRegistry.Pull(ctx, repository)).To(Equal(chart))
+			requests := registry.requests.Load()
+
+			Expect(ociRegistry.Pull(ctx, repository)).To(Equal(chart))
+			// unauthorized manifest request, token request, authorized manifest request
+			Expect(registry.requests.Load()).To(Equal(requests + 3))
+		})
+
+		It("should fail if the manifest does not match the digest", func() {
+			registry.push("1.0.0", chart, MediaTypeHelmChartContent)
+			repository.Digest = digest.FromString("foo").String()
+			registry.manifests[repository.Digest] = registry.manifests["1.0.0"]
+
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("digest of manifest")))
+		})
+
+		It("should fail if the chart does not match the digest of the layer", func() {
+			chartDigest := digest.FromBytes(chart).String()
+			registry.push("1.0.0", chart, MediaTypeHelmChartContent)
+			registry.blobs[chartDigest] = []byte("manipulated!!")
+
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("digest of chart")))
+		})
+
+		It("should fail if the manifest does not contain a chart layer", func() {
+			registry.push("1.0.0", chart, ocispecv1.MediaTypeImageLayerGzip)
+
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("found no layer with media type")))
+		})
+
+		It("should fail if the chart does not exist", func() {
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("404 Not Found")))
+		})
+
+		It("should fail without credentials", func() {
+			registry.push("1.0.0", chart, MediaTypeHelmChartContent)
+			repository.PullSecretRef = nil
+
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("failed fetching registry token")))
+		})
+
+		It("should fail if the pull secret does not contain credentials for the registry", func() {
+			pullSecret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{"other.example.com":{"username":"user","password":"pass"}}}`)
+			Expect(fakeClient.Update(ctx, pullSecret)).To(Succeed())
+
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("does not contain credentials for registry")))
+		})
+
+		It("should fail if the pull secret has the wrong type", func() {
+			pullSecret.Type = corev1.SecretTypeOpaque
+			Expect(fakeClient.Delete(ctx, pullSecret)).To(Succeed())
+			pullSecret.ResourceVersion = ""
+			Expect(fakeClient.Create(ctx, pullSecret)).To(Succeed())
+
+			_, err := ociRegistry.Pull(ctx, repository)
+			Expect(err).To(MatchError(ContainSubstring("must be of type")))
+		})
+
+		It("should fail for invalid references", func() {
+			_, err := ociRegistry.Pull(ctx, &Repository{Repository: "foo", Tag: "1.0.0"})
+			Expect(err).To(MatchError(ContainSubstring("must be of the form")))
+
+			_, err = ociRegistry.Pull(ctx, &Repository{Repository: "example.com/foo"})
+			Expect(err).To(MatchError(ContainSubstring("either a tag or a digest")))
+
+			_, err = ociRegistry.Pull(ctx, &Repository{Repository: "example.com/foo", Digest: "sha256:foo"})
+			Expect(err).To(MatchError(ContainSubstring("invalid digest")))
+		})
+	})
+
+	Describe("#NewHelmRegistry", func() {
+		It("should use an HTTP client with a timeout", func() {
+			Expect(NewHelmRegistry(fakeClient).(*helmRegistry).httpClient.Timeout).To(Equal(requestTimeout))
+		})
+	})
+
+	Describe("#parseChallenge", func() {
+		It("should parse the scheme and the parameters", func() {
+			scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry",scope="repository:foo:pull,push"`)
+			Expect(scheme).To(Equal("Bearer"))
+			Expect(params).To(Equal(map[string]string{
+				"realm":   "https://auth.example.com/token",
+				"service": "registry",
+				"scope":   "repository:foo:pull,push",
+			}))
+		})
+
+		It("should parse unquoted parameters", func() {
+			scheme, params := parseChallenge(`Basic realm=registry`)
+			Expect(scheme).To(Equal("Basic"))
+			Expect(params).To(Equal(map[string]string{"realm": "registry"}))
+		})
+	})
+})
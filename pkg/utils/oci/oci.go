@@ -0,0 +1,67 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Interface is an interface for pulling Helm charts from OCI registries.
+type Interface interface {
+	// Pull pulls the Helm chart referenced by the given repository and returns the chart archive.
+	Pull(ctx context.Context, repository *Repository) ([]byte, error)
+}
+
+// Repository is a reference to a Helm chart stored as artifact in an OCI registry.
+type Repository struct {
+	// Repository is the OCI repository of the chart including the registry host, e.g. `registry.example.com/charts/foo`.
+	Repository string `json:"repository"`
+	// Tag is the tag of the chart artifact. Either a tag or a digest must be specified.
+	Tag string `json:"tag,omitempty"`
+	// Digest is the digest of the chart artifact's manifest. If specified, the pulled artifact is verified against it.
+	Digest string `json:"digest,omitempty"`
+	// PullSecretRef is a reference to a secret of type `kubernetes.io/dockerconfigjson` in the `garden` namespace of
+	// the seed cluster which contains the credentials for the registry.
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty"`
+}
+
+// String returns the string representation of the repository reference.
+func (r *Repository) String() string {
+	if r.Digest != "" {
+		return r.Repository + "@" + r.Digest
+	}
+	return r.Repository + ":" + r.Tag
+}
+
+// reference returns the reference of the chart artifact's manifest. The digest takes precedence over the tag.
+func (r *Repository) reference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+// hostAndName splits the repository into the registry host and the name of the repository within the registry.
+func (r *Repository) hostAndName() (string, string, error) {
+	host, name, ok := strings.Cut(r.Repository, "/")
+	if !ok || host == "" || name == "" {
+		return "", "", fmt.Errorf("repository %q must be of the form <registry-host>/<name>", r.Repository)
+	}
+	return host, name, nil
+}
@@ -47,7 +47,14 @@ func (m *manager) Cleanup(ctx context.Context) error {
 
 		fns = append(fns, func(ctx context.Context) error {
 			m.logger.Info("Deleting stale secret", "namespace", secret.Namespace, "name", secret.Name)
-			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
+			if err := client.IgnoreNotFound(m.client.Delete(ctx, &secret)); err != nil {
+				return err
+			}
+
+			if len(ExternalDataKeys(&secret)) > 0 && m.dataStore != nil {
+				return m.dataStore.Delete(ctx, secret.Namespace, secret.Name)
+			}
+			return nil
 		})
 	}
 
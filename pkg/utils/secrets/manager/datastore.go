@@ -0,0 +1,219 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DataStore is a storage backend for sensitive data of secrets managed by the secrets manager, e.g. an external
+// KMS or secret store. By default (i.e., if no DataStore is configured), all data is stored in the Kubernetes Secrets.
+// Data keys are only persisted in the DataStore if requested with the StoreDataExternally option.
+type DataStore interface {
+	// Write persists the given data for the secret with the given namespace and name.
+	Write(ctx context.Context, namespace, name string, data map[string][]byte) error
+	// Read returns the data persisted for the secret with the given namespace and name. It returns an error if no data
+	// is found.
+	Read(ctx context.Context, namespace, name string) (map[string][]byte, error)
+	// Delete removes the data persisted for the secret with the given namespace and name. It does not return an error
+	// if no data is found.
+	Delete(ctx context.Context, namespace, name string) error
+	// DeleteAll removes the data persisted for all secrets in the given namespace. It is meant to be called when the
+	// namespace is deleted for good, i.e., not when its secrets are restored elsewhere (e.g., during a migration).
+	DeleteAll(ctx context.Context, namespace string) error
+}
+
+type fileDataStore struct {
+	dir string
+}
+
+// NewFileDataStore returns a DataStore which persists the data in files in the given directory. It serves as a
+// reference implementation and is meant for testing purposes.
+func NewFileDataStore(dir string) DataStore {
+	return &fileDataStore{dir: dir}
+}
+
+func (f *fileDataStore) path(namespace, name string) string {
+	return filepath.Join(f.dir, namespace, name+".json")
+}
+
+func (f *fileDataStore) Write(_ context.Context, namespace, name string, data map[string][]byte) error {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	path := f.path(namespace, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "."+name+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func (f *fileDataStore) Read(_ context.Context, namespace, name string) (map[string][]byte, error) {
+	content, err := os.ReadFile(f.path(namespace, name))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string][]byte{}
+	return data, json.Unmarshal(content, &data)
+}
+
+func (f *fileDataStore) Delete(_ context.Context, namespace, name string) error {
+	if err := os.Remove(f.path(namespace, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *fileDataStore) DeleteAll(_ context.Context, namespace string) error {
+	return os.RemoveAll(filepath.Join(f.dir, namespace))
+}
+
+// splitExternalData moves the given keys from the data of the given secret into a separate map which is meant to be
+// persisted in the DataStore. The moved keys are recorded in the AnnotationKeyExternalDataKeys annotation. The type
+// of the secret is recomputed based on the remaining data.
+func splitExternalData(secret *corev1.Secret, keys []string) map[string][]byte {
+	external := make(map[string][]byte, len(keys))
+
+	for _, key := range keys {
+		if value, ok := secret.Data[key]; ok {
+			external[key] = value
+			delete(secret.Data, key)
+		}
+	}
+
+	if len(external) == 0 {
+		return nil
+	}
+
+	externalKeys := make([]string, 0, len(external))
+	for key := range external {
+		externalKeys = append(externalKeys, key)
+	}
+	sort.Strings(externalKeys)
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[AnnotationKeyExternalDataKeys] = strings.Join(externalKeys, ",")
+	secret.Type = secretTypeForData(secret.Data)
+
+	return external
+}
+
+// ExternalDataKeys returns the data keys of the given secret which are persisted in a DataStore.
+func ExternalDataKeys(secret *corev1.Secret) []string {
+	if value := secret.Annotations[AnnotationKeyExternalDataKeys]; value != "" {
+		return strings.Split(value, ",")
+	}
+	return nil
+}
+
+// CreateSecret creates the given secret in the system. If a DataStore is given, the given data keys are removed from
+// the secret and persisted in the DataStore instead. The data is only written to the DataStore after the secret was
+// created successfully, otherwise the DataStore might contain data which does not belong to the secret in the system
+// (e.g., if the secret already exists). If writing the data fails, the incomplete secret is deleted again. On success,
+// the given secret contains the complete data.
+func CreateSecret(ctx context.Context, c client.Client, dataStore DataStore, secret *corev1.Secret, externalDataKeys []string) error {
+	var externalData map[string][]byte
+	if dataStore != nil {
+		externalData = splitExternalData(secret, externalDataKeys)
+	}
+
+	if err := c.Create(ctx, secret); err != nil {
+		return err
+	}
+
+	if len(externalData) == 0 {
+		return nil
+	}
+
+	if err := dataStore.Write(ctx, secret.Namespace, secret.Name, externalData); err != nil {
+		if deleteErr := c.Delete(ctx, secret); client.IgnoreNotFound(deleteErr) != nil {
+			return fmt.Errorf("failed writing external data of secret %s: %w (failed deleting incomplete secret: %v)", client.ObjectKeyFromObject(secret), err, deleteErr)
+		}
+		return fmt.Errorf("failed writing external data of secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte, len(externalData))
+	}
+	maps.Copy(secret.Data, externalData)
+	return nil
+}
+
+// LoadExternalData adds the data persisted in the given DataStore to the data of the given secret. It is a no-op if
+// the secret does not have externally stored data keys.
+func LoadExternalData(ctx context.Context, dataStore DataStore, secret *corev1.Secret) error {
+	keys := ExternalDataKeys(secret)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if dataStore == nil {
+		return fmt.Errorf("secret %s has externally stored data keys %v but no data store is configured", client.ObjectKeyFromObject(secret), keys)
+	}
+
+	data, err := dataStore.Read(ctx, secret.Namespace, secret.Name)
+	if err != nil {
+		return fmt.Errorf("failed reading external data of secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte, len(keys))
+	}
+
+	for _, key := range keys {
+		value, ok := data[key]
+		if !ok {
+			return fmt.Errorf("external data of secret %s does not contain key %q", client.ObjectKeyFromObject(secret), key)
+		}
+		secret.Data[key] = value
+	}
+
+	return nil
+}
+
+func (m *manager) loadExternalData(ctx context.Context, secret *corev1.Secret) error {
+	return LoadExternalData(ctx, m.dataStore, secret)
+}
@@ -0,0 +1,228 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("DataStore", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+
+		dataStore  DataStore
+		fakeClient client.Client
+		fakeClock  = testclock.NewFakeClock(time.Time{})
+	)
+
+	BeforeEach(func() {
+		dataStore = NewFileDataStore(GinkgoT().TempDir())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+	})
+
+	Describe("#NewFileDataStore", func() {
+		It("should write, read and delete data", func() {
+			data := map[string][]byte{"foo": []byte("bar")}
+
+			Expect(dataStore.Write(ctx, namespace, "secret", data)).To(Succeed())
+			Expect(dataStore.Read(ctx, namespace, "secret")).To(Equal(data))
+
+			Expect(dataStore.Delete(ctx, namespace, "secret")).To(Succeed())
+			_, err := dataStore.Read(ctx, namespace, "secret")
+			Expect(err).To(HaveOccurred())
+
+			Expect(dataStore.Delete(ctx, namespace, "secret")).To(Succeed())
+		})
+
+		It("should delete the data of all secrets in a namespace", func() {
+			data := map[string][]byte{"foo": []byte("bar")}
+
+			Expect(dataStore.Write(ctx, namespace, "secret1", data)).To(Succeed())
+			Expect(dataStore.Write(ctx, namespace, "secret2", data)).To(Succeed())
+			Expect(dataStore.Write(ctx, "other", "secret1", data)).To(Succeed())
+
+			Expect(dataStore.DeleteAll(ctx, namespace)).To(Succeed())
+			_, err := dataStore.Read(ctx, namespace, "secret1")
+			Expect(err).To(HaveOccurred())
+			_, err = dataStore.Read(ctx, namespace, "secret2")
+			Expect(err).To(HaveOccurred())
+			Expect(dataStore.Read(ctx, "other", "secret1")).To(Equal(data))
+
+			Expect(dataStore.DeleteAll(ctx, namespace)).To(Succeed())
+		})
+	})
+
+	Describe("#StoreDataExternally", func() {
+		var (
+			m      Interface
+			config *secretsutils.CertificateSecretConfig
+		)
+
+		BeforeEach(func() {
+			var err error
+			m, err = New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{DataStore: dataStore})
+			Expect(err).NotTo(HaveOccurred())
+
+			config = &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}
+		})
+
+		It("should persist the given data keys in the data store only", func() {
+			secret, err := m.Generate(ctx, config, StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Data).To(HaveKey(secretsutils.DataKeyPrivateKeyCA))
+
+			foundSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), foundSecret)).To(Succeed())
+			Expect(foundSecret.Data).To(HaveKey(secretsutils.DataKeyCertificateCA))
+			Expect(foundSecret.Data).NotTo(HaveKey(secretsutils.DataKeyPrivateKeyCA))
+			Expect(foundSecret.Annotations).To(HaveKeyWithValue("external-data-keys", secretsutils.DataKeyPrivateKeyCA))
+
+			Expect(dataStore.Read(ctx, namespace, secret.Name)).To(Equal(map[string][]byte{
+				secretsutils.DataKeyPrivateKeyCA: secret.Data[secretsutils.DataKeyPrivateKeyCA],
+			}))
+		})
+
+		It("should load the external data for existing secrets and sign certificates with them", func() {
+			secret, err := m.Generate(ctx, config, StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+			Expect(err).NotTo(HaveOccurred())
+
+			m, err = New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{DataStore: dataStore})
+			Expect(err).NotTo(HaveOccurred())
+
+			config = &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}
+			existingSecret, err := m.Generate(ctx, config, StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existingSecret.Name).To(Equal(secret.Name))
+			Expect(existingSecret.Data).To(Equal(secret.Data))
+
+			_, err = m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "server", CommonName: "server", CertType: secretsutils.ServerCert}, SignedByCA("ca"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should store the tls key externally and change the secret type", func() {
+			_, err := m.Generate(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "server", CommonName: "server", CertType: secretsutils.ServerCert}, SignedByCA("ca"), StoreDataExternally(secretsutils.DataKeyPrivateKey))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Data).To(HaveKey(secretsutils.DataKeyPrivateKey))
+
+			foundSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), foundSecret)).To(Succeed())
+			Expect(foundSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(foundSecret.Data).NotTo(HaveKey(secretsutils.DataKeyPrivateKey))
+		})
+
+		It("should delete the external data of stale secrets", func() {
+			secret, err := m.Generate(ctx, config, StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+			Expect(err).NotTo(HaveOccurred())
+
+			m, err = New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{DataStore: dataStore})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Cleanup(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(BeNotFoundError())
+			_, err = dataStore.Read(ctx, namespace, secret.Name)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should keep all data in the secret if no data store is configured", func() {
+			m, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{})
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := m.Generate(ctx, config, StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+			Expect(err).NotTo(HaveOccurred())
+			expectSecretWasCreated(ctx, fakeClient, secret)
+			Expect(secret.Data).To(HaveKey(secretsutils.DataKeyPrivateKeyCA))
+			Expect(secret.Annotations).NotTo(HaveKey("external-data-keys"))
+		})
+
+		It("should fail loading secrets with external data if no data store is configured", func() {
+			_, err := m.Generate(ctx, config, StoreDataExternally(secretsutils.DataKeyPrivateKeyCA))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{})
+			Expect(err).To(MatchError(ContainSubstring("no data store is configured")))
+		})
+	})
+
+	Describe("#CreateSecret", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = Secret(metav1.ObjectMeta{Name: "secret", Namespace: namespace}, map[string][]byte{"foo": []byte("bar"), "key": []byte("secret")})
+		})
+
+		It("should create the secret and persist the given data keys in the data store", func() {
+			Expect(CreateSecret(ctx, fakeClient, dataStore, secret, []string{"key"})).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{"foo": []byte("bar"), "key": []byte("secret")}))
+
+			foundSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), foundSecret)).To(Succeed())
+			Expect(foundSecret.Data).To(Equal(map[string][]byte{"foo": []byte("bar")}))
+			Expect(ExternalDataKeys(foundSecret)).To(ConsistOf("key"))
+
+			Expect(LoadExternalData(ctx, dataStore, foundSecret)).To(Succeed())
+			Expect(foundSecret.Data).To(Equal(secret.Data))
+		})
+
+		It("should not write to the data store if the secret already exists", func() {
+			Expect(fakeClient.Create(ctx, secret.DeepCopy())).To(Succeed())
+
+			Expect(CreateSecret(ctx, fakeClient, dataStore, secret, []string{"key"})).To(BeAlreadyExistsError())
+			_, err := dataStore.Read(ctx, namespace, secret.Name)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should delete the secret if writing to the data store fails", func() {
+			Expect(CreateSecret(ctx, fakeClient, &failingDataStore{}, secret, []string{"key"})).To(MatchError(ContainSubstring("fake")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(BeNotFoundError())
+		})
+
+		It("should keep all data in the secret if no data store is given", func() {
+			Expect(CreateSecret(ctx, fakeClient, nil, secret, []string{"key"})).To(Succeed())
+
+			foundSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), foundSecret)).To(Succeed())
+			Expect(foundSecret.Data).To(Equal(map[string][]byte{"foo": []byte("bar"), "key": []byte("secret")}))
+			Expect(ExternalDataKeys(foundSecret)).To(BeEmpty())
+		})
+	})
+})
+
+type failingDataStore struct {
+	DataStore
+}
+
+func (f *failingDataStore) Write(_ context.Context, _, _ string, _ map[string][]byte) error {
+	return fmt.Errorf("fake")
+}
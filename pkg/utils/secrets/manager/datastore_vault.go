@@ -0,0 +1,200 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// VaultDataStoreOptions contains the options for a DataStore backed by a HashiCorp Vault KV (version 2) secrets engine.
+type VaultDataStoreOptions struct {
+	// Server is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Server string
+	// MountPath is the path at which the KV (version 2) secrets engine is mounted.
+	MountPath string
+	// PathPrefix is prepended to the paths of all secrets in the secrets engine.
+	PathPrefix string
+	// TokenFile is the path of the file containing the Vault token. It is read for every request.
+	TokenFile string
+	// CAFile is the path of the file containing the CA bundle used to verify the certificate of the Vault server. If
+	// it is empty, the system's root CAs are used.
+	CAFile string
+}
+
+type vaultDataStore struct {
+	client     *http.Client
+	server     *url.URL
+	mountPath  string
+	pathPrefix string
+	tokenFile  string
+}
+
+// NewVaultDataStore returns a DataStore which persists the data in a HashiCorp Vault KV (version 2) secrets engine. The
+// data of a secret is stored at '<mount-path>/data/<path-prefix>/<namespace>/<name>'.
+func NewVaultDataStore(options VaultDataStoreOptions) (DataStore, error) {
+	server, err := url.Parse(options.Server)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing Vault server address: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if options.CAFile != "" {
+		caBundle, err := os.ReadFile(options.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading Vault CA bundle: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates found in Vault CA bundle %s", options.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &vaultDataStore{
+		client:     &http.Client{Transport: transport, Timeout: 30 * time.Second},
+		server:     server,
+		mountPath:  strings.Trim(options.MountPath, "/"),
+		pathPrefix: strings.Trim(options.PathPrefix, "/"),
+		tokenFile:  options.TokenFile,
+	}, nil
+}
+
+type vaultKVData struct {
+	Data map[string][]byte `json:"data"`
+}
+
+type vaultKVReadResponse struct {
+	Data vaultKVData `json:"data"`
+}
+
+type vaultKVListResponse struct {
+	Data struct {
+		Keys []string `json:"keys"`
+	} `json:"data"`
+}
+
+func (v *vaultDataStore) Write(ctx context.Context, namespace, name string, data map[string][]byte) error {
+	body, err := json.Marshal(vaultKVData{Data: data})
+	if err != nil {
+		return err
+	}
+
+	_, err = v.do(ctx, http.MethodPost, v.url("data", namespace, name), body)
+	return err
+}
+
+func (v *vaultDataStore) Read(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	body, err := v.do(ctx, http.MethodGet, v.url("data", namespace, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return nil, fmt.Errorf("no data found in Vault for secret %s/%s", namespace, name)
+	}
+
+	response := &vaultKVReadResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return nil, fmt.Errorf("failed decoding Vault response: %w", err)
+	}
+	return response.Data.Data, nil
+}
+
+func (v *vaultDataStore) Delete(ctx context.Context, namespace, name string) error {
+	// Deleting the metadata removes all versions of the secret.
+	_, err := v.do(ctx, http.MethodDelete, v.url("metadata", namespace, name), nil)
+	return err
+}
+
+func (v *vaultDataStore) DeleteAll(ctx context.Context, namespace string) error {
+	body, err := v.do(ctx, "LIST", v.url("metadata", namespace, ""), nil)
+	if err != nil || body == nil {
+		return err
+	}
+
+	response := &vaultKVListResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return fmt.Errorf("failed decoding Vault response: %w", err)
+	}
+
+	for _, key := range response.Data.Keys {
+		// Secrets are stored directly below the namespace, hence keys denoting sub-directories are not expected.
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+
+		if err := v.Delete(ctx, namespace, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *vaultDataStore) url(kind, namespace, name string) string {
+	u := *v.server
+	u.Path = path.Join(u.Path, "v1", v.mountPath, kind, v.pathPrefix, namespace, name)
+	return u.String()
+}
+
+// do performs the given request against the Vault API and returns the response body. It returns a nil body without
+// an error if the requested path does not exist.
+func (v *vaultDataStore) do(ctx context.Context, method, requestURL string, body []byte) ([]byte, error) {
+	token, err := os.ReadFile(v.tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading Vault token: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := v.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed sending %s request to Vault: %w", method, err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading Vault response: %w", err)
+	}
+
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case response.StatusCode < 200 || response.StatusCode > 299:
+		return nil, fmt.Errorf("unexpected status code %d for %s request to Vault: %s", response.StatusCode, method, strings.TrimSpace(string(responseBody)))
+	}
+
+	return responseBody, nil
+}
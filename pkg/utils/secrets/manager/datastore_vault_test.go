@@ -0,0 +1,161 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("VaultDataStore", func() {
+	const token = "s.vault-token"
+
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		server    *httptest.Server
+		tokenFile string
+		lock      sync.Mutex
+		kv        map[string]json.RawMessage
+
+		dataStore DataStore
+	)
+
+	BeforeEach(func() {
+		kv = map[string]json.RawMessage{}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			if r.Header.Get("X-Vault-Token") != token {
+				http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+				return
+			}
+
+			switch {
+			case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+				body, _ := io.ReadAll(r.Body)
+				kv[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")] = body
+				w.WriteHeader(http.StatusOK)
+			case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+				body, ok := kv[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")]
+				if !ok {
+					http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":` + string(body) + `}`))
+			case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+				delete(kv, strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/"))
+				w.WriteHeader(http.StatusNoContent)
+			case r.Method == "LIST" && strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+				prefix := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/"), "/") + "/"
+				keys := sets.New[string]()
+				for key := range kv {
+					if rest, ok := strings.CutPrefix(key, prefix); ok {
+						if before, _, isDir := strings.Cut(rest, "/"); isDir {
+							rest = before + "/"
+						}
+						keys.Insert(rest)
+					}
+				}
+				if keys.Len() == 0 {
+					http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+					return
+				}
+				response, _ := json.Marshal(map[string]interface{}{"data": map[string]interface{}{"keys": sets.List(keys)}})
+				_, _ = w.Write(response)
+			default:
+				http.Error(w, `{"errors":["unsupported"]}`, http.StatusMethodNotAllowed)
+			}
+		}))
+		DeferCleanup(server.Close)
+
+		tokenFile = filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte(token+"\n"), 0600)).To(Succeed())
+
+		var err error
+		dataStore, err = NewVaultDataStore(VaultDataStoreOptions{
+			Server:     server.URL,
+			MountPath:  "/secret/",
+			PathPrefix: "gardener",
+			TokenFile:  tokenFile,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should write, read and delete data", func() {
+		data := map[string][]byte{"foo": []byte("bar")}
+
+		Expect(dataStore.Write(ctx, namespace, "secret", data)).To(Succeed())
+		Expect(kv).To(HaveKey("gardener/shoot--foo--bar/secret"))
+		Expect(dataStore.Read(ctx, namespace, "secret")).To(Equal(data))
+
+		Expect(dataStore.Delete(ctx, namespace, "secret")).To(Succeed())
+		Expect(kv).To(BeEmpty())
+		_, err := dataStore.Read(ctx, namespace, "secret")
+		Expect(err).To(MatchError(ContainSubstring("no data found")))
+
+		Expect(dataStore.Delete(ctx, namespace, "secret")).To(Succeed())
+	})
+
+	It("should delete the data of all secrets in a namespace", func() {
+		data := map[string][]byte{"foo": []byte("bar")}
+
+		Expect(dataStore.Write(ctx, namespace, "ca", data)).To(Succeed())
+		Expect(dataStore.Write(ctx, namespace, "ca-etcd", data)).To(Succeed())
+		Expect(dataStore.Write(ctx, "shoot--foo--baz", "ca", data)).To(Succeed())
+
+		Expect(dataStore.DeleteAll(ctx, namespace)).To(Succeed())
+		Expect(kv).To(HaveLen(1))
+		Expect(kv).To(HaveKey("gardener/shoot--foo--baz/ca"))
+
+		Expect(dataStore.DeleteAll(ctx, namespace)).To(Succeed())
+	})
+
+	It("should read the token for every request", func() {
+		Expect(os.WriteFile(tokenFile, []byte("invalid"), 0600)).To(Succeed())
+		Expect(dataStore.Write(ctx, namespace, "secret", map[string][]byte{"foo": []byte("bar")})).To(MatchError(ContainSubstring("unexpected status code 403")))
+
+		Expect(os.WriteFile(tokenFile, []byte(token), 0600)).To(Succeed())
+		Expect(dataStore.Write(ctx, namespace, "secret", map[string][]byte{"foo": []byte("bar")})).To(Succeed())
+	})
+
+	It("should fail if the token file does not exist", func() {
+		Expect(os.Remove(tokenFile)).To(Succeed())
+		_, err := dataStore.Read(ctx, namespace, "secret")
+		Expect(err).To(MatchError(ContainSubstring("failed reading Vault token")))
+	})
+
+	It("should fail if the CA bundle does not contain certificates", func() {
+		caFile := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+		Expect(os.WriteFile(caFile, []byte("foo"), 0600)).To(Succeed())
+
+		_, err := NewVaultDataStore(VaultDataStoreOptions{Server: server.URL, MountPath: "secret", TokenFile: tokenFile, CAFile: caFile})
+		Expect(err).To(MatchError(ContainSubstring("no certificates found")))
+	})
+})
@@ -0,0 +1,88 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// CertificateExpiration contains the expiration time of a certificate or certificate authority managed by a secrets
+// manager.
+type CertificateExpiration struct {
+	// Name is the name of the certificate as passed to the secrets manager.
+	Name string
+	// CA specifies whether the certificate is a certificate authority.
+	CA bool
+	// ValidUntil is the time when the certificate expires.
+	ValidUntil time.Time
+}
+
+// CertificateExpirations returns the expiration times of the current certificates and certificate authorities which
+// are managed by the secrets manager with the given identity in the given namespace. Bundle secrets and secrets which
+// do not contain a certificate are ignored. The result is sorted by name.
+func CertificateExpirations(ctx context.Context, c client.Reader, namespace, identity string) ([]CertificateExpiration, error) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{
+		LabelKeyManagedBy:       LabelValueSecretsManager,
+		LabelKeyManagerIdentity: identity,
+	}); err != nil {
+		return nil, err
+	}
+
+	nameToNewestSecret := make(map[string]*corev1.Secret, len(secretList.Items))
+	for i, secret := range secretList.Items {
+		if secret.Labels[LabelKeyBundleFor] != "" || secret.Labels[LabelKeyValidUntilTime] == "" {
+			continue
+		}
+
+		name := secret.Labels[LabelKeyName]
+		if oldSecret, found := nameToNewestSecret[name]; !found || oldSecret.CreationTimestamp.Time.Before(secret.CreationTimestamp.Time) {
+			nameToNewestSecret[name] = &secretList.Items[i]
+		}
+	}
+
+	var expirations []CertificateExpiration
+	for name, secret := range nameToNewestSecret {
+		isCA := secret.Data[secretsutils.DataKeyCertificateCA] != nil &&
+			(secret.Data[secretsutils.DataKeyPrivateKeyCA] != nil || slices.Contains(ExternalDataKeys(secret), secretsutils.DataKeyPrivateKeyCA))
+		if !isCA && secret.Data[secretsutils.DataKeyCertificate] == nil {
+			continue
+		}
+
+		validUntil, err := strconv.ParseInt(secret.Labels[LabelKeyValidUntilTime], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed converting %s from label %s of secret %s to int64: %w", secret.Labels[LabelKeyValidUntilTime], LabelKeyValidUntilTime, client.ObjectKeyFromObject(secret), err)
+		}
+
+		expirations = append(expirations, CertificateExpiration{
+			Name:       name,
+			CA:         isCA,
+			ValidUntil: time.Unix(validUntil, 0).UTC(),
+		})
+	}
+
+	sort.Slice(expirations, func(i, j int) bool { return expirations[i].Name < expirations[j].Name })
+	return expirations, nil
+}
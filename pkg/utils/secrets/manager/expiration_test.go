@@ -0,0 +1,106 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+var _ = Describe("Expiration", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+
+		fakeClient client.Client
+
+		newSecret = func(name, secretName string, creationTimestamp time.Time, validUntil string, data map[string][]byte, extraLabels map[string]string) *corev1.Secret {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              secretName,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(creationTimestamp),
+					Labels: map[string]string{
+						"name":             name,
+						"managed-by":       "secrets-manager",
+						"manager-identity": identity,
+					},
+				},
+				Data: data,
+			}
+			if validUntil != "" {
+				secret.Labels["valid-until-time"] = validUntil
+			}
+			for k, v := range extraLabels {
+				secret.Labels[k] = v
+			}
+			return secret
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+	})
+
+	Describe("#CertificateExpirations", func() {
+		It("should return the expiration times of the newest certificates and certificate authorities", func() {
+			now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			for _, secret := range []*corev1.Secret{
+				newSecret("ca", "ca-old", now.Add(-time.Hour), "1700000000", map[string][]byte{"ca.crt": []byte("old"), "ca.key": []byte("old")}, nil),
+				newSecret("ca", "ca-new", now, "1900000000", map[string][]byte{"ca.crt": []byte("new"), "ca.key": []byte("new")}, nil),
+				newSecret("ca-external", "ca-external", now, "1800000000", map[string][]byte{"ca.crt": []byte("crt")}, nil),
+				newSecret("ca-bundle", "ca-bundle", now, "1900000000", map[string][]byte{"bundle.crt": []byte("bundle")}, map[string]string{"bundle-for": "ca"}),
+				newSecret("server", "server", now, "1750000000", map[string][]byte{"tls.crt": []byte("crt"), "tls.key": []byte("key")}, nil),
+				newSecret("kubeconfig", "kubeconfig", now, "", map[string][]byte{"kubeconfig": []byte("config")}, nil),
+				newSecret("password", "password", now, "1750000000", map[string][]byte{"password": []byte("secret")}, nil),
+			} {
+				Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+			}
+
+			caExternal := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "ca-external"}, caExternal)).To(Succeed())
+			metav1.SetMetaDataAnnotation(&caExternal.ObjectMeta, AnnotationKeyExternalDataKeys, "ca.key")
+			Expect(fakeClient.Update(ctx, caExternal)).To(Succeed())
+
+			otherManagerSecret := newSecret("other", "other", now, "1750000000", map[string][]byte{"tls.crt": []byte("crt")}, map[string]string{"manager-identity": "other"})
+			Expect(fakeClient.Create(ctx, otherManagerSecret)).To(Succeed())
+
+			Expect(CertificateExpirations(ctx, fakeClient, namespace, identity)).To(Equal([]CertificateExpiration{
+				{Name: "ca", CA: true, ValidUntil: time.Unix(1900000000, 0).UTC()},
+				{Name: "ca-external", CA: true, ValidUntil: time.Unix(1800000000, 0).UTC()},
+				{Name: "server", CA: false, ValidUntil: time.Unix(1750000000, 0).UTC()},
+			}))
+		})
+
+		It("should fail for invalid valid-until-time labels", func() {
+			Expect(fakeClient.Create(ctx, newSecret("server", "server", time.Now(), "foo", map[string][]byte{"tls.crt": []byte("crt")}, nil))).To(Succeed())
+
+			_, err := CertificateExpirations(ctx, fakeClient, namespace, identity)
+			Expect(err).To(MatchError(ContainSubstring("failed converting foo from label valid-until-time")))
+		})
+	})
+})
@@ -17,6 +17,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strconv"
 	"strings"
 	"time"
@@ -64,10 +65,12 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 			return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
 
-		secret, err = m.generateAndCreate(ctx, config, objectMeta)
+		secret, err = m.generateAndCreate(ctx, config, objectMeta, options)
 		if err != nil {
 			return nil, fmt.Errorf("failed generating and creating new secret %s for config %s: %w", client.ObjectKey{Name: objectMeta.Name, Namespace: objectMeta.Namespace}, config.GetName(), err)
 		}
+	} else if err := m.loadExternalData(ctx, secret); err != nil {
+		return nil, fmt.Errorf("failed loading external data for config %s: %w", config.GetName(), err)
 	}
 
 	if err := m.maintainLifetimeLabels(config, secret, desiredLabels, options.Validity); err != nil {
@@ -99,7 +102,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	return secret, nil
 }
 
-func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta, options *GenerateOptions) (*corev1.Secret, error) {
 	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
 	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
 		certConfig.CommonName = objectMeta.Name
@@ -115,8 +118,8 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
 	}
 
-	secret := Secret(objectMeta, dataMap)
-	if err := m.client.Create(ctx, secret); err != nil {
+	secret := Secret(objectMeta, maps.Clone(dataMap))
+	if err := CreateSecret(ctx, m.client, m.dataStore, secret, options.ExternalDataKeys); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
 			return nil, fmt.Errorf("failed creating new secret: %w", err)
 		}
@@ -124,6 +127,10 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		if err := m.client.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
 			return nil, fmt.Errorf("failed reading existing secret: %w", err)
 		}
+
+		if err := m.loadExternalData(ctx, secret); err != nil {
+			return nil, err
+		}
 	}
 
 	m.logger.Info("Generated new secret", "configName", config.GetName(), "secretName", secret.Name)
@@ -200,6 +207,10 @@ func (m *manager) storeOldSecrets(ctx context.Context, name, currentSecretName s
 		return nil
 	}
 
+	if err := m.loadExternalData(ctx, oldSecret); err != nil {
+		return err
+	}
+
 	return m.addToStore(oldSecret.Labels[LabelKeyName], oldSecret, old)
 }
 
@@ -343,7 +354,12 @@ func (m *manager) reconcileSecret(ctx context.Context, secret *corev1.Secret, la
 		return nil
 	}
 
-	return m.client.Patch(ctx, secret, patch)
+	if err := m.client.Patch(ctx, secret, patch); err != nil {
+		return err
+	}
+
+	// The patched object does not contain the external data anymore, hence it must be loaded again.
+	return m.loadExternalData(ctx, secret)
 }
 
 // GenerateOption is some configuration that modifies options for a Generate request.
@@ -364,6 +380,8 @@ type GenerateOptions struct {
 	// IgnoreConfigChecksumForCASecretName specifies whether the secret config checksum should be ignored when
 	// computing the secret name for CA secrets.
 	IgnoreConfigChecksumForCASecretName bool
+	// ExternalDataKeys specifies the data keys which should be persisted in the DataStore instead of the Secret.
+	ExternalDataKeys []string
 
 	signingCAChecksum *string
 	isBundleSecret    bool
@@ -525,6 +543,17 @@ func IgnoreConfigChecksumForCASecretName() GenerateOption {
 	}
 }
 
+// StoreDataExternally returns a function which sets the 'ExternalDataKeys' field to the given data keys. The keys are
+// only persisted in the DataStore if one is configured for the manager, otherwise this option has no effect. Note that
+// the externally stored data is not part of the Secret in the system, hence, it must not be required by components
+// mounting the Secret. Secrets returned by the manager always contain the complete data.
+func StoreDataExternally(keys ...string) GenerateOption {
+	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
+		options.ExternalDataKeys = keys
+		return nil
+	}
+}
+
 func isBundleSecret() GenerateOption {
 	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
 		options.isBundleSecret = true
@@ -65,6 +65,10 @@ const (
 	// instead of generating a fresh secret with the same name.
 	LabelKeyUseDataForName = "secrets-manager-use-data-for-name"
 
+	// AnnotationKeyExternalDataKeys is a constant for a key of an annotation on a Secret describing the comma-separated
+	// list of data keys which are not part of the Secret but persisted in the external DataStore.
+	AnnotationKeyExternalDataKeys = "external-data-keys"
+
 	// LabelValueTrue is a constant for a value of a label on a Secret describing the value 'true'.
 	LabelValueTrue = "true"
 	// LabelValueSecretsManager is a constant for a value of a label on a Secret describing the value 'secret-manager'.
@@ -80,6 +84,7 @@ type (
 		store                       secretStore
 		logger                      logr.Logger
 		client                      client.Client
+		dataStore                   DataStore
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
@@ -106,6 +111,9 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// DataStore is an optional storage backend for sensitive data. If set, the data keys requested with the
+		// StoreDataExternally option are persisted in this store instead of the Kubernetes Secrets.
+		DataStore DataStore
 	}
 )
 
@@ -137,6 +145,7 @@ func New(
 		clock:                       clock,
 		logger:                      logger.WithValues("namespace", namespace),
 		client:                      c,
+		dataStore:                   rotation.DataStore,
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
@@ -177,6 +186,10 @@ func (m *manager) initialize(ctx context.Context, rotation Config) error {
 
 	// Check if the secrets must be automatically renewed because they are about to expire.
 	for name, secret := range nameToNewestSecret {
+		if err := m.loadExternalData(ctx, &secret); err != nil {
+			return err
+		}
+
 		if isCASecret(secret.Data) && !rotation.CASecretAutoRotation {
 			continue
 		}
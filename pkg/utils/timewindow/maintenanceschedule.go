@@ -0,0 +1,351 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timewindow
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// minimumLookahead is the minimum number of days which are searched for the next occurrence of a maintenance range.
+// It covers a full week so that ranges restricted to a single weekday are always found.
+const minimumLookahead = 8
+
+var weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// ParseWeekday parses the given abbreviated day of the week (Mon, Tue, Wed, Thu, Fri, Sat, Sun).
+func ParseWeekday(value string) (time.Weekday, error) {
+	weekday, ok := weekdays[value]
+	if !ok {
+		return 0, fmt.Errorf("unknown weekday %q, must be one of Mon, Tue, Wed, Thu, Fri, Sat, Sun", value)
+	}
+	return weekday, nil
+}
+
+// MaintenanceRange is a maintenance time window which can be restricted to certain days of the week.
+type MaintenanceRange struct {
+	window   *MaintenanceTimeWindow
+	weekdays map[time.Weekday]struct{}
+	location *time.Location
+}
+
+// NewMaintenanceRange returns a maintenance range for the given time window. If weekdays are given, the range only
+// applies to days on which the time window begins on one of them, evaluated in the given location.
+func NewMaintenanceRange(window *MaintenanceTimeWindow, weekdays []time.Weekday, location *time.Location) *MaintenanceRange {
+	r := &MaintenanceRange{window: window, location: location}
+	if r.location == nil {
+		r.location = time.UTC
+	}
+	if len(weekdays) > 0 {
+		r.weekdays = make(map[time.Weekday]struct{}, len(weekdays))
+		for _, weekday := range weekdays {
+			r.weekdays[weekday] = struct{}{}
+		}
+	}
+	return r
+}
+
+// ParseMaintenanceRange takes a begin and an end of a time window in the maintenance format and a list of abbreviated
+// weekdays and returns a pointer to a MaintenanceRange structure. The weekdays are evaluated in the time zone of the
+// begin value.
+func ParseMaintenanceRange(begin, end string, weekdayValues []string) (*MaintenanceRange, error) {
+	window, err := ParseMaintenanceTimeWindow(begin, end)
+	if err != nil {
+		return nil, err
+	}
+
+	beginTime, err := time.Parse(maintenanceTimeLayout, begin)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse begin time: %s", err.Error())
+	}
+
+	var days []time.Weekday
+	for _, value := range weekdayValues {
+		weekday, err := ParseWeekday(value)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, weekday)
+	}
+
+	return NewMaintenanceRange(window, days, fixedLocation(beginTime)), nil
+}
+
+// fixedLocation returns a location with the fixed zone offset of the given time. time.Parse might return the local
+// location if its current offset matches, however, the local location could observe daylight saving time.
+func fixedLocation(t time.Time) *time.Location {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return time.UTC
+	}
+	return time.FixedZone("", offset)
+}
+
+// Window returns the time window of the maintenance range.
+func (r *MaintenanceRange) Window() *MaintenanceTimeWindow {
+	return r.window
+}
+
+// WithWindow returns a new maintenance range with the given <window> (weekdays and location will be kept).
+func (r *MaintenanceRange) WithWindow(window *MaintenanceTimeWindow) *MaintenanceRange {
+	return &MaintenanceRange{window: window, weekdays: r.weekdays, location: r.location}
+}
+
+// String returns the string representation of the maintenance range.
+func (r *MaintenanceRange) String() string {
+	if len(r.weekdays) == 0 {
+		return r.window.String()
+	}
+
+	var days []time.Weekday
+	for weekday := range r.weekdays {
+		days = append(days, weekday)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+
+	return fmt.Sprintf("%s, weekdays=%v", r.window, days)
+}
+
+// WeekdaysAt returns the days of the week (in UTC) on which the given time of the day (in UTC) is within an
+// occurrence of the range. If the range is not restricted to certain weekdays, all days of the week are returned.
+func (r *MaintenanceRange) WeekdaysAt(hour, minute, second int) []time.Weekday {
+	var (
+		result []time.Weekday
+		seen   = make(map[time.Weekday]struct{}, 7)
+		// 2023-01-01 is a Sunday, the day before is checked as well because its occurrence might overlap with Sunday.
+		day = time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	for i := 0; i < 8; i++ {
+		if occ, ok := r.occurrence(day); ok {
+			for _, t := range []time.Time{
+				time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, time.UTC),
+				time.Date(day.Year(), day.Month(), day.Day()+1, hour, minute, second, 0, time.UTC),
+			} {
+				if _, ok := seen[t.Weekday()]; !ok && occ.contains(t) {
+					seen[t.Weekday()] = struct{}{}
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if _, ok := seen[weekday]; ok {
+			result = append(result, weekday)
+		}
+	}
+	return result
+}
+
+// occurrence returns the interval of the range which begins on the given (UTC) day. It returns false if the range does
+// not apply to this day.
+func (r *MaintenanceRange) occurrence(day time.Time) (interval, bool) {
+	i := interval{begin: r.window.adjustedBegin(day), end: r.window.adjustedEnd(day)}
+	if r.weekdays != nil {
+		if _, ok := r.weekdays[i.begin.In(r.location).Weekday()]; !ok {
+			return interval{}, false
+		}
+	}
+	return i, true
+}
+
+// Blackout is a period in which no maintenance operations must be performed.
+type Blackout struct {
+	begin time.Time
+	end   time.Time
+}
+
+// NewBlackout returns a blackout period from <begin> (inclusive) until <end> (exclusive).
+func NewBlackout(begin, end time.Time) Blackout {
+	return Blackout{begin: begin.UTC(), end: end.UTC()}
+}
+
+// Contains returns true in case the given time is within the blackout period.
+func (b Blackout) Contains(t time.Time) bool {
+	return !t.Before(b.begin) && t.Before(b.end)
+}
+
+// MaintenanceSchedule combines multiple maintenance ranges and blackout periods. Maintenance operations can be
+// performed whenever one of the ranges applies and no blackout period is active.
+type MaintenanceSchedule struct {
+	ranges    []*MaintenanceRange
+	blackouts []Blackout
+}
+
+// AlwaysMaintenanceSchedule is a MaintenanceSchedule that contains all durations.
+var AlwaysMaintenanceSchedule = NewMaintenanceSchedule([]*MaintenanceRange{NewMaintenanceRange(AlwaysTimeWindow, nil, nil)}, nil)
+
+// NewMaintenanceSchedule returns a maintenance schedule for the given ranges and blackout periods.
+func NewMaintenanceSchedule(ranges []*MaintenanceRange, blackouts []Blackout) *MaintenanceSchedule {
+	return &MaintenanceSchedule{ranges: ranges, blackouts: blackouts}
+}
+
+// Ranges returns the maintenance ranges of the schedule.
+func (s *MaintenanceSchedule) Ranges() []*MaintenanceRange {
+	return s.ranges
+}
+
+// Blackouts returns the blackout periods of the schedule.
+func (s *MaintenanceSchedule) Blackouts() []Blackout {
+	return s.blackouts
+}
+
+// HasBlackoutBetween returns true in case one of the blackout periods overlaps with the given period.
+func (s *MaintenanceSchedule) HasBlackoutBetween(from, until time.Time) bool {
+	for _, b := range s.blackouts {
+		if b.begin.Before(until) && b.end.After(from) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the string representation of the maintenance schedule.
+func (s *MaintenanceSchedule) String() string {
+	return fmt.Sprintf("ranges=%v, blackouts=%d", s.ranges, len(s.blackouts))
+}
+
+// Contains returns true in case the given time is within one of the maintenance ranges and not within a blackout
+// period.
+func (s *MaintenanceSchedule) Contains(tTime time.Time) bool {
+	t := tTime.UTC().Truncate(time.Second)
+
+	for _, b := range s.blackouts {
+		if b.Contains(t) {
+			return false
+		}
+	}
+
+	day := t.AddDate(0, 0, -1)
+	for i := 0; i < 2; i++ {
+		for _, r := range s.ranges {
+			if occ, ok := r.occurrence(day); ok && occ.contains(t) {
+				return true
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return false
+}
+
+// RandomDurationUntilNext computes the duration until a random time within the next maintenance interval of the
+// schedule, i.e. the next occurrence of one of its ranges, cut by blackout periods.
+// The <from> parameter is the time from which the calculation for the next maintenance interval shall be performed
+// (typically: time.Now()).
+// The <shiftBeginToFromIfContained> parameter controls whether the beginning of the maintenance interval should be
+// changed to <from> if <from> is already inside a maintenance interval (otherwise, it has no effect). As a
+// consequence, this will return a random duration from <from> until the end of the current maintenance interval.
+func (s *MaintenanceSchedule) RandomDurationUntilNext(from time.Time, shiftBeginToFromIfContained bool) time.Duration {
+	from = from.UTC()
+
+	var (
+		next    *interval
+		foundOn time.Time
+		horizon = s.lookaheadHorizon(from)
+	)
+
+	for day := from.AddDate(0, 0, -1); !day.After(horizon); day = day.AddDate(0, 0, 1) {
+		// Intervals shifted by blackouts might begin later than intervals of the following day, hence, one more day is
+		// checked after a candidate has been found.
+		if !foundOn.IsZero() && day.Sub(foundOn) > 24*time.Hour {
+			break
+		}
+
+		for _, r := range s.ranges {
+			occ, ok := r.occurrence(day)
+			if !ok {
+				continue
+			}
+
+			for _, candidate := range occ.subtract(s.blackouts) {
+				if shiftBeginToFromIfContained && candidate.contains(from) {
+					candidate.begin = from
+				} else if !candidate.begin.After(from) {
+					continue
+				}
+
+				if next == nil || candidate.begin.Before(next.begin) {
+					c := candidate
+					next = &c
+				}
+				if foundOn.IsZero() {
+					foundOn = day
+				}
+			}
+		}
+	}
+
+	if next == nil {
+		return horizon.Sub(from)
+	}
+
+	delta := next.end.Sub(next.begin)
+	return time.Duration(int64(next.begin.Sub(from)) + RandomFunc(0, delta.Nanoseconds()))
+}
+
+// lookaheadHorizon returns the last day which is searched for the next maintenance interval. It covers at least a week
+// and all configured blackout periods.
+func (s *MaintenanceSchedule) lookaheadHorizon(from time.Time) time.Time {
+	horizon := from.AddDate(0, 0, minimumLookahead)
+	for _, b := range s.blackouts {
+		if end := b.end.AddDate(0, 0, minimumLookahead); end.After(horizon) {
+			horizon = end
+		}
+	}
+	return horizon
+}
+
+type interval struct {
+	begin time.Time
+	end   time.Time
+}
+
+func (i interval) contains(t time.Time) bool {
+	return !t.Before(i.begin) && !t.After(i.end)
+}
+
+// subtract cuts the given blackout periods out of the interval and returns the remaining parts.
+func (i interval) subtract(blackouts []Blackout) []interval {
+	remaining := []interval{i}
+
+	for _, b := range blackouts {
+		var next []interval
+		for _, r := range remaining {
+			if !b.begin.Before(r.end) || !b.end.After(r.begin) {
+				next = append(next, r)
+				continue
+			}
+			if b.begin.After(r.begin) {
+				next = append(next, interval{begin: r.begin, end: b.begin})
+			}
+			if b.end.Before(r.end) {
+				next = append(next, interval{begin: b.end, end: r.end})
+			}
+		}
+		remaining = next
+	}
+
+	return remaining
+}
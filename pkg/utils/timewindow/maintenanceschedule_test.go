@@ -0,0 +1,163 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timewindow_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+var _ = Describe("MaintenanceSchedule", func() {
+	var (
+		// 2023-10-16 is a Monday.
+		monday = func(hour, minute int) time.Time {
+			return time.Date(2023, 10, 16, hour, minute, 0, 0, time.UTC)
+		}
+		day = func(offset, hour, minute int) time.Time {
+			return monday(hour, minute).AddDate(0, 0, offset)
+		}
+
+		mustParseRange = func(begin, end string, weekdays ...string) *MaintenanceRange {
+			r, err := ParseMaintenanceRange(begin, end, weekdays)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return r
+		}
+	)
+
+	Describe("#ParseWeekday", func() {
+		It("should parse abbreviated weekdays", func() {
+			Expect(ParseWeekday("Mon")).To(Equal(time.Monday))
+			Expect(ParseWeekday("Sun")).To(Equal(time.Sunday))
+		})
+
+		It("should fail for unknown weekdays", func() {
+			_, err := ParseWeekday("Monday")
+			Expect(err).To(MatchError(ContainSubstring(`unknown weekday "Monday"`)))
+		})
+	})
+
+	Describe("#ParseMaintenanceRange", func() {
+		It("should fail for invalid times", func() {
+			_, err := ParseMaintenanceRange("foo", "010000+0000", nil)
+			Expect(err).To(MatchError(ContainSubstring("could not parse begin time")))
+		})
+
+		It("should fail for invalid weekdays", func() {
+			_, err := ParseMaintenanceRange("000000+0000", "010000+0000", []string{"Foo"})
+			Expect(err).To(MatchError(ContainSubstring(`unknown weekday "Foo"`)))
+		})
+	})
+
+	DescribeTable("#Contains",
+		func(schedule *MaintenanceSchedule, t time.Time, expected bool) {
+			Expect(schedule.Contains(t)).To(Equal(expected))
+		},
+
+		Entry("daily range, contained",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, nil), day(1, 22, 30), true),
+		Entry("daily range, not contained",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, nil), day(1, 21, 30), false),
+		Entry("weekday range, contained on matching day",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000", "Mon")}, nil), monday(22, 30), true),
+		Entry("weekday range, not contained on other day",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000", "Mon")}, nil), day(1, 22, 30), false),
+		Entry("weekday range spanning midnight, contained on the following day",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("230000+0000", "010000+0000", "Mon")}, nil), day(1, 0, 30), true),
+		Entry("weekday range spanning midnight, not contained after the previous day",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("230000+0000", "010000+0000", "Mon")}, nil), monday(0, 30), false),
+		Entry("weekday range evaluated in the time zone of begin, contained",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("010000+0200", "020000+0200", "Tue")}, nil), monday(23, 30), true),
+		Entry("weekday range evaluated in the time zone of begin, not contained",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("010000+0200", "020000+0200", "Tue")}, nil), day(1, 23, 30), false),
+		Entry("multiple ranges, contained in additional range",
+			NewMaintenanceSchedule([]*MaintenanceRange{
+				mustParseRange("220000+0000", "230000+0000", "Mon"),
+				mustParseRange("020000+0000", "040000+0000", "Sat", "Sun"),
+			}, nil), day(5, 3, 0), true),
+		Entry("multiple ranges, not contained",
+			NewMaintenanceSchedule([]*MaintenanceRange{
+				mustParseRange("220000+0000", "230000+0000", "Mon"),
+				mustParseRange("020000+0000", "040000+0000", "Sat", "Sun"),
+			}, nil), day(4, 3, 0), false),
+		Entry("blackout, not contained",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, []Blackout{NewBlackout(monday(22, 0), day(1, 0, 0))}), monday(22, 30), false),
+		Entry("blackout, contained after blackout has ended",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, []Blackout{NewBlackout(monday(22, 0), day(1, 0, 0))}), day(1, 22, 30), true),
+		Entry("always schedule",
+			AlwaysMaintenanceSchedule, monday(12, 0), true),
+	)
+
+	DescribeTable("#RandomDurationUntilNext",
+		func(schedule *MaintenanceSchedule, shiftBeginToFromIfContained bool, from time.Time, expected time.Duration) {
+			randomFunc := RandomFunc
+			defer func() { RandomFunc = randomFunc }()
+			RandomFunc = func(_ int64, delta int64) int64 {
+				return delta
+			}
+
+			Expect(schedule.RandomDurationUntilNext(from, shiftBeginToFromIfContained)).To(Equal(expected))
+		},
+
+		Entry("weekday range, next occurrence in the following week",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000", "Mon")}, nil), false, day(1, 10, 0), 6*24*time.Hour+13*time.Hour),
+		Entry("multiple ranges, earliest occurrence is chosen",
+			NewMaintenanceSchedule([]*MaintenanceRange{
+				mustParseRange("220000+0000", "230000+0000", "Mon"),
+				mustParseRange("020000+0000", "030000+0000", "Wed"),
+			}, nil), false, day(1, 10, 0), 17*time.Hour),
+		Entry("blackout cutting the beginning of the next occurrence",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, []Blackout{NewBlackout(monday(22, 0), monday(22, 30))}), false, monday(12, 0), 11*time.Hour),
+		Entry("blackout spanning multiple days",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, []Blackout{NewBlackout(monday(0, 0), day(3, 0, 0))}), false, monday(12, 0), 3*24*time.Hour+11*time.Hour),
+		Entry("contained, keep begin as is",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, nil), false, monday(22, 15), 24*time.Hour+45*time.Minute),
+		Entry("contained, shift begin",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, nil), true, monday(22, 15), 45*time.Minute),
+		Entry("within blackout, shift begin",
+			NewMaintenanceSchedule([]*MaintenanceRange{mustParseRange("220000+0000", "230000+0000")}, []Blackout{NewBlackout(monday(22, 0), monday(22, 30))}), true, monday(22, 15), 45*time.Minute),
+		Entry("no ranges",
+			NewMaintenanceSchedule(nil, nil), false, monday(12, 0), 8*24*time.Hour),
+	)
+	DescribeTable("#WeekdaysAt",
+		func(r *MaintenanceRange, hour, minute int, expected []time.Weekday) {
+			Expect(r.WeekdaysAt(hour, minute, 0)).To(Equal(expected))
+		},
+
+		Entry("no weekdays", mustParseRange("220000+0000", "230000+0000"), 22, 30,
+			[]time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}),
+		Entry("same day in UTC", mustParseRange("220000+0000", "230000+0000", "Mon", "Thu"), 22, 30, []time.Weekday{time.Monday, time.Thursday}),
+		Entry("time after midnight", mustParseRange("230000+0000", "010000+0000", "Sat"), 0, 30, []time.Weekday{time.Sunday}),
+		Entry("time zone shifting the begin to the previous day", mustParseRange("003000+0100", "013000+0100", "Mon"), 23, 45, []time.Weekday{time.Sunday}),
+		Entry("time zone shifting the time to the next day", mustParseRange("233000+0100", "020000+0100", "Mon"), 0, 15, []time.Weekday{time.Tuesday}),
+	)
+
+	Describe("#HasBlackoutBetween", func() {
+		schedule := NewMaintenanceSchedule(nil, []Blackout{NewBlackout(day(2, 0, 0), day(3, 0, 0))})
+
+		It("should return true if a blackout overlaps with the period", func() {
+			Expect(schedule.HasBlackoutBetween(monday(12, 0), day(2, 1, 0))).To(BeTrue())
+			Expect(schedule.HasBlackoutBetween(day(2, 12, 0), day(4, 0, 0))).To(BeTrue())
+		})
+
+		It("should return false if no blackout overlaps with the period", func() {
+			Expect(schedule.HasBlackoutBetween(monday(12, 0), day(2, 0, 0))).To(BeFalse())
+			Expect(schedule.HasBlackoutBetween(day(3, 0, 0), day(4, 0, 0))).To(BeFalse())
+		})
+	})
+})
@@ -17,11 +17,19 @@ package timewindow
 import (
 	"fmt"
 	"hash/crc32"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/robfig/cron"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// blackoutLookahead is the duration after now in which blackout periods are excluded from schedules.
+const blackoutLookahead = minimumLookahead * 24 * time.Hour
+
 // MutateScheduleFunc is a function for mutating the schedule based on the maintenance time window and UID.
 type MutateScheduleFunc func(string, MaintenanceTimeWindow, types.UID) string
 
@@ -54,14 +62,48 @@ func DetermineSchedule(
 
 // RandomizeWithinTimeWindow computes a random time (based on the provided UID) within the provided time window.
 func RandomizeWithinTimeWindow(scheduleFormat string, window MaintenanceTimeWindow, uid types.UID) string {
+	randomTime := randomTimeWithinTimeWindow(window, uid)
+	return fmt.Sprintf(scheduleFormat, randomTime.Minute(), randomTime.Hour())
+}
+
+// RandomizeWithinMaintenanceRange returns a function which computes a random time (based on the provided UID) within
+// the time window like RandomizeWithinTimeWindow. If the range is restricted to certain weekdays, the day-of-week field
+// of the resulting cron schedule is set to the (UTC) weekdays on which the random time is within the range. As cron
+// matches either the day-of-month or the day-of-week field if both are restricted, the day-of-month field is reset to
+// "*" in this case.
+func RandomizeWithinMaintenanceRange(r *MaintenanceRange) MutateScheduleFunc {
+	return func(scheduleFormat string, window MaintenanceTimeWindow, uid types.UID) string {
+		var (
+			randomTime = randomTimeWithinTimeWindow(window, uid)
+			schedule   = fmt.Sprintf(scheduleFormat, randomTime.Minute(), randomTime.Hour())
+			fields     = strings.Fields(schedule)
+		)
+
+		if r.weekdays == nil || len(fields) != 5 {
+			return schedule
+		}
+
+		var days []string
+		for _, weekday := range r.WeekdaysAt(randomTime.Hour(), randomTime.Minute(), randomTime.Second()) {
+			days = append(days, strconv.Itoa(int(weekday)))
+		}
+		if len(days) == 0 {
+			return schedule
+		}
+
+		fields[2], fields[4] = "*", strings.Join(days, ",")
+		return strings.Join(fields, " ")
+	}
+}
+
+func randomTimeWithinTimeWindow(window MaintenanceTimeWindow, uid types.UID) *MaintenanceTime {
 	var (
 		windowBegin     = window.Begin()
 		windowInMinutes = uint32(window.Duration().Minutes())
 		randomMinutes   = int(crc32.ChecksumIEEE([]byte(uid)) % windowInMinutes)
-		randomTime      = windowBegin.Add(0, randomMinutes, 0)
 	)
 
-	return fmt.Sprintf(scheduleFormat, randomTime.Minute(), randomTime.Hour())
+	return windowBegin.Add(0, randomMinutes, 0)
 }
 
 // RandomizeWithinFirstHourOfTimeWindow computes a random time (based on the provided UID) within the first hour of the
@@ -75,3 +117,94 @@ func RandomizeWithinFirstHourOfTimeWindow(scheduleFormat string, window Maintena
 
 	return fmt.Sprintf(scheduleFormat, randomTime.Minute(), randomTime.Hour())
 }
+
+// ScheduleOutsideBlackouts restricts the given (UTC) cron schedule to the days which are not covered by one of the
+// given blackout periods overlapping with the next days after <now>. The result is a recurring schedule which only
+// changes when a blackout period enters this lookahead or ends, i.e., it is stable across reconciliations and stays
+// valid if it is not re-computed in time.
+// As cron matches either the day-of-month or the day-of-week field if both are restricted, the weekdays of the blacked
+// out days are excluded if the schedule is restricted to certain weekdays, and the days of the month otherwise. If no
+// day remains, the schedule is restricted to the day on which the last of these blackout periods ends.
+func ScheduleOutsideBlackouts(schedule string, blackouts []Blackout, now time.Time) (string, error) {
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return "", fmt.Errorf("could not parse schedule %q: %w", schedule, err)
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return "", fmt.Errorf("schedule %q must consist of five fields", schedule)
+	}
+
+	now = now.UTC()
+
+	var (
+		blackedOutDays, blackedOutWeekdays = sets.New[int](), sets.New[int]()
+		lastEnd                            time.Time
+	)
+
+	for _, b := range blackouts {
+		if !b.begin.Before(now.Add(blackoutLookahead)) || !b.end.After(now) {
+			continue
+		}
+
+		if b.end.After(lastEnd) {
+			lastEnd = b.end
+		}
+
+		// a month has at most 31 days, hence all (week)days are covered afterwards
+		day := time.Date(b.begin.Year(), b.begin.Month(), b.begin.Day(), 0, 0, 0, 0, time.UTC)
+		for i := 0; i < 31 && day.Before(b.end); i, day = i+1, day.AddDate(0, 0, 1) {
+			blackedOutDays.Insert(day.Day())
+			blackedOutWeekdays.Insert(int(day.Weekday()))
+		}
+	}
+
+	if lastEnd.IsZero() {
+		return schedule, nil
+	}
+
+	var (
+		index   = 2
+		allowed []int
+		err     error
+	)
+
+	if fields[4] == "*" {
+		// January has 31 days, hence all days of the month matching the field are found
+		allowed, err = matchingValues("0 0 "+fields[2]+" 1 *", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), 31, func(t time.Time) int { return t.Day() }, blackedOutDays)
+	} else {
+		index = 4
+		allowed, err = matchingValues("0 0 * * "+fields[4], time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), 7, func(t time.Time) int { return int(t.Weekday()) }, blackedOutWeekdays)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if len(allowed) == 0 {
+		return fmt.Sprintf("%s %s %d %d *", fields[0], fields[1], lastEnd.Day(), int(lastEnd.Month())), nil
+	}
+
+	values := make([]string, 0, len(allowed))
+	for _, value := range allowed {
+		values = append(values, strconv.Itoa(value))
+	}
+	fields[index] = strings.Join(values, ",")
+	return strings.Join(fields, " "), nil
+}
+
+// matchingValues returns the sorted values (computed by <value>) of the days within <days> days after <from> which
+// match the given schedule, excluding the given values.
+func matchingValues(schedule string, from time.Time, days int, value func(time.Time) int, excluded sets.Set[int]) ([]int, error) {
+	cronSchedule, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse schedule %q: %w", schedule, err)
+	}
+
+	values := sets.New[int]()
+	for t := cronSchedule.Next(from.Add(-time.Second)); !t.IsZero() && t.Before(from.AddDate(0, 0, days)); t = cronSchedule.Next(t) {
+		if v := value(t); !excluded.Has(v) {
+			values.Insert(v)
+		}
+	}
+	return sets.List(values), nil
+}
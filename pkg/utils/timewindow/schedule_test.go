@@ -15,6 +15,8 @@
 package timewindow_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -73,4 +75,79 @@ var _ = Describe("Schedule", func() {
 			Expect(RandomizeWithinFirstHourOfTimeWindow(scheduleFormat, *window, uid)).To(Equal("55 12"))
 		})
 	})
+	Describe("#RandomizeWithinMaintenanceRange", func() {
+		It("should behave like RandomizeWithinTimeWindow if no weekdays are configured", func() {
+			r, err := ParseMaintenanceRange("140000+0000", "220000+0000", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(RandomizeWithinMaintenanceRange(r)("%d %d */3 * *", *window, uid)).To(Equal("10 15 */3 * *"))
+		})
+
+		It("should restrict the schedule to the configured weekdays", func() {
+			r, err := ParseMaintenanceRange("140000+0000", "220000+0000", []string{"Mon", "Fri"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(RandomizeWithinMaintenanceRange(r)("%d %d */3 * *", *window, uid)).To(Equal("10 15 * * 1,5"))
+		})
+
+		It("should shift the weekdays to UTC", func() {
+			r, err := ParseMaintenanceRange("003000+0100", "005000+0100", []string{"Mon"})
+			Expect(err).NotTo(HaveOccurred())
+			window, err := ParseMaintenanceTimeWindow("003000+0100", "005000+0100")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(RandomizeWithinMaintenanceRange(r)("%d %d * * *", *window, types.UID("foo"))).To(MatchRegexp(`^\d+ 23 \* \* 0$`))
+		})
+	})
+
+	Describe("#ScheduleOutsideBlackouts", func() {
+		var (
+			// 2023-10-16 is a Monday.
+			now      = time.Date(2023, 10, 16, 12, 0, 0, 0, time.UTC)
+			schedule = "30 22 * * *"
+		)
+
+		It("should return the schedule if there are no blackouts", func() {
+			Expect(ScheduleOutsideBlackouts(schedule, nil, now)).To(Equal(schedule))
+		})
+
+		It("should return the schedule for distant and past blackouts", func() {
+			Expect(ScheduleOutsideBlackouts(schedule, []Blackout{
+				NewBlackout(now.AddDate(0, 1, 0), now.AddDate(0, 2, 0)),
+				NewBlackout(now.AddDate(0, -1, 0), now.AddDate(0, 0, -1)),
+			}, now)).To(Equal(schedule))
+		})
+
+		It("should exclude the days of the month of an active blackout", func() {
+			Expect(ScheduleOutsideBlackouts(schedule, []Blackout{NewBlackout(now.AddDate(0, 0, -1), now.AddDate(0, 0, 3))}, now)).To(Equal("30 22 1,2,3,4,5,6,7,8,9,10,11,12,13,14,20,21,22,23,24,25,26,27,28,29,30,31 * *"))
+		})
+
+		It("should only exclude matching days of the month of a blackout beginning within the lookahead", func() {
+			Expect(ScheduleOutsideBlackouts("30 22 */3 * *", []Blackout{NewBlackout(now.AddDate(0, 0, 2), now.AddDate(0, 0, 3))}, now)).To(Equal("30 22 1,4,7,10,13,16,22,25,28,31 * *"))
+		})
+
+		It("should return the same schedule until the blackout ends", func() {
+			blackouts := []Blackout{NewBlackout(now.AddDate(0, 0, 2), now.AddDate(0, 0, 4))}
+
+			expected, err := ScheduleOutsideBlackouts(schedule, blackouts, now)
+			Expect(err).NotTo(HaveOccurred())
+			for _, t := range []time.Time{now.Add(time.Hour), now.AddDate(0, 0, 1), now.AddDate(0, 0, 3)} {
+				Expect(ScheduleOutsideBlackouts(schedule, blackouts, t)).To(Equal(expected))
+			}
+			Expect(ScheduleOutsideBlackouts(schedule, blackouts, now.AddDate(0, 0, 5))).To(Equal(schedule))
+		})
+
+		It("should exclude the weekdays of a blackout if the schedule is restricted to weekdays", func() {
+			Expect(ScheduleOutsideBlackouts("30 22 * * 1,4", []Blackout{NewBlackout(now, now.AddDate(0, 0, 1))}, now)).To(Equal("30 22 * * 4"))
+		})
+
+		It("should restrict the schedule to the end of the blackouts if no day remains", func() {
+			Expect(ScheduleOutsideBlackouts("30 22 * * 1,4", []Blackout{NewBlackout(now.AddDate(0, 0, -1), now.AddDate(0, 0, 10))}, now)).To(Equal("30 22 26 10 *"))
+		})
+
+		It("should fail for an invalid schedule", func() {
+			_, err := ScheduleOutsideBlackouts("foo", []Blackout{NewBlackout(now, now.AddDate(0, 0, 1))}, now)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
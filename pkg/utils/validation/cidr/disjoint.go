@@ -33,25 +33,25 @@ func ValidateNetworkDisjointedness(fldPath *field.Path, shootNodes, shootPods, s
 	return allErrs
 }
 
+// ValidateSecondaryNetworkDisjointedness validates that the given secondary networks of dual-stack shoots and the seed
+// networks are disjoint. Networks of different IP families never intersect, i.e., the secondary networks are only
+// validated against the seed networks of the secondary IP family.
+func ValidateSecondaryNetworkDisjointedness(fldPath *field.Path, shootSecondaryNodes, shootSecondaryPods, shootSecondaryServices, seedNodes *string, seedPods, seedServices string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateOverlapWithSeedNetworks(fldPath.Child("secondaryNodes"), shootSecondaryNodes, "secondary node", seedNodes, seedPods, seedServices)...)
+	allErrs = append(allErrs, validateOverlapWithSeedNetworks(fldPath.Child("secondaryServices"), shootSecondaryServices, "secondary service", seedNodes, seedPods, seedServices)...)
+	allErrs = append(allErrs, validateOverlapWithSeedNetworks(fldPath.Child("secondaryPods"), shootSecondaryPods, "secondary pod", seedNodes, seedPods, seedServices)...)
+
+	return allErrs
+}
+
 func validateOverlapWithSeed(fldPath *field.Path, shootNetwork *string, networkType string, networkRequired bool, seedNodes *string, seedPods, seedServices string) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if shootNetwork != nil {
-		if NetworksIntersect(seedServices, *shootNetwork) {
-			allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with seed service network", networkType)))
-		}
-		if NetworksIntersect(seedPods, *shootNetwork) {
-			allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with seed pod network", networkType)))
-		}
-		if seedNodes != nil && NetworksIntersect(*seedNodes, *shootNetwork) {
-			allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with seed node network", networkType)))
-		}
-		if NetworksIntersect(v1beta1constants.DefaultVPNRange, *shootNetwork) {
-			allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with default vpn network (%s)", networkType, v1beta1constants.DefaultVPNRange)))
-		}
-		if NetworksIntersect(v1beta1constants.DefaultVPNRangeV6, *shootNetwork) {
-			allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with default vpn network (%s)", networkType, v1beta1constants.DefaultVPNRangeV6)))
-		}
+		allErrs = append(allErrs, validateOverlapWithSeedNetworks(fldPath, shootNetwork, networkType, seedNodes, seedPods, seedServices)...)
+		allErrs = append(allErrs, validateOverlapWithVPN(fldPath, *shootNetwork, networkType)...)
 	} else if networkRequired {
 		allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("%ss is required", networkType)))
 	}
@@ -59,6 +59,38 @@ func validateOverlapWithSeed(fldPath *field.Path, shootNetwork *string, networkT
 	return allErrs
 }
 
+func validateOverlapWithSeedNetworks(fldPath *field.Path, shootNetwork *string, networkType string, seedNodes *string, seedPods, seedServices string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shootNetwork == nil {
+		return allErrs
+	}
+
+	if NetworksIntersect(seedServices, *shootNetwork) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with seed service network", networkType)))
+	}
+	if NetworksIntersect(seedPods, *shootNetwork) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with seed pod network", networkType)))
+	}
+	if seedNodes != nil && NetworksIntersect(*seedNodes, *shootNetwork) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *shootNetwork, fmt.Sprintf("shoot %s network intersects with seed node network", networkType)))
+	}
+
+	return allErrs
+}
+
+func validateOverlapWithVPN(fldPath *field.Path, shootNetwork, networkType string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, vpnRange := range []string{v1beta1constants.DefaultVPNRange, v1beta1constants.DefaultVPNRangeV6} {
+		if NetworksIntersect(vpnRange, shootNetwork) {
+			allErrs = append(allErrs, field.Invalid(fldPath, shootNetwork, fmt.Sprintf("shoot %s network intersects with default vpn network (%s)", networkType, vpnRange)))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateShootNetworkDisjointedness validates that the given shoot network is disjoint.
 func ValidateShootNetworkDisjointedness(fldPath *field.Path, shootNodes, shootPods, shootServices *string, workerless bool) field.ErrorList {
 	var (
@@ -100,6 +132,40 @@ func ValidateShootNetworkDisjointedness(fldPath *field.Path, shootNodes, shootPo
 	return allErrs
 }
 
+// ValidateShootSecondaryNetworkDisjointedness validates that the given secondary networks of dual-stack shoots are
+// disjoint with each other and with the default VPN networks.
+func ValidateShootSecondaryNetworkDisjointedness(fldPath *field.Path, shootSecondaryNodes, shootSecondaryPods, shootSecondaryServices *string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, network := range []struct {
+		name        string
+		cidr        *string
+		networkType string
+	}{
+		{name: "secondaryNodes", cidr: shootSecondaryNodes, networkType: "secondary node"},
+		{name: "secondaryServices", cidr: shootSecondaryServices, networkType: "secondary service"},
+		{name: "secondaryPods", cidr: shootSecondaryPods, networkType: "secondary pod"},
+	} {
+		if network.cidr != nil {
+			allErrs = append(allErrs, validateOverlapWithVPN(fldPath.Child(network.name), *network.cidr, network.networkType)...)
+		}
+	}
+
+	if shootSecondaryPods != nil && shootSecondaryServices != nil && NetworksIntersect(*shootSecondaryPods, *shootSecondaryServices) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("secondaryServices"), *shootSecondaryServices, "shoot secondary pod network intersects with shoot secondary service network"))
+	}
+	if shootSecondaryNodes != nil {
+		if shootSecondaryPods != nil && NetworksIntersect(*shootSecondaryPods, *shootSecondaryNodes) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secondaryPods"), *shootSecondaryPods, "shoot secondary pod network intersects with shoot secondary node network"))
+		}
+		if shootSecondaryServices != nil && NetworksIntersect(*shootSecondaryServices, *shootSecondaryNodes) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secondaryServices"), *shootSecondaryServices, "shoot secondary service network intersects with shoot secondary node network"))
+		}
+	}
+
+	return allErrs
+}
+
 // NetworksIntersect returns true if the given network CIDRs intersect.
 func NetworksIntersect(cidr1, cidr2 string) bool {
 	c1 := NewCIDR(cidr1, field.NewPath(""))
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/gardener/gardener/pkg/utils/validation/cidr"
 )
 
@@ -753,4 +754,121 @@ var _ = Describe("utils", func() {
 			))
 		})
 	})
+
+	Describe("#ValidateSecondaryNetworkDisjointedness", func() {
+		var (
+			seedPodsCIDR     = "2001:0db8:11::/48"
+			seedServicesCIDR = "2001:0db8:12::/48"
+			seedNodesCIDR    = "2001:0db8:13::/48"
+		)
+
+		It("should pass the validation", func() {
+			var (
+				podsCIDR     = "2001:0db8:21::/48"
+				servicesCIDR = "2001:0db8:22::/108"
+				nodesCIDR    = "2001:0db8:23::/48"
+			)
+
+			errorList := ValidateSecondaryNetworkDisjointedness(
+				field.NewPath(""),
+				&nodesCIDR,
+				&podsCIDR,
+				&servicesCIDR,
+				&seedNodesCIDR,
+				seedPodsCIDR,
+				seedServicesCIDR,
+			)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should pass the validation for seed networks of another IP family", func() {
+			var (
+				podsCIDR     = "2001:0db8:21::/48"
+				servicesCIDR = "2001:0db8:22::/108"
+			)
+
+			errorList := ValidateSecondaryNetworkDisjointedness(
+				field.NewPath(""),
+				nil,
+				&podsCIDR,
+				&servicesCIDR,
+				nil,
+				"10.241.128.0/17",
+				"10.241.0.0/17",
+			)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should fail due to disjointedness", func() {
+			errorList := ValidateSecondaryNetworkDisjointedness(
+				field.NewPath(""),
+				&seedNodesCIDR,
+				&seedPodsCIDR,
+				&seedServicesCIDR,
+				&seedNodesCIDR,
+				seedPodsCIDR,
+				seedServicesCIDR,
+			)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("[].secondaryNodes"),
+				"Detail": Equal("shoot secondary node network intersects with seed node network"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("[].secondaryServices"),
+				"Detail": Equal("shoot secondary service network intersects with seed service network"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("[].secondaryPods"),
+				"Detail": Equal("shoot secondary pod network intersects with seed pod network"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootSecondaryNetworkDisjointedness", func() {
+		It("should pass the validation", func() {
+			var (
+				podsCIDR     = "2001:0db8:35a3::/113"
+				servicesCIDR = "2001:0db8:45a3::/113"
+				nodesCIDR    = "2001:0db8:55a3::/112"
+			)
+
+			Expect(ValidateShootSecondaryNetworkDisjointedness(field.NewPath(""), &nodesCIDR, &podsCIDR, &servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should pass the validation if secondary networks are unset", func() {
+			Expect(ValidateShootSecondaryNetworkDisjointedness(field.NewPath(""), nil, nil, nil)).To(BeEmpty())
+		})
+
+		It("should fail due to disjointedness", func() {
+			cidr := "2001:0db8:35a3::/113"
+
+			Expect(ValidateShootSecondaryNetworkDisjointedness(field.NewPath(""), &cidr, &cidr, &cidr)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("[].secondaryServices"),
+				"Detail": Equal("shoot secondary pod network intersects with shoot secondary service network"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("[].secondaryPods"),
+				"Detail": Equal("shoot secondary pod network intersects with shoot secondary node network"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("[].secondaryServices"),
+				"Detail": Equal("shoot secondary service network intersects with shoot secondary node network"),
+			}))))
+		})
+
+		It("should fail due to overlap with the default VPN network", func() {
+			podsCIDR := v1beta1constants.DefaultVPNRangeV6
+
+			Expect(ValidateShootSecondaryNetworkDisjointedness(field.NewPath(""), nil, &podsCIDR, nil)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("[].secondaryPods"),
+				"Detail": Equal("shoot secondary pod network intersects with default vpn network (" + v1beta1constants.DefaultVPNRangeV6 + ")"),
+			}))))
+		})
+	})
 })
@@ -43,6 +43,8 @@ var (
 	ConstraintK8sEqual128 *semver.Constraints
 	// ConstraintK8sGreaterEqual129 is a version constraint for versions >= 1.29.
 	ConstraintK8sGreaterEqual129 *semver.Constraints
+	// ConstraintK8sLess130 is a version constraint for versions < 1.30.
+	ConstraintK8sLess130 *semver.Constraints
 )
 
 func init() {
@@ -67,6 +69,8 @@ func init() {
 	utilruntime.Must(err)
 	ConstraintK8sGreaterEqual129, err = semver.NewConstraint(">= 1.29-0")
 	utilruntime.Must(err)
+	ConstraintK8sLess130, err = semver.NewConstraint("< 1.30-0")
+	utilruntime.Must(err)
 }
 
 // CompareVersions returns true if the constraint <version1> compared by <operator> to <version2>
@@ -305,6 +305,10 @@ func (e *ExtensionValidator) validateShoot(kindToTypesMap map[string]sets.Set[st
 		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ExtensionResource, extension.Type, fmt.Sprintf("extension type: %s", field.NewPath("spec", "extensions").Index(i).Child("type"))})
 	}
 
+	if kubeAPIServer := spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.EncryptionConfig != nil && kubeAPIServer.EncryptionConfig.Provider != nil && kubeAPIServer.EncryptionConfig.Provider.Type != core.EncryptionProviderTypeAESCBC {
+		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ExtensionResource, kubeAPIServer.EncryptionConfig.Provider.Type, fmt.Sprintf("%s encryption provider type: %s", message, field.NewPath("spec", "kubernetes", "kubeAPIServer", "encryptionConfig", "provider", "type"))})
+	}
+
 	for i, worker := range spec.Provider.Workers {
 		if worker.CRI != nil {
 			for j, cr := range worker.CRI.ContainerRuntimes {
@@ -295,6 +295,13 @@ var _ = Describe("ExtensionValidator", func() {
 					{Type: "foo1"},
 					{Type: "foo2"},
 				},
+				Kubernetes: core.Kubernetes{
+					KubeAPIServer: &core.KubeAPIServerConfig{
+						EncryptionConfig: &core.EncryptionConfig{
+							Provider: &core.EncryptionProvider{Type: "kms1"},
+						},
+					},
+				},
 				Networking: &core.Networking{
 					Type: pointer.String("foo3"),
 				},
@@ -329,6 +336,7 @@ var _ = Describe("ExtensionValidator", func() {
 				{extensionsv1alpha1.ControlPlaneResource, shoot.Spec.Provider.Type},
 				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Extensions[0].Type},
 				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Extensions[1].Type},
+				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.Provider.Type},
 				{extensionsv1alpha1.InfrastructureResource, shoot.Spec.Provider.Type},
 				{extensionsv1alpha1.NetworkResource, *shoot.Spec.Networking.Type},
 				{extensionsv1alpha1.OperatingSystemConfigResource, shoot.Spec.Provider.Workers[0].Machine.Image.Name},
@@ -65,22 +65,23 @@ func Register(plugins *admission.Plugins) {
 // ReferenceManager contains listers and admission handler.
 type ReferenceManager struct {
 	*admission.Handler
-	gardenCoreClient           internalversion.Interface
-	kubeClient                 kubernetes.Interface
-	dynamicClient              dynamic.Interface
-	authorizer                 authorizer.Authorizer
-	secretLister               kubecorev1listers.SecretLister
-	configMapLister            kubecorev1listers.ConfigMapLister
-	backupBucketLister         gardencorelisters.BackupBucketLister
-	cloudProfileLister         gardencorelisters.CloudProfileLister
-	seedLister                 gardencorelisters.SeedLister
-	shootLister                gardencorelisters.ShootLister
-	secretBindingLister        gardencorelisters.SecretBindingLister
-	projectLister              gardencorelisters.ProjectLister
-	quotaLister                gardencorelisters.QuotaLister
-	controllerDeploymentLister gardencorelisters.ControllerDeploymentLister
-	exposureClassLister        gardencorelisters.ExposureClassLister
-	readyFunc                  admission.ReadyFunc
+	gardenCoreClient             internalversion.Interface
+	kubeClient                   kubernetes.Interface
+	dynamicClient                dynamic.Interface
+	authorizer                   authorizer.Authorizer
+	secretLister                 kubecorev1listers.SecretLister
+	configMapLister              kubecorev1listers.ConfigMapLister
+	backupBucketLister           gardencorelisters.BackupBucketLister
+	cloudProfileLister           gardencorelisters.CloudProfileLister
+	namespacedCloudProfileLister gardencorelisters.NamespacedCloudProfileLister
+	seedLister                   gardencorelisters.SeedLister
+	shootLister                  gardencorelisters.ShootLister
+	secretBindingLister          gardencorelisters.SecretBindingLister
+	projectLister                gardencorelisters.ProjectLister
+	quotaLister                  gardencorelisters.QuotaLister
+	controllerDeploymentLister   gardencorelisters.ControllerDeploymentLister
+	exposureClassLister          gardencorelisters.ExposureClassLister
+	readyFunc                    admission.ReadyFunc
 }
 
 var (
@@ -130,6 +131,9 @@ func (r *ReferenceManager) SetInternalCoreInformerFactory(f gardencoreinformers.
 	cloudProfileInformer := f.Core().InternalVersion().CloudProfiles()
 	r.cloudProfileLister = cloudProfileInformer.Lister()
 
+	namespacedCloudProfileInformer := f.Core().InternalVersion().NamespacedCloudProfiles()
+	r.namespacedCloudProfileLister = namespacedCloudProfileInformer.Lister()
+
 	secretBindingInformer := f.Core().InternalVersion().SecretBindings()
 	r.secretBindingLister = secretBindingInformer.Lister()
 
@@ -150,6 +154,7 @@ func (r *ReferenceManager) SetInternalCoreInformerFactory(f gardencoreinformers.
 		shootInformer.Informer().HasSynced,
 		backupBucketInformer.Informer().HasSynced,
 		cloudProfileInformer.Informer().HasSynced,
+		namespacedCloudProfileInformer.Informer().HasSynced,
 		secretBindingInformer.Informer().HasSynced,
 		quotaInformer.Informer().HasSynced,
 		projectInformer.Informer().HasSynced,
@@ -200,6 +205,9 @@ func (r *ReferenceManager) ValidateInitialization() error {
 	if r.cloudProfileLister == nil {
 		return errors.New("missing cloud profile lister")
 	}
+	if r.namespacedCloudProfileLister == nil {
+		return errors.New("missing namespaced cloud profile lister")
+	}
 	if r.seedLister == nil {
 		return errors.New("missing seed lister")
 	}
@@ -615,6 +623,17 @@ func (r *ReferenceManager) ensureShootReferences(ctx context.Context, attributes
 		}
 	}
 
+	if (!equality.Semantic.DeepEqual(oldShoot.Spec.CloudProfile, shoot.Spec.CloudProfile) || !equality.Semantic.DeepEqual(oldShoot.Spec.CloudProfileName, shoot.Spec.CloudProfileName)) &&
+		shoot.Spec.CloudProfile != nil && shoot.Spec.CloudProfile.Kind == core.CloudProfileReferenceKindNamespacedCloudProfile {
+		namespacedCloudProfile, err := r.namespacedCloudProfileLister.NamespacedCloudProfiles(shoot.Namespace).Get(shoot.Spec.CloudProfile.Name)
+		if err != nil {
+			return err
+		}
+		if namespacedCloudProfile.Spec.Parent.Name != shoot.Spec.CloudProfileName {
+			return fmt.Errorf("parent %q of referenced NamespacedCloudProfile %q does not match cloud profile name %q", namespacedCloudProfile.Spec.Parent.Name, namespacedCloudProfile.Name, shoot.Spec.CloudProfileName)
+		}
+	}
+
 	if !equality.Semantic.DeepEqual(oldShoot.Spec.SeedName, shoot.Spec.SeedName) {
 		if shoot.Spec.SeedName != nil {
 			if _, err := r.seedLister.Get(*shoot.Spec.SeedName); err != nil {
@@ -690,6 +709,31 @@ func (r *ReferenceManager) ensureShootReferences(ctx context.Context, attributes
 		}
 	}
 
+	authorizationWebhookSecretName := helper.GetShootAuthorizationWebhookKubeconfigSecretName(shoot.Spec.Kubernetes.KubeAPIServer)
+	if authorizationWebhookSecretName != helper.GetShootAuthorizationWebhookKubeconfigSecretName(oldShoot.Spec.Kubernetes.KubeAPIServer) && len(authorizationWebhookSecretName) > 0 && shoot.DeletionTimestamp == nil {
+		if err := r.lookupSecret(ctx, shoot.Namespace, authorizationWebhookSecretName); err != nil {
+			return fmt.Errorf("failed to reference authorization webhook kubeconfig secret %w", err)
+		}
+	}
+
+	if shoot.DeletionTimestamp == nil {
+		if configMapName := helper.GetShootAuthorizationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); len(configMapName) > 0 && configMapName != helper.GetShootAuthorizationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) {
+			if err := r.lookupConfigMap(ctx, shoot.Namespace, configMapName); err != nil {
+				return fmt.Errorf("failed to reference authorization configuration configmap %w", err)
+			}
+		}
+
+		oldSecretNames := sets.New(helper.GetShootAuthorizationConfigurationKubeconfigSecretNames(oldShoot.Spec.Kubernetes.KubeAPIServer)...)
+		for _, secretName := range helper.GetShootAuthorizationConfigurationKubeconfigSecretNames(shoot.Spec.Kubernetes.KubeAPIServer) {
+			if oldSecretNames.Has(secretName) {
+				continue
+			}
+			if err := r.lookupSecret(ctx, shoot.Namespace, secretName); err != nil {
+				return fmt.Errorf("failed to reference authorization configuration kubeconfig secret %w", err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -806,6 +850,18 @@ func (r *ReferenceManager) lookupSecret(ctx context.Context, namespace, name str
 	return lookupResource(ctx, namespace, name, secretFromLister, secretFromClient)
 }
 
+func (r *ReferenceManager) lookupConfigMap(ctx context.Context, namespace, name string) error {
+	configMapFromLister := func(_ context.Context, namespace, name string) (runtime.Object, error) {
+		return r.configMapLister.ConfigMaps(namespace).Get(name)
+	}
+
+	configMapFromClient := func(ctx context.Context, namespace, name string) (runtime.Object, error) {
+		return r.kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, kubernetesclient.DefaultGetOptions())
+	}
+
+	return lookupResource(ctx, namespace, name, configMapFromLister, configMapFromClient)
+}
+
 func (r *ReferenceManager) lookupControllerDeployment(ctx context.Context, name string) error {
 	deploymentFromLister := func(_ context.Context, _, name string) (runtime.Object, error) {
 		return r.controllerDeploymentLister.Get(name)
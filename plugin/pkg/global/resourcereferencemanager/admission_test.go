@@ -291,7 +291,7 @@ var _ = Describe("resourcereferencemanager", func() {
 			MissingSecretWait = 0
 
 			project = projectBase
-			shoot = shootBase
+			shoot = *shootBase.DeepCopy()
 			backupBucket = backupBucketBase
 			backupEntry = backupEntryBase
 		})
@@ -576,6 +576,56 @@ var _ = Describe("resourcereferencemanager", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("should accept because the referenced namespaced cloud profile exists and has the correct parent", func() {
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().NamespacedCloudProfiles().Informer().GetStore().Add(&core.NamespacedCloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: shoot.Namespace},
+					Spec:       core.NamespacedCloudProfileSpec{Parent: core.CloudProfileReference{Kind: "CloudProfile", Name: shoot.Spec.CloudProfileName}},
+				})).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)).To(Succeed())
+
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, &user.DefaultInfo{Name: allowedUser})
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject because the referenced namespaced cloud profile does not exist", func() {
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)).To(Succeed())
+
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should reject because the parent of the referenced namespaced cloud profile does not match", func() {
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().NamespacedCloudProfiles().Informer().GetStore().Add(&core.NamespacedCloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: shoot.Namespace},
+					Spec:       core.NamespacedCloudProfileSpec{Parent: core.CloudProfileReference{Kind: "CloudProfile", Name: "other-profile"}},
+				})).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)).To(Succeed())
+
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+				Expect(err).To(MatchError(ContainSubstring("does not match cloud profile name")))
+			})
+
 			It("should reject because the referenced seed does not exist (create)", func() {
 				Expect(gardenCoreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
 				Expect(gardenCoreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
@@ -851,6 +901,159 @@ var _ = Describe("resourcereferencemanager", func() {
 
 				Expect(err).To(Not(HaveOccurred()))
 			})
+
+			Context("authorization webhook kubeconfig secret", func() {
+				BeforeEach(func() {
+					Expect(gardenCoreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(gardenCoreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(gardenCoreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+					Expect(kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)).To(Succeed())
+
+					if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+						shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{}
+					}
+					shoot.Spec.Kubernetes.KubeAPIServer.Authorization = &core.Authorization{
+						Webhook: &core.AuthorizationWebhook{KubeconfigSecretName: "authz-webhook"},
+					}
+				})
+
+				It("should reject because the referenced secret does not exist (create)", func() {
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(MatchError(ContainSubstring("failed to reference authorization webhook kubeconfig secret")))
+				})
+
+				It("should reject because the referenced secret does not exist (update)", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Kubernetes.KubeAPIServer.Authorization = nil
+
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(MatchError(ContainSubstring("failed to reference authorization webhook kubeconfig secret")))
+				})
+
+				It("should pass because the referenced secret was not changed", func() {
+					oldShoot := shoot.DeepCopy()
+
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(Not(HaveOccurred()))
+				})
+
+				It("should pass because the referenced secret exists", func() {
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, nil
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(Not(HaveOccurred()))
+				})
+			})
+
+			Context("structured authorization references", func() {
+				BeforeEach(func() {
+					Expect(gardenCoreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(gardenCoreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(gardenCoreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+					Expect(kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)).To(Succeed())
+
+					if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+						shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{}
+					}
+					shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization = &core.StructuredAuthorization{
+						ConfigMapName: "authz-config",
+						Kubeconfigs:   []core.AuthorizerKubeconfigReference{{AuthorizerName: "authz", SecretName: "authz-kubeconfig"}},
+					}
+				})
+
+				It("should reject because the referenced configmap does not exist", func() {
+					kubeClient.AddReactor("get", "configmaps", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(MatchError(ContainSubstring("failed to reference authorization configuration configmap")))
+				})
+
+				It("should reject because a referenced kubeconfig secret does not exist", func() {
+					kubeClient.AddReactor("get", "configmaps", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, nil
+					})
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(MatchError(ContainSubstring("failed to reference authorization configuration kubeconfig secret")))
+				})
+
+				It("should pass because the references were not changed", func() {
+					oldShoot := shoot.DeepCopy()
+
+					kubeClient.AddReactor("get", "configmaps", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(Not(HaveOccurred()))
+				})
+
+				It("should pass because the referenced configmap and secrets exist", func() {
+					kubeClient.AddReactor("get", "configmaps", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, nil
+					})
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, nil
+					})
+
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(Not(HaveOccurred()))
+				})
+			})
 		})
 
 		Context("tests for BackupBucket objects", func() {
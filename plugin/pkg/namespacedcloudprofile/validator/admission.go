@@ -0,0 +1,371 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameNamespacedCloudProfileValidator, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ValidateNamespacedCloudProfile contains listers and admission handler.
+type ValidateNamespacedCloudProfile struct {
+	*admission.Handler
+	cloudProfileLister gardencorelisters.CloudProfileLister
+	shootLister        gardencorelisters.ShootLister
+	readyFunc          admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalCoreInformerFactory(&ValidateNamespacedCloudProfile{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ValidateNamespacedCloudProfile admission plugin.
+func New() (*ValidateNamespacedCloudProfile, error) {
+	return &ValidateNamespacedCloudProfile{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (v *ValidateNamespacedCloudProfile) AssignReadyFunc(f admission.ReadyFunc) {
+	v.readyFunc = f
+	v.SetReadyFunc(f)
+}
+
+// SetInternalCoreInformerFactory gets Lister from SharedInformerFactory.
+func (v *ValidateNamespacedCloudProfile) SetInternalCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	cloudProfileInformer := f.Core().InternalVersion().CloudProfiles()
+	v.cloudProfileLister = cloudProfileInformer.Lister()
+
+	shootInformer := f.Core().InternalVersion().Shoots()
+	v.shootLister = shootInformer.Lister()
+
+	readyFuncs = append(readyFuncs, cloudProfileInformer.Informer().HasSynced, shootInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (v *ValidateNamespacedCloudProfile) ValidateInitialization() error {
+	if v.cloudProfileLister == nil {
+		return errors.New("missing cloudProfile lister")
+	}
+	if v.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	return nil
+}
+
+var _ admission.ValidationInterface = &ValidateNamespacedCloudProfile{}
+
+// Validate validates the NamespacedCloudProfile against its parent CloudProfile.
+func (v *ValidateNamespacedCloudProfile) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if v.readyFunc == nil {
+		v.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if !v.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than NamespacedCloudProfile
+	if a.GetKind().GroupKind() != core.Kind("NamespacedCloudProfile") {
+		return nil
+	}
+
+	// Ignore updates to status or other subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	namespacedCloudProfile, ok := a.GetObject().(*core.NamespacedCloudProfile)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("failed to convert resource into NamespacedCloudProfile object"))
+	}
+
+	var oldSpec *core.NamespacedCloudProfileSpec
+	if a.GetOperation() == admission.Update {
+		oldNamespacedCloudProfile, ok := a.GetOldObject().(*core.NamespacedCloudProfile)
+		if !ok {
+			return apierrors.NewInternalError(errors.New("failed to convert old resource into NamespacedCloudProfile object"))
+		}
+		oldSpec = &oldNamespacedCloudProfile.Spec
+	}
+
+	parentCloudProfile, err := v.cloudProfileLister.Get(namespacedCloudProfile.Spec.Parent.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("parent CloudProfile %q could not be found", namespacedCloudProfile.Spec.Parent.Name))
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get parent CloudProfile %q: %w", namespacedCloudProfile.Spec.Parent.Name, err))
+	}
+
+	allErrs := validateAgainstParent(&namespacedCloudProfile.Spec, oldSpec, &parentCloudProfile.Spec, field.NewPath("spec"))
+
+	if oldSpec != nil {
+		shoots, err := v.shootLister.Shoots(namespacedCloudProfile.Namespace).List(labels.Everything())
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not list shoots to verify that removed entries are not in use: %w", err))
+		}
+		allErrs = append(allErrs, validateRemovedEntriesNotInUse(namespacedCloudProfile.Name, &namespacedCloudProfile.Spec, oldSpec, &parentCloudProfile.Spec, shoots, field.NewPath("spec"))...)
+	}
+
+	if len(allErrs) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("%+v", allErrs))
+	}
+
+	return nil
+}
+
+// validateAgainstParent validates the given spec against the spec of the parent CloudProfile. Entries which are
+// unchanged compared to the old spec are not validated again: they were valid when they were added, and the parent may
+// have defined the same entries afterwards (which take precedence when merging).
+func validateAgainstParent(spec, oldSpec *core.NamespacedCloudProfileSpec, parentSpec *core.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldSpec == nil {
+		oldSpec = &core.NamespacedCloudProfileSpec{}
+	}
+
+	if spec.Kubernetes != nil {
+		var oldVersions []core.ExpirableVersion
+		if oldSpec.Kubernetes != nil {
+			oldVersions = oldSpec.Kubernetes.Versions
+		}
+
+		for i, version := range spec.Kubernetes.Versions {
+			idxPath := fldPath.Child("kubernetes", "versions").Index(i)
+
+			if oldVersion := findExpirableVersion(oldVersions, version.Version); oldVersion != nil && apiequality.Semantic.DeepEqual(*oldVersion, version) {
+				continue
+			}
+
+			parentVersion := findExpirableVersion(parentSpec.Kubernetes.Versions, version.Version)
+			if parentVersion == nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("version"), version.Version, "version must be present in the parent CloudProfile"))
+				continue
+			}
+			allErrs = append(allErrs, validateExpirationDateExtension(version, *parentVersion, idxPath.Child("expirationDate"))...)
+		}
+	}
+
+	for i, image := range spec.MachineImages {
+		idxPath := fldPath.Child("machineImages").Index(i)
+
+		var parentImage *core.MachineImage
+		for j := range parentSpec.MachineImages {
+			if parentSpec.MachineImages[j].Name == image.Name {
+				parentImage = &parentSpec.MachineImages[j]
+				break
+			}
+		}
+		if parentImage == nil {
+			continue
+		}
+
+		oldImageVersions := machineImageVersions(oldSpec.MachineImages, image.Name)
+
+		for j, version := range image.Versions {
+			if oldVersion := findExpirableVersion(oldImageVersions, version.Version); oldVersion != nil && apiequality.Semantic.DeepEqual(*oldVersion, version.ExpirableVersion) {
+				continue
+			}
+
+			for _, parentVersion := range parentImage.Versions {
+				if parentVersion.Version == version.Version {
+					allErrs = append(allErrs, validateExpirationDateExtension(version.ExpirableVersion, parentVersion.ExpirableVersion, idxPath.Child("versions").Index(j).Child("expirationDate"))...)
+					break
+				}
+			}
+		}
+	}
+
+	oldMachineTypeNames := machineTypeNames(oldSpec.MachineTypes)
+	for i, machineType := range spec.MachineTypes {
+		if oldMachineTypeNames.Has(machineType.Name) {
+			continue
+		}
+		for _, parentMachineType := range parentSpec.MachineTypes {
+			if parentMachineType.Name == machineType.Name {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("machineTypes").Index(i).Child("name"), fmt.Sprintf("machine type %q is already defined in the parent CloudProfile", machineType.Name)))
+				break
+			}
+		}
+	}
+
+	oldVolumeTypeNames := volumeTypeNames(oldSpec.VolumeTypes)
+	for i, volumeType := range spec.VolumeTypes {
+		if oldVolumeTypeNames.Has(volumeType.Name) {
+			continue
+		}
+		for _, parentVolumeType := range parentSpec.VolumeTypes {
+			if parentVolumeType.Name == volumeType.Name {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("volumeTypes").Index(i).Child("name"), fmt.Sprintf("volume type %q is already defined in the parent CloudProfile", volumeType.Name)))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateRemovedEntriesNotInUse ensures that machine types, volume types and machine image versions which are removed
+// from the NamespacedCloudProfile (and not provided by the parent CloudProfile) are not used by any shoot referencing it.
+func validateRemovedEntriesNotInUse(name string, spec, oldSpec *core.NamespacedCloudProfileSpec, parentSpec *core.CloudProfileSpec, shoots []*core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	removedMachineTypes := machineTypeNames(oldSpec.MachineTypes).
+		Difference(machineTypeNames(spec.MachineTypes)).
+		Difference(machineTypeNames(parentSpec.MachineTypes))
+	removedVolumeTypes := volumeTypeNames(oldSpec.VolumeTypes).
+		Difference(volumeTypeNames(spec.VolumeTypes)).
+		Difference(volumeTypeNames(parentSpec.VolumeTypes))
+	removedMachineImageVersions := map[string]sets.Set[string]{}
+	for _, oldImage := range oldSpec.MachineImages {
+		removedVersions := expirableVersionNames(machineImageVersions(oldSpec.MachineImages, oldImage.Name)).
+			Difference(expirableVersionNames(machineImageVersions(spec.MachineImages, oldImage.Name))).
+			Difference(expirableVersionNames(machineImageVersions(parentSpec.MachineImages, oldImage.Name)))
+		if removedVersions.Len() > 0 {
+			removedMachineImageVersions[oldImage.Name] = removedVersions
+		}
+	}
+
+	if removedMachineTypes.Len() == 0 && removedVolumeTypes.Len() == 0 && len(removedMachineImageVersions) == 0 {
+		return allErrs
+	}
+
+	for _, shoot := range shoots {
+		if shoot.Spec.CloudProfile == nil || shoot.Spec.CloudProfile.Kind != core.CloudProfileReferenceKindNamespacedCloudProfile ||
+			shoot.Spec.CloudProfile.Name != name || shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		for _, worker := range shoot.Spec.Provider.Workers {
+			if removedMachineTypes.Has(worker.Machine.Type) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("machineTypes"), fmt.Sprintf("unable to remove machine type %q - it is still in use by shoot %q by worker %q", worker.Machine.Type, shoot.Name, worker.Name)))
+			}
+
+			if image := worker.Machine.Image; image != nil && removedMachineImageVersions[image.Name].Has(image.Version) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("machineImages"), fmt.Sprintf("unable to remove machine image version '%s/%s' - it is still in use by shoot %q by worker %q", image.Name, image.Version, shoot.Name, worker.Name)))
+			}
+
+			volumeTypes := sets.New[string]()
+			if worker.Volume != nil && worker.Volume.Type != nil {
+				volumeTypes.Insert(*worker.Volume.Type)
+			}
+			for _, dataVolume := range worker.DataVolumes {
+				if dataVolume.Type != nil {
+					volumeTypes.Insert(*dataVolume.Type)
+				}
+			}
+			for _, volumeType := range sets.List(volumeTypes.Intersection(removedVolumeTypes)) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("volumeTypes"), fmt.Sprintf("unable to remove volume type %q - it is still in use by shoot %q by worker %q", volumeType, shoot.Name, worker.Name)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateExpirationDateExtension ensures that a version which is already defined in the parent CloudProfile is only
+// used to extend its expiration date.
+func validateExpirationDateExtension(version, parentVersion core.ExpirableVersion, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if parentVersion.ExpirationDate == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("version %q does not expire in the parent CloudProfile", version.Version)))
+	} else if version.ExpirationDate == nil {
+		allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("expiration date of version %q must be set to extend the one of the parent CloudProfile", version.Version)))
+	} else if !version.ExpirationDate.After(parentVersion.ExpirationDate.Time) {
+		allErrs = append(allErrs, field.Invalid(fldPath, version.ExpirationDate, fmt.Sprintf("expiration date of version %q must be later than the one of the parent CloudProfile (%s)", version.Version, parentVersion.ExpirationDate)))
+	}
+
+	return allErrs
+}
+
+func findExpirableVersion(versions []core.ExpirableVersion, version string) *core.ExpirableVersion {
+	for i := range versions {
+		if versions[i].Version == version {
+			return &versions[i]
+		}
+	}
+	return nil
+}
+
+func machineImageVersions(images []core.MachineImage, name string) []core.ExpirableVersion {
+	for _, image := range images {
+		if image.Name == name {
+			versions := make([]core.ExpirableVersion, 0, len(image.Versions))
+			for _, version := range image.Versions {
+				versions = append(versions, version.ExpirableVersion)
+			}
+			return versions
+		}
+	}
+	return nil
+}
+
+func expirableVersionNames(versions []core.ExpirableVersion) sets.Set[string] {
+	names := sets.New[string]()
+	for _, version := range versions {
+		names.Insert(version.Version)
+	}
+	return names
+}
+
+func machineTypeNames(machineTypes []core.MachineType) sets.Set[string] {
+	names := sets.New[string]()
+	for _, machineType := range machineTypes {
+		names.Insert(machineType.Name)
+	}
+	return names
+}
+
+func volumeTypeNames(volumeTypes []core.VolumeType) sets.Set[string] {
+	names := sets.New[string]()
+	for _, volumeType := range volumeTypes {
+		names.Insert(volumeType.Name)
+	}
+	return names
+}
@@ -0,0 +1,311 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/namespacedcloudprofile/validator"
+)
+
+var _ = Describe("validator", func() {
+	Describe("#Validate", func() {
+		var (
+			ctx                 = context.TODO()
+			admissionHandler    *ValidateNamespacedCloudProfile
+			coreInformerFactory gardencoreinformers.SharedInformerFactory
+
+			now     = metav1.Now()
+			later   = metav1.NewTime(now.Add(24 * time.Hour))
+			earlier = metav1.NewTime(now.Add(-24 * time.Hour))
+
+			parentCloudProfile     *core.CloudProfile
+			namespacedCloudProfile *core.NamespacedCloudProfile
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalCoreInformerFactory(coreInformerFactory)
+
+			parentCloudProfile = &core.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "parent"},
+				Spec: core.CloudProfileSpec{
+					Kubernetes: core.KubernetesSettings{
+						Versions: []core.ExpirableVersion{
+							{Version: "1.28.0", ExpirationDate: &now},
+							{Version: "1.27.0"},
+						},
+					},
+					MachineImages: []core.MachineImage{{
+						Name: "image",
+						Versions: []core.MachineImageVersion{
+							{ExpirableVersion: core.ExpirableVersion{Version: "1.0.0", ExpirationDate: &now}},
+						},
+					}},
+					MachineTypes: []core.MachineType{{Name: "type"}},
+					VolumeTypes:  []core.VolumeType{{Name: "volume"}},
+				},
+			}
+			namespacedCloudProfile = &core.NamespacedCloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-dev"},
+				Spec: core.NamespacedCloudProfileSpec{
+					Parent: core.CloudProfileReference{Kind: "CloudProfile", Name: "parent"},
+				},
+			}
+
+			Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(parentCloudProfile)).To(Succeed())
+		})
+
+		validate := func() error {
+			attrs := admission.NewAttributesRecord(namespacedCloudProfile, nil, core.Kind("NamespacedCloudProfile").WithVersion("version"), namespacedCloudProfile.Namespace, namespacedCloudProfile.Name, core.Resource("namespacedcloudprofiles").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			return admissionHandler.Validate(ctx, attrs, nil)
+		}
+
+		validateUpdate := func(oldNamespacedCloudProfile *core.NamespacedCloudProfile) error {
+			attrs := admission.NewAttributesRecord(namespacedCloudProfile, oldNamespacedCloudProfile, core.Kind("NamespacedCloudProfile").WithVersion("version"), namespacedCloudProfile.Namespace, namespacedCloudProfile.Name, core.Resource("namespacedcloudprofiles").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+			return admissionHandler.Validate(ctx, attrs, nil)
+		}
+
+		It("should ignore other kinds", func() {
+			attrs := admission.NewAttributesRecord(&core.Shoot{}, nil, core.Kind("Shoot").WithVersion("version"), "", "", core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should ignore status updates", func() {
+			namespacedCloudProfile.Spec.Parent.Name = "missing"
+			attrs := admission.NewAttributesRecord(namespacedCloudProfile, namespacedCloudProfile, core.Kind("NamespacedCloudProfile").WithVersion("version"), namespacedCloudProfile.Namespace, namespacedCloudProfile.Name, core.Resource("namespacedcloudprofiles").WithVersion("version"), "status", admission.Update, &metav1.UpdateOptions{}, false, nil)
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should forbid the creation if the parent does not exist", func() {
+			namespacedCloudProfile.Spec.Parent.Name = "missing"
+			Expect(validate()).To(BeForbiddenError())
+		})
+
+		It("should allow adding new machine types, volume types and machine images", func() {
+			namespacedCloudProfile.Spec.MachineTypes = []core.MachineType{{Name: "custom-type"}}
+			namespacedCloudProfile.Spec.VolumeTypes = []core.VolumeType{{Name: "custom-volume"}}
+			namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
+				{Name: "image", Versions: []core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "2.0.0"}}}},
+				{Name: "custom-image", Versions: []core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.0.0"}}}},
+			}
+
+			Expect(validate()).To(Succeed())
+		})
+
+		It("should forbid overriding machine types and volume types of the parent", func() {
+			namespacedCloudProfile.Spec.MachineTypes = []core.MachineType{{Name: "type"}}
+			namespacedCloudProfile.Spec.VolumeTypes = []core.VolumeType{{Name: "volume"}}
+
+			err := validate()
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`machine type "type" is already defined in the parent CloudProfile`),
+				ContainSubstring(`volume type "volume" is already defined in the parent CloudProfile`),
+			)))
+		})
+
+		It("should allow extending the expiration dates of versions of the parent", func() {
+			namespacedCloudProfile.Spec.Kubernetes = &core.KubernetesSettings{
+				Versions: []core.ExpirableVersion{{Version: "1.28.0", ExpirationDate: &later}},
+			}
+			namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
+				{Name: "image", Versions: []core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.0.0", ExpirationDate: &later}}}},
+			}
+
+			Expect(validate()).To(Succeed())
+		})
+
+		It("should forbid shortening the expiration dates of versions of the parent", func() {
+			namespacedCloudProfile.Spec.Kubernetes = &core.KubernetesSettings{
+				Versions: []core.ExpirableVersion{{Version: "1.28.0", ExpirationDate: &earlier}},
+			}
+			namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
+				{Name: "image", Versions: []core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.0.0"}}}},
+			}
+
+			err := validate()
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`expiration date of version "1.28.0" must be later than the one of the parent CloudProfile`),
+				ContainSubstring(`expiration date of version "1.0.0" must be set to extend the one of the parent CloudProfile`),
+			)))
+		})
+
+		It("should forbid Kubernetes versions which are not expiring or not present in the parent", func() {
+			namespacedCloudProfile.Spec.Kubernetes = &core.KubernetesSettings{
+				Versions: []core.ExpirableVersion{
+					{Version: "1.27.0", ExpirationDate: &later},
+					{Version: "1.29.0", ExpirationDate: &later},
+				},
+			}
+
+			err := validate()
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`version "1.27.0" does not expire in the parent CloudProfile`),
+				ContainSubstring("version must be present in the parent CloudProfile"),
+			)))
+		})
+
+		Context("update", func() {
+			var oldNamespacedCloudProfile *core.NamespacedCloudProfile
+
+			BeforeEach(func() {
+				namespacedCloudProfile.Spec.MachineTypes = []core.MachineType{{Name: "custom-type"}}
+				namespacedCloudProfile.Spec.VolumeTypes = []core.VolumeType{{Name: "custom-volume"}}
+				namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
+					{Name: "image", Versions: []core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "2.0.0"}}}},
+					{Name: "custom-image", Versions: []core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.0.0"}}}},
+				}
+				namespacedCloudProfile.Spec.Kubernetes = &core.KubernetesSettings{
+					Versions: []core.ExpirableVersion{{Version: "1.28.0", ExpirationDate: &later}},
+				}
+				oldNamespacedCloudProfile = namespacedCloudProfile.DeepCopy()
+			})
+
+			It("should tolerate unchanged entries which have been added to the parent in the meantime", func() {
+				parentCloudProfile.Spec.MachineTypes = append(parentCloudProfile.Spec.MachineTypes, core.MachineType{Name: "custom-type"})
+				parentCloudProfile.Spec.VolumeTypes = append(parentCloudProfile.Spec.VolumeTypes, core.VolumeType{Name: "custom-volume"})
+				parentCloudProfile.Spec.MachineImages[0].Versions = append(parentCloudProfile.Spec.MachineImages[0].Versions, core.MachineImageVersion{ExpirableVersion: core.ExpirableVersion{Version: "2.0.0"}})
+				parentCloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &later
+
+				namespacedCloudProfile.Spec.MachineTypes = append(namespacedCloudProfile.Spec.MachineTypes, core.MachineType{Name: "other-type"})
+
+				Expect(validateUpdate(oldNamespacedCloudProfile)).To(Succeed())
+			})
+
+			It("should still validate changed entries against the parent", func() {
+				namespacedCloudProfile.Spec.MachineTypes = append(namespacedCloudProfile.Spec.MachineTypes, core.MachineType{Name: "type"})
+				namespacedCloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &earlier
+
+				err := validateUpdate(oldNamespacedCloudProfile)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(And(
+					ContainSubstring(`machine type "type" is already defined in the parent CloudProfile`),
+					ContainSubstring(`expiration date of version "1.28.0" must be later than the one of the parent CloudProfile`),
+				)))
+			})
+
+			Context("removed entries", func() {
+				var shoot *core.Shoot
+
+				BeforeEach(func() {
+					shoot = &core.Shoot{
+						ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespacedCloudProfile.Namespace},
+						Spec: core.ShootSpec{
+							CloudProfileName: "parent",
+							CloudProfile:     &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: namespacedCloudProfile.Name},
+							Provider: core.Provider{
+								Workers: []core.Worker{{
+									Name: "worker",
+									Machine: core.Machine{
+										Type:  "custom-type",
+										Image: &core.ShootMachineImage{Name: "image", Version: "2.0.0"},
+									},
+									Volume:      &core.Volume{Type: pointer.String("volume")},
+									DataVolumes: []core.DataVolume{{Name: "data", Type: pointer.String("custom-volume")}},
+								}},
+							},
+						},
+					}
+
+					Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(shoot)).To(Succeed())
+
+					namespacedCloudProfile.Spec.MachineTypes = nil
+					namespacedCloudProfile.Spec.VolumeTypes = nil
+					namespacedCloudProfile.Spec.MachineImages = nil
+				})
+
+				It("should forbid removing entries which are still in use", func() {
+					err := validateUpdate(oldNamespacedCloudProfile)
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(And(
+						ContainSubstring(`unable to remove machine type "custom-type" - it is still in use by shoot "shoot" by worker "worker"`),
+						ContainSubstring(`unable to remove volume type "custom-volume" - it is still in use by shoot "shoot" by worker "worker"`),
+						ContainSubstring(`unable to remove machine image version 'image/2.0.0' - it is still in use by shoot "shoot" by worker "worker"`),
+					)))
+				})
+
+				It("should allow removing entries which are provided by the parent", func() {
+					parentCloudProfile.Spec.MachineTypes = append(parentCloudProfile.Spec.MachineTypes, core.MachineType{Name: "custom-type"})
+					parentCloudProfile.Spec.VolumeTypes = append(parentCloudProfile.Spec.VolumeTypes, core.VolumeType{Name: "custom-volume"})
+					parentCloudProfile.Spec.MachineImages[0].Versions = append(parentCloudProfile.Spec.MachineImages[0].Versions, core.MachineImageVersion{ExpirableVersion: core.ExpirableVersion{Version: "2.0.0"}})
+
+					Expect(validateUpdate(oldNamespacedCloudProfile)).To(Succeed())
+				})
+
+				It("should allow removing entries which are used by shoots referencing other cloud profiles", func() {
+					shoot.Spec.CloudProfile = nil
+
+					Expect(validateUpdate(oldNamespacedCloudProfile)).To(Succeed())
+				})
+
+				It("should allow removing entries which are used by shoots in deletion", func() {
+					shoot.DeletionTimestamp = &now
+
+					Expect(validateUpdate(oldNamespacedCloudProfile)).To(Succeed())
+				})
+			})
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("NamespacedCloudProfileValidator"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should handle only CREATE and UPDATE operations", func() {
+			v, err := New()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v.Handles(admission.Create)).To(BeTrue())
+			Expect(v.Handles(admission.Update)).To(BeTrue())
+			Expect(v.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(v.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no CloudProfileLister is set", func() {
+			v, _ := New()
+			Expect(v.ValidateInitialization()).To(HaveOccurred())
+		})
+
+		It("should not return error if CloudProfileLister is set", func() {
+			v, _ := New()
+			v.SetInternalCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			Expect(v.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
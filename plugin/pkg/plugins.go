@@ -42,6 +42,8 @@ const (
 	PluginNameManagedSeedShoot = "ManagedSeedShoot"
 	// PluginNameManagedSeed is the name of the ManagedSeed admission plugin.
 	PluginNameManagedSeed = "ManagedSeed"
+	// PluginNameNamespacedCloudProfileValidator is the name of the NamespacedCloudProfileValidator admission plugin.
+	PluginNameNamespacedCloudProfileValidator = "NamespacedCloudProfileValidator"
 	// PluginNameProjectValidator is the name of the ProjectValidator admission plugin.
 	PluginNameProjectValidator = "ProjectValidator"
 	// PluginNameSeedValidator is the name of the SeedValidator admission plugin.
@@ -86,6 +88,7 @@ func AllPluginNames() []string {
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
 		PluginNameShootValidator,                    // ShootValidator
 		PluginNameSeedValidator,                     // SeedValidator
+		PluginNameNamespacedCloudProfileValidator,   // NamespacedCloudProfileValidator
 		PluginNameControllerRegistrationResources,   // ControllerRegistrationResources
 		PluginNameProjectValidator,                  // ProjectValidator
 		PluginNameDeletionConfirmation,              // DeletionConfirmation
@@ -124,6 +127,7 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameShootQuotaValidator,             // ShootQuotaValidator
 		PluginNameShootValidator,                  // ShootValidator
 		PluginNameSeedValidator,                   // SeedValidator
+		PluginNameNamespacedCloudProfileValidator, // NamespacedCloudProfileValidator
 		PluginNameControllerRegistrationResources, // ControllerRegistrationResources
 		PluginNameProjectValidator,                // ProjectValidator
 		PluginNameDeletionConfirmation,            // DeletionConfirmation
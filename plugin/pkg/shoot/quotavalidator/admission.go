@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -37,16 +40,7 @@ import (
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
 	timeutils "github.com/gardener/gardener/pkg/utils/time"
 	plugin "github.com/gardener/gardener/plugin/pkg"
-)
-
-var (
-	quotaMetricNames = [6]corev1.ResourceName{
-		core.QuotaMetricCPU,
-		core.QuotaMetricGPU,
-		core.QuotaMetricMemory,
-		core.QuotaMetricStorageStandard,
-		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 )
 
 // Register registers a plugin.
@@ -59,12 +53,13 @@ func Register(plugins *admission.Plugins) {
 // QuotaValidator contains listers and admission handler.
 type QuotaValidator struct {
 	*admission.Handler
-	shootLister         gardencorelisters.ShootLister
-	cloudProfileLister  gardencorelisters.CloudProfileLister
-	secretBindingLister gardencorelisters.SecretBindingLister
-	quotaLister         gardencorelisters.QuotaLister
-	readyFunc           admission.ReadyFunc
-	time                timeutils.Ops
+	shootLister                  gardencorelisters.ShootLister
+	cloudProfileLister           gardencorelisters.CloudProfileLister
+	namespacedCloudProfileLister gardencorelisters.NamespacedCloudProfileLister
+	secretBindingLister          gardencorelisters.SecretBindingLister
+	quotaLister                  gardencorelisters.QuotaLister
+	readyFunc                    admission.ReadyFunc
+	time                         timeutils.Ops
 }
 
 var (
@@ -95,13 +90,16 @@ func (q *QuotaValidator) SetInternalCoreInformerFactory(f gardencoreinformers.Sh
 	cloudProfileInformer := f.Core().InternalVersion().CloudProfiles()
 	q.cloudProfileLister = cloudProfileInformer.Lister()
 
+	namespacedCloudProfileInformer := f.Core().InternalVersion().NamespacedCloudProfiles()
+	q.namespacedCloudProfileLister = namespacedCloudProfileInformer.Lister()
+
 	secretBindingInformer := f.Core().InternalVersion().SecretBindings()
 	q.secretBindingLister = secretBindingInformer.Lister()
 
 	quotaInformer := f.Core().InternalVersion().Quotas()
 	q.quotaLister = quotaInformer.Lister()
 
-	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, namespacedCloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
 }
 
 // ValidateInitialization checks whether the plugin was correctly initialized.
@@ -112,6 +110,9 @@ func (q *QuotaValidator) ValidateInitialization() error {
 	if q.cloudProfileLister == nil {
 		return errors.New("missing cloudProfile lister")
 	}
+	if q.namespacedCloudProfileLister == nil {
+		return errors.New("missing namespacedCloudProfile lister")
+	}
 	if q.secretBindingLister == nil {
 		return errors.New("missing secretBinding lister")
 	}
@@ -124,7 +125,7 @@ func (q *QuotaValidator) ValidateInitialization() error {
 var _ admission.ValidationInterface = &QuotaValidator{}
 
 // Validate checks that the requested Shoot resources do not exceed the quota limits.
-func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+func (q *QuotaValidator) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if q.readyFunc == nil {
 		q.AssignReadyFunc(func() bool {
@@ -163,6 +164,7 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		maxShootLifetime *int32
 		checkLifetime    = false
 		checkQuota       = false
+		warnings         []string
 	)
 
 	if a.GetOperation() == admission.Create {
@@ -203,21 +205,28 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 			}
 
 			if checkQuota {
-				exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota)
+				exceededMetrics, exceededSoftMetrics, err := q.isQuotaExceeded(*shoot, *quota)
 				if err != nil {
 					return apierrors.NewInternalError(err)
 				}
-				if exceededMetrics != nil {
+				if len(exceededMetrics) > 0 {
 					message := ""
-					for _, metric := range *exceededMetrics {
+					for _, metric := range exceededMetrics {
 						message = message + metric.String() + " "
 					}
 					return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", message))
 				}
+				if len(exceededSoftMetrics) > 0 {
+					warnings = append(warnings, fmt.Sprintf("soft limits of quota %s/%s exceeded for %s", quota.Namespace, quota.Name, joinResourceNames(exceededSoftMetrics)))
+				}
 			}
 		}
 	}
 
+	for _, message := range warnings {
+		warning.AddWarning(ctx, "", message)
+	}
+
 	// Admit Shoot lifetime changes
 	if lifetime, exists := shoot.Annotations[v1beta1constants.ShootExpirationTimestamp]; checkLifetime && exists && maxShootLifetime != nil {
 		var (
@@ -239,29 +248,36 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota core.Quota) (*[]corev1.ResourceName, error) {
+func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota core.Quota) ([]corev1.ResourceName, []corev1.ResourceName, error) {
 	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	requiredResources, err := q.determineRequiredResources(allocatedResources, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	exceededMetrics := make([]corev1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
-		if _, ok := quota.Spec.Metrics[metric]; !ok {
-			continue
-		}
-		if !hasSufficientQuota(quota.Spec.Metrics[metric], requiredResources[metric]) {
-			exceededMetrics = append(exceededMetrics, metric)
+	return exceededMetrics(quota.Spec.Metrics, requiredResources), exceededMetrics(quota.Spec.SoftMetrics, requiredResources), nil
+}
+
+func exceededMetrics(limits, requiredResources corev1.ResourceList) []corev1.ResourceName {
+	var exceeded []corev1.ResourceName
+	for metric, limit := range limits {
+		if !hasSufficientQuota(limit, requiredResources[metric]) {
+			exceeded = append(exceeded, metric)
 		}
 	}
-	if len(exceededMetrics) != 0 {
-		return &exceededMetrics, nil
+	sort.Slice(exceeded, func(i, j int) bool { return exceeded[i] < exceeded[j] })
+	return exceeded
+}
+
+func joinResourceNames(names []corev1.ResourceName) string {
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		values = append(values, name.String())
 	}
-	return nil, nil
+	return strings.Join(values, ", ")
 }
 
 func (q *QuotaValidator) determineAllocatedResources(quota core.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
@@ -277,8 +293,8 @@ func (q *QuotaValidator) determineAllocatedResources(quota core.Quota, shoot cor
 		if err != nil {
 			return nil, err
 		}
-		for _, metric := range quotaMetricNames {
-			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+		for metric, quantity := range shootResources {
+			allocatedResources[metric] = sumQuantity(allocatedResources[metric], quantity)
 		}
 	}
 
@@ -338,15 +354,15 @@ func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.Re
 		return nil, err
 	}
 
-	requiredResources := make(corev1.ResourceList)
-	for _, metric := range quotaMetricNames {
-		requiredResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+	requiredResources := allocatedResources.DeepCopy()
+	for metric, quantity := range shootResources {
+		requiredResources[metric] = sumQuantity(allocatedResources[metric], quantity)
 	}
 	return requiredResources, nil
 }
 
 func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceList, error) {
-	cloudProfile, err := q.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
+	cloudProfile, err := admissionutils.GetCloudProfile(q.cloudProfileLister, q.namespacedCloudProfileLister, &shoot)
 	if err != nil {
 		return nil, apierrors.NewInternalError(fmt.Errorf("could not find referenced cloud profile: %+v", err.Error()))
 	}
@@ -401,6 +417,10 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
 		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
 		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+		resources[core.QuotaMetricNodes] = sumQuantity(resources[core.QuotaMetricNodes], *resource.NewQuantity(int64(worker.Maximum), resource.DecimalSI))
+
+		machineTypeMetric := corev1.ResourceName(core.QuotaMetricMachineTypePrefix + machineType.Name)
+		resources[machineTypeMetric] = sumQuantity(resources[machineTypeMetric], *resource.NewQuantity(int64(worker.Maximum), resource.DecimalSI))
 
 		size, _ := resource.ParseQuantity("0Gi")
 		if worker.Volume != nil {
@@ -424,6 +444,11 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		countLB++
 	}
 	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+	resources[core.QuotaMetricShoots] = *resource.NewQuantity(1, resource.DecimalSI)
+
+	if helper.IsHAControlPlaneConfigured(&shoot) {
+		resources[core.QuotaMetricHighlyAvailableControlPlanes] = *resource.NewQuantity(1, resource.DecimalSI)
+	}
 
 	return resources, nil
 }
@@ -465,6 +490,10 @@ func quotaVerificationNeeded(new, old core.Shoot) bool {
 		return true
 	}
 
+	if !helper.IsHAControlPlaneConfigured(&old) && helper.IsHAControlPlaneConfigured(&new) {
+		return true
+	}
+
 	// Check for diffs on workers
 	for _, worker := range new.Spec.Provider.Workers {
 		oldHasWorker := false
@@ -24,7 +24,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -312,6 +314,124 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for additional quota metrics and soft limits", func() {
+			var (
+				quotaExtra core.Quota
+				recorder   *warningRecorder
+				ctx        context.Context
+			)
+
+			BeforeEach(func() {
+				quotaExtra = core.Quota{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: trialNamespace,
+						Name:      "extra-quota",
+					},
+					Spec: core.QuotaSpec{
+						Scope: corev1.ObjectReference{
+							APIVersion: "core.gardener.cloud/v1beta1",
+							Kind:       "Project",
+						},
+						Metrics:     corev1.ResourceList{},
+						SoftMetrics: corev1.ResourceList{},
+					},
+				}
+				secretBinding.Quotas = []corev1.ObjectReference{{Namespace: trialNamespace, Name: quotaExtra.Name}}
+
+				Expect(coreInformerFactory.Core().InternalVersion().Quotas().Informer().GetStore().Add(&quotaExtra)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				recorder = &warningRecorder{}
+				ctx = warning.WithWarningRecorder(context.TODO(), recorder)
+			})
+
+			addOtherShoot := func() {
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+			}
+
+			validate := func(newShoot, oldShoot *core.Shoot, dryRun bool) error {
+				operation, options := admission.Create, runtime.Object(&metav1.CreateOptions{})
+				if oldShoot != nil {
+					operation, options = admission.Update, &metav1.UpdateOptions{}
+				}
+				attrs := admission.NewAttributesRecord(newShoot, oldShoot, core.Kind("Shoot").WithVersion("version"), newShoot.Namespace, newShoot.Name, core.Resource("shoots").WithVersion("version"), "", operation, options, dryRun, nil)
+				return admissionHandler.Validate(ctx, attrs, nil)
+			}
+
+			It("should fail because the number of shoots is exceeded", func() {
+				quotaExtra.Spec.Metrics[core.QuotaMetricShoots] = resource.MustParse("1")
+				addOtherShoot()
+
+				Expect(validate(&shoot, nil, false)).To(MatchError(ContainSubstring("quota limits exceeded. Unable to allocate further shoots")))
+			})
+
+			It("should pass because the number of shoots is sufficient", func() {
+				quotaExtra.Spec.Metrics[core.QuotaMetricShoots] = resource.MustParse("2")
+				addOtherShoot()
+
+				Expect(validate(&shoot, nil, false)).To(Succeed())
+			})
+
+			It("should fail because the number of nodes is exceeded", func() {
+				quotaExtra.Spec.Metrics[core.QuotaMetricNodes] = resource.MustParse("1")
+				shoot.Spec.Provider.Workers = workersBase2
+
+				Expect(validate(&shoot, nil, false)).To(MatchError(ContainSubstring("Unable to allocate further nodes")))
+			})
+
+			It("should fail because the number of nodes of a machine type is exceeded", func() {
+				quotaExtra.Spec.Metrics[corev1.ResourceName(core.QuotaMetricMachineTypePrefix+machineTypeName)] = resource.MustParse("0")
+
+				Expect(validate(&shoot, nil, false)).To(MatchError(ContainSubstring("Unable to allocate further machinetype/" + machineTypeName)))
+			})
+
+			It("should pass because the machine type with exceeded quota is not used", func() {
+				quotaExtra.Spec.Metrics[corev1.ResourceName(core.QuotaMetricMachineTypePrefix+machineTypeName2)] = resource.MustParse("0")
+
+				Expect(validate(&shoot, nil, false)).To(Succeed())
+			})
+
+			It("should fail because the number of highly available control planes is exceeded", func() {
+				quotaExtra.Spec.Metrics[core.QuotaMetricHighlyAvailableControlPlanes] = resource.MustParse("0")
+				oldShoot = *shoot.DeepCopy()
+				shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+
+				Expect(validate(&shoot, &oldShoot, false)).To(MatchError(ContainSubstring("Unable to allocate further controlplanes.highavailability")))
+			})
+
+			It("should pass because no highly available control plane is requested", func() {
+				quotaExtra.Spec.Metrics[core.QuotaMetricHighlyAvailableControlPlanes] = resource.MustParse("0")
+
+				Expect(validate(&shoot, nil, false)).To(Succeed())
+			})
+
+			It("should pass with warning because a soft limit is exceeded", func() {
+				quotaExtra.Spec.SoftMetrics[core.QuotaMetricShoots] = resource.MustParse("1")
+				quotaExtra.Spec.Metrics[core.QuotaMetricShoots] = resource.MustParse("2")
+				addOtherShoot()
+
+				Expect(validate(&shoot, nil, false)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf("soft limits of quota trial/extra-quota exceeded for shoots"))
+			})
+
+			It("should pass with warning for dry-run requests", func() {
+				quotaExtra.Spec.SoftMetrics[core.QuotaMetricShoots] = resource.MustParse("1")
+				addOtherShoot()
+
+				Expect(validate(&shoot, nil, true)).To(Succeed())
+				Expect(recorder.warnings).To(HaveLen(1))
+			})
+
+			It("should pass without warning because the soft limit is not exceeded", func() {
+				quotaExtra.Spec.SoftMetrics[core.QuotaMetricShoots] = resource.MustParse("2")
+				addOtherShoot()
+
+				Expect(validate(&shoot, nil, false)).To(Succeed())
+				Expect(recorder.warnings).To(BeEmpty())
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -420,3 +540,11 @@ var _ = Describe("quotavalidator", func() {
 		})
 	})
 })
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (w *warningRecorder) AddWarning(_, text string) {
+	w.warnings = append(w.warnings, text)
+}
@@ -69,14 +69,15 @@ func Register(plugins *admission.Plugins) {
 // ValidateShoot contains listers and admission handler.
 type ValidateShoot struct {
 	*admission.Handler
-	authorizer          authorizer.Authorizer
-	secretLister        kubecorev1listers.SecretLister
-	cloudProfileLister  gardencorelisters.CloudProfileLister
-	seedLister          gardencorelisters.SeedLister
-	shootLister         gardencorelisters.ShootLister
-	projectLister       gardencorelisters.ProjectLister
-	secretBindingLister gardencorelisters.SecretBindingLister
-	readyFunc           admission.ReadyFunc
+	authorizer                   authorizer.Authorizer
+	secretLister                 kubecorev1listers.SecretLister
+	cloudProfileLister           gardencorelisters.CloudProfileLister
+	namespacedCloudProfileLister gardencorelisters.NamespacedCloudProfileLister
+	seedLister                   gardencorelisters.SeedLister
+	shootLister                  gardencorelisters.ShootLister
+	projectLister                gardencorelisters.ProjectLister
+	secretBindingLister          gardencorelisters.SecretBindingLister
+	readyFunc                    admission.ReadyFunc
 }
 
 var (
@@ -116,6 +117,9 @@ func (v *ValidateShoot) SetInternalCoreInformerFactory(f gardencoreinformers.Sha
 	cloudProfileInformer := f.Core().InternalVersion().CloudProfiles()
 	v.cloudProfileLister = cloudProfileInformer.Lister()
 
+	namespacedCloudProfileInformer := f.Core().InternalVersion().NamespacedCloudProfiles()
+	v.namespacedCloudProfileLister = namespacedCloudProfileInformer.Lister()
+
 	projectInformer := f.Core().InternalVersion().Projects()
 	v.projectLister = projectInformer.Lister()
 
@@ -127,6 +131,7 @@ func (v *ValidateShoot) SetInternalCoreInformerFactory(f gardencoreinformers.Sha
 		seedInformer.Informer().HasSynced,
 		shootInformer.Informer().HasSynced,
 		cloudProfileInformer.Informer().HasSynced,
+		namespacedCloudProfileInformer.Informer().HasSynced,
 		projectInformer.Informer().HasSynced,
 		secretBindingInformer.Informer().HasSynced,
 	)
@@ -151,6 +156,9 @@ func (v *ValidateShoot) ValidateInitialization() error {
 	if v.cloudProfileLister == nil {
 		return errors.New("missing cloudProfile lister")
 	}
+	if v.namespacedCloudProfileLister == nil {
+		return errors.New("missing namespacedCloudProfile lister")
+	}
 	if v.seedLister == nil {
 		return errors.New("missing seed lister")
 	}
@@ -234,7 +242,7 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 		}
 	}
 
-	cloudProfile, err := v.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
+	cloudProfile, err := admissionutils.GetCloudProfile(v.cloudProfileLister, v.namespacedCloudProfileLister, shoot)
 	if err != nil {
 		return apierrors.NewInternalError(fmt.Errorf("could not find referenced cloud profile: %+v", err.Error()))
 	}
@@ -726,9 +734,17 @@ func (c *validationContext) validateShootNetworks(a admission.Attributes, worker
 			c.shoot.Spec.Networking.Services,
 			workerless,
 		)...)
+		allErrs = append(allErrs, cidrvalidation.ValidateShootSecondaryNetworkDisjointedness(
+			path,
+			c.shoot.Spec.Networking.SecondaryNodes,
+			c.shoot.Spec.Networking.SecondaryPods,
+			c.shoot.Spec.Networking.SecondaryServices,
+		)...)
+
+		seedChanged := !apiequality.Semantic.DeepEqual(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName)
 
 		// validate network disjointedness with seed networks if shoot is being (re)scheduled
-		if !apiequality.Semantic.DeepEqual(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName) {
+		if seedChanged {
 			allErrs = append(allErrs, cidrvalidation.ValidateNetworkDisjointedness(
 				path,
 				c.shoot.Spec.Networking.Nodes,
@@ -740,11 +756,41 @@ func (c *validationContext) validateShootNetworks(a admission.Attributes, worker
 				workerless,
 			)...)
 		}
+
+		// validate that the seed supports the IP families and that the secondary networks are disjoint with the seed
+		// networks if shoot is being (re)scheduled or a secondary IP family is added
+		if seedChanged || secondaryNetworksChanged(c.oldShoot.Spec.Networking, c.shoot.Spec.Networking) {
+			if !helper.SeedSupportsIPFamilies(c.seed.Spec.Networks.IPFamilies, c.shoot.Spec.Networking.IPFamilies) {
+				allErrs = append(allErrs, field.Forbidden(path.Child("ipFamilies"), fmt.Sprintf("seed %q does not support the IP families %v", c.seed.Name, c.shoot.Spec.Networking.IPFamilies)))
+			}
+			allErrs = append(allErrs, cidrvalidation.ValidateSecondaryNetworkDisjointedness(
+				path,
+				c.shoot.Spec.Networking.SecondaryNodes,
+				c.shoot.Spec.Networking.SecondaryPods,
+				c.shoot.Spec.Networking.SecondaryServices,
+				c.seed.Spec.Networks.Nodes,
+				c.seed.Spec.Networks.Pods,
+				c.seed.Spec.Networks.Services,
+			)...)
+		}
 	}
 
 	return allErrs
 }
 
+// secondaryNetworksChanged returns true if the IP families or the secondary networks of the given networking
+// configurations differ.
+func secondaryNetworksChanged(oldNetworking, newNetworking *core.Networking) bool {
+	if oldNetworking == nil {
+		return true
+	}
+
+	return !apiequality.Semantic.DeepEqual(oldNetworking.IPFamilies, newNetworking.IPFamilies) ||
+		!apiequality.Semantic.DeepEqual(oldNetworking.SecondaryNodes, newNetworking.SecondaryNodes) ||
+		!apiequality.Semantic.DeepEqual(oldNetworking.SecondaryPods, newNetworking.SecondaryPods) ||
+		!apiequality.Semantic.DeepEqual(oldNetworking.SecondaryServices, newNetworking.SecondaryServices)
+}
+
 func (c *validationContext) validateKubernetes(a admission.Attributes) field.ErrorList {
 	var (
 		allErrs field.ErrorList
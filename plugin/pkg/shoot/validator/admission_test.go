@@ -1691,6 +1691,82 @@ var _ = Describe("validator", func() {
 					Expect(err).To(BeForbiddenError())
 				})
 
+				It("create should fail because secondary pod network overlaps with the default IPv6 VPN network", func() {
+					seed.Spec.Networks.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					shoot.Spec.Networking.SecondaryPods = pointer.String(v1beta1constants.DefaultVPNRangeV6)
+					shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:2::/108")
+
+					Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("spec.networking.secondaryPods"))
+				})
+
+				It("update should fail because secondary shoot networks overlap", func() {
+					seed.Spec.Networks.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					shoot.Spec.Networking.SecondaryPods = pointer.String("2001:db8:1::/48")
+					shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:1::/108")
+					oldShoot.Spec.SeedName = shoot.Spec.SeedName
+
+					Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("spec.networking.secondaryServices"))
+				})
+
+				It("create should fail because the seed does not support the IP families of a dual-stack shoot", func() {
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					shoot.Spec.Networking.SecondaryPods = pointer.String("2001:db8:1::/48")
+					shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:3::/108")
+
+					Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("spec.networking.ipFamilies: Forbidden: seed %q does not support the IP families [IPv4 IPv6]", seed.Name))
+				})
+
+				It("update should fail because the added secondary pod network overlaps with the seed networks of the same IP family", func() {
+					seed.Spec.Networks = core.SeedNetworks{
+						IPFamilies: []core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4},
+						Pods:       "2001:db8:11::/48",
+						Services:   "2001:db8:12::/108",
+					}
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					shoot.Spec.Networking.SecondaryPods = pointer.String(seed.Spec.Networks.Pods)
+					shoot.Spec.Networking.SecondaryServices = pointer.String("2001:db8:3::/108")
+					oldShoot.Spec.SeedName = shoot.Spec.SeedName
+
+					Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("shoot secondary pod network intersects with seed pod network"))
+					Expect(err.Error()).NotTo(ContainSubstring("spec.networking.ipFamilies"))
+				})
+
 				It("delete should pass because validation of network disjointedness should not be executed", func() {
 					// set shoot pod cidr to overlap with vpn pod cidr
 					shoot.Spec.Networking.Pods = pointer.String(v1beta1constants.DefaultVPNRange)
@@ -3988,6 +4064,42 @@ var _ = Describe("validator", func() {
 					Expect(err).To(MatchError(ContainSubstring("Unsupported value: %q: supported values: %q, %q]", "not-present-in-cloudprofile", "machine-type-1", "machine-type-2")))
 				})
 
+				It("should not reject a machine type added by the referenced NamespacedCloudProfile", func() {
+					namespacedCloudProfile := &core.NamespacedCloudProfile{
+						ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: shoot.Namespace},
+						Spec: core.NamespacedCloudProfileSpec{
+							Parent: core.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+						},
+						Status: core.NamespacedCloudProfileStatus{
+							CloudProfileSpec: *cloudProfile.Spec.DeepCopy(),
+						},
+					}
+					namespacedCloudProfile.Status.CloudProfileSpec.MachineTypes = append(namespacedCloudProfile.Status.CloudProfileSpec.MachineTypes, core.MachineType{
+						Name:         "custom-machine-type",
+						Architecture: pointer.String("amd64"),
+						Usable:       pointer.Bool(true),
+					})
+					Expect(coreInformerFactory.Core().InternalVersion().NamespacedCloudProfiles().Informer().GetStore().Add(namespacedCloudProfile)).To(Succeed())
+
+					shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: namespacedCloudProfile.Name}
+					shoot.Spec.Provider.Workers[0].Machine.Type = "custom-machine-type"
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject because the referenced NamespacedCloudProfile does not exist", func() {
+					shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeInternalServerError())
+					Expect(err).To(MatchError(ContainSubstring("could not find referenced cloud profile")))
+				})
+
 				It("should reject if the machine is unavailable in atleast one zone", func() {
 					unavailableMachine := "unavailable-machine"
 					zone := "some-zone"
@@ -0,0 +1,45 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
+)
+
+// GetCloudProfile returns the effective CloudProfile for the given shoot. If the shoot references a
+// NamespacedCloudProfile, a CloudProfile is constructed from its status. Otherwise, the CloudProfile referenced by
+// spec.cloudProfileName is returned.
+func GetCloudProfile(cloudProfileLister internalversion.CloudProfileLister, namespacedCloudProfileLister internalversion.NamespacedCloudProfileLister, shoot *core.Shoot) (*core.CloudProfile, error) {
+	if shoot.Spec.CloudProfile == nil || shoot.Spec.CloudProfile.Kind != core.CloudProfileReferenceKindNamespacedCloudProfile {
+		return cloudProfileLister.Get(shoot.Spec.CloudProfileName)
+	}
+
+	namespacedCloudProfile, err := namespacedCloudProfileLister.NamespacedCloudProfiles(shoot.Namespace).Get(shoot.Spec.CloudProfile.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespacedCloudProfile.Status.CloudProfileSpec.Type == "" {
+		return nil, fmt.Errorf("namespaced cloud profile %s/%s has not yet been reconciled", namespacedCloudProfile.Namespace, namespacedCloudProfile.Name)
+	}
+
+	return &core.CloudProfile{
+		ObjectMeta: *namespacedCloudProfile.ObjectMeta.DeepCopy(),
+		Spec:       *namespacedCloudProfile.Status.CloudProfileSpec.DeepCopy(),
+	}, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	"github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+var _ = Describe("CloudProfile", func() {
+	Describe("#GetCloudProfile", func() {
+		var (
+			coreInformerFactory gardencoreinformers.SharedInformerFactory
+
+			cloudProfile           *gardencore.CloudProfile
+			namespacedCloudProfile *gardencore.NamespacedCloudProfile
+			shoot                  *gardencore.Shoot
+		)
+
+		BeforeEach(func() {
+			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+			cloudProfile = &gardencore.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "parent"},
+				Spec:       gardencore.CloudProfileSpec{Type: "local"},
+			}
+			namespacedCloudProfile = &gardencore.NamespacedCloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-dev"},
+				Spec: gardencore.NamespacedCloudProfileSpec{
+					Parent: gardencore.CloudProfileReference{Kind: "CloudProfile", Name: "parent"},
+				},
+				Status: gardencore.NamespacedCloudProfileStatus{
+					CloudProfileSpec: gardencore.CloudProfileSpec{
+						Type:         "local",
+						MachineTypes: []gardencore.MachineType{{Name: "custom-type"}},
+					},
+				},
+			}
+			shoot = &gardencore.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec:       gardencore.ShootSpec{CloudProfileName: "parent"},
+			}
+
+			Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(cloudProfile)).To(Succeed())
+			Expect(coreInformerFactory.Core().InternalVersion().NamespacedCloudProfiles().Informer().GetStore().Add(namespacedCloudProfile)).To(Succeed())
+		})
+
+		getCloudProfile := func() (*gardencore.CloudProfile, error) {
+			return utils.GetCloudProfile(
+				coreInformerFactory.Core().InternalVersion().CloudProfiles().Lister(),
+				coreInformerFactory.Core().InternalVersion().NamespacedCloudProfiles().Lister(),
+				shoot,
+			)
+		}
+
+		It("should return the referenced CloudProfile if no NamespacedCloudProfile is referenced", func() {
+			result, err := getCloudProfile()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(cloudProfile))
+		})
+
+		It("should return the referenced CloudProfile if spec.cloudProfile is of kind CloudProfile", func() {
+			shoot.Spec.CloudProfile = &gardencore.CloudProfileReference{Kind: "CloudProfile", Name: "parent"}
+
+			result, err := getCloudProfile()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(cloudProfile))
+		})
+
+		It("should return the effective CloudProfile of the referenced NamespacedCloudProfile", func() {
+			shoot.Spec.CloudProfile = &gardencore.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+
+			result, err := getCloudProfile()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ObjectMeta).To(Equal(namespacedCloudProfile.ObjectMeta))
+			Expect(result.Spec).To(Equal(namespacedCloudProfile.Status.CloudProfileSpec))
+		})
+
+		It("should return an error if the NamespacedCloudProfile does not exist", func() {
+			shoot.Spec.CloudProfile = &gardencore.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "missing"}
+
+			result, err := getCloudProfile()
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should return an error if the NamespacedCloudProfile has not yet been reconciled", func() {
+			namespacedCloudProfile.Status.CloudProfileSpec = gardencore.CloudProfileSpec{}
+			shoot.Spec.CloudProfile = &gardencore.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+
+			result, err := getCloudProfile()
+			Expect(err).To(MatchError(ContainSubstring("has not yet been reconciled")))
+			Expect(result).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,133 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	e2e "github.com/gardener/gardener/test/e2e/gardener"
+	"github.com/gardener/gardener/test/framework"
+	"github.com/gardener/gardener/test/utils/access"
+)
+
+// The secondary networks match the IPv6 shoot defaults of the local dual-stack seed, see
+// example/gardener-local/gardenlet/values-dual.yaml.
+const (
+	dualStackSecondaryPods     = "fd00:10:3::/56"
+	dualStackSecondaryServices = "fd00:10:4::/112"
+)
+
+var _ = Describe("Shoot Tests", Label("Shoot", "dual-stack"), func() {
+	newShoot := func(name string) *gardencorev1beta1.Shoot {
+		shoot := e2e.DefaultShoot(name)
+		shoot.Spec.Networking.Pods = pointer.String("10.3.0.0/16")
+		shoot.Spec.Networking.Services = pointer.String("10.4.0.0/16")
+		return shoot
+	}
+
+	enableDualStack := func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+		shoot.Spec.Networking.SecondaryPods = pointer.String(dualStackSecondaryPods)
+		shoot.Spec.Networking.SecondaryServices = pointer.String(dualStackSecondaryServices)
+	}
+
+	Context("Dual-stack Shoot", func() {
+		f := defaultShootCreationFramework()
+		f.Shoot = newShoot("e2e-dual")
+		enableDualStack(f.Shoot)
+
+		It("Create and Delete Dual-Stack Shoot", func() {
+			By("Create Shoot")
+			ctx, cancel := context.WithTimeout(parentCtx, 15*time.Minute)
+			defer cancel()
+			Expect(f.CreateShootAndWaitForCreation(ctx, false)).To(Succeed())
+			f.Verify()
+
+			By("Verify that the nodes have pod CIDRs of both IP families")
+			verifyDualStackNodes(ctx, f)
+
+			By("Delete Shoot")
+			ctx, cancel = context.WithTimeout(parentCtx, 15*time.Minute)
+			defer cancel()
+			Expect(f.DeleteShootAndWaitForDeletion(ctx, f.Shoot)).To(Succeed())
+		})
+	})
+
+	Context("Single-stack Shoot", func() {
+		f := defaultShootCreationFramework()
+		f.Shoot = newShoot("e2e-dual-mig")
+
+		It("Create, Migrate to Dual-Stack and Delete Shoot", func() {
+			By("Create Shoot")
+			ctx, cancel := context.WithTimeout(parentCtx, 15*time.Minute)
+			defer cancel()
+			Expect(f.CreateShootAndWaitForCreation(ctx, false)).To(Succeed())
+			f.Verify()
+
+			By("Add IPv6 as secondary IP family")
+			ctx, cancel = context.WithTimeout(parentCtx, 20*time.Minute)
+			defer cancel()
+			Expect(f.GardenerFramework.UpdateShoot(ctx, f.Shoot, func(shoot *gardencorev1beta1.Shoot) error {
+				enableDualStack(shoot)
+				return nil
+			})).To(Succeed())
+
+			By("Verify that the rolled nodes have pod CIDRs of both IP families")
+			verifyDualStackNodes(ctx, f)
+
+			By("Delete Shoot")
+			ctx, cancel = context.WithTimeout(parentCtx, 15*time.Minute)
+			defer cancel()
+			Expect(f.DeleteShootAndWaitForDeletion(ctx, f.Shoot)).To(Succeed())
+		})
+	})
+})
+
+func verifyDualStackNodes(ctx context.Context, f *framework.ShootCreationFramework) {
+	var shootClient kubernetes.Interface
+
+	Eventually(func(g Gomega) {
+		var err error
+		shootClient, err = access.CreateShootClientFromAdminKubeconfig(ctx, f.GardenClient, f.Shoot)
+		g.Expect(err).NotTo(HaveOccurred())
+	}).Should(Succeed())
+
+	Eventually(func(g Gomega) {
+		nodeList := &corev1.NodeList{}
+		g.Expect(shootClient.Client().List(ctx, nodeList)).To(Succeed())
+		g.Expect(nodeList.Items).NotTo(BeEmpty())
+
+		for _, node := range nodeList.Items {
+			g.Expect(node.Spec.PodCIDRs).To(HaveLen(2), "node %s should have pod CIDRs of both IP families", node.Name)
+
+			_, primary, err := net.ParseCIDR(node.Spec.PodCIDRs[0])
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(primary.IP.To4()).NotTo(BeNil(), "primary pod CIDR of node %s should be an IPv4 CIDR", node.Name)
+
+			_, secondary, err := net.ParseCIDR(node.Spec.PodCIDRs[1])
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(secondary.IP.To4()).To(BeNil(), "secondary pod CIDR of node %s should be an IPv6 CIDR", node.Name)
+		}
+	}).Should(Succeed())
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	e2e "github.com/gardener/gardener/test/e2e/gardener"
+)
+
+var _ = Describe("Shoot Tests", Label("Shoot", "default"), func() {
+	f := defaultShootCreationFramework()
+
+	namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "local-e2e-ncp",
+		},
+		Spec: gardencorev1beta1.NamespacedCloudProfileSpec{
+			Parent: gardencorev1beta1.CloudProfileReference{
+				Kind: gardencorev1beta1.CloudProfileReferenceKindCloudProfile,
+				Name: "local",
+			},
+			// The machine type and the machine image version are only available in the NamespacedCloudProfile, hence
+			// the shoot can only be reconciled if gardenlet and provider-local use its effective specification.
+			MachineTypes: []gardencorev1beta1.MachineType{{
+				Name:   "local-namespaced",
+				CPU:    resource.MustParse("1"),
+				GPU:    resource.MustParse("0"),
+				Memory: resource.MustParse("8Gi"),
+			}},
+			MachineImages: []gardencorev1beta1.MachineImage{{
+				Name: "local",
+				Versions: []gardencorev1beta1.MachineImageVersion{{
+					ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.1"},
+					CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+				}},
+			}},
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+  "apiVersion": "local.provider.extensions.gardener.cloud/v1alpha1",
+  "kind": "CloudProfileConfig",
+  "machineImages": [{
+    "name": "local",
+    "versions": [{
+      "version": "1.0.1",
+      "image": "ghcr.io/gardener/machine-controller-manager-provider-local/node:latest"
+    }]
+  }]
+}`)},
+		},
+	}
+
+	f.Shoot = e2e.DefaultShoot("e2e-ncp")
+	f.Shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{
+		Kind: gardencorev1beta1.CloudProfileReferenceKindNamespacedCloudProfile,
+		Name: namespacedCloudProfile.Name,
+	}
+	f.Shoot.Spec.Provider.Workers[0].Machine.Type = "local-namespaced"
+	f.Shoot.Spec.Provider.Workers[0].Machine.Image = &gardencorev1beta1.ShootMachineImage{
+		Name:    "local",
+		Version: pointer.String("1.0.1"),
+	}
+
+	It("Create and Delete Shoot with NamespacedCloudProfile", Label("namespaced-cloudprofile"), func() {
+		By("Create NamespacedCloudProfile")
+		ctx, cancel := context.WithTimeout(parentCtx, 15*time.Minute)
+		defer cancel()
+
+		gardenClient := f.GardenClient.Client()
+		namespacedCloudProfile.Namespace = f.ProjectNamespace
+		Expect(gardenClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+		DeferCleanup(func() {
+			ctx, cancel := context.WithTimeout(parentCtx, 2*time.Minute)
+			defer cancel()
+
+			By("Delete NamespacedCloudProfile")
+			Expect(client.IgnoreNotFound(gardenClient.Delete(ctx, namespacedCloudProfile))).To(Succeed())
+			Eventually(func() error {
+				return gardenClient.Get(ctx, client.ObjectKeyFromObject(namespacedCloudProfile), namespacedCloudProfile)
+			}).Should(BeNotFoundError())
+		})
+
+		Eventually(func(g Gomega) {
+			g.Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(namespacedCloudProfile), namespacedCloudProfile)).To(Succeed())
+			g.Expect(namespacedCloudProfile.Status.ObservedGeneration).To(Equal(namespacedCloudProfile.Generation))
+		}).Should(Succeed())
+
+		By("Create Shoot")
+		Expect(f.CreateShootAndWaitForCreation(ctx, false)).To(Succeed())
+		f.Verify()
+
+		By("Delete Shoot")
+		ctx, cancel = context.WithTimeout(parentCtx, 15*time.Minute)
+		defer cancel()
+		Expect(f.DeleteShootAndWaitForDeletion(ctx, f.Shoot)).To(Succeed())
+	})
+})
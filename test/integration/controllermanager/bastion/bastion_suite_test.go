@@ -125,6 +125,7 @@ var _ = BeforeSuite(func() {
 
 	By("Setup field indexes")
 	Expect(indexer.AddBastionShootName(ctx, mgr.GetFieldIndexer())).To(Succeed())
+	Expect(indexer.AddProjectNamespace(ctx, mgr.GetFieldIndexer())).To(Succeed())
 
 	By("Register controller")
 	fakeClock = testclock.NewFakeClock(time.Now())
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +34,7 @@ import (
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	bastionregistry "github.com/gardener/gardener/pkg/registry/operations/bastion"
 	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -197,6 +199,24 @@ var _ = Describe("Bastion controller tests", func() {
 					return testClient.Get(ctx, objectKey, bastion)
 				}).Should(Succeed())
 			})
+
+			It("should record an event for the creation of the Bastion", func() {
+				Eventually(func(g Gomega) {
+					g.Expect(testClient.Get(ctx, objectKey, bastion)).To(Succeed())
+					g.Expect(bastion.Status.RecordedSessions).To(PointTo(Equal(int32(1))))
+				}).Should(Succeed())
+
+				eventList := &corev1.EventList{}
+				Expect(testClient.List(ctx, eventList, client.InNamespace(testNamespace.Name))).To(Succeed())
+				Expect(eventList.Items).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"InvolvedObject": MatchFields(IgnoreExtras, Fields{
+						"Kind": Equal("Bastion"),
+						"Name": Equal(bastion.Name),
+					}),
+					"Reason":  Equal(gardenerutils.EventReasonBastionSessionCreated),
+					"Message": ContainSubstring(shoot.Name),
+				})))
+			})
 		})
 
 		Describe("expiration timestamp", func() {
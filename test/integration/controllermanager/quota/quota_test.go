@@ -140,6 +140,18 @@ var _ = Describe("Quota controller tests", func() {
 		})
 	})
 
+	Context("usage", func() {
+		It("should report the usage of the Quota", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, objectKey, quota)).To(Succeed())
+				g.Expect(quota.Status.ObservedGeneration).To(Equal(quota.Generation))
+				g.Expect(quota.Status.LastUpdateTime).NotTo(BeNil())
+				g.Expect(quota.Status.Usage).To(HaveLen(1))
+				g.Expect(quota.Status.Usage[0].Consumers).To(BeEmpty())
+			}).Should(Succeed())
+		})
+	})
+
 	Context("SecretBinding referencing Quota", func() {
 		JustBeforeEach(func() {
 			By("Ensure finalizer got added")
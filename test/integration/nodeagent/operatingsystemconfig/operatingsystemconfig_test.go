@@ -16,6 +16,7 @@ package operatingsystemconfig_test
 
 import (
 	"context"
+	"fmt"
 	"path"
 	"path/filepath"
 	"time"
@@ -317,6 +318,15 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 			HaveKeyWithValue("worker.gardener.cloud/kubernetes-version", kubernetesVersion.String()),
 		))
 
+		By("Assert that node condition has been updated")
+		updatedNode := &corev1.Node{}
+		Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+		Expect(updatedNode.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigApplied")),
+			HaveField("Status", corev1.ConditionTrue),
+			HaveField("Reason", "OSCApplied"),
+		)))
+
 		By("Assert that files and units have been created")
 		test.AssertFileOnDisk(fakeFS, file1.Path, "file1", 0777)
 		test.AssertFileOnDisk(fakeFS, file2.Path, "file2", 0600)
@@ -479,6 +489,71 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		Expect(cancelFunc.called).To(BeFalse())
 	})
 
+	It("should roll back to the previous OSC when applying the new OSC fails", func() {
+		var lastAppliedOSC []byte
+		By("Wait last-applied OSC file to be persisted")
+		Eventually(func(g Gomega) error {
+			var err error
+			lastAppliedOSC, err = fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")
+			return err
+		}).Should(Succeed())
+
+		fakeDBus.Actions = nil // reset actions on dbus to not repeat assertions from above for update scenario
+		fakeDBus.InjectRestartError("unit10", fmt.Errorf("fake"))
+
+		By("Update Operating System Config")
+		// the content of file1 is changed
+		// unit10 is added but fails to start
+		unit10 := extensionsv1alpha1.Unit{
+			Name:    "unit10",
+			Enable:  pointer.Bool(true),
+			Command: extensionsv1alpha1.UnitCommandPtr(extensionsv1alpha1.CommandStart),
+			Content: pointer.String("#unit10"),
+		}
+
+		operatingSystemConfig.Spec.Files[0].Content.Inline.Data = "changeme"
+		operatingSystemConfig.Spec.Units = append(operatingSystemConfig.Spec.Units, unit10)
+
+		newOSCRaw, err := runtime.Encode(codec, operatingSystemConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Update Secret containing the operating system config")
+		patch := client.MergeFrom(oscSecret.DeepCopy())
+		oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(newOSCRaw)
+		oscSecret.Data["osc.yaml"] = newOSCRaw
+		Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+		By("Wait for node condition to be updated")
+		Eventually(func(g Gomega) []corev1.NodeCondition {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Status.Conditions
+		}).Should(ContainElement(And(
+			HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigApplied")),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", "OSCRolledBack"),
+			HaveField("Message", ContainSubstring(`unit "unit10": unable to restart unit "unit10": fake`)),
+		)))
+
+		By("Assert that files and units have been restored")
+		test.AssertFileOnDisk(fakeFS, file1.Path, "file1", 0777)
+		test.AssertNoFileOnDisk(fakeFS, "/etc/systemd/system/"+unit10.Name)
+
+		By("Assert that unit actions have been applied")
+		Expect(fakeDBus.Actions).To(ContainElements(
+			fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{unit10.Name}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit10.Name}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionDisable, UnitNames: []string{unit10.Name}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{unit10.Name}},
+		))
+
+		By("Assert that last-applied OSC file and node annotations have not been updated")
+		Expect(fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")).To(Equal(lastAppliedOSC))
+		updatedNode := &corev1.Node{}
+		Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+		Expect(updatedNode.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+	})
+
 	It("should call the cancel function when gardener-node-agent must be restarted itself", func() {
 		var lastAppliedOSC []byte
 		By("Wait last-applied OSC file to be persisted")
@@ -0,0 +1,149 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("BackupBucket Scheduler tests", func() {
+	It("should schedule the backup bucket to a seed with the same provider type in the same region", func() {
+		createSeed("other-provider-type", "some-region")
+		createSeed(providerType, "other-region")
+		seed := createSeed(providerType, "some-region")
+		backupBucket := createBackupBucket(providerType, "some-region", nil)
+
+		Eventually(func(g Gomega) *string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+			return backupBucket.Spec.SeedName
+		}).Should(PointTo(Equal(seed.Name)))
+	})
+
+	It("should not schedule the backup bucket if there is no seed with the same provider type", func() {
+		createSeed("other-provider-type", "some-region")
+		backupBucket := createBackupBucket(providerType, "some-region", nil)
+
+		Consistently(func(g Gomega) *string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+			return backupBucket.Spec.SeedName
+		}).Should(BeNil())
+	})
+
+	It("should not change the seed of an already scheduled backup bucket", func() {
+		seed := createSeed(providerType, "some-region")
+		backupBucket := createBackupBucket(providerType, "some-region", pointer.String("other-seed"))
+
+		Consistently(func(g Gomega) *string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+			return backupBucket.Spec.SeedName
+		}).ShouldNot(PointTo(Equal(seed.Name)))
+	})
+})
+
+func createSeed(providerType, region string) *gardencorev1beta1.Seed {
+	By("Create Seed")
+	seed := &gardencorev1beta1.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: testID + "-",
+			Labels:       map[string]string{testID: testRunID},
+		},
+		Spec: gardencorev1beta1.SeedSpec{
+			Provider: gardencorev1beta1.SeedProvider{
+				Type:   providerType,
+				Region: region,
+			},
+			Ingress: &gardencorev1beta1.Ingress{
+				Domain: "seed.example.com",
+				Controller: gardencorev1beta1.IngressController{
+					Kind: "nginx",
+				},
+			},
+			DNS: gardencorev1beta1.SeedDNS{
+				Provider: &gardencorev1beta1.SeedDNSProvider{
+					Type: providerType,
+					SecretRef: corev1.SecretReference{
+						Name:      "some-secret",
+						Namespace: "some-namespace",
+					},
+				},
+			},
+			Settings: &gardencorev1beta1.SeedSettings{
+				Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+			},
+			Networks: gardencorev1beta1.SeedNetworks{
+				Pods:     "10.0.0.0/16",
+				Services: "10.1.0.0/16",
+				Nodes:    pointer.String("10.2.0.0/16"),
+			},
+		},
+	}
+	ExpectWithOffset(1, testClient.Create(ctx, seed)).To(Succeed())
+	log.Info("Created Seed for test", "seed", client.ObjectKeyFromObject(seed), "region", seed.Spec.Provider.Region)
+
+	DeferCleanup(func() {
+		By("Delete Seed")
+		ExpectWithOffset(1, client.IgnoreNotFound(testClient.Delete(ctx, seed))).To(Succeed())
+	})
+
+	seed.Status = gardencorev1beta1.SeedStatus{
+		Conditions: []gardencorev1beta1.Condition{
+			{
+				Type:   gardencorev1beta1.SeedGardenletReady,
+				Status: gardencorev1beta1.ConditionTrue,
+			},
+		},
+		LastOperation: &gardencorev1beta1.LastOperation{},
+	}
+	ExpectWithOffset(1, testClient.Status().Update(ctx, seed)).To(Succeed())
+	return seed
+}
+
+func createBackupBucket(providerType, region string, seedName *string) *gardencorev1beta1.BackupBucket {
+	By("Create BackupBucket")
+	backupBucket := &gardencorev1beta1.BackupBucket{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: testID + "-",
+			Labels:       map[string]string{testID: testRunID},
+		},
+		Spec: gardencorev1beta1.BackupBucketSpec{
+			Provider: gardencorev1beta1.BackupBucketProvider{
+				Type:   providerType,
+				Region: region,
+			},
+			SecretRef: corev1.SecretReference{
+				Name:      "some-secret",
+				Namespace: "garden",
+			},
+			SeedName: seedName,
+		},
+	}
+	ExpectWithOffset(1, testClient.Create(ctx, backupBucket)).To(Succeed())
+	log.Info("Created BackupBucket for test", "backupBucket", client.ObjectKeyFromObject(backupBucket))
+
+	DeferCleanup(func() {
+		By("Delete BackupBucket")
+		ExpectWithOffset(1, client.IgnoreNotFound(testClient.Delete(ctx, backupBucket))).To(Succeed())
+	})
+
+	return backupBucket
+}